@@ -118,9 +118,13 @@ export interface SymbolMatch {
 export interface MatchedSymbol {
     url: string
     name: string
+    /** The complete symbol name, set for symbols read from a precise index. */
+    fullyQualifiedName?: string
     containerName: string
     kind: SymbolKind
     line: number
+    /** Where the symbol came from: 'precise' (SCIP index) or 'search-based' (ctags). */
+    provenance?: 'precise' | 'search-based'
 }
 
 type MarkdownText = string
@@ -11,6 +11,7 @@ import {
     mdiArchive,
     mdiLock,
     mdiDotsVertical,
+    mdiSourceBranchRemove,
 } from '@mdi/js'
 import { VisuallyHidden } from '@reach/visually-hidden'
 import classNames from 'classnames'
@@ -68,6 +69,9 @@ export const ChangesetStatusCell: React.FunctionComponent<React.PropsWithChildre
         case ChangesetState.DELETED: {
             return <ChangesetStatusDeleted className={className} role={role} />
         }
+        case ChangesetState.CONFLICTING: {
+            return <ChangesetStatusConflicting className={className} role={role} />
+        }
     }
 }
 
@@ -189,6 +193,17 @@ export const ChangesetStatusArchived: React.FunctionComponent<React.PropsWithChi
     </div>
 )
 
+export const ChangesetStatusConflicting: React.FunctionComponent<
+    React.PropsWithChildren<ChangesetStatusIconProps>
+> = ({ label = <StatusLabel className="text-warning" status="Conflicting" />, className, ...props }) => (
+    <Tooltip content="This changeset is open, but its diff no longer applies cleanly to the latest commit of its base branch.">
+        <div className={classNames(iconClassNames, className)} {...props}>
+            <Icon className="text-warning" svgPath={mdiSourceBranchRemove} inline={false} aria-hidden={true} />
+            {label}
+        </div>
+    </Tooltip>
+)
+
 export const ChangesetStatusReadOnly: React.FunctionComponent<React.PropsWithChildren<ChangesetStatusIconProps>> = ({
     label = <StatusLabel status="Read-only" />,
     className,
@@ -71,6 +71,7 @@ export const checkPublishability = (
         if (
             [
                 ChangesetState.CLOSED,
+                ChangesetState.CONFLICTING,
                 ChangesetState.DELETED,
                 ChangesetState.MERGED,
                 ChangesetState.OPEN,
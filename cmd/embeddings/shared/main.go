@@ -68,7 +68,19 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		repoStore,
 		repoEmbeddingJobsStore,
 		func(ctx context.Context, repoID api.RepoID, repoName api.RepoName) (*embeddings.RepoEmbeddingIndex, error) {
-			return embeddings.DownloadRepoEmbeddingIndex(ctx, uploadStore, repoID, repoName)
+			index, err := embeddings.DownloadRepoEmbeddingIndex(ctx, uploadStore, repoID, repoName)
+			if err != nil {
+				return nil, err
+			}
+
+			// Indexes without an IVF index are searched exhaustively.
+			ivfIndex, err := embeddings.DownloadRepoEmbeddingIVFIndex(ctx, uploadStore, repoID)
+			if err != nil {
+				logger.Debug("no IVF index for repo", log.String("repoName", string(repoName)), log.Error(err))
+				return index, nil
+			}
+			index.SetIVFIndex(ivfIndex)
+			return index, nil
 		},
 		config.EmbeddingsCacheSize,
 	)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/embeddings"
+	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -17,6 +18,8 @@ const (
 	queryEmbeddingRetries          = 3
 )
 
+var similaritySearchNumProbes = env.MustGetInt("EMBEDDINGS_SEARCH_NUM_PROBES", 32, "The number of lists of the IVF index of a repo searched for each query. Higher values improve recall at the cost of latency. 0 disables approximate search.")
+
 type (
 	getRepoEmbeddingIndexFn func(ctx context.Context, repoID api.RepoID, repoName api.RepoName) (*embeddings.RepoEmbeddingIndex, error)
 	getQueryEmbeddingFn     func(ctx context.Context, model string) ([]float32, string, error)
@@ -44,6 +47,7 @@ func searchRepoEmbeddingIndexes(
 
 	searchOpts := embeddings.SearchOptions{
 		UseDocumentRanks: params.UseDocumentRanks,
+		NumProbes:        similaritySearchNumProbes,
 	}
 
 	searchRepo := func(repoID api.RepoID, repoName api.RepoName) (codeResults, textResults []embeddings.EmbeddingSearchResult, err error) {
@@ -398,6 +398,32 @@ func (c *Client) UpdateExecutionLogEntry(ctx context.Context, job types.Job, ent
 	return c.client.DoAndDrop(ctx, req)
 }
 
+func (c *Client) AppendExecutionLogChunk(ctx context.Context, job types.Job, entryID int, entryKey, data string) (err error) {
+	queue := c.inferQueueName(job)
+
+	ctx, _, endObservation := c.operations.appendExecutionLogChunk.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("queueName", queue),
+		attribute.Int("jobID", job.ID),
+		attribute.Int("entryID", entryID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	req, err := c.client.NewJSONJobRequest(job.ID, http.MethodPost, fmt.Sprintf("%s/appendExecutionLogChunk", queue), job.Token, types.AppendExecutionLogChunkRequest{
+		JobOperationRequest: types.JobOperationRequest{
+			ExecutorName: c.options.ExecutorName,
+			JobID:        job.ID,
+		},
+		EntryID:  entryID,
+		EntryKey: entryKey,
+		Data:     data,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.client.DoAndDrop(ctx, req)
+}
+
 // inferQueueName returns the queue name if it is specified on the job, which is the case
 // when an executor is configured to listen to multiple queues. If the queue name is empty,
 // return the specific queue that is configured.
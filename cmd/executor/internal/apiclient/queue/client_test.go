@@ -727,6 +727,32 @@ func TestUpdateExecutionLogEntryBadResponse(t *testing.T) {
 	})
 }
 
+func TestAppendExecutionLogChunk(t *testing.T) {
+	spec := routeSpec{
+		expectedMethod:       "POST",
+		expectedPath:         "/.executors/queue/test_queue/appendExecutionLogChunk",
+		expectedUsername:     "test",
+		expectedToken:        "job-token",
+		expectedJobID:        "42",
+		expectedExecutorName: "deadbeef",
+		expectedPayload: `{
+			"executorName": "deadbeef",
+			"jobId": 42,
+			"entryId": 99,
+			"entryKey": "foo",
+			"data": "<log payload>\n"
+		}`,
+		responseStatus:  http.StatusNoContent,
+		responsePayload: ``,
+	}
+
+	testRoute(t, spec, func(client *queue.Client) {
+		if err := client.AppendExecutionLogChunk(context.Background(), types.Job{ID: 42, Token: "job-token"}, 99, "foo", "<log payload>\n"); err != nil {
+			t.Fatalf("unexpected error appending log chunk: %s", err)
+		}
+	})
+}
+
 type routeSpec struct {
 	expectedMethod       string
 	expectedPath         string
@@ -40,6 +40,7 @@ type Config struct {
 	KeepWorkspaces                                 bool
 	DockerHostMountPath                            string
 	UseFirecracker                                 bool
+	ContainerRuntime                               string
 	JobNumCPUs                                     int
 	JobMemory                                      string
 	FirecrackerDiskSpace                           string
@@ -125,6 +126,7 @@ func (c *Config) Load() {
 	c.QueuePollInterval = c.GetInterval("EXECUTOR_QUEUE_POLL_INTERVAL", "1s", "Interval between dequeue requests.")
 	c.MaximumNumJobs = c.GetInt("EXECUTOR_MAXIMUM_NUM_JOBS", "1", "Number of virtual machines or containers that can be running at once.")
 	c.UseFirecracker = c.GetBool("EXECUTOR_USE_FIRECRACKER", strconv.FormatBool(runtime.GOOS == "linux" && !IsKubernetes()), "Whether to isolate commands in virtual machines. Requires ignite and firecracker. Linux hosts only. Kubernetes is not supported.")
+	c.ContainerRuntime = c.Get("EXECUTOR_CONTAINER_RUNTIME", ContainerRuntimeDocker, "The runtime used to run containers when not using Firecracker. One of docker, podman (rootless Podman) or gvisor (rootless Podman with the gVisor runsc runtime).")
 	c.FirecrackerImage = c.Get("EXECUTOR_FIRECRACKER_IMAGE", DefaultFirecrackerImage, "The base image to use for virtual machines.")
 	c.FirecrackerKernelImage = c.Get("EXECUTOR_FIRECRACKER_KERNEL_IMAGE", DefaultFirecrackerKernelImage, "The base image containing the kernel binary to use for virtual machines.")
 	c.FirecrackerSandboxImage = c.Get("EXECUTOR_FIRECRACKER_SANDBOX_IMAGE", DefaultFirecrackerSandboxImage, "The OCI image for the ignite VM sandbox.")
@@ -317,6 +319,23 @@ func (c *Config) Validate() error {
 		c.AddError(errors.Wrap(c.kubernetesNodeTolerationsUnmarshalError, "invalid EXECUTOR_KUBERNETES_NODE_TOLERATIONS, failed to parse"))
 	}
 
+	switch c.ContainerRuntime {
+	case ContainerRuntimeDocker:
+	case ContainerRuntimePodman, ContainerRuntimeGVisor:
+		if c.UseFirecracker {
+			c.AddError(errors.Newf("EXECUTOR_CONTAINER_RUNTIME %q cannot be used together with EXECUTOR_USE_FIRECRACKER, set EXECUTOR_USE_FIRECRACKER=false", c.ContainerRuntime))
+		}
+		if IsKubernetes() {
+			c.AddError(errors.Newf("EXECUTOR_CONTAINER_RUNTIME %q is not supported on Kubernetes", c.ContainerRuntime))
+		}
+		// gVisor only runs on Linux.
+		if c.ContainerRuntime == ContainerRuntimeGVisor && runtime.GOOS != "linux" {
+			c.AddError(errors.New("EXECUTOR_CONTAINER_RUNTIME gvisor is only supported on linux hosts."))
+		}
+	default:
+		c.AddError(errors.Newf("invalid EXECUTOR_CONTAINER_RUNTIME %q, valid values are '%s', '%s' and '%s'", c.ContainerRuntime, ContainerRuntimeDocker, ContainerRuntimePodman, ContainerRuntimeGVisor))
+	}
+
 	if c.UseFirecracker {
 		// Validate that firecracker can work on this host.
 		if runtime.GOOS != "linux" {
@@ -87,6 +87,7 @@ func TestConfig_Load(t *testing.T) {
 	assert.Equal(t, 10*time.Second, cfg.QueuePollInterval)
 	assert.Equal(t, 10, cfg.MaximumNumJobs)
 	assert.True(t, cfg.UseFirecracker)
+	assert.Equal(t, "EXECUTOR_CONTAINER_RUNTIME", cfg.ContainerRuntime)
 	assert.Equal(t, "EXECUTOR_FIRECRACKER_IMAGE", cfg.FirecrackerImage)
 	assert.Equal(t, "EXECUTOR_FIRECRACKER_KERNEL_IMAGE", cfg.FirecrackerKernelImage)
 	assert.Equal(t, "EXECUTOR_FIRECRACKER_SANDBOX_IMAGE", cfg.FirecrackerSandboxImage)
@@ -196,6 +197,7 @@ func TestConfig_Load_Defaults(t *testing.T) {
 	assert.Empty(t, cfg.QueueNamesStr)
 	assert.Equal(t, time.Second, cfg.QueuePollInterval)
 	assert.Equal(t, 1, cfg.MaximumNumJobs)
+	assert.Equal(t, "docker", cfg.ContainerRuntime)
 	assert.Equal(t, "sourcegraph/executor-vm:insiders", cfg.FirecrackerImage)
 	assert.Equal(t, "sourcegraph/ignite-kernel:5.10.135-amd64", cfg.FirecrackerKernelImage)
 	assert.Equal(t, "sourcegraph/ignite:v0.10.5", cfg.FirecrackerSandboxImage)
@@ -348,6 +350,65 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			expectedErr: errors.New("EXECUTOR_QUEUE_NAMES contains invalid queue name 'batches;codeintel', valid names are 'batches, codeintel' and should be comma-separated"),
 		},
+		{
+			name: "Podman container runtime",
+			getterFunc: func(name string, defaultValue, description string) string {
+				switch name {
+				case "EXECUTOR_QUEUE_NAME":
+					return "batches"
+				case "EXECUTOR_FRONTEND_URL":
+					return "http://some-url.com"
+				case "EXECUTOR_FRONTEND_PASSWORD":
+					return "some-password"
+				case "EXECUTOR_USE_FIRECRACKER":
+					return "false"
+				case "EXECUTOR_CONTAINER_RUNTIME":
+					return "podman"
+				default:
+					return defaultValue
+				}
+			},
+		},
+		{
+			name: "Invalid EXECUTOR_CONTAINER_RUNTIME",
+			getterFunc: func(name string, defaultValue, description string) string {
+				switch name {
+				case "EXECUTOR_QUEUE_NAME":
+					return "batches"
+				case "EXECUTOR_FRONTEND_URL":
+					return "http://some-url.com"
+				case "EXECUTOR_FRONTEND_PASSWORD":
+					return "some-password"
+				case "EXECUTOR_USE_FIRECRACKER":
+					return "false"
+				case "EXECUTOR_CONTAINER_RUNTIME":
+					return "containerd"
+				default:
+					return defaultValue
+				}
+			},
+			expectedErr: errors.New("invalid EXECUTOR_CONTAINER_RUNTIME \"containerd\", valid values are 'docker', 'podman' and 'gvisor'"),
+		},
+		{
+			name: "EXECUTOR_CONTAINER_RUNTIME with firecracker",
+			getterFunc: func(name string, defaultValue, description string) string {
+				switch name {
+				case "EXECUTOR_QUEUE_NAME":
+					return "batches"
+				case "EXECUTOR_FRONTEND_URL":
+					return "http://some-url.com"
+				case "EXECUTOR_FRONTEND_PASSWORD":
+					return "some-password"
+				case "EXECUTOR_USE_FIRECRACKER":
+					return "true"
+				case "EXECUTOR_CONTAINER_RUNTIME":
+					return "podman"
+				default:
+					return defaultValue
+				}
+			},
+			expectedErr: errors.New("EXECUTOR_CONTAINER_RUNTIME \"podman\" cannot be used together with EXECUTOR_USE_FIRECRACKER, set EXECUTOR_USE_FIRECRACKER=false"),
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -34,6 +34,16 @@ const (
 	// i8042.X: Makes boot faster, doesn't poll on the i8042 device on boot. See
 	// https://github.com/firecracker-microvm/firecracker/blob/main/docs/api_requests/actions.md#intel-and-amd-only-sendctrlaltdel.
 	FirecrackerKernelArgs = "console=ttyS0 reboot=k panic=1 pci=off ip=dhcp random.trust_cpu=on i8042.noaux i8042.nomux i8042.nopnp i8042.dumbkbd"
+
+	// ContainerRuntimeDocker runs containers with the Docker daemon of the host.
+	ContainerRuntimeDocker = "docker"
+	// ContainerRuntimePodman runs containers with rootless Podman.
+	ContainerRuntimePodman = "podman"
+	// ContainerRuntimeGVisor runs containers with rootless Podman, sandboxed by
+	// the gVisor runsc OCI runtime.
+	ContainerRuntimeGVisor = "gvisor"
+	// GVisorOCIRuntime is the name of the gVisor OCI runtime binary.
+	GVisorOCIRuntime = "runsc"
 )
 
 var (
@@ -71,6 +81,20 @@ var (
 		"git":    "Use your package manager, or build from source.",
 		"src":    "Run executor install src-cli, or refer to https://github.com/sourcegraph/src-cli to install src-cli yourself.",
 	}
+	// RequiredCLIToolsPodman contains all the programs that are expected to exist
+	// in PATH when running the executor with the podman container runtime and a
+	// help text on installation.
+	RequiredCLIToolsPodman = map[string]string{
+		"git":    "Use your package manager, or build from source.",
+		"podman": "Check out https://podman.io/docs/installation on how to install.",
+		"src":    "Run executor install src-cli, or refer to https://github.com/sourcegraph/src-cli to install src-cli yourself.",
+	}
+	// RequiredCLIToolsGVisor contains the programs that are expected to exist in
+	// PATH in addition to RequiredCLIToolsPodman when running the executor with
+	// the gvisor container runtime and a help text on installation.
+	RequiredCLIToolsGVisor = map[string]string{
+		GVisorOCIRuntime: "Check out https://gvisor.dev/docs/user_guide/install/ on how to install.",
+	}
 	// RequiredCLIToolsFirecracker contains all the programs that are expected to
 	// exist in PATH when running the executor with firecracker enabled.
 	RequiredCLIToolsFirecracker = []string{"dmsetup", "losetup", "mkfs.ext4", "strings"}
@@ -41,7 +41,7 @@ func StandaloneRun(ctx context.Context, runner util.CmdRunner, logger log.Logger
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
-		return newQueueTelemetryOptions(ctx, runner, cfg.UseFirecracker, logger)
+		return newQueueTelemetryOptions(ctx, runner, cfg.ContainerRuntime, cfg.UseFirecracker, logger)
 	}()
 	logger.Debug("Telemetry information gathered", log.String("info", fmt.Sprintf("%+v", queueTelemetryOptions)))
 
@@ -50,10 +50,17 @@ func StandaloneRun(ctx context.Context, runner util.CmdRunner, logger log.Logger
 	// TODO: This is too similar to the RunValidate func. Make it share even more code.
 	if runVerifyChecks {
 		// Then, validate all tools that are required are installed.
-		if err := util.ValidateRequiredTools(runner, cfg.UseFirecracker); err != nil {
+		if err := util.ValidateRequiredTools(runner, cfg.ContainerRuntime, cfg.UseFirecracker); err != nil {
 			return err
 		}
 
+		// Validate podman doesn't run containers as root.
+		if cfg.ContainerRuntime != config.ContainerRuntimeDocker {
+			if err := util.ValidatePodmanRootless(ctx, runner); err != nil {
+				return err
+			}
+		}
+
 		// Validate git is of the right version.
 		if err := util.ValidateGitVersion(ctx, runner); err != nil {
 			return err
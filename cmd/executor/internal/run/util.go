@@ -26,7 +26,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 )
 
-func newQueueTelemetryOptions(ctx context.Context, runner util.CmdRunner, useFirecracker bool, logger log.Logger) queue.TelemetryOptions {
+func newQueueTelemetryOptions(ctx context.Context, runner util.CmdRunner, containerRuntime string, useFirecracker bool, logger log.Logger) queue.TelemetryOptions {
 	t := queue.TelemetryOptions{
 		OS:              runtime.GOOS,
 		Architecture:    runtime.GOARCH,
@@ -46,9 +46,12 @@ func newQueueTelemetryOptions(ctx context.Context, runner util.CmdRunner, useFir
 			logger.Error("Failed to get src-cli version", log.Error(err))
 		}
 
-		t.DockerVersion, err = util.GetDockerVersion(ctx, runner)
-		if err != nil {
-			logger.Error("Failed to get docker version", log.Error(err))
+		// Podman based runtimes don't talk to a Docker daemon.
+		if containerRuntime == config.ContainerRuntimeDocker {
+			t.DockerVersion, err = util.GetDockerVersion(ctx, runner)
+			if err != nil {
+				logger.Error("Failed to get docker version", log.Error(err))
+			}
 		}
 	}
 
@@ -72,6 +75,7 @@ func apiWorkerOptions(c *config.Config, queueTelemetryOptions queue.TelemetryOpt
 			DockerOptions:      dockerOptions(c),
 			FirecrackerOptions: firecrackerOptions(c),
 			KubernetesOptions:  kubernetesOptions(c),
+			PodmanOptions:      podmanOptions(c),
 		},
 		GitServicePath: "/.executors/git",
 		QueueOptions:   queueOptions(c, queueTelemetryOptions),
@@ -127,6 +131,16 @@ func firecrackerOptions(c *config.Config) runner.FirecrackerOptions {
 	}
 }
 
+func podmanOptions(c *config.Config) runner.PodmanOptions {
+	opts := runner.PodmanOptions{
+		Enabled: !c.UseFirecracker && (c.ContainerRuntime == config.ContainerRuntimePodman || c.ContainerRuntime == config.ContainerRuntimeGVisor),
+	}
+	if c.ContainerRuntime == config.ContainerRuntimeGVisor {
+		opts.OCIRuntime = config.GVisorOCIRuntime
+	}
+	return opts
+}
+
 func resourceOptions(c *config.Config) command.ResourceOptions {
 	return command.ResourceOptions{
 		NumCPUs:             c.JobNumCPUs,
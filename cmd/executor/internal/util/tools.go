@@ -28,6 +28,20 @@ func GetDockerVersion(ctx context.Context, runner CmdRunner) (string, error) {
 	return execOutput(ctx, runner, "docker", "version", "-f", "{{.Server.Version}}")
 }
 
+// GetPodmanVersion returns the version of podman installed on the host.
+func GetPodmanVersion(ctx context.Context, runner CmdRunner) (string, error) {
+	return execOutput(ctx, runner, "podman", "version", "-f", "{{.Client.Version}}")
+}
+
+// GetPodmanRootless returns true if podman runs containers without root privileges.
+func GetPodmanRootless(ctx context.Context, runner CmdRunner) (bool, error) {
+	out, err := execOutput(ctx, runner, "podman", "info", "-f", "{{.Host.Security.Rootless}}")
+	if err != nil {
+		return false, err
+	}
+	return out == "true", nil
+}
+
 // GetIgniteVersion returns the version of ignite installed on the host.
 func GetIgniteVersion(ctx context.Context, runner CmdRunner) (string, error) {
 	return execOutput(ctx, runner, "ignite", "version", "-o", "short")
@@ -69,10 +69,21 @@ func ValidateSrcCLIVersion(ctx context.Context, runner CmdRunner, client *apicli
 // ErrSrcPatchBehind is the specific error if the currently installed src version is a patch behind the latest version.
 var ErrSrcPatchBehind = errors.New("installed src-cli is not the latest version")
 
-// ValidateRequiredTools validates that the tools required to run Docker and/or Firecracker are installed.
-func ValidateRequiredTools(runner CmdRunner, useFirecracker bool) error {
-	if err := ValidateDockerTools(runner); err != nil {
-		return err
+// ValidateRequiredTools validates that the tools required by the container runtime and/or Firecracker are installed.
+func ValidateRequiredTools(runner CmdRunner, containerRuntime string, useFirecracker bool) error {
+	switch containerRuntime {
+	case config.ContainerRuntimePodman:
+		if err := ValidatePodmanTools(runner, false); err != nil {
+			return err
+		}
+	case config.ContainerRuntimeGVisor:
+		if err := ValidatePodmanTools(runner, true); err != nil {
+			return err
+		}
+	default:
+		if err := ValidateDockerTools(runner); err != nil {
+			return err
+		}
 	}
 	if useFirecracker {
 		if err := ValidateFirecrackerTools(runner); err != nil {
@@ -84,11 +95,30 @@ func ValidateRequiredTools(runner CmdRunner, useFirecracker bool) error {
 
 // ValidateDockerTools validates that the tools required to run Docker are installed.
 func ValidateDockerTools(runner CmdRunner) error {
+	return validateTools(runner, config.RequiredCLITools)
+}
+
+// ValidatePodmanTools validates that the tools required to run Podman are installed.
+// If gVisor is true, the gVisor runtime is required as well.
+func ValidatePodmanTools(runner CmdRunner, gVisor bool) error {
+	tools := make(map[string]string, len(config.RequiredCLIToolsPodman)+len(config.RequiredCLIToolsGVisor))
+	for tool, help := range config.RequiredCLIToolsPodman {
+		tools[tool] = help
+	}
+	if gVisor {
+		for tool, help := range config.RequiredCLIToolsGVisor {
+			tools[tool] = help
+		}
+	}
+	return validateTools(runner, tools)
+}
+
+func validateTools(runner CmdRunner, requiredTools map[string]string) error {
 	var missingTools []string
 	// So, iterating thru a map is not deterministic, breaking unit tests, so we need to sort the keys.
-	tools := make([]string, len(config.RequiredCLITools))
+	tools := make([]string, len(requiredTools))
 	i := 0
-	for t := range config.RequiredCLITools {
+	for t := range requiredTools {
 		tools[i] = t
 		i++
 	}
@@ -107,6 +137,19 @@ func ValidateDockerTools(runner CmdRunner) error {
 	return nil
 }
 
+// ValidatePodmanRootless validates that Podman runs containers as the current,
+// unprivileged user.
+func ValidatePodmanRootless(ctx context.Context, runner CmdRunner) error {
+	rootless, err := GetPodmanRootless(ctx, runner)
+	if err != nil {
+		return errors.Wrap(err, "failed to determine if podman is rootless")
+	}
+	if !rootless {
+		return errors.New("podman is not running rootless, run the executor as an unprivileged user")
+	}
+	return nil
+}
+
 // ValidateFirecrackerTools validates that the tools required to run Firecracker are installed.
 func ValidateFirecrackerTools(runner CmdRunner) error {
 	var missingTools []string
@@ -211,13 +254,21 @@ type ErrMissingTools struct {
 func (e *ErrMissingTools) Error() string {
 	var errs error
 	for _, tool := range e.Tools {
-		helpText, ok := config.RequiredCLITools[tool]
 		// TODO: Help lines for config.RequiredCLIToolsFirecracker.
 		helpLine := ""
-		if ok {
+		if helpText, ok := toolHelpText(tool); ok {
 			helpLine = fmt.Sprintf("\n%s", helpText)
 		}
 		errs = errors.Append(errs, errors.Newf("%s not found in PATH, is it installed?%s", tool, helpLine))
 	}
 	return errs.Error()
 }
+
+func toolHelpText(tool string) (string, bool) {
+	for _, tools := range []map[string]string{config.RequiredCLITools, config.RequiredCLIToolsPodman, config.RequiredCLIToolsGVisor} {
+		if helpText, ok := tools[tool]; ok {
+			return helpText, true
+		}
+	}
+	return "", false
+}
@@ -274,6 +274,128 @@ func TestValidateDockerTools(t *testing.T) {
 	}
 }
 
+func TestValidatePodmanTools(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		gVisor      bool
+		mockFunc    func(runner *fakeCmdRunner)
+		expectedErr error
+	}{
+		{
+			name: "Podman is valid",
+			mockFunc: func(runner *fakeCmdRunner) {
+				runner.On("LookPath", "git").
+					Return("", nil)
+				runner.On("LookPath", "podman").
+					Return("", nil)
+				runner.On("LookPath", "src").
+					Return("", nil)
+			},
+		},
+		{
+			name: "Podman missing",
+			mockFunc: func(runner *fakeCmdRunner) {
+				runner.On("LookPath", "git").
+					Return("", nil)
+				runner.On("LookPath", "podman").
+					Return("", exec.ErrNotFound)
+				runner.On("LookPath", "src").
+					Return("", nil)
+			},
+			expectedErr: errors.New("podman not found in PATH, is it installed?\nCheck out https://podman.io/docs/installation on how to install."),
+		},
+		{
+			name:   "gVisor is valid",
+			gVisor: true,
+			mockFunc: func(runner *fakeCmdRunner) {
+				runner.On("LookPath", "git").
+					Return("", nil)
+				runner.On("LookPath", "podman").
+					Return("", nil)
+				runner.On("LookPath", "runsc").
+					Return("", nil)
+				runner.On("LookPath", "src").
+					Return("", nil)
+			},
+		},
+		{
+			name:   "gVisor missing",
+			gVisor: true,
+			mockFunc: func(runner *fakeCmdRunner) {
+				runner.On("LookPath", "git").
+					Return("", nil)
+				runner.On("LookPath", "podman").
+					Return("", nil)
+				runner.On("LookPath", "runsc").
+					Return("", exec.ErrNotFound)
+				runner.On("LookPath", "src").
+					Return("", nil)
+			},
+			expectedErr: errors.New("runsc not found in PATH, is it installed?\nCheck out https://gvisor.dev/docs/user_guide/install/ on how to install."),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := new(fakeCmdRunner)
+			if test.mockFunc != nil {
+				test.mockFunc(runner)
+			}
+
+			err := util.ValidatePodmanTools(runner, test.gVisor)
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, test.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePodmanRootless(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		exitStatus  int
+		stdout      string
+		expectedErr error
+	}{
+		{
+			name:   "Rootless",
+			stdout: "true",
+		},
+		{
+			name:        "Not rootless",
+			stdout:      "false",
+			expectedErr: errors.New("podman is not running rootless, run the executor as an unprivileged user"),
+		},
+		{
+			name:        "Error",
+			exitStatus:  1,
+			stdout:      "failed to get info",
+			expectedErr: errors.New("failed to determine if podman is rootless: 'podman info -f {{.Host.Security.Rootless}}': failed to get info: exit status 1"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := new(fakeCmdRunner)
+			runner.On("CombinedOutput", mock.Anything, "podman", []string{"info", "-f", "{{.Host.Security.Rootless}}"}).
+				Return(test.exitStatus, test.stdout)
+
+			err := util.ValidatePodmanRootless(context.Background(), runner)
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, test.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateFirecrackerTools(t *testing.T) {
 	t.Parallel()
 
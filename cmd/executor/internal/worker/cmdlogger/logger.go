@@ -45,6 +45,9 @@ type ExecutionLogEntryStore interface {
 	AddExecutionLogEntry(ctx context.Context, job types.Job, entry internalexecutor.ExecutionLogEntry) (int, error)
 	// UpdateExecutionLogEntry updates the log entry with the given ID.
 	UpdateExecutionLogEntry(ctx context.Context, job types.Job, entryID int, entry internalexecutor.ExecutionLogEntry) error
+	// AppendExecutionLogChunk appends a chunk of output of the log entry with the given ID,
+	// so that it can be tailed while the command is still running.
+	AppendExecutionLogChunk(ctx context.Context, job types.Job, entryID int, entryKey, data string) error
 }
 
 // NewLogger creates a new logger instance with the given store, job, record,
@@ -129,6 +132,9 @@ func (l *logger) appendError(err error) {
 
 func (l *logger) syncLogEntry(handle *entryHandle, entryID int, old internalexecutor.ExecutionLogEntry) {
 	lastWrite := false
+	// streamed is the part of the output that has already been appended as log chunks.
+	streamed := ""
+	streaming := true
 
 	for !lastWrite {
 		select {
@@ -138,6 +144,11 @@ func (l *logger) syncLogEntry(handle *entryHandle, entryID int, old internalexec
 		}
 
 		current := handle.CurrentLogEntry()
+
+		if streaming {
+			streamed, streaming = l.streamLogChunk(entryID, current, streamed, lastWrite)
+		}
+
 		if !entryWasUpdated(old, current) {
 			continue
 		}
@@ -178,6 +189,41 @@ func (l *logger) syncLogEntry(handle *entryHandle, entryID int, old internalexec
 	}
 }
 
+// streamLogChunk appends the output of the entry that has not been streamed yet as a log chunk.
+// Only complete lines are streamed until the last write, so that a sensitive value is never
+// split across chunks before it could be redacted. It returns the streamed output and whether
+// streaming should continue for this entry.
+func (l *logger) streamLogChunk(entryID int, current internalexecutor.ExecutionLogEntry, streamed string, lastWrite bool) (string, bool) {
+	if !strings.HasPrefix(current.Out, streamed) {
+		// The already streamed output changed after redaction, the chunks of this entry can
+		// no longer be appended consistently. The full output is still sent with the entry.
+		return streamed, false
+	}
+
+	end := len(current.Out)
+	if !lastWrite {
+		end = strings.LastIndexByte(current.Out, '\n') + 1
+	}
+	if end <= len(streamed) {
+		return streamed, true
+	}
+
+	if err := l.store.AppendExecutionLogChunk(context.Background(), l.job, entryID, current.Key, current.Out[len(streamed):end]); err != nil {
+		// Log chunks are only used to tail the output of running jobs, we retry on the next sync.
+		l.internalLogger.Warn(
+			"Failed to append executor log chunk for job",
+			log.Int("jobID", l.job.ID),
+			log.Int("entryID", entryID),
+			log.String("repositoryName", l.job.RepositoryName),
+			log.String("commit", l.job.Commit),
+			log.Error(err),
+		)
+		return streamed, true
+	}
+
+	return current.Out[:end], true
+}
+
 const syncLogEntryInterval = 1 * time.Second
 
 // If old didn't have exit code or duration and current does, update; we're finished.
@@ -101,3 +101,40 @@ func TestLogger_Failure(t *testing.T) {
 		t.Fatalf("incorrect invokation count on UpdateExecutionLogEntry, want=%d have=%d", 1, len(s.UpdateExecutionLogEntryFunc.History()))
 	}
 }
+
+func TestLogger_StreamLogChunk(t *testing.T) {
+	s := NewMockExecutionLogEntryStore()
+	l := &logger{internalLogger: logtest.Scoped(t), store: s}
+
+	entry := internalexecutor.ExecutionLogEntry{Key: "the_key", Out: "line 1\nline 2\npart"}
+
+	// Only complete lines are streamed while the command is running.
+	streamed, streaming := l.streamLogChunk(1, entry, "", false)
+	if !streaming || streamed != "line 1\nline 2\n" {
+		t.Fatalf("unexpected streamed output, have=%q streaming=%v", streamed, streaming)
+	}
+
+	// Nothing is appended until a new line is complete.
+	if streamed, _ = l.streamLogChunk(1, entry, streamed, false); len(s.AppendExecutionLogChunkFunc.History()) != 1 {
+		t.Fatalf("incorrect invokation count on AppendExecutionLogChunk, want=%d have=%d", 1, len(s.AppendExecutionLogChunkFunc.History()))
+	}
+
+	// The remaining output is streamed with the last write.
+	entry.Out += "ial"
+	if streamed, _ = l.streamLogChunk(1, entry, streamed, true); streamed != entry.Out {
+		t.Fatalf("unexpected streamed output, want=%q have=%q", entry.Out, streamed)
+	}
+
+	history := s.AppendExecutionLogChunkFunc.History()
+	if len(history) != 2 {
+		t.Fatalf("incorrect invokation count on AppendExecutionLogChunk, want=%d have=%d", 2, len(history))
+	}
+	if history[0].Arg3 != "the_key" || history[0].Arg4 != "line 1\nline 2\n" || history[1].Arg4 != "partial" {
+		t.Fatalf("unexpected chunks, have=%q and %q", history[0].Arg4, history[1].Arg4)
+	}
+
+	// Streaming stops when the streamed output is no longer a prefix of the redacted output.
+	if _, streaming = l.streamLogChunk(1, internalexecutor.ExecutionLogEntry{Out: "******\n"}, streamed, false); streaming {
+		t.Fatal("expected streaming to stop")
+	}
+}
@@ -45,33 +45,44 @@ type ResourceOptions struct {
 // a one-shot docker container subject to the resource limits specified in the
 // given options.
 func NewDockerSpec(workingDir string, image string, scriptPath string, spec Spec, options DockerOptions) Spec {
-	// TODO - remove this once src-cli is not required anymore for SSBC.
 	if image == "" {
-		env := spec.Env
-		if options.ConfigPath != "" {
-			env = append(env, fmt.Sprintf("DOCKER_CONFIG=%s", options.ConfigPath))
-		}
-		return Spec{
-			Key:       spec.Key,
-			Command:   spec.Command,
-			Dir:       filepath.Join(workingDir, spec.Dir),
-			Env:       env,
-			Operation: spec.Operation,
-		}
+		return newHostSpec(workingDir, spec, options)
 	}
 
-	hostDir := workingDir
-	if options.Resources.DockerHostMountPath != "" {
-		hostDir = filepath.Join(options.Resources.DockerHostMountPath, filepath.Base(workingDir))
+	hostDir := dockerHostDir(workingDir, options)
+	return Spec{
+		Key:       spec.Key,
+		Command:   formatDockerCommand(hostDir, image, scriptPath, spec, options),
+		Operation: spec.Operation,
 	}
+}
 
+// newHostSpec constructs the command to run the given spec directly on the host,
+// for specs that do not specify an image.
+// TODO - remove this once src-cli is not required anymore for SSBC.
+func newHostSpec(workingDir string, spec Spec, options DockerOptions) Spec {
+	env := spec.Env
+	if options.ConfigPath != "" {
+		env = append(env, fmt.Sprintf("DOCKER_CONFIG=%s", options.ConfigPath))
+	}
 	return Spec{
 		Key:       spec.Key,
-		Command:   formatDockerCommand(hostDir, image, scriptPath, spec, options),
+		Command:   spec.Command,
+		Dir:       filepath.Join(workingDir, spec.Dir),
+		Env:       env,
 		Operation: spec.Operation,
 	}
 }
 
+// dockerHostDir returns the path of the working directory on the host, which is
+// mounted into the container.
+func dockerHostDir(workingDir string, options DockerOptions) string {
+	if options.Resources.DockerHostMountPath != "" {
+		return filepath.Join(options.Resources.DockerHostMountPath, filepath.Base(workingDir))
+	}
+	return workingDir
+}
+
 func formatDockerCommand(hostDir string, image string, scriptPath string, spec Spec, options DockerOptions) []string {
 	return Flatten(
 		"docker",
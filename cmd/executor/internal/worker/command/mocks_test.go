@@ -8,11 +8,10 @@ package command
 
 import (
 	"context"
-	"sync"
-
 	cmdlogger "github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
 	executor "github.com/sourcegraph/sourcegraph/internal/executor"
 	types "github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"sync"
 )
 
 // MockCommand is a mock implementation of the Command interface (from the
@@ -174,6 +173,9 @@ type MockExecutionLogEntryStore struct {
 	// AddExecutionLogEntryFunc is an instance of a mock function object
 	// controlling the behavior of the method AddExecutionLogEntry.
 	AddExecutionLogEntryFunc *ExecutionLogEntryStoreAddExecutionLogEntryFunc
+	// AppendExecutionLogChunkFunc is an instance of a mock function object
+	// controlling the behavior of the method AppendExecutionLogChunk.
+	AppendExecutionLogChunkFunc *ExecutionLogEntryStoreAppendExecutionLogChunkFunc
 	// UpdateExecutionLogEntryFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateExecutionLogEntry.
 	UpdateExecutionLogEntryFunc *ExecutionLogEntryStoreUpdateExecutionLogEntryFunc
@@ -189,6 +191,11 @@ func NewMockExecutionLogEntryStore() *MockExecutionLogEntryStore {
 				return
 			},
 		},
+		AppendExecutionLogChunkFunc: &ExecutionLogEntryStoreAppendExecutionLogChunkFunc{
+			defaultHook: func(context.Context, types.Job, int, string, string) (r0 error) {
+				return
+			},
+		},
 		UpdateExecutionLogEntryFunc: &ExecutionLogEntryStoreUpdateExecutionLogEntryFunc{
 			defaultHook: func(context.Context, types.Job, int, executor.ExecutionLogEntry) (r0 error) {
 				return
@@ -207,6 +214,11 @@ func NewStrictMockExecutionLogEntryStore() *MockExecutionLogEntryStore {
 				panic("unexpected invocation of MockExecutionLogEntryStore.AddExecutionLogEntry")
 			},
 		},
+		AppendExecutionLogChunkFunc: &ExecutionLogEntryStoreAppendExecutionLogChunkFunc{
+			defaultHook: func(context.Context, types.Job, int, string, string) error {
+				panic("unexpected invocation of MockExecutionLogEntryStore.AppendExecutionLogChunk")
+			},
+		},
 		UpdateExecutionLogEntryFunc: &ExecutionLogEntryStoreUpdateExecutionLogEntryFunc{
 			defaultHook: func(context.Context, types.Job, int, executor.ExecutionLogEntry) error {
 				panic("unexpected invocation of MockExecutionLogEntryStore.UpdateExecutionLogEntry")
@@ -223,6 +235,9 @@ func NewMockExecutionLogEntryStoreFrom(i cmdlogger.ExecutionLogEntryStore) *Mock
 		AddExecutionLogEntryFunc: &ExecutionLogEntryStoreAddExecutionLogEntryFunc{
 			defaultHook: i.AddExecutionLogEntry,
 		},
+		AppendExecutionLogChunkFunc: &ExecutionLogEntryStoreAppendExecutionLogChunkFunc{
+			defaultHook: i.AppendExecutionLogChunk,
+		},
 		UpdateExecutionLogEntryFunc: &ExecutionLogEntryStoreUpdateExecutionLogEntryFunc{
 			defaultHook: i.UpdateExecutionLogEntry,
 		},
@@ -344,6 +359,124 @@ func (c ExecutionLogEntryStoreAddExecutionLogEntryFuncCall) Results() []interfac
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// ExecutionLogEntryStoreAppendExecutionLogChunkFunc describes the behavior
+// when the AppendExecutionLogChunk method of the parent
+// MockExecutionLogEntryStore instance is invoked.
+type ExecutionLogEntryStoreAppendExecutionLogChunkFunc struct {
+	defaultHook func(context.Context, types.Job, int, string, string) error
+	hooks       []func(context.Context, types.Job, int, string, string) error
+	history     []ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall
+	mutex       sync.Mutex
+}
+
+// AppendExecutionLogChunk delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockExecutionLogEntryStore) AppendExecutionLogChunk(v0 context.Context, v1 types.Job, v2 int, v3 string, v4 string) error {
+	r0 := m.AppendExecutionLogChunkFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.AppendExecutionLogChunkFunc.appendCall(ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// AppendExecutionLogChunk method of the parent MockExecutionLogEntryStore
+// instance is invoked and the hook queue is empty.
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) SetDefaultHook(hook func(context.Context, types.Job, int, string, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AppendExecutionLogChunk method of the parent MockExecutionLogEntryStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) PushHook(hook func(context.Context, types.Job, int, string, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, types.Job, int, string, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, types.Job, int, string, string) error {
+		return r0
+	})
+}
+
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) nextHook() func(context.Context, types.Job, int, string, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) appendCall(r0 ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall objects describing
+// the invocations of this function.
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) History() []ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall {
+	f.mutex.Lock()
+	history := make([]ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall is an object that
+// describes an invocation of method AppendExecutionLogChunk on an instance
+// of MockExecutionLogEntryStore.
+type ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 types.Job
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // ExecutionLogEntryStoreUpdateExecutionLogEntryFunc describes the behavior
 // when the UpdateExecutionLogEntry method of the parent
 // MockExecutionLogEntryStore instance is invoked.
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"path/filepath"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/files"
+)
+
+// PodmanOptions are the options that are specific to running a container with Podman.
+type PodmanOptions struct {
+	// OCIRuntime is the OCI runtime Podman runs containers with, e.g. runsc to
+	// sandbox them with gVisor. The default runtime of Podman is used if empty.
+	OCIRuntime string
+}
+
+// NewPodmanSpec constructs the command to run on the host in order to invoke the
+// given spec. It behaves like NewDockerSpec, except that containers are run by
+// Podman, which doesn't require a daemon and runs containers as the executor user.
+func NewPodmanSpec(workingDir string, image string, scriptPath string, spec Spec, options DockerOptions, podmanOptions PodmanOptions) Spec {
+	if image == "" {
+		return newHostSpec(workingDir, spec, options)
+	}
+
+	hostDir := dockerHostDir(workingDir, options)
+
+	return Spec{
+		Key:       spec.Key,
+		Command:   formatPodmanCommand(hostDir, image, scriptPath, spec, options, podmanOptions),
+		Operation: spec.Operation,
+	}
+}
+
+func formatPodmanCommand(hostDir string, image string, scriptPath string, spec Spec, options DockerOptions, podmanOptions PodmanOptions) []string {
+	return Flatten(
+		"podman",
+		podmanRuntimeFlag(podmanOptions.OCIRuntime),
+		"run",
+		"--rm",
+		podmanAuthFileFlag(options.ConfigPath),
+		dockerHostGatewayFlag(options.AddHostGateway),
+		dockerResourceFlags(options.Resources),
+		dockerVolumeFlags(hostDir),
+		dockerWorkingDirectoryFlags(spec.Dir),
+		dockerEnvFlags(spec.Env),
+		dockerEntrypointFlags,
+		image,
+		filepath.Join("/data", files.ScriptsPath, scriptPath),
+	)
+}
+
+func podmanRuntimeFlag(ociRuntime string) []string {
+	if ociRuntime == "" {
+		return nil
+	}
+	return []string{"--runtime", ociRuntime}
+}
+
+// podmanAuthFileFlag points Podman to the config.json in the given Docker config
+// directory. Podman reads registry credentials in the same format as Docker.
+func podmanAuthFileFlag(dockerConfigPath string) []string {
+	if dockerConfigPath == "" {
+		return nil
+	}
+	return []string{"--authfile", filepath.Join(dockerConfigPath, "config.json")}
+}
@@ -0,0 +1,152 @@
+package command_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
+)
+
+func TestNewPodmanSpec(t *testing.T) {
+	tests := []struct {
+		name          string
+		workingDir    string
+		image         string
+		scriptPath    string
+		spec          command.Spec
+		options       command.DockerOptions
+		podmanOptions command.PodmanOptions
+		expectedSpec  command.Spec
+	}{
+		{
+			name:       "Converts to podman spec",
+			workingDir: "/workingDirectory",
+			image:      "some-image",
+			scriptPath: "script/path",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"some", "command"},
+				Dir:     "/some/dir",
+				Env:     []string{"FOO=BAR"},
+			},
+			options: command.DockerOptions{
+				Resources: command.ResourceOptions{
+					NumCPUs: 4,
+					Memory:  "4G",
+				},
+			},
+			expectedSpec: command.Spec{
+				Key: "some-key",
+				Command: []string{
+					"podman",
+					"run",
+					"--rm",
+					"--cpus",
+					"4",
+					"--memory",
+					"4G",
+					"-v",
+					"/workingDirectory:/data",
+					"-w",
+					"/data/some/dir",
+					"-e",
+					"FOO=BAR",
+					"--entrypoint",
+					"/bin/sh",
+					"some-image",
+					"/data/.sourcegraph-executor/script/path",
+				},
+			},
+		},
+		{
+			name:       "gVisor",
+			workingDir: "/workingDirectory",
+			image:      "some-image",
+			scriptPath: "script/path",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"some", "command"},
+				Dir:     "/some/dir",
+			},
+			podmanOptions: command.PodmanOptions{
+				OCIRuntime: "runsc",
+			},
+			expectedSpec: command.Spec{
+				Key: "some-key",
+				Command: []string{
+					"podman",
+					"--runtime",
+					"runsc",
+					"run",
+					"--rm",
+					"-v",
+					"/workingDirectory:/data",
+					"-w",
+					"/data/some/dir",
+					"--entrypoint",
+					"/bin/sh",
+					"some-image",
+					"/data/.sourcegraph-executor/script/path",
+				},
+			},
+		},
+		{
+			name:       "Config Path",
+			workingDir: "/workingDirectory",
+			image:      "some-image",
+			scriptPath: "script/path",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"some", "command"},
+				Dir:     "/some/dir",
+			},
+			options: command.DockerOptions{
+				ConfigPath: "/docker/config/path",
+			},
+			expectedSpec: command.Spec{
+				Key: "some-key",
+				Command: []string{
+					"podman",
+					"run",
+					"--rm",
+					"--authfile",
+					"/docker/config/path/config.json",
+					"-v",
+					"/workingDirectory:/data",
+					"-w",
+					"/data/some/dir",
+					"--entrypoint",
+					"/bin/sh",
+					"some-image",
+					"/data/.sourcegraph-executor/script/path",
+				},
+			},
+		},
+		{
+			name:       "src-cli Spec",
+			workingDir: "/workingDirectory",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"src", "exec", "-f", "batch.yml"},
+				Dir:     "/some/dir",
+				Env:     []string{"FOO=BAR"},
+			},
+			podmanOptions: command.PodmanOptions{
+				OCIRuntime: "runsc",
+			},
+			expectedSpec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"src", "exec", "-f", "batch.yml"},
+				Dir:     "/workingDirectory/some/dir",
+				Env:     []string{"FOO=BAR"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actualSpec := command.NewPodmanSpec(test.workingDir, test.image, test.scriptPath, test.spec, test.options, test.podmanOptions)
+			assert.Equal(t, test.expectedSpec, actualSpec)
+		})
+	}
+}
@@ -25,6 +25,9 @@ type MockStore struct {
 	// GetFunc is an instance of a mock function object controlling the
 	// behavior of the method Get.
 	GetFunc *StoreGetFunc
+	// UploadFunc is an instance of a mock function object controlling the
+	// behavior of the method Upload.
+	UploadFunc *StoreUploadFunc
 }
 
 // NewMockStore creates a new mock of the Store interface. All methods
@@ -41,6 +44,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		UploadFunc: &StoreUploadFunc{
+			defaultHook: func(context.Context, types.Job, string, string, io.Reader) (r0 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -58,6 +66,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.Get")
 			},
 		},
+		UploadFunc: &StoreUploadFunc{
+			defaultHook: func(context.Context, types.Job, string, string, io.Reader) error {
+				panic("unexpected invocation of MockStore.Upload")
+			},
+		},
 	}
 }
 
@@ -71,6 +84,9 @@ func NewMockStoreFrom(i Store) *MockStore {
 		GetFunc: &StoreGetFunc{
 			defaultHook: i.Get,
 		},
+		UploadFunc: &StoreUploadFunc{
+			defaultHook: i.Upload,
+		},
 	}
 }
 
@@ -299,3 +315,116 @@ func (c StoreGetFuncCall) Args() []interface{} {
 func (c StoreGetFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
+
+// StoreUploadFunc describes the behavior when the Upload method of the
+// parent MockStore instance is invoked.
+type StoreUploadFunc struct {
+	defaultHook func(context.Context, types.Job, string, string, io.Reader) error
+	hooks       []func(context.Context, types.Job, string, string, io.Reader) error
+	history     []StoreUploadFuncCall
+	mutex       sync.Mutex
+}
+
+// Upload delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockStore) Upload(v0 context.Context, v1 types.Job, v2 string, v3 string, v4 io.Reader) error {
+	r0 := m.UploadFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.UploadFunc.appendCall(StoreUploadFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Upload method of the
+// parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreUploadFunc) SetDefaultHook(hook func(context.Context, types.Job, string, string, io.Reader) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Upload method of the parent MockStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *StoreUploadFunc) PushHook(hook func(context.Context, types.Job, string, string, io.Reader) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreUploadFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, types.Job, string, string, io.Reader) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreUploadFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, types.Job, string, string, io.Reader) error {
+		return r0
+	})
+}
+
+func (f *StoreUploadFunc) nextHook() func(context.Context, types.Job, string, string, io.Reader) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreUploadFunc) appendCall(r0 StoreUploadFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreUploadFuncCall objects describing the
+// invocations of this function.
+func (f *StoreUploadFunc) History() []StoreUploadFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreUploadFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreUploadFuncCall is an object that describes an invocation of method
+// Upload on an instance of MockStore.
+type StoreUploadFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 types.Job
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 io.Reader
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreUploadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreUploadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
@@ -117,6 +117,19 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 	}
 	defer ws.Remove(ctx, h.options.RunnerOptions.FirecrackerOptions.KeepWorkspaces)
 
+	job.Queue = h.options.QueueName
+
+	// Caches are restored before the VM is set up and saved after it is torn down, as
+	// Firecracker workspaces are mounted on the host to access them.
+	logger.Info("Restoring caches")
+	workspace.RestoreWorkspaceCaches(ctx, h.filesStore, job, ws, commandLogger)
+	defer func() {
+		if err == nil {
+			logger.Info("Saving caches")
+			workspace.SaveWorkspaceCaches(ctx, h.filesStore, job, ws, commandLogger)
+		}
+	}()
+
 	// Before we setup a VM (and after we teardown), mark the name as in-use so that
 	// the janitor process cleaning up orphaned VMs doesn't try to stop/remove the one
 	// we're using for the current job.
@@ -146,7 +159,6 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 
 	// Get the commands we will execute.
 	logger.Info("Creating commands")
-	job.Queue = h.options.QueueName
 	commands, err := h.jobRuntime.NewRunnerSpecs(ws, job)
 	if err != nil {
 		return errors.Wrap(err, "creating commands")
@@ -183,6 +183,9 @@ type MockExecutionLogEntryStore struct {
 	// AddExecutionLogEntryFunc is an instance of a mock function object
 	// controlling the behavior of the method AddExecutionLogEntry.
 	AddExecutionLogEntryFunc *ExecutionLogEntryStoreAddExecutionLogEntryFunc
+	// AppendExecutionLogChunkFunc is an instance of a mock function object
+	// controlling the behavior of the method AppendExecutionLogChunk.
+	AppendExecutionLogChunkFunc *ExecutionLogEntryStoreAppendExecutionLogChunkFunc
 	// UpdateExecutionLogEntryFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateExecutionLogEntry.
 	UpdateExecutionLogEntryFunc *ExecutionLogEntryStoreUpdateExecutionLogEntryFunc
@@ -198,6 +201,11 @@ func NewMockExecutionLogEntryStore() *MockExecutionLogEntryStore {
 				return
 			},
 		},
+		AppendExecutionLogChunkFunc: &ExecutionLogEntryStoreAppendExecutionLogChunkFunc{
+			defaultHook: func(context.Context, types.Job, int, string, string) (r0 error) {
+				return
+			},
+		},
 		UpdateExecutionLogEntryFunc: &ExecutionLogEntryStoreUpdateExecutionLogEntryFunc{
 			defaultHook: func(context.Context, types.Job, int, executor.ExecutionLogEntry) (r0 error) {
 				return
@@ -216,6 +224,11 @@ func NewStrictMockExecutionLogEntryStore() *MockExecutionLogEntryStore {
 				panic("unexpected invocation of MockExecutionLogEntryStore.AddExecutionLogEntry")
 			},
 		},
+		AppendExecutionLogChunkFunc: &ExecutionLogEntryStoreAppendExecutionLogChunkFunc{
+			defaultHook: func(context.Context, types.Job, int, string, string) error {
+				panic("unexpected invocation of MockExecutionLogEntryStore.AppendExecutionLogChunk")
+			},
+		},
 		UpdateExecutionLogEntryFunc: &ExecutionLogEntryStoreUpdateExecutionLogEntryFunc{
 			defaultHook: func(context.Context, types.Job, int, executor.ExecutionLogEntry) error {
 				panic("unexpected invocation of MockExecutionLogEntryStore.UpdateExecutionLogEntry")
@@ -232,6 +245,9 @@ func NewMockExecutionLogEntryStoreFrom(i cmdlogger.ExecutionLogEntryStore) *Mock
 		AddExecutionLogEntryFunc: &ExecutionLogEntryStoreAddExecutionLogEntryFunc{
 			defaultHook: i.AddExecutionLogEntry,
 		},
+		AppendExecutionLogChunkFunc: &ExecutionLogEntryStoreAppendExecutionLogChunkFunc{
+			defaultHook: i.AppendExecutionLogChunk,
+		},
 		UpdateExecutionLogEntryFunc: &ExecutionLogEntryStoreUpdateExecutionLogEntryFunc{
 			defaultHook: i.UpdateExecutionLogEntry,
 		},
@@ -353,6 +369,124 @@ func (c ExecutionLogEntryStoreAddExecutionLogEntryFuncCall) Results() []interfac
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// ExecutionLogEntryStoreAppendExecutionLogChunkFunc describes the behavior
+// when the AppendExecutionLogChunk method of the parent
+// MockExecutionLogEntryStore instance is invoked.
+type ExecutionLogEntryStoreAppendExecutionLogChunkFunc struct {
+	defaultHook func(context.Context, types.Job, int, string, string) error
+	hooks       []func(context.Context, types.Job, int, string, string) error
+	history     []ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall
+	mutex       sync.Mutex
+}
+
+// AppendExecutionLogChunk delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockExecutionLogEntryStore) AppendExecutionLogChunk(v0 context.Context, v1 types.Job, v2 int, v3 string, v4 string) error {
+	r0 := m.AppendExecutionLogChunkFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.AppendExecutionLogChunkFunc.appendCall(ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// AppendExecutionLogChunk method of the parent MockExecutionLogEntryStore
+// instance is invoked and the hook queue is empty.
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) SetDefaultHook(hook func(context.Context, types.Job, int, string, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AppendExecutionLogChunk method of the parent MockExecutionLogEntryStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) PushHook(hook func(context.Context, types.Job, int, string, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, types.Job, int, string, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, types.Job, int, string, string) error {
+		return r0
+	})
+}
+
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) nextHook() func(context.Context, types.Job, int, string, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) appendCall(r0 ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall objects describing
+// the invocations of this function.
+func (f *ExecutionLogEntryStoreAppendExecutionLogChunkFunc) History() []ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall {
+	f.mutex.Lock()
+	history := make([]ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall is an object that
+// describes an invocation of method AppendExecutionLogChunk on an instance
+// of MockExecutionLogEntryStore.
+type ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 types.Job
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ExecutionLogEntryStoreAppendExecutionLogChunkFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // ExecutionLogEntryStoreUpdateExecutionLogEntryFunc describes the behavior
 // when the UpdateExecutionLogEntry method of the parent
 // MockExecutionLogEntryStore instance is invoked.
@@ -1765,6 +1899,9 @@ type MockStore struct {
 	// GetFunc is an instance of a mock function object controlling the
 	// behavior of the method Get.
 	GetFunc *StoreGetFunc
+	// UploadFunc is an instance of a mock function object controlling the
+	// behavior of the method Upload.
+	UploadFunc *StoreUploadFunc
 }
 
 // NewMockStore creates a new mock of the Store interface. All methods
@@ -1781,6 +1918,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		UploadFunc: &StoreUploadFunc{
+			defaultHook: func(context.Context, types.Job, string, string, io.Reader) (r0 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -1798,6 +1940,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.Get")
 			},
 		},
+		UploadFunc: &StoreUploadFunc{
+			defaultHook: func(context.Context, types.Job, string, string, io.Reader) error {
+				panic("unexpected invocation of MockStore.Upload")
+			},
+		},
 	}
 }
 
@@ -1811,6 +1958,9 @@ func NewMockStoreFrom(i files.Store) *MockStore {
 		GetFunc: &StoreGetFunc{
 			defaultHook: i.Get,
 		},
+		UploadFunc: &StoreUploadFunc{
+			defaultHook: i.Upload,
+		},
 	}
 }
 
@@ -2040,6 +2190,119 @@ func (c StoreGetFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreUploadFunc describes the behavior when the Upload method of the
+// parent MockStore instance is invoked.
+type StoreUploadFunc struct {
+	defaultHook func(context.Context, types.Job, string, string, io.Reader) error
+	hooks       []func(context.Context, types.Job, string, string, io.Reader) error
+	history     []StoreUploadFuncCall
+	mutex       sync.Mutex
+}
+
+// Upload delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockStore) Upload(v0 context.Context, v1 types.Job, v2 string, v3 string, v4 io.Reader) error {
+	r0 := m.UploadFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.UploadFunc.appendCall(StoreUploadFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Upload method of the
+// parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreUploadFunc) SetDefaultHook(hook func(context.Context, types.Job, string, string, io.Reader) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Upload method of the parent MockStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *StoreUploadFunc) PushHook(hook func(context.Context, types.Job, string, string, io.Reader) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreUploadFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, types.Job, string, string, io.Reader) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreUploadFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, types.Job, string, string, io.Reader) error {
+		return r0
+	})
+}
+
+func (f *StoreUploadFunc) nextHook() func(context.Context, types.Job, string, string, io.Reader) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreUploadFunc) appendCall(r0 StoreUploadFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreUploadFuncCall objects describing the
+// invocations of this function.
+func (f *StoreUploadFunc) History() []StoreUploadFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreUploadFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreUploadFuncCall is an object that describes an invocation of method
+// Upload on an instance of MockStore.
+type StoreUploadFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 types.Job
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 io.Reader
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreUploadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreUploadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockRuntime is a mock implementation of the Runtime interface (from the
 // package
 // github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runtime)
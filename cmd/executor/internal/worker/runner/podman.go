@@ -0,0 +1,58 @@
+package runner
+
+import (
+	"context"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+)
+
+// podmanRunner runs commands in containers managed by rootless Podman. Registry
+// credentials are prepared the same way as for the docker runner.
+type podmanRunner struct {
+	*dockerRunner
+	podmanOptions PodmanOptions
+}
+
+type PodmanOptions struct {
+	// Enabled determines if commands will be run in containers managed by Podman
+	// instead of Docker.
+	Enabled bool
+	// OCIRuntime is the OCI runtime Podman uses to run containers, e.g. runsc
+	// for gVisor. The default runtime of Podman is used if empty.
+	OCIRuntime string
+}
+
+var _ Runner = &podmanRunner{}
+
+func NewPodmanRunner(
+	cmd command.Command,
+	logger cmdlogger.Logger,
+	dir string,
+	options command.DockerOptions,
+	podmanOptions PodmanOptions,
+	dockerAuthConfig types.DockerAuthConfig,
+) Runner {
+	r := NewDockerRunner(cmd, logger, dir, options, dockerAuthConfig).(*dockerRunner)
+	r.internalLogger = log.Scoped("podman-runner")
+
+	return &podmanRunner{
+		dockerRunner:  r,
+		podmanOptions: podmanOptions,
+	}
+}
+
+func (r *podmanRunner) Run(ctx context.Context, spec Spec) error {
+	podmanSpec := command.NewPodmanSpec(
+		r.dir,
+		spec.Image,
+		spec.ScriptPath,
+		spec.CommandSpecs[0],
+		r.options,
+		command.PodmanOptions{OCIRuntime: r.podmanOptions.OCIRuntime},
+	)
+	return r.cmd.Run(ctx, r.commandLogger, podmanSpec)
+}
@@ -0,0 +1,104 @@
+package runner_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+)
+
+func TestPodmanRunner_SetupTeardown(t *testing.T) {
+	options := command.DockerOptions{
+		DockerAuthConfig: types.DockerAuthConfig{
+			Auths: map[string]types.DockerAuthConfigAuth{
+				"index.docker.io": {
+					Auth: []byte("foobar"),
+				},
+			},
+		},
+	}
+	podmanRunner := runner.NewPodmanRunner(nil, nil, "", options, runner.PodmanOptions{Enabled: true}, types.DockerAuthConfig{})
+
+	ctx := context.Background()
+	err := podmanRunner.Setup(ctx)
+	require.NoError(t, err)
+
+	dir := podmanRunner.TempDir()
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	err = podmanRunner.Teardown(ctx)
+	require.NoError(t, err)
+
+	_, err = os.Stat(dir)
+	require.Error(t, err)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestPodmanRunner_Run(t *testing.T) {
+	cmd := runner.NewMockCommand()
+	logger := runner.NewMockLogger()
+	dir := "/some/dir"
+	options := command.DockerOptions{
+		ConfigPath: "/docker/config",
+		Resources: command.ResourceOptions{
+			NumCPUs:   10,
+			Memory:    "1G",
+			DiskSpace: "10G",
+		},
+	}
+	spec := runner.Spec{
+		CommandSpecs: []command.Spec{
+			{
+				Key:     "some-key",
+				Command: []string{"echo", "hello"},
+				Dir:     "/workingdir",
+				Env:     []string{"FOO=bar"},
+			},
+		},
+		Image:      "alpine",
+		ScriptPath: "/some/script",
+	}
+
+	podmanRunner := runner.NewPodmanRunner(cmd, logger, dir, options, runner.PodmanOptions{Enabled: true, OCIRuntime: "runsc"}, types.DockerAuthConfig{})
+
+	cmd.RunFunc.PushReturn(nil)
+
+	err := podmanRunner.Run(context.Background(), spec)
+
+	require.NoError(t, err)
+
+	require.Len(t, cmd.RunFunc.History(), 1)
+	assert.Equal(t, logger, cmd.RunFunc.History()[0].Arg1)
+	assert.Equal(t, "some-key", cmd.RunFunc.History()[0].Arg2.Key)
+	assert.Equal(t, []string{
+		"podman",
+		"--runtime",
+		"runsc",
+		"run",
+		"--rm",
+		"--authfile",
+		"/docker/config/config.json",
+		"--cpus",
+		"10",
+		"--memory",
+		"1G",
+		"-v",
+		"/some/dir:/data",
+		"-w",
+		"/data/workingdir",
+		"-e",
+		"FOO=bar",
+		"--entrypoint",
+		"/bin/sh",
+		"alpine",
+		"/data/.sourcegraph-executor/some/script",
+	}, cmd.RunFunc.History()[0].Arg2.Command)
+}
@@ -0,0 +1,34 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/files"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// podmanRuntime runs the steps of a job in containers managed by rootless Podman.
+// Workspaces and runner specs are the same as for the docker runtime.
+type podmanRuntime struct {
+	dockerRuntime
+	podmanOpts runner.PodmanOptions
+}
+
+var _ Runtime = &podmanRuntime{}
+
+func (r *podmanRuntime) Name() Name {
+	if r.podmanOpts.OCIRuntime != "" {
+		return NameGVisor
+	}
+	return NamePodman
+}
+
+func (r *podmanRuntime) NewRunner(ctx context.Context, logger cmdlogger.Logger, filesStore files.Store, options RunnerOptions) (runner.Runner, error) {
+	run := runner.NewPodmanRunner(r.cmd, logger, options.Path, r.dockerOpts, r.podmanOpts, options.DockerAuthConfig)
+	if err := run.Setup(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to setup podman runner")
+	}
+	return run, nil
+}
@@ -0,0 +1,52 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestPodmanRuntime_Name(t *testing.T) {
+	r := podmanRuntime{}
+	assert.Equal(t, "podman", string(r.Name()))
+
+	r = podmanRuntime{podmanOpts: runner.PodmanOptions{Enabled: true, OCIRuntime: "runsc"}}
+	assert.Equal(t, "gvisor", string(r.Name()))
+}
+
+func TestPodmanRuntime_NewRunnerSpecs(t *testing.T) {
+	operations := command.NewOperations(&observation.TestContext)
+
+	ws := NewMockWorkspace()
+	ws.ScriptFilenamesFunc.SetDefaultReturn([]string{"script.sh"})
+	job := types.Job{
+		DockerSteps: []types.DockerStep{
+			{
+				Key:      "key-1",
+				Image:    "my-image",
+				Commands: []string{"echo", "hello"},
+				Dir:      ".",
+				Env:      []string{"FOO=bar"},
+			},
+		},
+	}
+
+	r := &podmanRuntime{dockerRuntime: dockerRuntime{operations: operations}}
+	actual, err := r.NewRunnerSpecs(ws, job)
+	require.NoError(t, err)
+	require.Len(t, actual, 1)
+	assert.Equal(t, "my-image", actual[0].Image)
+	assert.Equal(t, "script.sh", actual[0].ScriptPath)
+	assert.Equal(t, command.Spec{
+		Key:       "step.docker.key-1",
+		Dir:       ".",
+		Env:       []string{"FOO=bar"},
+		Operation: operations.Exec,
+	}, actual[0].CommandSpecs[0])
+}
@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/sourcegraph/log"
 	"k8s.io/client-go/kubernetes"
@@ -118,6 +119,34 @@ func New(
 		}, nil
 	}
 
+	if runnerOpts.PodmanOptions.Enabled {
+		r := &podmanRuntime{
+			dockerRuntime: dockerRuntime{
+				operations:   ops,
+				filesStore:   filesStore,
+				cloneOptions: cloneOpts,
+				dockerOpts:   runnerOpts.DockerOptions,
+				cmd:          cmd,
+			},
+			podmanOpts: runnerOpts.PodmanOptions,
+		}
+		// We explicitly want a Podman runtime. So validation must pass.
+		if err := util.ValidatePodmanTools(runner, r.Name() == NameGVisor); err != nil {
+			var errMissingTools *util.ErrMissingTools
+			if errors.As(err, &errMissingTools) {
+				logger.Error("runtime is not supported: missing required tools", log.String("runtime", string(r.Name())), log.Strings("podmanTools", errMissingTools.Tools))
+			} else {
+				logger.Error("failed to determine if podman tools are configured", log.Error(err))
+			}
+			return nil, err
+		} else if err = util.ValidatePodmanRootless(context.Background(), runner); err != nil {
+			logger.Error("runtime is not supported: podman is not rootless", log.String("runtime", string(r.Name())), log.Error(err))
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("using runtime '%s'", r.Name()))
+		return r, nil
+	}
+
 	// Default to Docker runtime.
 	if err := util.ValidateDockerTools(runner); err != nil {
 		var errMissingTools *util.ErrMissingTools
@@ -148,7 +177,9 @@ type Name string
 const (
 	NameDocker      Name = "docker"
 	NameFirecracker Name = "firecracker"
+	NameGVisor      Name = "gvisor"
 	NameKubernetes  Name = "kubernetes"
+	NamePodman      Name = "podman"
 	NameShell       Name = "shell"
 )
 
@@ -158,7 +189,7 @@ func CommandKey(name Name, rawStepKey string, index int) string {
 	case NameKubernetes:
 		return kubernetesKey(rawStepKey, index)
 	default:
-		// shell, docker, and firecracker all use the same key format.
+		// shell, docker, podman, gvisor, and firecracker all use the same key format.
 		return dockerKey(rawStepKey, index)
 	}
 }
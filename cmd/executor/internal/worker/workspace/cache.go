@@ -0,0 +1,265 @@
+package workspace
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/files"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/internal/unpack"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// CacheKey returns the key of the given cache in the caches bucket of the files store.
+// Caches are scoped to the queue and the repository of the job, the files store rejects
+// requests for caches of other repositories or queues.
+func CacheKey(job types.Job, cache types.JobCache) string {
+	return fmt.Sprintf("%s/%s/-/%s", job.Queue, job.RepositoryName, cache.Key)
+}
+
+// RestoreWorkspaceCaches restores the caches declared by the job into the working directory
+// of the workspace. The working directory of a Firecracker workspace is only mounted on the
+// host while the workspace is prepared, so it is mounted again for the duration of the
+// restore. It must be called before a VM uses the workspace.
+func RestoreWorkspaceCaches(ctx context.Context, filesStore files.Store, job types.Job, ws Workspace, logger cmdlogger.Logger) {
+	withHostWorkingDirectory(ctx, job, ws, logger, "setup.cache.mount", func(workingDirectory string) {
+		RestoreCaches(ctx, filesStore, job, workingDirectory, logger)
+	})
+}
+
+// SaveWorkspaceCaches saves the caches declared by the job from the working directory of
+// the workspace. Like RestoreWorkspaceCaches, it mounts the working directory of a
+// Firecracker workspace on the host, so it must be called after the VM using the workspace
+// has been torn down.
+func SaveWorkspaceCaches(ctx context.Context, filesStore files.Store, job types.Job, ws Workspace, logger cmdlogger.Logger) {
+	withHostWorkingDirectory(ctx, job, ws, logger, "teardown.cache.mount", func(workingDirectory string) {
+		SaveCaches(ctx, filesStore, job, workingDirectory, logger)
+	})
+}
+
+// withHostWorkingDirectory invokes fn with the working directory of the workspace on the
+// host, if the job declares caches. Firecracker workspaces are mounted for the duration of
+// fn, failures to do so are written to the job log under the given key.
+func withHostWorkingDirectory(ctx context.Context, job types.Job, ws Workspace, logger cmdlogger.Logger, key string, fn func(workingDirectory string)) {
+	if len(job.Caches) == 0 {
+		return
+	}
+
+	fw, ok := ws.(*firecrackerWorkspace)
+	if !ok {
+		if workingDirectory := ws.WorkingDirectory(); workingDirectory != "" {
+			fn(workingDirectory)
+		}
+		return
+	}
+
+	handle := logger.LogEntry(key, nil)
+	defer func() {
+		handle.Finalize(0)
+		handle.Close()
+	}()
+
+	mountDir, err := mountLoopDevice(ctx, fw.cmdRunner, fw.blockDevice, handle)
+	if err != nil {
+		fmt.Fprintf(handle, "Failed to mount workspace device %q, skipping caches: %s\n", fw.blockDevice, err)
+		return
+	}
+	defer func() {
+		if err := unmount(mountDir); err != nil {
+			fmt.Fprintf(handle, "stderr: Failed to unmount workspace device: %s\n", err)
+			return
+		}
+		if err := os.RemoveAll(mountDir); err != nil {
+			fmt.Fprintf(handle, "stderr: Failed to remove workspace mount location: %s\n", err)
+		}
+	}()
+
+	fn(mountDir)
+}
+
+// RestoreCaches downloads the caches declared by the job and unpacks them into the
+// working directory. Caches are an optimization, so failures are written to the
+// job log but never fail the job.
+func RestoreCaches(ctx context.Context, filesStore files.Store, job types.Job, workingDirectory string, logger cmdlogger.Logger) {
+	if len(job.Caches) == 0 {
+		return
+	}
+
+	handle := logger.LogEntry("setup.cache.restore", nil)
+	defer func() {
+		handle.Finalize(0)
+		handle.Close()
+	}()
+
+	for _, cache := range job.Caches {
+		if err := restoreCache(ctx, filesStore, job, cache, workingDirectory); err != nil {
+			fmt.Fprintf(handle, "Failed to restore cache %q: %s\n", cache.Key, err.Error())
+			continue
+		}
+		fmt.Fprintf(handle, "Restored cache %q\n", cache.Key)
+	}
+}
+
+func restoreCache(ctx context.Context, filesStore files.Store, job types.Job, cache types.JobCache, workingDirectory string) error {
+	dir, err := cacheDirectory(workingDirectory, cache)
+	if err != nil {
+		return err
+	}
+
+	exists, err := filesStore.Exists(ctx, job, types.JobCachesBucket, CacheKey(job, cache))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.New("cache does not exist yet")
+	}
+
+	content, err := filesStore.Get(ctx, job, types.JobCachesBucket, CacheKey(job, cache))
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	// Files that are part of the repository take precedence over cached ones.
+	return unpack.Tgz(content, dir, unpack.Opts{SkipInvalid: true, SkipDuplicates: true})
+}
+
+// SaveCaches archives the cached directories of the job and uploads them to the files
+// store, replacing the previous content of each cache. Like RestoreCaches, failures are
+// written to the job log but never fail the job.
+func SaveCaches(ctx context.Context, filesStore files.Store, job types.Job, workingDirectory string, logger cmdlogger.Logger) {
+	if len(job.Caches) == 0 {
+		return
+	}
+
+	handle := logger.LogEntry("teardown.cache.save", nil)
+	defer func() {
+		handle.Finalize(0)
+		handle.Close()
+	}()
+
+	for _, cache := range job.Caches {
+		if err := saveCache(ctx, filesStore, job, cache, workingDirectory); err != nil {
+			fmt.Fprintf(handle, "Failed to save cache %q: %s\n", cache.Key, err.Error())
+			continue
+		}
+		fmt.Fprintf(handle, "Saved cache %q\n", cache.Key)
+	}
+}
+
+func saveCache(ctx context.Context, filesStore files.Store, job types.Job, cache types.JobCache, workingDirectory string) error {
+	dir, err := cacheDirectory(workingDirectory, cache)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return errors.Newf("directory %q does not exist", cache.Path)
+		}
+		return err
+	}
+
+	// The archive is written to disk first so that large caches are not held in memory.
+	archive, err := os.CreateTemp("", "executor-cache-*.tar.gz")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = archive.Close()
+		_ = os.Remove(archive.Name())
+	}()
+
+	if err = writeTgz(archive, dir); err != nil {
+		return errors.Wrap(err, "archiving cache")
+	}
+	if _, err = archive.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	return filesStore.Upload(ctx, job, types.JobCachesBucket, CacheKey(job, cache), archive)
+}
+
+// cacheDirectory returns the absolute path of the cached directory, ensuring that it
+// does not point outside the working directory.
+func cacheDirectory(workingDirectory string, cache types.JobCache) (string, error) {
+	if cache.Key == "" || strings.Contains(cache.Key, "/") {
+		return "", errors.Newf("invalid cache key %q", cache.Key)
+	}
+	dir, err := filepath.Abs(filepath.Join(workingDirectory, cache.Path))
+	if err != nil {
+		return "", err
+	}
+	if dir == workingDirectory || !strings.HasPrefix(dir, workingDirectory+string(os.PathSeparator)) {
+		return "", errors.New("refusing to cache a directory outside of working directory")
+	}
+	return dir, nil
+}
+
+// writeTgz writes a gzip compressed tarball of the contents of dir to w.
+func writeTgz(w io.Writer, dir string) error {
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil || name == "." {
+			return err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		var link string
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		} else if !info.Mode().IsRegular() && !info.IsDir() {
+			// Sockets, devices and the like are not worth caching.
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+		if err = tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = tw.Close(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
@@ -0,0 +1,183 @@
+package workspace_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/workspace"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestCaches(t *testing.T) {
+	job := types.Job{
+		ID:             42,
+		Queue:          "codeintel",
+		RepositoryName: "github.com/sourcegraph/sourcegraph",
+		Caches: []types.JobCache{
+			{Key: "go-modules", Path: ".cache/go"},
+			{Key: "missing", Path: ".cache/missing"},
+			{Key: "outside", Path: "../outside"},
+		},
+	}
+
+	logger := workspace.NewMockLogger()
+	logEntry := workspace.NewMockLogEntry()
+	logger.LogEntryFunc.SetDefaultReturn(logEntry)
+
+	// Populate the cached directory of a first job.
+	first := t.TempDir()
+	writeFile(t, filepath.Join(first, ".cache/go/pkg/mod/cache.txt"), "cached")
+	writeFile(t, filepath.Join(first, ".cache/go/bin/tool"), "#!/bin/sh")
+	require.NoError(t, os.Chmod(filepath.Join(first, ".cache/go/bin/tool"), 0o755))
+	require.NoError(t, os.Symlink("pkg/mod/cache.txt", filepath.Join(first, ".cache/go/link")))
+
+	uploaded := map[string][]byte{}
+	filesStore := workspace.NewMockStore()
+	filesStore.UploadFunc.SetDefaultHook(func(_ context.Context, _ types.Job, bucket string, key string, content io.Reader) error {
+		b, err := io.ReadAll(content)
+		uploaded[bucket+"/"+key] = b
+		return err
+	})
+	filesStore.ExistsFunc.SetDefaultHook(func(_ context.Context, _ types.Job, bucket string, key string) (bool, error) {
+		_, ok := uploaded[bucket+"/"+key]
+		return ok, nil
+	})
+	filesStore.GetFunc.SetDefaultHook(func(_ context.Context, _ types.Job, bucket string, key string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(uploaded[bucket+"/"+key])), nil
+	})
+
+	workspace.SaveCaches(context.Background(), filesStore, job, first, logger)
+
+	require.Len(t, filesStore.UploadFunc.History(), 1)
+	assert.Equal(t, "caches", filesStore.UploadFunc.History()[0].Arg2)
+	assert.Equal(t, "codeintel/github.com/sourcegraph/sourcegraph/-/go-modules", filesStore.UploadFunc.History()[0].Arg3)
+
+	// Restore the caches into the workspace of a second job.
+	second := t.TempDir()
+	writeFile(t, filepath.Join(second, ".cache/go/pkg/mod/cache.txt"), "from repository")
+
+	workspace.RestoreCaches(context.Background(), filesStore, job, second, logger)
+
+	assert.Equal(t, "from repository", readFile(t, filepath.Join(second, ".cache/go/pkg/mod/cache.txt")))
+	assert.Equal(t, "#!/bin/sh", readFile(t, filepath.Join(second, ".cache/go/bin/tool")))
+	info, err := os.Stat(filepath.Join(second, ".cache/go/bin/tool"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+	link, err := os.Readlink(filepath.Join(second, ".cache/go/link"))
+	require.NoError(t, err)
+	assert.Equal(t, "pkg/mod/cache.txt", link)
+
+	// The missing cache is never fetched, the cache outside of the workspace is rejected.
+	require.Len(t, filesStore.GetFunc.History(), 1)
+	assert.Equal(t, "codeintel/github.com/sourcegraph/sourcegraph/-/go-modules", filesStore.GetFunc.History()[0].Arg3)
+	_, err = os.Stat(filepath.Join(filepath.Dir(second), "outside"))
+	assert.True(t, os.IsNotExist(err))
+
+	// Failures are logged but don't fail the job.
+	for _, call := range logEntry.FinalizeFunc.History() {
+		assert.Equal(t, 0, call.Arg0)
+	}
+}
+
+func TestWorkspaceCaches_Firecracker(t *testing.T) {
+	job := types.Job{
+		ID:             42,
+		Queue:          "codeintel",
+		RepositoryName: "github.com/sourcegraph/sourcegraph",
+		Commit:         "commit",
+		Caches:         []types.JobCache{{Key: "go-modules", Path: ".cache/go"}},
+	}
+
+	logger := workspace.NewMockLogger()
+	logger.LogEntryFunc.SetDefaultReturn(workspace.NewMockLogEntry())
+	cmdRunner := workspace.NewMockCmdRunner()
+	firecrackerDir := t.TempDir()
+	// losetup --find
+	cmdRunner.CombinedOutputFunc.PushReturn([]byte(firecrackerDir), nil)
+	// mkfs.ext4, mount, losetup --detach
+	cmdRunner.CombinedOutputFunc.SetDefaultReturn([]byte{}, nil)
+
+	makeLoopFile, makeMountDirectory := workspace.MakeLoopFile, workspace.MakeMountDirectory
+	t.Cleanup(func() {
+		workspace.MakeLoopFile, workspace.MakeMountDirectory = makeLoopFile, makeMountDirectory
+	})
+	workspace.MakeLoopFile = func(prefix string) (*os.File, error) {
+		return os.CreateTemp(firecrackerDir, prefix)
+	}
+	workspace.MakeMountDirectory = func(prefix string) (string, error) {
+		return os.MkdirTemp(firecrackerDir, prefix)
+	}
+
+	uploaded := map[string][]byte{}
+	filesStore := workspace.NewMockStore()
+	filesStore.UploadFunc.SetDefaultHook(func(_ context.Context, _ types.Job, bucket string, key string, content io.Reader) error {
+		b, err := io.ReadAll(content)
+		uploaded[bucket+"/"+key] = b
+		return err
+	})
+	filesStore.ExistsFunc.SetDefaultHook(func(_ context.Context, _ types.Job, bucket string, key string) (bool, error) {
+		_, ok := uploaded[bucket+"/"+key]
+		return ok, nil
+	})
+	filesStore.GetFunc.SetDefaultHook(func(_ context.Context, _ types.Job, bucket string, key string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(uploaded[bucket+"/"+key])), nil
+	})
+
+	ws, err := workspace.NewFirecrackerWorkspace(
+		context.Background(),
+		filesStore,
+		job,
+		"10G",
+		false,
+		cmdRunner,
+		workspace.NewMockCommand(),
+		logger,
+		workspace.CloneOptions{},
+		command.NewOperations(&observation.TestContext),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { ws.Remove(context.Background(), false) })
+
+	// The workspace device is mounted on the host again to read the cached directories.
+	mounted := t.TempDir()
+	writeFile(t, filepath.Join(mounted, ".cache/go/pkg/mod/cache.txt"), "cached")
+	workspace.MakeMountDirectory = func(string) (string, error) { return mounted, nil }
+
+	workspace.SaveWorkspaceCaches(context.Background(), filesStore, job, ws, logger)
+
+	require.Len(t, filesStore.UploadFunc.History(), 1)
+	assert.Equal(t, "codeintel/github.com/sourcegraph/sourcegraph/-/go-modules", filesStore.UploadFunc.History()[0].Arg3)
+	lastCall := cmdRunner.CombinedOutputFunc.History()[len(cmdRunner.CombinedOutputFunc.History())-1]
+	assert.Equal(t, "mount", lastCall.Arg1)
+	assert.Equal(t, []string{ws.Path(), mounted}, lastCall.Arg2)
+
+	// And to restore them before the VM uses it.
+	restored := t.TempDir()
+	workspace.MakeMountDirectory = func(string) (string, error) { return restored, nil }
+
+	workspace.RestoreWorkspaceCaches(context.Background(), filesStore, job, ws, logger)
+
+	assert.Equal(t, "cached", readFile(t, filepath.Join(restored, ".cache/go/pkg/mod/cache.txt")))
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(b)
+}
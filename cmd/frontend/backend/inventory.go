@@ -32,14 +32,6 @@ func InventoryContext(logger log.Logger, repo api.RepoName, gsClient gitserver.C
 		return inventory.Context{}, errors.Errorf("refusing to compute inventory for non-absolute commit ID %q", commitID)
 	}
 
-	cacheKey := func(e fs.FileInfo) string {
-		info, ok := e.Sys().(gitdomain.ObjectInfo)
-		if !ok {
-			return "" // not cacheable
-		}
-		return info.OID().String()
-	}
-
 	logger = logger.Scoped("InventoryContext").
 		With(log.String("repo", string(repo)), log.String("commitID", string(commitID)))
 	invCtx := inventory.Context{
@@ -52,22 +44,10 @@ func InventoryContext(logger log.Logger, repo api.RepoName, gsClient gitserver.C
 			return gsClient.NewFileReader(ctx, repo, commitID, path)
 		},
 		CacheGet: func(e fs.FileInfo) (inventory.Inventory, bool) {
-			cacheKey := cacheKey(e)
-			if cacheKey == "" {
-				return inventory.Inventory{}, false // not cacheable
-			}
-			if b, ok := inventoryCache.Get(cacheKey); ok {
-				var inv inventory.Inventory
-				if err := json.Unmarshal(b, &inv); err != nil {
-					logger.Warn("Failed to unmarshal cached JSON inventory.", log.String("path", e.Name()), log.Error(err))
-					return inventory.Inventory{}, false
-				}
-				return inv, true
-			}
-			return inventory.Inventory{}, false
+			return getCachedInventory(logger, e)
 		},
 		CacheSet: func(e fs.FileInfo, inv inventory.Inventory) {
-			cacheKey := cacheKey(e)
+			cacheKey := inventoryCacheKey(e)
 			if cacheKey == "" {
 				return // not cacheable
 			}
@@ -90,3 +70,49 @@ func InventoryContext(logger log.Logger, repo api.RepoName, gsClient gitserver.C
 
 	return invCtx, nil
 }
+
+// CachedInventory returns the inventory of the root tree of the repository at the given commit
+// if it was cached when it was last computed, e.g. for the language statistics of the repository.
+// Unlike the inventory context, it never reads the tree or the files of the repository, so ok is
+// false if the inventory has not been computed yet.
+func CachedInventory(ctx context.Context, logger log.Logger, repo api.RepoName, gsClient gitserver.Client, commitID api.CommitID) (_ inventory.Inventory, ok bool, err error) {
+	if !gitdomain.IsAbsoluteRevision(string(commitID)) {
+		return inventory.Inventory{}, false, errors.Errorf("refusing to read inventory for non-absolute commit ID %q", commitID)
+	}
+
+	root, err := gsClient.Stat(ctx, repo, commitID, "")
+	if err != nil {
+		return inventory.Inventory{}, false, err
+	}
+
+	logger = logger.Scoped("CachedInventory").
+		With(log.String("repo", string(repo)), log.String("commitID", string(commitID)))
+	inv, ok := getCachedInventory(logger, root)
+	return inv, ok, nil
+}
+
+// inventoryCacheKey returns the key of the inventory of the tree or file e in the inventory cache,
+// or the empty string if it is not cacheable.
+func inventoryCacheKey(e fs.FileInfo) string {
+	info, ok := e.Sys().(gitdomain.ObjectInfo)
+	if !ok {
+		return "" // not cacheable
+	}
+	return info.OID().String()
+}
+
+func getCachedInventory(logger log.Logger, e fs.FileInfo) (inventory.Inventory, bool) {
+	cacheKey := inventoryCacheKey(e)
+	if cacheKey == "" {
+		return inventory.Inventory{}, false // not cacheable
+	}
+	if b, ok := inventoryCache.Get(cacheKey); ok {
+		var inv inventory.Inventory
+		if err := json.Unmarshal(b, &inv); err != nil {
+			logger.Warn("Failed to unmarshal cached JSON inventory.", log.String("path", e.Name()), log.Error(err))
+			return inventory.Inventory{}, false
+		}
+		return inv, true
+	}
+	return inventory.Inventory{}, false
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -18,8 +19,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/inventory"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
@@ -336,9 +339,38 @@ func (s *repos) ResolveRev(ctx context.Context, repo *types.Repo, rev string) (c
 	ctx, done := startTrace(ctx, "ResolveRev", map[string]any{"repo": repo.Name, "rev": rev}, &err)
 	defer done()
 
+	if strings.HasPrefix(rev, hgRevPrefix) {
+		return s.resolveHgChangeset(ctx, repo, strings.TrimPrefix(rev, hgRevPrefix))
+	}
+
 	return s.gitserverClient.ResolveRevision(ctx, repo.Name, rev, gitserver.ResolveRevisionOptions{})
 }
 
+// hgRevPrefix is the prefix of revision specs that refer to a Mercurial
+// changeset of a repository converted from Mercurial, e.g. "hg:d4b9c8bd0a0b".
+const hgRevPrefix = "hg:"
+
+var hgChangesetIDPattern = lazyregexp.New(`^[0-9a-f]{1,40}$`)
+
+// resolveHgChangeset resolves the (possibly abbreviated) Mercurial changeset
+// ID to the commit it was converted to, using the changeset mapping recorded
+// by gitserver.
+func (s *repos) resolveHgChangeset(ctx context.Context, repo *types.Repo, changesetID string) (api.CommitID, error) {
+	notFound := &gitdomain.RevisionNotFoundError{Repo: repo.Name, Spec: hgRevPrefix + changesetID}
+	if !hgChangesetIDPattern.MatchString(changesetID) {
+		return "", notFound
+	}
+
+	rc, err := s.db.RepoCommitsHgChangesets().GetRepoCommitHgChangeset(ctx, repo.ID, changesetID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return "", notFound
+		}
+		return "", err
+	}
+	return api.CommitID(rc.CommitSHA), nil
+}
+
 // ErrRepoSeeOther indicates that the repo does not exist on this server but might exist on an external Sourcegraph
 // server.
 type ErrRepoSeeOther struct {
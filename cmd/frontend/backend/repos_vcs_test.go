@@ -8,8 +8,11 @@ import (
 	"github.com/sourcegraph/log/logtest"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -173,3 +176,44 @@ func TestRepos_ResolveRev_commitIDSpecified_failsToResolve(t *testing.T) {
 		t.Error("!calledVCSRepoResolveRevision")
 	}
 }
+
+func TestRepos_ResolveRev_hgChangeset(t *testing.T) {
+	ctx := testContext()
+	logger := logtest.Scoped(t)
+
+	want := strings.Repeat("a", 40)
+
+	client := gitserver.NewMockClient()
+	client.ResolveRevisionFunc.SetDefaultHook(func(context.Context, api.RepoName, string, gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		t.Error("unexpected call to ResolveRevision")
+		return "", nil
+	})
+
+	changesets := dbmocks.NewMockRepoCommitsHgChangesetsStore()
+	changesets.GetRepoCommitHgChangesetFunc.SetDefaultHook(func(_ context.Context, repoID api.RepoID, changesetID string) (*types.RepoCommitHgChangeset, error) {
+		if repoID == 1 && changesetID == "d4b9c8bd0a0b" {
+			return &types.RepoCommitHgChangeset{RepoID: repoID, CommitSHA: dbutil.CommitBytea(want), HgChangesetID: changesetID}, nil
+		}
+		return nil, &database.HgChangesetNotFoundError{RepoID: repoID, ID: changesetID}
+	})
+	db := dbmocks.NewMockDB()
+	db.RepoCommitsHgChangesetsFunc.SetDefaultReturn(changesets)
+
+	repos := NewRepos(logger, db, client)
+	repo := &types.Repo{ID: 1, Name: "a"}
+
+	commitID, err := repos.ResolveRev(ctx, repo, "hg:d4b9c8bd0a0b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(commitID) != want {
+		t.Errorf("got resolved commit %q, want %q", commitID, want)
+	}
+
+	for _, rev := range []string{"hg:ffffffffffff", "hg:notahash", "hg:"} {
+		_, err := repos.ResolveRev(ctx, repo, rev)
+		if !errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+			t.Errorf("%s: got err %v, want RevisionNotFoundError", rev, err)
+		}
+	}
+}
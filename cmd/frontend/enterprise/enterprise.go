@@ -44,6 +44,10 @@ type Services struct {
 	SearchJobsDataExportHandler http.Handler
 	SearchJobsLogsHandler       http.Handler
 
+	// Handlers for tailing and searching the logs of executor jobs.
+	ExecutorJobLogsStreamHandler http.Handler
+	ExecutorJobLogsGrepHandler   http.Handler
+
 	// Handler for completions stream.
 	NewChatCompletionsStreamHandler NewChatCompletionsStreamHandler
 
@@ -122,6 +126,8 @@ func DefaultServices() Services {
 		NewCodeCompletionsHandler:       func() http.Handler { return makeNotFoundHandler("code completions streaming endpoint") },
 		SearchJobsDataExportHandler:     makeNotFoundHandler("search jobs data export handler"),
 		SearchJobsLogsHandler:           makeNotFoundHandler("search jobs logs handler"),
+		ExecutorJobLogsStreamHandler:    makeNotFoundHandler("executor job logs stream handler"),
+		ExecutorJobLogsGrepHandler:      makeNotFoundHandler("executor job logs grep handler"),
 	}
 }
 
@@ -233,6 +233,17 @@ type PublishChangesetsArgs struct {
 	Draft bool
 }
 
+type EnableBatchChangeMergeQueueArgs struct {
+	BatchChange     graphql.ID
+	MergesPerWindow int32
+	WindowMinutes   int32
+	Squash          bool
+}
+
+type DisableBatchChangeMergeQueueArgs struct {
+	BatchChange graphql.ID
+}
+
 type ResolveWorkspacesForBatchSpecArgs struct {
 	BatchSpec string
 }
@@ -284,6 +295,8 @@ type BatchChangesResolver interface {
 	MergeChangesets(ctx context.Context, args *MergeChangesetsArgs) (BulkOperationResolver, error)
 	CloseChangesets(ctx context.Context, args *CloseChangesetsArgs) (BulkOperationResolver, error)
 	PublishChangesets(ctx context.Context, args *PublishChangesetsArgs) (BulkOperationResolver, error)
+	EnableBatchChangeMergeQueue(ctx context.Context, args *EnableBatchChangeMergeQueueArgs) (BatchChangeMergeQueueResolver, error)
+	DisableBatchChangeMergeQueue(ctx context.Context, args *DisableBatchChangeMergeQueueArgs) (*EmptyResponse, error)
 
 	// Queries
 	BatchChange(ctx context.Context, args *BatchChangeArgs) (BatchChangeResolver, error)
@@ -520,6 +533,7 @@ type GitBranchChangesetDescriptionResolver interface {
 	BaseRev() string
 
 	HeadRef() string
+	DependsOn() *string
 
 	Title() string
 	Body() string
@@ -674,6 +688,23 @@ type BatchChangeResolver interface {
 	CurrentSpec(ctx context.Context) (BatchSpecResolver, error)
 	BulkOperations(ctx context.Context, args *ListBatchChangeBulkOperationArgs) (BulkOperationConnectionResolver, error)
 	BatchSpecs(ctx context.Context, args *ListBatchSpecArgs) (BatchSpecConnectionResolver, error)
+	MergeQueue(ctx context.Context) (BatchChangeMergeQueueResolver, error)
+}
+
+type BatchChangeMergeQueueResolver interface {
+	User(ctx context.Context) (*UserResolver, error)
+	MergesPerWindow() int32
+	WindowMinutes() int32
+	MergesInWindow(ctx context.Context) (int32, error)
+	Squash() bool
+	PausedReason() *string
+	Entries(ctx context.Context) ([]BatchChangeMergeQueueEntryResolver, error)
+}
+
+type BatchChangeMergeQueueEntryResolver interface {
+	Changeset() ChangesetResolver
+	State() string
+	BlockedReason() *string
 }
 
 type BatchChangesConnectionResolver interface {
@@ -744,6 +775,7 @@ type ChangesetsStatsResolver interface {
 	Processing() int32
 	Deleted() int32
 	Archived() int32
+	Conflicting() int32
 	IsCompleted() bool
 	PercentComplete() int32
 }
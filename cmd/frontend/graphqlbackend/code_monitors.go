@@ -32,6 +32,7 @@ type CodeMonitorsResolver interface {
 	TriggerTestEmailAction(ctx context.Context, args *TriggerTestEmailActionArgs) (*EmptyResponse, error)
 	TriggerTestWebhookAction(ctx context.Context, args *TriggerTestWebhookActionArgs) (*EmptyResponse, error)
 	TriggerTestSlackWebhookAction(ctx context.Context, args *TriggerTestSlackWebhookActionArgs) (*EmptyResponse, error)
+	TriggerTestChatWebhookAction(ctx context.Context, args *TriggerTestChatWebhookActionArgs) (*EmptyResponse, error)
 
 	NodeResolvers() map[string]NodeByIDFunc
 }
@@ -55,6 +56,7 @@ type MonitorResolver interface {
 
 type MonitorTrigger interface {
 	ToMonitorQuery() (MonitorQueryResolver, bool)
+	ToMonitorPathTrigger() (MonitorPathTriggerResolver, bool)
 }
 
 type MonitorQueryResolver interface {
@@ -63,6 +65,14 @@ type MonitorQueryResolver interface {
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorTriggerEventConnectionResolver, error)
 }
 
+type MonitorPathTriggerResolver interface {
+	ID() graphql.ID
+	Repositories(ctx context.Context) ([]*RepositoryResolver, error)
+	PathPatterns() []string
+	Owner() *string
+	Events(ctx context.Context, args *ListEventsArgs) (MonitorTriggerEventConnectionResolver, error)
+}
+
 type MonitorTriggerEventConnectionResolver interface {
 	Nodes() []MonitorTriggerEventResolver
 	TotalCount() int32
@@ -90,6 +100,7 @@ type MonitorAction interface {
 	ToMonitorEmail() (MonitorEmailResolver, bool)
 	ToMonitorWebhook() (MonitorWebhookResolver, bool)
 	ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, bool)
+	ToMonitorChatWebhook() (MonitorChatWebhookResolver, bool)
 }
 
 type MonitorEmailResolver interface {
@@ -98,6 +109,7 @@ type MonitorEmailResolver interface {
 	IncludeResults() bool
 	Priority() string
 	Header() string
+	Schedule() string
 	Recipients(ctx context.Context, args *ListRecipientsArgs) (MonitorActionEmailRecipientsConnectionResolver, error)
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
@@ -107,6 +119,7 @@ type MonitorWebhookResolver interface {
 	Enabled() bool
 	IncludeResults() bool
 	URL() string
+	Schedule() string
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
 
@@ -115,6 +128,17 @@ type MonitorSlackWebhookResolver interface {
 	Enabled() bool
 	IncludeResults() bool
 	URL() string
+	Schedule() string
+	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
+}
+
+type MonitorChatWebhookResolver interface {
+	ID() graphql.ID
+	Enabled() bool
+	IncludeResults() bool
+	Format() string
+	URL() string
+	Schedule() string
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
 
@@ -169,12 +193,20 @@ type CreateCodeMonitorArgs struct {
 
 type CreateTriggerArgs struct {
 	Query string
+	Paths *CreatePathTriggerArgs
+}
+
+type CreatePathTriggerArgs struct {
+	Repositories []graphql.ID
+	PathPatterns *[]string
+	Owner        *string
 }
 
 type CreateActionArgs struct {
 	Email        *CreateActionEmailArgs
 	Webhook      *CreateActionWebhookArgs
 	SlackWebhook *CreateActionSlackWebhookArgs
+	ChatWebhook  *CreateActionChatWebhookArgs
 }
 
 type CreateActionEmailArgs struct {
@@ -183,18 +215,29 @@ type CreateActionEmailArgs struct {
 	Priority       string
 	Recipients     []graphql.ID
 	Header         string
+	Schedule       string
 }
 
 type CreateActionWebhookArgs struct {
 	Enabled        bool
 	IncludeResults bool
 	URL            string
+	Schedule       string
 }
 
 type CreateActionSlackWebhookArgs struct {
 	Enabled        bool
 	IncludeResults bool
 	URL            string
+	Schedule       string
+}
+
+type CreateActionChatWebhookArgs struct {
+	Enabled        bool
+	IncludeResults bool
+	Format         string
+	URL            string
+	Schedule       string
 }
 
 type ToggleCodeMonitorArgs struct {
@@ -228,6 +271,12 @@ type TriggerTestSlackWebhookActionArgs struct {
 	SlackWebhook *CreateActionSlackWebhookArgs
 }
 
+type TriggerTestChatWebhookActionArgs struct {
+	Namespace   graphql.ID
+	Description string
+	ChatWebhook *CreateActionChatWebhookArgs
+}
+
 type CreateMonitorArgs struct {
 	Namespace   graphql.ID
 	Description string
@@ -249,10 +298,16 @@ type EditActionSlackWebhookArgs struct {
 	Update *CreateActionSlackWebhookArgs
 }
 
+type EditActionChatWebhookArgs struct {
+	Id     *graphql.ID
+	Update *CreateActionChatWebhookArgs
+}
+
 type EditActionArgs struct {
 	Email        *EditActionEmailArgs
 	Webhook      *EditActionWebhookArgs
 	SlackWebhook *EditActionSlackWebhookArgs
+	ChatWebhook  *EditActionChatWebhookArgs
 }
 
 type EditTriggerArgs struct {
@@ -239,6 +239,7 @@ type SearchInsightDataSeriesDefinitionResolver interface {
 	GeneratedFromCaptureGroups() (bool, error)
 	IsCalculated() (bool, error)
 	GroupBy() (*string, error)
+	SymbolReferences() (bool, error)
 }
 
 type InsightPresentation interface {
@@ -429,6 +430,7 @@ type LineChartSearchInsightDataSeriesInput struct {
 	Options                    LineChartDataSeriesOptionsInput
 	GeneratedFromCaptureGroups *bool
 	GroupBy                    *string
+	SymbolReferences           *bool
 }
 
 type LineChartDataSeriesOptionsInput struct {
@@ -64,6 +64,11 @@ func (r *NodeResolver) ToMonitorQuery() (MonitorQueryResolver, bool) {
 	return n, ok
 }
 
+func (r *NodeResolver) ToMonitorPathTrigger() (MonitorPathTriggerResolver, bool) {
+	n, ok := r.Node.(MonitorPathTriggerResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToMonitorEmail() (MonitorEmailResolver, bool) {
 	n, ok := r.Node.(MonitorEmailResolver)
 	return n, ok
@@ -79,6 +84,11 @@ func (r *NodeResolver) ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, boo
 	return n, ok
 }
 
+func (r *NodeResolver) ToMonitorChatWebhook() (MonitorChatWebhookResolver, bool) {
+	n, ok := r.Node.(MonitorChatWebhookResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToMonitorActionEvent() (MonitorActionEventResolver, bool) {
 	n, ok := r.Node.(MonitorActionEventResolver)
 	return n, ok
@@ -69,6 +69,10 @@ type OwnResolver interface {
 	AssignTeam(context.Context, *AssignOwnerOrTeamArgs) (*EmptyResponse, error)
 	RemoveAssignedTeam(context.Context, *AssignOwnerOrTeamArgs) (*EmptyResponse, error)
 
+	// Coverage queries.
+	OwnCoverageReports(context.Context, *OwnCoverageReportsArgs) (OwnCoverageReportConnectionResolver, error)
+	RepoOwnCoverageReport(context.Context, api.RepoID) (OwnCoverageReportResolver, error)
+
 	// Config.
 	OwnSignalConfigurations(ctx context.Context) ([]SignalConfigurationResolver, error)
 	UpdateOwnSignalConfigurations(ctx context.Context, configurationsArgs UpdateSignalConfigurationsArgs) ([]SignalConfigurationResolver, error)
@@ -195,6 +199,41 @@ type CodeownersIngestedFileConnectionResolver interface {
 	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
 }
 
+type OwnCoverageReportsArgs struct {
+	First *int32
+	After *string
+}
+
+type OwnCoverageReportResolver interface {
+	Repository(context.Context) (*RepositoryResolver, error)
+	Commit() string
+	TotalFiles() int32
+	TotalOwnedFiles() int32
+	Coverage() float64
+	UnownedDirectories() []string
+	UnmatchedRules() []OwnCoverageRuleResolver
+	UnresolvedOwners() []OwnCoverageUnresolvedOwnerResolver
+	UpdatedAt() gqlutil.DateTime
+}
+
+type OwnCoverageRuleResolver interface {
+	Pattern() string
+	FilePath() *string
+	LineNumber() int32
+}
+
+type OwnCoverageUnresolvedOwnerResolver interface {
+	Handle() *string
+	Email() *string
+	Rules() []OwnCoverageRuleResolver
+}
+
+type OwnCoverageReportConnectionResolver interface {
+	Nodes(ctx context.Context) ([]OwnCoverageReportResolver, error)
+	TotalCount(ctx context.Context) (int32, error)
+	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
+}
+
 type SignalConfigurationResolver interface {
 	Name() string
 	Description() string
@@ -5,14 +5,17 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
@@ -39,6 +42,11 @@ type repositoryMirrorInfoResolver struct {
 	gsRepoOnce sync.Once
 	gsRepo     *types.GitserverRepo
 	gsRepoErr  error
+
+	// memoize the gitserver RepoCloneProgress call
+	cloneProgressOnce sync.Once
+	cloneProgress     *protocol.RepoCloneProgress
+	cloneProgressErr  error
 }
 
 func (r *repositoryMirrorInfoResolver) computeGitserverRepo(ctx context.Context) (*types.GitserverRepo, error) {
@@ -48,6 +56,24 @@ func (r *repositoryMirrorInfoResolver) computeGitserverRepo(ctx context.Context)
 	return r.gsRepo, r.gsRepoErr
 }
 
+func (r *repositoryMirrorInfoResolver) computeCloneProgress(ctx context.Context) (*protocol.RepoCloneProgress, error) {
+	r.cloneProgressOnce.Do(func() {
+		progress, err := r.gitServerClient.RepoCloneProgress(ctx, r.repository.RepoName())
+		if err != nil {
+			r.cloneProgressErr = err
+			return
+		}
+
+		result, ok := progress.Results[r.repository.RepoName()]
+		if !ok {
+			r.cloneProgressErr = errors.New("got empty result for repo from RepoCloneProgress")
+			return
+		}
+		r.cloneProgress = result
+	})
+	return r.cloneProgress, r.cloneProgressErr
+}
+
 func (r *repositoryMirrorInfoResolver) repoUpdateSchedulerInfo(ctx context.Context) (*repoupdaterprotocol.RepoUpdateSchedulerInfoResult, error) {
 	r.repoUpdateSchedulerInfoOnce.Do(func() {
 		args := repoupdaterprotocol.RepoUpdateSchedulerInfoArgs{
@@ -136,17 +162,56 @@ func (r *repositoryMirrorInfoResolver) CloneProgress(ctx context.Context) (*stri
 		}
 		return strptr(info.CloningProgress), nil
 	}
-	progress, err := r.gitServerClient.RepoCloneProgress(ctx, r.repository.RepoName())
+	result, err := r.computeCloneProgress(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	result, ok := progress.Results[r.repository.RepoName()]
-	if !ok {
-		return nil, errors.New("got empty result for repo from RepoCloneProgress")
+	return strptr(result.CloneProgress), nil
+}
+
+func (r *repositoryMirrorInfoResolver) CloneProgressDetails(ctx context.Context) (*cloneProgressDetailsResolver, error) {
+	result, err := r.computeCloneProgress(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return strptr(result.CloneProgress), nil
+	if result.ProgressDetails == nil {
+		return nil, nil
+	}
+	return &cloneProgressDetailsResolver{details: result.ProgressDetails}, nil
+}
+
+type cloneProgressDetailsResolver struct {
+	details *protocol.CloneProgressDetails
+}
+
+func (r *cloneProgressDetailsResolver) Phase() string {
+	return r.details.Phase
+}
+
+func (r *cloneProgressDetailsResolver) ObjectsReceived() int32 {
+	return int32(r.details.ObjectsReceived)
+}
+
+func (r *cloneProgressDetailsResolver) ObjectsTotal() int32 {
+	return int32(r.details.ObjectsTotal)
+}
+
+func (r *cloneProgressDetailsResolver) BytesReceived() BigInt {
+	return BigInt(r.details.BytesReceived)
+}
+
+func (r *cloneProgressDetailsResolver) EstimatedSecondsRemaining() *int32 {
+	if r.details.ETA == nil {
+		return nil
+	}
+	seconds := int32(r.details.ETA.Round(time.Second) / time.Second)
+	return &seconds
+}
+
+func (r *cloneProgressDetailsResolver) Resumed() bool {
+	return r.details.Resumed
 }
 
 func (r *repositoryMirrorInfoResolver) LastError(ctx context.Context) (*string, error) {
@@ -261,6 +326,90 @@ func (r *repositoryMirrorInfoResolver) Shard(ctx context.Context) (*string, erro
 	return &info.ShardID, nil
 }
 
+func (r *repositoryMirrorInfoResolver) PushMirrors(ctx context.Context) ([]*pushMirrorResolver, error) {
+	// 🚨 SECURITY: This is a query that reveals internal details of the
+	// instance that only the admin should be able to see.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	info, err := r.computeGitserverRepo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mirrors, err := r.db.GitserverRepoPushMirrors().ListByRepo(ctx, r.repository.IDInt32())
+	if err != nil {
+		return nil, err
+	}
+
+	// Push mirrors that were removed from the site configuration are not
+	// pushed to anymore, so don't report them.
+	configured := map[string]struct{}{}
+	for _, m := range conf.ExperimentalFeatures().PushMirrors {
+		configured[m.Name] = struct{}{}
+	}
+
+	resolvers := make([]*pushMirrorResolver, 0, len(mirrors))
+	for _, m := range mirrors {
+		if _, ok := configured[m.RemoteName]; !ok {
+			continue
+		}
+		resolvers = append(resolvers, &pushMirrorResolver{mirror: m, lastChanged: info.LastChanged})
+	}
+	return resolvers, nil
+}
+
+type pushMirrorResolver struct {
+	mirror      *types.GitserverRepoPushMirror
+	lastChanged time.Time
+}
+
+func (r *pushMirrorResolver) Name() string {
+	return r.mirror.RemoteName
+}
+
+func (r *pushMirrorResolver) LastPushedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(nonZeroTime(r.mirror.LastPushedAt))
+}
+
+func (r *pushMirrorResolver) LastAttemptAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.mirror.LastAttemptAt}
+}
+
+func (r *pushMirrorResolver) LastError() *string {
+	if r.mirror.LastError == "" {
+		return nil
+	}
+	return &r.mirror.LastError
+}
+
+func (r *pushMirrorResolver) Failures() int32 {
+	return int32(r.mirror.Failures)
+}
+
+func (r *pushMirrorResolver) NextAttemptAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(nonZeroTime(r.mirror.NextAttemptAt))
+}
+
+func (r *pushMirrorResolver) LagSeconds() *int32 {
+	if r.mirror.LastPushedAt.IsZero() {
+		return nil
+	}
+	var lag int32
+	if r.mirror.LastPushedAt.Before(r.lastChanged) {
+		lag = int32(time.Since(r.mirror.LastPushedAt).Seconds())
+	}
+	return &lag
+}
+
+func nonZeroTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
 func (r *repositoryMirrorInfoResolver) UpdateSchedule(ctx context.Context) (*updateScheduleResolver, error) {
 	info, err := r.repoUpdateSchedulerInfo(ctx)
 	if err != nil {
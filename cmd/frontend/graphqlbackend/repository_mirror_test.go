@@ -189,11 +189,20 @@ type fakeGitserverClient struct {
 
 func (f *fakeGitserverClient) RepoCloneProgress(_ context.Context, repoName ...api.RepoName) (*protocol.RepoCloneProgressResponse, error) {
 	results := map[api.RepoName]*protocol.RepoCloneProgress{}
+	eta := 90 * time.Second
 	for _, n := range repoName {
 		results[n] = &protocol.RepoCloneProgress{
 			CloneInProgress: true,
 			CloneProgress:   fmt.Sprintf("cloning fake %s...", n),
 			Cloned:          false,
+			ProgressDetails: &protocol.CloneProgressDetails{
+				Phase:           "Receiving objects",
+				ObjectsReceived: 450,
+				ObjectsTotal:    1000,
+				BytesReceived:   1258291,
+				ETA:             &eta,
+				Resumed:         true,
+			},
 		}
 	}
 	return &protocol.RepoCloneProgressResponse{
@@ -242,6 +251,61 @@ func TestRepositoryMirrorInfoCloneProgressCallsGitserver(t *testing.T) {
 	})
 }
 
+func TestRepositoryMirrorInfoCloneProgressDetails(t *testing.T) {
+	users := dbmocks.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{SiteAdmin: true}, nil)
+
+	db := dbmocks.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+
+	backend.Mocks.Repos.GetByName = func(ctx context.Context, name api.RepoName) (*types.Repo, error) {
+		return &types.Repo{
+			Name:      "repo-name",
+			CreatedAt: time.Now(),
+			Sources:   map[string]*types.SourceInfo{"1": {}},
+		}, nil
+	}
+	t.Cleanup(func() {
+		backend.Mocks = backend.MockServices{}
+	})
+
+	RunTest(t, &Test{
+		Schema: mustParseGraphQLSchemaWithClient(t, db, &fakeGitserverClient{}),
+		Query: `
+			{
+				repository(name: "my/repo") {
+					mirrorInfo {
+						cloneProgressDetails {
+							phase
+							objectsReceived
+							objectsTotal
+							bytesReceived
+							estimatedSecondsRemaining
+							resumed
+						}
+					}
+				}
+			}
+		`,
+		ExpectedResult: `
+			{
+				"repository": {
+					"mirrorInfo": {
+						"cloneProgressDetails": {
+							"phase": "Receiving objects",
+							"objectsReceived": 450,
+							"objectsTotal": 1000,
+							"bytesReceived": "1258291",
+							"estimatedSecondsRemaining": 90,
+							"resumed": true
+						}
+					}
+				}
+			}
+		`,
+	})
+}
+
 func TestRepositoryMirrorInfoCloneProgressFetchedFromDatabase(t *testing.T) {
 	users := dbmocks.NewMockUserStore()
 	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{SiteAdmin: true}, nil)
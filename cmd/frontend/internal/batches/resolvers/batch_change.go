@@ -359,3 +359,15 @@ func (r *batchChangeResolver) BatchSpecs(
 
 	return &batchSpecConnectionResolver{store: r.store, logger: r.logger, opts: opts}, nil
 }
+
+func (r *batchChangeResolver) MergeQueue(ctx context.Context) (graphqlbackend.BatchChangeMergeQueueResolver, error) {
+	queue, err := r.store.GetBatchChangeMergeQueue(ctx, r.batchChange.ID)
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &batchChangeMergeQueueResolver{store: r.store, gitserverClient: r.gitserverClient, logger: r.logger, queue: queue}, nil
+}
@@ -164,6 +164,13 @@ func (r *changesetDescriptionResolver) BaseRev() string { return r.spec.BaseRev
 func (r *changesetDescriptionResolver) HeadRef() string {
 	return gitdomain.AbbreviateRef(r.spec.HeadRef)
 }
+func (r *changesetDescriptionResolver) DependsOn() *string {
+	if r.spec.DependsOn == "" {
+		return nil
+	}
+	ref := gitdomain.AbbreviateRef(r.spec.DependsOn)
+	return &ref
+}
 func (r *changesetDescriptionResolver) Title() string { return r.spec.Title }
 func (r *changesetDescriptionResolver) Body() string  { return r.spec.Body }
 func (r *changesetDescriptionResolver) Published() *batcheslib.PublishedValue {
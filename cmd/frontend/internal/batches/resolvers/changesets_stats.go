@@ -44,6 +44,9 @@ func (r *changesetsStatsResolver) Deleted() int32 {
 func (r *changesetsStatsResolver) Archived() int32 {
 	return r.stats.Archived
 }
+func (r *changesetsStatsResolver) Conflicting() int32 {
+	return r.stats.Conflicting
+}
 func (r *changesetsStatsResolver) Total() int32 {
 	return r.stats.Total
 }
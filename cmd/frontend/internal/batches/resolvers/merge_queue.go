@@ -0,0 +1,130 @@
+package resolvers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/batches/mergequeue"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+var _ graphqlbackend.BatchChangeMergeQueueResolver = &batchChangeMergeQueueResolver{}
+
+type batchChangeMergeQueueResolver struct {
+	store           *store.Store
+	gitserverClient gitserver.Client
+	logger          log.Logger
+
+	queue *btypes.BatchChangeMergeQueue
+
+	entriesOnce sync.Once
+	entries     []*btypes.MergeQueueEntry
+	entriesErr  error
+}
+
+func (r *batchChangeMergeQueueResolver) User(ctx context.Context) (*graphqlbackend.UserResolver, error) {
+	user, err := graphqlbackend.UserByIDInt32(ctx, r.store.DatabaseDB(), r.queue.UserID)
+	if errcode.IsNotFound(err) {
+		return nil, nil
+	}
+	return user, err
+}
+
+func (r *batchChangeMergeQueueResolver) MergesPerWindow() int32 {
+	return r.queue.MaxMerges
+}
+
+func (r *batchChangeMergeQueueResolver) WindowMinutes() int32 {
+	return int32(r.queue.Window / time.Minute)
+}
+
+func (r *batchChangeMergeQueueResolver) MergesInWindow(ctx context.Context) (int32, error) {
+	count, err := r.store.CountBatchChangeMerges(ctx, r.queue.BatchChangeID, r.store.Clock()().Add(-r.queue.Window))
+	return int32(count), err
+}
+
+func (r *batchChangeMergeQueueResolver) Squash() bool {
+	return r.queue.Squash
+}
+
+func (r *batchChangeMergeQueueResolver) PausedReason() *string {
+	if r.queue.PausedReason == "" {
+		return nil
+	}
+	return &r.queue.PausedReason
+}
+
+func (r *batchChangeMergeQueueResolver) computeEntries(ctx context.Context) ([]*btypes.MergeQueueEntry, error) {
+	r.entriesOnce.Do(func() {
+		r.entries, r.entriesErr = mergequeue.ListEntries(ctx, r.store, r.queue.BatchChangeID)
+	})
+	return r.entries, r.entriesErr
+}
+
+func (r *batchChangeMergeQueueResolver) Entries(ctx context.Context) ([]graphqlbackend.BatchChangeMergeQueueEntryResolver, error) {
+	entries, err := r.computeEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cs := make(btypes.Changesets, 0, len(entries))
+	for _, entry := range entries {
+		cs = append(cs, entry.Changeset)
+	}
+	// 🚨 SECURITY: database.Repos.GetReposSetByIDs uses the authzFilter under the hood and
+	// filters out repositories that the user doesn't have access to.
+	reposByID, err := r.store.Repos().GetReposSetByIDs(ctx, cs.RepoIDs()...)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]graphqlbackend.BatchChangeMergeQueueEntryResolver, 0, len(entries))
+	for _, entry := range entries {
+		repo, ok := reposByID[entry.Changeset.RepoID]
+		if !ok {
+			continue
+		}
+		resolvers = append(resolvers, &batchChangeMergeQueueEntryResolver{
+			store:           r.store,
+			gitserverClient: r.gitserverClient,
+			logger:          r.logger,
+			entry:           entry,
+			repo:            repo,
+		})
+	}
+	return resolvers, nil
+}
+
+var _ graphqlbackend.BatchChangeMergeQueueEntryResolver = &batchChangeMergeQueueEntryResolver{}
+
+type batchChangeMergeQueueEntryResolver struct {
+	store           *store.Store
+	gitserverClient gitserver.Client
+	logger          log.Logger
+
+	entry *btypes.MergeQueueEntry
+	repo  *types.Repo
+}
+
+func (r *batchChangeMergeQueueEntryResolver) Changeset() graphqlbackend.ChangesetResolver {
+	return NewChangesetResolver(r.store, r.gitserverClient, r.logger, r.entry.Changeset, r.repo)
+}
+
+func (r *batchChangeMergeQueueEntryResolver) State() string {
+	return string(r.entry.State)
+}
+
+func (r *batchChangeMergeQueueEntryResolver) BlockedReason() *string {
+	if r.entry.BlockedReason == "" {
+		return nil
+	}
+	return &r.entry.BlockedReason
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
 	"strconv"
+	"time"
 
 	"github.com/graph-gophers/graphql-go"
 	"go.opentelemetry.io/otel/attribute"
@@ -932,7 +933,7 @@ func listChangesetOptsFromArgs(args *graphqlbackend.ListChangesetsArgs, batchCha
 			return opts, false, errors.New("invalid combination of state and onlyClosable")
 		}
 
-		opts.States = []btypes.ChangesetState{btypes.ChangesetStateOpen, btypes.ChangesetStateDraft}
+		opts.States = []btypes.ChangesetState{btypes.ChangesetStateOpen, btypes.ChangesetStateDraft, btypes.ChangesetStateConflicting}
 	}
 
 	if args.State != nil {
@@ -1555,6 +1556,70 @@ func (r *Resolver) PublishChangesets(ctx context.Context, args *graphqlbackend.P
 	return r.bulkOperationByIDString(ctx, bulkGroupID)
 }
 
+func (r *Resolver) EnableBatchChangeMergeQueue(ctx context.Context, args *graphqlbackend.EnableBatchChangeMergeQueueArgs) (_ graphqlbackend.BatchChangeMergeQueueResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.EnableBatchChangeMergeQueue", attribute.String("batchChange", string(args.BatchChange)))
+	defer tr.EndWithErr(&err)
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	batchChangeID, err := unmarshalBatchChangeID(args.BatchChange)
+	if err != nil {
+		return nil, err
+	}
+
+	if batchChangeID == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	svc := service.New(r.store)
+	// 🚨 SECURITY: EnableMergeQueue checks whether current user is authorized.
+	queue, err := svc.EnableMergeQueue(ctx, service.EnableMergeQueueOpts{
+		BatchChangeID: batchChangeID,
+		MaxMerges:     args.MergesPerWindow,
+		Window:        time.Duration(args.WindowMinutes) * time.Minute,
+		Squash:        args.Squash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchChangeMergeQueueResolver{store: r.store, gitserverClient: r.gitserverClient, logger: r.logger, queue: queue}, nil
+}
+
+func (r *Resolver) DisableBatchChangeMergeQueue(ctx context.Context, args *graphqlbackend.DisableBatchChangeMergeQueueArgs) (_ *graphqlbackend.EmptyResponse, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.DisableBatchChangeMergeQueue", attribute.String("batchChange", string(args.BatchChange)))
+	defer tr.EndWithErr(&err)
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	batchChangeID, err := unmarshalBatchChangeID(args.BatchChange)
+	if err != nil {
+		return nil, err
+	}
+
+	if batchChangeID == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	svc := service.New(r.store)
+	// 🚨 SECURITY: DisableMergeQueue checks whether current user is authorized.
+	if err := svc.DisableMergeQueue(ctx, batchChangeID); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
 func (r *Resolver) BatchSpecs(ctx context.Context, args *graphqlbackend.ListBatchSpecArgs) (_ graphqlbackend.BatchSpecConnectionResolver, err error) {
 	tr, ctx := trace.New(ctx, "Resolver.BatchSpecs",
 		attribute.Int("first", int(args.First)),
@@ -1780,6 +1780,7 @@ func TestListChangesetOptsFromArgs(t *testing.T) {
 				States: []btypes.ChangesetState{
 					btypes.ChangesetStateOpen,
 					btypes.ChangesetStateDraft,
+					btypes.ChangesetStateConflicting,
 				},
 			},
 		},
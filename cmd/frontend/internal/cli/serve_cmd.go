@@ -293,6 +293,8 @@ func makeExternalAPI(db database.DB, logger sglog.Logger, schema *graphql.Schema
 			CodeInsightsDataExportHandler:   enterprise.CodeInsightsDataExportHandler,
 			SearchJobsDataExportHandler:     enterprise.SearchJobsDataExportHandler,
 			SearchJobsLogsHandler:           enterprise.SearchJobsLogsHandler,
+			ExecutorJobLogsStreamHandler:    enterprise.ExecutorJobLogsStreamHandler,
+			ExecutorJobLogsGrepHandler:      enterprise.ExecutorJobLogsGrepHandler,
 			NewDotcomLicenseCheckHandler:    enterprise.NewDotcomLicenseCheckHandler,
 			NewChatCompletionsStreamHandler: enterprise.NewChatCompletionsStreamHandler,
 			NewCodeCompletionsHandler:       enterprise.NewCodeCompletionsHandler,
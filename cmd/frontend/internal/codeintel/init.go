@@ -23,6 +23,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/symbols"
 )
 
 func LoadConfig() {
@@ -134,6 +135,9 @@ func Init(
 	))
 	enterpriseServices.NewCodeIntelUploadHandler = newUploadHandler
 	enterpriseServices.RankingService = codeIntelServices.RankingService
+
+	// Serve type:symbol searches from precise indexes when available
+	symbols.DefaultPreciseSearcher = newPreciseSymbolSearcher(codeIntelServices.CodenavService)
 	return nil
 }
 
@@ -0,0 +1,336 @@
+package codeintel
+
+import (
+	"context"
+	"regexp/syntax"
+	"strings"
+
+	"github.com/go-enry/go-enry/v2"
+	"github.com/grafana/regexp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	codenavshared "github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/symbols"
+)
+
+// defaultPreciseSymbolLimit is used when a symbol search does not specify a limit.
+const defaultPreciseSymbolLimit = 100
+
+type symbolDefinitionSearcher interface {
+	SearchSymbols(ctx context.Context, repositoryID int, commit string, filter codenavshared.SymbolFilter, limit int) ([]codenavshared.SymbolDefinition, func(path string) bool, error)
+}
+
+// preciseSymbolSearcher reads type:symbol search results from the symbol definitions of
+// precise (SCIP) indexes.
+type preciseSymbolSearcher struct {
+	codenavSvc symbolDefinitionSearcher
+}
+
+var _ symbols.PreciseSearcher = &preciseSymbolSearcher{}
+
+func newPreciseSymbolSearcher(codenavSvc symbolDefinitionSearcher) *preciseSymbolSearcher {
+	return &preciseSymbolSearcher{codenavSvc: codenavSvc}
+}
+
+func (s *preciseSymbolSearcher) Search(ctx context.Context, repoID api.RepoID, args search.SymbolsParameters) (result.Symbols, func(path string) bool, error) {
+	matcher, err := newPreciseSymbolMatcher(args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	limit := args.First
+	if limit <= 0 {
+		limit = defaultPreciseSymbolLimit
+	}
+
+	definitions, covered, err := s.codenavSvc.SearchSymbols(ctx, int(repoID), string(args.CommitID), matcher.filter(), limit)
+	if err != nil || covered == nil {
+		return nil, nil, err
+	}
+
+	res := make(result.Symbols, 0, len(definitions))
+	for _, definition := range definitions {
+		if symbol, ok := preciseSymbolToResult(definition); ok {
+			res = append(res, symbol)
+		}
+	}
+
+	return res, covered, nil
+}
+
+// preciseSymbolMatcher applies the symbol name and path patterns of a symbol search to
+// SCIP symbols. The name pattern is matched against the name of the symbol's last
+// descriptor (e.g. `Bar` for `scip-go gomod example v1 foo/Bar#`), which is also the
+// name displayed for a symbol result.
+type preciseSymbolMatcher struct {
+	query           *regexp.Regexp
+	includePatterns []*regexp.Regexp
+	excludePattern  *regexp.Regexp
+	kinds           result.SymbolKindFilter
+
+	// nameSubstring is a substring of every matching symbol name, used to narrow down
+	// the symbols read from the database.
+	nameSubstring string
+	ignoreCase    bool
+}
+
+func newPreciseSymbolMatcher(args search.SymbolsParameters) (*preciseSymbolMatcher, error) {
+	compile := func(pattern string) (*regexp.Regexp, error) {
+		if !args.IsCaseSensitive {
+			pattern = "(?i:" + pattern + ")"
+		}
+		return regexp.Compile(pattern)
+	}
+
+	pattern := args.Query
+	nameSubstring, ignoreCase := args.Query, !args.IsCaseSensitive
+	if args.IsRegExp {
+		var foldCase bool
+		nameSubstring, foldCase = literalPrefix(pattern)
+		ignoreCase = ignoreCase || foldCase
+	} else {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	query, err := compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	// Names with backticks are escaped in SCIP symbols, so they do not contain the
+	// name verbatim.
+	if strings.Contains(nameSubstring, "`") {
+		nameSubstring = ""
+	}
+
+	includePatterns := make([]*regexp.Regexp, 0, len(args.IncludePatterns))
+	for _, includePattern := range args.IncludePatterns {
+		re, err := compile(includePattern)
+		if err != nil {
+			return nil, err
+		}
+		includePatterns = append(includePatterns, re)
+	}
+
+	var excludePattern *regexp.Regexp
+	if args.ExcludePattern != "" {
+		if excludePattern, err = compile(args.ExcludePattern); err != nil {
+			return nil, err
+		}
+	}
+
+	return &preciseSymbolMatcher{
+		query:           query,
+		includePatterns: includePatterns,
+		excludePattern:  excludePattern,
+		kinds:           args.Kinds,
+		nameSubstring:   nameSubstring,
+		ignoreCase:      ignoreCase,
+	}, nil
+}
+
+// literalPrefix returns the literal that every match of the given regular expression starts
+// with, and whether the literal is matched case-insensitively. It returns an empty literal if
+// the pattern is invalid or has no literal prefix.
+func literalPrefix(pattern string) (string, bool) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+	re = re.Simplify()
+
+	for re.Op == syntax.OpConcat || re.Op == syntax.OpCapture {
+		if re.Op == syntax.OpConcat {
+			// Skip leading anchors, which do not consume any text.
+			subs := re.Sub
+			for len(subs) > 0 && (subs[0].Op == syntax.OpBeginText || subs[0].Op == syntax.OpBeginLine) {
+				subs = subs[1:]
+			}
+			if len(subs) == 0 {
+				return "", false
+			}
+			re = subs[0]
+		} else {
+			re = re.Sub[0]
+		}
+	}
+
+	if re.Op != syntax.OpLiteral {
+		return "", false
+	}
+	return string(re.Rune), re.Flags&syntax.FoldCase != 0
+}
+
+func (m *preciseSymbolMatcher) filter() codenavshared.SymbolFilter {
+	filter := codenavshared.SymbolFilter{
+		NameSubstring: m.nameSubstring,
+		IgnoreCase:    m.ignoreCase,
+		Match:         m.match,
+	}
+	if !m.kinds.IsEmpty() {
+		filter.MatchKind = m.matchKind
+	}
+	return filter
+}
+
+func (m *preciseSymbolMatcher) match(path, symbolName string) bool {
+	for _, includePattern := range m.includePatterns {
+		if !includePattern.MatchString(path) {
+			return false
+		}
+	}
+	if m.excludePattern != nil && m.excludePattern.MatchString(path) {
+		return false
+	}
+
+	descriptors, ok := parseSearchableSymbol(symbolName)
+	if !ok {
+		return false
+	}
+
+	return m.query.MatchString(descriptors[len(descriptors)-1].Name)
+}
+
+func (m *preciseSymbolMatcher) matchKind(symbolName string, kind scip.SymbolInformation_Kind) bool {
+	descriptors, ok := parseSearchableSymbol(symbolName)
+	if !ok {
+		return false
+	}
+
+	return m.kinds.Matches(result.Symbol{Kind: symbolKind(kind, descriptors)})
+}
+
+// parseSearchableSymbol returns the descriptors of the given SCIP symbol. The returned
+// flag is false for symbols that should not appear in symbol search results, such as
+// malformed symbols and parameters.
+func parseSearchableSymbol(symbolName string) ([]*scip.Descriptor, bool) {
+	symbol, err := scip.ParseSymbol(symbolName)
+	if err != nil || len(symbol.Descriptors) == 0 {
+		return nil, false
+	}
+
+	switch symbol.Descriptors[len(symbol.Descriptors)-1].Suffix {
+	case scip.Descriptor_Parameter, scip.Descriptor_Local, scip.Descriptor_Meta:
+		return nil, false
+	}
+
+	return symbol.Descriptors, true
+}
+
+// preciseSymbolToResult converts a precise symbol definition into a symbol search result.
+// Lines are 0-indexed, as with results of the symbols service.
+func preciseSymbolToResult(definition codenavshared.SymbolDefinition) (result.Symbol, bool) {
+	descriptors, ok := parseSearchableSymbol(definition.Symbol)
+	if !ok {
+		return result.Symbol{}, false
+	}
+
+	var parent, parentKind string
+	if n := len(descriptors); n > 1 {
+		parent = descriptors[n-2].Name
+		parentKind = descriptorKind(descriptors[:n-1])
+	}
+
+	language, _ := enry.GetLanguageByExtension(definition.Path)
+
+	return result.Symbol{
+		Name:               descriptors[len(descriptors)-1].Name,
+		FullyQualifiedName: definition.Symbol,
+		Path:               definition.Path,
+		Line:               definition.Range.Start.Line,
+		Character:          definition.Range.Start.Character,
+		Kind:               symbolKind(definition.Kind, descriptors),
+		Language:           language,
+		Parent:             parent,
+		ParentKind:         parentKind,
+		Provenance:         result.SymbolProvenancePrecise,
+	}, true
+}
+
+// symbolKind returns the kind of a symbol. It uses the kind reported by the indexer when
+// there is one, and otherwise infers the kind from the symbol's descriptors.
+func symbolKind(kind scip.SymbolInformation_Kind, descriptors []*scip.Descriptor) string {
+	if name, ok := scipKindNames[kind]; ok {
+		return name
+	}
+	return descriptorKind(descriptors)
+}
+
+// scipKindNames maps the kinds of SCIP symbol information to the ctags names understood by
+// result.Symbol. Kinds without a ctags equivalent are inferred from descriptors instead.
+var scipKindNames = map[scip.SymbolInformation_Kind]string{
+	scip.SymbolInformation_Array:          "array",
+	scip.SymbolInformation_Boolean:        "boolean",
+	scip.SymbolInformation_Class:          "class",
+	scip.SymbolInformation_Constant:       "constant",
+	scip.SymbolInformation_Constructor:    "constructor",
+	scip.SymbolInformation_Enum:           "enum",
+	scip.SymbolInformation_EnumMember:     "enum member",
+	scip.SymbolInformation_Event:          "event",
+	scip.SymbolInformation_Field:          "field",
+	scip.SymbolInformation_File:           "file",
+	scip.SymbolInformation_Function:       "function",
+	scip.SymbolInformation_Getter:         "method",
+	scip.SymbolInformation_Interface:      "interface",
+	scip.SymbolInformation_Key:            "key",
+	scip.SymbolInformation_Macro:          "macro",
+	scip.SymbolInformation_Message:        "message",
+	scip.SymbolInformation_Method:         "method",
+	scip.SymbolInformation_Module:         "module",
+	scip.SymbolInformation_Namespace:      "namespace",
+	scip.SymbolInformation_Null:           "null",
+	scip.SymbolInformation_Number:         "number",
+	scip.SymbolInformation_Object:         "object",
+	scip.SymbolInformation_Operator:       "operator",
+	scip.SymbolInformation_Package:        "package",
+	scip.SymbolInformation_PackageObject:  "package",
+	scip.SymbolInformation_Property:       "property",
+	scip.SymbolInformation_Protocol:       "interface",
+	scip.SymbolInformation_Setter:         "method",
+	scip.SymbolInformation_String:         "string",
+	scip.SymbolInformation_Struct:         "struct",
+	scip.SymbolInformation_Trait:          "interface",
+	scip.SymbolInformation_Type:           "type",
+	scip.SymbolInformation_TypeAlias:      "typedef",
+	scip.SymbolInformation_TypeClass:      "interface",
+	scip.SymbolInformation_TypeParameter:  "type parameter",
+	scip.SymbolInformation_Union:          "union",
+	scip.SymbolInformation_Value:          "variable",
+	scip.SymbolInformation_Variable:       "variable",
+	scip.SymbolInformation_MethodReceiver: "method",
+}
+
+// descriptorKind returns the symbol kind of the last of the given descriptors. SCIP symbol
+// names only distinguish a few kinds of descriptors, so we use the enclosing descriptor to
+// tell apart e.g. methods from functions. The returned kinds use the ctags names understood
+// by result.Symbol, so that select:symbol.<kind> and symbol.kind: behave the same for
+// precise and search-based symbols.
+func descriptorKind(descriptors []*scip.Descriptor) string {
+	n := len(descriptors)
+	inType := n > 1 && descriptors[n-2].Suffix == scip.Descriptor_Type
+
+	switch descriptors[n-1].Suffix {
+	case scip.Descriptor_Namespace:
+		return "namespace"
+	case scip.Descriptor_Type:
+		return "type"
+	case scip.Descriptor_Method:
+		if inType {
+			return "method"
+		}
+		return "function"
+	case scip.Descriptor_Term:
+		if inType {
+			return "field"
+		}
+		return "variable"
+	case scip.Descriptor_TypeParameter:
+		return "type parameter"
+	case scip.Descriptor_Macro:
+		return "macro"
+	}
+
+	return ""
+}
@@ -0,0 +1,259 @@
+package codeintel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	codenavshared "github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+type fakeSymbolDefinitionSearcher struct {
+	hasIndex    bool
+	gotCommit   string
+	gotRepoID   int
+	gotLimit    int
+	gotFilter   codenavshared.SymbolFilter
+	definitions []codenavshared.SymbolDefinition
+}
+
+func (f *fakeSymbolDefinitionSearcher) SearchSymbols(_ context.Context, repositoryID int, commit string, filter codenavshared.SymbolFilter, limit int) ([]codenavshared.SymbolDefinition, func(path string) bool, error) {
+	f.gotRepoID, f.gotCommit, f.gotLimit, f.gotFilter = repositoryID, commit, limit, filter
+	if !f.hasIndex {
+		return nil, nil, nil
+	}
+
+	var definitions []codenavshared.SymbolDefinition
+	for _, definition := range f.definitions {
+		if !filter.Match(definition.Path, definition.Symbol) {
+			continue
+		}
+		if filter.MatchKind != nil && !filter.MatchKind(definition.Symbol, definition.Kind) {
+			continue
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions, func(path string) bool { return true }, nil
+}
+
+func TestPreciseSymbolSearcher(t *testing.T) {
+	definition := func(path, symbol string, line, character int) codenavshared.SymbolDefinition {
+		return codenavshared.SymbolDefinition{
+			Path:   path,
+			Symbol: symbol,
+			Range: codenavshared.Range{
+				Start: codenavshared.Position{Line: line, Character: character},
+				End:   codenavshared.Position{Line: line, Character: character + 3},
+			},
+		}
+	}
+
+	svc := &fakeSymbolDefinitionSearcher{
+		hasIndex: true,
+		definitions: []codenavshared.SymbolDefinition{
+			definition("foo/server.go", "scip-go gomod example v1 `example/foo`/", 0, 8),
+			definition("foo/server.go", "scip-go gomod example v1 `example/foo`/Server#", 10, 5),
+			definition("foo/server.go", "scip-go gomod example v1 `example/foo`/Server#Serve().", 20, 18),
+			definition("foo/server.go", "scip-go gomod example v1 `example/foo`/Server#serverName.", 11, 1),
+			definition("foo/server.go", "scip-go gomod example v1 `example/foo`/NewServer().", 30, 5),
+			definition("foo/server.go", "scip-go gomod example v1 `example/foo`/NewServer().(name)", 30, 15),
+			definition("foo/server_test.go", "scip-go gomod example v1 `example/foo`/TestServer().", 5, 5),
+		},
+	}
+	// Kinds reported by the indexer take precedence over kinds inferred from descriptors.
+	svc.definitions[1].Kind = scip.SymbolInformation_Struct
+	searcher := newPreciseSymbolSearcher(svc)
+
+	t.Run("kinds", func(t *testing.T) {
+		symbols, covered, err := searcher.Search(context.Background(), 42, search.SymbolsParameters{
+			CommitID: "deadbeef",
+			Query:    "serve",
+			IsRegExp: true,
+			First:    10,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if covered == nil {
+			t.Fatalf("expected precise index to be found")
+		}
+		if svc.gotRepoID != 42 || svc.gotCommit != "deadbeef" || svc.gotLimit != 10 {
+			t.Errorf("unexpected arguments. repoID=%d commit=%q limit=%d", svc.gotRepoID, svc.gotCommit, svc.gotLimit)
+		}
+
+		expected := result.Symbols{
+			{
+				Name:               "Server",
+				FullyQualifiedName: "scip-go gomod example v1 `example/foo`/Server#",
+				Path:               "foo/server.go",
+				Line:               10,
+				Character:          5,
+				Kind:               "struct",
+				Language:           "Go",
+				Parent:             "example/foo",
+				ParentKind:         "namespace",
+				Provenance:         result.SymbolProvenancePrecise,
+			},
+			{
+				Name:               "Serve",
+				FullyQualifiedName: "scip-go gomod example v1 `example/foo`/Server#Serve().",
+				Path:               "foo/server.go",
+				Line:               20,
+				Character:          18,
+				Kind:               "method",
+				Language:           "Go",
+				Parent:             "Server",
+				ParentKind:         "type",
+				Provenance:         result.SymbolProvenancePrecise,
+			},
+			{
+				Name:               "serverName",
+				FullyQualifiedName: "scip-go gomod example v1 `example/foo`/Server#serverName.",
+				Path:               "foo/server.go",
+				Line:               11,
+				Character:          1,
+				Kind:               "field",
+				Language:           "Go",
+				Parent:             "Server",
+				ParentKind:         "type",
+				Provenance:         result.SymbolProvenancePrecise,
+			},
+			{
+				Name:               "NewServer",
+				FullyQualifiedName: "scip-go gomod example v1 `example/foo`/NewServer().",
+				Path:               "foo/server.go",
+				Line:               30,
+				Character:          5,
+				Kind:               "function",
+				Language:           "Go",
+				Parent:             "example/foo",
+				ParentKind:         "namespace",
+				Provenance:         result.SymbolProvenancePrecise,
+			},
+			{
+				Name:               "TestServer",
+				FullyQualifiedName: "scip-go gomod example v1 `example/foo`/TestServer().",
+				Path:               "foo/server_test.go",
+				Line:               5,
+				Character:          5,
+				Kind:               "function",
+				Language:           "Go",
+				Parent:             "example/foo",
+				ParentKind:         "namespace",
+				Provenance:         result.SymbolProvenancePrecise,
+			},
+		}
+		if diff := cmp.Diff(expected, symbols); diff != "" {
+			t.Errorf("unexpected symbols (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("patterns", func(t *testing.T) {
+		symbols, _, err := searcher.Search(context.Background(), 42, search.SymbolsParameters{
+			CommitID:        "deadbeef",
+			Query:           "^Serve",
+			IsRegExp:        true,
+			IsCaseSensitive: true,
+			IncludePatterns: []string{`\.go$`},
+			ExcludePattern:  `_test\.go$`,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		var names []string
+		for _, symbol := range symbols {
+			names = append(names, symbol.Name)
+		}
+		if diff := cmp.Diff([]string{"Server", "Serve"}, names); diff != "" {
+			t.Errorf("unexpected symbols (-want +got):\n%s", diff)
+		}
+		if svc.gotLimit != defaultPreciseSymbolLimit {
+			t.Errorf("unexpected limit. want=%d have=%d", defaultPreciseSymbolLimit, svc.gotLimit)
+		}
+	})
+
+	t.Run("symbol kinds", func(t *testing.T) {
+		symbols, _, err := searcher.Search(context.Background(), 42, search.SymbolsParameters{
+			Query:    "serve",
+			IsRegExp: true,
+			Kinds:    result.SymbolKindFilter{Include: []string{"function", "method", "struct"}, Exclude: []string{"method"}},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		var names []string
+		for _, symbol := range symbols {
+			names = append(names, symbol.Name)
+		}
+		if diff := cmp.Diff([]string{"Server", "NewServer", "TestServer"}, names); diff != "" {
+			t.Errorf("unexpected symbols (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("no symbol kinds", func(t *testing.T) {
+		if _, _, err := searcher.Search(context.Background(), 42, search.SymbolsParameters{Query: "serve"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if svc.gotFilter.MatchKind != nil {
+			t.Errorf("expected no kind filter without symbol.kind: values")
+		}
+	})
+
+	t.Run("literal", func(t *testing.T) {
+		symbols, _, err := searcher.Search(context.Background(), 42, search.SymbolsParameters{
+			CommitID: "deadbeef",
+			Query:    "new.",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(symbols) != 0 {
+			t.Errorf("expected literal pattern not to match, got %d symbols", len(symbols))
+		}
+	})
+
+	t.Run("name substring", func(t *testing.T) {
+		for _, testCase := range []struct {
+			query              string
+			isRegExp           bool
+			expected           string
+			expectedIgnoreCase bool
+		}{
+			{query: "new.", expected: "new."},
+			{query: "^Serve(r|)$", isRegExp: true, expected: "Serve"},
+			{query: "(Serve)r", isRegExp: true, expected: "Serve"},
+			{query: "(?i)serve", isRegExp: true, expected: "SERVE", expectedIgnoreCase: true},
+			{query: "Serve|New", isRegExp: true, expected: ""},
+			{query: "a`b", expected: ""},
+		} {
+			if _, _, err := searcher.Search(context.Background(), 42, search.SymbolsParameters{
+				Query:           testCase.query,
+				IsRegExp:        testCase.isRegExp,
+				IsCaseSensitive: true,
+			}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if svc.gotFilter.NameSubstring != testCase.expected {
+				t.Errorf("unexpected name substring for %q. want=%q have=%q", testCase.query, testCase.expected, svc.gotFilter.NameSubstring)
+			}
+			if svc.gotFilter.IgnoreCase != testCase.expectedIgnoreCase {
+				t.Errorf("unexpected case sensitivity for %q. want=%v have=%v", testCase.query, testCase.expectedIgnoreCase, svc.gotFilter.IgnoreCase)
+			}
+		}
+	})
+
+	t.Run("no index", func(t *testing.T) {
+		svc := &fakeSymbolDefinitionSearcher{}
+		if _, covered, err := newPreciseSymbolSearcher(svc).Search(context.Background(), 42, search.SymbolsParameters{Query: "x"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if covered != nil {
+			t.Errorf("expected no precise index to be found")
+		}
+	})
+}
@@ -59,6 +59,7 @@ type Action struct {
 	Email        *ActionEmail
 	Webhook      *ActionWebhook
 	SlackWebhook *ActionSlackWebhook
+	ChatWebhook  *ActionChatWebhook
 }
 
 func (a *Action) UnmarshalJSON(b []byte) error {
@@ -80,6 +81,9 @@ func (a *Action) UnmarshalJSON(b []byte) error {
 	case "MonitorSlackWebhook":
 		a.SlackWebhook = &ActionSlackWebhook{}
 		return json.Unmarshal(b, &a.SlackWebhook)
+	case "MonitorChatWebhook":
+		a.ChatWebhook = &ActionChatWebhook{}
+		return json.Unmarshal(b, &a.ChatWebhook)
 	default:
 		return errors.Errorf("unexpected typename %q", t.TypeName)
 	}
@@ -108,6 +112,14 @@ type ActionSlackWebhook struct {
 	Events  ActionEventConnection
 }
 
+type ActionChatWebhook struct {
+	Id      string
+	Enabled bool
+	Format  string
+	URL     string
+	Events  ActionEventConnection
+}
+
 type RecipientsConnection struct {
 	Nodes      []UserOrg
 	TotalCount int
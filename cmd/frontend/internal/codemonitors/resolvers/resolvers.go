@@ -8,13 +8,16 @@ import (
 	"github.com/graph-gophers/graphql-go"
 	"github.com/graph-gophers/graphql-go/relay"
 	"github.com/sourcegraph/log"
+	"golang.org/x/exp/slices"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/internal/codemonitors/background"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -126,10 +129,15 @@ func (r *Resolver) CreateCodeMonitor(ctx context.Context, args *graphqlbackend.C
 		return nil, err
 	}
 
+	pathTrigger, err := unmarshalPathTrigger(args.Trigger)
+	if err != nil {
+		return nil, err
+	}
+
 	// Snapshot the state of the searched repos when the monitor is created so that
 	// we can distinguish new repos. We run the snapshot outside the transaction because
 	// search requires that the DB handle is not a transaction.
-	resolvedRevisions, err := codemonitors.Snapshot(ctx, r.logger, r.db, args.Trigger.Query)
+	resolvedRevisions, err := r.snapshot(ctx, r.db, args.Trigger.Query, pathTrigger)
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +157,11 @@ func (r *Resolver) CreateCodeMonitor(ctx context.Context, args *graphqlbackend.C
 		}
 
 		// Create trigger.
-		_, err = tx.db.CodeMonitors().CreateQueryTrigger(ctx, m.ID, args.Trigger.Query)
+		if pathTrigger != nil {
+			_, err = tx.db.CodeMonitors().CreatePathTrigger(ctx, m.ID, *pathTrigger)
+		} else {
+			_, err = tx.db.CodeMonitors().CreateQueryTrigger(ctx, m.ID, args.Trigger.Query)
+		}
 		if err != nil {
 			return err
 		}
@@ -277,6 +289,7 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 				IncludeResults: a.Email.IncludeResults,
 				Priority:       a.Email.Priority,
 				Header:         a.Email.Header,
+				Schedule:       database.ActionSchedule(a.Email.Schedule),
 			})
 			if err != nil {
 				return err
@@ -286,7 +299,7 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 				return err
 			}
 		case a.Webhook != nil:
-			_, err := r.db.CodeMonitors().CreateWebhookAction(ctx, monitorID, a.Webhook.Enabled, a.Webhook.IncludeResults, a.Webhook.URL)
+			_, err := r.db.CodeMonitors().CreateWebhookAction(ctx, monitorID, a.Webhook.Enabled, a.Webhook.IncludeResults, a.Webhook.URL, database.ActionSchedule(a.Webhook.Schedule))
 			if err != nil {
 				return err
 			}
@@ -294,19 +307,27 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 			if err := validateSlackURL(a.SlackWebhook.URL); err != nil {
 				return err
 			}
-			_, err := r.db.CodeMonitors().CreateSlackWebhookAction(ctx, monitorID, a.SlackWebhook.Enabled, a.SlackWebhook.IncludeResults, a.SlackWebhook.URL)
+			_, err := r.db.CodeMonitors().CreateSlackWebhookAction(ctx, monitorID, a.SlackWebhook.Enabled, a.SlackWebhook.IncludeResults, a.SlackWebhook.URL, database.ActionSchedule(a.SlackWebhook.Schedule))
+			if err != nil {
+				return err
+			}
+		case a.ChatWebhook != nil:
+			if err := validateChatWebhookURL(a.ChatWebhook.URL); err != nil {
+				return err
+			}
+			_, err := r.db.CodeMonitors().CreateChatWebhookAction(ctx, monitorID, chatWebhookActionArgs(a.ChatWebhook))
 			if err != nil {
 				return err
 			}
 		default:
-			return errors.New("exactly one of Email, Webhook, or SlackWebhook must be set")
+			return errors.New("exactly one of Email, Webhook, SlackWebhook, or ChatWebhook must be set")
 		}
 	}
 	return nil
 }
 
 func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []graphql.ID) error {
-	var email, webhook, slackWebhook []int64
+	var email, webhook, slackWebhook, chatWebhook []int64
 	for _, id := range ids {
 		var intID int64
 		err := relay.UnmarshalSpec(id, &intID)
@@ -321,8 +342,10 @@ func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []gra
 			webhook = append(webhook, intID)
 		case monitorActionSlackWebhookKind:
 			slackWebhook = append(slackWebhook, intID)
+		case monitorActionChatWebhookKind:
+			chatWebhook = append(chatWebhook, intID)
 		default:
-			return errors.New("action IDs must be exactly one of email, webhook, or slack webhook")
+			return errors.New("action IDs must be exactly one of email, webhook, slack webhook, or chat webhook")
 		}
 	}
 
@@ -338,6 +361,10 @@ func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []gra
 		return err
 	}
 
+	if err := r.db.CodeMonitors().DeleteChatWebhookActions(ctx, monitorID, chatWebhook...); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -418,6 +445,23 @@ func (r *Resolver) TriggerTestSlackWebhookAction(ctx context.Context, args *grap
 	return &graphqlbackend.EmptyResponse{}, nil
 }
 
+func (r *Resolver) TriggerTestChatWebhookAction(ctx context.Context, args *graphqlbackend.TriggerTestChatWebhookActionArgs) (*graphqlbackend.EmptyResponse, error) {
+	err := r.isAllowedToCreate(ctx, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateChatWebhookURL(args.ChatWebhook.URL); err != nil {
+		return nil, err
+	}
+
+	if err := background.SendTestChatWebhook(ctx, httpcli.ExternalDoer, database.ChatWebhookFormat(args.ChatWebhook.Format), args.Description, args.ChatWebhook.URL); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
 func sendTestEmail(ctx context.Context, db database.DB, recipient graphql.ID, description string) error {
 	var (
 		userID int32
@@ -449,7 +493,11 @@ func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int
 	if err != nil {
 		return nil, err
 	}
-	ids := make([]graphql.ID, 0, len(emailActions)+len(webhookActions)+len(slackWebhookActions))
+	chatWebhookActions, err := r.db.CodeMonitors().ListChatWebhookActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]graphql.ID, 0, len(emailActions)+len(webhookActions)+len(slackWebhookActions)+len(chatWebhookActions))
 	for _, emailAction := range emailActions {
 		ids = append(ids, (&monitorEmail{EmailAction: emailAction}).ID())
 	}
@@ -459,6 +507,9 @@ func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int
 	for _, slackWebhookAction := range slackWebhookActions {
 		ids = append(ids, (&monitorSlackWebhook{SlackWebhookAction: slackWebhookAction}).ID())
 	}
+	for _, chatWebhookAction := range chatWebhookActions {
+		ids = append(ids, (&monitorChatWebhook{ChatWebhookAction: chatWebhookAction}).ID())
+	}
 	return ids, nil
 }
 
@@ -503,6 +554,16 @@ func splitActionIDs(args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []grap
 			}
 			toUpdateActions = append(toUpdateActions, a)
 			delete(aMap, *a.SlackWebhook.Id)
+		case a.ChatWebhook != nil:
+			if a.ChatWebhook.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{ChatWebhook: a.ChatWebhook.Update})
+				continue
+			}
+			if _, ok := aMap[*a.ChatWebhook.Id]; !ok {
+				return nil, nil, errors.Errorf("unknown ID=%s for action", *a.ChatWebhook.Id)
+			}
+			toUpdateActions = append(toUpdateActions, a)
+			delete(aMap, *a.ChatWebhook.Id)
 		}
 	}
 
@@ -513,6 +574,51 @@ func splitActionIDs(args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []grap
 	return toCreate, toDelete, nil
 }
 
+// snapshot returns the commits of the repos searched by the given trigger,
+// which is a path trigger if pathTrigger is set.
+func (r *Resolver) snapshot(ctx context.Context, db database.DB, query string, pathTrigger *database.PathTrigger) (map[api.RepoID][]string, error) {
+	if pathTrigger != nil {
+		return codemonitors.SnapshotPaths(ctx, db, gitserver.NewClient("graphql.codemonitors"), *pathTrigger)
+	}
+	return codemonitors.Snapshot(ctx, r.logger, db, query)
+}
+
+// unmarshalPathTrigger returns the path trigger of the given trigger input, or
+// nil if the trigger is a query.
+func unmarshalPathTrigger(args *graphqlbackend.CreateTriggerArgs) (*database.PathTrigger, error) {
+	if args.Paths == nil {
+		return nil, nil
+	}
+	if args.Query != "" {
+		return nil, errors.New("a trigger cannot have both a query and paths")
+	}
+	repoIDs, err := graphqlbackend.UnmarshalRepositoryIDs(args.Paths.Repositories)
+	if err != nil {
+		return nil, err
+	}
+	pathTrigger := &database.PathTrigger{
+		RepoIDs:      repoIDs,
+		PathPatterns: []string{},
+	}
+	if args.Paths.PathPatterns != nil {
+		pathTrigger.PathPatterns = *args.Paths.PathPatterns
+	}
+	if args.Paths.Owner != nil {
+		pathTrigger.Owner = *args.Paths.Owner
+	}
+	if err := codemonitors.ValidatePathTrigger(*pathTrigger); err != nil {
+		return nil, err
+	}
+	return pathTrigger, nil
+}
+
+func pathTriggersEqual(a, b *database.PathTrigger) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Owner == b.Owner && slices.Equal(a.RepoIDs, b.RepoIDs) && slices.Equal(a.PathPatterns, b.PathPatterns)
+}
+
 // updateCodeMonitor updates the code monitor in the database. We pass in "rawDB" because Snapshot requires that the
 // database being used is not in a transaction, and updateCodeMonitor is run with a transacted resolver.
 func (r *Resolver) updateCodeMonitor(ctx context.Context, rawDB database.DB, args *graphqlbackend.UpdateCodeMonitorArgs) (*monitor, error) {
@@ -547,13 +653,18 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, rawDB database.DB, arg
 		return nil, err
 	}
 
+	pathTrigger, err := unmarshalPathTrigger(args.Trigger.Update)
+	if err != nil {
+		return nil, err
+	}
+
 	// When the query is changed, take a new snapshot of the commits that currently
 	// exist so we know where to start.
-	if currentTrigger.QueryString != args.Trigger.Update.Query {
+	if currentTrigger.QueryString != args.Trigger.Update.Query || !pathTriggersEqual(currentTrigger.PathTrigger, pathTrigger) {
 		// Snapshot the state of the searched repos when the monitor is created so that
 		// we can distinguish new repos.
 		// NOTE: we use rawDB here because Snapshot requires that the db conn is not a transaction.
-		resolvedRevisions, err := codemonitors.Snapshot(ctx, r.logger, rawDB, args.Trigger.Update.Query)
+		resolvedRevisions, err := r.snapshot(ctx, rawDB, args.Trigger.Update.Query, pathTrigger)
 		if err != nil {
 			return nil, err
 		}
@@ -566,7 +677,11 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, rawDB database.DB, arg
 	}
 
 	// Update trigger.
-	err = r.db.CodeMonitors().UpdateQueryTrigger(ctx, triggerID, args.Trigger.Update.Query)
+	if pathTrigger != nil {
+		err = r.db.CodeMonitors().UpdatePathTrigger(ctx, triggerID, *pathTrigger)
+	} else {
+		err = r.db.CodeMonitors().UpdateQueryTrigger(ctx, triggerID, args.Trigger.Update.Query)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -589,8 +704,13 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, rawDB database.DB, arg
 				return nil, err
 			}
 			err = r.updateSlackWebhookAction(ctx, *action.SlackWebhook)
+		case action.ChatWebhook != nil:
+			if err := validateChatWebhookURL(action.ChatWebhook.Update.URL); err != nil {
+				return nil, err
+			}
+			err = r.updateChatWebhookAction(ctx, *action.ChatWebhook)
 		default:
-			err = errors.New("action must be one of email, webhook, or slack webhook")
+			err = errors.New("action must be one of email, webhook, slack webhook, or chat webhook")
 		}
 		if err != nil {
 			return nil, err
@@ -617,6 +737,7 @@ func (r *Resolver) updateEmailAction(ctx context.Context, args graphqlbackend.Ed
 		IncludeResults: args.Update.IncludeResults,
 		Priority:       args.Update.Priority,
 		Header:         args.Update.Header,
+		Schedule:       database.ActionSchedule(args.Update.Schedule),
 	})
 	if err != nil {
 		return err
@@ -631,7 +752,7 @@ func (r *Resolver) updateWebhookAction(ctx context.Context, args graphqlbackend.
 		return err
 	}
 
-	_, err = r.db.CodeMonitors().UpdateWebhookAction(ctx, id, args.Update.Enabled, args.Update.IncludeResults, args.Update.URL)
+	_, err = r.db.CodeMonitors().UpdateWebhookAction(ctx, id, args.Update.Enabled, args.Update.IncludeResults, args.Update.URL, database.ActionSchedule(args.Update.Schedule))
 	return err
 }
 
@@ -642,10 +763,31 @@ func (r *Resolver) updateSlackWebhookAction(ctx context.Context, args graphqlbac
 		return err
 	}
 
-	_, err = r.db.CodeMonitors().UpdateSlackWebhookAction(ctx, id, args.Update.Enabled, args.Update.IncludeResults, args.Update.URL)
+	_, err = r.db.CodeMonitors().UpdateSlackWebhookAction(ctx, id, args.Update.Enabled, args.Update.IncludeResults, args.Update.URL, database.ActionSchedule(args.Update.Schedule))
 	return err
 }
 
+func (r *Resolver) updateChatWebhookAction(ctx context.Context, args graphqlbackend.EditActionChatWebhookArgs) error {
+	var id int64
+	err := relay.UnmarshalSpec(*args.Id, &id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.CodeMonitors().UpdateChatWebhookAction(ctx, id, chatWebhookActionArgs(args.Update))
+	return err
+}
+
+func chatWebhookActionArgs(args *graphqlbackend.CreateActionChatWebhookArgs) *database.ChatWebhookActionArgs {
+	return &database.ChatWebhookActionArgs{
+		Format:         database.ChatWebhookFormat(args.Format),
+		Enabled:        args.Enabled,
+		IncludeResults: args.IncludeResults,
+		URL:            args.URL,
+		Schedule:       database.ActionSchedule(args.Schedule),
+	}
+}
+
 func (r *Resolver) withTransact(ctx context.Context, f func(*Resolver) error) error {
 	return r.db.WithTransact(ctx, func(tx database.DB) error {
 		return f(&Resolver{
@@ -725,13 +867,16 @@ func (m *monitorConnection) PageInfo() *graphqlutil.PageInfo {
 const (
 	MonitorKind                        = "CodeMonitor"
 	monitorTriggerQueryKind            = "CodeMonitorTriggerQuery"
+	monitorPathTriggerKind             = "CodeMonitorPathTrigger"
 	monitorTriggerEventKind            = "CodeMonitorTriggerEvent"
 	monitorActionEmailKind             = "CodeMonitorActionEmail"
 	monitorActionWebhookKind           = "CodeMonitorActionWebhook"
 	monitorActionSlackWebhookKind      = "CodeMonitorActionSlackWebhook"
+	monitorActionChatWebhookKind       = "CodeMonitorActionChatWebhook"
 	monitorActionEmailEventKind        = "CodeMonitorActionEmailEvent"
 	monitorActionWebhookEventKind      = "CodeMonitorActionWebhookEvent"
 	monitorActionSlackWebhookEventKind = "CodeMonitorActionSlackWebhookEvent"
+	monitorActionChatWebhookEventKind  = "CodeMonitorActionChatWebhookEvent"
 	monitorActionEmailRecipientKind    = "CodeMonitorActionEmailRecipient"
 )
 
@@ -799,7 +944,10 @@ func (m *monitor) Trigger(ctx context.Context) (graphqlbackend.MonitorTrigger, e
 	if err != nil {
 		return nil, err
 	}
-	return &monitorTrigger{&monitorQuery{m.Resolver, t}}, nil
+	if t.PathTrigger != nil {
+		return &monitorTrigger{pathTrigger: &monitorPathTrigger{monitorQuery{m.Resolver, t}}}, nil
+	}
+	return &monitorTrigger{query: &monitorQuery{m.Resolver, t}}, nil
 }
 
 func (m *monitor) Actions(ctx context.Context, args *graphqlbackend.ListActionArgs) (graphqlbackend.MonitorActionConnectionResolver, error) {
@@ -824,7 +972,12 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 		return nil, err
 	}
 
-	actions := make([]graphqlbackend.MonitorAction, 0, len(es)+len(ws)+len(sws))
+	cws, err := r.db.CodeMonitors().ListChatWebhookActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]graphqlbackend.MonitorAction, 0, len(es)+len(ws)+len(sws)+len(cws))
 	for _, e := range es {
 		actions = append(actions, &action{
 			email: &monitorEmail{
@@ -852,6 +1005,15 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 			},
 		})
 	}
+	for _, cw := range cws {
+		actions = append(actions, &action{
+			chatWebhook: &monitorChatWebhook{
+				Resolver:          r,
+				ChatWebhookAction: cw,
+				triggerEventID:    triggerEventID,
+			},
+		})
+	}
 
 	totalCount := len(actions)
 	if args.After != nil {
@@ -872,13 +1034,18 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 
 // MonitorTrigger <<UNION>>
 type monitorTrigger struct {
-	query graphqlbackend.MonitorQueryResolver
+	query       graphqlbackend.MonitorQueryResolver
+	pathTrigger graphqlbackend.MonitorPathTriggerResolver
 }
 
 func (t *monitorTrigger) ToMonitorQuery() (graphqlbackend.MonitorQueryResolver, bool) {
 	return t.query, t.query != nil
 }
 
+func (t *monitorTrigger) ToMonitorPathTrigger() (graphqlbackend.MonitorPathTriggerResolver, bool) {
+	return t.pathTrigger, t.pathTrigger != nil
+}
+
 // Query
 type monitorQuery struct {
 	*Resolver
@@ -921,6 +1088,44 @@ func (q *monitorQuery) Events(ctx context.Context, args *graphqlbackend.ListEven
 	return &monitorTriggerEventConnection{Resolver: q.Resolver, events: events, totalCount: totalCount}, nil
 }
 
+// PathTrigger
+type monitorPathTrigger struct {
+	// Path triggers share the events of query triggers.
+	monitorQuery
+}
+
+func (p *monitorPathTrigger) ID() graphql.ID {
+	return relay.MarshalID(monitorPathTriggerKind, p.QueryTrigger.ID)
+}
+
+func (p *monitorPathTrigger) Repositories(ctx context.Context) ([]*graphqlbackend.RepositoryResolver, error) {
+	// The repository store omits the repos the viewer cannot access.
+	repos, err := p.db.Repos().GetByIDs(ctx, p.PathTrigger.RepoIDs...)
+	if err != nil {
+		return nil, err
+	}
+	gs := gitserver.NewClient("graphql.codemonitors")
+	resolvers := make([]*graphqlbackend.RepositoryResolver, 0, len(repos))
+	for _, repo := range repos {
+		resolvers = append(resolvers, graphqlbackend.NewRepositoryResolver(p.db, gs, repo))
+	}
+	return resolvers, nil
+}
+
+func (p *monitorPathTrigger) PathPatterns() []string {
+	if p.PathTrigger.PathPatterns == nil {
+		return []string{}
+	}
+	return p.PathTrigger.PathPatterns
+}
+
+func (p *monitorPathTrigger) Owner() *string {
+	if p.PathTrigger.Owner == "" {
+		return nil
+	}
+	return &p.PathTrigger.Owner
+}
+
 // MonitorTriggerEventConnection
 type monitorTriggerEventConnection struct {
 	*Resolver
@@ -1028,6 +1233,7 @@ type action struct {
 	email        graphqlbackend.MonitorEmailResolver
 	webhook      graphqlbackend.MonitorWebhookResolver
 	slackWebhook graphqlbackend.MonitorSlackWebhookResolver
+	chatWebhook  graphqlbackend.MonitorChatWebhookResolver
 }
 
 func (a *action) ID() graphql.ID {
@@ -1038,6 +1244,8 @@ func (a *action) ID() graphql.ID {
 		return a.webhook.ID()
 	case a.slackWebhook != nil:
 		return a.slackWebhook.ID()
+	case a.chatWebhook != nil:
+		return a.chatWebhook.ID()
 	default:
 		panic("action must have a type")
 	}
@@ -1055,6 +1263,10 @@ func (a *action) ToMonitorSlackWebhook() (graphqlbackend.MonitorSlackWebhookReso
 	return a.slackWebhook, a.slackWebhook != nil
 }
 
+func (a *action) ToMonitorChatWebhook() (graphqlbackend.MonitorChatWebhookResolver, bool) {
+	return a.chatWebhook, a.chatWebhook != nil
+}
+
 // Email
 type monitorEmail struct {
 	*Resolver
@@ -1124,6 +1336,10 @@ func (m *monitorEmail) Header() string {
 	return m.EmailAction.Header
 }
 
+func (m *monitorEmail) Schedule() string {
+	return string(m.EmailAction.Schedule)
+}
+
 func (m *monitorEmail) ID() graphql.ID {
 	return relay.MarshalID(monitorActionEmailKind, m.EmailAction.ID)
 }
@@ -1184,6 +1400,10 @@ func (m *monitorWebhook) URL() string {
 	return m.WebhookAction.URL
 }
 
+func (m *monitorWebhook) Schedule() string {
+	return string(m.WebhookAction.Schedule)
+}
+
 func (m *monitorWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
 	after, err := unmarshalAfter(args.After)
 	if err != nil {
@@ -1240,6 +1460,10 @@ func (m *monitorSlackWebhook) URL() string {
 	return m.SlackWebhookAction.URL
 }
 
+func (m *monitorSlackWebhook) Schedule() string {
+	return string(m.SlackWebhookAction.Schedule)
+}
+
 func (m *monitorSlackWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
 	after, err := unmarshalAfter(args.After)
 	if err != nil {
@@ -1270,6 +1494,70 @@ func (m *monitorSlackWebhook) Events(ctx context.Context, args *graphqlbackend.L
 	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
 }
 
+type monitorChatWebhook struct {
+	*Resolver
+	*database.ChatWebhookAction
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int32
+}
+
+func (m *monitorChatWebhook) ID() graphql.ID {
+	return relay.MarshalID(monitorActionChatWebhookKind, m.ChatWebhookAction.ID)
+}
+
+func (m *monitorChatWebhook) Enabled() bool {
+	return m.ChatWebhookAction.Enabled
+}
+
+func (m *monitorChatWebhook) IncludeResults() bool {
+	return m.ChatWebhookAction.IncludeResults
+}
+
+func (m *monitorChatWebhook) Format() string {
+	return string(m.ChatWebhookAction.Format)
+}
+
+func (m *monitorChatWebhook) URL() string {
+	return m.ChatWebhookAction.URL
+}
+
+func (m *monitorChatWebhook) Schedule() string {
+	return string(m.ChatWebhookAction.Schedule)
+}
+
+func (m *monitorChatWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	after, err := unmarshalAfter(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	ajs, err := m.db.CodeMonitors().ListActionJobs(ctx, database.ListActionJobsOpts{
+		ChatWebhookID:  pointers.Ptr(int(m.ChatWebhookAction.ID)),
+		TriggerEventID: m.triggerEventID,
+		First:          pointers.Ptr(int(args.First)),
+		After:          after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := m.db.CodeMonitors().CountActionJobs(ctx, database.ListActionJobsOpts{
+		ChatWebhookID:  pointers.Ptr(int(m.ChatWebhookAction.ID)),
+		TriggerEventID: m.triggerEventID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]graphqlbackend.MonitorActionEventResolver, len(ajs))
+	for i, aj := range ajs {
+		events[i] = &monitorActionEvent{Resolver: m.Resolver, ActionJob: aj}
+	}
+	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
+}
+
 func intPtrToInt64Ptr(i *int) *int64 {
 	if i == nil {
 		return nil
@@ -1362,3 +1650,18 @@ func validateSlackURL(urlString string) error {
 	}
 	return nil
 }
+
+// validateChatWebhookURL checks that chat webhook URLs are absolute HTTPS URLs.
+// Unlike Slack, Teams and Mattermost webhooks are not served from a single
+// canonical host.
+func validateChatWebhookURL(urlString string) error {
+	u, err := url.Parse(urlString)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return errors.New("chat webhook URL must be an absolute URL beginning with 'https://'")
+	}
+	return nil
+}
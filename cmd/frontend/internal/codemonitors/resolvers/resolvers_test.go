@@ -1322,3 +1322,24 @@ func TestValidateSlackURL(t *testing.T) {
 		require.Error(t, validateSlackURL(url))
 	}
 }
+
+func TestValidateChatWebhookURL(t *testing.T) {
+	valid := []string{
+		"https://example.webhook.office.com/webhookb2/8d8d8/IncomingWebhook/8dd88d",
+		"https://mattermost.example.com:8065/hooks/838383",
+	}
+
+	for _, url := range valid {
+		require.NoError(t, validateChatWebhookURL(url))
+	}
+
+	invalid := []string{
+		"http://mattermost.example.com/hooks/838383",
+		"https:///hooks/838383",
+		"/hooks/838383",
+	}
+
+	for _, url := range invalid {
+		require.Error(t, validateChatWebhookURL(url))
+	}
+}
@@ -0,0 +1,217 @@
+// Package caches serves the caches of executor jobs, which persist directories of a job
+// workspace (e.g. downloaded dependencies) between runs of jobs for the same repository
+// and queue.
+package caches
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/executor/store"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Handler retrieves and stores job caches. The size of each cache is limited, and the
+// least recently used caches are evicted once the total size of all caches exceeds the
+// configured limit.
+type Handler struct {
+	logger       log.Logger
+	store        store.JobCacheStore
+	uploadStore  uploadstore.Store
+	maxSize      int64
+	maxTotalSize int64
+	operations   *operations
+}
+
+// NewHandler creates a new Handler.
+func NewHandler(observationCtx *observation.Context, store store.JobCacheStore, uploadStore uploadstore.Store, maxSize, maxTotalSize int64) *Handler {
+	return &Handler{
+		logger:       observationCtx.Logger.Scoped("caches"),
+		store:        store,
+		uploadStore:  uploadStore,
+		maxSize:      maxSize,
+		maxTotalSize: maxTotalSize,
+		operations:   newOperations(observationCtx),
+	}
+}
+
+// Get retrieves the cache archive.
+func (h *Handler) Get() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, statusCode, err := h.get(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+		defer content.Close()
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(statusCode)
+
+		if _, err := io.Copy(w, content); err != nil {
+			h.logger.Error("failed to write payload to client", log.Error(err))
+		}
+	})
+}
+
+func (h *Handler) get(r *http.Request) (_ io.ReadCloser, statusCode int, err error) {
+	ctx, _, endObservation := h.operations.get.With(r.Context(), &err, observation.Args{})
+	defer func() {
+		endObservation(1, observation.Args{Attrs: []attribute.KeyValue{
+			attribute.Int("statusCode", statusCode),
+		}})
+	}()
+
+	key, err := cacheKey(r)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	// Marking the cache as used up front keeps it from being evicted while it is read.
+	found, err := h.store.Touch(ctx, key)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.Wrap(err, "marking cache as used")
+	}
+	if !found {
+		return nil, http.StatusNotFound, errors.New("cache does not exist")
+	}
+
+	content, err := h.uploadStore.Get(ctx, key)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.Wrap(err, "retrieving cache")
+	}
+	return content, http.StatusOK, nil
+}
+
+// Exists checks if the cache exists.
+func (h *Handler) Exists() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		statusCode, err := h.exists(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		w.WriteHeader(statusCode)
+	})
+}
+
+func (h *Handler) exists(r *http.Request) (statusCode int, err error) {
+	ctx, _, endObservation := h.operations.exists.With(r.Context(), &err, observation.Args{})
+	defer func() {
+		endObservation(1, observation.Args{Attrs: []attribute.KeyValue{
+			attribute.Int("statusCode", statusCode),
+		}})
+	}()
+
+	key, err := cacheKey(r)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	exists, err := h.store.Exists(ctx, key)
+	if err != nil {
+		return http.StatusInternalServerError, errors.Wrap(err, "checking cache existence")
+	}
+	if !exists {
+		return http.StatusNotFound, nil
+	}
+	return http.StatusOK, nil
+}
+
+// Upload stores the cache archive in the request body, replacing the previous content
+// of the cache.
+func (h *Handler) Upload() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		statusCode, err := h.upload(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		w.WriteHeader(statusCode)
+	})
+}
+
+func (h *Handler) upload(r *http.Request) (statusCode int, err error) {
+	ctx, _, endObservation := h.operations.upload.With(r.Context(), &err, observation.Args{})
+	defer func() {
+		endObservation(1, observation.Args{Attrs: []attribute.KeyValue{
+			attribute.Int("statusCode", statusCode),
+		}})
+	}()
+
+	key, err := cacheKey(r)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	errTooLarge := errors.Newf("cache exceeds the maximum size of %d bytes", h.maxSize)
+	if r.ContentLength > h.maxSize {
+		return http.StatusRequestEntityTooLarge, errTooLarge
+	}
+
+	// Archives are usually streamed without a content length, so the size can only be
+	// checked after the upload. Reading one byte past the limit tells us whether the
+	// archive was truncated. The archive is uploaded to a temporary key, so that the
+	// previous content of the cache stays intact until the upload succeeded.
+	tmpKey := fmt.Sprintf("%s.upload-%s", key, uuid.NewString())
+	size, err := h.uploadStore.Upload(ctx, tmpKey, io.LimitReader(r.Body, h.maxSize+1))
+	if err != nil {
+		h.deleteTemporary(ctx, tmpKey)
+		return http.StatusInternalServerError, errors.Wrap(err, "storing cache")
+	}
+	if size > h.maxSize {
+		h.deleteTemporary(ctx, tmpKey)
+		return http.StatusRequestEntityTooLarge, errTooLarge
+	}
+
+	// Composing a single source renames it, and deletes the temporary key.
+	if _, err := h.uploadStore.Compose(ctx, key, tmpKey); err != nil {
+		h.deleteTemporary(ctx, tmpKey)
+		return http.StatusInternalServerError, errors.Wrap(err, "storing cache")
+	}
+
+	if err := h.store.Upsert(ctx, key, size); err != nil {
+		return http.StatusInternalServerError, errors.Wrap(err, "recording cache")
+	}
+
+	evicted, err := h.store.EvictLeastRecentlyUsed(ctx, h.maxTotalSize)
+	if err != nil {
+		return http.StatusInternalServerError, errors.Wrap(err, "evicting caches")
+	}
+	for _, evictedKey := range evicted {
+		// The records are gone already, so a leftover archive is never served.
+		if err := h.uploadStore.Delete(ctx, evictedKey); err != nil {
+			h.logger.Warn("failed to delete evicted cache", log.String("key", evictedKey), log.Error(err))
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+func (h *Handler) deleteTemporary(ctx context.Context, tmpKey string) {
+	if err := h.uploadStore.Delete(ctx, tmpKey); err != nil {
+		h.logger.Warn("failed to delete temporary cache upload", log.String("key", tmpKey), log.Error(err))
+	}
+}
+
+// cacheKey returns the key of the requested cache in the upload store. The queue and
+// repository have already been validated against the job token of the request.
+func cacheKey(r *http.Request) (string, error) {
+	vars := mux.Vars(r)
+	queue, repo, name := vars["queueName"], vars["RepoName"], vars["cacheKey"]
+	if queue == "" || repo == "" || name == "" {
+		return "", errors.New("queue, repository and cache key must be provided")
+	}
+	return fmt.Sprintf("%s/%s/-/%s", queue, repo, name), nil
+}
@@ -0,0 +1,174 @@
+package caches_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/caches"
+	"github.com/sourcegraph/sourcegraph/internal/executor/store"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestHandler(t *testing.T) {
+	const cachePath = "/codeintel/github.com/sourcegraph/sourcegraph/-/go-modules"
+	const cacheKey = "codeintel/github.com/sourcegraph/sourcegraph/-/go-modules"
+
+	tests := []struct {
+		name string
+
+		method string
+		body   string
+
+		mockFunc      func(cacheStore *store.MockJobCacheStore, uploadStore *mocks.MockStore)
+		assertionFunc func(t *testing.T, cacheStore *store.MockJobCacheStore, uploadStore *mocks.MockStore)
+
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:   "Get cache",
+			method: http.MethodGet,
+			mockFunc: func(cacheStore *store.MockJobCacheStore, uploadStore *mocks.MockStore) {
+				cacheStore.TouchFunc.SetDefaultReturn(true, nil)
+				uploadStore.GetFunc.SetDefaultReturn(io.NopCloser(strings.NewReader("archive")), nil)
+			},
+			assertionFunc: func(t *testing.T, cacheStore *store.MockJobCacheStore, uploadStore *mocks.MockStore) {
+				require.Len(t, cacheStore.TouchFunc.History(), 1)
+				assert.Equal(t, cacheKey, cacheStore.TouchFunc.History()[0].Arg1)
+				require.Len(t, uploadStore.GetFunc.History(), 1)
+				assert.Equal(t, cacheKey, uploadStore.GetFunc.History()[0].Arg1)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: "archive",
+		},
+		{
+			name:   "Get missing cache",
+			method: http.MethodGet,
+			assertionFunc: func(t *testing.T, cacheStore *store.MockJobCacheStore, uploadStore *mocks.MockStore) {
+				require.Len(t, uploadStore.GetFunc.History(), 0)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: "cache does not exist\n",
+		},
+		{
+			name:   "Cache exists",
+			method: http.MethodHead,
+			mockFunc: func(cacheStore *store.MockJobCacheStore, uploadStore *mocks.MockStore) {
+				cacheStore.ExistsFunc.SetDefaultReturn(true, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Cache does not exist",
+			method:             http.MethodHead,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:   "Upload cache",
+			method: http.MethodPut,
+			body:   "archive",
+			mockFunc: func(cacheStore *store.MockJobCacheStore, uploadStore *mocks.MockStore) {
+				uploadStore.UploadFunc.SetDefaultHook(func(_ context.Context, _ string, r io.Reader) (int64, error) {
+					return io.Copy(io.Discard, r)
+				})
+				cacheStore.EvictLeastRecentlyUsedFunc.SetDefaultReturn([]string{"batches/github.com/sourcegraph/old/-/node-modules"}, nil)
+			},
+			assertionFunc: func(t *testing.T, cacheStore *store.MockJobCacheStore, uploadStore *mocks.MockStore) {
+				// The archive is renamed to the cache key once it is uploaded completely.
+				require.Len(t, uploadStore.UploadFunc.History(), 1)
+				tmpKey := uploadStore.UploadFunc.History()[0].Arg1
+				assert.True(t, strings.HasPrefix(tmpKey, cacheKey+".upload-"))
+				require.Len(t, uploadStore.ComposeFunc.History(), 1)
+				assert.Equal(t, cacheKey, uploadStore.ComposeFunc.History()[0].Arg1)
+				assert.Equal(t, []string{tmpKey}, uploadStore.ComposeFunc.History()[0].Arg2)
+				require.Len(t, cacheStore.UpsertFunc.History(), 1)
+				assert.Equal(t, cacheKey, cacheStore.UpsertFunc.History()[0].Arg1)
+				assert.Equal(t, int64(7), cacheStore.UpsertFunc.History()[0].Arg2)
+				require.Len(t, cacheStore.EvictLeastRecentlyUsedFunc.History(), 1)
+				assert.Equal(t, int64(100), cacheStore.EvictLeastRecentlyUsedFunc.History()[0].Arg1)
+				require.Len(t, uploadStore.DeleteFunc.History(), 1)
+				assert.Equal(t, "batches/github.com/sourcegraph/old/-/node-modules", uploadStore.DeleteFunc.History()[0].Arg1)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:   "Upload cache too large",
+			method: http.MethodPut,
+			body:   strings.Repeat("a", 11),
+			mockFunc: func(cacheStore *store.MockJobCacheStore, uploadStore *mocks.MockStore) {
+				uploadStore.UploadFunc.SetDefaultHook(func(_ context.Context, _ string, r io.Reader) (int64, error) {
+					return io.Copy(io.Discard, r)
+				})
+			},
+			assertionFunc: func(t *testing.T, cacheStore *store.MockJobCacheStore, uploadStore *mocks.MockStore) {
+				// The previous content of the cache is kept, and only the truncated
+				// upload is deleted.
+				require.Len(t, cacheStore.UpsertFunc.History(), 0)
+				require.Len(t, cacheStore.DeleteFunc.History(), 0)
+				require.Len(t, uploadStore.ComposeFunc.History(), 0)
+				require.Len(t, uploadStore.DeleteFunc.History(), 1)
+				assert.Equal(t, uploadStore.UploadFunc.History()[0].Arg1, uploadStore.DeleteFunc.History()[0].Arg1)
+				assert.NotEqual(t, cacheKey, uploadStore.DeleteFunc.History()[0].Arg1)
+			},
+			expectedStatusCode:   http.StatusRequestEntityTooLarge,
+			expectedResponseBody: "cache exceeds the maximum size of 10 bytes\n",
+		},
+		{
+			name:   "Upload cache fails",
+			method: http.MethodPut,
+			body:   "archive",
+			mockFunc: func(cacheStore *store.MockJobCacheStore, uploadStore *mocks.MockStore) {
+				uploadStore.UploadFunc.SetDefaultReturn(0, errors.New("boom"))
+			},
+			assertionFunc: func(t *testing.T, cacheStore *store.MockJobCacheStore, uploadStore *mocks.MockStore) {
+				require.Len(t, cacheStore.UpsertFunc.History(), 0)
+				require.Len(t, uploadStore.ComposeFunc.History(), 0)
+				require.Len(t, uploadStore.DeleteFunc.History(), 1)
+				assert.NotEqual(t, cacheKey, uploadStore.DeleteFunc.History()[0].Arg1)
+			},
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedResponseBody: "storing cache: boom\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cacheStore := store.NewMockJobCacheStore()
+			uploadStore := mocks.NewMockStore()
+			if test.mockFunc != nil {
+				test.mockFunc(cacheStore, uploadStore)
+			}
+
+			handler := caches.NewHandler(&observation.TestContext, cacheStore, uploadStore, 10, 100)
+			router := mux.NewRouter()
+			path := "/{queueName}/{RepoName:.*}/-/{cacheKey}"
+			router.Path(path).Methods(http.MethodGet).Handler(handler.Get())
+			router.Path(path).Methods(http.MethodHead).Handler(handler.Exists())
+			router.Path(path).Methods(http.MethodPut).Handler(handler.Upload())
+
+			req, err := http.NewRequest(test.method, cachePath, strings.NewReader(test.body))
+			require.NoError(t, err)
+			// Archives are streamed without a content length.
+			req.ContentLength = -1
+
+			rw := httptest.NewRecorder()
+			router.ServeHTTP(rw, req)
+
+			assert.Equal(t, test.expectedStatusCode, rw.Code)
+			assert.Equal(t, test.expectedResponseBody, rw.Body.String())
+
+			if test.assertionFunc != nil {
+				test.assertionFunc(t, cacheStore, uploadStore)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package caches
+
+import (
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type operations struct {
+	get    *observation.Operation
+	exists *observation.Operation
+	upload *observation.Operation
+}
+
+func newOperations(observationCtx *observation.Context) *operations {
+	m := metrics.NewREDMetrics(
+		observationCtx.Registerer,
+		"executor_caches",
+		metrics.WithLabels("op"),
+		metrics.WithCountHelp("Total number of method invocations."),
+	)
+
+	op := func(name string) *observation.Operation {
+		return observationCtx.Operation(observation.Op{
+			Name:              fmt.Sprintf("executor.caches.%s", name),
+			MetricLabelValues: []string{name},
+			Metrics:           m,
+		})
+	}
+
+	return &operations{
+		get:    op("get"),
+		exists: op("exists"),
+		upload: op("upload"),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gorilla/mux"
@@ -36,6 +37,8 @@ type ExecutorHandler interface {
 	HandleAddExecutionLogEntry(w http.ResponseWriter, r *http.Request)
 	// HandleUpdateExecutionLogEntry updates the log entry for the executor.Job.
 	HandleUpdateExecutionLogEntry(w http.ResponseWriter, r *http.Request)
+	// HandleAppendExecutionLogChunk appends a chunk of output to the log entry for the executor.Job.
+	HandleAppendExecutionLogChunk(w http.ResponseWriter, r *http.Request)
 	// HandleMarkComplete updates the executor.Job to have a completed status.
 	HandleMarkComplete(w http.ResponseWriter, r *http.Request)
 	// HandleMarkErrored updates the executor.Job to have an errored status.
@@ -52,6 +55,7 @@ type handler[T workerutil.Record] struct {
 	queueHandler  QueueHandler[T]
 	executorStore database.ExecutorStore
 	jobTokenStore executorstore.JobTokenStore
+	jobLogStore   executorstore.JobLogStore
 	metricsStore  metricsstore.DistributedStore
 	logger        log.Logger
 }
@@ -74,12 +78,14 @@ type TransformerFunc[T workerutil.Record] func(ctx context.Context, version stri
 func NewHandler[T workerutil.Record](
 	executorStore database.ExecutorStore,
 	jobTokenStore executorstore.JobTokenStore,
+	jobLogStore executorstore.JobLogStore,
 	metricsStore metricsstore.DistributedStore,
 	queueHandler QueueHandler[T],
 ) ExecutorHandler {
 	return &handler[T]{
 		executorStore: executorStore,
 		jobTokenStore: jobTokenStore,
+		jobLogStore:   jobLogStore,
 		metricsStore:  metricsStore,
 		logger: log.Scoped(
 			fmt.Sprintf("executor-queue-handler-%s", queueHandler.Name),
@@ -208,7 +214,19 @@ func (h *handler[T]) addExecutionLogEntry(ctx context.Context, executorName stri
 	if err == store.ErrExecutionLogEntryNotUpdated {
 		return 0, ErrUnknownJob
 	}
-	return entryID, errors.Wrap(err, "dbworkerstore.AddExecutionLogEntry")
+	if err != nil {
+		return 0, errors.Wrap(err, "dbworkerstore.AddExecutionLogEntry")
+	}
+
+	// The execution logs are reset when a job is dequeued, so the first entry marks a new
+	// attempt of the job. The log chunks of previous attempts are discarded with them.
+	if entryID == 1 {
+		if err := h.jobLogStore.DeleteForJob(ctx, h.queueHandler.Name, jobID); err != nil {
+			return 0, errors.Wrap(err, "jobLogStore.DeleteForJob")
+		}
+	}
+
+	return entryID, nil
 }
 
 func (h *handler[T]) HandleUpdateExecutionLogEntry(w http.ResponseWriter, r *http.Request) {
@@ -235,6 +253,42 @@ func (h *handler[T]) updateExecutionLogEntry(ctx context.Context, executorName s
 	return errors.Wrap(err, "dbworkerstore.UpdateExecutionLogEntry")
 }
 
+func (h *handler[T]) HandleAppendExecutionLogChunk(w http.ResponseWriter, r *http.Request) {
+	var payload executortypes.AppendExecutionLogChunkRequest
+
+	wrapHandler(w, r, &payload, h.logger, func() (int, any, error) {
+		// The job token checked by the job routes is only valid for the job in the header,
+		// so chunks may only be appended to the logs of that job.
+		if jobID, err := strconv.Atoi(r.Header.Get("X-Sourcegraph-Job-ID")); err != nil || jobID != payload.JobID {
+			return http.StatusForbidden, errorResponse{Error: "job ID does not match the job ID of the request header"}, nil
+		}
+
+		err := h.appendExecutionLogChunk(r.Context(), payload.ExecutorName, payload.JobID, payload.EntryID, payload.EntryKey, payload.Data)
+		if err == ErrUnknownJob {
+			return http.StatusNotFound, nil, nil
+		}
+
+		return http.StatusNoContent, nil, err
+	})
+}
+
+func (h *handler[T]) appendExecutionLogChunk(ctx context.Context, executorName string, jobID, entryID int, entryKey, data string) error {
+	writable, err := h.queueHandler.Store.ExecutionLogsWritable(ctx, jobID, store.ExecutionLogEntryOptions{
+		// Chunks are subject to the same rules as log entries: only the executor that owns
+		// the record may append to its logs, and only while the record is still dequeued.
+		WorkerHostname: executorName,
+		State:          "processing",
+	})
+	if err != nil {
+		return errors.Wrap(err, "dbworkerstore.ExecutionLogsWritable")
+	}
+	if !writable {
+		return ErrUnknownJob
+	}
+
+	return errors.Wrap(h.jobLogStore.Append(ctx, h.queueHandler.Name, jobID, entryID, entryKey, data), "jobLogStore.Append")
+}
+
 func (h *handler[T]) HandleMarkComplete(w http.ResponseWriter, r *http.Request) {
 	var payload executortypes.MarkCompleteRequest
 
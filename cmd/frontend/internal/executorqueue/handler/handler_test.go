@@ -35,6 +35,7 @@ func TestHandler_Name(t *testing.T) {
 	h := handler.NewHandler(
 		dbmocks.NewMockExecutorStore(),
 		executorstore.NewMockJobTokenStore(),
+		executorstore.NewMockJobLogStore(),
 		metricsstore.NewMockDistributedStore(),
 		queueHandler,
 	)
@@ -233,6 +234,7 @@ func TestHandler_HandleDequeue(t *testing.T) {
 			h := handler.NewHandler(
 				dbmocks.NewMockExecutorStore(),
 				jobTokenStore,
+				executorstore.NewMockJobLogStore(),
 				metricsstore.NewMockDistributedStore(),
 				handler.QueueHandler[testRecord]{Store: mockStore, RecordTransformer: test.transformerFunc},
 			)
@@ -342,6 +344,7 @@ func TestHandler_HandleAddExecutionLogEntry(t *testing.T) {
 			h := handler.NewHandler(
 				dbmocks.NewMockExecutorStore(),
 				executorstore.NewMockJobTokenStore(),
+				executorstore.NewMockJobLogStore(),
 				metricsstore.NewMockDistributedStore(),
 				handler.QueueHandler[testRecord]{Store: mockStore},
 			)
@@ -451,6 +454,7 @@ func TestHandler_HandleUpdateExecutionLogEntry(t *testing.T) {
 			h := handler.NewHandler(
 				dbmocks.NewMockExecutorStore(),
 				executorstore.NewMockJobTokenStore(),
+				executorstore.NewMockJobLogStore(),
 				metricsstore.NewMockDistributedStore(),
 				handler.QueueHandler[testRecord]{Store: mockStore},
 			)
@@ -487,6 +491,173 @@ func TestHandler_HandleUpdateExecutionLogEntry(t *testing.T) {
 	}
 }
 
+func TestHandler_HandleAddExecutionLogEntry_FirstEntry(t *testing.T) {
+	mockStore := dbworkerstoremocks.NewMockStore[testRecord]()
+	mockStore.AddExecutionLogEntryFunc.PushReturn(1, nil)
+	logStore := executorstore.NewMockJobLogStore()
+
+	h := handler.NewHandler(
+		dbmocks.NewMockExecutorStore(),
+		executorstore.NewMockJobTokenStore(),
+		logStore,
+		metricsstore.NewMockDistributedStore(),
+		handler.QueueHandler[testRecord]{Name: "test", Store: mockStore},
+	)
+
+	router := mux.NewRouter()
+	router.HandleFunc("/{queueName}", h.HandleAddExecutionLogEntry)
+
+	req, err := http.NewRequest(http.MethodPost, "/test", strings.NewReader(`{"executorName": "test-executor", "jobId": 42, "key": "foo", "command": ["faz", "baz"], "out": ""}`))
+	require.NoError(t, err)
+
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, req)
+
+	assert.Equal(t, http.StatusOK, rw.Code)
+	// The log chunks of a previous attempt of the job are discarded.
+	require.Len(t, logStore.DeleteForJobFunc.History(), 1)
+	assert.Equal(t, "test", logStore.DeleteForJobFunc.History()[0].Arg1)
+	assert.Equal(t, 42, logStore.DeleteForJobFunc.History()[0].Arg2)
+}
+
+func TestHandler_HandleAppendExecutionLogChunk(t *testing.T) {
+	tests := []struct {
+		name                 string
+		jobIDHeader          string
+		body                 string
+		mockFunc             func(mockStore *dbworkerstoremocks.MockStore[testRecord], logStore *executorstore.MockJobLogStore)
+		expectedStatusCode   int
+		expectedResponseBody string
+		assertionFunc        func(t *testing.T, mockStore *dbworkerstoremocks.MockStore[testRecord], logStore *executorstore.MockJobLogStore)
+	}{
+		{
+			name:        "Append execution log chunk",
+			jobIDHeader: "42",
+			body:        `{"executorName": "test-executor", "jobId": 42, "entryId": 2, "entryKey": "step.docker.step.0.run", "data": "hello\n"}`,
+			mockFunc: func(mockStore *dbworkerstoremocks.MockStore[testRecord], logStore *executorstore.MockJobLogStore) {
+				mockStore.ExecutionLogsWritableFunc.PushReturn(true, nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+			assertionFunc: func(t *testing.T, mockStore *dbworkerstoremocks.MockStore[testRecord], logStore *executorstore.MockJobLogStore) {
+				require.Len(t, mockStore.ExecutionLogsWritableFunc.History(), 1)
+				assert.Equal(t, 42, mockStore.ExecutionLogsWritableFunc.History()[0].Arg1)
+				assert.Equal(t, dbworkerstore.ExecutionLogEntryOptions{WorkerHostname: "test-executor", State: "processing"}, mockStore.ExecutionLogsWritableFunc.History()[0].Arg2)
+
+				require.Len(t, logStore.AppendFunc.History(), 1)
+				call := logStore.AppendFunc.History()[0]
+				assert.Equal(t, "test", call.Arg1)
+				assert.Equal(t, 42, call.Arg2)
+				assert.Equal(t, 2, call.Arg3)
+				assert.Equal(t, "step.docker.step.0.run", call.Arg4)
+				assert.Equal(t, "hello\n", call.Arg5)
+			},
+		},
+		{
+			name:                 "Job ID does not match header",
+			jobIDHeader:          "43",
+			body:                 `{"executorName": "test-executor", "jobId": 42, "entryId": 2, "entryKey": "step.docker.step.0.run", "data": "hello\n"}`,
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"error":"job ID does not match the job ID of the request header"}`,
+			assertionFunc: func(t *testing.T, mockStore *dbworkerstoremocks.MockStore[testRecord], logStore *executorstore.MockJobLogStore) {
+				require.Len(t, mockStore.ExecutionLogsWritableFunc.History(), 0)
+				require.Len(t, logStore.AppendFunc.History(), 0)
+			},
+		},
+		{
+			name:                 "Job ID header missing",
+			body:                 `{"executorName": "test-executor", "jobId": 42, "entryId": 2, "entryKey": "step.docker.step.0.run", "data": "hello\n"}`,
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: `{"error":"job ID does not match the job ID of the request header"}`,
+			assertionFunc: func(t *testing.T, mockStore *dbworkerstoremocks.MockStore[testRecord], logStore *executorstore.MockJobLogStore) {
+				require.Len(t, logStore.AppendFunc.History(), 0)
+			},
+		},
+		{
+			name:        "Unknown job",
+			jobIDHeader: "42",
+			body:        `{"executorName": "test-executor", "jobId": 42, "entryId": 2, "entryKey": "step.docker.step.0.run", "data": "hello\n"}`,
+			mockFunc: func(mockStore *dbworkerstoremocks.MockStore[testRecord], logStore *executorstore.MockJobLogStore) {
+				mockStore.ExecutionLogsWritableFunc.PushReturn(false, nil)
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `null`,
+			assertionFunc: func(t *testing.T, mockStore *dbworkerstoremocks.MockStore[testRecord], logStore *executorstore.MockJobLogStore) {
+				require.Len(t, logStore.AppendFunc.History(), 0)
+			},
+		},
+		{
+			name:        "Failed to check job",
+			jobIDHeader: "42",
+			body:        `{"executorName": "test-executor", "jobId": 42, "entryId": 2, "entryKey": "step.docker.step.0.run", "data": "hello\n"}`,
+			mockFunc: func(mockStore *dbworkerstoremocks.MockStore[testRecord], logStore *executorstore.MockJobLogStore) {
+				mockStore.ExecutionLogsWritableFunc.PushReturn(false, errors.New("failed"))
+			},
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedResponseBody: `{"error":"dbworkerstore.ExecutionLogsWritable: failed"}`,
+			assertionFunc: func(t *testing.T, mockStore *dbworkerstoremocks.MockStore[testRecord], logStore *executorstore.MockJobLogStore) {
+				require.Len(t, logStore.AppendFunc.History(), 0)
+			},
+		},
+		{
+			name:        "Failed to append execution log chunk",
+			jobIDHeader: "42",
+			body:        `{"executorName": "test-executor", "jobId": 42, "entryId": 2, "entryKey": "step.docker.step.0.run", "data": "hello\n"}`,
+			mockFunc: func(mockStore *dbworkerstoremocks.MockStore[testRecord], logStore *executorstore.MockJobLogStore) {
+				mockStore.ExecutionLogsWritableFunc.PushReturn(true, nil)
+				logStore.AppendFunc.PushReturn(errors.New("failed to append"))
+			},
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedResponseBody: `{"error":"jobLogStore.Append: failed to append"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockStore := dbworkerstoremocks.NewMockStore[testRecord]()
+			logStore := executorstore.NewMockJobLogStore()
+
+			h := handler.NewHandler(
+				dbmocks.NewMockExecutorStore(),
+				executorstore.NewMockJobTokenStore(),
+				logStore,
+				metricsstore.NewMockDistributedStore(),
+				handler.QueueHandler[testRecord]{Name: "test", Store: mockStore},
+			)
+
+			router := mux.NewRouter()
+			router.HandleFunc("/{queueName}", h.HandleAppendExecutionLogChunk)
+
+			req, err := http.NewRequest(http.MethodPost, "/test", strings.NewReader(test.body))
+			require.NoError(t, err)
+			if test.jobIDHeader != "" {
+				req.Header.Set("X-Sourcegraph-Job-ID", test.jobIDHeader)
+			}
+
+			rw := httptest.NewRecorder()
+
+			if test.mockFunc != nil {
+				test.mockFunc(mockStore, logStore)
+			}
+
+			router.ServeHTTP(rw, req)
+
+			assert.Equal(t, test.expectedStatusCode, rw.Code)
+
+			b, err := io.ReadAll(rw.Body)
+			require.NoError(t, err)
+
+			if len(test.expectedResponseBody) > 0 {
+				assert.JSONEq(t, test.expectedResponseBody, string(b))
+			} else {
+				assert.Empty(t, string(b))
+			}
+
+			if test.assertionFunc != nil {
+				test.assertionFunc(t, mockStore, logStore)
+			}
+		})
+	}
+}
+
 func TestHandler_HandleMarkComplete(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -562,6 +733,7 @@ func TestHandler_HandleMarkComplete(t *testing.T) {
 			h := handler.NewHandler(
 				dbmocks.NewMockExecutorStore(),
 				tokenStore,
+				executorstore.NewMockJobLogStore(),
 				metricsstore.NewMockDistributedStore(),
 				handler.QueueHandler[testRecord]{Store: mockStore},
 			)
@@ -674,6 +846,7 @@ func TestHandler_HandleMarkErrored(t *testing.T) {
 			h := handler.NewHandler(
 				dbmocks.NewMockExecutorStore(),
 				tokenStore,
+				executorstore.NewMockJobLogStore(),
 				metricsstore.NewMockDistributedStore(),
 				handler.QueueHandler[testRecord]{Store: mockStore},
 			)
@@ -786,6 +959,7 @@ func TestHandler_HandleMarkFailed(t *testing.T) {
 			h := handler.NewHandler(
 				dbmocks.NewMockExecutorStore(),
 				tokenStore,
+				executorstore.NewMockJobLogStore(),
 				metricsstore.NewMockDistributedStore(),
 				handler.QueueHandler[testRecord]{Store: mockStore},
 			)
@@ -921,6 +1095,7 @@ func TestHandler_HandleHeartbeat(t *testing.T) {
 			h := handler.NewHandler(
 				executorStore,
 				executorstore.NewMockJobTokenStore(),
+				executorstore.NewMockJobLogStore(),
 				metricsStore,
 				handler.QueueHandler[testRecord]{Store: mockStore},
 			)
@@ -143,50 +143,24 @@ func (m *MultiHandler) dequeue(ctx context.Context, req executortypes.DequeueReq
 		DiskSpace: req.DiskSpace,
 	}
 
-	logger := m.logger.Scoped("dequeue")
+	// The queued jobs of the selected queue may all be held back by concurrency limits, or
+	// another executor instance could have dequeued them in the meantime. In that case, fall
+	// back to the other populated queues rather than leaving this executor idle.
 	var job executortypes.Job
-	switch selectedQueue {
-	case m.BatchesQueueHandler.Name:
-		record, dequeued, err := m.BatchesQueueHandler.Store.Dequeue(ctx, req.ExecutorName, nil)
+	var dequeued bool
+	for _, queue := range append([]string{selectedQueue}, otherQueues(nonEmptyQueues, selectedQueue)...) {
+		job, dequeued, err = m.dequeueFromQueue(ctx, queue, req, resourceMetadata)
 		if err != nil {
-			err = errors.Wrapf(err, "dbworkerstore.Dequeue %s", selectedQueue)
-			logger.Error("Failed to dequeue", log.String("queue", selectedQueue), log.Error(err))
 			return executortypes.Job{}, false, err
 		}
-		if !dequeued {
-			// no batches job to dequeue. Even though the queue was populated before, another executor
-			// instance could have dequeued in the meantime
-			return executortypes.Job{}, false, nil
-		}
-
-		job, err = m.BatchesQueueHandler.RecordTransformer(ctx, req.Version, record, resourceMetadata)
-		if err != nil {
-			markErr := markRecordAsFailed(ctx, m.BatchesQueueHandler.Store, record.RecordID(), err, logger)
-			err = errors.Wrapf(errors.Append(err, markErr), "RecordTransformer %s", selectedQueue)
-			logger.Error("Failed to transform record", log.String("queue", selectedQueue), log.Error(err))
-			return executortypes.Job{}, false, err
-		}
-	case m.CodeIntelQueueHandler.Name:
-		record, dequeued, err := m.CodeIntelQueueHandler.Store.Dequeue(ctx, req.ExecutorName, nil)
-		if err != nil {
-			err = errors.Wrapf(err, "dbworkerstore.Dequeue %s", selectedQueue)
-			logger.Error("Failed to dequeue", log.String("queue", selectedQueue), log.Error(err))
-			return executortypes.Job{}, false, err
-		}
-		if !dequeued {
-			// no codeintel job to dequeue. Even though the queue was populated before, another executor
-			// instance could have dequeued in the meantime
-			return executortypes.Job{}, false, nil
-		}
-
-		job, err = m.CodeIntelQueueHandler.RecordTransformer(ctx, req.Version, record, resourceMetadata)
-		if err != nil {
-			markErr := markRecordAsFailed(ctx, m.CodeIntelQueueHandler.Store, record.RecordID(), err, logger)
-			err = errors.Wrapf(errors.Append(err, markErr), "RecordTransformer %s", selectedQueue)
-			logger.Error("Failed to transform record", log.String("queue", selectedQueue), log.Error(err))
-			return executortypes.Job{}, false, err
+		if dequeued {
+			selectedQueue = queue
+			break
 		}
 	}
+	if !dequeued {
+		return executortypes.Job{}, false, nil
+	}
 	job.Queue = selectedQueue
 
 	// If this executor supports v2, return a v2 payload. Based on this field,
@@ -195,7 +169,7 @@ func (m *MultiHandler) dequeue(ctx context.Context, req executortypes.DequeueReq
 		job.Version = 2
 	}
 
-	logger = m.logger.Scoped("token")
+	logger := m.logger.Scoped("token")
 	token, err := m.jobTokenStore.Create(ctx, job.ID, job.Queue, job.RepositoryName)
 	if err != nil {
 		if errors.Is(err, executorstore.ErrJobTokenAlreadyCreated) {
@@ -223,6 +197,51 @@ func (m *MultiHandler) dequeue(ctx context.Context, req executortypes.DequeueReq
 	return job, true, nil
 }
 
+// dequeueFromQueue dequeues a record from the given queue and transforms it into a job. Records
+// that fail to transform are marked as failed.
+func (m *MultiHandler) dequeueFromQueue(ctx context.Context, queue string, req executortypes.DequeueRequest, resourceMetadata ResourceMetadata) (executortypes.Job, bool, error) {
+	logger := m.logger.Scoped("dequeue")
+	switch queue {
+	case m.BatchesQueueHandler.Name:
+		return dequeueAndTransform(ctx, m.BatchesQueueHandler, req, resourceMetadata, logger)
+	case m.CodeIntelQueueHandler.Name:
+		return dequeueAndTransform(ctx, m.CodeIntelQueueHandler, req, resourceMetadata, logger)
+	}
+	return executortypes.Job{}, false, nil
+}
+
+func dequeueAndTransform[T workerutil.Record](ctx context.Context, queueHandler QueueHandler[T], req executortypes.DequeueRequest, resourceMetadata ResourceMetadata, logger log.Logger) (executortypes.Job, bool, error) {
+	record, dequeued, err := queueHandler.Store.Dequeue(ctx, req.ExecutorName, nil)
+	if err != nil {
+		err = errors.Wrapf(err, "dbworkerstore.Dequeue %s", queueHandler.Name)
+		logger.Error("Failed to dequeue", log.String("queue", queueHandler.Name), log.Error(err))
+		return executortypes.Job{}, false, err
+	}
+	if !dequeued {
+		return executortypes.Job{}, false, nil
+	}
+
+	job, err := queueHandler.RecordTransformer(ctx, req.Version, record, resourceMetadata)
+	if err != nil {
+		markErr := markRecordAsFailed(ctx, queueHandler.Store, record.RecordID(), err, logger)
+		err = errors.Wrapf(errors.Append(err, markErr), "RecordTransformer %s", queueHandler.Name)
+		logger.Error("Failed to transform record", log.String("queue", queueHandler.Name), log.Error(err))
+		return executortypes.Job{}, false, err
+	}
+	return job, true, nil
+}
+
+// otherQueues returns the given queues without the excluded queue.
+func otherQueues(queues []string, excluded string) []string {
+	var others []string
+	for _, queue := range queues {
+		if queue != excluded {
+			others = append(others, queue)
+		}
+	}
+	return others
+}
+
 // SelectQueueForDequeueing selects a queue from the provided list with weighted randomness.
 func (m *MultiHandler) SelectQueueForDequeueing(candidateQueues []string) (string, error) {
 	return DoSelectQueueForDequeueing(candidateQueues, m.dequeueCacheConfig)
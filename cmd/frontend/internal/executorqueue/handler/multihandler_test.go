@@ -239,6 +239,32 @@ func TestMultiHandler_HandleDequeue(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Fall back to other queue when selected queue has nothing to dequeue",
+			body: `{"executorName": "test-executor", "numCPUs": 1, "memory": "1GB", "diskSpace": "10GB","queues": ["codeintel","batches"]}`,
+			mockFunc: func(codeintelMockStore *dbworkerstoremocks.MockStore[uploadsshared.Index], batchesMockStore *dbworkerstoremocks.MockStore[*btypes.BatchSpecWorkspaceExecutionJob], jobTokenStore *executorstore.MockJobTokenStore) {
+				// The queued codeintel jobs are held back, e.g. by the concurrency limit of their repository.
+				codeintelMockStore.QueuedCountFunc.PushReturn(1, nil)
+				batchesMockStore.QueuedCountFunc.PushReturn(1, nil)
+				codeintelMockStore.DequeueFunc.PushReturn(uploadsshared.Index{}, false, nil)
+				batchesMockStore.DequeueFunc.PushReturn(&btypes.BatchSpecWorkspaceExecutionJob{ID: 2}, true, nil)
+				jobTokenStore.CreateFunc.PushReturn("token2", nil)
+			},
+			assertionFunc: func(t *testing.T, codeintelMockStore *dbworkerstoremocks.MockStore[uploadsshared.Index], batchesMockStore *dbworkerstoremocks.MockStore[*btypes.BatchSpecWorkspaceExecutionJob], jobTokenStore *executorstore.MockJobTokenStore) {
+				require.Len(t, codeintelMockStore.DequeueFunc.History(), 1)
+				require.Len(t, batchesMockStore.DequeueFunc.History(), 1)
+				require.Len(t, jobTokenStore.CreateFunc.History(), 1)
+				assert.Equal(t, 2, jobTokenStore.CreateFunc.History()[0].Arg1)
+				assert.Equal(t, "batches", jobTokenStore.CreateFunc.History()[0].Arg2)
+			},
+			dequeueEvents: []dequeueEvent{
+				{
+					queueName:            "codeintel",
+					expectedStatusCode:   http.StatusOK,
+					expectedResponseBody: `{"id":2,"token":"token2","queue":"batches","repositoryName":"","repositoryDirectory":"","commit":"","fetchTags":false,"shallowClone":false,"sparseCheckout":null,"files":{},"dockerSteps":null,"cliSteps":null,"redactedValues":null}`,
+				},
+			},
+		},
 		{
 			name: "Dequeue error codeintel",
 			body: `{"executorName": "test-executor", "numCPUs": 1, "memory": "1GB", "diskSpace": "10GB","queues": ["codeintel"]}`,
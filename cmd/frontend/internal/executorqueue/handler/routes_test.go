@@ -100,6 +100,15 @@ func TestSetupJobRoutes(t *testing.T) {
 				h.On("HandleUpdateExecutionLogEntry").Once()
 			},
 		},
+		{
+			name:               "AppendExecutionLogChunk",
+			method:             http.MethodPost,
+			path:               "/test/appendExecutionLogChunk",
+			expectedStatusCode: http.StatusOK,
+			expectationsFunc: func(h *testExecutorHandler) {
+				h.On("HandleAppendExecutionLogChunk").Once()
+			},
+		},
 		{
 			name:               "MarkComplete",
 			method:             http.MethodPost,
@@ -182,6 +191,10 @@ func (t *testExecutorHandler) HandleUpdateExecutionLogEntry(w http.ResponseWrite
 	t.Called()
 }
 
+func (t *testExecutorHandler) HandleAppendExecutionLogChunk(w http.ResponseWriter, r *http.Request) {
+	t.Called()
+}
+
 func (t *testExecutorHandler) HandleMarkComplete(w http.ResponseWriter, r *http.Request) {
 	t.Called()
 }
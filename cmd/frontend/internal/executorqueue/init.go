@@ -1,21 +1,36 @@
 package executorqueue
 
 import (
+	"context"
+	"time"
+
 	"github.com/sourcegraph/log"
 
+	"github.com/sourcegraph/sourcegraph/internal/codeintel"
 	"github.com/sourcegraph/sourcegraph/internal/conf/confdefaults"
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/conf/deploy"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/executor/store"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/caches"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/logs"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/batches"
+	codeintelqueue "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/codeintel"
 )
 
+func LoadConfig() {
+	caches.ConfigInst.Load()
+}
+
 // Init initializes the executor endpoints required for use with the executor service.
 func Init(
+	ctx context.Context,
 	observationCtx *observation.Context,
 	db database.DB,
+	codeIntelServices codeintel.Services,
 	conf conftypes.UnifiedWatchable,
 	enterpriseServices *enterprise.Services,
 ) error {
@@ -32,6 +47,21 @@ func Init(
 
 	logger := log.Scoped("executorqueue")
 
+	if err := caches.ConfigInst.Validate(); err != nil {
+		return err
+	}
+	cachesUploadStore, err := caches.NewUploadStore(ctx, observationCtx, caches.ConfigInst)
+	if err != nil {
+		return err
+	}
+	cachesHandler := caches.NewHandler(
+		observationCtx,
+		store.NewJobCacheStore(db),
+		cachesUploadStore,
+		int64(caches.ConfigInst.MaxCacheSizeMB)*1024*1024,
+		int64(caches.ConfigInst.MaxTotalSizeMB)*1024*1024,
+	)
+
 	queueHandler := newExecutorQueuesHandler(
 		observationCtx,
 		db,
@@ -40,8 +70,17 @@ func Init(
 		codeintelUploadHandler,
 		batchesWorkspaceFileGetHandler,
 		batchesWorkspaceFileExistsHandler,
+		cachesHandler,
 	)
 
 	enterpriseServices.NewExecutorProxyHandler = queueHandler
+
+	logsHandler := logs.NewHandler(logger, store.NewJobLogStore(db), map[string]logs.JobStateFunc{
+		"batches":   batches.JobState(observationCtx, db),
+		"codeintel": codeintelqueue.JobState(db, codeIntelServices.UploadsService),
+	}, time.Second)
+	enterpriseServices.ExecutorJobLogsStreamHandler = logsHandler.Stream()
+	enterpriseServices.ExecutorJobLogsGrepHandler = logsHandler.Grep()
+
 	return nil
 }
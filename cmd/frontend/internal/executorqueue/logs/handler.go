@@ -0,0 +1,316 @@
+// Package logs serves the log chunks that executors append while they run a job, so that
+// users can tail the output of a running job and search the output of a job.
+package logs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/grafana/regexp"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/executor/store"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrJobNotFound is returned by a JobStateFunc when the job does not exist or the current
+// user is not allowed to read its logs.
+var ErrJobNotFound = errors.New("job not found")
+
+// JobStateFunc checks that the current user can read the logs of the job with the given ID
+// and returns whether the job has finished, after which no more chunks are appended.
+type JobStateFunc func(ctx context.Context, jobID int) (finished bool, err error)
+
+const (
+	// listPageSize is the maximum number of chunks read from the store at once.
+	listPageSize = 500
+	// defaultMaxMatches is the default maximum number of lines returned by a search.
+	defaultMaxMatches = 1000
+)
+
+// Handler serves the logs of executor jobs. Logs can be read for the queues that have
+// a JobStateFunc registered.
+type Handler struct {
+	logger       log.Logger
+	store        store.JobLogStore
+	jobStates    map[string]JobStateFunc
+	pollInterval time.Duration
+}
+
+// NewHandler creates a new Handler. The pollInterval is the interval at which new chunks of
+// a running job are read while its logs are streamed.
+func NewHandler(logger log.Logger, store store.JobLogStore, jobStates map[string]JobStateFunc, pollInterval time.Duration) *Handler {
+	return &Handler{
+		logger:       logger.Scoped("logs"),
+		store:        store,
+		jobStates:    jobStates,
+		pollInterval: pollInterval,
+	}
+}
+
+// chunkEvent is sent for each chunk of the logs while streaming. The ID can be passed as the
+// after parameter to resume streaming after the chunk.
+type chunkEvent struct {
+	ID       int64  `json:"id"`
+	EntryID  int    `json:"entryId"`
+	EntryKey string `json:"entryKey"`
+	Data     string `json:"data"`
+}
+
+// Stream streams the logs of the job as server-sent events. A "chunk" event is sent for each
+// chunk, starting after the chunk given by the after parameter, and a "done" event is sent
+// once the job has finished and all of its chunks were sent.
+func (h *Handler) Stream() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		queue, jobID, jobState, statusCode, err := h.job(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		var after int64
+		if v := r.URL.Query().Get("after"); v != "" {
+			if after, err = strconv.ParseInt(v, 10, 64); err != nil {
+				http.Error(w, "invalid after parameter", http.StatusBadRequest)
+				return
+			}
+		}
+
+		eventWriter, err := streamhttp.NewWriter(w)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		for {
+			// The state is read before the chunks, so that the chunks appended before the job
+			// finished are always sent before the done event.
+			finished, err := jobState(ctx, jobID)
+			if err != nil {
+				h.writeError(eventWriter, err)
+				return
+			}
+
+			chunks, err := h.store.List(ctx, queue, jobID, after, listPageSize)
+			if err != nil {
+				h.writeError(eventWriter, err)
+				return
+			}
+			for _, chunk := range chunks {
+				if err := eventWriter.Event("chunk", chunkEvent{
+					ID:       chunk.ID,
+					EntryID:  chunk.EntryID,
+					EntryKey: chunk.EntryKey,
+					Data:     chunk.Data,
+				}); err != nil {
+					// The client went away.
+					return
+				}
+				after = chunk.ID
+			}
+			if len(chunks) == listPageSize {
+				continue
+			}
+
+			if finished {
+				_ = eventWriter.Event("done", map[string]any{})
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(h.pollInterval):
+			}
+		}
+	})
+}
+
+func (h *Handler) writeError(eventWriter *streamhttp.Writer, err error) {
+	h.logger.Error("failed to stream job logs", log.Error(err))
+	_ = eventWriter.Event("error", map[string]any{"message": err.Error()})
+}
+
+// Match is a line of the logs of a job that matches a search.
+type Match struct {
+	EntryID    int    `json:"entryId"`
+	EntryKey   string `json:"entryKey"`
+	LineNumber int    `json:"lineNumber"`
+	Line       string `json:"line"`
+}
+
+// GrepResponse is the response of a search in the logs of a job.
+type GrepResponse struct {
+	Matches []Match `json:"matches"`
+	// LimitHit is true when more lines matched than were returned.
+	LimitHit bool `json:"limitHit"`
+}
+
+// Grep searches the logs of the job for the lines that match the regular expression given by
+// the pattern parameter. At most limit matching lines are returned.
+func (h *Handler) Grep() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, statusCode, err := h.grep(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			h.logger.Error("failed to write payload to client", log.Error(err))
+		}
+	})
+}
+
+func (h *Handler) grep(r *http.Request) (_ GrepResponse, statusCode int, err error) {
+	ctx := r.Context()
+
+	queue, jobID, _, statusCode, err := h.job(r)
+	if err != nil {
+		return GrepResponse{}, statusCode, err
+	}
+
+	pattern, err := regexp.Compile(r.URL.Query().Get("pattern"))
+	if err != nil {
+		return GrepResponse{}, http.StatusBadRequest, errors.Wrap(err, "invalid pattern")
+	}
+	maxMatches := defaultMaxMatches
+	if v := r.URL.Query().Get("limit"); v != "" {
+		if maxMatches, err = strconv.Atoi(v); err != nil || maxMatches <= 0 {
+			return GrepResponse{}, http.StatusBadRequest, errors.New("invalid limit parameter")
+		}
+	}
+
+	g := newGrepper(pattern, maxMatches)
+	var after int64
+	for !g.limitHit {
+		chunks, err := h.store.List(ctx, queue, jobID, after, listPageSize)
+		if err != nil {
+			return GrepResponse{}, http.StatusInternalServerError, errors.Wrap(err, "listing log chunks")
+		}
+		for _, chunk := range chunks {
+			g.write(chunk)
+			after = chunk.ID
+		}
+		if len(chunks) < listPageSize {
+			break
+		}
+	}
+	g.flush()
+
+	return GrepResponse{Matches: g.matches, LimitHit: g.limitHit}, http.StatusOK, nil
+}
+
+// job returns the queue and ID of the job of the request, and the JobStateFunc of its queue.
+func (h *Handler) job(r *http.Request) (queue string, jobID int, jobState JobStateFunc, statusCode int, err error) {
+	queue = mux.Vars(r)["queueName"]
+	jobState, ok := h.jobStates[queue]
+	if !ok {
+		return "", 0, nil, http.StatusNotFound, errors.Newf("unknown queue %q", queue)
+	}
+
+	jobID, err = strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		return "", 0, nil, http.StatusBadRequest, errors.New("invalid job ID")
+	}
+
+	// 🚨 SECURITY: The JobStateFunc checks that the current user can read the logs of the job.
+	if _, err := jobState(r.Context(), jobID); err != nil {
+		return "", 0, nil, statusCodeForError(err), err
+	}
+
+	return queue, jobID, jobState, http.StatusOK, nil
+}
+
+func statusCodeForError(err error) int {
+	if errors.Is(err, ErrJobNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+// grepper matches the lines of the chunks of a job. The lines of a log entry can be split
+// across chunks, the incomplete last line of each entry is kept until it is complete.
+type grepper struct {
+	pattern    *regexp.Regexp
+	maxMatches int
+
+	partial     map[int]string
+	entryKeys   map[int]string
+	lineNumbers map[int]int
+
+	matches  []Match
+	limitHit bool
+}
+
+func newGrepper(pattern *regexp.Regexp, maxMatches int) *grepper {
+	return &grepper{
+		pattern:     pattern,
+		maxMatches:  maxMatches,
+		partial:     map[int]string{},
+		entryKeys:   map[int]string{},
+		lineNumbers: map[int]int{},
+		matches:     []Match{},
+	}
+}
+
+func (g *grepper) write(chunk store.JobLogChunk) {
+	g.entryKeys[chunk.EntryID] = chunk.EntryKey
+
+	data := g.partial[chunk.EntryID] + chunk.Data
+	end := strings.LastIndexByte(data, '\n') + 1
+	g.partial[chunk.EntryID] = data[end:]
+
+	if end == 0 {
+		return
+	}
+	for _, line := range strings.Split(data[:end-1], "\n") {
+		g.matchLine(chunk.EntryID, line)
+	}
+}
+
+// flush matches the incomplete last lines of all entries.
+func (g *grepper) flush() {
+	entryIDs := make([]int, 0, len(g.partial))
+	for entryID, line := range g.partial {
+		if line != "" {
+			entryIDs = append(entryIDs, entryID)
+		}
+	}
+	sort.Ints(entryIDs)
+
+	for _, entryID := range entryIDs {
+		g.matchLine(entryID, g.partial[entryID])
+	}
+	g.partial = map[int]string{}
+}
+
+func (g *grepper) matchLine(entryID int, line string) {
+	g.lineNumbers[entryID]++
+	if g.limitHit || !g.pattern.MatchString(line) {
+		return
+	}
+	if len(g.matches) == g.maxMatches {
+		g.limitHit = true
+		return
+	}
+
+	g.matches = append(g.matches, Match{
+		EntryID:    entryID,
+		EntryKey:   g.entryKeys[entryID],
+		LineNumber: g.lineNumbers[entryID],
+		Line:       line,
+	})
+}
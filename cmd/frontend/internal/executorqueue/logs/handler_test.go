@@ -0,0 +1,183 @@
+package logs_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/logs"
+	"github.com/sourcegraph/sourcegraph/internal/executor/store"
+)
+
+func TestHandler_Stream(t *testing.T) {
+	logStore := store.NewMockJobLogStore()
+	logStore.ListFunc.PushReturn([]store.JobLogChunk{
+		{ID: 1, EntryID: 1, EntryKey: "setup.git.init", Data: "Initialized\n"},
+		{ID: 2, EntryID: 2, EntryKey: "step.docker.step.0.run", Data: "hello\n"},
+	}, nil)
+	logStore.ListFunc.PushReturn([]store.JobLogChunk{
+		{ID: 3, EntryID: 2, EntryKey: "step.docker.step.0.run", Data: "world\n"},
+	}, nil)
+
+	// The job finishes after the first poll.
+	var polls int
+	jobState := func(_ context.Context, jobID int) (bool, error) {
+		if jobID != 42 {
+			return false, logs.ErrJobNotFound
+		}
+		polls++
+		return polls > 2, nil
+	}
+
+	rw := serve(t, logStore, jobState, "/codeintel/42/logs/stream?after=0")
+
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, "text/event-stream", rw.Header().Get("Content-Type"))
+	assert.Equal(t, `event: chunk
+data: {"id":1,"entryId":1,"entryKey":"setup.git.init","data":"Initialized\n"}
+
+event: chunk
+data: {"id":2,"entryId":2,"entryKey":"step.docker.step.0.run","data":"hello\n"}
+
+event: chunk
+data: {"id":3,"entryId":2,"entryKey":"step.docker.step.0.run","data":"world\n"}
+
+event: done
+data: {}
+
+`, rw.Body.String())
+
+	// Streaming continues after the last chunk that was sent.
+	history := logStore.ListFunc.History()
+	require.Len(t, history, 2)
+	assert.Equal(t, "codeintel", history[0].Arg1)
+	assert.Equal(t, 42, history[0].Arg2)
+	assert.Equal(t, int64(0), history[0].Arg3)
+	assert.Equal(t, int64(2), history[1].Arg3)
+}
+
+func TestHandler_Grep(t *testing.T) {
+	logStore := store.NewMockJobLogStore()
+	// Lines can be split across chunks, and the chunks of entries can be interleaved. Lines are
+	// matched in the order they are completed.
+	logStore.ListFunc.PushReturn([]store.JobLogChunk{
+		{ID: 1, EntryID: 1, EntryKey: "step.docker.step.0.run", Data: "ok: a\nerr"},
+		{ID: 2, EntryID: 2, EntryKey: "step.docker.step.1.run", Data: "error: b\n"},
+		{ID: 3, EntryID: 1, EntryKey: "step.docker.step.0.run", Data: "or: c\nok: d\nerror: e"},
+	}, nil)
+
+	jobState := func(context.Context, int) (bool, error) { return true, nil }
+
+	rw := serve(t, logStore, jobState, "/codeintel/42/logs/grep?pattern=^err")
+
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.JSONEq(t, `{
+		"matches": [
+			{"entryId": 2, "entryKey": "step.docker.step.1.run", "lineNumber": 1, "line": "error: b"},
+			{"entryId": 1, "entryKey": "step.docker.step.0.run", "lineNumber": 2, "line": "error: c"},
+			{"entryId": 1, "entryKey": "step.docker.step.0.run", "lineNumber": 4, "line": "error: e"}
+		],
+		"limitHit": false
+	}`, rw.Body.String())
+}
+
+func TestHandler_GrepLimit(t *testing.T) {
+	logStore := store.NewMockJobLogStore()
+	logStore.ListFunc.PushReturn([]store.JobLogChunk{
+		{ID: 1, EntryID: 1, EntryKey: "step.docker.step.0.run", Data: "a\nb\nc\n"},
+	}, nil)
+
+	jobState := func(context.Context, int) (bool, error) { return true, nil }
+
+	rw := serve(t, logStore, jobState, "/codeintel/42/logs/grep?pattern=.&limit=2")
+
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.JSONEq(t, `{
+		"matches": [
+			{"entryId": 1, "entryKey": "step.docker.step.0.run", "lineNumber": 1, "line": "a"},
+			{"entryId": 1, "entryKey": "step.docker.step.0.run", "lineNumber": 2, "line": "b"}
+		],
+		"limitHit": true
+	}`, rw.Body.String())
+}
+
+func TestHandler_Errors(t *testing.T) {
+	jobState := func(_ context.Context, jobID int) (bool, error) {
+		if jobID != 42 {
+			return false, logs.ErrJobNotFound
+		}
+		return true, nil
+	}
+
+	tests := []struct {
+		name                 string
+		path                 string
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:                 "Unknown queue",
+			path:                 "/unknown/42/logs/stream",
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: "unknown queue \"unknown\"\n",
+		},
+		{
+			name:                 "Invalid job ID",
+			path:                 "/codeintel/foo/logs/grep?pattern=a",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: "invalid job ID\n",
+		},
+		{
+			name:                 "Job not found",
+			path:                 "/codeintel/43/logs/stream",
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: "job not found\n",
+		},
+		{
+			name:                 "Invalid after",
+			path:                 "/codeintel/42/logs/stream?after=foo",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: "invalid after parameter\n",
+		},
+		{
+			name:                 "Invalid pattern",
+			path:                 "/codeintel/42/logs/grep?pattern=(",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: "invalid pattern: error parsing regexp: missing closing ): `(`\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logStore := store.NewMockJobLogStore()
+
+			rw := serve(t, logStore, jobState, test.path)
+
+			assert.Equal(t, test.expectedStatusCode, rw.Code)
+			assert.Equal(t, test.expectedResponseBody, rw.Body.String())
+			assert.Empty(t, logStore.ListFunc.History())
+		})
+	}
+}
+
+func serve(t *testing.T, logStore store.JobLogStore, jobState logs.JobStateFunc, path string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	handler := logs.NewHandler(logtest.Scoped(t), logStore, map[string]logs.JobStateFunc{"codeintel": jobState}, time.Millisecond)
+	router := mux.NewRouter()
+	router.Path("/{queueName}/{id}/logs/stream").Methods(http.MethodGet).Handler(handler.Stream())
+	router.Path("/{queueName}/{id}/logs/grep").Methods(http.MethodGet).Handler(handler.Grep())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	require.NoError(t, err)
+
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, req)
+	return rw
+}
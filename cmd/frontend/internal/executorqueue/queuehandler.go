@@ -10,6 +10,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/sourcegraph/log"
 
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/caches"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/batches"
 	codeintelqueue "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/codeintel"
@@ -31,10 +32,12 @@ func newExecutorQueuesHandler(
 	uploadHandler http.Handler,
 	batchesWorkspaceFileGetHandler http.Handler,
 	batchesWorkspaceFileExistsHandler http.Handler,
+	cachesHandler *caches.Handler,
 ) func() http.Handler {
 	metricsStore := metricsstore.NewDistributedStore("executors:")
 	executorStore := db.Executors()
 	jobTokenStore := store.NewJobTokenStore(observationCtx, db)
+	jobLogStore := store.NewJobLogStore(db)
 
 	// Register queues. If this set changes, be sure to also update the list of valid
 	// queue names in ./metrics/queue_allocation.go, and register a metrics exporter
@@ -44,8 +47,8 @@ func newExecutorQueuesHandler(
 	codeIntelQueueHandler := codeintelqueue.QueueHandler(observationCtx, db, accessToken)
 	batchesQueueHandler := batches.QueueHandler(observationCtx, db, accessToken)
 
-	codeintelHandler := handler.NewHandler(executorStore, jobTokenStore, metricsStore, codeIntelQueueHandler)
-	batchesHandler := handler.NewHandler(executorStore, jobTokenStore, metricsStore, batchesQueueHandler)
+	codeintelHandler := handler.NewHandler(executorStore, jobTokenStore, jobLogStore, metricsStore, codeIntelQueueHandler)
+	batchesHandler := handler.NewHandler(executorStore, jobTokenStore, jobLogStore, metricsStore, batchesQueueHandler)
 	handlers := []handler.ExecutorHandler{codeintelHandler, batchesHandler}
 
 	multiHandler := handler.NewMultiHandler(executorStore, jobTokenStore, metricsStore, codeIntelQueueHandler, batchesQueueHandler)
@@ -109,6 +112,16 @@ func newExecutorQueuesHandler(
 		// The scip route are treated as an internal actor and require the executor access token to authenticate.
 		scipRouter.Use(withInternalActor, executorAuth)
 
+		// Job caches are registered before the other files, which are all scoped to the batches queue.
+		cachesRouter := base.PathPrefix("/files/caches").Name("executor-caches").Subrouter()
+		cachesPath := "/{queueName}/{RepoName:.*}/-/{cacheKey}"
+		cachesRouter.Path(cachesPath).Methods(http.MethodGet).Handler(cachesHandler.Get())
+		cachesRouter.Path(cachesPath).Methods(http.MethodHead).Handler(cachesHandler.Exists())
+		cachesRouter.Path(cachesPath).Methods(http.MethodPut).Handler(cachesHandler.Upload())
+		// The caches route are treated as an internal actor. Additionally, each job comes with a short-lived token that is
+		// checked by jobAuthMiddleware.
+		cachesRouter.Use(withInternalActor, jobAuthMiddleware(logger, routeCaches, jobTokenStore, executorStore))
+
 		filesRouter := base.PathPrefix("/files").Name("executor-files").Subrouter()
 		batchChangesRouter := filesRouter.PathPrefix("/batch-changes").Subrouter()
 		batchChangesRouter.Path("/{spec}/{file}").Methods(http.MethodGet).Handler(batchesWorkspaceFileGetHandler)
@@ -125,9 +138,10 @@ func newExecutorQueuesHandler(
 type routeName string
 
 const (
-	routeFiles = "files"
-	routeGit   = "git"
-	routeQueue = "queue"
+	routeCaches = "caches"
+	routeFiles  = "files"
+	routeGit    = "git"
+	routeQueue  = "queue"
 )
 
 // withInternalActor ensures that the request handling is running as an internal actor.
@@ -260,6 +274,9 @@ func validateJobRequest(
 
 	// Each route is "special". Set additional information based on the route that is being worked with.
 	switch routeName {
+	case routeCaches:
+		queue = mux.Vars(r)["queueName"]
+		repo = mux.Vars(r)["RepoName"]
 	case routeFiles:
 		queue = "batches"
 	case routeGit:
@@ -304,14 +321,16 @@ func validateJobRequest(
 		return false
 	}
 
-	// Check if the token is associated with the correct queue or repo.
+	// Check if the token is associated with the correct queue or repo. Caches are
+	// scoped to both, so that jobs cannot read the caches of other repositories.
 	if len(repo) > 0 {
 		if jobToken.Repo != repo {
 			logger.Error("repo does not match")
 			http.Error(w, "invalid token", http.StatusForbidden)
 			return false
 		}
-	} else {
+	}
+	if len(repo) == 0 || routeName == routeCaches {
 		// Ensure the token was generated for the correct queue.
 		if jobToken.Queue != queue {
 			logger.Error("queue name does not match")
@@ -187,6 +187,62 @@ func TestJobAuthMiddleware(t *testing.T) {
 				require.Len(t, executorStore.GetByHostnameFunc.History(), 0)
 			},
 		},
+		{
+			name:      "Caches Authorized",
+			routeName: routeCaches,
+			header: map[string]string{
+				"Authorization":               "Bearer somejobtoken",
+				"X-Sourcegraph-Job-ID":        "42",
+				"X-Sourcegraph-Executor-Name": "test-executor",
+			},
+			mockFunc: func(executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore) {
+				jobTokenStore.GetByTokenFunc.PushReturn(executorstore.JobToken{JobID: 42, Queue: "test", Repo: "test"}, nil)
+				executorStore.GetByHostnameFunc.PushReturn(types.Executor{}, true, nil)
+			},
+			expectedStatusCode: http.StatusTeapot,
+			assertionFunc: func(t *testing.T, executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore) {
+				require.Len(t, jobTokenStore.GetByTokenFunc.History(), 1)
+				assert.Equal(t, jobTokenStore.GetByTokenFunc.History()[0].Arg1, "somejobtoken")
+				require.Len(t, executorStore.GetByHostnameFunc.History(), 1)
+				assert.Equal(t, executorStore.GetByHostnameFunc.History()[0].Arg1, "test-executor")
+			},
+		},
+		{
+			name:      "Caches of other queue",
+			routeName: routeCaches,
+			header: map[string]string{
+				"Authorization":               "Bearer somejobtoken",
+				"X-Sourcegraph-Job-ID":        "42",
+				"X-Sourcegraph-Executor-Name": "test-executor",
+			},
+			mockFunc: func(executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore) {
+				jobTokenStore.GetByTokenFunc.PushReturn(executorstore.JobToken{JobID: 42, Queue: "test1", Repo: "test"}, nil)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: "invalid token\n",
+			assertionFunc: func(t *testing.T, executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore) {
+				require.Len(t, jobTokenStore.GetByTokenFunc.History(), 1)
+				require.Len(t, executorStore.GetByHostnameFunc.History(), 0)
+			},
+		},
+		{
+			name:      "Caches of other repo",
+			routeName: routeCaches,
+			header: map[string]string{
+				"Authorization":               "Bearer somejobtoken",
+				"X-Sourcegraph-Job-ID":        "42",
+				"X-Sourcegraph-Executor-Name": "test-executor",
+			},
+			mockFunc: func(executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore) {
+				jobTokenStore.GetByTokenFunc.PushReturn(executorstore.JobToken{JobID: 42, Queue: "test", Repo: "test1"}, nil)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: "invalid token\n",
+			assertionFunc: func(t *testing.T, executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore) {
+				require.Len(t, jobTokenStore.GetByTokenFunc.History(), 1)
+				require.Len(t, executorStore.GetByHostnameFunc.History(), 0)
+			},
+		},
 		{
 			name:      "No worker hostname provided",
 			routeName: routeQueue,
@@ -393,10 +449,16 @@ func TestJobAuthMiddleware(t *testing.T) {
 			jobTokenStore := executorstore.NewMockJobTokenStore()
 
 			router := mux.NewRouter()
+			path := "/test"
 			if test.routeName == routeGit {
 				router.HandleFunc("/{RepoName}", func(w http.ResponseWriter, r *http.Request) {
 					w.WriteHeader(http.StatusTeapot)
 				})
+			} else if test.routeName == routeCaches {
+				path = "/test/test"
+				router.HandleFunc("/{queueName}/{RepoName}", func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusTeapot)
+				})
 			} else {
 				router.HandleFunc("/{queueName}", func(w http.ResponseWriter, r *http.Request) {
 					w.WriteHeader(http.StatusTeapot)
@@ -404,7 +466,7 @@ func TestJobAuthMiddleware(t *testing.T) {
 			}
 			router.Use(jobAuthMiddleware(logger, test.routeName, jobTokenStore, executorStore))
 
-			req, err := http.NewRequest("GET", "/test", nil)
+			req, err := http.NewRequest("GET", path, nil)
 			require.NoError(t, err)
 			for k, v := range test.header {
 				req.Header.Add(k, v)
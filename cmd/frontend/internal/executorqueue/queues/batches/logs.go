@@ -0,0 +1,40 @@
+package batches
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/logs"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	bstore "github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// JobState returns the logs.JobStateFunc of batch spec workspace execution jobs.
+func JobState(observationCtx *observation.Context, db database.DB) logs.JobStateFunc {
+	batchesStore := bstore.New(db, observationCtx, nil)
+
+	return func(ctx context.Context, jobID int) (bool, error) {
+		job, err := batchesStore.GetBatchSpecWorkspaceExecutionJob(ctx, bstore.GetBatchSpecWorkspaceExecutionJobOpts{ID: int64(jobID), ExcludeRank: true})
+		if err != nil {
+			if err == bstore.ErrNoResults {
+				return false, logs.ErrJobNotFound
+			}
+			return false, err
+		}
+
+		// 🚨 SECURITY: Only the creator of the batch spec and site admins can read the logs of
+		// its workspaces. We don't reveal the existence of the job to other users.
+		if err := auth.CheckSiteAdminOrSameUser(ctx, db, job.UserID); err != nil {
+			if errors.Is(err, auth.ErrMustBeSiteAdminOrSameUser) {
+				return false, logs.ErrJobNotFound
+			}
+			return false, err
+		}
+
+		return job.State != btypes.BatchSpecWorkspaceExecutionJobStateQueued &&
+			job.State != btypes.BatchSpecWorkspaceExecutionJobStateProcessing, nil
+	}
+}
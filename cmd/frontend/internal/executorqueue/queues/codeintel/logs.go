@@ -0,0 +1,35 @@
+package codeintel
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/logs"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+)
+
+// JobState returns the logs.JobStateFunc of auto-indexing jobs.
+func JobState(db database.DB, uploadsService *uploads.Service) logs.JobStateFunc {
+	return func(ctx context.Context, jobID int) (bool, error) {
+		// 🚨 SECURITY: Only site admins can read executor log contents of indexes, as for the
+		// execution logs of the GraphQL API.
+		if err := auth.CheckCurrentUserIsSiteAdmin(ctx, db); err != nil {
+			if err == auth.ErrMustBeSiteAdmin {
+				return false, logs.ErrJobNotFound
+			}
+			return false, err
+		}
+
+		// GetIndexByID also filters out indexes of repositories the user cannot access.
+		index, ok, err := uploadsService.GetIndexByID(ctx, jobID)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, logs.ErrJobNotFound
+		}
+
+		return index.State != "queued" && index.State != "processing", nil
+	}
+}
@@ -24,8 +24,21 @@ const (
 	defaultOutfile      = "dump.lsif"
 	uploadRoute         = "/.executors/lsif/upload"
 	schemeExecutorToken = "token-executor"
+
+	dependencyCacheKey  = "dependencies"
+	dependencyCachePath = ".sourcegraph-cache"
 )
 
+// dependencyCacheEnv points the package managers used by common indexers into the
+// dependency cache, which the executor restores before and saves after each job, so
+// that dependencies are not downloaded again on every run.
+var dependencyCacheEnv = []string{
+	"GOMODCACHE=/data/" + dependencyCachePath + "/go",
+	"MAVEN_OPTS=-Dmaven.repo.local=/data/" + dependencyCachePath + "/maven",
+	"npm_config_cache=/data/" + dependencyCachePath + "/npm",
+	"YARN_CACHE_FOLDER=/data/" + dependencyCachePath + "/yarn",
+}
+
 // accessLogTransformer sets the approriate fields on the executor secret access log entry
 // for auto-indexing access
 type accessLogTransformer struct {
@@ -71,7 +84,8 @@ func transformRecord(ctx context.Context, db database.DB, index uploadsshared.In
 		redactedEnvVars[val] = fmt.Sprintf("${{ secrets.%s }}", secret.Key)
 	}
 
-	envVars := append(resourceEnvironment, secretEnvVars...)
+	// Secrets come last so that they can override the cache locations.
+	envVars := append(append(resourceEnvironment, dependencyCacheEnv...), secretEnvVars...)
 
 	dockerSteps := make([]apiclient.DockerStep, 0, len(index.DockerSteps)+2)
 	for i, dockerStep := range index.DockerSteps {
@@ -156,6 +170,9 @@ func transformRecord(ctx context.Context, db database.DB, index uploadsshared.In
 		RepositoryName: index.RepositoryName,
 		ShallowClone:   true,
 		FetchTags:      fetchTags,
+		Caches: []apiclient.JobCache{
+			{Key: dependencyCacheKey, Path: dependencyCachePath},
+		},
 		DockerSteps:    dockerSteps,
 		RedactedValues: allRedactedValues,
 	}
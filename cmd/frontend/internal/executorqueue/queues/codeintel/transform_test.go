@@ -33,6 +33,8 @@ func TestTransformRecord(t *testing.T) {
 			expected: []string{
 				// Default resource variables
 				"VM_MEM=12.0 GB", "VM_MEM_GB=12", "VM_MEM_MB=12288", "VM_DISK=20.0 GB", "VM_DISK_GB=20", "VM_DISK_MB=20480",
+				// Dependency cache variables
+				"GOMODCACHE=/data/.sourcegraph-cache/go", "MAVEN_OPTS=-Dmaven.repo.local=/data/.sourcegraph-cache/maven", "npm_config_cache=/data/.sourcegraph-cache/npm", "YARN_CACHE_FOLDER=/data/.sourcegraph-cache/yarn",
 			},
 		},
 		{
@@ -43,6 +45,8 @@ func TestTransformRecord(t *testing.T) {
 				"VM_CPUS=3", "VM_MEM=3.0 TB", "VM_MEM_GB=3072", "VM_MEM_MB=3145728",
 				// Default resource variables
 				"VM_DISK=20.0 GB", "VM_DISK_GB=20", "VM_DISK_MB=20480",
+				// Dependency cache variables
+				"GOMODCACHE=/data/.sourcegraph-cache/go", "MAVEN_OPTS=-Dmaven.repo.local=/data/.sourcegraph-cache/maven", "npm_config_cache=/data/.sourcegraph-cache/npm", "YARN_CACHE_FOLDER=/data/.sourcegraph-cache/yarn",
 			},
 		},
 		{
@@ -51,6 +55,8 @@ func TestTransformRecord(t *testing.T) {
 			expected: []string{
 				// Default resource variables (note: no disk)
 				"VM_MEM=12.0 GB", "VM_MEM_GB=12", "VM_MEM_MB=12288",
+				// Dependency cache variables
+				"GOMODCACHE=/data/.sourcegraph-cache/go", "MAVEN_OPTS=-Dmaven.repo.local=/data/.sourcegraph-cache/maven", "npm_config_cache=/data/.sourcegraph-cache/npm", "YARN_CACHE_FOLDER=/data/.sourcegraph-cache/yarn",
 			},
 		},
 	} {
@@ -93,6 +99,7 @@ func TestTransformRecord(t *testing.T) {
 				ShallowClone:        true,
 				FetchTags:           false,
 				VirtualMachineFiles: nil,
+				Caches:              []apiclient.JobCache{{Key: "dependencies", Path: ".sourcegraph-cache"}},
 				DockerSteps: []apiclient.DockerStep{
 					{
 						Key:      "pre-index.0",
@@ -189,6 +196,7 @@ func TestTransformRecordWithoutIndexer(t *testing.T) {
 		ShallowClone:        true,
 		FetchTags:           false,
 		VirtualMachineFiles: nil,
+		Caches:              []apiclient.JobCache{{Key: "dependencies", Path: ".sourcegraph-cache"}},
 		DockerSteps: []apiclient.DockerStep{
 			{
 				Key:      "pre-index.0",
@@ -198,6 +206,8 @@ func TestTransformRecordWithoutIndexer(t *testing.T) {
 				Env: []string{
 					// Default resource variables
 					"VM_MEM=12.0 GB", "VM_MEM_GB=12", "VM_MEM_MB=12288", "VM_DISK=20.0 GB", "VM_DISK_GB=20", "VM_DISK_MB=20480",
+					// Dependency cache variables
+					"GOMODCACHE=/data/.sourcegraph-cache/go", "MAVEN_OPTS=-Dmaven.repo.local=/data/.sourcegraph-cache/maven", "npm_config_cache=/data/.sourcegraph-cache/npm", "YARN_CACHE_FOLDER=/data/.sourcegraph-cache/yarn",
 				},
 			},
 			{
@@ -208,6 +218,8 @@ func TestTransformRecordWithoutIndexer(t *testing.T) {
 				Env: []string{
 					// Default resource variables
 					"VM_MEM=12.0 GB", "VM_MEM_GB=12", "VM_MEM_MB=12288", "VM_DISK=20.0 GB", "VM_DISK_GB=20", "VM_DISK_MB=20480",
+					// Dependency cache variables
+					"GOMODCACHE=/data/.sourcegraph-cache/go", "MAVEN_OPTS=-Dmaven.repo.local=/data/.sourcegraph-cache/maven", "npm_config_cache=/data/.sourcegraph-cache/npm", "YARN_CACHE_FOLDER=/data/.sourcegraph-cache/yarn",
 				},
 			},
 			{
@@ -276,7 +288,11 @@ func TestTransformRecordWithSecrets(t *testing.T) {
 			resourceMetadata: handler.ResourceMetadata{},
 			expected: []string{
 				// Default resource variables
-				"VM_MEM=12.0 GB", "VM_MEM_GB=12", "VM_MEM_MB=12288", "VM_DISK=20.0 GB", "VM_DISK_GB=20", "VM_DISK_MB=20480", "NPM_TOKEN=banana",
+				"VM_MEM=12.0 GB", "VM_MEM_GB=12", "VM_MEM_MB=12288", "VM_DISK=20.0 GB", "VM_DISK_GB=20", "VM_DISK_MB=20480",
+				// Dependency cache variables
+				"GOMODCACHE=/data/.sourcegraph-cache/go", "MAVEN_OPTS=-Dmaven.repo.local=/data/.sourcegraph-cache/maven", "npm_config_cache=/data/.sourcegraph-cache/npm", "YARN_CACHE_FOLDER=/data/.sourcegraph-cache/yarn",
+				// Secrets
+				"NPM_TOKEN=banana",
 			},
 		},
 	} {
@@ -324,6 +340,7 @@ func TestTransformRecordWithSecrets(t *testing.T) {
 				ShallowClone:        true,
 				FetchTags:           false,
 				VirtualMachineFiles: nil,
+				Caches:              []apiclient.JobCache{{Key: "dependencies", Path: ".sourcegraph-cache"}},
 				DockerSteps: []apiclient.DockerStep{
 					{
 						Key:      "pre-index.0",
@@ -401,6 +418,7 @@ func TestTransformRecordDockerAuthConfig(t *testing.T) {
 		ShallowClone:        true,
 		FetchTags:           false,
 		VirtualMachineFiles: nil,
+		Caches:              []apiclient.JobCache{{Key: "dependencies", Path: ".sourcegraph-cache"}},
 		DockerSteps: []apiclient.DockerStep{
 			{
 				Key:      "upload",
@@ -78,6 +78,10 @@ type Handlers struct {
 	SearchJobsDataExportHandler http.Handler
 	SearchJobsLogsHandler       http.Handler
 
+	// Executor job logs
+	ExecutorJobLogsStreamHandler http.Handler
+	ExecutorJobLogsGrepHandler   http.Handler
+
 	// Dotcom license check
 	NewDotcomLicenseCheckHandler enterprise.NewDotcomLicenseCheckHandler
 
@@ -162,6 +166,8 @@ func NewHandler(
 	m.Path("/search/stream").Methods("GET").Handler(trace.Route(frontendsearch.StreamHandler(db)))
 	m.Path("/search/export/{id}.csv").Methods("GET").Handler(trace.Route(handlers.SearchJobsDataExportHandler))
 	m.Path("/search/export/{id}.log").Methods("GET").Handler(trace.Route(handlers.SearchJobsLogsHandler))
+	m.Path("/executors/jobs/{queueName}/{id}/logs/stream").Methods("GET").Handler(trace.Route(handlers.ExecutorJobLogsStreamHandler))
+	m.Path("/executors/jobs/{queueName}/{id}/logs/grep").Methods("GET").Handler(trace.Route(handlers.ExecutorJobLogsGrepHandler))
 
 	m.Path("/completions/stream").Methods("POST").Handler(trace.Route(handlers.NewChatCompletionsStreamHandler()))
 	m.Path("/completions/code").Methods("POST").Handler(trace.Route(handlers.NewCodeCompletionsHandler()))
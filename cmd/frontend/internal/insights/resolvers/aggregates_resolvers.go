@@ -12,11 +12,13 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/insights/aggregation"
 	"github.com/sourcegraph/sourcegraph/internal/insights/query/querybuilder"
 	"github.com/sourcegraph/sourcegraph/internal/insights/query/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/insights/types"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/own"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
 	"github.com/sourcegraph/sourcegraph/internal/search/limits"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
@@ -35,7 +37,11 @@ const (
 // Possible reasons that grouping is disabled
 const invalidQueryMsg = "Grouping is disabled because the search query is not valid."
 const fileUnsupportedFieldValueFmt = `Grouping by file is not available for searches with "%s:%s".`
+const languageUnsupportedFieldValueFmt = `Grouping by language is not available for searches with "%s:%s".`
+const fileExtensionUnsupportedFieldValueFmt = `Grouping by file extension is not available for searches with "%s:%s".`
+const ownerUnsupportedFieldValueFmt = `Grouping by owner is not available for searches with "%s:%s".`
 const authNotCommitDiffMsg = "Grouping by author is only available for diff and commit searches."
+const commitDateNotCommitDiffMsg = "Grouping by commit date is only available for diff and commit searches."
 const repoMetadataNotRepoSelectMsg = "Grouping by repo metadata is only available for repository searches."
 const cgInvalidQueryMsg = "Grouping by capture group is only available for regexp searches that contain a capturing group."
 const cgMultipleQueryPatternMsg = "Grouping by capture group does not support search patterns with the following: and, or, negation."
@@ -140,7 +146,8 @@ func (r *searchAggregateResolver) Aggregations(ctx context.Context, args graphql
 		cappedAggregator.Add(amr.Key.Group, int32(amr.Count))
 	}
 
-	countingFunc, err := aggregation.GetCountFuncForMode(r.searchQuery, r.patternType, aggregationMode)
+	ownService := own.NewService(gitserver.NewClient("graphql.insights.aggregations"), r.postgresDB)
+	countingFunc, err := aggregation.GetCountFuncForMode(ctx, r.searchQuery, r.patternType, aggregationMode, ownService)
 	if err != nil {
 		r.getLogger().Debug("no aggregation counting function for mode", log.String("mode", string(aggregationMode)), log.Error(err))
 		return &searchAggregationResultResolver{
@@ -367,11 +374,15 @@ func getNotAvailableReason(query, patternType string, mode types.SearchAggregati
 
 func getAggregateBy(mode types.SearchAggregationMode) canAggregateBy {
 	checkByMode := map[types.SearchAggregationMode]canAggregateBy{
-		types.REPO_AGGREGATION_MODE:          canAggregateByRepo,
-		types.PATH_AGGREGATION_MODE:          canAggregateByPath,
-		types.AUTHOR_AGGREGATION_MODE:        canAggregateByAuthor,
-		types.CAPTURE_GROUP_AGGREGATION_MODE: canAggregateByCaptureGroup,
-		types.REPO_METADATA_AGGREGATION_MODE: canAggregateByRepoMetadata,
+		types.REPO_AGGREGATION_MODE:           canAggregateByRepo,
+		types.PATH_AGGREGATION_MODE:           canAggregateByPath,
+		types.AUTHOR_AGGREGATION_MODE:         canAggregateByAuthor,
+		types.CAPTURE_GROUP_AGGREGATION_MODE:  canAggregateByCaptureGroup,
+		types.REPO_METADATA_AGGREGATION_MODE:  canAggregateByRepoMetadata,
+		types.LANGUAGE_AGGREGATION_MODE:       canAggregateByLanguage,
+		types.FILE_EXTENSION_AGGREGATION_MODE: canAggregateByFileExtension,
+		types.COMMIT_DATE_AGGREGATION_MODE:    canAggregateByCommitDate,
+		types.OWNER_AGGREGATION_MODE:          canAggregateByOwner,
 	}
 	canAggregateByFunc, ok := checkByMode[mode]
 	if !ok {
@@ -397,6 +408,25 @@ func canAggregateByRepo(searchQuery, patternType string) (bool, *notAvailableRea
 }
 
 func canAggregateByPath(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	return canAggregateByFile(searchQuery, patternType, fileUnsupportedFieldValueFmt)
+}
+
+func canAggregateByLanguage(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	return canAggregateByFile(searchQuery, patternType, languageUnsupportedFieldValueFmt)
+}
+
+func canAggregateByFileExtension(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	return canAggregateByFile(searchQuery, patternType, fileExtensionUnsupportedFieldValueFmt)
+}
+
+func canAggregateByOwner(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	return canAggregateByFile(searchQuery, patternType, ownerUnsupportedFieldValueFmt)
+}
+
+// canAggregateByFile checks that a query returns file matches, which are required by all modes
+// grouping results by a property of their file. unsupportedFieldValueFmt is used to build the
+// reason for queries that don't.
+func canAggregateByFile(searchQuery, patternType, unsupportedFieldValueFmt string) (bool, *notAvailableReason, error) {
 	plan, err := querybuilder.ParseQuery(searchQuery, patternType)
 	if err != nil {
 		return false, &notAvailableReason{reason: invalidQueryMsg, reasonType: types.INVALID_QUERY}, errors.Wrapf(err, "ParseQuery")
@@ -407,7 +437,7 @@ func canAggregateByPath(searchQuery, patternType string) (bool, *notAvailableRea
 	for _, parameter := range parameters {
 		if parameter.Field == query.FieldSelect || parameter.Field == query.FieldType {
 			if strings.EqualFold(parameter.Value, "commit") || strings.EqualFold(parameter.Value, "diff") || strings.EqualFold(parameter.Value, "repo") {
-				reason := fmt.Sprintf(fileUnsupportedFieldValueFmt,
+				reason := fmt.Sprintf(unsupportedFieldValueFmt,
 					parameter.Field, parameter.Value)
 				return false, &notAvailableReason{reason: reason, reasonType: types.INVALID_AGGREGATION_MODE_FOR_QUERY}, nil
 			}
@@ -417,6 +447,17 @@ func canAggregateByPath(searchQuery, patternType string) (bool, *notAvailableRea
 }
 
 func canAggregateByAuthor(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	return canAggregateByCommit(searchQuery, patternType, authNotCommitDiffMsg)
+}
+
+func canAggregateByCommitDate(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	return canAggregateByCommit(searchQuery, patternType, commitDateNotCommitDiffMsg)
+}
+
+// canAggregateByCommit checks that a query returns commit or diff matches, which are required by
+// all modes grouping results by a property of their commit. notCommitDiffMsg is the reason given
+// for queries that don't.
+func canAggregateByCommit(searchQuery, patternType, notCommitDiffMsg string) (bool, *notAvailableReason, error) {
 	plan, err := querybuilder.ParseQuery(searchQuery, patternType)
 	if err != nil {
 		return false, &notAvailableReason{reason: invalidQueryMsg, reasonType: types.INVALID_QUERY}, errors.Wrapf(err, "ParseQuery")
@@ -431,7 +472,7 @@ func canAggregateByAuthor(searchQuery, patternType string) (bool, *notAvailableR
 			}
 		}
 	}
-	return false, &notAvailableReason{reason: authNotCommitDiffMsg, reasonType: types.INVALID_AGGREGATION_MODE_FOR_QUERY}, nil
+	return false, &notAvailableReason{reason: notCommitDiffMsg, reasonType: types.INVALID_AGGREGATION_MODE_FOR_QUERY}, nil
 }
 
 func canAggregateByCaptureGroup(searchQuery, patternType string) (bool, *notAvailableReason, error) {
@@ -608,6 +649,14 @@ func buildDrilldownQuery(mode types.SearchAggregationMode, originalQuery string,
 		modifierFunc = querybuilder.AddFileFilter
 	case types.AUTHOR_AGGREGATION_MODE:
 		modifierFunc = querybuilder.AddAuthorFilter
+	case types.LANGUAGE_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddLanguageFilter
+	case types.FILE_EXTENSION_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddFileExtensionFilter
+	case types.COMMIT_DATE_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddCommitDateFilter
+	case types.OWNER_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddOwnerFilter
 	case types.CAPTURE_GROUP_AGGREGATION_MODE:
 		searchType, err := client.SearchTypeFromString(patternType)
 		if err != nil {
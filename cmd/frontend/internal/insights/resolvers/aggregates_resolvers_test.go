@@ -189,6 +189,72 @@ func Test_canAggregateByAuthor(t *testing.T) {
 	suite.Test_canAggregateBy()
 }
 
+func Test_canAggregateByFileProperties(t *testing.T) {
+	for name, tc := range map[string]struct {
+		canAggregateByFunc       canAggregateBy
+		unsupportedFieldValueFmt string
+	}{
+		"language":       {canAggregateByLanguage, languageUnsupportedFieldValueFmt},
+		"file extension": {canAggregateByFileExtension, fileExtensionUnsupportedFieldValueFmt},
+		"owner":          {canAggregateByOwner, ownerUnsupportedFieldValueFmt},
+	} {
+		t.Run(name, func(t *testing.T) {
+			testCases := []canAggregateTestCase{
+				{
+					name:         "can aggregate for query without parameters",
+					query:        "func(t *testing.T)",
+					canAggregate: true,
+				},
+				{
+					name:         "cannot aggregate for query with select:repo parameter",
+					query:        "repo:contains.path(README) select:repo",
+					reason:       fmt.Sprintf(tc.unsupportedFieldValueFmt, "select", "repo"),
+					canAggregate: false,
+				},
+				{
+					name:         "cannot aggregate for query with type:diff parameter",
+					query:        "insights type:diff",
+					reason:       fmt.Sprintf(tc.unsupportedFieldValueFmt, "type", "diff"),
+					canAggregate: false,
+				},
+			}
+			suite := canAggregateBySuite{
+				canAggregateByFunc: tc.canAggregateByFunc,
+				testCases:          testCases,
+				t:                  t,
+			}
+			suite.Test_canAggregateBy()
+		})
+	}
+}
+
+func Test_canAggregateByCommitDate(t *testing.T) {
+	testCases := []canAggregateTestCase{
+		{
+			name:         "cannot aggregate for query without parameters",
+			query:        "func(t *testing.T)",
+			reason:       commitDateNotCommitDiffMsg,
+			canAggregate: false,
+		},
+		{
+			name:         "can aggregate for query with type:commit parameter",
+			query:        "type:commit fix",
+			canAggregate: true,
+		},
+		{
+			name:         "can aggregate for query with type:diff parameter",
+			query:        "type:diff fix",
+			canAggregate: true,
+		},
+	}
+	suite := canAggregateBySuite{
+		canAggregateByFunc: canAggregateByCommitDate,
+		testCases:          testCases,
+		t:                  t,
+	}
+	suite.Test_canAggregateBy()
+}
+
 func Test_canAggregateByCaptureGroup(t *testing.T) {
 	testCases := []canAggregateTestCase{
 		{
@@ -456,6 +522,34 @@ func Test_buildDrilldownQuery(t *testing.T) {
 			patternType: "standard",
 			mode:        types.PATH_AGGREGATION_MODE,
 		},
+		{
+			want:        autogold.Expect("lang:c++ findme"),
+			query:       "findme",
+			drilldown:   "C++",
+			patternType: "standard",
+			mode:        types.LANGUAGE_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect("file:\\.go$ findme"),
+			query:       "findme",
+			drilldown:   ".go",
+			patternType: "standard",
+			mode:        types.FILE_EXTENSION_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect("type:commit after:2023-05-01 before:2023-06-01 fix"),
+			query:       "type:commit fix",
+			drilldown:   "2023-05",
+			patternType: "standard",
+			mode:        types.COMMIT_DATE_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect("file:has.owner(sourcegraph/search) findme"),
+			query:       "findme",
+			drilldown:   "sourcegraph/search",
+			patternType: "standard",
+			mode:        types.OWNER_AGGREGATION_MODE,
+		},
 		{
 			want:        autogold.Expect("case:yes /fin(?:d m)e/"),
 			query:       "/fin(.*)e/",
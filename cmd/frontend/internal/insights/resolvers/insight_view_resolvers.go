@@ -13,6 +13,7 @@ import (
 	"github.com/segmentio/ksuid"
 
 	"github.com/sourcegraph/log"
+	"github.com/sourcegraph/scip/bindings/go/scip"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
@@ -340,6 +341,10 @@ func (s *searchInsightDataSeriesDefinitionResolver) GeneratedFromCaptureGroups()
 	return s.series.GeneratedFromCaptureGroups, nil
 }
 
+func (s *searchInsightDataSeriesDefinitionResolver) SymbolReferences() (bool, error) {
+	return s.series.GenerationMethod == types.ReferenceCount, nil
+}
+
 func (s *searchInsightDataSeriesDefinitionResolver) GroupBy() (*string, error) {
 	if s.series.GroupBy != nil {
 		groupBy := strings.ToUpper(*s.series.GroupBy)
@@ -666,6 +671,13 @@ func isCaptureGroupSeries(generatedFromCaptureGroups *bool) bool {
 	return *generatedFromCaptureGroups
 }
 
+func isSymbolReferencesSeries(symbolReferences *bool) bool {
+	if symbolReferences == nil {
+		return false
+	}
+	return *symbolReferences
+}
+
 func updateCaptureGroupInsight(ctx context.Context, input graphqlbackend.LineChartSearchInsightDataSeriesInput, existingSeries []types.InsightViewSeries, view types.InsightView, tx *store.InsightStore, seriesFillStrategy fillSeriesStrategy) error {
 	if len(existingSeries) == 0 {
 		// This should not happen, but if we somehow have no existing series for an insight, create one.
@@ -744,6 +756,9 @@ func existingSeriesHasChanged(new graphqlbackend.LineChartSearchInsightDataSerie
 	if new.Query != existing.Query {
 		return true
 	}
+	if isSymbolReferencesSeries(new.SymbolReferences) != (existing.GenerationMethod == types.ReferenceCount) {
+		return true
+	}
 	if new.TimeScope.StepInterval.Unit != existing.SampleIntervalUnit {
 		return true
 	}
@@ -1316,7 +1331,11 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 	var err error
 	var dynamic bool
 	// Validate the query before creating anything; we don't want faulty insights running pointlessly.
-	if series.GroupBy != nil || series.GeneratedFromCaptureGroups != nil {
+	if isSymbolReferencesSeries(series.SymbolReferences) {
+		if _, err := scip.ParseSymbol(series.Query); err != nil {
+			return errors.Wrap(err, "query validation")
+		}
+	} else if series.GroupBy != nil || series.GeneratedFromCaptureGroups != nil {
 		if _, err := querybuilder.ParseComputeQuery(series.Query, gitserver.NewClient("graphql.insights.computequery")); err != nil {
 			return errors.Wrap(err, "query validation")
 		}
@@ -1341,9 +1360,9 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 	}
 
 	// Don't try to match on non-global series, since they are always replaced
-	// Also don't try to match on series that use repo criteria
+	// Also don't try to match on series that use repo criteria, or that count symbol references
 	// TODO: Reconsider matching on criteria based series. If so the edit case would need work to ensure other insights remain the same.
-	if len(series.RepositoryScope.Repositories) == 0 && series.RepositoryScope.RepositoryCriteria == nil {
+	if len(series.RepositoryScope.Repositories) == 0 && series.RepositoryScope.RepositoryCriteria == nil && !isSymbolReferencesSeries(series.SymbolReferences) {
 		matchingSeries, foundSeries, err = tx.FindMatchingSeries(ctx, store.MatchSeriesArgs{
 			Query:                     series.Query,
 			StepIntervalUnit:          series.TimeScope.StepInterval.Unit,
@@ -1399,6 +1418,9 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 }
 
 func searchGenerationMethod(series graphqlbackend.LineChartSearchInsightDataSeriesInput) types.GenerationMethod {
+	if isSymbolReferencesSeries(series.SymbolReferences) {
+		return types.ReferenceCount
+	}
 	if series.GeneratedFromCaptureGroups != nil && *series.GeneratedFromCaptureGroups {
 		if series.GroupBy != nil {
 			return types.MappingCompute
@@ -1627,6 +1649,14 @@ func isValidSeriesInput(seriesInput graphqlbackend.LineChartSearchInsightDataSer
 	if !repoListSpecified && seriesInput.GroupBy != nil {
 		return errors.New("group by series require a list of repositories to be specified.")
 	}
+	if isSymbolReferencesSeries(seriesInput.SymbolReferences) {
+		if seriesInput.GroupBy != nil || isCaptureGroupSeries(seriesInput.GeneratedFromCaptureGroups) {
+			return errors.New("symbol references series can not be generated from capture groups or grouped.")
+		}
+		if scip.IsLocalSymbol(seriesInput.Query) {
+			return errors.New("symbol references series require a global symbol.")
+		}
+	}
 
 	if repoCriteriaSpecified {
 		plan, err := querybuilder.ParseQuery(*seriesInput.RepositoryScope.RepositoryCriteria, "literal")
@@ -0,0 +1,236 @@
+package resolvers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/own/types"
+	itypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var (
+	_ graphqlbackend.OwnCoverageReportResolver           = &ownCoverageReportResolver{}
+	_ graphqlbackend.OwnCoverageReportConnectionResolver = &ownCoverageReportConnectionResolver{}
+)
+
+func (r *ownResolver) OwnCoverageReports(ctx context.Context, args *graphqlbackend.OwnCoverageReportsArgs) (graphqlbackend.OwnCoverageReportConnectionResolver, error) {
+	// 🚨 SECURITY: Coverage reports of all repositories are only listed for site admins.
+	if err := auth.CheckCurrentActorIsSiteAdmin(actor.FromContext(ctx), r.db); err != nil {
+		return nil, err
+	}
+	connectionResolver := &ownCoverageReportConnectionResolver{
+		db:        r.db,
+		gitserver: r.gitserver,
+	}
+	if args.After != nil {
+		offset, err := graphqlutil.DecodeIntCursor(args.After)
+		if err != nil {
+			return nil, err
+		}
+		connectionResolver.offset = offset
+	}
+	if args.First != nil {
+		connectionResolver.limit = int(*args.First)
+	}
+	return connectionResolver, nil
+}
+
+func (r *ownResolver) RepoOwnCoverageReport(ctx context.Context, repoID api.RepoID) (graphqlbackend.OwnCoverageReportResolver, error) {
+	// This endpoint is open to anyone.
+	// The repository store makes sure the viewer has access to the repository.
+	repo, err := r.db.Repos().Get(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+	report, err := r.db.OwnCoverageReports().GetByRepoID(ctx, repoID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ownCoverageReportResolver{
+		db:         r.db,
+		gitserver:  r.gitserver,
+		report:     report,
+		repository: repo,
+	}, nil
+}
+
+type ownCoverageReportResolver struct {
+	db         database.DB
+	gitserver  gitserver.Client
+	report     *types.OwnCoverageReport
+	repository *itypes.Repo
+}
+
+func (r *ownCoverageReportResolver) Repository(ctx context.Context) (*graphqlbackend.RepositoryResolver, error) {
+	if r.repository == nil {
+		repo, err := r.db.Repos().Get(ctx, r.report.RepoID)
+		if err != nil {
+			return nil, err
+		}
+		r.repository = repo
+	}
+	return graphqlbackend.NewRepositoryResolver(r.db, r.gitserver, r.repository), nil
+}
+
+func (r *ownCoverageReportResolver) Commit() string {
+	return string(r.report.CommitID)
+}
+
+func (r *ownCoverageReportResolver) TotalFiles() int32 {
+	return int32(r.report.TotalFileCount)
+}
+
+func (r *ownCoverageReportResolver) TotalOwnedFiles() int32 {
+	return int32(r.report.OwnedFileCount)
+}
+
+func (r *ownCoverageReportResolver) Coverage() float64 {
+	return r.report.Coverage() * 100
+}
+
+func (r *ownCoverageReportResolver) UnownedDirectories() []string {
+	if r.report.UnownedDirectories == nil {
+		return []string{}
+	}
+	return r.report.UnownedDirectories
+}
+
+func (r *ownCoverageReportResolver) UnmatchedRules() []graphqlbackend.OwnCoverageRuleResolver {
+	return newOwnCoverageRuleResolvers(r.report.UnmatchedRules)
+}
+
+func (r *ownCoverageReportResolver) UnresolvedOwners() []graphqlbackend.OwnCoverageUnresolvedOwnerResolver {
+	resolvers := make([]graphqlbackend.OwnCoverageUnresolvedOwnerResolver, 0, len(r.report.UnresolvedOwners))
+	for _, o := range r.report.UnresolvedOwners {
+		resolvers = append(resolvers, &ownCoverageUnresolvedOwnerResolver{owner: o})
+	}
+	return resolvers
+}
+
+func (r *ownCoverageReportResolver) UpdatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.report.UpdatedAt}
+}
+
+type ownCoverageRuleResolver struct {
+	rule types.OwnCoverageRule
+}
+
+func newOwnCoverageRuleResolvers(rules []types.OwnCoverageRule) []graphqlbackend.OwnCoverageRuleResolver {
+	resolvers := make([]graphqlbackend.OwnCoverageRuleResolver, 0, len(rules))
+	for _, rule := range rules {
+		resolvers = append(resolvers, &ownCoverageRuleResolver{rule: rule})
+	}
+	return resolvers
+}
+
+func (r *ownCoverageRuleResolver) Pattern() string {
+	return r.rule.Pattern
+}
+
+func (r *ownCoverageRuleResolver) FilePath() *string {
+	return nonEmptyStringPtr(r.rule.FilePath)
+}
+
+func (r *ownCoverageRuleResolver) LineNumber() int32 {
+	return r.rule.LineNumber
+}
+
+type ownCoverageUnresolvedOwnerResolver struct {
+	owner types.OwnCoverageUnresolvedOwner
+}
+
+func (r *ownCoverageUnresolvedOwnerResolver) Handle() *string {
+	return nonEmptyStringPtr(r.owner.Handle)
+}
+
+func (r *ownCoverageUnresolvedOwnerResolver) Email() *string {
+	return nonEmptyStringPtr(r.owner.Email)
+}
+
+func (r *ownCoverageUnresolvedOwnerResolver) Rules() []graphqlbackend.OwnCoverageRuleResolver {
+	return newOwnCoverageRuleResolvers(r.owner.Rules)
+}
+
+func nonEmptyStringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+type ownCoverageReportConnectionResolver struct {
+	db        database.DB
+	gitserver gitserver.Client
+
+	once     sync.Once
+	offset   int
+	limit    int
+	pageInfo *graphqlutil.PageInfo
+	err      error
+
+	reports []*types.OwnCoverageReport
+}
+
+func (r *ownCoverageReportConnectionResolver) compute(ctx context.Context) {
+	r.once.Do(func() {
+		opts := database.ListOwnCoverageReportsOpts{}
+		// Fetch one more report than requested to know whether there is a next page.
+		if r.limit != 0 {
+			opts.LimitOffset = &database.LimitOffset{Limit: r.limit + 1, Offset: r.offset}
+		} else if r.offset != 0 {
+			opts.LimitOffset = &database.LimitOffset{Offset: r.offset}
+		}
+		reports, err := r.db.OwnCoverageReports().List(ctx, opts)
+		if err != nil {
+			r.err = errors.Wrap(err, "OwnCoverageReports.List")
+			return
+		}
+		if r.limit != 0 && len(reports) > r.limit {
+			r.reports = reports[:r.limit]
+			next := int32(r.offset + r.limit)
+			r.pageInfo = graphqlutil.EncodeIntCursor(&next)
+		} else {
+			r.reports = reports
+			r.pageInfo = graphqlutil.HasNextPage(false)
+		}
+	})
+}
+
+func (r *ownCoverageReportConnectionResolver) Nodes(ctx context.Context) ([]graphqlbackend.OwnCoverageReportResolver, error) {
+	r.compute(ctx)
+	if r.err != nil {
+		return nil, r.err
+	}
+	resolvers := make([]graphqlbackend.OwnCoverageReportResolver, 0, len(r.reports))
+	for _, report := range r.reports {
+		resolvers = append(resolvers, &ownCoverageReportResolver{
+			db:        r.db,
+			gitserver: r.gitserver,
+			report:    report,
+		})
+	}
+	return resolvers, nil
+}
+
+func (r *ownCoverageReportConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	count, err := r.db.OwnCoverageReports().Count(ctx)
+	return int32(count), err
+}
+
+func (r *ownCoverageReportConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
+	r.compute(ctx)
+	return r.pageInfo, r.err
+}
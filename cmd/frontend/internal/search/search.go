@@ -408,12 +408,19 @@ func fromSymbolMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.Searc
 			kindString = strings.ToUpper(kind.String())
 		}
 
+		provenance := sym.Symbol.Provenance
+		if provenance == "" {
+			provenance = result.SymbolProvenanceSearchBased
+		}
+
 		symbols = append(symbols, streamhttp.Symbol{
-			URL:           sym.URL().String(),
-			Name:          sym.Symbol.Name,
-			ContainerName: sym.Symbol.Parent,
-			Kind:          kindString,
-			Line:          int32(sym.Symbol.Line),
+			URL:                sym.URL().String(),
+			Name:               sym.Symbol.Name,
+			FullyQualifiedName: sym.Symbol.FullyQualifiedName,
+			ContainerName:      sym.Symbol.Parent,
+			Kind:               kindString,
+			Line:               int32(sym.Symbol.Line),
+			Provenance:         string(provenance),
 		})
 	}
 
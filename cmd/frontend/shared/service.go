@@ -6,6 +6,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/cli"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/codeintel"
+	executor "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/debugserver"
 	"github.com/sourcegraph/sourcegraph/internal/env"
@@ -24,6 +25,7 @@ func (svc) Name() string { return "frontend" }
 func (svc) Configure() (env.Config, []debugserver.Endpoint) {
 	CLILoadConfig()
 	codeintel.LoadConfig()
+	executor.LoadConfig()
 	search.LoadConfig()
 	return nil, CreateDebugServerEndpoints()
 }
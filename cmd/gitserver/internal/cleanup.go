@@ -109,6 +109,9 @@ const (
 	// repoTTLGC is how often we should re-clone a repository once it is
 	// reporting git gc issues.
 	repoTTLGC = 2 * day
+	// partialCloneTTL is how long a partial clone is kept without being resumed
+	// before it is removed.
+	partialCloneTTL = 1 * day
 	// gitConfigMaybeCorrupt is a key we add to git config to signal that a repo may be
 	// corrupt on disk.
 	gitConfigMaybeCorrupt = "sourcegraph.maybeCorruptRepo"
@@ -592,6 +595,12 @@ func cleanupRepos(
 		logger.Error("error iterating over repositories", log.Error(err))
 	}
 
+	// Partial clones are kept so that an interrupted clone can be resumed, but
+	// we don't want to keep them around forever if the clone is never retried.
+	if err := removeStalePartialClones(logger, reposDir, partialCloneTTL); err != nil {
+		logger.Error("error removing stale partial clones", log.Error(err))
+	}
+
 	if len(repoToSize) > 0 {
 		_, err := db.GitserverRepos().UpdateRepoSizes(ctx, shardID, repoToSize)
 		if err != nil {
@@ -761,6 +770,30 @@ func gitDirModTime(d common.GitDir) (time.Time, error) {
 	return head.ModTime(), nil
 }
 
+// removeStalePartialClones removes the partial clones under reposDir that have
+// not been modified for maxAge.
+func removeStalePartialClones(logger log.Logger, reposDir string, maxAge time.Duration) error {
+	root := filepath.Join(reposDir, gitserverfs.PartialClonesDirName)
+	return gitserverfs.BestEffortWalk(root, func(dir string, fi fs.DirEntry) error {
+		if !fi.IsDir() || fi.Name() != ".git" {
+			return nil
+		}
+
+		info, err := fi.Info()
+		if err != nil {
+			return err
+		}
+		if age := time.Since(info.ModTime()); age >= maxAge {
+			logger.Info("removing stale partial clone", log.String("path", dir), log.Duration("age", age))
+			if err := os.RemoveAll(filepath.Dir(dir)); err != nil {
+				return err
+			}
+		}
+
+		return filepath.SkipDir
+	})
+}
+
 // iterateGitDirs walks over the reposDir on disk and calls walkFn for each of the
 // git directories found on disk.
 func iterateGitDirs(reposDir string, walkFn func(common.GitDir) (done bool)) error {
@@ -1040,13 +1073,17 @@ func pruneIfNeeded(rcf *wrexec.RecordingCommandFactory, reposDir string, dir com
 
 func needsMaintenance(dir common.GitDir) (bool, string, error) {
 	// Bitmaps store reachability information about the set of objects in a
-	// packfile which speeds up clone and fetch operations.
-	hasBm, err := hasBitmap(dir)
-	if err != nil {
-		return false, "", err
-	}
-	if !hasBm {
-		return true, "bitmap", nil
+	// packfile which speeds up clone and fetch operations. git doesn't write
+	// bitmaps for partial clones, because their promisor packs don't contain
+	// all reachable objects, so we must not require one for them.
+	if !git.IsPartialClone(dir) {
+		hasBm, err := hasBitmap(dir)
+		if err != nil {
+			return false, "", err
+		}
+		if !hasBm {
+			return true, "bitmap", nil
+		}
 	}
 
 	// The commit-graph file is a supplemental data structure that accelerates
@@ -1129,7 +1166,9 @@ func hasCommitGraph(dir common.GitDir) (bool, error) {
 }
 
 // tooManyPackfiles counts the packfiles in objects/pack. Packfiles with an
-// accompanying .keep file are ignored.
+// accompanying .keep file are ignored. Promisor packs are counted like any other
+// packfile: partial clones get a new one for every lazy fetch of missing blobs,
+// and repacking combines them into a single promisor pack.
 func tooManyPackfiles(dir common.GitDir, limit int) (bool, error) {
 	packs, err := filepath.Glob(dir.Path("objects", "pack", "*.pack"))
 	if err != nil {
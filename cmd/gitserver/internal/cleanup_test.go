@@ -1174,6 +1174,37 @@ git commit-graph write --reachable --changed-paths
 	}
 }
 
+func TestNeedsMaintenance_PartialClone(t *testing.T) {
+	dir := t.TempDir()
+	gitDir := prepareEmptyGitRepo(t, dir)
+
+	// git doesn't write bitmaps for partial clones, so we mark the pack as a
+	// promisor pack after repacking without a bitmap.
+	script := `echo acont > afile
+git add afile
+git commit -am amsg
+git repack -d -l -A
+git commit-graph write --reachable --changed-paths
+for pack in .git/objects/pack/*.pack; do touch "${pack%.pack}.promisor"; done
+`
+	cmd := exec.Command("/bin/sh", "-euxc", script)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("out=%s, err=%s", out, err)
+	}
+
+	needed, reason, err := needsMaintenance(gitDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reason != "skipped" {
+		t.Fatalf("want %s, got %s", "skipped", reason)
+	}
+	if needed {
+		t.Fatal("partial clones without a bitmap don't need maintenance")
+	}
+}
+
 func TestPruneIfNeeded(t *testing.T) {
 	reposDir := t.TempDir()
 	gitDir := prepareEmptyGitRepo(t, reposDir)
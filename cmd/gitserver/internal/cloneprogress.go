@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+)
+
+// gitProgressPattern matches the progress lines git prints while cloning or
+// fetching, for example:
+//
+//	remote: Compressing objects:  50% (1/2)
+//	Receiving objects:  45% (450/1000), 1.20 MiB | 2.00 MiB/s
+//	Resolving deltas:   9% (117/1263)
+var gitProgressPattern = lazyregexp.New(`^(?:remote: )?([A-Za-z][A-Za-z ]*):\s+\d+% \((\d+)/(\d+)\)(?:, ([0-9.]+) (bytes?|KiB|MiB|GiB|TiB))?`)
+
+var byteUnits = map[string]float64{
+	"byte":  1,
+	"bytes": 1,
+	"KiB":   1 << 10,
+	"MiB":   1 << 20,
+	"GiB":   1 << 30,
+	"TiB":   1 << 40,
+}
+
+// cloneProgressTracker turns the progress output of a running clone into
+// structured progress. It is safe for concurrent use.
+type cloneProgressTracker struct {
+	resumed bool
+	now     func() time.Time
+
+	mu         sync.Mutex
+	phase      string
+	phaseStart time.Time
+	received   int64
+	total      int64
+	bytes      int64
+}
+
+func newCloneProgressTracker(resumed bool) *cloneProgressTracker {
+	return &cloneProgressTracker{resumed: resumed, now: time.Now}
+}
+
+// Update records the progress reported by a line of clone output. Lines that
+// don't report progress are ignored.
+func (t *cloneProgressTracker) Update(line string) {
+	m := gitProgressPattern.FindStringSubmatch(line)
+	if m == nil {
+		return
+	}
+	received, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return
+	}
+	total, err := strconv.ParseInt(m[3], 10, 64)
+	if err != nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if m[1] != t.phase {
+		t.phase = m[1]
+		t.phaseStart = t.now()
+	}
+	t.received = received
+	t.total = total
+
+	// git only reports the amount of data received while receiving objects,
+	// so we keep the last reported value for the later phases.
+	if m[4] != "" {
+		if size, err := strconv.ParseFloat(m[4], 64); err == nil {
+			t.bytes = int64(size * byteUnits[m[5]])
+		}
+	}
+}
+
+// Details returns the structured progress of the clone, or nil if no progress
+// has been reported yet.
+func (t *cloneProgressTracker) Details() *protocol.CloneProgressDetails {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.phase == "" {
+		return nil
+	}
+
+	details := &protocol.CloneProgressDetails{
+		Phase:           t.phase,
+		ObjectsReceived: t.received,
+		ObjectsTotal:    t.total,
+		BytesReceived:   t.bytes,
+		Resumed:         t.resumed,
+	}
+
+	// Estimate the time remaining from the rate at which objects were
+	// processed so far in the current phase.
+	if t.received >= t.total {
+		eta := time.Duration(0)
+		details.ETA = &eta
+	} else if elapsed := t.now().Sub(t.phaseStart); t.received > 0 && elapsed > 0 {
+		eta := time.Duration(float64(elapsed) * float64(t.total-t.received) / float64(t.received))
+		details.ETA = &eta
+	}
+
+	return details
+}
+
+// cloneProgressTrackers holds the progress trackers of the clones that are
+// currently running. The zero value is ready to use.
+type cloneProgressTrackers struct {
+	m sync.Map // map[common.GitDir]*cloneProgressTracker
+}
+
+func (c *cloneProgressTrackers) Set(dir common.GitDir, t *cloneProgressTracker) {
+	c.m.Store(dir, t)
+}
+
+func (c *cloneProgressTrackers) Delete(dir common.GitDir) {
+	c.m.Delete(dir)
+}
+
+// Details returns the structured progress of the clone of dir, or nil if dir
+// is not being cloned.
+func (c *cloneProgressTrackers) Details(dir common.GitDir) *protocol.CloneProgressDetails {
+	t, ok := c.m.Load(dir)
+	if !ok {
+		return nil
+	}
+	return t.(*cloneProgressTracker).Details()
+}
@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+)
+
+func TestCloneProgressTracker(t *testing.T) {
+	now := time.Date(2023, 11, 1, 12, 0, 0, 0, time.UTC)
+	tracker := newCloneProgressTracker(true)
+	tracker.now = func() time.Time { return now }
+
+	duration := func(d time.Duration) *time.Duration { return &d }
+
+	if got := tracker.Details(); got != nil {
+		t.Fatalf("expected no details before any progress, got %+v", got)
+	}
+
+	tracker.Update("Fetching remote contents")
+	if got := tracker.Details(); got != nil {
+		t.Fatalf("expected no details for a line without progress, got %+v", got)
+	}
+
+	tracker.Update("remote: Counting objects: 100% (1000/1000), done.")
+	tracker.Update("Receiving objects:   0% (1/1000)")
+	now = now.Add(10 * time.Second)
+	tracker.Update("Receiving objects:  25% (250/1000), 1.50 MiB | 2.00 MiB/s")
+
+	want := &protocol.CloneProgressDetails{
+		Phase:           "Receiving objects",
+		ObjectsReceived: 250,
+		ObjectsTotal:    1000,
+		BytesReceived:   1572864,
+		ETA:             duration(30 * time.Second),
+		Resumed:         true,
+	}
+	if diff := cmp.Diff(want, tracker.Details()); diff != "" {
+		t.Fatalf("unexpected details (-want +got):\n%s", diff)
+	}
+
+	// The amount of data received is kept in later phases, and the ETA is
+	// estimated from the start of the phase.
+	tracker.Update("Resolving deltas:   0% (0/400)")
+	want = &protocol.CloneProgressDetails{
+		Phase:           "Resolving deltas",
+		ObjectsReceived: 0,
+		ObjectsTotal:    400,
+		BytesReceived:   1572864,
+		Resumed:         true,
+	}
+	if diff := cmp.Diff(want, tracker.Details()); diff != "" {
+		t.Fatalf("unexpected details (-want +got):\n%s", diff)
+	}
+
+	now = now.Add(5 * time.Second)
+	tracker.Update("Resolving deltas: 100% (400/400), done.")
+	want.ObjectsReceived = 400
+	want.ETA = duration(0)
+	if diff := cmp.Diff(want, tracker.Details()); diff != "" {
+		t.Fatalf("unexpected details (-want +got):\n%s", diff)
+	}
+}
+
+func TestCloneProgressTrackers(t *testing.T) {
+	var trackers cloneProgressTrackers
+	dir := common.GitDir("/repos/github.com/foo/bar/.git")
+
+	if got := trackers.Details(dir); got != nil {
+		t.Fatalf("expected no details for a repo that is not being cloned, got %+v", got)
+	}
+
+	tracker := newCloneProgressTracker(false)
+	tracker.Update("Receiving objects: 100% (5/5), 420 bytes | 420.00 KiB/s, done.")
+	trackers.Set(dir, tracker)
+
+	want := &protocol.CloneProgressDetails{
+		Phase:           "Receiving objects",
+		ObjectsReceived: 5,
+		ObjectsTotal:    5,
+		BytesReceived:   420,
+		ETA:             func() *time.Duration { d := time.Duration(0); return &d }(),
+	}
+	if diff := cmp.Diff(want, trackers.Details(dir)); diff != "" {
+		t.Fatalf("unexpected details (-want +got):\n%s", diff)
+	}
+
+	trackers.Delete(dir)
+	if got := trackers.Details(dir); got != nil {
+		t.Fatalf("expected no details after the clone finished, got %+v", got)
+	}
+}
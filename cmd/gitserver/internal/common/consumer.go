@@ -0,0 +1,78 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+// slowJobThreshold is the processing time above which a job of a queue is logged as slow.
+//
+// NOTE: Hardcoded at the moment. Will revisit this if it becomes noisy under production loads.
+const slowJobThreshold = time.Minute
+
+// StartQueueConsumer starts a producer-consumer pipeline that "pops" jobs from the queue and
+// "produces" them for "consumption" by process, until ctx is done. Jobs are processed one at a
+// time for a simpler pipeline at the moment, so process should log its own errors.
+func StartQueueConsumer[T any](ctx context.Context, logger log.Logger, queue *Queue[T], process func(context.Context, T)) {
+	tasks := make(chan *queueTask[T])
+
+	// Protect against panics.
+	goroutine.Go(func() { consumeQueue(ctx, logger, tasks, process) })
+	goroutine.Go(func() { produceQueue(ctx, logger, queue, tasks) })
+}
+
+// queueTask is a thin wrapper around a job to associate the doneFunc with it.
+type queueTask[T any] struct {
+	job  T
+	done func() time.Duration
+}
+
+// produceQueue "pops" jobs from the FIFO queue and produces them for "consumption".
+func produceQueue[T any](ctx context.Context, logger log.Logger, queue *Queue[T], tasks chan<- *queueTask[T]) {
+	defer close(tasks)
+
+	for {
+		queue.Mutex.Lock()
+		if queue.Empty() {
+			queue.Cond.Wait()
+		}
+
+		queue.Mutex.Unlock()
+
+		for {
+			job, doneFunc := queue.Pop()
+			if job == nil {
+				break
+			}
+
+			select {
+			case tasks <- &queueTask[T]{job: *job, done: doneFunc}:
+			case <-ctx.Done():
+				logger.Error("queue producer: ", log.Error(ctx.Err()))
+				return
+			}
+		}
+	}
+}
+
+// consumeQueue "consumes" jobs "produced" by the producer.
+func consumeQueue[T any](ctx context.Context, logger log.Logger, tasks <-chan *queueTask[T], process func(context.Context, T)) {
+	for task := range tasks {
+		select {
+		case <-ctx.Done():
+			logger.Error("context done", log.Error(ctx.Err()))
+			return
+		default:
+		}
+
+		process(ctx, task.job)
+
+		if timeTaken := task.done(); timeTaken > slowJobThreshold {
+			logger.Warn("job took long to complete", log.Duration("duration", timeTaken))
+		}
+	}
+}
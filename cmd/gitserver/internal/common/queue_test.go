@@ -2,9 +2,11 @@ package common
 
 import (
 	"container/list"
+	"context"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/stretchr/testify/require"
 )
@@ -53,3 +55,23 @@ func TestQueue(t *testing.T) {
 		t.Error("Expected queue to be empty after popping all elements")
 	}
 }
+
+func TestStartQueueConsumer(t *testing.T) {
+	queue := NewQueue[*testJob](observation.TestContextTB(t), "test-consumer", list.New())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	processed := make(chan string)
+	StartQueueConsumer(ctx, logtest.Scoped(t), queue, func(_ context.Context, job *testJob) {
+		processed <- job.Value
+	})
+
+	// Push 1, 2 and 3 into the queue, and expect them to be processed in that order.
+	for _, v := range []string{"1", "2", "3"} {
+		queue.Push(&testJob{Value: v})
+	}
+	for _, want := range []string{"1", "2", "3"} {
+		require.Equal(t, want, <-processed)
+	}
+}
@@ -0,0 +1,122 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// BlameOptions are options for Blame.
+type BlameOptions struct {
+	NewestCommit     api.CommitID
+	IgnoreWhitespace bool
+	// StartLine and EndLine are the 1-indexed, inclusive range of lines to
+	// blame. If both are zero, the whole file is blamed.
+	StartLine int
+	EndLine   int
+}
+
+// Blame runs `git blame --porcelain --incremental` on the file at path and
+// returns a reader of its output. Closing the reader waits for the command to
+// exit and returns its error, if any. The command runs with env, or the
+// environment of gitserver if env is nil.
+func Blame(ctx context.Context, rcf *wrexec.RecordingCommandFactory, reposDir string, repo api.RepoName, path string, opts BlameOptions, env []string) (io.ReadCloser, error) {
+	if err := CheckSpecArgSafety(string(opts.NewestCommit)); err != nil {
+		return nil, err
+	}
+
+	args := []string{"blame", "--porcelain", "--incremental"}
+	if opts.IgnoreWhitespace {
+		args = append(args, "-w")
+	}
+	if opts.StartLine != 0 || opts.EndLine != 0 {
+		args = append(args, fmt.Sprintf("-L%d,%d", opts.StartLine, opts.EndLine))
+	}
+	args = append(args, string(opts.NewestCommit), "--", filepath.ToSlash(path))
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = env
+	gitserverfs.RepoDirFromName(reposDir, repo).Set(cmd)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	wrappedCmd := rcf.WrapWithRepoName(ctx, log.NoOp(), repo, cmd)
+	stdout, err := wrappedCmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := wrappedCmd.Start(); err != nil {
+		return nil, commandFailedError(err, wrappedCmd.Args)
+	}
+
+	return &commandReader{
+		ReadCloser: stdout,
+		wait: func() error {
+			if err := wrappedCmd.Wait(); err != nil {
+				return errors.WithMessage(err, fmt.Sprintf("git command %v failed (stderr: %q)", wrappedCmd.Args, stderr.Bytes()))
+			}
+			return nil
+		},
+	}, nil
+}
+
+// commandReader reads the stdout of a running command. Closing it waits for
+// the command to exit.
+type commandReader struct {
+	io.ReadCloser
+	wait func() error
+}
+
+func (r *commandReader) Close() error {
+	// Wait closes the pipe, so a Close on the embedded reader is not needed.
+	return r.wait()
+}
+
+// LineOffsets returns the byte offsets of the start of each line of the file
+// at path in the given commit. The returned slice has one more element than
+// the file has lines; the last element is the size of the file. The command
+// runs with env, or the environment of gitserver if env is nil.
+func LineOffsets(ctx context.Context, rcf *wrexec.RecordingCommandFactory, reposDir string, repo api.RepoName, commit api.CommitID, path string, env []string) ([]int, error) {
+	if err := CheckSpecArgSafety(string(commit)); err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "cat-file", "blob", fmt.Sprintf("%s:%s", commit, filepath.ToSlash(path)))
+	cmd.Env = env
+	gitserverfs.RepoDirFromName(reposDir, repo).Set(cmd)
+	wrappedCmd := rcf.WrapWithRepoName(ctx, log.NoOp(), repo, cmd)
+	content, err := wrappedCmd.Output()
+	if err != nil {
+		stderr := stderrOf(err)
+		if bytes.Contains(stderr, []byte("does not exist")) || bytes.Contains(stderr, []byte("exists on disk, but not in")) {
+			return nil, &os.PathError{Op: "blame", Path: path, Err: os.ErrNotExist}
+		}
+		if IsRevisionNotFound(err) {
+			return nil, &gitdomain.RevisionNotFoundError{Repo: repo, Spec: string(commit)}
+		}
+		return nil, commandFailedError(err, wrappedCmd.Args)
+	}
+
+	return lineOffsets(content), nil
+}
+
+func lineOffsets(content []byte) []int {
+	offsets := []int{0}
+	for i, b := range content {
+		if b == '\n' && i != len(content)-1 {
+			offsets = append(offsets, i+1)
+		}
+	}
+	return append(offsets, len(content))
+}
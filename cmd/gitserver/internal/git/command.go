@@ -0,0 +1,48 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// commandFailedError wraps the error of a failed git command with its
+// arguments and, if available, its stderr output.
+func commandFailedError(err error, args []string) error {
+	return errors.WithMessage(err, fmt.Sprintf("git command %v failed (stderr: %q)", args, stderrOf(err)))
+}
+
+// stderrOf returns the stderr output captured in err, if any.
+func stderrOf(err error) []byte {
+	var e *exec.ExitError
+	if errors.As(err, &e) {
+		return e.Stderr
+	}
+	return nil
+}
+
+// revisionNotFoundMessages are the fragments of git error messages that
+// indicate that a revision does not exist in a repository.
+var revisionNotFoundMessages = [][]byte{
+	[]byte("unknown revision"),
+	[]byte("bad revision"),
+	[]byte("bad object"),
+	[]byte("not a valid object name"),
+	[]byte("Not a valid object name"),
+	[]byte("Needed a single revision"),
+	[]byte("invalid object name"),
+}
+
+// IsRevisionNotFound reports whether err is the error of a git command that
+// failed because a revision does not exist.
+func IsRevisionNotFound(err error) bool {
+	stderr := stderrOf(err)
+	for _, msg := range revisionNotFoundMessages {
+		if bytes.Contains(stderr, msg) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/mail"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// MergeBase returns the best common ancestor of base and head. If either of
+// the revisions doesn't exist, a gitdomain.RevisionNotFoundError is returned.
+func MergeBase(ctx context.Context, rcf *wrexec.RecordingCommandFactory, reposDir string, repo api.RepoName, base, head string) (api.CommitID, error) {
+	if err := CheckSpecArgSafety(base); err != nil {
+		return "", err
+	}
+	if err := CheckSpecArgSafety(head); err != nil {
+		return "", err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "merge-base", "--", base, head)
+	gitserverfs.RepoDirFromName(reposDir, repo).Set(cmd)
+	wrappedCmd := rcf.WrapWithRepoName(ctx, log.NoOp(), repo, cmd)
+	out, err := wrappedCmd.Output()
+	if err != nil {
+		if IsRevisionNotFound(err) {
+			return "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: fmt.Sprintf("%s...%s", base, head)}
+		}
+		return "", commandFailedError(err, wrappedCmd.Args)
+	}
+	return api.CommitID(bytes.TrimSpace(out)), nil
+}
+
+// ContributorCountsOpts are options for ContributorCounts.
+type ContributorCountsOpts struct {
+	// Range is the revision range to consider. If empty, HEAD is used.
+	Range string
+	// After only counts commits more recent than the given git date.
+	After string
+	// Path only counts commits that modify the given path.
+	Path string
+}
+
+// ContributorCounts returns the number of non-merge commits per author in the
+// given range, sorted by descending count.
+func ContributorCounts(ctx context.Context, rcf *wrexec.RecordingCommandFactory, reposDir string, repo api.RepoName, opts ContributorCountsOpts) ([]*gitdomain.ContributorCount, error) {
+	if opts.Range == "" {
+		opts.Range = "HEAD"
+	}
+	if err := CheckSpecArgSafety(opts.Range); err != nil {
+		return nil, err
+	}
+
+	args := []string{"shortlog", "-s", "-n", "-e", "--no-merges"}
+	if opts.After != "" {
+		args = append(args, "--after="+opts.After)
+	}
+	args = append(args, opts.Range, "--")
+	if opts.Path != "" {
+		args = append(args, opts.Path)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	gitserverfs.RepoDirFromName(reposDir, repo).Set(cmd)
+	wrappedCmd := rcf.WrapWithRepoName(ctx, log.NoOp(), repo, cmd)
+	out, err := wrappedCmd.Output()
+	if err != nil {
+		if IsRevisionNotFound(err) {
+			return nil, &gitdomain.RevisionNotFoundError{Repo: repo, Spec: opts.Range}
+		}
+		return nil, commandFailedError(err, wrappedCmd.Args)
+	}
+
+	return parseShortLog(out)
+}
+
+// logEntryPattern is the regexp pattern that matches entries in the output of the `git shortlog
+// -sne` command.
+var logEntryPattern = lazyregexp.New(`^\s*([0-9]+)\s+(.*)$`)
+
+func parseShortLog(out []byte) ([]*gitdomain.ContributorCount, error) {
+	out = bytes.TrimSpace(out)
+	if len(out) == 0 {
+		return nil, nil
+	}
+	lines := bytes.Split(out, []byte{'\n'})
+	results := make([]*gitdomain.ContributorCount, len(lines))
+	for i, line := range lines {
+		// example line: "1125\tJane Doe <jane@sourcegraph.com>"
+		match := logEntryPattern.FindSubmatch(line)
+		if match == nil {
+			return nil, errors.Errorf("invalid git shortlog line: %q", line)
+		}
+		// example match: ["1125\tJane Doe <jane@sourcegraph.com>" "1125" "Jane Doe <jane@sourcegraph.com>"]
+		count, err := strconv.Atoi(string(match[1]))
+		if err != nil {
+			return nil, err
+		}
+		addr, err := lenientParseAddress(string(match[2]))
+		if err != nil || addr == nil {
+			addr = &mail.Address{Name: string(match[2])}
+		}
+		results[i] = &gitdomain.ContributorCount{
+			Count: int32(count),
+			Name:  addr.Name,
+			Email: addr.Address,
+		}
+	}
+	return results, nil
+}
+
+// lenientParseAddress is just like mail.ParseAddress, except that it treats
+// the following somewhat-common malformed syntax where a user has misconfigured
+// their email address as their name:
+//
+//	foo@gmail.com <foo@gmail.com>
+//
+// As a valid name, whereas mail.ParseAddress would return an error:
+//
+//	mail: expected single address, got "<foo@gmail.com>"
+func lenientParseAddress(address string) (*mail.Address, error) {
+	addr, err := mail.ParseAddress(address)
+	if err != nil && strings.Contains(err.Error(), "expected single address") {
+		p := strings.LastIndex(address, "<")
+		if p == -1 {
+			return addr, err
+		}
+		return &mail.Address{
+			Name:    strings.TrimSpace(address[:p]),
+			Address: strings.Trim(address[p:], " <>"),
+		}, nil
+	}
+	return addr, err
+}
+
+// ResolveCommit returns the commit that rev refers to. If rev doesn't exist or
+// doesn't refer to a commit, a gitdomain.RevisionNotFoundError is returned.
+func ResolveCommit(ctx context.Context, rcf *wrexec.RecordingCommandFactory, reposDir string, repo api.RepoName, rev string) (api.CommitID, error) {
+	if err := CheckSpecArgSafety(rev); err != nil {
+		return "", err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--verify", "--end-of-options", rev+"^{commit}")
+	gitserverfs.RepoDirFromName(reposDir, repo).Set(cmd)
+	wrappedCmd := rcf.WrapWithRepoName(ctx, log.NoOp(), repo, cmd)
+	out, err := wrappedCmd.Output()
+	if err != nil {
+		if IsRevisionNotFound(err) {
+			return "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: rev}
+		}
+		return "", commandFailedError(err, wrappedCmd.Args)
+	}
+	return api.CommitID(bytes.TrimSpace(out)), nil
+}
@@ -0,0 +1,240 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// CommitLogOpts are options for CommitLog. They correspond to the options of
+// `git log` of the same name.
+type CommitLogOpts struct {
+	Range        string
+	MaxCommits   uint
+	Skip         uint
+	Author       string
+	After        string
+	Before       string
+	Reverse      bool
+	DateOrder    bool
+	MessageQuery string
+	Path         string
+	Follow       bool
+	// IncludeModifiedFiles lists the files modified by each commit.
+	IncludeModifiedFiles bool
+}
+
+// CommitLogEntry is a commit returned by CommitLog.
+type CommitLogEntry struct {
+	*gitdomain.Commit
+	// ModifiedFiles is only populated if IncludeModifiedFiles is set.
+	ModifiedFiles []string
+}
+
+const (
+	partsPerCommit = 10 // number of \x00-separated fields per commit
+
+	// logFormat has 10 parts: oid, author name, author email, author time,
+	// committer name, committer email, committer time, message body, parent
+	// hashes and the (optional) modified files. Each commit starts with an
+	// ASCII record separator byte (0x1E), and each field of the commit is
+	// separated by a null byte (0x00).
+	logFormat = "--format=format:%x1e%H%x00%aN%x00%aE%x00%at%x00%cN%x00%cE%x00%ct%x00%B%x00%P%x00"
+)
+
+// CommitLog runs `git log` with the given options and calls onCommit for every
+// commit, in the order git prints them. If the range doesn't exist, a
+// gitdomain.RevisionNotFoundError is returned.
+func CommitLog(ctx context.Context, rcf *wrexec.RecordingCommandFactory, reposDir string, repo api.RepoName, opts CommitLogOpts, onCommit func(*CommitLogEntry) error) error {
+	args, err := commitLogArgs(opts)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	gitserverfs.RepoDirFromName(reposDir, repo).Set(cmd)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	wrappedCmd := rcf.WrapWithRepoName(ctx, log.NoOp(), repo, cmd)
+	stdout, err := wrappedCmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := wrappedCmd.Start(); err != nil {
+		return commandFailedError(err, wrappedCmd.Args)
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 0, 64*1024), 256*1024*1024)
+	scanner.Split(commitSplitFunc)
+
+	var callbackErr error
+	for scanner.Scan() {
+		parts := bytes.Split(scanner.Bytes(), []byte{'\x00'})
+		if len(parts) != partsPerCommit {
+			callbackErr = errors.Newf("internal error: expected %d parts, got %d", partsPerCommit, len(parts))
+			break
+		}
+
+		commit, err := parseCommitFromLog(parts, opts.IncludeModifiedFiles)
+		if err != nil {
+			callbackErr = err
+			break
+		}
+		if err := onCommit(commit); err != nil {
+			callbackErr = err
+			break
+		}
+	}
+	if callbackErr != nil {
+		// Stop git from producing more output.
+		cancel()
+		_ = wrappedCmd.Wait()
+		return callbackErr
+	}
+	if err := scanner.Err(); err != nil {
+		cancel()
+		_ = wrappedCmd.Wait()
+		return err
+	}
+
+	if err := wrappedCmd.Wait(); err != nil {
+		if bytes.HasPrefix(bytes.TrimSpace(stderr.Bytes()), []byte("fatal: bad object")) || bytes.Contains(stderr.Bytes(), []byte("unknown revision")) {
+			return &gitdomain.RevisionNotFoundError{Repo: repo, Spec: opts.Range}
+		}
+		return errors.WithMessage(err, fmt.Sprintf("git command %v failed (stderr: %q)", wrappedCmd.Args, stderr.Bytes()))
+	}
+	return nil
+}
+
+func commitLogArgs(opts CommitLogOpts) ([]string, error) {
+	if err := CheckSpecArgSafety(opts.Range); err != nil {
+		return nil, err
+	}
+
+	args := []string{"log", logFormat}
+	if opts.MaxCommits != 0 {
+		args = append(args, "-n", strconv.FormatUint(uint64(opts.MaxCommits), 10))
+	}
+	if opts.Skip != 0 {
+		args = append(args, "--skip="+strconv.FormatUint(uint64(opts.Skip), 10))
+	}
+	if opts.Author != "" {
+		args = append(args, "--fixed-strings", "--author="+opts.Author)
+	}
+	if opts.After != "" {
+		args = append(args, "--after="+opts.After)
+	}
+	if opts.Before != "" {
+		args = append(args, "--before="+opts.Before)
+	}
+	if opts.Reverse {
+		args = append(args, "--reverse")
+	}
+	if opts.DateOrder {
+		args = append(args, "--date-order")
+	}
+	if opts.MessageQuery != "" {
+		args = append(args, "--fixed-strings", "--regexp-ignore-case", "--grep="+opts.MessageQuery)
+	}
+	if opts.Range != "" {
+		args = append(args, opts.Range)
+	}
+	if opts.IncludeModifiedFiles {
+		args = append(args, "--name-only")
+	}
+	if opts.Follow {
+		args = append(args, "--follow")
+	}
+	if opts.Path != "" {
+		args = append(args, "--", opts.Path)
+	}
+	return args, nil
+}
+
+func commitSplitFunc(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if len(data) == 0 {
+		// Request more data
+		return 0, nil, nil
+	}
+
+	// Safety check: ensure we are always starting with a record separator
+	if data[0] != '\x1e' {
+		return 0, nil, errors.New("internal error: data should always start with an ASCII record separator")
+	}
+
+	loc := bytes.IndexByte(data[1:], '\x1e')
+	if loc < 0 {
+		// We can't find the start of the next record
+		if atEOF {
+			// If we're at the end of the stream, just return the rest as the last record
+			return len(data), data[1:], bufio.ErrFinalToken
+		}
+		// If we're not at the end of the stream, request more data
+		return 0, nil, nil
+	}
+	nextStart := loc + 1 // correct for searching at an offset
+
+	return nextStart, data[1:nextStart], nil
+}
+
+// parseCommitFromLog parses a single commit from the NUL-separated fields
+// formatted by logFormat.
+func parseCommitFromLog(parts [][]byte, includeModifiedFiles bool) (*CommitLogEntry, error) {
+	// log outputs are newline separated, so all but the 1st commit ID part
+	// has an erroneous leading newline.
+	parts[0] = bytes.TrimPrefix(parts[0], []byte{'\n'})
+	commitID := api.CommitID(parts[0])
+
+	authorTime, err := strconv.ParseInt(string(parts[3]), 10, 64)
+	if err != nil {
+		return nil, errors.Errorf("parsing git commit author time: %s", err)
+	}
+	committerTime, err := strconv.ParseInt(string(parts[6]), 10, 64)
+	if err != nil {
+		return nil, errors.Errorf("parsing git commit committer time: %s", err)
+	}
+
+	var parents []api.CommitID
+	if parentPart := parts[8]; len(parentPart) > 0 {
+		parentIDs := bytes.Split(parentPart, []byte{' '})
+		parents = make([]api.CommitID, len(parentIDs))
+		for i, id := range parentIDs {
+			parents[i] = api.CommitID(id)
+		}
+	}
+
+	var modifiedFiles []string
+	if includeModifiedFiles {
+		if files := bytes.TrimSpace(parts[9]); len(files) > 0 {
+			modifiedFiles = strings.Split(string(files), "\n")
+		}
+	}
+
+	return &CommitLogEntry{
+		Commit: &gitdomain.Commit{
+			ID:        commitID,
+			Author:    gitdomain.Signature{Name: string(parts[1]), Email: string(parts[2]), Date: time.Unix(authorTime, 0).UTC()},
+			Committer: &gitdomain.Signature{Name: string(parts[4]), Email: string(parts[5]), Date: time.Unix(committerTime, 0).UTC()},
+			Message:   gitdomain.Message(strings.TrimSuffix(string(parts[7]), "\n")),
+			Parents:   parents,
+		},
+		ModifiedFiles: modifiedFiles,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package git
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// PromisorRemote is the name of the remote from which partial clones fetch
+	// the objects they are missing.
+	PromisorRemote = "origin"
+
+	// PartialCloneFilter is the object filter of partial clones. They only
+	// store commits and trees, blobs are fetched when they are first read.
+	PartialCloneFilter = "blob:none"
+)
+
+// ConfigurePartialClone configures the bare repository in dir as a blobless
+// partial clone of PromisorRemote.
+//
+// The URL of the remote is not stored in the repository config, because it
+// can contain credentials. Commands that need to reach the remote must set it
+// with the environment returned by PromisorRemoteEnv.
+func ConfigurePartialClone(ctx context.Context, dir string) error {
+	for _, kv := range [][2]string{
+		{"core.repositoryformatversion", "1"},
+		{"extensions.partialClone", PromisorRemote},
+		{"remote." + PromisorRemote + ".promisor", "true"},
+		{"remote." + PromisorRemote + ".partialclonefilter", PartialCloneFilter},
+	} {
+		cmd := exec.CommandContext(ctx, "git", "config", kv[0], kv[1])
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return errors.Wrapf(err, "failed to set git config %s: %s", kv[0], string(out))
+		}
+	}
+	return nil
+}
+
+// IsPartialClone returns true if the repository in dir is a partial clone,
+// which is the case if it has at least one promisor pack.
+func IsPartialClone(dir common.GitDir) bool {
+	matches, err := filepath.Glob(dir.Path("objects", "pack", "*.promisor"))
+	return err == nil && len(matches) > 0
+}
+
+// PromisorRemoteEnv returns the environment variables that point
+// PromisorRemote of a partial clone at remoteURL, without persisting the URL
+// in the repository config.
+func PromisorRemoteEnv(remoteURL *vcs.URL) []string {
+	return []string{
+		"GIT_CONFIG_COUNT=1",
+		"GIT_CONFIG_KEY_0=remote." + PromisorRemote + ".url",
+		"GIT_CONFIG_VALUE_0=" + remoteURL.String(),
+	}
+}
@@ -0,0 +1,135 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ListRefsOpts are options for ListRefs.
+type ListRefsOpts struct {
+	// HeadsOnly restricts the result to refs under refs/heads/.
+	HeadsOnly bool
+	// TagsOnly restricts the result to refs under refs/tags/.
+	TagsOnly bool
+	// PointsAtCommit restricts the result to refs that point at any of the
+	// given commits.
+	PointsAtCommit []api.CommitID
+}
+
+// refFormat is the for-each-ref format used by ListRefs. Fields are separated
+// by NUL bytes. The starred fields refer to the object an annotated tag points
+// at and are empty for all other refs.
+const refFormat = "%(objectname)%00%(*objectname)%00%(refname)%00%(refname:short)%00%(HEAD)%00%(creatordate:unix)%00%(*creatordate:unix)%00%(objecttype)%00%(*objecttype)"
+
+// ListRefs returns the refs of the given repository, sorted by name.
+func ListRefs(ctx context.Context, rcf *wrexec.RecordingCommandFactory, reposDir string, repo api.RepoName, opts ListRefsOpts) ([]*gitdomain.Ref, error) {
+	args := []string{"for-each-ref", "--sort=refname", "--format=" + refFormat}
+	for _, commit := range opts.PointsAtCommit {
+		if err := CheckSpecArgSafety(string(commit)); err != nil {
+			return nil, err
+		}
+		args = append(args, "--points-at="+string(commit))
+	}
+	if opts.HeadsOnly {
+		args = append(args, "refs/heads/")
+	}
+	if opts.TagsOnly {
+		args = append(args, "refs/tags/")
+	}
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	gitserverfs.RepoDirFromName(reposDir, repo).Set(cmd)
+	wrappedCmd := rcf.WrapWithRepoName(ctx, log.NoOp(), repo, cmd)
+	out, err := wrappedCmd.Output()
+	if err != nil {
+		return nil, commandFailedError(err, wrappedCmd.Args)
+	}
+
+	return parseRefs(out)
+}
+
+func parseRefs(out []byte) ([]*gitdomain.Ref, error) {
+	lines := bytes.Split(bytes.TrimSuffix(out, []byte("\n")), []byte("\n"))
+	refs := make([]*gitdomain.Ref, 0, len(lines))
+	for _, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+
+		parts := strings.Split(string(line), "\x00")
+		if len(parts) != 9 {
+			return nil, errors.Errorf("invalid for-each-ref output line: %q", line)
+		}
+		objectName, derefObjectName, refName, shortName, head := parts[0], parts[1], parts[2], parts[3], parts[4]
+		createdAt, derefCreatedAt, objectType, derefObjectType := parts[5], parts[6], parts[7], parts[8]
+
+		ref := &gitdomain.Ref{
+			Name:      refName,
+			ShortName: shortName,
+			RefOID:    api.CommitID(objectName),
+			CommitID:  api.CommitID(objectName),
+			IsHead:    head == "*",
+		}
+
+		switch {
+		case strings.HasPrefix(refName, "refs/heads/"):
+			ref.Type = gitdomain.RefTypeBranch
+		case strings.HasPrefix(refName, "refs/tags/"):
+			ref.Type = gitdomain.RefTypeTag
+		}
+
+		if derefObjectName != "" {
+			// Annotated tag: report the object the tag points at.
+			ref.CommitID = api.CommitID(derefObjectName)
+		}
+
+		// Tags without a tagger date fall back to the date of the object they
+		// point at.
+		if createdAt == "" {
+			createdAt = derefCreatedAt
+		}
+		if createdAt != "" {
+			t, err := parseUnixTimestamp(createdAt)
+			if err != nil {
+				return nil, err
+			}
+			ref.CreatedDate = t
+		}
+
+		commitCreatedAt, commitType := createdAt, objectType
+		if derefObjectName != "" {
+			commitCreatedAt, commitType = derefCreatedAt, derefObjectType
+		}
+		if commitType == "commit" && commitCreatedAt != "" {
+			t, err := parseUnixTimestamp(commitCreatedAt)
+			if err != nil {
+				return nil, err
+			}
+			ref.CommitCreatedDate = &t
+		}
+
+		refs = append(refs, ref)
+	}
+
+	return refs, nil
+}
+
+func parseUnixTimestamp(s string) (time.Time, error) {
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "parsing timestamp %q", s)
+	}
+	return time.Unix(sec, 0).UTC(), nil
+}
@@ -0,0 +1,70 @@
+package git
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+func TestParseRefs(t *testing.T) {
+	out := []byte("" +
+		"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\x00\x00refs/heads/main\x00main\x00*\x001136214245\x00\x00commit\x00\n" +
+		"bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb\x00aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\x00refs/tags/v1\x00v1\x00 \x001262444705\x001136214245\x00tag\x00commit\n" +
+		"cccccccccccccccccccccccccccccccccccccccc\x00dddddddddddddddddddddddddddddddddddddddd\x00refs/tags/tree\x00tree\x00 \x00\x00\x00tag\x00tree\n" +
+		"eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee\x00\x00refs/pull/1/head\x00pull/1/head\x00 \x001136214245\x00\x00commit\x00\n")
+
+	refs, err := parseRefs(out)
+	require.NoError(t, err)
+
+	commitDate := time.Unix(1136214245, 0).UTC()
+	tagDate := time.Unix(1262444705, 0).UTC()
+	want := []*gitdomain.Ref{
+		{
+			Name:              "refs/heads/main",
+			ShortName:         "main",
+			Type:              gitdomain.RefTypeBranch,
+			CommitID:          "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			RefOID:            "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			CreatedDate:       commitDate,
+			CommitCreatedDate: &commitDate,
+			IsHead:            true,
+		},
+		{
+			Name:              "refs/tags/v1",
+			ShortName:         "v1",
+			Type:              gitdomain.RefTypeTag,
+			CommitID:          "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			RefOID:            "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+			CreatedDate:       tagDate,
+			CommitCreatedDate: &commitDate,
+		},
+		{
+			Name:      "refs/tags/tree",
+			ShortName: "tree",
+			Type:      gitdomain.RefTypeTag,
+			CommitID:  "dddddddddddddddddddddddddddddddddddddddd",
+			RefOID:    "cccccccccccccccccccccccccccccccccccccccc",
+		},
+		{
+			Name:              "refs/pull/1/head",
+			ShortName:         "pull/1/head",
+			CommitID:          "eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee",
+			RefOID:            "eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee",
+			CreatedDate:       commitDate,
+			CommitCreatedDate: &commitDate,
+		},
+	}
+	if diff := cmp.Diff(want, refs); diff != "" {
+		t.Errorf("unexpected refs (-want +got):\n%s", diff)
+	}
+}
+
+func TestLineOffsets(t *testing.T) {
+	require.Equal(t, []int{0, 6, 12}, lineOffsets([]byte("line1\nline2\n")))
+	require.Equal(t, []int{0, 6, 11}, lineOffsets([]byte("line1\nline2")))
+	require.Equal(t, []int{0, 0}, lineOffsets(nil))
+}
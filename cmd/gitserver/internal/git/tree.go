@@ -0,0 +1,202 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	stdlibpath "path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ObjectInfo is the value returned by (fs.FileInfo).Sys for blobs and trees
+// returned from ReadDir.
+type ObjectInfo gitdomain.OID
+
+func (oid ObjectInfo) OID() gitdomain.OID { return gitdomain.OID(oid) }
+
+// ReadDir lists the tree entries at path in the given commit. If path is
+// empty or has a trailing slash, the contents of the directory are listed,
+// otherwise the tree entry of path itself is returned. If recurse is true,
+// all entries below path are listed, including trees.
+//
+// The Sys field of the returned entries is a gitdomain.Submodule for
+// submodules and an ObjectInfo for all other entries.
+//
+// The git commands run with env, or the environment of gitserver if env is nil.
+func ReadDir(ctx context.Context, rcf *wrexec.RecordingCommandFactory, reposDir string, repo api.RepoName, commit api.CommitID, path string, recurse bool, env []string) ([]fs.FileInfo, error) {
+	if err := gitdomain.EnsureAbsoluteCommit(commit); err != nil {
+		return nil, err
+	}
+
+	if err := CheckSpecArgSafety(path); err != nil {
+		return nil, err
+	}
+
+	args := []string{
+		"ls-tree",
+		"--long", // show size
+		"--full-name",
+		"-z",
+		string(commit),
+	}
+	if recurse {
+		args = append(args, "-r", "-t")
+	}
+	if path != "" {
+		args = append(args, "--", filepath.ToSlash(path))
+	}
+
+	dir := gitserverfs.RepoDirFromName(reposDir, repo)
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = env
+	dir.Set(cmd)
+	wrappedCmd := rcf.WrapWithRepoName(ctx, log.NoOp(), repo, cmd)
+	out, err := wrappedCmd.Output()
+	if err != nil {
+		if bytes.Contains(stderrOf(err), []byte("exists on disk, but not in")) {
+			return nil, &os.PathError{Op: "ls-tree", Path: filepath.ToSlash(path), Err: os.ErrNotExist}
+		}
+		return nil, commandFailedError(err, wrappedCmd.Args)
+	}
+
+	if len(out) == 0 {
+		// If we are listing the empty root tree, we will have no output.
+		if stdlibpath.Clean(path) == "." {
+			return []fs.FileInfo{}, nil
+		}
+		return nil, &os.PathError{Op: "git ls-tree", Path: path, Err: os.ErrNotExist}
+	}
+
+	submodules := func() (*config.Config, error) {
+		return readGitModules(ctx, rcf, dir, repo, commit, env)
+	}
+	return parseLsTree(out, path, submodules)
+}
+
+// parseLsTree parses the output of `git ls-tree --long --full-name -z`. The
+// submodules function is called to read the .gitmodules of the commit when
+// the output contains a submodule.
+func parseLsTree(out []byte, path string, submodules func() (*config.Config, error)) ([]fs.FileInfo, error) {
+	var gitModules *config.Config
+
+	trimPath := strings.TrimPrefix(path, "./")
+	lines := strings.Split(string(out), "\x00")
+	fis := make([]fs.FileInfo, 0, len(lines)-1)
+	for i, line := range lines {
+		if i == len(lines)-1 {
+			// last entry is empty
+			continue
+		}
+
+		tabPos := strings.IndexByte(line, '\t')
+		if tabPos == -1 {
+			return nil, errors.Errorf("invalid `git ls-tree` output: %q", out)
+		}
+		info := strings.SplitN(line[:tabPos], " ", 4)
+		name := line[tabPos+1:]
+		if len(name) < len(trimPath) {
+			// This is in a submodule; return the original path to avoid a slice out of bounds panic
+			// when setting the FileInfo._Name below.
+			name = trimPath
+		}
+
+		if len(info) != 4 {
+			return nil, errors.Errorf("invalid `git ls-tree` output: %q", out)
+		}
+		typ := info[1]
+		sha := info[2]
+		if !gitdomain.IsAbsoluteRevision(sha) {
+			return nil, errors.Errorf("invalid `git ls-tree` SHA output: %q", sha)
+		}
+		oid, err := decodeOID(sha)
+		if err != nil {
+			return nil, err
+		}
+
+		sizeStr := strings.TrimSpace(info[3])
+		var size int64
+		if sizeStr != "-" {
+			// Size of "-" indicates a dir or submodule.
+			size, err = strconv.ParseInt(sizeStr, 10, 64)
+			if err != nil || size < 0 {
+				return nil, errors.Errorf("invalid `git ls-tree` size output: %q (error: %s)", sizeStr, err)
+			}
+		}
+
+		var sys any = ObjectInfo(oid)
+		modeVal, err := strconv.ParseInt(info[0], 8, 32)
+		if err != nil {
+			return nil, err
+		}
+		mode := os.FileMode(modeVal)
+		switch typ {
+		case "blob":
+			const gitModeSymlink = 0o20000
+			if mode&gitModeSymlink != 0 {
+				mode = os.ModeSymlink
+			} else {
+				// Regular file.
+				mode = mode | 0o644
+			}
+		case "commit":
+			mode = mode | gitdomain.ModeSubmodule
+			if gitModules == nil {
+				gitModules, err = submodules()
+				if err != nil {
+					return nil, err
+				}
+			}
+			sys = gitdomain.Submodule{
+				URL:      gitModules.Section("submodule").Subsection(name).Option("url"),
+				Path:     gitModules.Section("submodule").Subsection(name).Option("path"),
+				CommitID: api.CommitID(oid.String()),
+			}
+		case "tree":
+			mode = mode | os.ModeDir
+		}
+
+		fis = append(fis, &fileutil.FileInfo{
+			Name_: name, // full path relative to root (not just basename)
+			Mode_: mode,
+			Size_: size,
+			Sys_:  sys,
+		})
+	}
+	fileutil.SortFileInfosByName(fis)
+
+	return fis, nil
+}
+
+// readGitModules reads and parses the .gitmodules file of the given commit. A
+// missing .gitmodules file results in an empty config.
+func readGitModules(ctx context.Context, rcf *wrexec.RecordingCommandFactory, dir common.GitDir, repo api.RepoName, commit api.CommitID, env []string) (*config.Config, error) {
+	cmd := exec.CommandContext(ctx, "git", "show", fmt.Sprintf("%s:.gitmodules", commit))
+	cmd.Env = env
+	dir.Set(cmd)
+	out, err := rcf.WrapWithRepoName(ctx, log.NoOp(), repo, cmd).Output()
+	if err != nil {
+		return config.New(), nil
+	}
+
+	cfg := config.New()
+	if err := config.NewDecoder(bytes.NewBuffer(out)).Decode(cfg); err != nil {
+		return nil, errors.Errorf("error parsing .gitmodules: %s", err)
+	}
+	return cfg, nil
+}
@@ -25,6 +25,12 @@ const TempDirName = ".tmp"
 // and where it will store cache data.
 const P4HomeName = ".p4home"
 
+// PartialClonesDirName is the name used for the directory under ReposDir that
+// holds the clones that have not completed yet. Unlike the temporary
+// directory, it is not cleared when gitserver starts, so that an interrupted
+// clone can be resumed.
+const PartialClonesDirName = ".partial-clones"
+
 func MakeP4HomeDir(reposDir string) (string, error) {
 	p4Home := filepath.Join(reposDir, P4HomeName)
 	// Ensure the directory exists
@@ -39,6 +45,14 @@ func RepoDirFromName(reposDir string, name api.RepoName) common.GitDir {
 	return common.GitDir(filepath.Join(reposDir, filepath.FromSlash(p), ".git"))
 }
 
+// PartialCloneDir returns the directory in which the given repository is
+// cloned before it is moved to its final location, when the clone is
+// resumable.
+func PartialCloneDir(reposDir string, name api.RepoName) string {
+	p := string(protocol.NormalizeRepo(name))
+	return filepath.Join(reposDir, PartialClonesDirName, filepath.FromSlash(p))
+}
+
 func RepoNameFromDir(reposDir string, dir common.GitDir) api.RepoName {
 	// dir == ${s.ReposDir}/${name}/.git
 	parent := filepath.Dir(string(dir))                   // remove suffix "/.git"
@@ -65,12 +79,13 @@ func TempDir(reposDir, prefix string) (name string, err error) {
 }
 
 func IgnorePath(reposDir string, path string) bool {
-	// We ignore any path which starts with .tmp or .p4home in ReposDir
+	// We ignore any path which starts with .tmp, .p4home or .partial-clones in
+	// ReposDir
 	if filepath.Dir(path) != reposDir {
 		return false
 	}
 	base := filepath.Base(path)
-	return strings.HasPrefix(base, TempDirName) || strings.HasPrefix(base, P4HomeName)
+	return strings.HasPrefix(base, TempDirName) || strings.HasPrefix(base, P4HomeName) || strings.HasPrefix(base, PartialClonesDirName)
 }
 
 // RemoveRepoDirectory atomically removes a directory from reposDir.
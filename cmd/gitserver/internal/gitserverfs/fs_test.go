@@ -26,6 +26,7 @@ func TestIgnorePath(t *testing.T) {
 	}{
 		{path: filepath.Join(reposDir, TempDirName), shouldIgnore: true},
 		{path: filepath.Join(reposDir, P4HomeName), shouldIgnore: true},
+		{path: filepath.Join(reposDir, PartialClonesDirName), shouldIgnore: true},
 		// Double check handling of trailing space
 		{path: filepath.Join(reposDir, P4HomeName+"   "), shouldIgnore: true},
 		{path: filepath.Join(reposDir, "sourcegraph/sourcegraph"), shouldIgnore: false},
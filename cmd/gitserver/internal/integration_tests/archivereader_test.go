@@ -3,6 +3,7 @@ package inttests
 import (
 	"archive/zip"
 	"bytes"
+	"container/list"
 	"context"
 	"encoding/base64"
 	"fmt"
@@ -20,6 +21,8 @@ import (
 	"google.golang.org/grpc"
 
 	server "github.com/sourcegraph/sourcegraph/cmd/gitserver/internal"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/mercurial"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/pushmirror"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
@@ -28,6 +31,7 @@ import (
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	internalgrpc "github.com/sourcegraph/sourcegraph/internal/grpc"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/defaults"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
 	"github.com/sourcegraph/sourcegraph/internal/wrexec"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -95,10 +99,13 @@ func TestClient_ArchiveReader(t *testing.T) {
 	runArchiveReaderTestfunc := func(t *testing.T, mkClient func(t *testing.T, addrs []string) gitserver.Client, name api.RepoName, test test) {
 		t.Run(string(name), func(t *testing.T) {
 			// Setup: Prepare the test Gitserver server + register the gRPC server
+			db := newMockDB()
 			s := &server.Server{
-				Logger:   logtest.Scoped(t),
-				ReposDir: filepath.Join(root, "repos"),
-				DB:       newMockDB(),
+				Logger:      logtest.Scoped(t),
+				ReposDir:    filepath.Join(root, "repos"),
+				DB:          db,
+				Mercurial:   mercurial.NewService(context.Background(), observation.TestContextTB(t), logtest.Scoped(t), db, list.New()),
+				PushMirrors: pushmirror.NewService(context.Background(), observation.TestContextTB(t), logtest.Scoped(t), db, wrexec.NewNoOpRecordingCommandFactory(), filepath.Join(root, "repos"), "", list.New()),
 				GetRemoteURLFunc: func(_ context.Context, name api.RepoName) (string, error) {
 					if test.remote != "" {
 						return test.remote, nil
@@ -0,0 +1,105 @@
+package inttests
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+func TestBlame(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	gitCommands := []string{
+		"printf 'line1\\nline2\\n' > f",
+		"git add f",
+		"GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m foo --author='a <a@a.com>' --date 2006-01-02T15:04:05Z",
+		"printf 'line1\\nline2\\nline3\\n' > f",
+		"git add f",
+		"GIT_COMMITTER_DATE=2008-01-02T15:04:05Z git commit -m bar --author='b <b@b.com>' --date 2008-01-02T15:04:05Z",
+	}
+	repo := MakeGitRepository(t, gitCommands...)
+
+	source := gitserver.NewTestClientSource(t, GitserverAddresses)
+	client := gitserver.NewTestClient(t).WithClientSource(source)
+
+	head, err := client.ResolveRevision(ctx, repo, "HEAD", gitserver.ResolveRevisionOptions{})
+	require.NoError(t, err)
+	first, err := client.ResolveRevision(ctx, repo, "HEAD~1", gitserver.ResolveRevisionOptions{})
+	require.NoError(t, err)
+
+	want := []*gitserver.Hunk{
+		{
+			StartLine: 1,
+			EndLine:   3,
+			StartByte: 0,
+			EndByte:   12,
+			CommitID:  first,
+			Author:    gitdomain.Signature{Name: "a", Email: "a@a.com", Date: *mustParseDate("2006-01-02T15:04:05Z", t)},
+			Message:   "foo",
+			Filename:  "f",
+		},
+		{
+			StartLine: 3,
+			EndLine:   4,
+			StartByte: 12,
+			EndByte:   18,
+			CommitID:  head,
+			Author:    gitdomain.Signature{Name: "b", Email: "b@b.com", Date: *mustParseDate("2008-01-02T15:04:05Z", t)},
+			Message:   "bar",
+			Filename:  "f",
+		},
+	}
+
+	t.Run("BlameFile", func(t *testing.T) {
+		hunks, err := client.BlameFile(ctx, repo, "f", &gitserver.BlameOptions{NewestCommit: head})
+		require.NoError(t, err)
+		if diff := cmp.Diff(want, hunks); diff != "" {
+			t.Errorf("unexpected hunks (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("StreamBlameFile", func(t *testing.T) {
+		hr, err := client.StreamBlameFile(ctx, repo, "f", &gitserver.BlameOptions{NewestCommit: head})
+		require.NoError(t, err)
+		defer hr.Close()
+
+		got := map[int]*gitserver.Hunk{}
+		for {
+			h, err := hr.Read()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			got[h.StartLine] = h
+		}
+		require.Len(t, got, len(want))
+		for _, h := range want {
+			if diff := cmp.Diff(h, got[h.StartLine]); diff != "" {
+				t.Errorf("unexpected hunk (-want +got):\n%s", diff)
+			}
+		}
+	})
+
+	t.Run("line range", func(t *testing.T) {
+		hunks, err := client.BlameFile(ctx, repo, "f", &gitserver.BlameOptions{NewestCommit: head, StartLine: 2, EndLine: 3})
+		require.NoError(t, err)
+		require.Len(t, hunks, 2)
+		require.Equal(t, 0, hunks[0].StartByte)
+		require.Equal(t, 6, hunks[0].EndByte)
+		require.Equal(t, 6, hunks[1].StartByte)
+		require.Equal(t, 12, hunks[1].EndByte)
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		_, err := client.BlameFile(ctx, repo, "notexist", &gitserver.BlameOptions{NewestCommit: head})
+		require.True(t, os.IsNotExist(err), "got %v", err)
+	})
+}
@@ -17,7 +17,9 @@ import (
 
 	server "github.com/sourcegraph/sourcegraph/cmd/gitserver/internal"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/mercurial"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/perforce"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/pushmirror"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
@@ -61,6 +63,8 @@ func TestClone(t *testing.T) {
 		},
 		DB:                      db,
 		Perforce:                perforce.NewService(ctx, observation.TestContextTB(t), logger, db, list.New()),
+		Mercurial:               mercurial.NewService(ctx, observation.TestContextTB(t), logger, db, list.New()),
+		PushMirrors:             pushmirror.NewService(ctx, observation.TestContextTB(t), logger, db, wrexec.NewNoOpRecordingCommandFactory(), reposDir, "", list.New()),
 		RecordingCommandFactory: wrexec.NewNoOpRecordingCommandFactory(),
 		Locker:                  locker,
 		RPSLimiter:              ratelimit.NewInstrumentedLimiter("GitserverTest", rate.NewLimiter(100, 10)),
@@ -154,6 +158,8 @@ func TestClone_Fail(t *testing.T) {
 		},
 		DB:                      db,
 		Perforce:                perforce.NewService(ctx, observation.TestContextTB(t), logger, db, list.New()),
+		Mercurial:               mercurial.NewService(ctx, observation.TestContextTB(t), logger, db, list.New()),
+		PushMirrors:             pushmirror.NewService(ctx, observation.TestContextTB(t), logger, db, wrexec.NewNoOpRecordingCommandFactory(), reposDir, "", list.New()),
 		RecordingCommandFactory: wrexec.NewNoOpRecordingCommandFactory(),
 		Locker:                  locker,
 		RPSLimiter:              ratelimit.NewInstrumentedLimiter("GitserverTest", rate.NewLimiter(100, 10)),
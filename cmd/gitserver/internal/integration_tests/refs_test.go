@@ -0,0 +1,148 @@
+package inttests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestRefs(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	gitCommands := []string{
+		"GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit --allow-empty -m foo --author='a <a@a.com>' --date 2006-01-02T15:04:05Z",
+		"git tag t1",
+		"GIT_COMMITTER_DATE=2008-01-02T15:04:05Z git commit --allow-empty -m bar --author='a <a@a.com>' --date 2008-01-02T15:04:05Z",
+		"git tag t2",
+		"git branch b2",
+		"GIT_COMMITTER_DATE=2010-01-02T15:04:05Z git tag -a t3 -m tag HEAD~1",
+	}
+	repo := MakeGitRepository(t, gitCommands...)
+
+	source := gitserver.NewTestClientSource(t, GitserverAddresses)
+	client := gitserver.NewTestClient(t).WithClientSource(source)
+
+	head, err := client.ResolveRevision(ctx, repo, "HEAD", gitserver.ResolveRevisionOptions{})
+	require.NoError(t, err)
+	first, err := client.ResolveRevision(ctx, repo, "HEAD~1", gitserver.ResolveRevisionOptions{})
+	require.NoError(t, err)
+
+	t.Run("ListTags", func(t *testing.T) {
+		tags, err := client.ListTags(ctx, repo)
+		require.NoError(t, err)
+
+		want := []*gitdomain.Tag{
+			{Name: "t3", CommitID: first, CreatorDate: *mustParseDate("2010-01-02T15:04:05Z", t)},
+			{Name: "t2", CommitID: head, CreatorDate: *mustParseDate("2008-01-02T15:04:05Z", t)},
+			{Name: "t1", CommitID: first, CreatorDate: *mustParseDate("2006-01-02T15:04:05Z", t)},
+		}
+		if diff := cmp.Diff(want, tags); diff != "" {
+			t.Errorf("unexpected tags (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("ListTags points at", func(t *testing.T) {
+		tags, err := client.ListTags(ctx, repo, string(head))
+		require.NoError(t, err)
+
+		want := []*gitdomain.Tag{
+			{Name: "t2", CommitID: head, CreatorDate: *mustParseDate("2008-01-02T15:04:05Z", t)},
+		}
+		if diff := cmp.Diff(want, tags); diff != "" {
+			t.Errorf("unexpected tags (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("RefDescriptions", func(t *testing.T) {
+		descriptions, err := client.RefDescriptions(ctx, repo, string(first))
+		require.NoError(t, err)
+
+		want := map[string][]gitdomain.RefDescription{
+			string(first): {
+				{Name: "t1", Type: gitdomain.RefTypeTag, CreatedDate: mustParseDate("2006-01-02T15:04:05Z", t)},
+				{Name: "t3", Type: gitdomain.RefTypeTag, CreatedDate: mustParseDate("2006-01-02T15:04:05Z", t)},
+			},
+		}
+		if diff := cmp.Diff(want, descriptions); diff != "" {
+			t.Errorf("unexpected ref descriptions (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("ListRefs", func(t *testing.T) {
+		refs, err := client.ListRefs(ctx, repo)
+		require.NoError(t, err)
+
+		var names []string
+		for _, ref := range refs {
+			names = append(names, ref.Name)
+		}
+		want := []string{"refs/heads/b2", "refs/heads/master", "refs/tags/t1", "refs/tags/t2", "refs/tags/t3"}
+		if diff := cmp.Diff(want, names); diff != "" {
+			t.Errorf("unexpected refs (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("MergeBase", func(t *testing.T) {
+		base, err := client.MergeBase(ctx, repo, "b2", "t1")
+		require.NoError(t, err)
+		require.Equal(t, first, base)
+
+		_, err = client.MergeBase(ctx, repo, "b2", "notexist")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}), "got %v", err)
+	})
+
+	t.Run("GetBehindAhead", func(t *testing.T) {
+		behindAhead, err := client.GetBehindAhead(ctx, repo, "t1", "b2")
+		require.NoError(t, err)
+		require.Equal(t, &gitdomain.BehindAhead{Behind: 0, Ahead: 1}, behindAhead)
+
+		_, err = client.GetBehindAhead(ctx, repo, "t1", "notexist")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}), "got %v", err)
+	})
+
+	t.Run("BranchesContaining", func(t *testing.T) {
+		branches, err := client.BranchesContaining(ctx, repo, first)
+		require.NoError(t, err)
+		require.Equal(t, []string{"b2", "master"}, branches)
+	})
+
+	t.Run("CommitsUniqueToBranch", func(t *testing.T) {
+		commitsUniqueToBranch := func(branch string, isDefaultBranch bool, maxAge *time.Time) map[string]time.Time {
+			commits, err := client.CommitsUniqueToBranch(ctx, repo, branch, isDefaultBranch, maxAge)
+			require.NoError(t, err)
+			for commit, date := range commits {
+				commits[commit] = date.UTC()
+			}
+			return commits
+		}
+
+		require.Equal(t, map[string]time.Time{
+			string(head):  *mustParseDate("2008-01-02T15:04:05Z", t),
+			string(first): *mustParseDate("2006-01-02T15:04:05Z", t),
+		}, commitsUniqueToBranch("master", true, nil))
+		require.Equal(t, map[string]time.Time{
+			string(head): *mustParseDate("2008-01-02T15:04:05Z", t),
+		}, commitsUniqueToBranch("master", true, mustParseDate("2007-01-02T15:04:05Z", t)))
+		require.Empty(t, commitsUniqueToBranch("b2", false, nil))
+	})
+
+	t.Run("ContributorCount", func(t *testing.T) {
+		counts, err := client.ContributorCount(ctx, repo, gitserver.ContributorOptions{})
+		require.NoError(t, err)
+		require.Equal(t, []*gitdomain.ContributorCount{{Name: "a", Email: "a@a.com", Count: 2}}, counts)
+	})
+
+	t.Run("repo not found", func(t *testing.T) {
+		_, err := client.ListTags(ctx, api.RepoName("notexist"))
+		require.True(t, gitdomain.IsRepoNotExist(err), "got %v", err)
+	})
+}
@@ -13,7 +13,9 @@ import (
 	"golang.org/x/time/rate"
 
 	server "github.com/sourcegraph/sourcegraph/cmd/gitserver/internal"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/mercurial"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/perforce"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/pushmirror"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
@@ -80,6 +82,8 @@ func TestClient_ResolveRevisions(t *testing.T) {
 		},
 		DB:                      db,
 		Perforce:                perforce.NewService(ctx, observation.TestContextTB(t), logger, db, list.New()),
+		Mercurial:               mercurial.NewService(ctx, observation.TestContextTB(t), logger, db, list.New()),
+		PushMirrors:             pushmirror.NewService(ctx, observation.TestContextTB(t), logger, db, wrexec.NewNoOpRecordingCommandFactory(), filepath.Join(root, "repos"), "", list.New()),
 		RecordingCommandFactory: wrexec.NewNoOpRecordingCommandFactory(),
 		Locker:                  server.NewRepositoryLocker(),
 		RPSLimiter:              ratelimit.NewInstrumentedLimiter("GitserverTest", rate.NewLimiter(100, 10)),
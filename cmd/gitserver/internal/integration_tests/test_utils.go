@@ -1,6 +1,7 @@
 package inttests
 
 import (
+	"container/list"
 	"context"
 	"net"
 	"net/http"
@@ -17,6 +18,8 @@ import (
 	sglog "github.com/sourcegraph/log"
 
 	server "github.com/sourcegraph/sourcegraph/cmd/gitserver/internal"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/mercurial"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/pushmirror"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
@@ -85,6 +88,8 @@ func InitGitserver() {
 		},
 		GlobalBatchLogSemaphore: semaphore.NewWeighted(32),
 		DB:                      db,
+		Mercurial:               mercurial.NewService(context.Background(), &observation.TestContext, logger, db, list.New()),
+		PushMirrors:             pushmirror.NewService(context.Background(), &observation.TestContext, logger, db, wrexec.NewNoOpRecordingCommandFactory(), filepath.Join(root, "repos"), "", list.New()),
 		RecordingCommandFactory: wrexec.NewNoOpRecordingCommandFactory(),
 		Locker:                  server.NewRepositoryLocker(),
 		RPSLimiter:              ratelimit.NewInstrumentedLimiter("GitserverTest", rate.NewLimiter(100, 10)),
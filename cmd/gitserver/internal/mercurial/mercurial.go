@@ -0,0 +1,253 @@
+package mercurial
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"context"
+	"database/sql"
+	"os/exec"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NotesRef is the notes ref in which git-remote-hg records the Mercurial
+// changeset ID of every commit it converts.
+const NotesRef = "refs/notes/hg"
+
+type changesetMappingJob struct {
+	RepoName api.RepoName
+	RepoDir  common.GitDir
+}
+
+func NewChangesetMappingJob(repoName api.RepoName, repoDir common.GitDir) *changesetMappingJob {
+	return &changesetMappingJob{
+		RepoName: repoName,
+		RepoDir:  repoDir,
+	}
+}
+
+// Service records which commit each Mercurial changeset of a repo was converted to by
+// git-remote-hg, so that hg revisions can be resolved. Repos are mapped in the background after
+// every fetch, one at a time.
+//
+// NOTE: Use NewService to instantiate a new service, which also starts processing the queue.
+type Service struct {
+	Logger log.Logger
+	DB     database.DB
+
+	ctx                   context.Context
+	changesetMappingQueue *common.Queue[*changesetMappingJob]
+}
+
+// NewService initializes a new service and starts mapping the changesets of the repos that are
+// enqueued with EnqueueChangesetMappingJob.
+func NewService(ctx context.Context, obctx *observation.Context, logger log.Logger, db database.DB, jobs *list.List) *Service {
+	queue := common.NewQueue[*changesetMappingJob](obctx, "hg-changeset-mapper", jobs)
+
+	s := &Service{
+		Logger: logger,
+		DB:     db,
+
+		ctx:                   ctx,
+		changesetMappingQueue: queue,
+	}
+
+	s.startChangesetMappingPipeline(ctx)
+
+	return s
+}
+
+// EnqueueChangesetMappingJob will push the changesetMappingJob onto the queue iff the repo was
+// converted from Mercurial, which is the case if it has the changeset notes written by
+// git-remote-hg.
+func (s *Service) EnqueueChangesetMappingJob(job *changesetMappingJob) {
+	if ok, err := hasNotesRef(s.ctx, job.RepoDir); err != nil {
+		s.Logger.Warn("failed to check for hg changeset notes", log.String("repo", string(job.RepoName)), log.Error(err))
+	} else if ok {
+		s.changesetMappingQueue.Push(job)
+	}
+}
+
+func (s *Service) startChangesetMappingPipeline(ctx context.Context) {
+	logger := s.Logger.Scoped("changesetMappingConsumer")
+
+	common.StartQueueConsumer(ctx, logger, s.changesetMappingQueue, func(ctx context.Context, job *changesetMappingJob) {
+		if err := s.doChangesetMapping(ctx, job); err != nil {
+			logger.Error("failed to map hg changesets", log.String("job.repo", string(job.RepoName)), log.Error(err))
+		}
+	})
+}
+
+// doChangesetMapping performs the commits -> changeset ID mapping for a new or existing repo.
+func (s *Service) doChangesetMapping(ctx context.Context, job *changesetMappingJob) error {
+	logger := s.Logger.Scoped("doChangesetMapping").With(
+		log.String("repo", string(job.RepoName)),
+	)
+
+	logger.Debug("started")
+
+	repo, err := s.DB.Repos().GetByName(ctx, job.RepoName)
+	if err != nil {
+		return errors.Wrap(err, "Repos.GetByName")
+	}
+
+	changesets, err := s.getChangesetsToInsert(ctx, repo.ID, job.RepoDir)
+	if err != nil {
+		return err
+	}
+	if len(changesets) == 0 {
+		logger.Debug("repo changesets already mapped, skipping")
+		return nil
+	}
+
+	// We want to write all the changesets or nothing at all in a single transaction, so that the
+	// latest mapped commit in the DB is always a reliable start point for the next job.
+	return s.DB.RepoCommitsHgChangesets().BatchInsertCommitSHAsWithHgChangesetID(ctx, repo.ID, changesets)
+}
+
+// getChangesetsToInsert returns a list of commitSHA -> changesetID for each commit that is yet to
+// be "mapped" in the DB. For new repos, this will contain all the commits and for existing repos it
+// will only return the commits that are not ancestors of the latest mapped commit.
+func (s *Service) getChangesetsToInsert(ctx context.Context, repoID api.RepoID, dir common.GitDir) ([]types.HgChangeset, error) {
+	latest, err := s.DB.RepoCommitsHgChangesets().GetLatestForRepo(ctx, repoID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// This repo has not been mapped yet. Start from the beginning.
+			results, err := newMappableChangesets(ctx, dir, "")
+			return results, errors.Wrap(err, "failed to map new repo (hg revisions will not resolve)")
+		}
+
+		return nil, errors.Wrap(err, "RepoCommitsHgChangesets.GetLatestForRepo")
+	}
+
+	lastMappedCommit := string(latest.CommitSHA)
+	if !commitExists(ctx, dir, lastMappedCommit) {
+		// The changeset was stripped from the Mercurial repository. Changesets that are already
+		// mapped are skipped when inserting, so map the whole repo again.
+		lastMappedCommit = ""
+	}
+
+	results, err := newMappableChangesets(ctx, dir, lastMappedCommit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to map existing repo's commits after %q", latest.CommitSHA)
+	}
+	return results, nil
+}
+
+// hasNotesRef returns true if the repo has changeset notes written by git-remote-hg.
+func hasNotesRef(ctx context.Context, dir common.GitDir) (bool, error) {
+	cmd := exec.CommandContext(ctx, "git", "show-ref", "--verify", "--quiet", NotesRef)
+	dir.Set(cmd)
+
+	if err := cmd.Run(); err != nil {
+		var e *exec.ExitError
+		if errors.As(err, &e) && e.ExitCode() == 1 {
+			return false, nil
+		}
+		return false, &common.GitCommandError{Err: err}
+	}
+	return true, nil
+}
+
+func commitExists(ctx context.Context, dir common.GitDir, commit string) bool {
+	cmd := exec.CommandContext(ctx, "git", "cat-file", "-e", commit+"^{commit}")
+	dir.Set(cmd)
+	return cmd.Run() == nil
+}
+
+// logFormatWithCommitSHAAndNotes prints the commit SHA and the note of the commit in NotesRef
+// separated by a single space.
+var logFormatWithCommitSHAAndNotes = "--format=format:%H %N%x00"
+
+// newMappableChangesets executes git log with "logFormatWithCommitSHAAndNotes" as the format
+// specifier and returns a list of commitSHA -> changesetID for each commit of all branches and tags
+// that is not an ancestor of "lastMappedCommit", or all commits if "lastMappedCommit" is empty.
+//
+// Commits are returned parents first, so that the last returned commit is a sound start point for
+// the next mapping. Commits without a changeset note are skipped.
+func newMappableChangesets(ctx context.Context, dir common.GitDir, lastMappedCommit string) ([]types.HgChangeset, error) {
+	// ensure we cleanup command when returning
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "git", "log", logFormatWithCommitSHAAndNotes, "--notes="+NotesRef, "--topo-order", "--reverse", "--branches", "--tags")
+	if lastMappedCommit != "" {
+		cmd.Args = append(cmd.Args, "^"+lastMappedCommit)
+	}
+	cmd.Args = append(cmd.Args, "--")
+	dir.Set(cmd)
+
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create stdout pipe for command")
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, errors.Wrap(err, "failed to start command")
+	}
+
+	scan := bufio.NewScanner(out)
+	scan.Split(scanNull)
+
+	changesets := []types.HgChangeset{}
+	for scan.Scan() {
+		c, err := parseGitLogLine(strings.TrimSpace(scan.Text()))
+		if err != nil {
+			return nil, err
+		}
+		if c != nil {
+			changesets = append(changesets, *c)
+		}
+	}
+
+	return changesets, errors.Wrap(cmd.Wait(), "command execution pipeline failed")
+}
+
+func scanNull(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		return i + 1, data[0:i], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	// Request more data.
+	return 0, nil, nil
+}
+
+var changesetIDPattern = lazyregexp.New(`^[0-9a-f]{40}$`)
+
+// parseGitLogLine will parse a line from the git-log output and return the commitSHA and
+// changesetID. It returns nil if the commit has no changeset note.
+func parseGitLogLine(line string) (*types.HgChangeset, error) {
+	// Expected format: "<commitSHA> <note>"
+	commitSHA, note, _ := strings.Cut(line, " ")
+	if commitSHA == "" {
+		return nil, errors.Newf("failed to parse commitSHA from line %q of git log output", line)
+	}
+
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return nil, nil
+	}
+	if !changesetIDPattern.MatchString(note) {
+		return nil, errors.Newf("invalid hg changeset ID %q in note of commit %s", note, commitSHA)
+	}
+
+	return &types.HgChangeset{
+		CommitSHA:   api.CommitID(commitSHA),
+		ChangesetID: note,
+	}, nil
+}
@@ -0,0 +1,161 @@
+package mercurial
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// setupTestRepo will setup a git repo with 4 commits, 3 of which have a changeset note like the
+// ones written by git-remote-hg, and returns the directory where the repo is created and a list of
+// (commits, changeset IDs) ordered oldest to latest.
+func setupTestRepo(t *testing.T) (common.GitDir, []types.HgChangeset) {
+	commitCommand := "git commit --allow-empty -m 'change %d'"
+	noteCommand := "git notes --ref=hg add -m %s HEAD"
+
+	gitCommands := []string{}
+	for i := 1; i <= 3; i++ {
+		gitCommands = append(gitCommands,
+			fmt.Sprintf(commitCommand, i),
+			fmt.Sprintf(noteCommand, changesetID(i)),
+		)
+	}
+	// A commit without a note, which should be skipped.
+	gitCommands = append(gitCommands, fmt.Sprintf(commitCommand, 4))
+
+	dir := gitserver.InitGitRepository(t, gitCommands...)
+
+	cmd := gitserver.CreateGitCommand(dir, "git", "rev-list", "--reverse", "HEAD")
+	revList, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("failed to run git rev-list HEAD: %q", err.Error())
+	}
+
+	changesets := []types.HgChangeset{}
+	for i, commitSHA := range strings.Fields(string(revList))[:3] {
+		changesets = append(changesets, types.HgChangeset{
+			CommitSHA:   api.CommitID(commitSHA),
+			ChangesetID: changesetID(i + 1),
+		})
+	}
+
+	return common.GitDir(path.Join(dir, ".git")), changesets
+}
+
+func changesetID(i int) string {
+	return strings.Repeat(fmt.Sprintf("%x", i), 40)
+}
+
+func TestGetChangesetsToInsert(t *testing.T) {
+	dir, allChangesets := setupTestRepo(t)
+
+	ctx := context.Background()
+	db := dbmocks.NewMockDB()
+	store := dbmocks.NewMockRepoCommitsHgChangesetsStore()
+	db.RepoCommitsHgChangesetsFunc.SetDefaultReturn(store)
+
+	s := &Service{
+		Logger: logtest.NoOp(t),
+		DB:     db,
+	}
+
+	t.Run("new repo, never mapped", func(t *testing.T) {
+		store.GetLatestForRepoFunc.SetDefaultReturn(nil, sql.ErrNoRows)
+
+		changesets, err := s.getChangesetsToInsert(ctx, api.RepoID(1), dir)
+		require.NoError(t, err)
+
+		if diff := cmp.Diff(allChangesets, changesets); diff != "" {
+			t.Fatalf("mismatched changesets, (-want,+got)\n:%v", diff)
+		}
+	})
+
+	t.Run("existing repo, partially mapped", func(t *testing.T) {
+		store.GetLatestForRepoFunc.SetDefaultReturn(&types.RepoCommitHgChangeset{
+			ID:            1,
+			RepoID:        1,
+			CommitSHA:     dbutil.CommitBytea(allChangesets[0].CommitSHA),
+			HgChangesetID: allChangesets[0].ChangesetID,
+		}, nil)
+
+		changesets, err := s.getChangesetsToInsert(ctx, api.RepoID(1), dir)
+		require.NoError(t, err)
+
+		if diff := cmp.Diff(allChangesets[1:], changesets); diff != "" {
+			t.Fatalf("mismatched changesets, (-want,+got)\n:%v", diff)
+		}
+	})
+
+	t.Run("existing repo, fully mapped", func(t *testing.T) {
+		store.GetLatestForRepoFunc.SetDefaultReturn(&types.RepoCommitHgChangeset{
+			ID:            3,
+			RepoID:        1,
+			CommitSHA:     dbutil.CommitBytea(allChangesets[2].CommitSHA),
+			HgChangesetID: allChangesets[2].ChangesetID,
+		}, nil)
+
+		changesets, err := s.getChangesetsToInsert(ctx, api.RepoID(1), dir)
+		require.NoError(t, err)
+		require.Empty(t, changesets)
+	})
+
+	t.Run("existing repo, mapped commit stripped", func(t *testing.T) {
+		store.GetLatestForRepoFunc.SetDefaultReturn(&types.RepoCommitHgChangeset{
+			ID:            3,
+			RepoID:        1,
+			CommitSHA:     dbutil.CommitBytea("e9c83398bbd4c4e9481fd20f100a7e49d68d7510"),
+			HgChangesetID: changesetID(9),
+		}, nil)
+
+		changesets, err := s.getChangesetsToInsert(ctx, api.RepoID(1), dir)
+		require.NoError(t, err)
+
+		if diff := cmp.Diff(allChangesets, changesets); diff != "" {
+			t.Fatalf("mismatched changesets, (-want,+got)\n:%v", diff)
+		}
+	})
+}
+
+func TestHasNotesRef(t *testing.T) {
+	ctx := context.Background()
+
+	dir, _ := setupTestRepo(t)
+	ok, err := hasNotesRef(ctx, dir)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	plain := gitserver.InitGitRepository(t, "git commit --allow-empty -m foo")
+	ok, err = hasNotesRef(ctx, common.GitDir(path.Join(plain, ".git")))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestParseGitLogLine(t *testing.T) {
+	commitSHA := "4e5b9dbc6393b195688a93ea04b98fada50bfa03"
+	changeset := "d4b9c8bd0a0b35e0a7a1ef2c3c8a3c8f9a8d2e1f"
+
+	got, err := parseGitLogLine(commitSHA + " " + changeset + "\n")
+	require.NoError(t, err)
+	require.Equal(t, &types.HgChangeset{CommitSHA: api.CommitID(commitSHA), ChangesetID: changeset}, got)
+
+	got, err = parseGitLogLine(commitSHA + " ")
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	_, err = parseGitLogLine(commitSHA + " not a changeset")
+	require.Error(t, err)
+}
@@ -13,6 +13,13 @@ type operations struct {
 	batchLogSemaphoreWait prometheus.Histogram
 	batchLog              *observation.Operation
 	batchLogSingle        *observation.Operation
+	listRefs              *observation.Operation
+	readDir               *observation.Operation
+	blame                 *observation.Operation
+	commitLog             *observation.Operation
+	mergeBase             *observation.Operation
+	contributorCounts     *observation.Operation
+	reachability          *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -52,5 +59,12 @@ func newOperations(observationCtx *observation.Context) *operations {
 		batchLogSemaphoreWait: batchLogSemaphoreWait,
 		batchLog:              op("BatchLog"),
 		batchLogSingle:        subOp("batchLogSingle"),
+		listRefs:              op("ListRefs"),
+		readDir:               op("ReadDir"),
+		blame:                 op("Blame"),
+		commitLog:             op("CommitLog"),
+		mergeBase:             op("MergeBase"),
+		contributorCounts:     op("ContributorCounts"),
+		reachability:          op("Reachability"),
 	}
 }
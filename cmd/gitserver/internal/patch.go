@@ -207,6 +207,11 @@ func (s *Server) createCommitFromPatch(ctx context.Context, req protocol.CreateC
 
 	if out, err := run(cmd, "applying patch"); err != nil {
 		logger.Error("Failed to apply patch", log.String("output", string(out)))
+		// git apply exits with 1 if the patch does not apply, and with 128 if
+		// it can't be parsed.
+		if cmd.ProcessState != nil && cmd.ProcessState.ExitCode() == 1 {
+			resp.Error.PatchConflict = true
+		}
 		return http.StatusBadRequest, resp
 	}
 
@@ -353,6 +358,7 @@ func (s *Server) createCommitFromPatch(ctx context.Context, req protocol.CreateC
 			logger.Error("Failed to create ref for commit.", log.String("commit", cmtHash), log.String("output", string(out)))
 			return http.StatusInternalServerError, resp
 		}
+		s.ensureReachabilityIndexes().Invalidate(gitserverfs.RepoDirFromName(s.ReposDir, req.Repo))
 	}
 
 	return http.StatusOK, resp
@@ -10,7 +10,6 @@ import (
 	"os/exec"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/sourcegraph/log"
 
@@ -19,7 +18,6 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
-	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/perforce"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -84,72 +82,13 @@ func (s *Service) EnqueueChangelistMappingJob(job *changelistMappingJob) {
 }
 
 func (s *Service) startPerforceChangelistMappingPipeline(ctx context.Context) {
-	tasks := make(chan *changelistMappingTask)
-
-	// Protect against panics.
-	goroutine.Go(func() { s.changelistMappingConsumer(ctx, tasks) })
-	goroutine.Go(func() { s.changelistMappingProducer(ctx, tasks) })
-}
-
-// changelistMappingProducer "pops" jobs from the FIFO queue of the "Service" and produce them
-// for "consumption".
-func (s *Service) changelistMappingProducer(ctx context.Context, tasks chan<- *changelistMappingTask) {
-	defer close(tasks)
-
-	for {
-		s.changelistMappingQueue.Mutex.Lock()
-		if s.changelistMappingQueue.Empty() {
-			s.changelistMappingQueue.Cond.Wait()
-		}
-
-		s.changelistMappingQueue.Mutex.Unlock()
-
-		for {
-			job, doneFunc := s.changelistMappingQueue.Pop()
-			if job == nil {
-				break
-			}
-
-			select {
-			case tasks <- &changelistMappingTask{
-				changelistMappingJob: *job,
-				done:                 doneFunc,
-			}:
-			case <-ctx.Done():
-				s.Logger.Error("changelistMappingProducer: ", log.Error(ctx.Err()))
-				return
-			}
-		}
-	}
-}
-
-// changelistMappingConsumer "consumes" jobs "produced" by the producer.
-func (s *Service) changelistMappingConsumer(ctx context.Context, tasks <-chan *changelistMappingTask) {
 	logger := s.Logger.Scoped("changelistMappingConsumer")
 
-	// Process only one job at a time for a simpler pipeline at the moment.
-	for task := range tasks {
-		logger := logger.With(log.String("job.repo", string(task.RepoName)))
-
-		select {
-		case <-ctx.Done():
-			logger.Error("context done", log.Error(ctx.Err()))
-			return
-		default:
+	common.StartQueueConsumer(ctx, logger, s.changelistMappingQueue, func(ctx context.Context, job *changelistMappingJob) {
+		if err := s.doChangelistMapping(ctx, job); err != nil {
+			logger.Error("failed to map perforce changelists", log.String("job.repo", string(job.RepoName)), log.Error(err))
 		}
-
-		err := s.doChangelistMapping(ctx, task.changelistMappingJob)
-		if err != nil {
-			logger.Error("failed to map perforce changelists", log.Error(err))
-		}
-
-		timeTaken := task.done()
-		// NOTE: Hardcoded to log for tasks that run longer than 1 minute. Will revisit this if it
-		// becomes noisy under production loads.
-		if timeTaken > time.Duration(time.Second*60) {
-			s.Logger.Warn("mapping job took long to complete", log.Duration("duration", timeTaken))
-		}
-	}
+	})
 }
 
 // doChangelistMapping performs the commits -> changelist ID mapping for a new or existing repo.
@@ -356,10 +295,3 @@ func parseGitLogLine(line string) (*types.PerforceChangelist, error) {
 		ChangelistID: cid,
 	}, nil
 }
-
-// changelistMappingTask is a thin wrapper around a changelistMappingJob to associate the
-// doneFunc with each job.
-type changelistMappingTask struct {
-	*changelistMappingJob
-	done func() time.Duration
-}
@@ -0,0 +1,235 @@
+package pushmirror
+
+import (
+	"container/list"
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// minBackoff is the time after which a failed push is first retried. It
+	// doubles with every consecutive failure up to maxBackoff.
+	minBackoff = time.Minute
+	maxBackoff = 6 * time.Hour
+
+	// retryInterval is how often failed pushes that are due are retried.
+	retryInterval = time.Minute
+	// retryBatchSize is the maximum number of failed pushes retried at once.
+	retryBatchSize = 100
+)
+
+type pushJob struct {
+	RepoName api.RepoName
+	RepoDir  common.GitDir
+	// Remotes are the names of the push mirrors to push to. All the push
+	// mirrors of the repo are pushed to if empty.
+	Remotes []string
+}
+
+func NewPushJob(repoName api.RepoName, repoDir common.GitDir) *pushJob {
+	return &pushJob{
+		RepoName: repoName,
+		RepoDir:  repoDir,
+	}
+}
+
+// Service is used to push repos to the push mirrors configured in
+// experimentalFeatures.pushMirrors after they were updated, and to retry
+// failed pushes.
+//
+// NOTE: Use NewService to instantiate a new service, which also starts pushing the enqueued repos
+// and retrying failed pushes.
+type Service struct {
+	Logger log.Logger
+	DB     database.DB
+
+	ctx                     context.Context
+	recordingCommandFactory *wrexec.RecordingCommandFactory
+	reposDir                string
+	shardID                 string
+	pushQueue               *common.Queue[*pushJob]
+}
+
+// NewService initializes a new service and starts pushing the repos that are enqueued with
+// EnqueuePushJob, one at a time. It also starts a routine that retries the failed pushes of the
+// repos on the gitserver shard shardID.
+func NewService(
+	ctx context.Context,
+	obctx *observation.Context,
+	logger log.Logger,
+	db database.DB,
+	rcf *wrexec.RecordingCommandFactory,
+	reposDir string,
+	shardID string,
+	jobs *list.List,
+) *Service {
+	queue := common.NewQueue[*pushJob](obctx, "push-mirror", jobs)
+
+	s := &Service{
+		Logger: logger,
+		DB:     db,
+
+		ctx:                     ctx,
+		recordingCommandFactory: rcf,
+		reposDir:                reposDir,
+		shardID:                 shardID,
+		pushQueue:               queue,
+	}
+
+	s.startPushPipeline(ctx)
+
+	return s
+}
+
+// EnqueuePushJob will push the pushJob onto the queue iff the repo has at least one push mirror.
+// Partial clones are not pushed, since they don't have all the objects of the repo.
+func (s *Service) EnqueuePushJob(job *pushJob) {
+	if len(vcssyncer.PushMirrors(job.RepoName)) == 0 {
+		return
+	}
+	if git.IsPartialClone(job.RepoDir) {
+		s.Logger.Warn("not pushing partial clone to push mirrors", log.String("repo", string(job.RepoName)))
+		return
+	}
+	s.pushQueue.Push(job)
+}
+
+func (s *Service) startPushPipeline(ctx context.Context) {
+	logger := s.Logger.Scoped("pushConsumer")
+
+	common.StartQueueConsumer(ctx, logger, s.pushQueue, func(ctx context.Context, job *pushJob) {
+		if err := s.doPush(ctx, job); err != nil {
+			logger.Error("failed to push to push mirrors", log.String("job.repo", string(job.RepoName)), log.Error(err))
+		}
+	})
+
+	// Protect against panics.
+	goroutine.Go(func() { s.retryFailedPushes(ctx) })
+}
+
+// doPush pushes the repo to its push mirrors and records the outcome of every push. A failed
+// push doesn't prevent pushing to the other push mirrors.
+func (s *Service) doPush(ctx context.Context, job *pushJob) error {
+	repo, err := s.DB.Repos().GetByName(ctx, job.RepoName)
+	if err != nil {
+		return errors.Wrap(err, "Repos.GetByName")
+	}
+
+	var errs error
+	for _, m := range vcssyncer.PushMirrors(job.RepoName) {
+		if !includesRemote(job.Remotes, m.Name) {
+			continue
+		}
+
+		startedAt := time.Now()
+		if pushErr := m.Push(ctx, s.recordingCommandFactory, job.RepoName, job.RepoDir); pushErr != nil {
+			s.Logger.Warn("failed to push to push mirror",
+				log.String("repo", string(job.RepoName)),
+				log.String("remote", m.Name),
+				log.Error(pushErr),
+			)
+			if err := s.DB.GitserverRepoPushMirrors().RecordFailure(ctx, repo.ID, m.Name, pushErr.Error(), minBackoff, maxBackoff); err != nil {
+				errs = errors.Append(errs, errors.Wrap(err, "GitserverRepoPushMirrors.RecordFailure"))
+			}
+			continue
+		}
+		if err := s.DB.GitserverRepoPushMirrors().RecordSuccess(ctx, repo.ID, m.Name, startedAt); err != nil {
+			errs = errors.Append(errs, errors.Wrap(err, "GitserverRepoPushMirrors.RecordSuccess"))
+		}
+	}
+	return errs
+}
+
+func includesRemote(remotes []string, name string) bool {
+	if len(remotes) == 0 {
+		return true
+	}
+	for _, r := range remotes {
+		if r == name {
+			return true
+		}
+	}
+	return false
+}
+
+// retryFailedPushes periodically enqueues the failed pushes that are due to be retried.
+func (s *Service) retryFailedPushes(ctx context.Context) {
+	ctx = actor.WithInternalActor(ctx)
+	logger := s.Logger.Scoped("retryFailedPushes")
+
+	ticker := time.NewTicker(retryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !vcssyncer.HasPushMirrors() {
+			continue
+		}
+
+		if err := s.enqueueDuePushes(ctx); err != nil {
+			logger.Error("failed to enqueue failed pushes", log.Error(err))
+		}
+	}
+}
+
+func (s *Service) enqueueDuePushes(ctx context.Context) error {
+	due, err := s.DB.GitserverRepoPushMirrors().ListDue(ctx, s.shardID, retryBatchSize)
+	if err != nil {
+		return errors.Wrap(err, "GitserverRepoPushMirrors.ListDue")
+	}
+
+	jobs := map[api.RepoName]*pushJob{}
+	for _, m := range due {
+		if !isConfigured(m.RepoName, m.RemoteName) {
+			// Push mirrors that were removed from the site configuration are
+			// not retried. Record another failure to back off, so that they
+			// don't keep other pushes from being retried.
+			if err := s.DB.GitserverRepoPushMirrors().RecordFailure(ctx, m.RepoID, m.RemoteName, errNotConfigured.Error(), minBackoff, maxBackoff); err != nil {
+				return errors.Wrap(err, "GitserverRepoPushMirrors.RecordFailure")
+			}
+			continue
+		}
+
+		job, ok := jobs[m.RepoName]
+		if !ok {
+			job = NewPushJob(m.RepoName, gitserverfs.RepoDirFromName(s.reposDir, m.RepoName))
+			jobs[m.RepoName] = job
+		}
+		job.Remotes = append(job.Remotes, m.RemoteName)
+	}
+
+	for _, job := range jobs {
+		s.EnqueuePushJob(job)
+	}
+	return nil
+}
+
+var errNotConfigured = errors.New("push mirror is not configured for the repo")
+
+func isConfigured(repo api.RepoName, remoteName string) bool {
+	for _, m := range vcssyncer.PushMirrors(repo) {
+		if m.Name == remoteName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package reachability
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/xcontext"
+)
+
+var (
+	indexCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_gitserver_reachability_index_cache_hits_total",
+		Help: "Number of reachability queries answered from a cached index.",
+	})
+	indexCacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_gitserver_reachability_index_cache_misses_total",
+		Help: "Number of reachability queries that had to build an index.",
+	})
+	indexBuildDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "src_gitserver_reachability_index_build_duration_seconds",
+		Help:    "Time it takes to build the reachability index of a repository.",
+		Buckets: prometheus.ExponentialBuckets(0.01, 4, 8),
+	})
+)
+
+// Cache holds the reachability indexes of the most recently queried
+// repositories.
+type Cache struct {
+	indexes *lru.Cache[common.GitDir, *Index]
+	sf      singleflight.Group
+
+	// versions is incremented for a repository every time its index is
+	// invalidated, so that builds that started before are neither cached nor
+	// joined by later callers.
+	mu       sync.Mutex
+	versions map[common.GitDir]uint64
+}
+
+// NewCache returns a cache that holds the indexes of at most size repositories.
+func NewCache(size int) (*Cache, error) {
+	indexes, err := lru.New[common.GitDir, *Index](size)
+	if err != nil {
+		return nil, err
+	}
+	return &Cache{indexes: indexes, versions: map[common.GitDir]uint64{}}, nil
+}
+
+// Get returns the index of the repository in dir, building it if it is not
+// cached.
+func (c *Cache) Get(ctx context.Context, dir common.GitDir) (*Index, error) {
+	if idx, ok := c.indexes.Get(dir); ok {
+		indexCacheHits.Inc()
+		return idx, nil
+	}
+	indexCacheMisses.Inc()
+
+	c.mu.Lock()
+	version := c.versions[dir]
+	c.mu.Unlock()
+
+	var (
+		done = make(chan struct{})
+		v    any
+		err  error
+	)
+	// Build the index outside of the singleflight so that context errors of
+	// one caller are not shared with the others. The key includes the version,
+	// so that callers after an invalidation don't join a build of the old refs.
+	go func() {
+		v, err, _ = c.sf.Do(fmt.Sprintf("%s@%d", dir, version), func() (any, error) {
+			return c.build(xcontext.Detach(ctx), dir, version)
+		})
+		close(done)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-done:
+		if err != nil {
+			return nil, err
+		}
+		return v.(*Index), nil
+	}
+}
+
+// buildIndex builds the index of a repository. It is a variable so that tests
+// can control when builds finish.
+var buildIndex = Build
+
+func (c *Cache) build(ctx context.Context, dir common.GitDir, version uint64) (*Index, error) {
+	start := time.Now()
+	idx, err := buildIndex(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	indexBuildDuration.Observe(time.Since(start).Seconds())
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.versions[dir] == version {
+		c.indexes.Add(dir, idx)
+	}
+	return idx, nil
+}
+
+// Invalidate removes the index of the repository in dir from the cache. It must
+// be called whenever the refs of the repository change. Invalidating is cheap,
+// but the next Get rebuilds the index, which reads every commit of the
+// repository. Queries on the index only take time proportional to the commits
+// they visit.
+func (c *Cache) Invalidate(dir common.GitDir) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.versions[dir]++
+	c.indexes.Remove(dir)
+}
+
+// Refresh rebuilds the index of the repository in dir after its refs changed,
+// if the index is cached. Indexes of repositories that are not being queried
+// are not built.
+func (c *Cache) Refresh(ctx context.Context, dir common.GitDir) error {
+	if !c.indexes.Contains(dir) {
+		return nil
+	}
+	c.Invalidate(dir)
+	_, err := c.Get(ctx, dir)
+	return err
+}
@@ -0,0 +1,57 @@
+package reachability
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestCache_InvalidateDuringBuild(t *testing.T) {
+	oldIndex, newIndex := &Index{}, &Index{}
+	started, release := make(chan struct{}), make(chan struct{})
+	builds := 0
+	buildIndex = func(context.Context, common.GitDir) (*Index, error) {
+		builds++
+		if builds == 1 {
+			close(started)
+			<-release
+			return oldIndex, nil
+		}
+		return newIndex, nil
+	}
+	t.Cleanup(func() { buildIndex = Build })
+
+	cache, err := NewCache(10)
+	require.NoError(t, err)
+	dir := common.GitDir("/repo/.git")
+
+	errs := make(chan error, 1)
+	go func() {
+		idx, err := cache.Get(context.Background(), dir)
+		if err == nil && idx != oldIndex {
+			err = errors.New("expected the index of the old refs")
+		}
+		errs <- err
+	}()
+	<-started
+
+	// The refs changed while the first build is running, so a later caller
+	// must not wait for it and get an index of the old refs.
+	cache.Invalidate(dir)
+	idx, err := cache.Get(context.Background(), dir)
+	require.NoError(t, err)
+	require.Same(t, newIndex, idx)
+
+	close(release)
+	require.NoError(t, <-errs)
+
+	// The outdated build is not cached.
+	idx, err = cache.Get(context.Background(), dir)
+	require.NoError(t, err)
+	require.Same(t, newIndex, idx)
+	require.Equal(t, 2, builds)
+}
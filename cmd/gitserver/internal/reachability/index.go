@@ -0,0 +1,465 @@
+// Package reachability implements an in-memory index of the commit graph of a
+// repository, which answers reachability queries without running git.
+package reachability
+
+import (
+	"bufio"
+	"container/heap"
+	"context"
+	"encoding/hex"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// oid is the binary form of a commit ID. It takes less than half the memory of
+// its hex form.
+type oid [20]byte
+
+// Index is an in-memory index of all commits reachable from the refs of a
+// repository.
+//
+// Every commit is assigned a generation number, which is one more than the
+// largest generation number of its parents. A commit can only be an ancestor of
+// commits with a larger generation number, which lets the queries stop walking
+// the graph as soon as the result can't change anymore, like the commit-graph
+// file of git does. Queries about commits that are close to each other in the
+// graph, which are the most common ones, therefore only visit a small part of
+// the graph. Every query takes O(visited commits) time, which is O(commits) in
+// the worst case, e.g. for commits far apart in the history.
+//
+// An Index is immutable and safe for concurrent use.
+type Index struct {
+	oids       []oid
+	ids        map[oid]uint32
+	parents    [][]uint32
+	children   [][]uint32
+	generation []uint32
+	commitTime []int64
+
+	// branches maps the short name of every branch to its tip.
+	branches map[string]uint32
+	// head is the commit HEAD points to, or -1 if HEAD is unborn.
+	head int64
+}
+
+// Build builds the index of the repository in dir by reading the commit graph
+// with git.
+func Build(ctx context.Context, dir common.GitDir) (*Index, error) {
+	idx := &Index{ids: map[oid]uint32{}, branches: map[string]uint32{}, head: -1}
+
+	// Every line has the format "<committer time> <commit> <parent>...".
+	var parentOIDs [][]oid
+	err := runLines(ctx, dir, func(line string) error {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return errors.Errorf("unexpected output from git rev-list %q", line)
+		}
+		t, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid commit time in %q", line)
+		}
+		commit, err := parseOID(fields[1])
+		if err != nil {
+			return err
+		}
+		ps := make([]oid, 0, len(fields)-2)
+		for _, f := range fields[2:] {
+			p, err := parseOID(f)
+			if err != nil {
+				return err
+			}
+			ps = append(ps, p)
+		}
+
+		idx.ids[commit] = uint32(len(idx.oids))
+		idx.oids = append(idx.oids, commit)
+		idx.commitTime = append(idx.commitTime, t)
+		parentOIDs = append(parentOIDs, ps)
+		return nil
+	}, "rev-list", "--all", "--parents", "--timestamp")
+	if err != nil {
+		return nil, err
+	}
+
+	idx.parents = make([][]uint32, len(idx.oids))
+	idx.children = make([][]uint32, len(idx.oids))
+	for i, ps := range parentOIDs {
+		for _, p := range ps {
+			// Parents are missing from the output of git rev-list in shallow
+			// clones, in which case the commit is treated as a root commit.
+			if j, ok := idx.ids[p]; ok {
+				idx.parents[i] = append(idx.parents[i], j)
+				idx.children[j] = append(idx.children[j], uint32(i))
+			}
+		}
+	}
+	idx.computeGenerations()
+
+	err = runLines(ctx, dir, func(line string) error {
+		sha, ref, ok := strings.Cut(line, " ")
+		if !ok {
+			return errors.Errorf("unexpected output from git for-each-ref %q", line)
+		}
+		commit, err := parseOID(sha)
+		if err != nil {
+			return err
+		}
+		if i, ok := idx.ids[commit]; ok {
+			idx.branches[strings.TrimPrefix(ref, "refs/heads/")] = i
+		}
+		return nil
+	}, "for-each-ref", "--format=%(objectname) %(refname)", "refs/heads/")
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--verify", "--quiet", "HEAD^{commit}")
+	dir.Set(cmd)
+	if out, err := cmd.Output(); err == nil {
+		if commit, err := parseOID(strings.TrimSpace(string(out))); err == nil {
+			if i, ok := idx.ids[commit]; ok {
+				idx.head = int64(i)
+			}
+		}
+	}
+
+	return idx, nil
+}
+
+func runLines(ctx context.Context, dir common.GitDir, onLine func(string) error, args ...string) (err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	dir.Set(cmd)
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	defer func() {
+		if waitErr := cmd.Wait(); err == nil && waitErr != nil {
+			err = &common.GitCommandError{Err: waitErr, Output: stderr.String()}
+		}
+	}()
+
+	sc := bufio.NewScanner(stdout)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		if err := onLine(sc.Text()); err != nil {
+			return err
+		}
+	}
+	return sc.Err()
+}
+
+func parseOID(s string) (oid, error) {
+	var o oid
+	if len(s) != 2*len(o) {
+		return o, errors.Errorf("invalid commit ID %q", s)
+	}
+	if _, err := hex.Decode(o[:], []byte(s)); err != nil {
+		return o, errors.Wrapf(err, "invalid commit ID %q", s)
+	}
+	return o, nil
+}
+
+// computeGenerations computes the generation numbers of all commits with an
+// iterative depth-first search, because the history of large repositories is
+// too deep to recurse.
+func (idx *Index) computeGenerations() {
+	idx.generation = make([]uint32, len(idx.oids))
+	var stack []uint32
+	for i := range idx.oids {
+		if idx.generation[i] != 0 {
+			continue
+		}
+		stack = append(stack[:0], uint32(i))
+		for len(stack) > 0 {
+			c := stack[len(stack)-1]
+			gen, done := uint32(1), true
+			for _, p := range idx.parents[c] {
+				if idx.generation[p] == 0 {
+					stack = append(stack, p)
+					done = false
+				} else if idx.generation[p] >= gen {
+					gen = idx.generation[p] + 1
+				}
+			}
+			if done {
+				idx.generation[c] = gen
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+}
+
+// Len returns the number of commits in the index.
+func (idx *Index) Len() int {
+	return len(idx.oids)
+}
+
+// Resolve returns the commit that rev refers to. rev can be a full commit ID,
+// HEAD or the name of a branch. ok is false if rev can't be resolved from the
+// index, in which case it has to be resolved with git.
+func (idx *Index) Resolve(rev string) (commit api.CommitID, ok bool) {
+	i, ok := idx.resolve(rev)
+	if !ok {
+		return "", false
+	}
+	return idx.commitID(i), true
+}
+
+// Contains returns true if the commit is in the index.
+func (idx *Index) Contains(commit api.CommitID) bool {
+	_, ok := idx.resolve(string(commit))
+	return ok
+}
+
+func (idx *Index) resolve(rev string) (uint32, bool) {
+	if rev == "HEAD" {
+		return uint32(idx.head), idx.head >= 0
+	}
+	if o, err := parseOID(rev); err == nil {
+		i, ok := idx.ids[o]
+		return i, ok
+	}
+	i, ok := idx.branches[strings.TrimPrefix(rev, "refs/heads/")]
+	return i, ok
+}
+
+func (idx *Index) commitID(i uint32) api.CommitID {
+	return api.CommitID(hex.EncodeToString(idx.oids[i][:]))
+}
+
+// ErrUnknownRevision is returned by queries for revisions that can't be
+// resolved from the index.
+var ErrUnknownRevision = errors.New("revision not in reachability index")
+
+func (idx *Index) mustResolve(revs ...string) ([]uint32, error) {
+	ids := make([]uint32, 0, len(revs))
+	for _, rev := range revs {
+		i, ok := idx.resolve(rev)
+		if !ok {
+			return nil, errors.Wrapf(ErrUnknownRevision, "%q", rev)
+		}
+		ids = append(ids, i)
+	}
+	return ids, nil
+}
+
+// IsAncestor returns true if ancestor is reachable from descendant. A commit is
+// an ancestor of itself.
+func (idx *Index) IsAncestor(ancestor, descendant string) (bool, error) {
+	ids, err := idx.mustResolve(ancestor, descendant)
+	if err != nil {
+		return false, err
+	}
+	a, d := ids[0], ids[1]
+
+	minGen := idx.generation[a]
+	visited := map[uint32]struct{}{d: {}}
+	stack := []uint32{d}
+	for len(stack) > 0 {
+		c := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if c == a {
+			return true, nil
+		}
+		for _, p := range idx.parents[c] {
+			// Commits with a generation number not larger than the one of the
+			// ancestor can't reach it.
+			if _, ok := visited[p]; ok || idx.generation[p] < minGen {
+				continue
+			}
+			visited[p] = struct{}{}
+			stack = append(stack, p)
+		}
+	}
+	return false, nil
+}
+
+// BehindAhead returns the number of commits that are reachable from left but
+// not from right (behind), and the other way around (ahead), like
+// "git rev-list --count --left-right left...right".
+func (idx *Index) BehindAhead(left, right string) (behind, ahead uint32, err error) {
+	ids, err := idx.mustResolve(left, right)
+	if err != nil {
+		return 0, 0, err
+	}
+	idx.paint(ids[0], ids[1], func(c uint32, f flags) {
+		switch f {
+		case leftFlag:
+			behind++
+		case rightFlag:
+			ahead++
+		}
+	})
+	return behind, ahead, nil
+}
+
+// CommitsUniqueTo returns the commits that are reachable from rev but not from
+// exclude, mapped to their committer date. If exclude is empty, all commits
+// reachable from rev are returned. If since is not nil, only commits committed
+// at or after since are returned.
+func (idx *Index) CommitsUniqueTo(rev, exclude string, since *time.Time) (map[api.CommitID]time.Time, error) {
+	revs := []string{rev}
+	if exclude != "" {
+		revs = append(revs, exclude)
+	}
+	ids, err := idx.mustResolve(revs...)
+	if err != nil {
+		return nil, err
+	}
+
+	commits := map[api.CommitID]time.Time{}
+	collect := func(c uint32, f flags) {
+		if f != rightFlag {
+			return
+		}
+		if t := time.Unix(idx.commitTime[c], 0); since == nil || !t.Before(*since) {
+			commits[idx.commitID(c)] = t
+		}
+	}
+	if exclude == "" {
+		idx.walk(ids[0], func(c uint32) { collect(c, rightFlag) })
+	} else {
+		idx.paint(ids[1], ids[0], collect)
+	}
+	return commits, nil
+}
+
+// BranchesContaining returns the sorted names of the branches from which the
+// commit is reachable.
+func (idx *Index) BranchesContaining(commit string) ([]string, error) {
+	ids, err := idx.mustResolve(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	tips := make(map[uint32][]string, len(idx.branches))
+	for name, tip := range idx.branches {
+		// A branch can only contain the commit if its tip is a descendant.
+		if idx.generation[tip] >= idx.generation[ids[0]] {
+			tips[tip] = append(tips[tip], name)
+		}
+	}
+
+	var names []string
+	visited := map[uint32]struct{}{ids[0]: {}}
+	queue := []uint32{ids[0]}
+	for len(queue) > 0 && len(tips) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		if ns, ok := tips[c]; ok {
+			names = append(names, ns...)
+			delete(tips, c)
+		}
+		for _, child := range idx.children[c] {
+			if _, ok := visited[child]; !ok {
+				visited[child] = struct{}{}
+				queue = append(queue, child)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// walk calls fn for every commit reachable from start.
+func (idx *Index) walk(start uint32, fn func(uint32)) {
+	visited := map[uint32]struct{}{start: {}}
+	stack := []uint32{start}
+	for len(stack) > 0 {
+		c := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		fn(c)
+		for _, p := range idx.parents[c] {
+			if _, ok := visited[p]; !ok {
+				visited[p] = struct{}{}
+				stack = append(stack, p)
+			}
+		}
+	}
+}
+
+type flags uint8
+
+const (
+	leftFlag flags = 1 << iota
+	rightFlag
+	bothFlags = leftFlag | rightFlag
+)
+
+// paint walks the commits reachable from left or right in order of decreasing
+// generation numbers, and calls fn for every commit with the flags of the sides
+// it is reachable from. All children of a commit have a larger generation
+// number than the commit itself, so its flags are final when it is visited.
+//
+// The walk stops as soon as all commits left to visit are reachable from both
+// sides, because so are all of their ancestors.
+func (idx *Index) paint(left, right uint32, fn func(uint32, flags)) {
+	painted := map[uint32]flags{}
+	q := &generationQueue{idx: idx}
+	notBoth := 0
+
+	push := func(c uint32, f flags) {
+		old, queued := painted[c]
+		if queued && old|f == old {
+			return
+		}
+		painted[c] = old | f
+		switch {
+		case !queued:
+			heap.Push(q, c)
+			if old|f != bothFlags {
+				notBoth++
+			}
+		case old|f == bothFlags:
+			notBoth--
+		}
+	}
+	push(left, leftFlag)
+	push(right, rightFlag)
+
+	for q.Len() > 0 && notBoth > 0 {
+		c := heap.Pop(q).(uint32)
+		f := painted[c]
+		if f != bothFlags {
+			notBoth--
+		}
+		fn(c, f)
+		for _, p := range idx.parents[c] {
+			push(p, f)
+		}
+	}
+}
+
+// generationQueue is a max-heap of commits ordered by generation number.
+type generationQueue struct {
+	idx     *Index
+	commits []uint32
+}
+
+func (q *generationQueue) Len() int { return len(q.commits) }
+func (q *generationQueue) Less(i, j int) bool {
+	return q.idx.generation[q.commits[i]] > q.idx.generation[q.commits[j]]
+}
+func (q *generationQueue) Swap(i, j int) { q.commits[i], q.commits[j] = q.commits[j], q.commits[i] }
+func (q *generationQueue) Push(x any)    { q.commits = append(q.commits, x.(uint32)) }
+func (q *generationQueue) Pop() any {
+	c := q.commits[len(q.commits)-1]
+	q.commits = q.commits[:len(q.commits)-1]
+	return c
+}
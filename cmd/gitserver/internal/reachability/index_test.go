@@ -0,0 +1,162 @@
+package reachability
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+)
+
+// setupTestRepo creates a repository with the following history, where m is a
+// merge of feature into master:
+//
+//	      f1 - f2          <- feature
+//	     /       \
+//	c1 - c2 - c3 - m - c6  <- master
+//	           \
+//	            o1         <- other
+func setupTestRepo(t *testing.T) (string, common.GitDir) {
+	commit := func(msg string, day int) string {
+		return fmt.Sprintf("GIT_COMMITTER_DATE=2006-01-%02dT15:04:05Z git commit --allow-empty -m %s", day, msg)
+	}
+	dir := gitserver.InitGitRepository(t,
+		commit("c1", 1),
+		commit("c2", 2),
+		"git checkout -b feature",
+		commit("f1", 3),
+		commit("f2", 5),
+		"git checkout master",
+		commit("c3", 4),
+		"git checkout -b other",
+		commit("o1", 6),
+		"git checkout master",
+		"GIT_COMMITTER_DATE=2006-01-07T15:04:05Z git merge --no-ff -m m feature",
+		commit("c6", 8),
+	)
+	return dir, common.GitDir(filepath.Join(dir, ".git"))
+}
+
+func git(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	out, err := gitserver.CreateGitCommand(dir, "git", args...).CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func TestIndex(t *testing.T) {
+	dir, gitDir := setupTestRepo(t)
+
+	idx, err := Build(context.Background(), gitDir)
+	require.NoError(t, err)
+	require.Equal(t, 8, idx.Len())
+
+	commits := strings.Fields(git(t, dir, "rev-list", "--all"))
+	revs := append([]string{"HEAD", "master", "refs/heads/feature", "other"}, commits...)
+
+	t.Run("Resolve", func(t *testing.T) {
+		for _, rev := range revs {
+			got, ok := idx.Resolve(rev)
+			require.True(t, ok, rev)
+			require.Equal(t, api.CommitID(git(t, dir, "rev-parse", rev)), got, rev)
+		}
+		_, ok := idx.Resolve("v1.0")
+		require.False(t, ok)
+		_, err := idx.IsAncestor("HEAD", "v1.0")
+		require.ErrorIs(t, err, ErrUnknownRevision)
+	})
+
+	t.Run("IsAncestor", func(t *testing.T) {
+		for _, a := range revs {
+			for _, d := range revs {
+				cmd := gitserver.CreateGitCommand(dir, "git", "merge-base", "--is-ancestor", a, d)
+				want := cmd.Run() == nil
+				got, err := idx.IsAncestor(a, d)
+				require.NoError(t, err)
+				require.Equal(t, want, got, "IsAncestor(%s, %s)", a, d)
+			}
+		}
+	})
+
+	t.Run("BehindAhead", func(t *testing.T) {
+		for _, l := range revs {
+			for _, r := range revs {
+				counts := strings.Fields(git(t, dir, "rev-list", "--count", "--left-right", l+"..."+r))
+				behind, ahead, err := idx.BehindAhead(l, r)
+				require.NoError(t, err)
+				require.Equal(t, counts[0], strconv.Itoa(int(behind)), "behind of %s...%s", l, r)
+				require.Equal(t, counts[1], strconv.Itoa(int(ahead)), "ahead of %s...%s", l, r)
+			}
+		}
+	})
+
+	t.Run("BranchesContaining", func(t *testing.T) {
+		for _, c := range commits {
+			want := strings.Fields(git(t, dir, "branch", "--contains", c, "--format=%(refname:short)"))
+			sort.Strings(want)
+			got, err := idx.BranchesContaining(c)
+			require.NoError(t, err)
+			require.Equal(t, want, got, c)
+		}
+	})
+
+	t.Run("CommitsUniqueTo", func(t *testing.T) {
+		logCommits := func(args ...string) map[api.CommitID]time.Time {
+			commits := map[api.CommitID]time.Time{}
+			for _, line := range strings.Fields(git(t, dir, append([]string{"log", "--pretty=format:%H:%ct"}, args...)...)) {
+				sha, ts, _ := strings.Cut(line, ":")
+				sec, err := strconv.ParseInt(ts, 10, 64)
+				require.NoError(t, err)
+				commits[api.CommitID(sha)] = time.Unix(sec, 0)
+			}
+			return commits
+		}
+
+		got, err := idx.CommitsUniqueTo("other", "HEAD", nil)
+		require.NoError(t, err)
+		require.Equal(t, logCommits("other", "^HEAD"), got)
+
+		got, err = idx.CommitsUniqueTo("HEAD", "", nil)
+		require.NoError(t, err)
+		require.Equal(t, logCommits("HEAD"), got)
+
+		since := time.Date(2006, 1, 4, 15, 4, 5, 0, time.UTC)
+		got, err = idx.CommitsUniqueTo("HEAD", "", &since)
+		require.NoError(t, err)
+		require.Equal(t, logCommits("HEAD", "--since="+since.Format(time.RFC3339)), got)
+	})
+}
+
+func TestCache(t *testing.T) {
+	dir, gitDir := setupTestRepo(t)
+	ctx := context.Background()
+
+	c, err := NewCache(1)
+	require.NoError(t, err)
+
+	idx, err := c.Get(ctx, gitDir)
+	require.NoError(t, err)
+	cached, err := c.Get(ctx, gitDir)
+	require.NoError(t, err)
+	require.Same(t, idx, cached)
+
+	git(t, dir, "commit", "--allow-empty", "-m", "c7")
+	require.NoError(t, c.Refresh(ctx, gitDir))
+	refreshed, err := c.Get(ctx, gitDir)
+	require.NoError(t, err)
+	require.Equal(t, 9, refreshed.Len())
+
+	c.Invalidate(gitDir)
+	rebuilt, err := c.Get(ctx, gitDir)
+	require.NoError(t, err)
+	require.NotSame(t, refreshed, rebuilt)
+}
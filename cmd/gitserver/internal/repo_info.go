@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"os"
 
 	"github.com/sourcegraph/log"
 
@@ -15,12 +16,15 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-func repoCloneProgress(reposDir string, locker RepositoryLocker, repo api.RepoName) *protocol.RepoCloneProgress {
+func repoCloneProgress(reposDir string, locker RepositoryLocker, clones *cloneProgressTrackers, repo api.RepoName) *protocol.RepoCloneProgress {
 	dir := gitserverfs.RepoDirFromName(reposDir, repo)
 	resp := protocol.RepoCloneProgress{
 		Cloned: repoCloned(dir),
 	}
 	resp.CloneProgress, resp.CloneInProgress = locker.Status(dir)
+	if resp.CloneInProgress {
+		resp.ProgressDetails = clones.Details(dir)
+	}
 	if isAlwaysCloningTest(repo) {
 		resp.CloneInProgress = true
 		resp.CloneProgress = "This will never finish cloning"
@@ -39,7 +43,7 @@ func (s *Server) handleRepoCloneProgress(w http.ResponseWriter, r *http.Request)
 		Results: make(map[api.RepoName]*protocol.RepoCloneProgress, len(req.Repos)),
 	}
 	for _, repoName := range req.Repos {
-		result := repoCloneProgress(s.ReposDir, s.Locker, repoName)
+		result := repoCloneProgress(s.ReposDir, s.Locker, &s.clonesInProgress, repoName)
 		resp.Results[repoName] = result
 	}
 
@@ -78,6 +82,11 @@ func deleteRepo(
 	if err != nil {
 		return errors.Wrap(err, "removing repo directory")
 	}
+	// A partial clone of the repo must not be resumed if the repo is cloned
+	// again later.
+	if err := os.RemoveAll(gitserverfs.PartialCloneDir(reposDir, api.UndeletedRepoName(repo))); err != nil {
+		return errors.Wrap(err, "removing partial clone")
+	}
 	err = db.GitserverRepos().SetCloneStatus(ctx, repo, types.CloneStatusNotCloned, shardID)
 	if err != nil {
 		return errors.Wrap(err, "setting clone status after delete")
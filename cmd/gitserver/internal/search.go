@@ -3,6 +3,7 @@ package internal
 import (
 	"context"
 	"math"
+	"os/exec"
 	"strconv"
 	"sync/atomic"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/sourcegraph/log"
 	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
@@ -145,6 +147,15 @@ func (s *Server) search(ctx context.Context, args *protocol.SearchRequest, onMat
 		IncludeModifiedFiles: args.IncludeModifiedFiles || hasDiffModifiesFile,
 	}
 
+	// Partial clones fetch the blobs they are missing when diffs are searched.
+	if git.IsPartialClone(dir) {
+		cmd := exec.Command("git")
+		if _, err := s.configurePromisorRemote(ctx, args.Repo, cmd); err != nil {
+			return false, err
+		}
+		searcher.Env = cmd.Env
+	}
+
 	return hitLimit.Load(), searcher.Search(ctx, limitedOnMatch)
 }
 
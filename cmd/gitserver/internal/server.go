@@ -36,7 +36,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/mercurial"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/perforce"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/pushmirror"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/reachability"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/urlredactor"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
@@ -157,6 +160,15 @@ type Server struct {
 	// Locker is used to lock repositories while fetching to prevent concurrent work.
 	Locker RepositoryLocker
 
+	// clonesInProgress tracks the structured progress of the running clones.
+	clonesInProgress cloneProgressTrackers
+
+	// reachabilityIndexes caches the commit graph indexes used to answer
+	// reachability queries. Use s.ensureReachabilityIndexes() instead of using
+	// it directly.
+	reachabilityIndexes     *reachability.Cache
+	reachabilityIndexesOnce sync.Once
+
 	// skipCloneForTests is set by tests to avoid clones.
 	skipCloneForTests bool
 
@@ -199,6 +211,13 @@ type Server struct {
 
 	// Perforce is a plugin-like service attached to Server for all things Perforce.
 	Perforce *perforce.Service
+
+	// Mercurial maps the changesets of repos converted from Mercurial to their
+	// commits after every fetch.
+	Mercurial *mercurial.Service
+
+	// PushMirrors pushes repos to their push mirrors after every update.
+	PushMirrors *pushmirror.Service
 }
 
 type locks struct {
@@ -497,6 +516,23 @@ func (s *Server) getRemoteURL(ctx context.Context, name api.RepoName) (*vcs.URL,
 	return vcs.ParseURL(remoteURL)
 }
 
+// configurePromisorRemote configures cmd, which runs in the partial clone of
+// repo, to fetch the objects that are missing from the clone from the remote
+// of repo. It returns a redactor for the output of cmd, because the remote URL
+// can contain credentials.
+func (s *Server) configurePromisorRemote(ctx context.Context, repo api.RepoName, cmd *exec.Cmd) (*urlredactor.URLRedactor, error) {
+	remoteURL, err := s.getRemoteURL(ctx, repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to determine remote URL of partial clone")
+	}
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env, git.PromisorRemoteEnv(remoteURL)...)
+	executil.ConfigureRemoteGitCommand(cmd)
+	return urlredactor.New(remoteURL), nil
+}
+
 // acquireCloneLimiter() acquires a cancellable context associated with the
 // clone limiter.
 func (s *Server) acquireCloneLimiter(ctx context.Context) (context.Context, context.CancelFunc, error) {
@@ -635,6 +671,8 @@ func (s *Server) repoUpdate(req *protocol.RepoUpdateRequest) protocol.RepoUpdate
 		resp.Error = updateErr.Error()
 	} else {
 		s.Perforce.EnqueueChangelistMappingJob(perforce.NewChangelistMappingJob(req.Repo, dir))
+		s.Mercurial.EnqueueChangesetMappingJob(mercurial.NewChangesetMappingJob(req.Repo, dir))
+		s.PushMirrors.EnqueuePushJob(pushmirror.NewPushJob(req.Repo, dir))
 	}
 
 	return resp
@@ -952,6 +990,22 @@ func (s *Server) ensureOperations() *operations {
 	return s.operations
 }
 
+var reachabilityIndexCacheSize, _ = strconv.Atoi(env.Get("SRC_GITSERVER_REACHABILITY_INDEX_CACHE_SIZE", "100", "the maximum number of repositories whose commit graph is kept in memory to answer reachability queries"))
+
+// ensureReachabilityIndexes returns the cache of reachability indexes, creating
+// it on first use.
+func (s *Server) ensureReachabilityIndexes() *reachability.Cache {
+	s.reachabilityIndexesOnce.Do(func() {
+		c, err := reachability.NewCache(reachabilityIndexCacheSize)
+		if err != nil {
+			s.Logger.Warn("invalid reachability index cache size, using the default", log.Int("size", reachabilityIndexCacheSize), log.Error(err))
+			c, _ = reachability.NewCache(100)
+		}
+		s.reachabilityIndexes = c
+	})
+	return s.reachabilityIndexes
+}
+
 func (s *Server) handleExec(w http.ResponseWriter, r *http.Request) {
 	// 🚨 SECURITY: Only allow POST requests.
 	// See https://github.com/sourcegraph/security-issues/issues/213.
@@ -1166,6 +1220,17 @@ func (s *Server) exec(ctx context.Context, logger log.Logger, req *protocol.Exec
 	cmd.Unwrap().Stderr = stderrW
 	cmd.Unwrap().Stdin = bytes.NewReader(req.Stdin)
 
+	// Partial clones fetch the blobs they are missing when they are read.
+	var redactor *urlredactor.URLRedactor
+	if git.IsPartialClone(dir) {
+		var err error
+		redactor, err = s.configurePromisorRemote(ctx, repoName, cmd.Unwrap())
+		if err != nil {
+			return execStatus{}, err
+		}
+		cmd = cmd.WithRedactorFunc(redactor.Redact)
+	}
+
 	exitStatus, execErr = executil.RunCommand(ctx, cmd)
 
 	status = strconv.Itoa(exitStatus)
@@ -1173,6 +1238,9 @@ func (s *Server) exec(ctx context.Context, logger log.Logger, req *protocol.Exec
 	stderrN = stderrW.n
 
 	stderr := stderrBuf.String()
+	if redactor != nil {
+		stderr = redactor.Redact(stderr)
+	}
 	s.logIfCorrupt(ctx, repoName, dir, stderr)
 
 	return execStatus{
@@ -1456,12 +1524,31 @@ func (s *Server) doClone(
 	// We clone to a temporary location first to avoid having incomplete
 	// clones in the repo tree. This also avoids leaving behind corrupt clones
 	// if the clone is interrupted.
-	tmpDir, err := gitserverfs.TempDir(s.ReposDir, "clone-")
-	if err != nil {
-		return err
+	//
+	// Resumable clones use a location that is not cleared when gitserver
+	// restarts, and which is only removed once the clone succeeded, so that
+	// the next attempt can resume an interrupted clone.
+	resumableCloner, resumable := syncer.(vcssyncer.ResumableCloner)
+	resumable = resumable && !opts.Overwrite && resumableClonesEnabled()
+
+	var tmpDir string
+	if resumable {
+		tmpDir = gitserverfs.PartialCloneDir(s.ReposDir, repo)
+		defer func() {
+			if err == nil {
+				os.RemoveAll(tmpDir)
+			}
+		}()
+	} else {
+		tmpDir, err = gitserverfs.TempDir(s.ReposDir, "clone-")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmpDir)
 	}
-	defer os.RemoveAll(tmpDir)
 	tmpPath := filepath.Join(tmpDir, ".git")
+	_, statErr := os.Stat(filepath.Join(tmpPath, "HEAD"))
+	resumed := resumable && statErr == nil
 
 	// It may already be cloned
 	if !repoCloned(dir) {
@@ -1485,9 +1572,17 @@ func (s *Server) doClone(
 	// produced, the ideal solution would be that readCloneProgress stores it in
 	// chunks.
 	output := &linebasedBufferedWriter{}
-	eg := readCloneProgress(s.DB, logger, lock, io.TeeReader(progressReader, output), repo)
-
-	cloneErr := syncer.Clone(ctx, repo, remoteURL, dir, tmpPath, progressWriter)
+	tracker := newCloneProgressTracker(resumed)
+	s.clonesInProgress.Set(dir, tracker)
+	defer s.clonesInProgress.Delete(dir)
+	eg := readCloneProgress(s.DB, logger, lock, tracker, io.TeeReader(progressReader, output), repo)
+
+	var cloneErr error
+	if resumable {
+		cloneErr = resumableCloner.ResumableClone(ctx, repo, remoteURL, dir, tmpPath, progressWriter)
+	} else {
+		cloneErr = syncer.Clone(ctx, repo, remoteURL, dir, tmpPath, progressWriter)
+	}
 	progressWriter.Close()
 
 	if err := eg.Wait(); err != nil {
@@ -1531,7 +1626,12 @@ func (s *Server) doClone(
 	logger.Info("repo cloned")
 	repoClonedCounter.Inc()
 
+	// The repository might have been recloned, which makes its index stale.
+	s.ensureReachabilityIndexes().Invalidate(dir)
+
 	s.Perforce.EnqueueChangelistMappingJob(perforce.NewChangelistMappingJob(repo, dir))
+	s.Mercurial.EnqueueChangesetMappingJob(mercurial.NewChangesetMappingJob(repo, dir))
+	s.PushMirrors.EnqueuePushJob(pushmirror.NewPushJob(repo, dir))
 
 	return nil
 }
@@ -1652,11 +1752,17 @@ func postRepoFetchActions(
 	return errs
 }
 
+// resumableClonesEnabled returns true if clones should be resumable.
+func resumableClonesEnabled() bool {
+	c := conf.Get()
+	return c.ExperimentalFeatures != nil && c.ExperimentalFeatures.ResumableClones == "enabled"
+}
+
 // readCloneProgress scans the reader and saves the most recent line of output
-// as the lock status, writes to a log file if siteConfig.cloneProgressLog is
-// enabled, and optionally to the database when the feature flag `clone-progress-logging`
-// is enabled.
-func readCloneProgress(db database.DB, logger log.Logger, lock RepositoryLock, pr io.Reader, repo api.RepoName) *errgroup.Group {
+// as the lock status, records it in the progress tracker, writes to a log file
+// if siteConfig.cloneProgressLog is enabled, and optionally to the database
+// when the feature flag `clone-progress-logging` is enabled.
+func readCloneProgress(db database.DB, logger log.Logger, lock RepositoryLock, tracker *cloneProgressTracker, pr io.Reader, repo api.RepoName) *errgroup.Group {
 	// Use a background context to ensure we still update the DB even if we
 	// time out. IE we intentionally don't take an input ctx.
 	ctx := featureflag.WithFlags(context.Background(), db.FeatureFlags())
@@ -1685,6 +1791,7 @@ func readCloneProgress(db database.DB, logger log.Logger, lock RepositoryLock, p
 		for scan.Scan() {
 			progress := scan.Text()
 			lock.SetStatus(progress)
+			tracker.Update(progress)
 
 			if logFile != nil {
 				// Failing to write here is non-fatal and we don't want to spam our logs if there
@@ -1908,7 +2015,16 @@ func (s *Server) doBackgroundRepoUpdate(repo api.RepoName, revspec string) error
 		}
 	}
 
-	return postRepoFetchActions(ctx, logger, s.DB, s.Hostname, s.RecordingCommandFactory, s.ReposDir, repo, dir, remoteURL, syncer)
+	err = postRepoFetchActions(ctx, logger, s.DB, s.Hostname, s.RecordingCommandFactory, s.ReposDir, repo, dir, remoteURL, syncer)
+
+	// The fetch changed the refs of the repository. Rebuild its reachability
+	// index now rather than on the next query, so that queries don't have to
+	// wait for it.
+	if err := s.ensureReachabilityIndexes().Refresh(ctx, dir); err != nil {
+		logger.Warn("failed to refresh reachability index", log.Error(err))
+	}
+
+	return err
 }
 
 // setHEAD configures git repo defaults (such as what HEAD is) which are
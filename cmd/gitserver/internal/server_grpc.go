@@ -3,10 +3,16 @@ package internal
 import (
 	"context"
 	"io"
+	"io/fs"
+	"os"
+	"os/exec"
 	"strings"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/sourcegraph/log"
 
@@ -14,12 +20,15 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/perforce"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/reachability"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+	"github.com/sourcegraph/sourcegraph/internal/grpc/chunk"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/streamio"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -324,7 +333,7 @@ func (gs *GRPCServer) RepoCloneProgress(_ context.Context, req *proto.RepoCloneP
 	}
 	for _, repo := range repositories {
 		repoName := api.RepoName(repo)
-		result := repoCloneProgress(gs.Server.ReposDir, gs.Server.Locker, repoName)
+		result := repoCloneProgress(gs.Server.ReposDir, gs.Server.Locker, &gs.Server.clonesInProgress, repoName)
 		resp.Results[repoName] = result
 	}
 	return resp.ToProto(), nil
@@ -622,3 +631,613 @@ func byteSlicesToStrings(in [][]byte) []string {
 	}
 	return res
 }
+
+func (gs *GRPCServer) ListRefs(req *proto.ListRefsRequest, ss proto.GitserverService_ListRefsServer) (err error) {
+	ctx := ss.Context()
+	repo := protocol.NormalizeRepo(api.RepoName(req.GetRepo()))
+
+	ctx, _, endObservation := gs.Server.ensureOperations().listRefs.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{repo.Attr()},
+	})
+	defer endObservation(1, observation.Args{})
+
+	accesslog.Record(ctx, string(repo))
+
+	if err := gs.checkRepoCloned(ctx, repo); err != nil {
+		return err
+	}
+
+	opts := git.ListRefsOpts{
+		HeadsOnly: req.GetHeadsOnly(),
+		TagsOnly:  req.GetTagsOnly(),
+	}
+	for _, c := range req.GetPointsAtCommit() {
+		if err := git.CheckSpecArgSafety(c); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		opts.PointsAtCommit = append(opts.PointsAtCommit, api.CommitID(c))
+	}
+
+	refs, err := git.ListRefs(ctx, gs.Server.RecordingCommandFactory, gs.Server.ReposDir, repo, opts)
+	if err != nil {
+		return gs.typedRPCError(ctx, repo, "", "", err)
+	}
+
+	chunker := chunk.New(func(refs []*proto.GitRef) error {
+		return ss.Send(&proto.ListRefsResponse{Refs: refs})
+	})
+	for _, ref := range refs {
+		if err := chunker.Send(ref.ToProto()); err != nil {
+			return err
+		}
+	}
+	return chunker.Flush()
+}
+
+func (gs *GRPCServer) ReadDir(req *proto.ReadDirRequest, ss proto.GitserverService_ReadDirServer) (err error) {
+	ctx := ss.Context()
+	repo := protocol.NormalizeRepo(api.RepoName(req.GetRepo()))
+	commit := api.CommitID(req.GetCommitSha())
+	path := string(req.GetPath())
+
+	ctx, _, endObservation := gs.Server.ensureOperations().readDir.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			commit.Attr(),
+			attribute.String("path", path),
+			attribute.Bool("recursive", req.GetRecursive()),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	accesslog.Record(ctx, string(repo),
+		log.String("commit", string(commit)),
+		log.String("path", path),
+	)
+
+	if err := gitdomain.EnsureAbsoluteCommit(commit); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := git.CheckSpecArgSafety(path); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := gs.checkRepoCloned(ctx, repo); err != nil {
+		return err
+	}
+	gs.Server.ensureRevision(ctx, repo, string(commit), gitserverfs.RepoDirFromName(gs.Server.ReposDir, repo))
+
+	// ls-tree --long reads the size of blobs.
+	env, err := gs.blobReadEnv(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	fis, err := git.ReadDir(ctx, gs.Server.RecordingCommandFactory, gs.Server.ReposDir, repo, commit, path, req.GetRecursive(), env)
+	if err != nil {
+		return gs.typedRPCError(ctx, repo, string(commit), path, err)
+	}
+
+	chunker := chunk.New(func(fis []*proto.FileInfo) error {
+		return ss.Send(&proto.ReadDirResponse{FileInfo: fis})
+	})
+	for _, fi := range fis {
+		if err := chunker.Send(fileInfoToProto(fi)); err != nil {
+			return err
+		}
+	}
+	return chunker.Flush()
+}
+
+func fileInfoToProto(fi fs.FileInfo) *proto.FileInfo {
+	p := &proto.FileInfo{
+		Name: []byte(fi.Name()),
+		Size: fi.Size(),
+		Mode: uint32(fi.Mode()),
+	}
+	switch sys := fi.Sys().(type) {
+	case gitdomain.Submodule:
+		p.BlobOid = string(sys.CommitID)
+		p.Submodule = &proto.GitSubmodule{
+			Url:       sys.URL,
+			Path:      []byte(sys.Path),
+			CommitSha: string(sys.CommitID),
+		}
+	case gitdomain.ObjectInfo:
+		p.BlobOid = sys.OID().String()
+	}
+	return p
+}
+
+func (gs *GRPCServer) Blame(req *proto.BlameRequest, ss proto.GitserverService_BlameServer) (err error) {
+	ctx := ss.Context()
+	repo := protocol.NormalizeRepo(api.RepoName(req.GetRepo()))
+	commit := api.CommitID(req.GetCommit())
+	path := string(req.GetPath())
+
+	ctx, _, endObservation := gs.Server.ensureOperations().blame.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			commit.Attr(),
+			attribute.String("path", path),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	accesslog.Record(ctx, string(repo),
+		log.String("commit", string(commit)),
+		log.String("path", path),
+	)
+
+	if commit == "" {
+		commit = "HEAD"
+	}
+	if err := git.CheckSpecArgSafety(string(commit)); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	opts := git.BlameOptions{
+		NewestCommit:     commit,
+		IgnoreWhitespace: req.GetIgnoreWhitespace(),
+	}
+	if r := req.GetRange(); r != nil {
+		opts.StartLine = int(r.GetStartLine())
+		opts.EndLine = int(r.GetEndLine())
+	}
+
+	if err := gs.checkRepoCloned(ctx, repo); err != nil {
+		return err
+	}
+	gs.Server.ensureRevision(ctx, repo, string(commit), gitserverfs.RepoDirFromName(gs.Server.ReposDir, repo))
+
+	env, err := gs.blobReadEnv(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	// git blame only reports line numbers, so we compute the byte offsets of
+	// the hunks from the blamed file itself. This also lets us return a
+	// proper not found error before starting to stream.
+	offsets, err := git.LineOffsets(ctx, gs.Server.RecordingCommandFactory, gs.Server.ReposDir, repo, commit, path, env)
+	if err != nil {
+		return gs.typedRPCError(ctx, repo, string(commit), path, err)
+	}
+
+	rc, err := git.Blame(ctx, gs.Server.RecordingCommandFactory, gs.Server.ReposDir, repo, path, opts, env)
+	if err != nil {
+		return gs.typedRPCError(ctx, repo, string(commit), path, err)
+	}
+	hr := gitserver.NewBlameHunkReader(rc)
+	defer hr.Close()
+
+	firstLine := 1
+	if opts.StartLine > 0 {
+		firstLine = opts.StartLine
+	}
+	byteOffset := func(line int) uint32 {
+		if line-1 >= len(offsets) {
+			line = len(offsets)
+		}
+		if firstLine-1 >= len(offsets) {
+			return 0
+		}
+		return uint32(offsets[line-1] - offsets[firstLine-1])
+	}
+
+	for {
+		h, err := hr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return gs.typedRPCError(ctx, repo, string(commit), path, err)
+		}
+
+		if err := ss.Send(&proto.BlameResponse{
+			Hunk: &proto.BlameHunk{
+				StartLine: uint32(h.StartLine),
+				EndLine:   uint32(h.EndLine),
+				StartByte: byteOffset(h.StartLine),
+				EndByte:   byteOffset(h.EndLine),
+				Commit:    string(h.CommitID),
+				Author: &proto.BlameAuthor{
+					Name:  []byte(h.Author.Name),
+					Email: []byte(h.Author.Email),
+					Date:  timestamppb.New(h.Author.Date),
+				},
+				Message:  h.Message,
+				Filename: []byte(h.Filename),
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := hr.Close(); err != nil {
+		return gs.typedRPCError(ctx, repo, string(commit), path, err)
+	}
+	return nil
+}
+
+func (gs *GRPCServer) CommitLog(req *proto.CommitLogRequest, ss proto.GitserverService_CommitLogServer) (err error) {
+	ctx := ss.Context()
+	repo := protocol.NormalizeRepo(api.RepoName(req.GetRepo()))
+
+	ctx, _, endObservation := gs.Server.ensureOperations().commitLog.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("range", req.GetRange()),
+			attribute.String("path", string(req.GetPath())),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	accesslog.Record(ctx, string(repo),
+		log.String("range", req.GetRange()),
+		log.String("path", string(req.GetPath())),
+	)
+
+	if err := git.CheckSpecArgSafety(req.GetRange()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := gs.checkRepoCloned(ctx, repo); err != nil {
+		return err
+	}
+	if !req.GetNoEnsureRevision() {
+		gs.Server.ensureRevision(ctx, repo, req.GetRange(), gitserverfs.RepoDirFromName(gs.Server.ReposDir, repo))
+	}
+
+	opts := git.CommitLogOpts{
+		Range:                req.GetRange(),
+		MaxCommits:           uint(req.GetMaxCommits()),
+		Skip:                 uint(req.GetSkip()),
+		Author:               req.GetAuthor(),
+		After:                req.GetAfter(),
+		Before:               req.GetBefore(),
+		Reverse:              req.GetReverse(),
+		DateOrder:            req.GetDateOrder(),
+		MessageQuery:         req.GetMessageQuery(),
+		Path:                 string(req.GetPath()),
+		Follow:               req.GetFollow(),
+		IncludeModifiedFiles: req.GetIncludeModifiedFiles(),
+	}
+
+	chunker := chunk.New(func(commits []*proto.GitCommit) error {
+		return ss.Send(&proto.CommitLogResponse{Commits: commits})
+	})
+	err = git.CommitLog(ctx, gs.Server.RecordingCommandFactory, gs.Server.ReposDir, repo, opts, func(c *git.CommitLogEntry) error {
+		p := c.Commit.ToProto()
+		for _, f := range c.ModifiedFiles {
+			p.ModifiedFiles = append(p.ModifiedFiles, []byte(f))
+		}
+		return chunker.Send(p)
+	})
+	if err != nil {
+		return gs.typedRPCError(ctx, repo, "", "", err)
+	}
+	return chunker.Flush()
+}
+
+func (gs *GRPCServer) MergeBase(ctx context.Context, req *proto.MergeBaseRequest) (_ *proto.MergeBaseResponse, err error) {
+	repo := protocol.NormalizeRepo(api.RepoName(req.GetRepo()))
+
+	ctx, _, endObservation := gs.Server.ensureOperations().mergeBase.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("base", req.GetBase()),
+			attribute.String("head", req.GetHead()),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	accesslog.Record(ctx, string(repo),
+		log.String("base", req.GetBase()),
+		log.String("head", req.GetHead()),
+	)
+
+	if req.GetBase() == "" || req.GetHead() == "" {
+		return nil, status.Error(codes.InvalidArgument, "base and head must be specified")
+	}
+	for _, rev := range []string{req.GetBase(), req.GetHead()} {
+		if err := git.CheckSpecArgSafety(rev); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if err := gs.checkRepoCloned(ctx, repo); err != nil {
+		return nil, err
+	}
+
+	sha, err := git.MergeBase(ctx, gs.Server.RecordingCommandFactory, gs.Server.ReposDir, repo, req.GetBase(), req.GetHead())
+	if err != nil {
+		return nil, gs.typedRPCError(ctx, repo, "", "", err)
+	}
+
+	return &proto.MergeBaseResponse{MergeBaseCommitSha: string(sha)}, nil
+}
+
+func (gs *GRPCServer) ContributorCounts(ctx context.Context, req *proto.ContributorCountsRequest) (_ *proto.ContributorCountsResponse, err error) {
+	repo := protocol.NormalizeRepo(api.RepoName(req.GetRepo()))
+
+	ctx, _, endObservation := gs.Server.ensureOperations().contributorCounts.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("range", req.GetRange()),
+			attribute.String("after", req.GetAfter()),
+			attribute.String("path", string(req.GetPath())),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	accesslog.Record(ctx, string(repo),
+		log.String("range", req.GetRange()),
+		log.String("path", string(req.GetPath())),
+	)
+
+	if err := git.CheckSpecArgSafety(req.GetRange()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := gs.checkRepoCloned(ctx, repo); err != nil {
+		return nil, err
+	}
+
+	counts, err := git.ContributorCounts(ctx, gs.Server.RecordingCommandFactory, gs.Server.ReposDir, repo, git.ContributorCountsOpts{
+		Range: req.GetRange(),
+		After: req.GetAfter(),
+		Path:  string(req.GetPath()),
+	})
+	if err != nil {
+		return nil, gs.typedRPCError(ctx, repo, "", "", err)
+	}
+
+	res := &proto.ContributorCountsResponse{Counts: make([]*proto.ContributorCount, 0, len(counts))}
+	for _, c := range counts {
+		res.Counts = append(res.Counts, c.ToProto())
+	}
+	return res, nil
+}
+
+// Reachability answers a query about the commit graph of a repository from its
+// reachability index, without running git for revisions the index can resolve.
+func (gs *GRPCServer) Reachability(ctx context.Context, req *proto.ReachabilityRequest) (_ *proto.ReachabilityResponse, err error) {
+	repo := protocol.NormalizeRepo(api.RepoName(req.GetRepo()))
+
+	revs, err := reachabilityQueryRevisions(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	for _, rev := range revs {
+		if err := git.CheckSpecArgSafety(rev); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx, _, endObservation := gs.Server.ensureOperations().reachability.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.StringSlice("revs", revs),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	accesslog.Record(ctx, string(repo),
+		log.Strings("revs", revs),
+	)
+
+	if err := gs.checkRepoCloned(ctx, repo); err != nil {
+		return nil, err
+	}
+
+	idx, resolved, err := gs.reachabilityIndex(ctx, repo, revs)
+	if err != nil {
+		return nil, gs.typedRPCError(ctx, repo, "", "", err)
+	}
+
+	res, err := answerReachabilityQuery(idx, req, resolved)
+	if err != nil {
+		return nil, gs.typedRPCError(ctx, repo, "", "", err)
+	}
+	return res, nil
+}
+
+// reachabilityIndex returns the reachability index of repo, together with the
+// commits of the given revisions that the index can't resolve by itself, like
+// tags or abbreviated commit IDs. These are resolved with git. If one of them
+// isn't in the index, the repository changed since the index was built and the
+// index is rebuilt.
+func (gs *GRPCServer) reachabilityIndex(ctx context.Context, repo api.RepoName, revs []string) (*reachability.Index, map[string]string, error) {
+	indexes := gs.Server.ensureReachabilityIndexes()
+	dir := gitserverfs.RepoDirFromName(gs.Server.ReposDir, repo)
+
+	idx, err := indexes.Get(ctx, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolved := make(map[string]string)
+	stale := false
+	for _, rev := range revs {
+		if _, ok := idx.Resolve(rev); ok {
+			continue
+		}
+		commit, err := git.ResolveCommit(ctx, gs.Server.RecordingCommandFactory, gs.Server.ReposDir, repo, rev)
+		if err != nil {
+			return nil, nil, err
+		}
+		resolved[rev] = string(commit)
+		stale = stale || !idx.Contains(commit)
+	}
+	if !stale {
+		return idx, resolved, nil
+	}
+
+	indexes.Invalidate(dir)
+	idx, err = indexes.Get(ctx, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Commits that are still missing exist in the object store but aren't
+	// reachable from any ref, so the index can't answer queries about them.
+	for rev, commit := range resolved {
+		if !idx.Contains(api.CommitID(commit)) {
+			return nil, nil, &gitdomain.RevisionNotFoundError{Repo: repo, Spec: rev}
+		}
+	}
+	return idx, resolved, nil
+}
+
+// reachabilityQueryRevisions returns the revisions that the query of req refers
+// to.
+func reachabilityQueryRevisions(req *proto.ReachabilityRequest) ([]string, error) {
+	switch q := req.GetQuery().(type) {
+	case *proto.ReachabilityRequest_IsAncestor:
+		return []string{q.IsAncestor.GetAncestor(), q.IsAncestor.GetDescendant()}, nil
+	case *proto.ReachabilityRequest_BehindAhead:
+		return []string{q.BehindAhead.GetLeft(), q.BehindAhead.GetRight()}, nil
+	case *proto.ReachabilityRequest_BranchesContaining:
+		return []string{q.BranchesContaining.GetCommit()}, nil
+	case *proto.ReachabilityRequest_CommitsUniqueToBranch:
+		if q.CommitsUniqueToBranch.GetIsDefaultBranch() {
+			return []string{"HEAD"}, nil
+		}
+		return []string{q.CommitsUniqueToBranch.GetBranch(), "HEAD"}, nil
+	default:
+		return nil, errors.Newf("unknown reachability query %T", q)
+	}
+}
+
+// answerReachabilityQuery answers the query of req from idx. Revisions in
+// resolved are replaced by the commit they map to.
+func answerReachabilityQuery(idx *reachability.Index, req *proto.ReachabilityRequest, resolved map[string]string) (*proto.ReachabilityResponse, error) {
+	rev := func(r string) string {
+		if commit, ok := resolved[r]; ok {
+			return commit
+		}
+		return r
+	}
+
+	switch q := req.GetQuery().(type) {
+	case *proto.ReachabilityRequest_IsAncestor:
+		ok, err := idx.IsAncestor(rev(q.IsAncestor.GetAncestor()), rev(q.IsAncestor.GetDescendant()))
+		if err != nil {
+			return nil, err
+		}
+		return &proto.ReachabilityResponse{Result: &proto.ReachabilityResponse_IsAncestor{IsAncestor: ok}}, nil
+
+	case *proto.ReachabilityRequest_BehindAhead:
+		behind, ahead, err := idx.BehindAhead(rev(q.BehindAhead.GetLeft()), rev(q.BehindAhead.GetRight()))
+		if err != nil {
+			return nil, err
+		}
+		return &proto.ReachabilityResponse{Result: &proto.ReachabilityResponse_BehindAhead{
+			BehindAhead: &proto.BehindAheadResponse{Behind: behind, Ahead: ahead},
+		}}, nil
+
+	case *proto.ReachabilityRequest_BranchesContaining:
+		branches, err := idx.BranchesContaining(rev(q.BranchesContaining.GetCommit()))
+		if err != nil {
+			return nil, err
+		}
+		return &proto.ReachabilityResponse{Result: &proto.ReachabilityResponse_BranchesContaining{
+			BranchesContaining: &proto.BranchesContainingResult{Branches: branches},
+		}}, nil
+
+	case *proto.ReachabilityRequest_CommitsUniqueToBranch:
+		var since *time.Time
+		if q.CommitsUniqueToBranch.GetMaxAge() != nil {
+			t := q.CommitsUniqueToBranch.GetMaxAge().AsTime()
+			since = &t
+		}
+		var (
+			commits map[api.CommitID]time.Time
+			err     error
+		)
+		if q.CommitsUniqueToBranch.GetIsDefaultBranch() {
+			commits, err = idx.CommitsUniqueTo(rev("HEAD"), "", since)
+		} else {
+			commits, err = idx.CommitsUniqueTo(rev(q.CommitsUniqueToBranch.GetBranch()), rev("HEAD"), since)
+		}
+		if err != nil {
+			return nil, err
+		}
+		result := &proto.CommitsUniqueToBranchResult{Commits: make([]*proto.CommitWithDate, 0, len(commits))}
+		for commit, date := range commits {
+			result.Commits = append(result.Commits, &proto.CommitWithDate{
+				Commit:        string(commit),
+				CommitterDate: timestamppb.New(date),
+			})
+		}
+		return &proto.ReachabilityResponse{Result: &proto.ReachabilityResponse_CommitsUniqueToBranch{CommitsUniqueToBranch: result}}, nil
+
+	default:
+		return nil, errors.Newf("unknown reachability query %T", q)
+	}
+}
+
+// checkRepoCloned returns a NotFound status error with a NotFoundPayload if
+// the repo is not cloned yet. A clone is started if possible.
+func (gs *GRPCServer) checkRepoCloned(ctx context.Context, repo api.RepoName) error {
+	notFound, cloned := gs.Server.maybeStartClone(ctx, gs.Server.Logger, repo)
+	if cloned {
+		return nil
+	}
+
+	s, err := status.New(codes.NotFound, "repo not found").WithDetails(&proto.NotFoundPayload{
+		Repo:            string(repo),
+		CloneInProgress: notFound.CloneInProgress,
+		CloneProgress:   notFound.CloneProgress,
+	})
+	if err != nil {
+		gs.Server.Logger.Error("failed to marshal status", log.Error(err))
+		return err
+	}
+	return s.Err()
+}
+
+// blobReadEnv returns the environment of git commands that read the blobs of
+// repo. Partial clones fetch the blobs they are missing from the remote of repo
+// when they are read. It returns nil for full clones, in which case commands run
+// with the environment of gitserver.
+func (gs *GRPCServer) blobReadEnv(ctx context.Context, repo api.RepoName) ([]string, error) {
+	if !git.IsPartialClone(gitserverfs.RepoDirFromName(gs.Server.ReposDir, repo)) {
+		return nil, nil
+	}
+	cmd := exec.Command("git")
+	if _, err := gs.Server.configurePromisorRemote(ctx, repo, cmd); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// The environment is shared by several commands, so appending to it must copy.
+	return cmd.Env[:len(cmd.Env):len(cmd.Env)], nil
+}
+
+// typedRPCError converts an error returned from the git package into a gRPC
+// status error. Missing revisions and paths are reported as NotFound with a
+// RevisionNotFoundPayload or FileNotFoundPayload respectively.
+func (gs *GRPCServer) typedRPCError(ctx context.Context, repo api.RepoName, commit, path string, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr).Err()
+	}
+
+	var s *status.Status
+	if revErr := new(gitdomain.RevisionNotFoundError); errors.As(err, &revErr) {
+		s, err = status.New(codes.NotFound, err.Error()).WithDetails(&proto.RevisionNotFoundPayload{
+			Repo: string(revErr.Repo),
+			Spec: revErr.Spec,
+		})
+	} else if errors.Is(err, os.ErrNotExist) {
+		s, err = status.New(codes.NotFound, err.Error()).WithDetails(&proto.FileNotFoundPayload{
+			Repo:   string(repo),
+			Commit: commit,
+			Path:   []byte(path),
+		})
+	} else {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err != nil {
+		gs.Server.Logger.Error("failed to marshal status", log.Error(err))
+		return err
+	}
+	return s.Err()
+}
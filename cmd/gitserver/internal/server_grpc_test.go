@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+func TestGRPCServer_PartialClone(t *testing.T) {
+	ctx := context.Background()
+
+	remote := t.TempDir()
+	runCmd(t, remote, "git", "init", "--initial-branch=master")
+	runCmd(t, remote, "git", "config", "uploadpack.allowFilter", "true")
+	runCmd(t, remote, "git", "config", "uploadpack.allowAnySHA1InWant", "true")
+	require.NoError(t, os.WriteFile(filepath.Join(remote, "f.txt"), []byte("a\nb\n"), 0o644))
+	runCmd(t, remote, "git", "add", "f.txt")
+	runCmd(t, remote, "git", "commit", "-m", "add f.txt")
+	commit := strings.TrimSpace(runCmd(t, remote, "git", "rev-parse", "HEAD"))
+	blob := strings.TrimSpace(runCmd(t, remote, "git", "rev-parse", "HEAD:f.txt"))
+
+	// Create a blobless partial clone that does not store the remote URL, like
+	// the ones created by the repo syncer.
+	reposDir := t.TempDir()
+	repo := api.RepoName("example.com/foo/bar")
+	dir := gitserverfs.RepoDirFromName(reposDir, repo)
+	runCmd(t, reposDir, "git", "clone", "--bare", "--filter=blob:none", "file://"+remote, dir.Path())
+	runCmd(t, dir.Path(), "git", "config", "--unset", "remote.origin.url")
+	require.True(t, git.IsPartialClone(dir))
+
+	hasBlob := func() bool {
+		cmd := exec.Command("git", "cat-file", "-e", blob)
+		cmd.Dir = dir.Path()
+		cmd.Env = append(os.Environ(), "GIT_NO_LAZY_FETCH=1")
+		return cmd.Run() == nil
+	}
+	require.False(t, hasBlob())
+
+	newServer := func(t *testing.T) *GRPCServer {
+		return &GRPCServer{Server: makeTestServer(ctx, t, reposDir, "file://"+remote, nil)}
+	}
+
+	t.Run("ReadDir", func(t *testing.T) {
+		ss := &testReadDirServer{testServerStream: testServerStream{ctx: ctx}}
+		err := newServer(t).ReadDir(&proto.ReadDirRequest{Repo: string(repo), CommitSha: commit}, ss)
+		require.NoError(t, err)
+		require.Len(t, ss.fileInfos, 1)
+		require.Equal(t, "f.txt", string(ss.fileInfos[0].GetName()))
+		require.Equal(t, int64(4), ss.fileInfos[0].GetSize())
+	})
+
+	t.Run("Blame", func(t *testing.T) {
+		ss := &testBlameServer{testServerStream: testServerStream{ctx: ctx}}
+		err := newServer(t).Blame(&proto.BlameRequest{Repo: string(repo), Commit: commit, Path: []byte("f.txt")}, ss)
+		require.NoError(t, err)
+		require.Len(t, ss.hunks, 1)
+		require.Equal(t, uint32(0), ss.hunks[0].GetStartByte())
+		require.Equal(t, uint32(4), ss.hunks[0].GetEndByte())
+	})
+
+	// The missing blob was fetched from the remote.
+	require.True(t, hasBlob())
+}
+
+func TestGRPCServer_MergeBaseInvalidArgument(t *testing.T) {
+	ctx := context.Background()
+	gs := &GRPCServer{Server: makeTestServer(ctx, t, t.TempDir(), "", nil)}
+
+	for _, req := range []*proto.MergeBaseRequest{
+		{Repo: "example.com/foo/bar", Base: "-x", Head: "HEAD"},
+		{Repo: "example.com/foo/bar", Base: "HEAD", Head: "--output=/tmp/x"},
+	} {
+		_, err := gs.MergeBase(ctx, req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), "got %v", err)
+	}
+}
+
+// testServerStream is a mock implementation of grpc.ServerStream for testing.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+type testReadDirServer struct {
+	testServerStream
+	fileInfos []*proto.FileInfo
+}
+
+func (s *testReadDirServer) Send(resp *proto.ReadDirResponse) error {
+	s.fileInfos = append(s.fileInfos, resp.GetFileInfo()...)
+	return nil
+}
+
+type testBlameServer struct {
+	testServerStream
+	hunks []*proto.BlameHunk
+}
+
+func (s *testBlameServer) Send(resp *proto.BlameResponse) error {
+	s.hunks = append(s.hunks, resp.GetHunk())
+	return nil
+}
@@ -28,7 +28,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/mercurial"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/perforce"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/pushmirror"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -321,6 +323,8 @@ func makeTestServer(ctx context.Context, t *testing.T, repoDir, remote string, d
 		RPSLimiter:              ratelimit.NewInstrumentedLimiter("GitserverTest", rate.NewLimiter(rate.Inf, 10)),
 		RecordingCommandFactory: wrexec.NewRecordingCommandFactory(nil, 0),
 		Perforce:                perforce.NewService(ctx, obctx, logger, db, list.New()),
+		Mercurial:               mercurial.NewService(ctx, obctx, logger, db, list.New()),
+		PushMirrors:             pushmirror.NewService(ctx, obctx, logger, db, wrexec.NewNoOpRecordingCommandFactory(), repoDir, "", list.New()),
 	}
 
 	p := s.NewClonePipeline(logtest.Scoped(t), cloneQueue)
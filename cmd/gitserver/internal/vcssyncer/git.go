@@ -1,10 +1,13 @@
 package vcssyncer
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 
 	"github.com/sourcegraph/log"
@@ -85,7 +88,116 @@ func (s *gitRepoSyncer) Clone(ctx context.Context, repo api.RepoName, remoteURL
 
 	// Now we build our fetch command. We don't actually clone, instead we init
 	// a bare repository and fetch all refs from remote once into local refs.
-	cmd, _ := s.fetchCommand(ctx, remoteURL)
+	cmd, configRemoteOpts := s.fetchCommand(ctx, remoteURL)
+
+	// Repositories that match a partial clone rule are cloned without blobs,
+	// unless they are fetched with a custom fetch command.
+	sparsePaths, partial := partialCloneConfig(repo)
+	partial = partial && configRemoteOpts
+	if partial {
+		tryWrite(s.logger, progressWriter, "Configuring partial clone\n")
+		if err := git.ConfigurePartialClone(ctx, tmpPath); err != nil {
+			return &common.GitCommandError{Err: err}
+		}
+		partialCloneFetchCmd(cmd, remoteURL)
+	}
+
+	tryWrite(s.logger, progressWriter, "Fetching remote contents\n")
+	if err := s.runCloneFetch(ctx, repo, remoteURL, tmpPath, cmd, progressWriter); err != nil {
+		return err
+	}
+
+	if partial && len(sparsePaths) > 0 {
+		tryWrite(s.logger, progressWriter, "Fetching contents of sparse paths\n")
+		if err := s.fetchSparsePaths(ctx, repo, remoteURL, common.GitDir(tmpPath), sparsePaths); err != nil {
+			return errors.Wrap(err, "failed to fetch sparse paths")
+		}
+	}
+
+	return nil
+}
+
+// cloneDeepenStep is the number of commits by which ResumableClone deepens
+// the history of the clone in every step.
+var cloneDeepenStep = 10000
+
+// ResumableClone clones a Git repository into tmpPath like Clone does, but
+// fetches the history in steps of cloneDeepenStep commits. Every completed
+// step is kept in tmpPath, so that a clone that is interrupted resumes from
+// the last completed step when ResumableClone is called again with the same
+// tmpPath.
+func (s *gitRepoSyncer) ResumableClone(ctx context.Context, repo api.RepoName, remoteURL *vcs.URL, targetDir common.GitDir, tmpPath string, progressWriter io.Writer) (err error) {
+	// A custom fetch command might not support fetching the history in steps,
+	// so we fall back to a regular clone. Partial clones don't contain blobs,
+	// so they are small enough to be cloned in one go.
+	_, partial := partialCloneConfig(repo)
+	if customFetchCmd(ctx, remoteURL) != nil || partial {
+		if err := os.RemoveAll(tmpPath); err != nil {
+			return errors.Wrap(err, "failed to remove partial clone")
+		}
+		return s.Clone(ctx, repo, remoteURL, targetDir, tmpPath, progressWriter)
+	}
+
+	if _, err := os.Stat(filepath.Join(tmpPath, "HEAD")); err == nil {
+		tryWrite(s.logger, progressWriter, "Resuming partial clone at %s\n", tmpPath)
+	} else {
+		if err := os.MkdirAll(tmpPath, os.ModePerm); err != nil {
+			return errors.Wrapf(err, "clone failed to create tmp dir")
+		}
+		tryWrite(s.logger, progressWriter, "Creating bare repo\n")
+		if err := git.MakeBareRepo(ctx, tmpPath); err != nil {
+			return &common.GitCommandError{Err: err}
+		}
+		tryWrite(s.logger, progressWriter, "Created bare repo at %s\n", tmpPath)
+	}
+
+	var lastShallow []byte
+	for {
+		hasRefs, err := hasRefs(ctx, tmpPath)
+		if err != nil {
+			return err
+		}
+		shallow, err := os.ReadFile(filepath.Join(tmpPath, "shallow"))
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "failed to read shallow file")
+		}
+		if hasRefs && len(shallow) == 0 {
+			// The complete history has been fetched.
+			break
+		}
+
+		var arg string
+		switch {
+		case !hasRefs:
+			arg = fmt.Sprintf("--depth=%d", cloneDeepenStep)
+			tryWrite(s.logger, progressWriter, "Fetching the latest %d commits\n", cloneDeepenStep)
+		case bytes.Equal(shallow, lastShallow):
+			// The last step did not deepen the history, which happens when the
+			// shallow commits are not reachable from the refs anymore. Fetch the
+			// remaining history in one go.
+			arg = "--unshallow"
+			tryWrite(s.logger, progressWriter, "Fetching the remaining history\n")
+		default:
+			arg = fmt.Sprintf("--deepen=%d", cloneDeepenStep)
+			tryWrite(s.logger, progressWriter, "Deepening history by %d commits\n", cloneDeepenStep)
+		}
+		lastShallow = shallow
+
+		cmd, _ := s.fetchCommand(ctx, remoteURL)
+		// The fetch command is always "git fetch ..." here, so we add the
+		// argument right after the subcommand.
+		cmd.Args = append(cmd.Args[:2], append([]string{arg}, cmd.Args[2:]...)...)
+		if err := s.runCloneFetch(ctx, repo, remoteURL, tmpPath, cmd, progressWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runCloneFetch runs the fetch command cmd of a clone in tmpPath, reporting
+// redacted progress logs via the progressWriter.
+func (s *gitRepoSyncer) runCloneFetch(ctx context.Context, repo api.RepoName, remoteURL *vcs.URL, tmpPath string, cmd *exec.Cmd, progressWriter io.Writer) error {
 	cmd.Dir = tmpPath
 	if cmd.Env == nil {
 		cmd.Env = os.Environ()
@@ -94,7 +206,6 @@ func (s *gitRepoSyncer) Clone(ctx context.Context, repo api.RepoName, remoteURL
 	cmd.Env = append(cmd.Env, "GIT_LFS_SKIP_SMUDGE=1")
 	executil.ConfigureRemoteGitCommand(cmd)
 
-	tryWrite(s.logger, progressWriter, "Fetching remote contents\n")
 	redactor := urlredactor.New(remoteURL)
 	wrCmd := s.recordingCommandFactory.WrapWithRepoName(ctx, s.logger, repo, cmd).WithRedactorFunc(redactor.Redact)
 	// Note: Using RunCommandWriteOutput here does NOT store the output of the
@@ -108,15 +219,40 @@ func (s *gitRepoSyncer) Clone(ctx context.Context, repo api.RepoName, remoteURL
 	return nil
 }
 
+// hasRefs returns true if the repository in dir has at least one ref.
+func hasRefs(ctx context.Context, dir string) (bool, error) {
+	cmd := exec.CommandContext(ctx, "git", "for-each-ref", "--count=1")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return false, &common.GitCommandError{Err: err, Output: string(out)}
+	}
+	return len(bytes.TrimSpace(out)) > 0, nil
+}
+
 // Fetch tries to fetch updates of a Git repository.
 func (s *gitRepoSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, repoName api.RepoName, dir common.GitDir, _ string) ([]byte, error) {
 	cmd, configRemoteOpts := s.fetchCommand(ctx, remoteURL)
+	// Partial clones stay partial until they are recloned, even if they don't
+	// match a partial clone rule anymore.
+	partial := configRemoteOpts && git.IsPartialClone(dir)
+	if partial {
+		partialCloneFetchCmd(cmd, remoteURL)
+	}
 	dir.Set(cmd)
 	r := urlredactor.New(remoteURL)
 	output, err := executil.RunRemoteGitCommand(ctx, s.recordingCommandFactory.WrapWithRepoName(ctx, log.NoOp(), repoName, cmd).WithRedactorFunc(r.Redact), configRemoteOpts)
 	if err != nil {
 		return nil, &common.GitCommandError{Err: err, Output: r.Redact(string(output))}
 	}
+
+	if partial {
+		if sparsePaths, ok := partialCloneConfig(repoName); ok && len(sparsePaths) > 0 {
+			if err := s.fetchSparsePaths(ctx, repoName, remoteURL, dir, sparsePaths); err != nil {
+				return nil, errors.Wrap(err, "failed to fetch sparse paths")
+			}
+		}
+	}
 	return output, nil
 }
 
@@ -0,0 +1,165 @@
+package vcssyncer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestGitRepoSyncer_ResumableClone(t *testing.T) {
+	orig := cloneDeepenStep
+	cloneDeepenStep = 2
+	t.Cleanup(func() { cloneDeepenStep = orig })
+
+	remote := t.TempDir()
+	runGit(t, remote, "init", "--initial-branch=master")
+	for i := 1; i <= 5; i++ {
+		runGit(t, remote, "commit", "--allow-empty", "-m", fmt.Sprintf("commit %d", i))
+	}
+	remoteURL, err := vcs.ParseURL("file://" + remote)
+	require.NoError(t, err)
+
+	s := NewGitRepoSyncer(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory())
+	ctx := context.Background()
+
+	assertFullClone := func(t *testing.T, tmpPath string) {
+		t.Helper()
+		require.Equal(t, "5", runGit(t, tmpPath, "rev-list", "--count", "master"))
+		_, err := os.Stat(filepath.Join(tmpPath, "shallow"))
+		require.True(t, os.IsNotExist(err), "expected clone to not be shallow")
+	}
+
+	t.Run("new clone", func(t *testing.T) {
+		tmpPath := filepath.Join(t.TempDir(), ".git")
+
+		var progress bytes.Buffer
+		err := s.ResumableClone(ctx, api.RepoName("repo"), remoteURL, common.GitDir("target"), tmpPath, &progress)
+		require.NoError(t, err)
+
+		assertFullClone(t, tmpPath)
+		require.Contains(t, progress.String(), "Fetching the latest 2 commits")
+		require.Contains(t, progress.String(), "Deepening history by 2 commits")
+	})
+
+	t.Run("resume partial clone", func(t *testing.T) {
+		tmpPath := filepath.Join(t.TempDir(), ".git")
+		runGit(t, "", "init", "--bare", tmpPath)
+		runGit(t, tmpPath, "fetch", "--depth=2", remoteURL.String(), "+refs/heads/*:refs/heads/*")
+
+		var progress bytes.Buffer
+		err := s.ResumableClone(ctx, api.RepoName("repo"), remoteURL, common.GitDir("target"), tmpPath, &progress)
+		require.NoError(t, err)
+
+		assertFullClone(t, tmpPath)
+		require.Contains(t, progress.String(), "Resuming partial clone")
+		require.NotContains(t, progress.String(), "Fetching the latest")
+	})
+}
+
+func TestGitRepoSyncer_PartialClone(t *testing.T) {
+	orig := partialCloneRules
+	partialCloneRules = func() []partialCloneRule {
+		return buildPartialCloneRules([]*schema.PartialCloneRule{
+			{Name: "^github\\.com/", SparsePaths: []string{"sparse"}},
+		})
+	}
+	t.Cleanup(func() { partialCloneRules = orig })
+
+	remote := t.TempDir()
+	runGit(t, remote, "init", "--initial-branch=master")
+	runGit(t, remote, "config", "uploadpack.allowFilter", "true")
+	runGit(t, remote, "config", "uploadpack.allowAnySHA1InWant", "true")
+	writeAndCommit := func(name, content string) string {
+		require.NoError(t, os.MkdirAll(filepath.Join(remote, filepath.Dir(name)), os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(remote, name), []byte(content), 0o644))
+		runGit(t, remote, "add", name)
+		runGit(t, remote, "commit", "-m", "add "+name)
+		return runGit(t, remote, "rev-parse", "HEAD:"+name)
+	}
+	sparseBlob := writeAndCommit("sparse/a.txt", "a")
+	otherBlob := writeAndCommit("other/b.txt", "b")
+
+	remoteURL, err := vcs.ParseURL("file://" + remote)
+	require.NoError(t, err)
+
+	s := NewGitRepoSyncer(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory())
+	ctx := context.Background()
+
+	// hasObject checks for an object without fetching it from the remote.
+	hasObject := func(t *testing.T, dir, oid string) bool {
+		t.Helper()
+		cmd := exec.Command("git", "cat-file", "-e", oid)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_NO_LAZY_FETCH=1")
+		return cmd.Run() == nil
+	}
+
+	tmpPath := filepath.Join(t.TempDir(), ".git")
+	var progress bytes.Buffer
+	err = s.Clone(ctx, api.RepoName("github.com/foo/bar"), remoteURL, common.GitDir("target"), tmpPath, &progress)
+	require.NoError(t, err)
+	require.Contains(t, progress.String(), "Configuring partial clone")
+
+	dir := common.GitDir(tmpPath)
+	require.True(t, git.IsPartialClone(dir))
+	require.True(t, hasObject(t, tmpPath, sparseBlob), "expected blob under sparse path to be fetched")
+	require.False(t, hasObject(t, tmpPath, otherBlob), "expected blob outside of sparse paths to be missing")
+
+	config, err := os.ReadFile(filepath.Join(tmpPath, "config"))
+	require.NoError(t, err)
+	require.NotContains(t, string(config), remote, "expected remote URL to not be persisted")
+
+	// Missing blobs are fetched lazily with the promisor remote environment.
+	cmd := exec.Command("git", "cat-file", "-p", otherBlob)
+	cmd.Dir = tmpPath
+	cmd.Env = append(os.Environ(), git.PromisorRemoteEnv(remoteURL)...)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	require.Equal(t, "b", string(out))
+
+	// Fetching keeps the clone partial and fetches new blobs under sparse paths.
+	newSparseBlob := writeAndCommit("sparse/c.txt", "c")
+	newOtherBlob := writeAndCommit("other/d.txt", "d")
+	_, err = s.Fetch(ctx, remoteURL, api.RepoName("github.com/foo/bar"), dir, "")
+	require.NoError(t, err)
+	require.Equal(t, runGit(t, remote, "rev-parse", "HEAD"), runGit(t, tmpPath, "rev-parse", "HEAD"))
+	require.True(t, hasObject(t, tmpPath, newSparseBlob), "expected new blob under sparse path to be fetched")
+	require.False(t, hasObject(t, tmpPath, newOtherBlob), "expected new blob outside of sparse paths to be missing")
+
+	t.Run("repo without rule", func(t *testing.T) {
+		tmpPath := filepath.Join(t.TempDir(), ".git")
+		err := s.Clone(ctx, api.RepoName("gitlab.com/foo/bar"), remoteURL, common.GitDir("target"), tmpPath, io.Discard)
+		require.NoError(t, err)
+		require.False(t, git.IsPartialClone(common.GitDir(tmpPath)))
+		require.True(t, hasObject(t, tmpPath, otherBlob))
+	})
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_COMMITTER_NAME=a", "GIT_COMMITTER_EMAIL=a@a.com",
+		"GIT_AUTHOR_NAME=a", "GIT_AUTHOR_EMAIL=a@a.com",
+	)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %s failed: %s", strings.Join(args, " "), out)
+	return strings.TrimSpace(string(out))
+}
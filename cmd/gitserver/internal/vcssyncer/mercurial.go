@@ -0,0 +1,145 @@
+package vcssyncer
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/urlredactor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// mercurialRepoSyncer is a syncer for Mercurial repositories. The repository
+// is converted to Git with the git-remote-hg remote helper, which keeps the
+// state of the conversion (its marks files) inside the Git directory, so that
+// subsequent fetches only convert changesets that are new since the last
+// fetch.
+//
+// git-remote-hg also records the Mercurial changeset ID of every converted
+// commit in the refs/notes/hg notes ref. The changeset mapping job of
+// gitserver reads these notes to store the changeset to commit mapping.
+type mercurialRepoSyncer struct {
+	logger                  log.Logger
+	recordingCommandFactory *wrexec.RecordingCommandFactory
+}
+
+func NewMercurialRepoSyncer(logger log.Logger, r *wrexec.RecordingCommandFactory) *mercurialRepoSyncer {
+	return &mercurialRepoSyncer{logger: logger.Scoped("MercurialRepoSyncer"), recordingCommandFactory: r}
+}
+
+func (s *mercurialRepoSyncer) Type() string {
+	return "hg"
+}
+
+// IsCloneable checks to see if the Mercurial remote URL is cloneable.
+func (s *mercurialRepoSyncer) IsCloneable(ctx context.Context, repoName api.RepoName, remoteURL *vcs.URL) error {
+	args := []string{"ls-remote", hgRemote(remoteURL), "HEAD"}
+	ctx, cancel := context.WithTimeout(ctx, executil.ShortGitCommandTimeout(args))
+	defer cancel()
+
+	r := urlredactor.New(remoteURL)
+	cmd := exec.CommandContext(ctx, "git", args...)
+	out, err := executil.RunRemoteGitCommand(ctx, s.recordingCommandFactory.WrapWithRepoName(ctx, log.NoOp(), repoName, cmd).WithRedactorFunc(r.Redact), true)
+	if err != nil {
+		if ctxerr := ctx.Err(); ctxerr != nil {
+			err = ctxerr
+		}
+		if len(out) > 0 {
+			err = &common.GitCommandError{Err: err, Output: r.Redact(string(out))}
+		}
+		return err
+	}
+	return nil
+}
+
+// Clone converts a Mercurial repository into a Git repository in tmpPath,
+// reporting redacted progress logs via the progressWriter.
+func (s *mercurialRepoSyncer) Clone(ctx context.Context, repo api.RepoName, remoteURL *vcs.URL, targetDir common.GitDir, tmpPath string, progressWriter io.Writer) (err error) {
+	// First, make sure the tmpPath exists.
+	if err := os.MkdirAll(tmpPath, os.ModePerm); err != nil {
+		return errors.Wrapf(err, "clone failed to create tmp dir")
+	}
+
+	tryWrite(s.logger, progressWriter, "Creating bare repo\n")
+	if err := git.MakeBareRepo(ctx, tmpPath); err != nil {
+		return &common.GitCommandError{Err: err}
+	}
+	tryWrite(s.logger, progressWriter, "Created bare repo at %s\n", tmpPath)
+
+	tryWrite(s.logger, progressWriter, "Converting repository using git-remote-hg\n")
+	cmd := s.fetchCommand(ctx, remoteURL)
+	cmd.Dir = tmpPath
+	executil.ConfigureRemoteGitCommand(cmd)
+
+	redactor := urlredactor.New(remoteURL)
+	wrCmd := s.recordingCommandFactory.WrapWithRepoName(ctx, s.logger, repo, cmd).WithRedactorFunc(redactor.Redact)
+	// Note: Using RunCommandWriteOutput here does NOT store the output of the
+	// command as the command output of the wrexec command, because the pipes are
+	// already used.
+	exitCode, err := executil.RunCommandWriteOutput(ctx, wrCmd, progressWriter, redactor.Redact)
+	if err != nil {
+		return errors.Wrapf(err, "failed to run hg->git conversion: exit code %d", exitCode)
+	}
+
+	// git-remote-hg imports through git fast-import, which writes packs that
+	// are far from optimal.
+	tryWrite(s.logger, progressWriter, "Repacking converted repository\n")
+	repack := exec.CommandContext(ctx, "git", "repack", "-a", "-d", "--write-bitmap-index", "--window-memory=100m")
+	repack.Dir = tmpPath
+	exitCode, err = executil.RunCommandWriteOutput(
+		ctx,
+		s.recordingCommandFactory.WrapWithRepoName(ctx, s.logger, repo, repack).WithRedactorFunc(redactor.Redact),
+		progressWriter,
+		redactor.Redact,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "failed to run git repack: exit code %d", exitCode)
+	}
+	tryWrite(s.logger, progressWriter, "Repacked converted repository!\n")
+
+	return nil
+}
+
+// Fetch converts the changesets that were added to the Mercurial repository
+// since the last fetch.
+func (s *mercurialRepoSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, repoName api.RepoName, dir common.GitDir, _ string) ([]byte, error) {
+	cmd := s.fetchCommand(ctx, remoteURL)
+	dir.Set(cmd)
+	r := urlredactor.New(remoteURL)
+	output, err := executil.RunRemoteGitCommand(ctx, s.recordingCommandFactory.WrapWithRepoName(ctx, log.NoOp(), repoName, cmd).WithRedactorFunc(r.Redact), true)
+	if err != nil {
+		return nil, &common.GitCommandError{Err: err, Output: r.Redact(string(output))}
+	}
+	return output, nil
+}
+
+// RemoteShowCommand returns the command to be executed for showing the remote
+// of a Mercurial repository.
+func (s *mercurialRepoSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error) {
+	return exec.CommandContext(ctx, "git", "remote", "show", hgRemote(remoteURL)), nil
+}
+
+func (s *mercurialRepoSyncer) fetchCommand(ctx context.Context, remoteURL *vcs.URL) *exec.Cmd {
+	// git-remote-hg keys its conversion state by the remote URL, so it is
+	// important that the same URL is used for every fetch. Otherwise, the
+	// whole repository is converted again.
+	return exec.CommandContext(ctx, "git", "fetch",
+		"--progress", "--prune", hgRemote(remoteURL),
+		// Mercurial bookmarks and branches are both converted to branches.
+		"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*")
+}
+
+// hgRemote returns the remote for the Mercurial repository at remoteURL that
+// makes git use the git-remote-hg remote helper.
+func hgRemote(remoteURL *vcs.URL) string {
+	return "hg::" + remoteURL.String()
+}
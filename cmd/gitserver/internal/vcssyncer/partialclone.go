@@ -0,0 +1,128 @@
+package vcssyncer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/grafana/regexp"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/urlredactor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type partialCloneRule struct {
+	name        *regexp.Regexp
+	sparsePaths []string
+}
+
+var partialCloneRules = conf.Cached(func() []partialCloneRule {
+	exp := conf.ExperimentalFeatures()
+	return buildPartialCloneRules(exp.PartialClones)
+})
+
+func buildPartialCloneRules(c []*schema.PartialCloneRule) []partialCloneRule {
+	rules := make([]partialCloneRule, 0, len(c))
+	for _, r := range c {
+		name, err := regexp.Compile(r.Name)
+		if err != nil {
+			log.Scoped("partialclone").Warn("ignoring partial clone rule with invalid name pattern", log.String("name", r.Name), log.Error(err))
+			continue
+		}
+		rules = append(rules, partialCloneRule{name: name, sparsePaths: r.SparsePaths})
+	}
+	return rules
+}
+
+// partialCloneConfig returns true if repo should be stored as a partial clone,
+// together with the sparse paths whose contents are always stored. The first
+// rule that matches the name of repo is used.
+func partialCloneConfig(repo api.RepoName) (sparsePaths []string, ok bool) {
+	for _, r := range partialCloneRules() {
+		if r.name.MatchString(string(repo)) {
+			return r.sparsePaths, true
+		}
+	}
+	return nil, false
+}
+
+// partialCloneFetchCmd changes the fetch command cmd to fetch commits and trees
+// from the promisor remote of a partial clone of remoteURL. The fetch command
+// must be "git fetch ..." with the remote URL as an argument.
+func partialCloneFetchCmd(cmd *exec.Cmd, remoteURL *vcs.URL) {
+	for i, arg := range cmd.Args {
+		if arg == remoteURL.String() {
+			cmd.Args[i] = git.PromisorRemote
+		}
+	}
+	cmd.Args = append(cmd.Args[:2], append([]string{"--filter=" + git.PartialCloneFilter}, cmd.Args[2:]...)...)
+
+	setPromisorRemoteEnv(cmd, remoteURL)
+}
+
+// setPromisorRemoteEnv points the promisor remote of the partial clone cmd runs
+// in at remoteURL. cmd keeps the environment of gitserver, which git needs to
+// authenticate with the remote.
+func setPromisorRemoteEnv(cmd *exec.Cmd, remoteURL *vcs.URL) {
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env, git.PromisorRemoteEnv(remoteURL)...)
+}
+
+// fetchSparsePaths fetches the contents of the files under sparsePaths at HEAD
+// into the partial clone in dir, so that they are stored instead of being
+// fetched when they are first read.
+func (s *gitRepoSyncer) fetchSparsePaths(ctx context.Context, repo api.RepoName, remoteURL *vcs.URL, dir common.GitDir, sparsePaths []string) error {
+	// An empty repository has nothing to fetch.
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--verify", "--quiet", "HEAD^{tree}")
+	dir.Set(cmd)
+	if err := cmd.Run(); err != nil {
+		return nil
+	}
+
+	cmd = exec.CommandContext(ctx, "git", append([]string{"ls-tree", "-r", "HEAD", "--"}, sparsePaths...)...)
+	dir.Set(cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return &common.GitCommandError{Err: err, Output: string(out)}
+	}
+
+	// Every line has the format "<mode> SP <type> SP <object> TAB <file>".
+	var oids bytes.Buffer
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) >= 3 && fields[1] == "blob" {
+			oids.WriteString(fields[2])
+			oids.WriteByte('\n')
+		}
+	}
+	if oids.Len() == 0 {
+		return nil
+	}
+
+	// Objects that are requested explicitly are fetched despite the filter.
+	cmd = exec.CommandContext(ctx, "git", "fetch", "--no-tags", "--no-write-fetch-head", "--recurse-submodules=no",
+		"--filter="+git.PartialCloneFilter, "--stdin", git.PromisorRemote)
+	dir.Set(cmd)
+	setPromisorRemoteEnv(cmd, remoteURL)
+	cmd.Stdin = &oids
+
+	r := urlredactor.New(remoteURL)
+	output, err := executil.RunRemoteGitCommand(ctx, s.recordingCommandFactory.WrapWithRepoName(ctx, log.NoOp(), repo, cmd).WithRedactorFunc(r.Redact), true)
+	if err != nil {
+		return &common.GitCommandError{Err: err, Output: r.Redact(string(output))}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package vcssyncer
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/grafana/regexp"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/urlredactor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// PushMirror is a secondary remote that repositories are pushed to after
+// every successful update.
+type PushMirror struct {
+	// Name is the name of the push mirror in the site configuration.
+	Name string
+	// URL is the URL of the repository on the remote or the path of a local
+	// bare repository. It can contain credentials.
+	URL string
+}
+
+type pushMirrorRule struct {
+	name  string
+	url   string
+	repos *regexp.Regexp
+}
+
+var pushMirrorRules = conf.Cached(func() []pushMirrorRule {
+	exp := conf.ExperimentalFeatures()
+	return buildPushMirrorRules(exp.PushMirrors)
+})
+
+func buildPushMirrorRules(c []*schema.PushMirror) []pushMirrorRule {
+	rules := make([]pushMirrorRule, 0, len(c))
+	for _, m := range c {
+		rule := pushMirrorRule{name: m.Name, url: m.Url}
+		if m.Repos != "" {
+			repos, err := regexp.Compile(m.Repos)
+			if err != nil {
+				log.Scoped("pushmirror").Warn("ignoring push mirror with invalid repos pattern", log.String("name", m.Name), log.Error(err))
+				continue
+			}
+			rule.repos = repos
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// HasPushMirrors returns true if at least one push mirror is configured.
+func HasPushMirrors() bool {
+	return len(pushMirrorRules()) > 0
+}
+
+// PushMirrors returns the push mirrors that repo is pushed to.
+func PushMirrors(repo api.RepoName) []PushMirror {
+	var mirrors []PushMirror
+	for _, r := range pushMirrorRules() {
+		if r.repos != nil && !r.repos.MatchString(string(repo)) {
+			continue
+		}
+		mirrors = append(mirrors, PushMirror{
+			Name: r.name,
+			URL:  strings.ReplaceAll(r.url, "{repo}", string(repo)),
+		})
+	}
+	return mirrors
+}
+
+// errInvalidPushMirrorURL is returned instead of the parse error, which can
+// contain the credentials in the URL.
+var errInvalidPushMirrorURL = errors.New("invalid push mirror URL")
+
+// Push pushes the branches and tags of the repository in dir to the push
+// mirror. Refs that were deleted from the repository are deleted from the
+// push mirror, and refs that were rewritten are force pushed, so that the push
+// mirror is an exact copy.
+func (m PushMirror) Push(ctx context.Context, rcf *wrexec.RecordingCommandFactory, repo api.RepoName, dir common.GitDir) error {
+	remoteURL, err := vcs.ParseURL(m.URL)
+	if err != nil {
+		return errInvalidPushMirrorURL
+	}
+
+	if remoteURL.Scheme == "file" {
+		if err := ensureBareRepo(ctx, remoteURL.Path); err != nil {
+			return err
+		}
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "push", "--porcelain", "--force", "--prune", remoteURL.String(),
+		"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*")
+	dir.Set(cmd)
+
+	r := urlredactor.New(remoteURL)
+	output, err := executil.RunRemoteGitCommand(ctx, rcf.WrapWithRepoName(ctx, log.NoOp(), repo, cmd).WithRedactorFunc(r.Redact), true)
+	if err != nil {
+		return &common.GitCommandError{Err: err, Output: r.Redact(string(output))}
+	}
+	return nil
+}
+
+// ensureBareRepo creates a bare repository at path if it doesn't exist, so
+// that push mirrors on the local filesystem don't have to be created upfront.
+func ensureBareRepo(ctx context.Context, path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := os.MkdirAll(path, os.ModePerm); err != nil {
+		return err
+	}
+	return git.MakeBareRepo(ctx, path)
+}
@@ -0,0 +1,60 @@
+package vcssyncer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestPushMirrors(t *testing.T) {
+	orig := pushMirrorRules
+	t.Cleanup(func() { pushMirrorRules = orig })
+	pushMirrorRules = func() []pushMirrorRule {
+		return buildPushMirrorRules([]*schema.PushMirror{
+			{Name: "dr", Url: "https://git.example.com/{repo}.git"},
+			{Name: "airgap", Url: "/mnt/mirror/{repo}", Repos: "^github\\.com/sourcegraph/"},
+			{Name: "invalid", Url: "/mnt/invalid/{repo}", Repos: "("},
+		})
+	}
+
+	require.Equal(t, []PushMirror{
+		{Name: "dr", URL: "https://git.example.com/github.com/sourcegraph/sourcegraph.git"},
+		{Name: "airgap", URL: "/mnt/mirror/github.com/sourcegraph/sourcegraph"},
+	}, PushMirrors("github.com/sourcegraph/sourcegraph"))
+
+	require.Equal(t, []PushMirror{
+		{Name: "dr", URL: "https://git.example.com/github.com/golang/go.git"},
+	}, PushMirrors("github.com/golang/go"))
+}
+
+func TestPushMirror_Push(t *testing.T) {
+	src := t.TempDir()
+	runGit(t, src, "init", "--initial-branch=master")
+	runGit(t, src, "commit", "--allow-empty", "-m", "c1")
+	runGit(t, src, "tag", "v1")
+	runGit(t, src, "branch", "feature")
+	dir := common.GitDir(filepath.Join(src, ".git"))
+
+	// The bare repository is created on the first push.
+	mirror := filepath.Join(t.TempDir(), "github.com", "foo", "bar")
+	m := PushMirror{Name: "local", URL: mirror}
+	rcf := wrexec.NewNoOpRecordingCommandFactory()
+	ctx := context.Background()
+
+	require.NoError(t, m.Push(ctx, rcf, api.RepoName("github.com/foo/bar"), dir))
+	require.Equal(t, "true", runGit(t, mirror, "rev-parse", "--is-bare-repository"))
+	require.Equal(t, runGit(t, src, "show-ref"), runGit(t, mirror, "show-ref"))
+
+	// Rewritten and deleted refs are mirrored too.
+	runGit(t, src, "commit", "--amend", "--allow-empty", "-m", "c1'")
+	runGit(t, src, "branch", "-D", "feature")
+	require.NoError(t, m.Push(ctx, rcf, api.RepoName("github.com/foo/bar"), dir))
+	require.Equal(t, runGit(t, src, "show-ref"), runGit(t, mirror, "show-ref"))
+}
@@ -66,6 +66,16 @@ type VCSSyncer interface {
 	RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error)
 }
 
+// ResumableCloner is implemented by VCSSyncers that can resume a clone that was
+// interrupted.
+type ResumableCloner interface {
+	// ResumableClone behaves like VCSSyncer.Clone, except that tmpPath may
+	// contain a partial clone left behind by an earlier attempt, which is
+	// resumed instead of starting from scratch. The progress of the clone is
+	// kept in tmpPath when it fails, so that the next attempt can resume it.
+	ResumableClone(ctx context.Context, repo api.RepoName, remoteURL *vcs.URL, targetDir common.GitDir, tmpPath string, progressWriter io.Writer) error
+}
+
 type NewVCSSyncerOpts struct {
 	ExternalServiceStore    database.ExternalServiceStore
 	RepoStore               database.RepoStore
@@ -179,6 +189,14 @@ func NewVCSSyncer(ctx context.Context, opts *NewVCSSyncerOpts) (VCSSyncer, error
 			return nil, err
 		}
 		return NewRubyPackagesSyncer(&c, opts.DepsSvc, cli, opts.ReposDir), nil
+	case extsvc.TypeOther:
+		var c schema.OtherExternalServiceConnection
+		if _, err := extractOptions(&c); err != nil {
+			return nil, err
+		}
+		if c.Vcs == "hg" {
+			return NewMercurialRepoSyncer(opts.Logger, opts.RecordingCommandFactory), nil
+		}
 	}
 
 	return NewGitRepoSyncer(opts.Logger, opts.RecordingCommandFactory), nil
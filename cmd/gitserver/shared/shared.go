@@ -20,7 +20,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/accesslog"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/cloneurl"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/mercurial"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/perforce"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/pushmirror"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -106,6 +108,8 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		CloneQueue:              cloneQueue,
 		GlobalBatchLogSemaphore: semaphore.NewWeighted(int64(config.BatchLogGlobalConcurrencyLimit)),
 		Perforce:                perforce.NewService(ctx, observationCtx, logger, db, list.New()),
+		Mercurial:               mercurial.NewService(ctx, observationCtx, logger, db, list.New()),
+		PushMirrors:             pushmirror.NewService(ctx, observationCtx, logger, db, recordingCommandFactory, config.ReposDir, config.ExternalAddress, list.New()),
 		RecordingCommandFactory: recordingCommandFactory,
 		Locker:                  locker,
 		RPSLimiter: ratelimit.NewInstrumentedLimiter(
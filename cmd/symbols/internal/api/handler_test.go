@@ -46,11 +46,13 @@ func TestHandler(t *testing.T) {
 					Name: "x",
 					Path: "a.js",
 					Line: 1, // ctags line numbers are 1-based
+					Kind: "variable",
 				},
 				{
 					Name: "y",
 					Path: "a.js",
 					Line: 2,
+					Kind: "function",
 				},
 			},
 		}
@@ -84,8 +86,8 @@ func TestHandler(t *testing.T) {
 		HTTPClient:          httpcli.InternalDoer,
 	}
 
-	x := result.Symbol{Name: "x", Path: "a.js", Line: 0, Character: 4}
-	y := result.Symbol{Name: "y", Path: "a.js", Line: 1, Character: 4}
+	x := result.Symbol{Name: "x", Path: "a.js", Line: 0, Character: 4, Kind: "variable"}
+	y := result.Symbol{Name: "y", Path: "a.js", Line: 1, Character: 4, Kind: "function"}
 
 	testCases := map[string]struct {
 		args     search.SymbolsParameters
@@ -131,6 +133,18 @@ func TestHandler(t *testing.T) {
 			args:     search.SymbolsParameters{ExcludePattern: "a.js", IsCaseSensitive: true, First: 10},
 			expected: nil,
 		},
+		"includekind": {
+			args:     search.SymbolsParameters{Kinds: result.SymbolKindFilter{Include: []string{"function"}}, First: 1},
+			expected: []result.Symbol{y},
+		},
+		"excludekind": {
+			args:     search.SymbolsParameters{Kinds: result.SymbolKindFilter{Exclude: []string{"variable"}}, First: 10},
+			expected: []result.Symbol{y},
+		},
+		"unknownkind": {
+			args:     search.SymbolsParameters{Kinds: result.SymbolKindFilter{Include: []string{"unknown"}}, First: 10},
+			expected: nil,
+		},
 	}
 
 	for label, testCase := range testCases {
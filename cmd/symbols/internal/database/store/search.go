@@ -74,6 +74,12 @@ func makeSearchConditions(args search.SymbolsParameters) []*sqlf.Query {
 	for _, includePattern := range args.IncludePatterns {
 		conditions = append(conditions, makeSearchCondition("path", includePattern, args.IsCaseSensitive))
 	}
+	if len(args.Kinds.Include) > 0 {
+		conditions = append(conditions, makeKindCondition(args.Kinds.Include))
+	}
+	if len(args.Kinds.Exclude) > 0 {
+		conditions = append(conditions, negate(makeKindCondition(args.Kinds.Exclude)))
+	}
 
 	filtered := conditions[:0]
 	for _, condition := range conditions {
@@ -90,6 +96,21 @@ func makeSearchConditions(args search.SymbolsParameters) []*sqlf.Query {
 	return filtered
 }
 
+// makeKindCondition returns a condition matching the symbols with one of the given
+// selector kinds (cf. symbol.kind:).
+func makeKindCondition(selectKinds []string) *sqlf.Query {
+	kinds := result.KindsForSelectKinds(selectKinds)
+	if len(kinds) == 0 {
+		return sqlf.Sprintf("FALSE")
+	}
+
+	values := make([]*sqlf.Query, 0, len(kinds))
+	for _, kind := range kinds {
+		values = append(values, sqlf.Sprintf("%s", kind))
+	}
+	return sqlf.Sprintf("lower(kind) IN (%s)", sqlf.Join(values, ", "))
+}
+
 func makeSearchCondition(column string, regex string, isCaseSensitive bool) *sqlf.Query {
 	if regex == "" {
 		return nil
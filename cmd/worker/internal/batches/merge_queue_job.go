@@ -0,0 +1,51 @@
+package batches
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/batches/mergequeue"
+	"github.com/sourcegraph/sourcegraph/internal/batches/sources"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type mergeQueueJob struct{}
+
+func NewMergeQueueJob() job.Job {
+	return &mergeQueueJob{}
+}
+
+func (j *mergeQueueJob) Description() string {
+	return ""
+}
+
+func (j *mergeQueueJob) Config() []env.Config {
+	return []env.Config{}
+}
+
+func (j *mergeQueueJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	observationCtx = observation.NewContext(observationCtx.Logger.Scoped("routines"))
+	workCtx := actor.WithInternalActor(context.Background())
+
+	bstore, err := InitStore()
+	if err != nil {
+		return nil, err
+	}
+
+	routines := []goroutine.BackgroundRoutine{
+		mergequeue.NewProcessor(
+			workCtx,
+			observationCtx,
+			bstore,
+			sources.NewSourcer(httpcli.NewExternalClientFactory(
+				httpcli.NewLoggingMiddleware(observationCtx.Logger.Scoped("sourcer")),
+			)),
+		),
+	}
+
+	return routines, nil
+}
@@ -208,10 +208,23 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, record *bgrepo.
 
 	indexName := string(embeddings.GetRepoEmbeddingIndexName(repo.ID))
 	if stats.IsIncremental {
-		return embeddings.UpdateRepoEmbeddingIndex(ctx, h.uploadStore, indexName, previousIndex, repoEmbeddingIndex, toRemove, ranks)
+		err = embeddings.UpdateRepoEmbeddingIndex(ctx, h.uploadStore, indexName, previousIndex, repoEmbeddingIndex, toRemove, ranks)
+		// The previous index was updated in place.
+		repoEmbeddingIndex = previousIndex
 	} else {
-		return embeddings.UploadRepoEmbeddingIndex(ctx, h.uploadStore, indexName, repoEmbeddingIndex)
+		err = embeddings.UploadRepoEmbeddingIndex(ctx, h.uploadStore, indexName, repoEmbeddingIndex)
 	}
+	if err != nil {
+		return err
+	}
+
+	// The IVF index is only used to speed up searches, which fall back to an
+	// exhaustive search without it. Failing to upload it does not fail the job.
+	if err := embeddings.UploadRepoEmbeddingIVFIndex(ctx, h.uploadStore, repo.ID, embeddings.BuildRepoEmbeddingIVFIndex(repoEmbeddingIndex)); err != nil {
+		logger.Error("failed to upload IVF index", log.Error(err))
+	}
+
+	return nil
 }
 
 func getFileFilterPathPatterns(embeddingsConfig *conftypes.EmbeddingsConfig) (includedFiles, excludedFiles []*paths.GlobPattern) {
@@ -9,4 +9,5 @@ import (
 
 func initPrometheusMetric[T workerutil.Record](observationCtx *observation.Context, queueName string, store store.Store[T]) {
 	dbworker.InitPrometheusMetric(observationCtx, store, "", "executor", map[string]string{"queue": queueName})
+	dbworker.InitTenantPrometheusMetric(observationCtx, store, "", "executor", map[string]string{"queue": queueName})
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
 	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
 	"github.com/sourcegraph/sourcegraph/internal/env"
+	executorstore "github.com/sourcegraph/sourcegraph/internal/executor/store"
 	executortypes "github.com/sourcegraph/sourcegraph/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -33,6 +34,7 @@ func (j *janitorJob) Routines(_ context.Context, observationCtx *observation.Con
 	}
 
 	dequeueCache := rcache.New(executortypes.DequeueCachePrefix)
+	jobLogStore := executorstore.NewJobLogStore(db)
 
 	routines := []goroutine.BackgroundRoutine{
 		goroutine.NewPeriodicGoroutine(
@@ -44,6 +46,16 @@ func (j *janitorJob) Routines(_ context.Context, observationCtx *observation.Con
 			goroutine.WithDescription("clean up executor heartbeat records for presumed dead executors"),
 			goroutine.WithInterval(janitorConfigInst.CleanupTaskInterval),
 		),
+		goroutine.NewPeriodicGoroutine(
+			context.Background(),
+			goroutine.HandlerFunc(func(ctx context.Context) error {
+				_, err := jobLogStore.DeleteOlderThan(ctx, janitorConfigInst.JobLogChunksMaxAge)
+				return err
+			}),
+			goroutine.WithName("executor.job-log-chunks-janitor"),
+			goroutine.WithDescription("clean up log chunks streamed by executor jobs"),
+			goroutine.WithInterval(janitorConfigInst.CleanupTaskInterval),
+		),
 		NewMultiqueueCacheCleaner(executortypes.ValidQueueNames, dequeueCache, janitorConfigInst.CacheDequeueTtl, janitorConfigInst.CacheCleanupInterval),
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
 	workerinsightsdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/codeinsights"
+	"github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/codeintel"
 	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
@@ -40,7 +41,12 @@ func (s *insightsJob) Routines(_ context.Context, observationCtx *observation.Co
 		return nil, err
 	}
 
-	return background.GetBackgroundJobs(context.Background(), observationCtx.Logger, db, insightsDB), nil
+	codeIntelServices, err := codeintel.InitServices(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return background.GetBackgroundJobs(context.Background(), observationCtx.Logger, db, insightsDB, codeIntelServices.CodenavService), nil
 }
 
 func NewInsightsJob() job.Job {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
 	workerinsightsdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/codeinsights"
+	"github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/codeintel"
 	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
@@ -42,7 +43,12 @@ func (s *insightsQueryRunnerJob) Routines(_ context.Context, observationCtx *obs
 		return nil, err
 	}
 
-	return background.GetBackgroundQueryRunnerJob(context.Background(), observationCtx.Logger, db, insightsDB), nil
+	codeIntelServices, err := codeintel.InitServices(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return background.GetBackgroundQueryRunnerJob(context.Background(), observationCtx.Logger, db, insightsDB, codeIntelServices.CodenavService), nil
 }
 
 func NewInsightsQueryRunnerJob() job.Job {
@@ -89,6 +89,8 @@ func LoadConfig(registerEnterpriseMigrators oobmigration.RegisterMigratorsFunc)
 		"batches-janitor":                       batches.NewJanitorJob(),
 		"batches-scheduler":                     batches.NewSchedulerJob(),
 		"batches-reconciler":                    batches.NewReconcilerJob(),
+		"batches-rebaser":                       batches.NewRebaserJob(),
+		"batches-merge-queue":                   batches.NewMergeQueueJob(),
 		"batches-bulk-processor":                batches.NewBulkOperationProcessorJob(),
 		"batches-workspace-resolver":            batches.NewWorkspaceResolverJob(),
 		"executors-janitor":                     executors.NewJanitorJob(),
@@ -0,0 +1,119 @@
+package mergequeue
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ListEntries returns the merge queue entries of the open changesets of the
+// given batch change that are in repositories the actor in ctx can access,
+// ordered by changeset ID.
+func ListEntries(ctx context.Context, s *store.Store, batchChangeID int64) ([]*btypes.MergeQueueEntry, error) {
+	published := btypes.ChangesetPublicationStatePublished
+	cs, _, err := s.ListChangesets(ctx, store.ListChangesetsOpts{
+		BatchChangeID:    batchChangeID,
+		PublicationState: &published,
+		ExternalStates:   []btypes.ChangesetExternalState{btypes.ChangesetExternalStateOpen, btypes.ChangesetExternalStateDraft},
+		EnforceAuthz:     true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing changesets")
+	}
+
+	var specIDs []int64
+	for _, ch := range cs {
+		if ch.CurrentSpecID != 0 {
+			specIDs = append(specIDs, ch.CurrentSpecID)
+		}
+	}
+	specsByID := make(map[int64]*btypes.ChangesetSpec, len(specIDs))
+	if len(specIDs) > 0 {
+		specs, _, err := s.ListChangesetSpecs(ctx, store.ListChangesetSpecsOpts{IDs: specIDs})
+		if err != nil {
+			return nil, errors.Wrap(err, "listing changeset specs")
+		}
+		for _, spec := range specs {
+			specsByID[spec.ID] = spec
+		}
+	}
+
+	mergingIDs, err := s.ListBatchChangeMergingChangesetIDs(ctx, batchChangeID)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing merging changesets")
+	}
+	merging := make(map[int64]bool, len(mergingIDs))
+	for _, id := range mergingIDs {
+		merging[id] = true
+	}
+
+	entries := make([]*btypes.MergeQueueEntry, 0, len(cs))
+	for _, ch := range cs {
+		entries = append(entries, Evaluate(ch, specsByID[ch.CurrentSpecID], merging[ch.ID]))
+	}
+	return entries, nil
+}
+
+// Evaluate returns the merge queue entry of the given open changeset. spec is
+// the current spec of the changeset, or nil if it is imported. merging is true
+// if a merge job has already been created for the changeset.
+func Evaluate(ch *btypes.Changeset, spec *btypes.ChangesetSpec, merging bool) *btypes.MergeQueueEntry {
+	entry := &btypes.MergeQueueEntry{Changeset: ch}
+	if merging {
+		entry.State = btypes.MergeQueueEntryStateMerging
+		return entry
+	}
+
+	entry.State = btypes.MergeQueueEntryStateBlocked
+	if reason := blockedReason(ch, spec); reason != "" {
+		entry.BlockedReason = reason
+		return entry
+	}
+
+	entry.State = btypes.MergeQueueEntryStateReady
+	return entry
+}
+
+// blockedReason returns why the changeset cannot be merged yet, or an empty
+// string if it can be merged.
+func blockedReason(ch *btypes.Changeset, spec *btypes.ChangesetSpec) string {
+	switch ch.State {
+	case btypes.ChangesetStateOpen:
+	case btypes.ChangesetStateDraft:
+		return "The changeset is a draft."
+	case btypes.ChangesetStateConflicting:
+		return "The changeset has conflicts with its base branch."
+	case btypes.ChangesetStateFailed:
+		return "Publishing the changeset failed."
+	default:
+		return "The changeset is being processed."
+	}
+
+	if spec != nil && spec.DependsOn != "" {
+		if baseRef, err := ch.BaseRef(); err != nil || baseRef == spec.DependsOn {
+			return "The changeset it depends on has not been merged yet."
+		}
+	}
+
+	switch ch.ExternalCheckState {
+	case btypes.ChangesetCheckStatePassed:
+	case btypes.ChangesetCheckStateFailed:
+		return "Checks are failing."
+	case btypes.ChangesetCheckStatePending:
+		return "Checks are pending."
+	default:
+		return "No checks have passed yet."
+	}
+
+	switch ch.ExternalReviewState {
+	case btypes.ChangesetReviewStateApproved:
+	case btypes.ChangesetReviewStateChangesRequested:
+		return "Changes have been requested."
+	default:
+		return "The changeset has not been approved yet."
+	}
+
+	return ""
+}
@@ -0,0 +1,109 @@
+package mergequeue
+
+import (
+	"testing"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	changeset := func(state btypes.ChangesetState, checks btypes.ChangesetCheckState, review btypes.ChangesetReviewState, baseRef string) *btypes.Changeset {
+		return &btypes.Changeset{
+			State:               state,
+			ExternalCheckState:  checks,
+			ExternalReviewState: review,
+			Metadata:            &github.PullRequest{BaseRefName: baseRef},
+		}
+	}
+	ready := func() *btypes.Changeset {
+		return changeset(btypes.ChangesetStateOpen, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateApproved, "main")
+	}
+	stacked := &btypes.ChangesetSpec{DependsOn: "refs/heads/dependency"}
+
+	tcs := []struct {
+		name      string
+		changeset *btypes.Changeset
+		spec      *btypes.ChangesetSpec
+		merging   bool
+		wantState btypes.MergeQueueEntryState
+		blocked   bool
+	}{
+		{name: "ready", changeset: ready(), wantState: btypes.MergeQueueEntryStateReady},
+		{name: "ready with spec", changeset: ready(), spec: &btypes.ChangesetSpec{}, wantState: btypes.MergeQueueEntryStateReady},
+		{name: "merging", changeset: ready(), merging: true, wantState: btypes.MergeQueueEntryStateMerging},
+		{
+			name:      "draft",
+			changeset: changeset(btypes.ChangesetStateDraft, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateApproved, "main"),
+			wantState: btypes.MergeQueueEntryStateBlocked,
+			blocked:   true,
+		},
+		{
+			name:      "conflicting",
+			changeset: changeset(btypes.ChangesetStateConflicting, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateApproved, "main"),
+			wantState: btypes.MergeQueueEntryStateBlocked,
+			blocked:   true,
+		},
+		{
+			name:      "processing",
+			changeset: changeset(btypes.ChangesetStateProcessing, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateApproved, "main"),
+			wantState: btypes.MergeQueueEntryStateBlocked,
+			blocked:   true,
+		},
+		{
+			name:      "checks failing",
+			changeset: changeset(btypes.ChangesetStateOpen, btypes.ChangesetCheckStateFailed, btypes.ChangesetReviewStateApproved, "main"),
+			wantState: btypes.MergeQueueEntryStateBlocked,
+			blocked:   true,
+		},
+		{
+			name:      "checks pending",
+			changeset: changeset(btypes.ChangesetStateOpen, btypes.ChangesetCheckStatePending, btypes.ChangesetReviewStateApproved, "main"),
+			wantState: btypes.MergeQueueEntryStateBlocked,
+			blocked:   true,
+		},
+		{
+			name:      "no checks",
+			changeset: changeset(btypes.ChangesetStateOpen, btypes.ChangesetCheckStateUnknown, btypes.ChangesetReviewStateApproved, "main"),
+			wantState: btypes.MergeQueueEntryStateBlocked,
+			blocked:   true,
+		},
+		{
+			name:      "changes requested",
+			changeset: changeset(btypes.ChangesetStateOpen, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateChangesRequested, "main"),
+			wantState: btypes.MergeQueueEntryStateBlocked,
+			blocked:   true,
+		},
+		{
+			name:      "review pending",
+			changeset: changeset(btypes.ChangesetStateOpen, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStatePending, "main"),
+			wantState: btypes.MergeQueueEntryStateBlocked,
+			blocked:   true,
+		},
+		{
+			name:      "stacked on unmerged dependency",
+			changeset: changeset(btypes.ChangesetStateOpen, btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateApproved, "dependency"),
+			spec:      stacked,
+			wantState: btypes.MergeQueueEntryStateBlocked,
+			blocked:   true,
+		},
+		{name: "stacked on merged dependency", changeset: ready(), spec: stacked, wantState: btypes.MergeQueueEntryStateReady},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			entry := Evaluate(tc.changeset, tc.spec, tc.merging)
+			if entry.Changeset != tc.changeset {
+				t.Fatal("entry has wrong changeset")
+			}
+			if entry.State != tc.wantState {
+				t.Fatalf("wrong state, want=%s have=%s", tc.wantState, entry.State)
+			}
+			if have := entry.BlockedReason != ""; have != tc.blocked {
+				t.Fatalf("wrong blocked reason %q", entry.BlockedReason)
+			}
+		})
+	}
+}
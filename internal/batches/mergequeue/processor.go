@@ -0,0 +1,183 @@
+package mergequeue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/batches/sources"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// processInterval is how often the merge queues are processed.
+const processInterval = 2 * time.Minute
+
+// Processor merges the changesets in the merge queues of batch changes. For
+// each merge queue, it creates merge jobs for the changesets that are ready to
+// be merged, as long as fewer than the configured maximum number of changesets
+// have been merged within the window. The merge jobs are then run by the bulk
+// operation processor.
+//
+// Before any changeset is merged, the checks on the base branches of the ready
+// changesets are checked on code hosts that support it. If they are failing,
+// the merge queue is paused until they pass again.
+type Processor struct {
+	store   *store.Store
+	sourcer sources.Sourcer
+	logger  log.Logger
+}
+
+// NewProcessor creates a new goroutine.PeriodicGoroutine that processes the
+// merge queues of batch changes.
+func NewProcessor(ctx context.Context, observationCtx *observation.Context, s *store.Store, sourcer sources.Sourcer) goroutine.BackgroundRoutine {
+	p := &Processor{
+		store:   s,
+		sourcer: sourcer,
+		logger:  observationCtx.Logger.Scoped("mergequeue"),
+	}
+
+	return goroutine.NewPeriodicGoroutine(
+		ctx,
+		p,
+		goroutine.WithName("batchchanges.merge-queue"),
+		goroutine.WithDescription("merges the changesets in the merge queues of batch changes"),
+		goroutine.WithInterval(processInterval),
+	)
+}
+
+// Handle processes all merge queues of batch changes that are not closed.
+func (p *Processor) Handle(ctx context.Context) error {
+	queues, err := p.store.ListBatchChangeMergeQueues(ctx)
+	if err != nil {
+		return errors.Wrap(err, "listing merge queues")
+	}
+
+	var errs error
+	for _, queue := range queues {
+		if err := p.process(ctx, queue); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "processing merge queue of batch change %d", queue.BatchChangeID))
+		}
+	}
+	return errs
+}
+
+func (p *Processor) process(ctx context.Context, queue *btypes.BatchChangeMergeQueue) error {
+	// Act as the user that enabled the merge queue, so that only changesets in
+	// repositories they have access to are merged.
+	ctx = actor.WithActor(ctx, actor.FromUser(queue.UserID))
+
+	merges, err := p.store.CountBatchChangeMerges(ctx, queue.BatchChangeID, p.store.Clock()().Add(-queue.Window))
+	if err != nil {
+		return errors.Wrap(err, "counting merges")
+	}
+	allowed := int(queue.MaxMerges) - merges
+	if allowed <= 0 {
+		return nil
+	}
+
+	entries, err := ListEntries(ctx, p.store, queue.BatchChangeID)
+	if err != nil {
+		return err
+	}
+	var ready []*btypes.Changeset
+	for _, entry := range entries {
+		if entry.State == btypes.MergeQueueEntryStateReady {
+			ready = append(ready, entry.Changeset)
+		}
+	}
+	if len(ready) == 0 {
+		return nil
+	}
+
+	reason, err := p.failingBaseBranch(ctx, queue, ready)
+	if err != nil {
+		return err
+	}
+	if reason != queue.PausedReason {
+		queue.PausedReason = reason
+		if err := p.store.UpdateBatchChangeMergeQueuePausedReason(ctx, queue); err != nil {
+			return errors.Wrap(err, "updating paused reason")
+		}
+	}
+	if queue.Paused() {
+		return nil
+	}
+
+	if len(ready) > allowed {
+		ready = ready[:allowed]
+	}
+	bulkGroup, err := store.RandomID()
+	if err != nil {
+		return err
+	}
+	jobs := make([]*btypes.ChangesetJob, 0, len(ready))
+	for _, ch := range ready {
+		jobs = append(jobs, &btypes.ChangesetJob{
+			BulkGroup:     bulkGroup,
+			ChangesetID:   ch.ID,
+			BatchChangeID: queue.BatchChangeID,
+			UserID:        queue.UserID,
+			State:         btypes.ChangesetJobStateQueued,
+			JobType:       btypes.ChangesetJobTypeMerge,
+			Payload:       &btypes.ChangesetJobMergePayload{Squash: queue.Squash},
+		})
+	}
+	p.logger.Info("merging changesets from merge queue",
+		log.Int64("batchChange", queue.BatchChangeID),
+		log.Int("count", len(jobs)))
+	return p.store.CreateChangesetJob(ctx, jobs...)
+}
+
+// failingBaseBranch returns why the merge queue should be paused, if the checks
+// on the base branch of any of the given changesets are failing. Code hosts
+// that cannot report the checks on a branch never pause the merge queue.
+func (p *Processor) failingBaseBranch(ctx context.Context, queue *btypes.BatchChangeMergeQueue, cs []*btypes.Changeset) (string, error) {
+	type branch struct {
+		repoID api.RepoID
+		ref    string
+	}
+	seen := map[branch]bool{}
+
+	for _, ch := range cs {
+		baseRef, err := ch.BaseRef()
+		if err != nil {
+			return "", errors.Wrapf(err, "getting base ref of changeset %d", ch.ID)
+		}
+		b := branch{repoID: ch.RepoID, ref: baseRef}
+		if seen[b] {
+			continue
+		}
+		seen[b] = true
+
+		repo, err := p.store.Repos().Get(ctx, ch.RepoID)
+		if err != nil {
+			return "", errors.Wrap(err, "loading repo")
+		}
+		css, err := p.sourcer.ForUser(ctx, p.store, queue.UserID, repo)
+		if err != nil {
+			return "", errors.Wrap(err, "loading changeset source")
+		}
+		bcs, ok := css.(sources.BranchChecksChangesetSource)
+		if !ok {
+			continue
+		}
+		state, err := bcs.BranchCheckState(ctx, repo, baseRef)
+		if err != nil {
+			return "", errors.Wrapf(err, "loading checks of %s in %s", baseRef, repo.Name)
+		}
+		if state == btypes.ChangesetCheckStateFailed {
+			return fmt.Sprintf("Checks are failing on branch %s of %s.", gitdomain.AbbreviateRef(baseRef), repo.Name), nil
+		}
+	}
+
+	return "", nil
+}
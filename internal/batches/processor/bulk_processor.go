@@ -37,6 +37,8 @@ func (e unknownJobTypeErr) NonRetryable() bool {
 
 var changesetIsProcessingErr = errors.New("cannot update a changeset that is currently being processed; will retry")
 
+var changesetDependencyNotMergedErr = errors.New("cannot merge a changeset before the changeset it depends on has been merged; will retry")
+
 func New(logger log.Logger, tx *store.Store, sourcer sources.Sourcer) BulkProcessor {
 	return &bulkProcessor{
 		tx:      tx,
@@ -157,6 +159,31 @@ func (b *bulkProcessor) detach(ctx context.Context, job *btypes.ChangesetJob) er
 	return b.tx.EnqueueChangeset(ctx, b.ch, global.DefaultReconcilerEnqueueState(), "")
 }
 
+// checkDependencyMerged makes sure that a stacked changeset is only merged
+// after the changeset it depends on, and after the reconciler based it on its
+// own base ref again. Otherwise, it would be merged into the branch of its
+// dependency.
+func (b *bulkProcessor) checkDependencyMerged(ctx context.Context, spec *btypes.ChangesetSpec) error {
+	if spec.DependsOn == "" {
+		return nil
+	}
+
+	dep, err := b.tx.GetChangesetDependency(ctx, b.ch, spec.DependsOn)
+	if err != nil {
+		if err == store.ErrNoResults {
+			return errcode.MakeNonRetryable(errors.Newf("changeset depends on %q, but no changeset in the same repository and batch change has that head ref", spec.DependsOn))
+		}
+		return errors.Wrap(err, "loading changeset dependency")
+	}
+	if dep.ExternalState != btypes.ChangesetExternalStateMerged {
+		return changesetDependencyNotMergedErr
+	}
+	if baseRef, err := b.ch.BaseRef(); err == nil && baseRef == spec.DependsOn {
+		return changesetDependencyNotMergedErr
+	}
+	return nil
+}
+
 func (b *bulkProcessor) reenqueueChangeset(ctx context.Context) error {
 	svc := service.New(b.tx)
 	_, _, err := svc.ReenqueueChangeset(ctx, b.ch.ID)
@@ -169,6 +196,17 @@ func (b *bulkProcessor) mergeChangeset(ctx context.Context, job *btypes.Changese
 		return nil, errors.Errorf("invalid payload type for changeset_job, want=%T have=%T", &btypes.ChangesetJobMergePayload{}, job.Payload)
 	}
 
+	var spec *btypes.ChangesetSpec
+	if b.ch.CurrentSpecID != 0 {
+		spec, err = b.tx.GetChangesetSpecByID(ctx, b.ch.CurrentSpecID)
+		if err != nil {
+			return nil, errors.Wrap(err, "loading changeset spec")
+		}
+		if err := b.checkDependencyMerged(ctx, spec); err != nil {
+			return nil, err
+		}
+	}
+
 	remoteRepo, err := sources.GetRemoteRepo(ctx, b.css, b.repo, b.ch, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "loading remote repo")
@@ -183,6 +221,13 @@ func (b *bulkProcessor) mergeChangeset(ctx context.Context, job *btypes.Changese
 		return nil, err
 	}
 
+	// Changesets stacked on this one can now be based on their own base ref.
+	if spec != nil {
+		if err := b.tx.EnqueueChangesetDependents(ctx, b.ch, spec.HeadRef); err != nil {
+			return nil, errors.Wrap(err, "enqueueing dependent changesets")
+		}
+	}
+
 	events, err := cs.Changeset.Events()
 	if err != nil {
 		b.logger.Error("Events", log.Error(err))
@@ -0,0 +1,143 @@
+package rebaser
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/batches/global"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// rebaseInterval is how often the rebaser looks for changesets to rebase.
+	rebaseInterval = 5 * time.Minute
+	// recheckInterval is how long the rebaser waits before checking a changeset
+	// again.
+	recheckInterval = time.Hour
+	// rebaseBatchSize is the maximum number of changesets checked per run.
+	rebaseBatchSize = 100
+)
+
+// Rebaser keeps the published, open changesets of batch changes up to date with
+// their base branch. When the base branch of a changeset has moved on, it
+// enqueues the changeset for the reconciler, which applies the diff of the
+// changeset to the latest commit of its base ref and force-pushes the result.
+// Since the changeset is enqueued like any other, rebasing it is subject to the
+// rollout windows of the reconciler. If the diff doesn't apply cleanly, the
+// changeset is marked as conflicting instead, until either the base branch
+// moves on or a new commit is pushed by the reconciler.
+//
+// Changesets that are stacked on another changeset are left alone: they are
+// based on the branch of their dependency, which the reconciler takes care of.
+type Rebaser struct {
+	store  *store.Store
+	client gitserver.Client
+	logger log.Logger
+}
+
+// NewRebaser creates a new goroutine.PeriodicGoroutine that enqueues open
+// changesets to be rebased onto the latest commit of their base branch.
+func NewRebaser(ctx context.Context, observationCtx *observation.Context, s *store.Store, client gitserver.Client) goroutine.BackgroundRoutine {
+	r := &Rebaser{
+		store:  s,
+		client: client,
+		logger: observationCtx.Logger.Scoped("rebaser"),
+	}
+
+	return goroutine.NewPeriodicGoroutine(
+		ctx,
+		r,
+		goroutine.WithName("batchchanges.rebaser"),
+		goroutine.WithDescription("enqueues open changesets to be rebased onto the latest commit of their base branch"),
+		goroutine.WithInterval(rebaseInterval),
+	)
+}
+
+// Handle checks the changesets that are due and enqueues those whose base
+// branch has moved on.
+func (r *Rebaser) Handle(ctx context.Context) error {
+	rebases, err := r.store.ListChangesetsToRebase(ctx, store.ListChangesetsToRebaseOpts{
+		LimitOpts:            store.LimitOpts{Limit: rebaseBatchSize},
+		CheckedBefore:        r.store.Clock()().Add(-recheckInterval),
+		ExternalServiceTypes: rebaseSupportedExternalServiceTypes(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "listing changesets to rebase")
+	}
+
+	var errs error
+	for _, rebase := range rebases {
+		if err := r.check(ctx, rebase); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "checking changeset %d", rebase.ChangesetID))
+		}
+	}
+
+	return errs
+}
+
+// check enqueues a single changeset to be rebased, if the latest commit of its
+// base ref isn't the one that it is based on, and records that it was checked.
+func (r *Rebaser) check(ctx context.Context, rebase *btypes.ChangesetRebase) error {
+	ch, err := r.store.GetChangesetByID(ctx, rebase.ChangesetID)
+	if err != nil {
+		return errors.Wrap(err, "loading changeset")
+	}
+	spec, err := r.store.GetChangesetSpecByID(ctx, ch.CurrentSpecID)
+	if err != nil {
+		return errors.Wrap(err, "loading changeset spec")
+	}
+	repo, err := r.store.Repos().Get(ctx, ch.RepoID)
+	if err != nil {
+		return errors.Wrap(err, "loading repo")
+	}
+
+	latest, err := r.client.ResolveRevision(ctx, repo.Name, spec.BaseRef, gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		return errors.Wrap(err, "resolving base ref")
+	}
+
+	rebase.TargetRev = ""
+	if !needsRebase(rebase, spec, latest) {
+		return r.store.UpdateChangesetRebase(ctx, rebase)
+	}
+
+	r.logger.Debug("enqueueing changeset to be rebased",
+		log.Int64("changeset", ch.ID),
+		log.String("targetRev", string(latest)))
+
+	rebase.TargetRev = string(latest)
+	return r.store.EnqueueChangesetRebase(ctx, rebase, global.DefaultReconcilerEnqueueState())
+}
+
+// needsRebase returns whether the changeset isn't based on the latest commit of
+// its base ref. Until the reconciler rebased it, the changeset is based on the
+// base revision of its spec.
+func needsRebase(rebase *btypes.ChangesetRebase, spec *btypes.ChangesetSpec, latest api.CommitID) bool {
+	current := rebase.BaseRev
+	if current == "" {
+		current = spec.BaseRev
+	}
+	return current != string(latest)
+}
+
+// rebaseSupportedExternalServiceTypes returns the code host types on which
+// changesets can be rebased.
+func rebaseSupportedExternalServiceTypes() []string {
+	var types []string
+	for typ := range btypes.GetSupportedExternalServices() {
+		if btypes.ExternalServiceSupports(typ, btypes.CodehostCapabilityAutomaticRebase) {
+			types = append(types, typ)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
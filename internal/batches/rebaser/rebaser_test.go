@@ -0,0 +1,59 @@
+package rebaser
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+)
+
+func TestNeedsRebase(t *testing.T) {
+	t.Parallel()
+
+	spec := bt.BuildChangesetSpec(t, bt.TestSpecOpts{
+		BaseRev: "cafebabe",
+		Typ:     btypes.ChangesetSpecTypeBranch,
+	})
+
+	tcs := []struct {
+		name    string
+		baseRev string
+		latest  api.CommitID
+		want    bool
+	}{
+		{name: "never rebased, base unchanged", latest: "cafebabe", want: false},
+		{name: "never rebased, base moved", latest: "deadbeef", want: true},
+		{name: "rebased, base unchanged", baseRev: "deadbeef", latest: "deadbeef", want: false},
+		{name: "rebased, base moved", baseRev: "deadbeef", latest: "f00dface", want: true},
+		{name: "rebased, base reset", baseRev: "deadbeef", latest: "cafebabe", want: true},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			rebase := &btypes.ChangesetRebase{BaseRev: tc.baseRev}
+			if have := needsRebase(rebase, spec, tc.latest); have != tc.want {
+				t.Fatalf("wrong result, want=%t have=%t", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestRebaseSupportedExternalServiceTypes(t *testing.T) {
+	t.Parallel()
+
+	types := map[string]bool{}
+	for _, typ := range rebaseSupportedExternalServiceTypes() {
+		types[typ] = true
+	}
+
+	for _, typ := range []string{extsvc.TypeGitHub, extsvc.TypeGitLab, extsvc.TypeBitbucketServer} {
+		if !types[typ] {
+			t.Errorf("expected %s to be supported", typ)
+		}
+	}
+	if types[extsvc.TypeGerrit] {
+		t.Errorf("expected %s not to be supported", extsvc.TypeGerrit)
+	}
+}
@@ -0,0 +1,189 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// changesetDependency is the changeset that another changeset is stacked on,
+// as declared by the dependsOn field of the changeset spec of the latter.
+type changesetDependency struct {
+	changeset    *btypes.Changeset
+	spec         *btypes.ChangesetSpec
+	previousSpec *btypes.ChangesetSpec
+}
+
+// ready returns whether the dependency has been published, so that the
+// changesets stacked on it can be based on its branch.
+func (d *changesetDependency) ready() bool {
+	return d.changeset.Published()
+}
+
+// merged returns whether the dependency has been merged, so that the changesets
+// stacked on it can be based on their own base ref again.
+func (d *changesetDependency) merged() bool {
+	return d.changeset.ExternalState == btypes.ChangesetExternalStateMerged
+}
+
+// errChangesetDependency is returned if the dependency of a changeset can't be
+// resolved. Retrying won't help, since only applying a new batch spec can
+// fix it.
+type errChangesetDependency struct {
+	msg string
+}
+
+func (e errChangesetDependency) Error() string      { return e.msg }
+func (e errChangesetDependency) NonRetryable() bool { return true }
+
+// loadChangesetDependency loads the changeset that the given changeset is
+// stacked on. It returns nil if the spec doesn't depend on another changeset.
+//
+// To catch cycles, the whole chain of dependencies is walked until a changeset
+// that doesn't depend on another one, or that has already been merged.
+func loadChangesetDependency(ctx context.Context, tx *store.Store, ch *btypes.Changeset, spec *btypes.ChangesetSpec) (*changesetDependency, error) {
+	if spec == nil || spec.DependsOn == "" {
+		return nil, nil
+	}
+
+	if !btypes.ExternalServiceSupports(ch.ExternalServiceType, btypes.CodehostCapabilityStackedChangesets) {
+		return nil, errChangesetDependency{msg: fmt.Sprintf("changesets on %s cannot depend on other changesets", ch.ExternalServiceType)}
+	}
+
+	var dep *changesetDependency
+	seen := map[string]struct{}{spec.HeadRef: {}}
+	for next := spec.DependsOn; next != ""; {
+		if _, ok := seen[next]; ok {
+			return nil, errChangesetDependency{msg: fmt.Sprintf("changeset dependencies form a cycle at %q", next)}
+		}
+		seen[next] = struct{}{}
+
+		c, err := tx.GetChangesetDependency(ctx, ch, next)
+		if err != nil {
+			if err == store.ErrNoResults {
+				return nil, errChangesetDependency{msg: fmt.Sprintf("changeset depends on %q, but no changeset in the same repository and batch change has that head ref", next)}
+			}
+			return nil, errors.Wrap(err, "loading changeset dependency")
+		}
+		prev, curr, err := loadChangesetSpecs(ctx, tx, c)
+		if err != nil {
+			return nil, errors.Wrap(err, "loading changeset specs of changeset dependency")
+		}
+
+		if dep == nil {
+			dep = &changesetDependency{changeset: c, spec: curr, previousSpec: prev}
+		}
+		if curr == nil || c.ExternalState == btypes.ChangesetExternalStateMerged {
+			break
+		}
+		next = curr.DependsOn
+	}
+
+	return dep, nil
+}
+
+// planDependency adjusts the plan of a changeset that is stacked on the given
+// dependency:
+//
+//   - While the dependency is unpublished, nothing is pushed to or changed on
+//     the code host. The changeset is enqueued again once its dependency has
+//     been pushed.
+//   - While the dependency is open, the changeset is based on the branch of the
+//     dependency, and rebased when the commit of the dependency changed.
+//   - Once the dependency has been merged, the changeset is based on its own
+//     base ref again.
+func planDependency(pl *Plan, dep *changesetDependency) {
+	if dep == nil || pl.ChangesetSpec == nil {
+		return
+	}
+
+	if !dep.ready() && !dep.merged() {
+		pl.Ops = withoutOperations(pl.Ops,
+			btypes.ReconcilerOperationPush,
+			btypes.ReconcilerOperationPublish,
+			btypes.ReconcilerOperationPublishDraft,
+			btypes.ReconcilerOperationUndraft,
+			btypes.ReconcilerOperationUpdate,
+			btypes.ReconcilerOperationSleep,
+			btypes.ReconcilerOperationSync,
+		)
+		return
+	}
+
+	ch := pl.Changeset
+	if !ch.Published() ||
+		ch.Closing ||
+		ch.ExternalState == btypes.ChangesetExternalStateMerged ||
+		ch.ExternalState == btypes.ChangesetExternalStateReadOnly ||
+		pl.Ops.Contains(btypes.ReconcilerOperationDetach) ||
+		pl.Ops.Contains(btypes.ReconcilerOperationArchive) {
+		return
+	}
+
+	wantBaseRef := pl.ChangesetSpec.BaseRef
+	if !dep.merged() {
+		wantBaseRef = dep.spec.HeadRef
+
+		if compareChangesetSpecs(dep.previousSpec, dep.spec, dep.changeset.UiPublicationState).NeedCommitUpdate() &&
+			!pl.Ops.Contains(btypes.ReconcilerOperationPush) {
+			pl.AddOp(btypes.ReconcilerOperationPush)
+			if !pl.Ops.Contains(btypes.ReconcilerOperationUpdate) {
+				pl.AddOp(btypes.ReconcilerOperationSleep)
+				pl.AddOp(btypes.ReconcilerOperationSync)
+			}
+		}
+	}
+
+	if baseRef, err := ch.BaseRef(); err == nil && baseRef != wantBaseRef && !pl.Ops.Contains(btypes.ReconcilerOperationUpdate) {
+		pl.Ops = withoutOperations(pl.Ops, btypes.ReconcilerOperationSleep, btypes.ReconcilerOperationSync)
+		pl.AddOp(btypes.ReconcilerOperationUpdate)
+	}
+}
+
+// stackedChangesetSpec returns the spec to use when pushing and publishing a
+// changeset that is stacked on the given, unmerged dependency: it is based on
+// the branch and the head commit of the dependency, and its body links to the
+// dependency.
+func stackedChangesetSpec(spec *btypes.ChangesetSpec, dep *changesetDependency) (*btypes.ChangesetSpec, error) {
+	stacked := spec.Clone()
+	stacked.BaseRef = dep.spec.HeadRef
+
+	headRefOid, err := dep.changeset.HeadRefOid()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting head commit of changeset dependency")
+	}
+	if headRefOid == "" {
+		return nil, errors.New("changeset dependency has no head commit yet; will retry")
+	}
+	stacked.BaseRev = headRefOid
+
+	if url, err := dep.changeset.URL(); err == nil && url != "" {
+		title, err := dep.changeset.Title()
+		if err != nil || title == "" {
+			title = dep.spec.Title
+		}
+		stacked.Body = fmt.Sprintf("%s\n\nDepends on [%s](%s)", stacked.Body, title, url)
+	}
+
+	return stacked, nil
+}
+
+func withoutOperations(ops Operations, remove ...btypes.ReconcilerOperation) Operations {
+	filtered := Operations{}
+	for _, op := range ops {
+		keep := true
+		for _, r := range remove {
+			if op == r {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			filtered = append(filtered, op)
+		}
+	}
+	return filtered
+}
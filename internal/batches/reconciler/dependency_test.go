@@ -0,0 +1,216 @@
+package reconciler
+
+import (
+	"testing"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+)
+
+func TestPlanDependency(t *testing.T) {
+	t.Parallel()
+
+	depSpec := bt.TestSpecOpts{
+		HeadRef:       "refs/heads/bump-library",
+		BaseRef:       "refs/heads/main",
+		CommitDiff:    []byte("diff"),
+		CommitMessage: "Bump library",
+		Published:     true,
+	}
+	depSpecNewDiff := depSpec
+	depSpecNewDiff.CommitDiff = []byte("new diff")
+
+	openDependency := bt.TestChangesetOpts{
+		PublicationState: btypes.ChangesetPublicationStatePublished,
+		ExternalState:    btypes.ChangesetExternalStateOpen,
+	}
+	mergedDependency := bt.TestChangesetOpts{
+		PublicationState: btypes.ChangesetPublicationStatePublished,
+		ExternalState:    btypes.ChangesetExternalStateMerged,
+	}
+
+	tcs := []struct {
+		name           string
+		ops            Operations
+		changeset      bt.TestChangesetOpts
+		dependency     bt.TestChangesetOpts
+		dependencyPrev *bt.TestSpecOpts
+		dependencySpec bt.TestSpecOpts
+		wantOperations Operations
+	}{
+		{
+			name: "dependency unpublished",
+			ops:  Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationPublish},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStateUnpublished,
+			},
+			dependency: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStateUnpublished,
+			},
+			dependencySpec: depSpec,
+			wantOperations: Operations{},
+		},
+		{
+			name: "dependency published",
+			ops:  Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationPublish},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStateUnpublished,
+			},
+			dependency:     openDependency,
+			dependencySpec: depSpec,
+			wantOperations: Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationPublish},
+		},
+		{
+			name: "dependency unchanged",
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+				Metadata:         &github.PullRequest{BaseRefName: "bump-library"},
+			},
+			dependency:     openDependency,
+			dependencyPrev: &depSpec,
+			dependencySpec: depSpec,
+			wantOperations: Operations{},
+		},
+		{
+			name: "dependency pushed new commit",
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+				Metadata:         &github.PullRequest{BaseRefName: "bump-library"},
+			},
+			dependency:     openDependency,
+			dependencyPrev: &depSpec,
+			dependencySpec: depSpecNewDiff,
+			wantOperations: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+		},
+		{
+			name: "not yet based on dependency",
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+				Metadata:         &github.PullRequest{BaseRefName: "main"},
+			},
+			dependency:     openDependency,
+			dependencySpec: depSpec,
+			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
+		},
+		{
+			name: "dependency merged",
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+				Metadata:         &github.PullRequest{BaseRefName: "bump-library"},
+			},
+			dependency:     mergedDependency,
+			dependencySpec: depSpec,
+			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
+		},
+		{
+			name: "dependency merged and retargeted",
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+				Metadata:         &github.PullRequest{BaseRefName: "main"},
+			},
+			dependency:     mergedDependency,
+			dependencySpec: depSpecNewDiff,
+			dependencyPrev: &depSpec,
+			wantOperations: Operations{},
+		},
+		{
+			name: "closing",
+			ops:  Operations{btypes.ReconcilerOperationClose},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+				Closing:          true,
+				Metadata:         &github.PullRequest{BaseRefName: "main"},
+			},
+			dependency:     openDependency,
+			dependencySpec: depSpec,
+			wantOperations: Operations{btypes.ReconcilerOperationClose},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			dep := &changesetDependency{changeset: bt.BuildChangeset(tc.dependency)}
+			tc.dependencySpec.Typ = btypes.ChangesetSpecTypeBranch
+			dep.spec = bt.BuildChangesetSpec(t, tc.dependencySpec)
+			if tc.dependencyPrev != nil {
+				prev := *tc.dependencyPrev
+				prev.Typ = btypes.ChangesetSpecTypeBranch
+				dep.previousSpec = bt.BuildChangesetSpec(t, prev)
+			}
+
+			pl := &Plan{
+				Changeset: bt.BuildChangeset(tc.changeset),
+				ChangesetSpec: bt.BuildChangesetSpec(t, bt.TestSpecOpts{
+					HeadRef:   "refs/heads/migrate-call-sites",
+					BaseRef:   "refs/heads/main",
+					DependsOn: "refs/heads/bump-library",
+					Published: true,
+					Typ:       btypes.ChangesetSpecTypeBranch,
+				}),
+				Ops: tc.ops,
+			}
+
+			planDependency(pl, dep)
+			if have, want := pl.Ops, tc.wantOperations; !have.Equal(want) {
+				t.Fatalf("incorrect plan determined, want=%v have=%v", want, have)
+			}
+		})
+	}
+}
+
+func TestStackedChangesetSpec(t *testing.T) {
+	t.Parallel()
+
+	dep := &changesetDependency{
+		changeset: bt.BuildChangeset(bt.TestChangesetOpts{
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			Metadata: &github.PullRequest{
+				Title:      "Bump library",
+				URL:        "https://github.com/sourcegraph/sourcegraph/pull/1",
+				HeadRefOid: "deadbeef",
+			},
+		}),
+		spec: bt.BuildChangesetSpec(t, bt.TestSpecOpts{
+			HeadRef: "refs/heads/bump-library",
+			Typ:     btypes.ChangesetSpecTypeBranch,
+		}),
+	}
+	spec := bt.BuildChangesetSpec(t, bt.TestSpecOpts{
+		HeadRef:   "refs/heads/migrate-call-sites",
+		BaseRef:   "refs/heads/main",
+		BaseRev:   "cafebabe",
+		DependsOn: "refs/heads/bump-library",
+		Body:      "Migrates the call sites.",
+		Typ:       btypes.ChangesetSpecTypeBranch,
+	})
+
+	stacked, err := stackedChangesetSpec(spec, dep)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if have, want := stacked.BaseRef, "refs/heads/bump-library"; have != want {
+		t.Errorf("wrong base ref, want=%q have=%q", want, have)
+	}
+	if have, want := stacked.BaseRev, "deadbeef"; have != want {
+		t.Errorf("wrong base rev, want=%q have=%q", want, have)
+	}
+	if have, want := stacked.Body, "Migrates the call sites.\n\nDepends on [Bump library](https://github.com/sourcegraph/sourcegraph/pull/1)"; have != want {
+		t.Errorf("wrong body, want=%q have=%q", want, have)
+	}
+	// The spec that is stored must not be changed.
+	if have, want := spec.BaseRef, "refs/heads/main"; have != want {
+		t.Errorf("original spec changed, want=%q have=%q", want, have)
+	}
+}
@@ -37,6 +37,7 @@ func executePlan(ctx context.Context, logger log.Logger, client gitserver.Client
 		tx:                tx,
 		ch:                plan.Changeset,
 		spec:              plan.ChangesetSpec,
+		rebaseRev:         plan.RebaseRev,
 	}
 
 	return e.Run(ctx, plan)
@@ -51,6 +52,10 @@ type executor struct {
 	ch                *btypes.Changeset
 	spec              *btypes.ChangesetSpec
 
+	// rebaseRev is the commit that the changeset is rebased onto, if the plan
+	// rebases it.
+	rebaseRev string
+
 	// targetRepo represents the repo where the changeset should be opened.
 	targetRepo *types.Repo
 
@@ -138,6 +143,14 @@ func (e *executor) Run(ctx context.Context, plan *Plan) (afterDone func(store *s
 		}
 	}
 
+	// Changesets stacked on this one are waiting for it to be published, or
+	// need to be rebased onto its new commit.
+	if e.spec != nil && (plan.Ops.Contains(btypes.ReconcilerOperationPush) || plan.Ops.Contains(btypes.ReconcilerOperationPublish) || plan.Ops.Contains(btypes.ReconcilerOperationPublishDraft)) {
+		if err := e.tx.EnqueueChangesetDependents(ctx, e.ch, e.spec.HeadRef); err != nil {
+			return afterDone, errors.Wrap(err, "enqueueing dependent changesets")
+		}
+	}
+
 	events, err := e.ch.Events()
 	if err != nil {
 		log15.Error("Events", "err", err)
@@ -203,6 +216,19 @@ func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook
 	opts := css.BuildCommitOpts(e.targetRepo, e.ch, e.spec, pushConf)
 	resp, err := e.pushCommit(ctx, opts)
 	if err != nil {
+		if errors.Is(err, errRebaseConflict) {
+			// The changeset is left as it is, and marked as conflicting until
+			// either its base branch moves on or a new commit is pushed.
+			if err := e.tx.UpdateChangesetRebase(ctx, &btypes.ChangesetRebase{
+				ChangesetID: e.ch.ID,
+				BaseRev:     e.rebaseRev,
+				Conflicting: true,
+			}); err != nil {
+				return afterDone, errors.Wrap(err, "marking changeset as conflicting")
+			}
+			return afterDone, nil
+		}
+
 		var pce pushCommitError
 		if errors.As(err, &pce) {
 			if acss, ok := css.(sources.ArchivableChangesetSource); ok {
@@ -229,6 +255,13 @@ func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook
 		return afterDone, errors.Wrap(err, "running after commit routine")
 	}
 
+	// Unless the changeset has just been rebased, the commit is based on the
+	// base revision of the spec again, so whatever the rebaser recorded for the
+	// changeset doesn't apply anymore.
+	if err = e.tx.UpdateChangesetRebase(ctx, &btypes.ChangesetRebase{ChangesetID: e.ch.ID, BaseRev: e.rebaseRev}); err != nil {
+		return afterDone, errors.Wrap(err, "recording rebase state")
+	}
+
 	if triggerUpdateWebhook && err == nil {
 		afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdate) }
 	}
@@ -628,6 +661,10 @@ func (e pushCommitError) Error() string {
 func (e *executor) pushCommit(ctx context.Context, opts protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
 	res, err := e.client.CreateCommitFromPatch(ctx, opts)
 	if err != nil {
+		if e.rebaseRev != "" && isPatchConflict(err) {
+			return nil, errRebaseConflict
+		}
+
 		var e *protocol.CreateCommitFromPatchError
 		if errors.As(err, &e) {
 			// Make "patch does not apply" errors a fatal error. Retrying the changeset
@@ -101,6 +101,10 @@ type Plan struct {
 	// The changeset spec that is used in this plan.
 	ChangesetSpec *btypes.ChangesetSpec
 
+	// The commit that the changeset is rebased onto by the push operation, if
+	// the rebaser found that its base branch has moved on.
+	RebaseRev string
+
 	// The operations that need to be done to reconcile the changeset.
 	Ops Operations
 
@@ -299,6 +303,9 @@ func compareChangesetSpecs(previous, current *btypes.ChangesetSpec, uiPublicatio
 	if previous.BaseRef != current.BaseRef {
 		delta.BaseRefChanged = true
 	}
+	if previous.DependsOn != current.DependsOn {
+		delta.DependsOnChanged = true
+	}
 
 	// If was set to "draft" and now "true", need to undraft the changeset.
 	// We currently ignore going from "true" to "draft".
@@ -344,6 +351,7 @@ type ChangesetSpecDelta struct {
 	BodyChanged          bool
 	Undraft              bool
 	BaseRefChanged       bool
+	DependsOnChanged     bool
 	DiffChanged          bool
 	CommitMessageChanged bool
 	AuthorNameChanged    bool
@@ -352,12 +360,15 @@ type ChangesetSpecDelta struct {
 
 func (d *ChangesetSpecDelta) String() string { return fmt.Sprintf("%#v", d) }
 
+// NeedCommitUpdate returns whether the commit needs to be pushed again. This
+// includes the changeset being stacked on another changeset, or no longer
+// being stacked, since that changes the parent of the commit.
 func (d *ChangesetSpecDelta) NeedCommitUpdate() bool {
-	return d.DiffChanged || d.CommitMessageChanged || d.AuthorNameChanged || d.AuthorEmailChanged
+	return d.DependsOnChanged || d.DiffChanged || d.CommitMessageChanged || d.AuthorNameChanged || d.AuthorEmailChanged
 }
 
 func (d *ChangesetSpecDelta) NeedCodeHostUpdate() bool {
-	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged
+	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged || d.DependsOnChanged
 }
 
 func (d *ChangesetSpecDelta) AttributesChanged() bool {
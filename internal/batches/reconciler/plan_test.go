@@ -256,6 +256,18 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 				btypes.ReconcilerOperationSync,
 			},
 		},
+		{
+			name:         "dependsOn changed on published changeset",
+			previousSpec: &bt.TestSpecOpts{Published: true},
+			currentSpec:  &bt.TestSpecOpts{Published: true, DependsOn: "refs/heads/bump-library"},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationUpdate,
+			},
+		},
 		{
 			name:         "commit diff changed on merge changeset",
 			previousSpec: &bt.TestSpecOpts{Published: true, CommitDiff: []byte("testDiff")},
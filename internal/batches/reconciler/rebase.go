@@ -0,0 +1,51 @@
+package reconciler
+
+import (
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// planRebase adjusts the plan of a changeset for which the rebaser recorded a
+// pending rebase: the diff of the changeset is pushed again, based on the
+// target commit of the rebase instead of the base revision of its spec.
+//
+// Nothing is rebased if the plan already pushes a new commit, which is based on
+// the spec again, or if the changeset isn't open on the code host anymore.
+func planRebase(pl *Plan, rebase *btypes.ChangesetRebase) {
+	if rebase == nil || rebase.TargetRev == "" || pl.ChangesetSpec == nil {
+		return
+	}
+
+	ch := pl.Changeset
+	if !ch.Published() ||
+		ch.Closing ||
+		(ch.ExternalState != btypes.ChangesetExternalStateOpen && ch.ExternalState != btypes.ChangesetExternalStateDraft) ||
+		pl.Ops.Contains(btypes.ReconcilerOperationPush) ||
+		pl.Ops.Contains(btypes.ReconcilerOperationDetach) ||
+		pl.Ops.Contains(btypes.ReconcilerOperationArchive) {
+		return
+	}
+
+	rebased := pl.ChangesetSpec.Clone()
+	rebased.BaseRev = rebase.TargetRev
+	pl.ChangesetSpec = rebased
+	pl.RebaseRev = rebase.TargetRev
+
+	pl.AddOp(btypes.ReconcilerOperationPush)
+	if !pl.Ops.Contains(btypes.ReconcilerOperationUpdate) {
+		pl.AddOp(btypes.ReconcilerOperationSleep)
+		pl.AddOp(btypes.ReconcilerOperationSync)
+	}
+}
+
+// errRebaseConflict is returned by pushCommit if the diff of a changeset
+// doesn't apply cleanly to the commit that it is being rebased onto.
+var errRebaseConflict = errors.New("diff does not apply to the latest commit of the base branch")
+
+// isPatchConflict returns whether the given error from CreateCommitFromPatch is
+// caused by the diff not applying cleanly to the base commit.
+func isPatchConflict(err error) bool {
+	var e *protocol.CreateCommitFromPatchError
+	return errors.As(err, &e) && e.PatchConflict
+}
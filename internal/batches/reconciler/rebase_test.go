@@ -0,0 +1,187 @@
+package reconciler
+
+import (
+	"testing"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestPlanRebase(t *testing.T) {
+	t.Parallel()
+
+	openChangeset := bt.TestChangesetOpts{
+		PublicationState: btypes.ChangesetPublicationStatePublished,
+		ExternalState:    btypes.ChangesetExternalStateOpen,
+	}
+	pending := &btypes.ChangesetRebase{BaseRev: "cafebabe", TargetRev: "deadbeef"}
+
+	tcs := []struct {
+		name           string
+		ops            Operations
+		changeset      bt.TestChangesetOpts
+		rebase         *btypes.ChangesetRebase
+		wantOperations Operations
+		wantRebaseRev  string
+	}{
+		{
+			name:           "no rebase state",
+			changeset:      openChangeset,
+			wantOperations: Operations{},
+		},
+		{
+			name:           "no rebase pending",
+			changeset:      openChangeset,
+			rebase:         &btypes.ChangesetRebase{BaseRev: "cafebabe"},
+			wantOperations: Operations{},
+		},
+		{
+			name:      "rebase pending",
+			changeset: openChangeset,
+			rebase:    pending,
+			wantOperations: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+			wantRebaseRev: "deadbeef",
+		},
+		{
+			name:      "rebase pending with update",
+			ops:       Operations{btypes.ReconcilerOperationUpdate},
+			changeset: openChangeset,
+			rebase:    pending,
+			wantOperations: Operations{
+				btypes.ReconcilerOperationUpdate,
+				btypes.ReconcilerOperationPush,
+			},
+			wantRebaseRev: "deadbeef",
+		},
+		{
+			name: "rebase pending on draft",
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateDraft,
+			},
+			rebase: pending,
+			wantOperations: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+			wantRebaseRev: "deadbeef",
+		},
+		{
+			name: "new commit pushed for spec",
+			ops: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+			changeset: openChangeset,
+			rebase:    pending,
+			wantOperations: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+		},
+		{
+			name: "changeset merged",
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateMerged,
+			},
+			rebase:         pending,
+			wantOperations: Operations{},
+		},
+		{
+			name: "changeset closing",
+			ops:  Operations{btypes.ReconcilerOperationClose},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+				Closing:          true,
+			},
+			rebase:         pending,
+			wantOperations: Operations{btypes.ReconcilerOperationClose},
+		},
+		{
+			name:           "changeset detached",
+			ops:            Operations{btypes.ReconcilerOperationDetach},
+			changeset:      openChangeset,
+			rebase:         pending,
+			wantOperations: Operations{btypes.ReconcilerOperationDetach},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			pl := &Plan{
+				Changeset: bt.BuildChangeset(tc.changeset),
+				ChangesetSpec: bt.BuildChangesetSpec(t, bt.TestSpecOpts{
+					HeadRef:   "refs/heads/my-branch",
+					BaseRef:   "refs/heads/main",
+					BaseRev:   "f00dface",
+					Published: true,
+					Typ:       btypes.ChangesetSpecTypeBranch,
+				}),
+				Ops: tc.ops,
+			}
+			spec := pl.ChangesetSpec
+
+			planRebase(pl, tc.rebase)
+			if have, want := pl.Ops, tc.wantOperations; !have.Equal(want) {
+				t.Fatalf("incorrect plan determined, want=%v have=%v", want, have)
+			}
+			if have, want := pl.RebaseRev, tc.wantRebaseRev; have != want {
+				t.Fatalf("wrong rebase rev, want=%q have=%q", want, have)
+			}
+			wantBaseRev := spec.BaseRev
+			if tc.wantRebaseRev != "" {
+				wantBaseRev = tc.wantRebaseRev
+			}
+			if have := pl.ChangesetSpec.BaseRev; have != wantBaseRev {
+				t.Fatalf("wrong base rev, want=%q have=%q", wantBaseRev, have)
+			}
+			if spec.BaseRev != "f00dface" {
+				t.Fatal("spec of the changeset was modified")
+			}
+		})
+	}
+}
+
+func TestIsPatchConflict(t *testing.T) {
+	t.Parallel()
+
+	conflict := &protocol.CreateCommitFromPatchError{
+		Command:        "git apply --cached",
+		CombinedOutput: "error: patch failed: README.md:1\nerror: README.md: patch does not apply",
+		PatchConflict:  true,
+	}
+	if !isPatchConflict(errors.Wrap(conflict, "pushing commit")) {
+		t.Error("patch not applying is not reported as a conflict")
+	}
+
+	corrupt := &protocol.CreateCommitFromPatchError{
+		Command:        "git apply --cached",
+		CombinedOutput: "error: corrupt patch at line 6",
+	}
+	if isPatchConflict(corrupt) {
+		t.Error("corrupt patch is reported as a conflict")
+	}
+
+	pushFailed := &protocol.CreateCommitFromPatchError{
+		Command:        "git push --force",
+		CombinedOutput: "remote: Permission denied",
+	}
+	if isPatchConflict(pushFailed) {
+		t.Error("push failure is reported as a conflict")
+	}
+
+	if isPatchConflict(errors.New("patch does not apply")) {
+		t.Error("unrelated error is reported as a conflict")
+	}
+}
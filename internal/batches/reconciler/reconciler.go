@@ -12,6 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/metrics"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 // Reconciler processes changesets and reconciles their current state — in
@@ -97,6 +98,29 @@ func (r *Reconciler) process(ctx context.Context, logger log.Logger, tx *store.S
 		return nil, err
 	}
 
+	// If the changeset is stacked on another changeset, it has to wait for,
+	// be based on, or be rebased onto that changeset.
+	dep, err := loadChangesetDependency(ctx, tx, ch, curr)
+	if err != nil {
+		return nil, err
+	}
+	if dep != nil {
+		planDependency(plan, dep)
+		if dep.ready() && !dep.merged() && !plan.Ops.IsNone() {
+			if plan.ChangesetSpec, err = stackedChangesetSpec(curr, dep); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		// If the base branch of the changeset has moved on, the rebaser may
+		// have enqueued it to be rebased.
+		rebase, err := tx.GetChangesetRebase(ctx, ch.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "loading rebase state")
+		}
+		planRebase(plan, rebase)
+	}
+
 	logger.Info("Reconciler processing changeset", log.Int64("changeset", ch.ID), log.String("operations", fmt.Sprintf("%+v", plan.Ops)))
 
 	return executePlan(
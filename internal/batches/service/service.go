@@ -24,6 +24,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/batches/webhooks"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
 	extsvcauth "github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/jsonc"
@@ -92,6 +93,8 @@ type operations struct {
 	fetchUsernameForBitbucketServerToken *observation.Operation
 	validateAuthenticator                *observation.Operation
 	createChangesetJobs                  *observation.Operation
+	enableMergeQueue                     *observation.Operation
+	disableMergeQueue                    *observation.Operation
 	applyBatchChange                     *observation.Operation
 	reconcileBatchChange                 *observation.Operation
 	validateChangesetSpecs               *observation.Operation
@@ -142,6 +145,8 @@ func newOperations(observationCtx *observation.Context) *operations {
 			fetchUsernameForBitbucketServerToken: op("FetchUsernameForBitbucketServerToken"),
 			validateAuthenticator:                op("ValidateAuthenticator"),
 			createChangesetJobs:                  op("CreateChangesetJobs"),
+			enableMergeQueue:                     op("EnableMergeQueue"),
+			disableMergeQueue:                    op("DisableMergeQueue"),
 			applyBatchChange:                     op("ApplyBatchChange"),
 			reconcileBatchChange:                 op("ReconcileBatchChange"),
 			validateChangesetSpecs:               op("ValidateChangesetSpecs"),
@@ -1381,6 +1386,82 @@ func (s *Service) CreateChangesetJobs(ctx context.Context, batchChangeID int64,
 	return bulkGroupID, nil
 }
 
+// ErrMergeQueueClosedBatchChange is returned when the merge queue of a closed
+// batch change is enabled.
+var ErrMergeQueueClosedBatchChange = errors.New("cannot enable the merge queue of a closed batch change")
+
+// EnableMergeQueueOpts are the options for EnableMergeQueue.
+type EnableMergeQueueOpts struct {
+	BatchChangeID int64
+	// MaxMerges is the maximum number of changesets merged within Window.
+	MaxMerges int32
+	Window    time.Duration
+	Squash    bool
+}
+
+// EnableMergeQueue enables the merge queue of the given batch change, or
+// updates its settings if it is already enabled. Changesets are merged on
+// behalf of the actor in ctx.
+func (s *Service) EnableMergeQueue(ctx context.Context, opts EnableMergeQueueOpts) (queue *btypes.BatchChangeMergeQueue, err error) {
+	ctx, _, endObservation := s.operations.enableMergeQueue.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	if opts.MaxMerges < 1 {
+		return nil, errors.New("the number of merges per window must be at least 1")
+	}
+	if opts.Window < time.Minute {
+		return nil, errors.New("the window must be at least one minute")
+	}
+
+	batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: opts.BatchChangeID})
+	if err != nil {
+		return nil, errors.Wrap(err, "loading batch change")
+	}
+
+	// 🚨 SECURITY: Changesets are merged with the credentials of the user
+	// enabling the merge queue, so only admins of the batch change may do so.
+	if err := s.checkViewerCanAdminister(ctx, batchChange.NamespaceOrgID, batchChange.CreatorID, false); err != nil {
+		return nil, err
+	}
+
+	if batchChange.Closed() {
+		return nil, ErrMergeQueueClosedBatchChange
+	}
+
+	queue = &btypes.BatchChangeMergeQueue{
+		BatchChangeID: batchChange.ID,
+		UserID:        sgactor.FromContext(ctx).UID,
+		Squash:        opts.Squash,
+		MaxMerges:     opts.MaxMerges,
+		Window:        opts.Window,
+	}
+	if err := s.store.UpsertBatchChangeMergeQueue(ctx, queue); err != nil {
+		return nil, err
+	}
+	return queue, nil
+}
+
+// DisableMergeQueue disables the merge queue of the given batch change.
+// Changesets for which a merge job has already been created are still merged.
+func (s *Service) DisableMergeQueue(ctx context.Context, batchChangeID int64) (err error) {
+	ctx, _, endObservation := s.operations.disableMergeQueue.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: batchChangeID})
+	if err != nil {
+		return errors.Wrap(err, "loading batch change")
+	}
+
+	if err := s.checkViewerCanAdminister(ctx, batchChange.NamespaceOrgID, batchChange.CreatorID, false); err != nil {
+		return err
+	}
+
+	if err := s.store.DeleteBatchChangeMergeQueue(ctx, batchChangeID); err != nil && err != store.ErrNoResults {
+		return err
+	}
+	return nil
+}
+
 // ValidateChangesetSpecs checks whether the given BachSpec has ChangesetSpecs
 // that would publish to the same branch in the same repository.
 // If the return value is nil, then the BatchSpec is valid.
@@ -1576,8 +1657,9 @@ func (s *Service) RetryBatchSpecWorkspaces(ctx context.Context, workspaceIDs []i
 		}
 	}
 
-	// Create new jobs
-	if err := tx.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, workspaceIDs); err != nil {
+	// Create new jobs. The user is waiting for the retried workspaces, so they
+	// are dequeued before the workspaces of batch specs executed as a whole.
+	if err := tx.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, workspaceIDs, executor.JobPriorityHigh); err != nil {
 		return errors.Wrap(err, "creating new batch spec workspace execution jobs")
 	}
 
@@ -1665,7 +1747,7 @@ func (s *Service) RetryBatchSpecExecution(ctx context.Context, opts RetryBatchSp
 	}
 
 	// Create new jobs
-	if err := tx.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, workspaceIDs); err != nil {
+	if err := tx.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, workspaceIDs, executor.JobPriorityNormal); err != nil {
 		return errors.Wrap(err, "creating new batch spec workspace execution jobs")
 	}
 
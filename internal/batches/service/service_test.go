@@ -211,6 +211,20 @@ func TestServicePermissionLevels(t *testing.T) {
 				tc.assertFunc(t, err)
 			})
 
+			t.Run("EnableMergeQueue", func(t *testing.T) {
+				_, err := svc.EnableMergeQueue(currentUserCtx, EnableMergeQueueOpts{
+					BatchChangeID: batchChange.ID,
+					MaxMerges:     1,
+					Window:        time.Hour,
+				})
+				tc.assertFunc(t, err)
+			})
+
+			t.Run("DisableMergeQueue", func(t *testing.T) {
+				err := svc.DisableMergeQueue(currentUserCtx, batchChange.ID)
+				tc.assertFunc(t, err)
+			})
+
 			t.Run("CloseBatchChange", func(t *testing.T) {
 				_, err := svc.CloseBatchChange(currentUserCtx, batchChange.ID, false)
 				tc.assertFunc(t, err)
@@ -514,6 +528,56 @@ func TestService(t *testing.T) {
 		})
 	})
 
+	t.Run("MergeQueue", func(t *testing.T) {
+		spec := testBatchSpec(admin.ID)
+		if err := s.CreateBatchSpec(ctx, spec); err != nil {
+			t.Fatal(err)
+		}
+		batchChange := testBatchChange(admin.ID, spec)
+		if err := s.CreateBatchChange(ctx, batchChange); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := svc.EnableMergeQueue(adminCtx, EnableMergeQueueOpts{
+			BatchChangeID: batchChange.ID,
+			MaxMerges:     0,
+			Window:        time.Hour,
+		}); err == nil {
+			t.Fatal("no error for invalid number of merges")
+		}
+
+		queue, err := svc.EnableMergeQueue(adminCtx, EnableMergeQueueOpts{
+			BatchChangeID: batchChange.ID,
+			MaxMerges:     5,
+			Window:        time.Hour,
+			Squash:        true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if queue.UserID != admin.ID || queue.MaxMerges != 5 || queue.Window != time.Hour || !queue.Squash {
+			t.Fatalf("wrong merge queue: %+v", queue)
+		}
+
+		if err := svc.DisableMergeQueue(adminCtx, batchChange.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.GetBatchChangeMergeQueue(ctx, batchChange.ID); err != store.ErrNoResults {
+			t.Fatalf("merge queue not disabled: %v", err)
+		}
+
+		batchChange.ClosedAt = now
+		if err := s.UpdateBatchChange(ctx, batchChange); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := svc.EnableMergeQueue(adminCtx, EnableMergeQueueOpts{
+			BatchChangeID: batchChange.ID,
+			MaxMerges:     5,
+			Window:        time.Hour,
+		}); err != ErrMergeQueueClosedBatchChange {
+			t.Fatalf("wrong error: want=%v have=%v", ErrMergeQueueClosedBatchChange, err)
+		}
+	})
 	t.Run("EnqueueChangesetSync", func(t *testing.T) {
 		spec := testBatchSpec(user.ID)
 		if err := s.CreateBatchSpec(ctx, spec); err != nil {
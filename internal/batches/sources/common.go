@@ -60,6 +60,16 @@ type ForkableChangesetSource interface {
 	GetFork(ctx context.Context, targetRepo *types.Repo, namespace, name *string) (*types.Repo, error)
 }
 
+// A BranchChecksChangesetSource can report the state of the checks on the
+// latest commit of a branch, such as the base branch of a changeset.
+type BranchChecksChangesetSource interface {
+	ChangesetSource
+
+	// BranchCheckState returns the combined state of the checks on the latest
+	// commit of the given branch of the repo.
+	BranchCheckState(ctx context.Context, repo *types.Repo, branch string) (btypes.ChangesetCheckState, error)
+}
+
 // A ChangesetSource can load the latest state of a list of Changesets.
 type ChangesetSource interface {
 	// GitserverPushConfig returns an authenticated push config used for pushing
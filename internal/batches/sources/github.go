@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/sourcegraph/sourcegraph/internal/batches/state"
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -29,7 +30,10 @@ type GitHubSource struct {
 	au     auth.Authenticator
 }
 
-var _ ForkableChangesetSource = GitHubSource{}
+var (
+	_ ForkableChangesetSource     = GitHubSource{}
+	_ BranchChecksChangesetSource = GitHubSource{}
+)
 
 func NewGitHubSource(ctx context.Context, db database.DB, svc *types.ExternalService, cf *httpcli.Factory) (*GitHubSource, error) {
 	rawConfig, err := svc.Config.Decrypt(ctx)
@@ -373,6 +377,25 @@ func (s GitHubSource) MergeChangeset(ctx context.Context, c *Changeset, squash b
 	return c.Changeset.SetMetadata(pr)
 }
 
+// BranchCheckState returns the state of the status check rollup of the latest
+// commit of the given branch.
+func (s GitHubSource) BranchCheckState(ctx context.Context, repo *types.Repo, branch string) (btypes.ChangesetCheckState, error) {
+	r, ok := repo.Metadata.(*github.Repository)
+	if !ok {
+		return "", errors.New("repo is not a GitHub repository")
+	}
+	owner, name, err := github.SplitRepositoryNameWithOwner(r.NameWithOwner)
+	if err != nil {
+		return "", errors.Wrap(err, "getting owner and repo name")
+	}
+
+	rollup, err := s.client.GetBranchStatusCheckRollupState(ctx, owner, name, branch)
+	if err != nil {
+		return "", err
+	}
+	return state.ParseGitHubCheckState(rollup), nil
+}
+
 func (GitHubSource) IsPushResponseArchived(s string) bool {
 	return strings.Contains(s, "This repository was archived so it is read-only.")
 }
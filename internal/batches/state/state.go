@@ -360,7 +360,7 @@ func computeGitHubCheckState(lastSynced time.Time, pr *github.PullRequest, event
 		latestOID = commit.Commit.OID
 		// Calc status per context for the most recent synced commit
 		for _, c := range commit.Commit.Status.Contexts {
-			statusPerContext[c.Context] = parseGithubCheckState(c.State)
+			statusPerContext[c.Context] = ParseGitHubCheckState(c.State)
 		}
 		for _, c := range commit.Commit.CheckSuites.Nodes {
 			if (c.Status == "QUEUED" || c.Status == "COMPLETED") && len(c.CheckRuns.Nodes) == 0 {
@@ -418,7 +418,7 @@ func computeGitHubCheckState(lastSynced time.Time, pr *github.PullRequest, event
 			if s.SHA != latestOID {
 				continue
 			}
-			statusPerContext[s.Context] = parseGithubCheckState(s.State)
+			statusPerContext[s.Context] = ParseGitHubCheckState(s.State)
 		}
 	}
 	finalStates := make([]btypes.ChangesetCheckState, 0, len(statusPerContext))
@@ -465,7 +465,9 @@ func combineCheckStates(states []btypes.ChangesetCheckState) btypes.ChangesetChe
 	return btypes.ChangesetCheckStateUnknown
 }
 
-func parseGithubCheckState(s string) btypes.ChangesetCheckState {
+// ParseGitHubCheckState maps the state of a GitHub commit status, or of the
+// status check rollup of a commit, to a ChangesetCheckState.
+func ParseGitHubCheckState(s string) btypes.ChangesetCheckState {
 	s = strings.ToUpper(s)
 	switch s {
 	case "ERROR", "FAILURE":
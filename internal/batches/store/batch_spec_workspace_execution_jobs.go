@@ -116,10 +116,11 @@ func (s *Store) CreateBatchSpecWorkspaceExecutionJobs(ctx context.Context, batch
 
 const createBatchSpecWorkspaceExecutionJobsForWorkspacesQueryFmtstr = `
 INSERT INTO
-	batch_spec_workspace_execution_jobs (batch_spec_workspace_id, user_id, version)
+	batch_spec_workspace_execution_jobs (batch_spec_workspace_id, user_id, version, priority)
 SELECT
 	batch_spec_workspaces.id,
 	batch_specs.user_id,
+	%s,
 	%s
 FROM
 	batch_spec_workspaces
@@ -129,12 +130,15 @@ WHERE
 	batch_spec_workspaces.id = ANY (%s)
 `
 
-// CreateBatchSpecWorkspaceExecutionJobsForWorkspaces creates the batch spec workspace jobs for the given workspaces.
-func (s *Store) CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx context.Context, workspaceIDs []int64) (err error) {
-	ctx, _, endObservation := s.operations.createBatchSpecWorkspaceExecutionJobsForWorkspaces.With(ctx, &err, observation.Args{})
+// CreateBatchSpecWorkspaceExecutionJobsForWorkspaces creates the batch spec workspace jobs for the given
+// workspaces, with the given priority.
+func (s *Store) CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx context.Context, workspaceIDs []int64, priority executor.JobPriority) (err error) {
+	ctx, _, endObservation := s.operations.createBatchSpecWorkspaceExecutionJobsForWorkspaces.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("priority", int(priority)),
+	}})
 	defer endObservation(1, observation.Args{})
 
-	q := sqlf.Sprintf(createBatchSpecWorkspaceExecutionJobsForWorkspacesQueryFmtstr, versionForExecution(ctx, s), pq.Array(workspaceIDs))
+	q := sqlf.Sprintf(createBatchSpecWorkspaceExecutionJobsForWorkspacesQueryFmtstr, versionForExecution(ctx, s), priority, pq.Array(workspaceIDs))
 	return s.Exec(ctx, q)
 }
 
@@ -14,6 +14,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
 )
 
 func testStoreBatchSpecWorkspaceExecutionJobs(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
@@ -571,7 +573,7 @@ func testStoreBatchSpecWorkspaceExecutionJobs(t *testing.T, ctx context.Context,
 			workspaces := createWorkspaces(t, ctx, s)
 			ids := workspacesIDs(t, workspaces)
 
-			err := s.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, ids)
+			err := s.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, ids, executor.JobPriorityNormal)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -587,6 +589,32 @@ func testStoreBatchSpecWorkspaceExecutionJobs(t *testing.T, ctx context.Context,
 				t.Fatalf("wrong number of jobs created. want=%d, have=%d", want, have)
 			}
 		})
+
+		t.Run("with priority", func(t *testing.T) {
+			workspaces := createWorkspaces(t, ctx, s)
+			ids := workspacesIDs(t, workspaces)
+
+			err := s.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, ids, executor.JobPriorityHigh)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			priorities, err := basestore.ScanInts(s.Query(ctx, sqlf.Sprintf(
+				"SELECT priority FROM batch_spec_workspace_execution_jobs WHERE batch_spec_workspace_id = ANY (%s)",
+				pq.Array(ids),
+			)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have, want := len(priorities), len(workspaces); have != want {
+				t.Fatalf("wrong number of jobs created. want=%d, have=%d", want, have)
+			}
+			for _, priority := range priorities {
+				if have, want := priority, int(executor.JobPriorityHigh); have != want {
+					t.Fatalf("wrong priority. want=%d, have=%d", want, have)
+				}
+			}
+		})
 	})
 
 	t.Run("DeleteBatchSpecWorkspaceExecutionJobs", func(t *testing.T) {
@@ -594,7 +622,7 @@ func testStoreBatchSpecWorkspaceExecutionJobs(t *testing.T, ctx context.Context,
 			workspaces := createWorkspaces(t, ctx, s)
 			ids := workspacesIDs(t, workspaces)
 
-			err := s.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, ids)
+			err := s.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, ids, executor.JobPriorityNormal)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -633,7 +661,7 @@ func testStoreBatchSpecWorkspaceExecutionJobs(t *testing.T, ctx context.Context,
 			workspaces := createWorkspaces(t, ctx, s)
 			ids := workspacesIDs(t, workspaces)
 
-			err := s.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, ids)
+			err := s.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, ids, executor.JobPriorityNormal)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -680,7 +708,7 @@ func testStoreBatchSpecWorkspaceExecutionJobs(t *testing.T, ctx context.Context,
 			workspaces := createWorkspaces(t, ctx, s)
 			ids := workspacesIDs(t, workspaces)
 
-			err := s.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, ids)
+			err := s.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, ids, executor.JobPriorityNormal)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1388,6 +1388,283 @@ updated_records AS (
 SELECT COUNT(id) FROM all_matching WHERE all_matching.reconciler_state = %s
 `
 
+// GetChangesetDependency returns the changeset that the given changeset is
+// stacked on: the changeset that is owned by the same batch change, in the
+// same repository, and whose current changeset spec has the given head ref.
+//
+// If no such changeset exists, ErrNoResults is returned.
+func (s *Store) GetChangesetDependency(ctx context.Context, ch *btypes.Changeset, headRef string) (dep *btypes.Changeset, err error) {
+	ctx, _, endObservation := s.operations.getChangesetDependency.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(ch.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getChangesetDependencyQueryFmtstr,
+		sqlf.Join(ChangesetColumns, ", "),
+		ch.ID,
+		ch.RepoID,
+		ch.OwnedByBatchChangeID,
+		headRef,
+	)
+
+	var c btypes.Changeset
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error { return ScanChangeset(&c, sc) })
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ID == 0 {
+		return nil, ErrNoResults
+	}
+	return &c, nil
+}
+
+const getChangesetDependencyQueryFmtstr = `
+SELECT %s FROM changesets
+INNER JOIN repo ON repo.id = changesets.repo_id
+INNER JOIN changeset_specs ON changeset_specs.id = changesets.current_spec_id
+WHERE
+	repo.deleted_at IS NULL
+	AND changesets.id != %s
+	AND changesets.repo_id = %s
+	AND changesets.owned_by_batch_change_id = %s
+	AND changeset_specs.head_ref = %s
+LIMIT 1
+`
+
+// EnqueueChangesetDependents re-enqueues the completed changesets that are
+// stacked on the given changeset, which has the given head ref, so that the
+// reconciler publishes, rebases or retargets them.
+func (s *Store) EnqueueChangesetDependents(ctx context.Context, ch *btypes.Changeset, headRef string) (err error) {
+	ctx, _, endObservation := s.operations.enqueueChangesetDependents.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(ch.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	// Changesets owned by no batch change can't have dependents.
+	if ch.OwnedByBatchChangeID == 0 {
+		return nil
+	}
+
+	q := sqlf.Sprintf(
+		enqueueChangesetDependentsQueryFmtstr,
+		btypes.ReconcilerStateQueued.ToDB(),
+		s.now(),
+		ch.ID,
+		ch.RepoID,
+		ch.OwnedByBatchChangeID,
+		headRef,
+		btypes.ReconcilerStateCompleted.ToDB(),
+	)
+	return s.Exec(ctx, q)
+}
+
+const enqueueChangesetDependentsQueryFmtstr = `
+UPDATE changesets
+SET
+	reconciler_state = %s,
+	num_resets = 0,
+	num_failures = 0,
+	failure_message = NULL,
+	updated_at = %s
+FROM changeset_specs
+WHERE
+	changeset_specs.id = changesets.current_spec_id
+	AND changesets.id != %s
+	AND changesets.repo_id = %s
+	AND changesets.owned_by_batch_change_id = %s
+	AND changeset_specs.depends_on = %s
+	AND changesets.reconciler_state = %s
+`
+
+// ListChangesetsToRebaseOpts captures the query options needed for listing
+// changesets to rebase.
+type ListChangesetsToRebaseOpts struct {
+	LimitOpts
+	// CheckedBefore excludes changesets that have been checked for rebasing
+	// at or after the given time.
+	CheckedBefore time.Time
+	// ExternalServiceTypes restricts the changesets to the given code host
+	// types.
+	ExternalServiceTypes []string
+}
+
+// ListChangesetsToRebase returns the rebase state of the published, open
+// changesets whose branch is pushed by a batch change, least recently checked
+// first. Changesets that are being reconciled, closed, or detached, and
+// changesets that are stacked on another changeset are not included.
+func (s *Store) ListChangesetsToRebase(ctx context.Context, opts ListChangesetsToRebaseOpts) (rs []*btypes.ChangesetRebase, err error) {
+	ctx, _, endObservation := s.operations.listChangesetsToRebase.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		listChangesetsToRebaseQueryFmtstr,
+		btypes.ChangesetPublicationStatePublished,
+		btypes.ChangesetExternalStateOpen,
+		btypes.ChangesetExternalStateDraft,
+		btypes.ReconcilerStateCompleted.ToDB(),
+		btypes.ChangesetSpecTypeBranch,
+		pq.Array(opts.ExternalServiceTypes),
+		opts.CheckedBefore,
+		opts.LimitOpts.ToDB(),
+	)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var r btypes.ChangesetRebase
+		if err := sc.Scan(
+			&r.ChangesetID,
+			&dbutil.NullString{S: &r.BaseRev},
+			&r.Conflicting,
+			&dbutil.NullString{S: &r.TargetRev},
+			&dbutil.NullTime{Time: &r.CheckedAt},
+		); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+	return rs, err
+}
+
+const listChangesetsToRebaseQueryFmtstr = `
+SELECT
+	changesets.id,
+	changesets.rebase_base_rev,
+	changesets.conflicting,
+	changesets.rebase_target_rev,
+	changesets.rebase_checked_at
+FROM changesets
+INNER JOIN changeset_specs ON changeset_specs.id = changesets.current_spec_id
+INNER JOIN repo ON repo.id = changesets.repo_id
+WHERE
+	changesets.publication_state = %s
+	AND changesets.external_state IN (%s, %s)
+	AND changesets.reconciler_state = %s
+	AND changesets.owned_by_batch_change_id IS NOT NULL
+	AND NOT changesets.closing
+	AND changesets.detached_at IS NULL
+	AND changeset_specs.type = %s
+	AND changeset_specs.depends_on IS NULL
+	AND changesets.external_service_type = ANY (%s)
+	AND repo.deleted_at IS NULL
+	AND repo.blocked IS NULL
+	AND (changesets.rebase_checked_at IS NULL OR changesets.rebase_checked_at < %s)
+ORDER BY changesets.rebase_checked_at ASC NULLS FIRST, changesets.id ASC
+%s
+`
+
+// GetChangesetRebase returns the rebase state of the changeset with the given
+// ID.
+func (s *Store) GetChangesetRebase(ctx context.Context, id int64) (r *btypes.ChangesetRebase, err error) {
+	ctx, _, endObservation := s.operations.getChangesetRebase.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(id)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(getChangesetRebaseQueryFmtstr, id)
+
+	r = &btypes.ChangesetRebase{}
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return sc.Scan(
+			&r.ChangesetID,
+			&dbutil.NullString{S: &r.BaseRev},
+			&r.Conflicting,
+			&dbutil.NullString{S: &r.TargetRev},
+			&dbutil.NullTime{Time: &r.CheckedAt},
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.ChangesetID == 0 {
+		return nil, ErrNoResults
+	}
+	return r, nil
+}
+
+const getChangesetRebaseQueryFmtstr = `
+SELECT
+	changesets.id,
+	changesets.rebase_base_rev,
+	changesets.conflicting,
+	changesets.rebase_target_rev,
+	changesets.rebase_checked_at
+FROM changesets
+WHERE changesets.id = %s
+`
+
+// EnqueueChangesetRebase records the TargetRev of the given rebase state on its
+// changeset, sets its CheckedAt to the current time, and enqueues the
+// changeset in the given reconciler state, so that the reconciler rebases it.
+// Changesets that are already being reconciled are left alone.
+func (s *Store) EnqueueChangesetRebase(ctx context.Context, r *btypes.ChangesetRebase, state btypes.ReconcilerState) (err error) {
+	ctx, _, endObservation := s.operations.enqueueChangesetRebase.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(r.ChangesetID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	r.CheckedAt = s.now()
+
+	q := sqlf.Sprintf(
+		enqueueChangesetRebaseQueryFmtstr,
+		state.ToDB(),
+		dbutil.NullStringColumn(r.TargetRev),
+		r.CheckedAt,
+		r.CheckedAt,
+		r.ChangesetID,
+		btypes.ReconcilerStateCompleted.ToDB(),
+	)
+	return s.Exec(ctx, q)
+}
+
+const enqueueChangesetRebaseQueryFmtstr = `
+UPDATE changesets
+SET
+	reconciler_state = %s,
+	num_resets = 0,
+	num_failures = 0,
+	failure_message = NULL,
+	rebase_target_rev = %s,
+	rebase_checked_at = %s,
+	updated_at = %s
+WHERE
+	id = %s
+	AND reconciler_state = %s
+`
+
+// UpdateChangesetRebase records the given rebase state on its changeset and
+// sets its CheckedAt to the current time. A changeset that is conflicting is
+// in the CONFLICTING state while it is open.
+func (s *Store) UpdateChangesetRebase(ctx context.Context, r *btypes.ChangesetRebase) (err error) {
+	ctx, _, endObservation := s.operations.updateChangesetRebase.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(r.ChangesetID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	r.CheckedAt = s.now()
+
+	q := sqlf.Sprintf(
+		updateChangesetRebaseQueryFmtstr,
+		dbutil.NullStringColumn(r.BaseRev),
+		r.Conflicting,
+		dbutil.NullStringColumn(r.TargetRev),
+		r.CheckedAt,
+		r.ChangesetID,
+	)
+	return s.Exec(ctx, q)
+}
+
+const updateChangesetRebaseQueryFmtstr = `
+UPDATE changesets
+SET
+	rebase_base_rev = %s,
+	conflicting = %s,
+	rebase_target_rev = %s,
+	rebase_checked_at = %s
+WHERE id = %s
+`
+
 // jsonBatchChangeChangesetSet represents a "join table" set as a JSONB object
 // where the keys are the ids and the values are json objects holding the properties.
 // It implements the sql.Scanner interface so it can be used as a scan destination,
@@ -1575,6 +1852,7 @@ func (s *Store) GetChangesetsStats(ctx context.Context, batchChangeID int64) (st
 			&stats.Open,
 			&stats.Deleted,
 			&stats.Archived,
+			&stats.Conflicting,
 		); err != nil {
 			return err
 		}
@@ -1599,7 +1877,8 @@ SELECT
 	COUNT(*) FILTER (WHERE NOT %s AND changesets.computed_state = 'MERGED') AS merged,
 	COUNT(*) FILTER (WHERE NOT %s AND changesets.computed_state = 'OPEN') AS open,
 	COUNT(*) FILTER (WHERE NOT %s AND changesets.computed_state = 'DELETED') AS deleted,
-	COUNT(*) FILTER (WHERE %s) AS archived
+	COUNT(*) FILTER (WHERE %s) AS archived,
+	COUNT(*) FILTER (WHERE NOT %s AND changesets.computed_state = 'CONFLICTING') AS conflicting
 FROM changesets
 INNER JOIN repo on repo.id = changesets.repo_id
 WHERE
@@ -1769,7 +2048,7 @@ func getChangesetsStatsQuery(batchChangeID int64) *sqlf.Query {
 		archived, archived,
 		archived, archived,
 		archived, archived,
-		archived,
+		archived, archived,
 		sqlf.Join(preds, " AND "),
 	)
 }
@@ -1789,7 +2068,7 @@ SELECT
 	COUNT(*) FILTER (WHERE computed_state = 'DRAFT') AS draft,
 	COUNT(*) FILTER (WHERE computed_state = 'CLOSED') AS closed,
 	COUNT(*) FILTER (WHERE computed_state = 'MERGED') AS merged,
-	COUNT(*) FILTER (WHERE computed_state = 'OPEN') AS open
+	COUNT(*) FILTER (WHERE computed_state IN ('OPEN', 'CONFLICTING')) AS open
 FROM (
 	SELECT
 		changesets.id,
@@ -1813,7 +2092,7 @@ SELECT
 	COUNT(*) FILTER (WHERE computed_state = 'DRAFT') AS draft,
 	COUNT(*) FILTER (WHERE computed_state = 'CLOSED') AS closed,
 	COUNT(*) FILTER (WHERE computed_state = 'MERGED') AS merged,
-	COUNT(*) FILTER (WHERE computed_state = 'OPEN') AS open
+	COUNT(*) FILTER (WHERE computed_state IN ('OPEN', 'CONFLICTING')) AS open
 FROM (
 	SELECT
 		changesets.id,
@@ -2722,3 +2722,199 @@ func TestCleanDetachedChangesets(t *testing.T) {
 		})
 	}
 }
+
+func testStoreChangesetDependencies(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	logger := logtest.Scoped(t)
+	rs := database.ReposWith(logger, s)
+	es := database.ExternalServicesWith(logger, s)
+
+	repo := bt.TestRepo(t, es, extsvc.KindGitHub)
+	otherRepo := bt.TestRepo(t, es, extsvc.KindGitHub)
+	if err := rs.Create(ctx, repo, otherRepo); err != nil {
+		t.Fatal(err)
+	}
+
+	createChangeset := func(repo api.RepoID, batchChange int64, headRef, dependsOn string, state btypes.ReconcilerState) *btypes.Changeset {
+		spec := bt.CreateChangesetSpec(t, ctx, s, bt.TestSpecOpts{
+			Repo:      repo,
+			HeadRef:   headRef,
+			DependsOn: dependsOn,
+			Typ:       btypes.ChangesetSpecTypeBranch,
+		})
+		return bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			Repo:               repo,
+			CurrentSpec:        spec.ID,
+			OwnedByBatchChange: batchChange,
+			ReconcilerState:    state,
+			PublicationState:   btypes.ChangesetPublicationStatePublished,
+		})
+	}
+
+	library := createChangeset(repo.ID, 1, "refs/heads/bump-library", "", btypes.ReconcilerStateCompleted)
+	callSites := createChangeset(repo.ID, 1, "refs/heads/migrate-call-sites", "refs/heads/bump-library", btypes.ReconcilerStateCompleted)
+	processing := createChangeset(repo.ID, 1, "refs/heads/processing", "refs/heads/bump-library", btypes.ReconcilerStateProcessing)
+	otherBatchChange := createChangeset(repo.ID, 2, "refs/heads/other-batch-change", "refs/heads/bump-library", btypes.ReconcilerStateCompleted)
+	otherRepoChangeset := createChangeset(otherRepo.ID, 1, "refs/heads/other-repo", "refs/heads/bump-library", btypes.ReconcilerStateCompleted)
+
+	t.Run("GetChangesetDependency", func(t *testing.T) {
+		dep, err := s.GetChangesetDependency(ctx, callSites, "refs/heads/bump-library")
+		require.NoError(t, err)
+		assert.Equal(t, library.ID, dep.ID)
+
+		_, err = s.GetChangesetDependency(ctx, otherBatchChange, "refs/heads/bump-library")
+		assert.Equal(t, ErrNoResults, err)
+
+		_, err = s.GetChangesetDependency(ctx, otherRepoChangeset, "refs/heads/bump-library")
+		assert.Equal(t, ErrNoResults, err)
+
+		_, err = s.GetChangesetDependency(ctx, library, "refs/heads/bump-library")
+		assert.Equal(t, ErrNoResults, err)
+	})
+
+	t.Run("EnqueueChangesetDependents", func(t *testing.T) {
+		require.NoError(t, s.EnqueueChangesetDependents(ctx, library, "refs/heads/bump-library"))
+
+		for _, tc := range []struct {
+			changeset *btypes.Changeset
+			want      btypes.ReconcilerState
+		}{
+			{library, btypes.ReconcilerStateCompleted},
+			{callSites, btypes.ReconcilerStateQueued},
+			{processing, btypes.ReconcilerStateProcessing},
+			{otherBatchChange, btypes.ReconcilerStateCompleted},
+			{otherRepoChangeset, btypes.ReconcilerStateCompleted},
+		} {
+			have, err := s.GetChangesetByID(ctx, tc.changeset.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, have.ReconcilerState, "changeset %d", tc.changeset.ID)
+		}
+	})
+}
+
+func testStoreChangesetRebases(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	logger := logtest.Scoped(t)
+	rs := database.ReposWith(logger, s)
+	es := database.ExternalServicesWith(logger, s)
+
+	repo := bt.TestRepo(t, es, extsvc.KindGitHub)
+	if err := rs.Create(ctx, repo); err != nil {
+		t.Fatal(err)
+	}
+
+	createChangeset := func(headRef, dependsOn string, externalState btypes.ChangesetExternalState, closing bool) *btypes.Changeset {
+		spec := bt.CreateChangesetSpec(t, ctx, s, bt.TestSpecOpts{
+			Repo:      repo.ID,
+			HeadRef:   headRef,
+			DependsOn: dependsOn,
+			Typ:       btypes.ChangesetSpecTypeBranch,
+		})
+		return bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			Repo:               repo.ID,
+			CurrentSpec:        spec.ID,
+			OwnedByBatchChange: 1,
+			ReconcilerState:    btypes.ReconcilerStateCompleted,
+			PublicationState:   btypes.ChangesetPublicationStatePublished,
+			ExternalState:      externalState,
+			Closing:            closing,
+		})
+	}
+
+	open := createChangeset("refs/heads/open", "", btypes.ChangesetExternalStateOpen, false)
+	draft := createChangeset("refs/heads/draft", "", btypes.ChangesetExternalStateDraft, false)
+	createChangeset("refs/heads/merged", "", btypes.ChangesetExternalStateMerged, false)
+	createChangeset("refs/heads/closing", "", btypes.ChangesetExternalStateOpen, true)
+	createChangeset("refs/heads/stacked", "refs/heads/open", btypes.ChangesetExternalStateOpen, false)
+
+	list := func(t *testing.T, types ...string) []int64 {
+		t.Helper()
+
+		rebases, err := s.ListChangesetsToRebase(ctx, ListChangesetsToRebaseOpts{
+			CheckedBefore:        clock.Now(),
+			ExternalServiceTypes: types,
+		})
+		require.NoError(t, err)
+
+		ids := []int64{}
+		for _, r := range rebases {
+			ids = append(ids, r.ChangesetID)
+		}
+		return ids
+	}
+
+	t.Run("ListChangesetsToRebase", func(t *testing.T) {
+		assert.Equal(t, []int64{open.ID, draft.ID}, list(t, extsvc.TypeGitHub))
+		assert.Equal(t, []int64{}, list(t, extsvc.TypeGitLab))
+	})
+
+	t.Run("UpdateChangesetRebase", func(t *testing.T) {
+		require.NoError(t, s.UpdateChangesetRebase(ctx, &btypes.ChangesetRebase{
+			ChangesetID: open.ID,
+			BaseRev:     "deadbeef",
+			Conflicting: true,
+		}))
+
+		have, err := s.GetChangesetByID(ctx, open.ID)
+		require.NoError(t, err)
+		assert.Equal(t, btypes.ChangesetStateConflicting, have.State)
+
+		// Checked changesets come last, and aren't listed again until they're
+		// due.
+		clock.Add(1 * time.Second)
+		assert.Equal(t, []int64{draft.ID, open.ID}, list(t, extsvc.TypeGitHub))
+
+		rebases, err := s.ListChangesetsToRebase(ctx, ListChangesetsToRebaseOpts{
+			CheckedBefore:        clock.Now().Add(-1 * time.Minute),
+			ExternalServiceTypes: []string{extsvc.TypeGitHub},
+		})
+		require.NoError(t, err)
+		require.Len(t, rebases, 1)
+		assert.Equal(t, draft.ID, rebases[0].ChangesetID)
+
+		// Resetting the rebase state makes the changeset open again.
+		require.NoError(t, s.UpdateChangesetRebase(ctx, &btypes.ChangesetRebase{ChangesetID: open.ID}))
+		have, err = s.GetChangesetByID(ctx, open.ID)
+		require.NoError(t, err)
+		assert.Equal(t, btypes.ChangesetStateOpen, have.State)
+	})
+
+	t.Run("EnqueueChangesetRebase", func(t *testing.T) {
+		require.NoError(t, s.EnqueueChangesetRebase(ctx, &btypes.ChangesetRebase{
+			ChangesetID: draft.ID,
+			TargetRev:   "deadbeef",
+		}, btypes.ReconcilerStateScheduled))
+
+		have, err := s.GetChangesetByID(ctx, draft.ID)
+		require.NoError(t, err)
+		assert.Equal(t, btypes.ReconcilerStateScheduled, have.ReconcilerState)
+
+		rebase, err := s.GetChangesetRebase(ctx, draft.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "deadbeef", rebase.TargetRev)
+
+		// Changesets that are being reconciled aren't listed, nor enqueued
+		// again.
+		clock.Add(1 * time.Second)
+		assert.Equal(t, []int64{open.ID}, list(t, extsvc.TypeGitHub))
+
+		require.NoError(t, s.EnqueueChangesetRebase(ctx, &btypes.ChangesetRebase{
+			ChangesetID: draft.ID,
+			TargetRev:   "f00dface",
+		}, btypes.ReconcilerStateQueued))
+		rebase, err = s.GetChangesetRebase(ctx, draft.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "deadbeef", rebase.TargetRev)
+
+		// Recording the outcome of the rebase clears the target.
+		require.NoError(t, s.UpdateChangesetRebase(ctx, &btypes.ChangesetRebase{
+			ChangesetID: draft.ID,
+			BaseRev:     "deadbeef",
+		}))
+		rebase, err = s.GetChangesetRebase(ctx, draft.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "deadbeef", rebase.BaseRev)
+		assert.Equal(t, "", rebase.TargetRev)
+
+		_, err = s.GetChangesetRebase(ctx, 0)
+		assert.Equal(t, ErrNoResults, err)
+	})
+}
@@ -23,6 +23,9 @@ func TestIntegration(t *testing.T) {
 		t.Run("Changesets", storeTest(db, nil, testStoreChangesets))
 		t.Run("ChangesetEvents", storeTest(db, nil, testStoreChangesetEvents))
 		t.Run("ChangesetScheduling", storeTest(db, nil, testStoreChangesetScheduling))
+		t.Run("ChangesetDependencies", storeTest(db, nil, testStoreChangesetDependencies))
+		t.Run("ChangesetRebases", storeTest(db, nil, testStoreChangesetRebases))
+		t.Run("BatchChangeMergeQueues", storeTest(db, nil, testStoreBatchChangeMergeQueues))
 		t.Run("ListChangesetSyncData", storeTest(db, nil, testStoreListChangesetSyncData))
 		t.Run("ListChangesetsTextSearch", storeTest(db, nil, testStoreListChangesetsTextSearch))
 		t.Run("BatchSpecs", storeTest(db, nil, testStoreBatchSpecs))
@@ -0,0 +1,270 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+var batchChangeMergeQueueColumns = []*sqlf.Query{
+	sqlf.Sprintf("batch_changes_merge_queues.batch_change_id"),
+	sqlf.Sprintf("batch_changes_merge_queues.user_id"),
+	sqlf.Sprintf("batch_changes_merge_queues.squash"),
+	sqlf.Sprintf("batch_changes_merge_queues.max_merges"),
+	sqlf.Sprintf("batch_changes_merge_queues.window_seconds"),
+	sqlf.Sprintf("batch_changes_merge_queues.paused_reason"),
+	sqlf.Sprintf("batch_changes_merge_queues.created_at"),
+	sqlf.Sprintf("batch_changes_merge_queues.updated_at"),
+}
+
+// UpsertBatchChangeMergeQueue enables the merge queue of a batch change, or
+// updates its settings if it is already enabled. Whether the merge queue is
+// paused is reevaluated on the next run of the merge queue processor.
+func (s *Store) UpsertBatchChangeMergeQueue(ctx context.Context, q *btypes.BatchChangeMergeQueue) (err error) {
+	ctx, _, endObservation := s.operations.upsertBatchChangeMergeQueue.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(q.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if q.CreatedAt.IsZero() {
+		q.CreatedAt = s.now()
+	}
+	q.UpdatedAt = s.now()
+
+	return s.query(ctx, upsertBatchChangeMergeQueueQuery(q), func(sc dbutil.Scanner) error {
+		return scanBatchChangeMergeQueue(q, sc)
+	})
+}
+
+var upsertBatchChangeMergeQueueQueryFmtstr = `
+INSERT INTO batch_changes_merge_queues (
+	batch_change_id,
+	user_id,
+	squash,
+	max_merges,
+	window_seconds,
+	paused_reason,
+	created_at,
+	updated_at
+)
+VALUES (%s, %s, %s, %s, %s, NULL, %s, %s)
+ON CONFLICT (batch_change_id) DO UPDATE SET
+	user_id = EXCLUDED.user_id,
+	squash = EXCLUDED.squash,
+	max_merges = EXCLUDED.max_merges,
+	window_seconds = EXCLUDED.window_seconds,
+	paused_reason = NULL,
+	updated_at = EXCLUDED.updated_at
+RETURNING %s
+`
+
+func upsertBatchChangeMergeQueueQuery(q *btypes.BatchChangeMergeQueue) *sqlf.Query {
+	return sqlf.Sprintf(
+		upsertBatchChangeMergeQueueQueryFmtstr,
+		q.BatchChangeID,
+		q.UserID,
+		q.Squash,
+		q.MaxMerges,
+		int64(q.Window/time.Second),
+		q.CreatedAt,
+		q.UpdatedAt,
+		sqlf.Join(batchChangeMergeQueueColumns, ", "),
+	)
+}
+
+// GetBatchChangeMergeQueue returns the merge queue of the given batch change,
+// or ErrNoResults if it is not enabled.
+func (s *Store) GetBatchChangeMergeQueue(ctx context.Context, batchChangeID int64) (q *btypes.BatchChangeMergeQueue, err error) {
+	ctx, _, endObservation := s.operations.getBatchChangeMergeQueue.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	var queue btypes.BatchChangeMergeQueue
+	err = s.query(ctx, getBatchChangeMergeQueueQuery(batchChangeID), func(sc dbutil.Scanner) error {
+		return scanBatchChangeMergeQueue(&queue, sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if queue.BatchChangeID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &queue, nil
+}
+
+var getBatchChangeMergeQueueQueryFmtstr = `
+SELECT %s FROM batch_changes_merge_queues
+WHERE batch_change_id = %s
+`
+
+func getBatchChangeMergeQueueQuery(batchChangeID int64) *sqlf.Query {
+	return sqlf.Sprintf(
+		getBatchChangeMergeQueueQueryFmtstr,
+		sqlf.Join(batchChangeMergeQueueColumns, ", "),
+		batchChangeID,
+	)
+}
+
+// ListBatchChangeMergeQueues returns the merge queues of all batch changes that
+// are not closed.
+func (s *Store) ListBatchChangeMergeQueues(ctx context.Context) (qs []*btypes.BatchChangeMergeQueue, err error) {
+	ctx, _, endObservation := s.operations.listBatchChangeMergeQueues.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	err = s.query(ctx, listBatchChangeMergeQueuesQuery(), func(sc dbutil.Scanner) error {
+		var q btypes.BatchChangeMergeQueue
+		if err := scanBatchChangeMergeQueue(&q, sc); err != nil {
+			return err
+		}
+		qs = append(qs, &q)
+		return nil
+	})
+
+	return qs, err
+}
+
+var listBatchChangeMergeQueuesQueryFmtstr = `
+SELECT %s FROM batch_changes_merge_queues
+INNER JOIN batch_changes ON batch_changes.id = batch_changes_merge_queues.batch_change_id
+WHERE batch_changes.closed_at IS NULL
+ORDER BY batch_changes_merge_queues.batch_change_id ASC
+`
+
+func listBatchChangeMergeQueuesQuery() *sqlf.Query {
+	return sqlf.Sprintf(
+		listBatchChangeMergeQueuesQueryFmtstr,
+		sqlf.Join(batchChangeMergeQueueColumns, ", "),
+	)
+}
+
+// UpdateBatchChangeMergeQueuePausedReason sets the reason why the merge queue
+// of a batch change is paused. An empty reason resumes the merge queue.
+func (s *Store) UpdateBatchChangeMergeQueuePausedReason(ctx context.Context, q *btypes.BatchChangeMergeQueue) (err error) {
+	ctx, _, endObservation := s.operations.updateBatchChangeMergeQueuePausedReason.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(q.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q.UpdatedAt = s.now()
+
+	return s.Exec(ctx, sqlf.Sprintf(
+		updateBatchChangeMergeQueuePausedReasonQueryFmtstr,
+		dbutil.NewNullString(q.PausedReason),
+		q.UpdatedAt,
+		q.BatchChangeID,
+	))
+}
+
+var updateBatchChangeMergeQueuePausedReasonQueryFmtstr = `
+UPDATE batch_changes_merge_queues
+SET paused_reason = %s, updated_at = %s
+WHERE batch_change_id = %s
+`
+
+// DeleteBatchChangeMergeQueue disables the merge queue of the given batch
+// change. It returns ErrNoResults if it wasn't enabled.
+func (s *Store) DeleteBatchChangeMergeQueue(ctx context.Context, batchChangeID int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteBatchChangeMergeQueue.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	res, err := s.ExecResult(ctx, sqlf.Sprintf(deleteBatchChangeMergeQueueQueryFmtstr, batchChangeID))
+	if err != nil {
+		return err
+	}
+
+	if rows, err := res.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return ErrNoResults
+	}
+	return nil
+}
+
+var deleteBatchChangeMergeQueueQueryFmtstr = `
+DELETE FROM batch_changes_merge_queues
+WHERE batch_change_id = %s
+`
+
+// CountBatchChangeMerges returns the number of merge jobs that were created for
+// changesets of the given batch change since the given time and have not
+// failed.
+func (s *Store) CountBatchChangeMerges(ctx context.Context, batchChangeID int64, since time.Time) (count int, err error) {
+	ctx, _, endObservation := s.operations.countBatchChangeMerges.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.queryCount(ctx, sqlf.Sprintf(
+		countBatchChangeMergesQueryFmtstr,
+		batchChangeID,
+		btypes.ChangesetJobTypeMerge,
+		since,
+		btypes.ChangesetJobStateFailed.ToDB(),
+	))
+}
+
+var countBatchChangeMergesQueryFmtstr = `
+SELECT COUNT(*) FROM changeset_jobs
+WHERE
+	batch_change_id = %s AND
+	job_type = %s AND
+	created_at >= %s AND
+	state != %s
+`
+
+// ListBatchChangeMergingChangesetIDs returns the IDs of the changesets of the
+// given batch change for which a merge job is queued or running.
+func (s *Store) ListBatchChangeMergingChangesetIDs(ctx context.Context, batchChangeID int64) (ids []int64, err error) {
+	ctx, _, endObservation := s.operations.listBatchChangeMergingChangesetIDs.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return basestore.ScanInt64s(s.Query(ctx, sqlf.Sprintf(
+		listBatchChangeMergingChangesetIDsQueryFmtstr,
+		batchChangeID,
+		btypes.ChangesetJobTypeMerge,
+		btypes.ChangesetJobStateQueued.ToDB(),
+		btypes.ChangesetJobStateProcessing.ToDB(),
+		btypes.ChangesetJobStateErrored.ToDB(),
+	)))
+}
+
+var listBatchChangeMergingChangesetIDsQueryFmtstr = `
+SELECT DISTINCT changeset_id FROM changeset_jobs
+WHERE
+	batch_change_id = %s AND
+	job_type = %s AND
+	state IN (%s, %s, %s)
+ORDER BY changeset_id ASC
+`
+
+func scanBatchChangeMergeQueue(q *btypes.BatchChangeMergeQueue, sc dbutil.Scanner) error {
+	var windowSeconds int64
+	if err := sc.Scan(
+		&q.BatchChangeID,
+		&q.UserID,
+		&q.Squash,
+		&q.MaxMerges,
+		&windowSeconds,
+		&dbutil.NullString{S: &q.PausedReason},
+		&q.CreatedAt,
+		&q.UpdatedAt,
+	); err != nil {
+		return err
+	}
+	q.Window = time.Duration(windowSeconds) * time.Second
+	return nil
+}
@@ -0,0 +1,162 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+)
+
+func testStoreBatchChangeMergeQueues(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	logger := logtest.Scoped(t)
+	rs := database.ReposWith(logger, s)
+	es := database.ExternalServicesWith(logger, s)
+
+	user := bt.CreateTestUser(t, s.DatabaseDB(), false)
+	spec := bt.CreateBatchSpec(t, ctx, s, "merge-queue", user.ID, 0)
+	batchChange := bt.CreateBatchChange(t, ctx, s, "merge-queue", user.ID, spec.ID)
+	closedBatchChange := bt.CreateBatchChange(t, ctx, s, "merge-queue-closed", user.ID, spec.ID)
+	closedBatchChange.ClosedAt = clock.Now()
+	if err := s.UpdateBatchChange(ctx, closedBatchChange); err != nil {
+		t.Fatal(err)
+	}
+
+	queue := &btypes.BatchChangeMergeQueue{
+		BatchChangeID: batchChange.ID,
+		UserID:        user.ID,
+		MaxMerges:     2,
+		Window:        time.Hour,
+	}
+
+	t.Run("Get not enabled", func(t *testing.T) {
+		if _, err := s.GetBatchChangeMergeQueue(ctx, batchChange.ID); err != ErrNoResults {
+			t.Fatalf("unexpected error: want=%v have=%v", ErrNoResults, err)
+		}
+	})
+
+	t.Run("Upsert", func(t *testing.T) {
+		if err := s.UpsertBatchChangeMergeQueue(ctx, queue); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.UpsertBatchChangeMergeQueue(ctx, &btypes.BatchChangeMergeQueue{
+			BatchChangeID: closedBatchChange.ID,
+			UserID:        user.ID,
+			MaxMerges:     1,
+			Window:        time.Minute,
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		have, err := s.GetBatchChangeMergeQueue(ctx, batchChange.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(queue, have); diff != "" {
+			t.Fatalf("invalid merge queue: %s", diff)
+		}
+	})
+
+	t.Run("UpdatePausedReason", func(t *testing.T) {
+		queue.PausedReason = "checks on main are failing"
+		if err := s.UpdateBatchChangeMergeQueuePausedReason(ctx, queue); err != nil {
+			t.Fatal(err)
+		}
+
+		have, err := s.GetBatchChangeMergeQueue(ctx, batchChange.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have.PausedReason != queue.PausedReason {
+			t.Fatalf("invalid paused reason: want=%q have=%q", queue.PausedReason, have.PausedReason)
+		}
+
+		// Updating the settings resumes the merge queue.
+		queue.MaxMerges = 3
+		if err := s.UpsertBatchChangeMergeQueue(ctx, queue); err != nil {
+			t.Fatal(err)
+		}
+		if queue.PausedReason != "" || queue.MaxMerges != 3 {
+			t.Fatalf("merge queue not updated: %+v", queue)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		have, err := s.ListBatchChangeMergeQueues(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(have) != 1 || have[0].BatchChangeID != batchChange.ID {
+			t.Fatalf("expected only the merge queue of the open batch change, have=%+v", have)
+		}
+	})
+
+	t.Run("Merges", func(t *testing.T) {
+		repo := bt.TestRepo(t, es, extsvc.KindGitHub)
+		if err := rs.Create(ctx, repo); err != nil {
+			t.Fatal(err)
+		}
+		merging := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{Repo: repo.ID, BatchChange: batchChange.ID})
+		merged := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{Repo: repo.ID, BatchChange: batchChange.ID})
+		failed := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{Repo: repo.ID, BatchChange: batchChange.ID})
+		commented := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{Repo: repo.ID, BatchChange: batchChange.ID})
+
+		job := func(ch *btypes.Changeset, typ btypes.ChangesetJobType, state btypes.ChangesetJobState) *btypes.ChangesetJob {
+			return &btypes.ChangesetJob{
+				BulkGroup:     "merge-queue",
+				BatchChangeID: batchChange.ID,
+				UserID:        user.ID,
+				ChangesetID:   ch.ID,
+				JobType:       typ,
+				State:         state,
+			}
+		}
+		if err := s.CreateChangesetJob(ctx,
+			job(merging, btypes.ChangesetJobTypeMerge, btypes.ChangesetJobStateQueued),
+			job(merged, btypes.ChangesetJobTypeMerge, btypes.ChangesetJobStateCompleted),
+			job(failed, btypes.ChangesetJobTypeMerge, btypes.ChangesetJobStateFailed),
+			job(commented, btypes.ChangesetJobTypeComment, btypes.ChangesetJobStateQueued),
+		); err != nil {
+			t.Fatal(err)
+		}
+
+		count, err := s.CountBatchChangeMerges(ctx, batchChange.ID, clock.Now().Add(-time.Minute))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 2 {
+			t.Fatalf("invalid merge count: want=%d have=%d", 2, count)
+		}
+
+		count, err = s.CountBatchChangeMerges(ctx, batchChange.ID, clock.Now().Add(time.Minute))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 0 {
+			t.Fatalf("invalid merge count: want=%d have=%d", 0, count)
+		}
+
+		ids, err := s.ListBatchChangeMergingChangesetIDs(ctx, batchChange.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]int64{merging.ID}, ids); diff != "" {
+			t.Fatalf("invalid merging changesets: %s", diff)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		if err := s.DeleteBatchChangeMergeQueue(ctx, batchChange.ID); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.DeleteBatchChangeMergeQueue(ctx, batchChange.ID); err != ErrNoResults {
+			t.Fatalf("unexpected error: want=%v have=%v", ErrNoResults, err)
+		}
+	})
+}
@@ -249,6 +249,12 @@ type operations struct {
 	getChangesetExternalIDs           *observation.Operation
 	cancelQueuedBatchChangeChangesets *observation.Operation
 	enqueueChangesetsToClose          *observation.Operation
+	getChangesetDependency            *observation.Operation
+	enqueueChangesetDependents        *observation.Operation
+	listChangesetsToRebase            *observation.Operation
+	getChangesetRebase                *observation.Operation
+	enqueueChangesetRebase            *observation.Operation
+	updateChangesetRebase             *observation.Operation
 	getChangesetsStats                *observation.Operation
 	getRepoChangesetsStats            *observation.Operation
 	getGlobalChangesetsStats          *observation.Operation
@@ -256,6 +262,14 @@ type operations struct {
 	getChangesetPlaceInSchedulerQueue *observation.Operation
 	cleanDetachedChangesets           *observation.Operation
 
+	upsertBatchChangeMergeQueue             *observation.Operation
+	getBatchChangeMergeQueue                *observation.Operation
+	listBatchChangeMergeQueues              *observation.Operation
+	updateBatchChangeMergeQueuePausedReason *observation.Operation
+	deleteBatchChangeMergeQueue             *observation.Operation
+	countBatchChangeMerges                  *observation.Operation
+	listBatchChangeMergingChangesetIDs      *observation.Operation
+
 	listCodeHosts         *observation.Operation
 	getExternalServiceIDs *observation.Operation
 
@@ -394,6 +408,12 @@ func newOperations(observationCtx *observation.Context) *operations {
 			getChangesetExternalIDs:           op("GetChangesetExternalIDs"),
 			cancelQueuedBatchChangeChangesets: op("CancelQueuedBatchChangeChangesets"),
 			enqueueChangesetsToClose:          op("EnqueueChangesetsToClose"),
+			getChangesetDependency:            op("GetChangesetDependency"),
+			enqueueChangesetDependents:        op("EnqueueChangesetDependents"),
+			listChangesetsToRebase:            op("ListChangesetsToRebase"),
+			getChangesetRebase:                op("GetChangesetRebase"),
+			enqueueChangesetRebase:            op("EnqueueChangesetRebase"),
+			updateChangesetRebase:             op("UpdateChangesetRebase"),
 			getChangesetsStats:                op("GetChangesetsStats"),
 			getRepoChangesetsStats:            op("GetRepoChangesetsStats"),
 			getGlobalChangesetsStats:          op("GetGlobalChangesetsStats"),
@@ -401,6 +421,14 @@ func newOperations(observationCtx *observation.Context) *operations {
 			getChangesetPlaceInSchedulerQueue: op("GetChangesetPlaceInSchedulerQueue"),
 			cleanDetachedChangesets:           op("CleanDetachedChangesets"),
 
+			upsertBatchChangeMergeQueue:             op("UpsertBatchChangeMergeQueue"),
+			getBatchChangeMergeQueue:                op("GetBatchChangeMergeQueue"),
+			listBatchChangeMergeQueues:              op("ListBatchChangeMergeQueues"),
+			updateBatchChangeMergeQueuePausedReason: op("UpdateBatchChangeMergeQueuePausedReason"),
+			deleteBatchChangeMergeQueue:             op("DeleteBatchChangeMergeQueue"),
+			countBatchChangeMerges:                  op("CountBatchChangeMerges"),
+			listBatchChangeMergingChangesetIDs:      op("ListBatchChangeMergingChangesetIDs"),
+
 			listCodeHosts:         op("ListCodeHosts"),
 			getExternalServiceIDs: op("GetExternalServiceIDs"),
 
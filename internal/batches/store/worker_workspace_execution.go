@@ -16,6 +16,7 @@ import (
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/executor"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
@@ -37,6 +38,11 @@ const batchSpecWorkspaceExecutionJobStalledJobMaximumAge = time.Second * 25
 // reset.
 const batchSpecWorkspaceExecutionJobMaximumNumResets = 3
 
+// batchSpecWorkspaceExecutionJobMaximumConcurrencyPerRepository is the maximum number of
+// jobs of the same repository that are processed at the same time, so that a single
+// monorepo cannot occupy all executors.
+var batchSpecWorkspaceExecutionJobMaximumConcurrencyPerRepository = env.MustGetInt("BATCH_CHANGES_EXECUTOR_MAXIMUM_CONCURRENT_JOBS_PER_REPOSITORY", 0, "The maximum number of batch spec workspace execution jobs of a single repository processed at the same time. Zero means no limit.")
+
 var batchSpecWorkspaceExecutionWorkerStoreOptions = dbworkerstore.Options[*btypes.BatchSpecWorkspaceExecutionJob]{
 	Name:              "batch_spec_workspace_execution_worker_store",
 	TableName:         "batch_spec_workspace_execution_jobs",
@@ -51,6 +57,15 @@ var batchSpecWorkspaceExecutionWorkerStoreOptions = dbworkerstore.Options[*btype
 	// This view ranks jobs from different users in a round-robin fashion
 	// so that no single user can clog the queue.
 	ViewName: "batch_spec_workspace_execution_jobs_with_rank batch_spec_workspace_execution_jobs",
+
+	// Retried workspaces a user is waiting for go before all other jobs.
+	PriorityExpression: sqlf.Sprintf("batch_spec_workspace_execution_jobs.priority"),
+
+	// Users with fewer jobs being processed go first, so that a single large
+	// batch change cannot occupy all executors.
+	FairShareExpression:      sqlf.Sprintf("batch_spec_workspace_execution_jobs.user_id"),
+	ConcurrencyKeyExpression: sqlf.Sprintf("(SELECT repo_id FROM batch_spec_workspaces WHERE batch_spec_workspaces.id = batch_spec_workspace_execution_jobs.batch_spec_workspace_id)"),
+	MaxConcurrencyPerKey:     batchSpecWorkspaceExecutionJobMaximumConcurrencyPerRepository,
 }
 
 // NewBatchSpecWorkspaceExecutionWorkerStore creates a dbworker store that
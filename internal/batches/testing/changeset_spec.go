@@ -25,6 +25,10 @@ type TestSpecOpts struct {
 	// branch" changeset spec.
 	HeadRef string
 
+	// If this is set along with headRef, the changesetSpec will be stacked on
+	// the changeset with this head ref.
+	DependsOn string
+
 	// If this is set along with headRef, the changesetSpec will have Published
 	// set.
 	Published any
@@ -65,6 +69,7 @@ func BuildChangesetSpec(t *testing.T, opts TestSpecOpts) *btypes.ChangesetSpec {
 		BaseRef:           opts.BaseRef,
 		ExternalID:        opts.ExternalID,
 		HeadRef:           opts.HeadRef,
+		DependsOn:         opts.DependsOn,
 		Published:         published,
 		Title:             opts.Title,
 		Body:              opts.Body,
@@ -46,6 +46,7 @@ const (
 	ChangesetStateReadOnly    ChangesetState = "READONLY"
 	ChangesetStateRetrying    ChangesetState = "RETRYING"
 	ChangesetStateFailed      ChangesetState = "FAILED"
+	ChangesetStateConflicting ChangesetState = "CONFLICTING"
 )
 
 // Valid returns true if the given ChangesetState is valid.
@@ -61,7 +62,8 @@ func (s ChangesetState) Valid() bool {
 		ChangesetStateDeleted,
 		ChangesetStateReadOnly,
 		ChangesetStateRetrying,
-		ChangesetStateFailed:
+		ChangesetStateFailed,
+		ChangesetStateConflicting:
 		return true
 	default:
 		return false
@@ -1220,12 +1222,13 @@ type GlobalChangesetsStats struct {
 // ChangesetsStats holds additional stats information on a list of changesets.
 type ChangesetsStats struct {
 	CommonChangesetsStats
-	Retrying   int32
-	Failed     int32
-	Scheduled  int32
-	Processing int32
-	Deleted    int32
-	Archived   int32
+	Retrying    int32
+	Failed      int32
+	Scheduled   int32
+	Processing  int32
+	Deleted     int32
+	Archived    int32
+	Conflicting int32
 }
 
 // ChangesetEventKindFor returns the ChangesetEventKind for the given
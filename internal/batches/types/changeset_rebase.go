@@ -0,0 +1,21 @@
+package types
+
+import "time"
+
+// ChangesetRebase represents the state of automatically rebasing a published,
+// open changeset onto the latest commit of its base ref.
+type ChangesetRebase struct {
+	ChangesetID int64
+	// BaseRev is the commit that the changeset was last rebased onto, or
+	// failed to be rebased onto. It is empty if the reconciler pushed the
+	// commit of the changeset since.
+	BaseRev string
+	// Conflicting is true if the diff of the changeset didn't apply cleanly on
+	// top of BaseRev.
+	Conflicting bool
+	// TargetRev is the commit that the reconciler is to rebase the changeset
+	// onto. It is empty unless a rebase is pending.
+	TargetRev string
+	// CheckedAt is the time the changeset was last checked for rebasing.
+	CheckedAt time.Time
+}
@@ -69,6 +69,7 @@ func NewChangesetSpecFromSpec(spec *batcheslib.ChangesetSpec) (*ChangesetSpec, e
 		c.Type = ChangesetSpecTypeBranch
 		c.Diff = diff
 		c.HeadRef = spec.HeadRef
+		c.DependsOn = spec.DependsOn
 		c.BaseRev = spec.BaseRev
 		c.BaseRef = spec.BaseRef
 		c.CommitMessage = commitMsg
@@ -103,10 +104,13 @@ type ChangesetSpec struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
-	ExternalID        string
-	BaseRev           string
-	BaseRef           string
-	HeadRef           string
+	ExternalID string
+	BaseRev    string
+	BaseRef    string
+	HeadRef    string
+	// DependsOn is the head ref of the changeset in the same repository and
+	// batch change that this changeset is stacked on, if any.
+	DependsOn         string
 	Title             string
 	Body              string
 	Published         batcheslib.PublishedValue
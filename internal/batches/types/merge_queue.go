@@ -0,0 +1,53 @@
+package types
+
+import "time"
+
+// BatchChangeMergeQueue is the merge queue of a batch change. While it is
+// enabled, the changesets of the batch change are merged automatically once
+// their checks passed and they have been approved.
+type BatchChangeMergeQueue struct {
+	BatchChangeID int64
+	// UserID is the user that enabled the merge queue. Changesets are merged
+	// with their credentials.
+	UserID int32
+	Squash bool
+
+	// MaxMerges is the maximum number of changesets that are merged within
+	// Window.
+	MaxMerges int32
+	Window    time.Duration
+
+	// PausedReason is set when no changesets are merged at the moment, e.g.
+	// because the checks on the base branch of a changeset are failing.
+	PausedReason string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Paused returns whether merging changesets is paused.
+func (q *BatchChangeMergeQueue) Paused() bool { return q.PausedReason != "" }
+
+// MergeQueueEntryState defines the possible states of a changeset in a merge
+// queue.
+type MergeQueueEntryState string
+
+// MergeQueueEntryState constants.
+const (
+	// MergeQueueEntryStateBlocked means the changeset cannot be merged yet; the
+	// entry has a reason.
+	MergeQueueEntryStateBlocked MergeQueueEntryState = "BLOCKED"
+	// MergeQueueEntryStateReady means the changeset is merged as soon as the
+	// merge queue has capacity.
+	MergeQueueEntryStateReady MergeQueueEntryState = "READY"
+	// MergeQueueEntryStateMerging means a merge job has been created for the
+	// changeset.
+	MergeQueueEntryStateMerging MergeQueueEntryState = "MERGING"
+)
+
+// MergeQueueEntry is a changeset in the merge queue of a batch change.
+type MergeQueueEntry struct {
+	Changeset     *Changeset
+	State         MergeQueueEntryState
+	BlockedReason string
+}
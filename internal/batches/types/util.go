@@ -13,6 +13,14 @@ type CodehostCapability string
 const (
 	CodehostCapabilityLabels          CodehostCapability = "Labels"
 	CodehostCapabilityDraftChangesets CodehostCapability = "DraftChangesets"
+	// CodehostCapabilityStackedChangesets means that a changeset can be opened
+	// against the branch of another changeset, which the code host then
+	// displays as its base.
+	CodehostCapabilityStackedChangesets CodehostCapability = "StackedChangesets"
+	// CodehostCapabilityAutomaticRebase means that the commit of a changeset
+	// can be replaced with a force-push, so that it can be rebased onto the
+	// latest commit of its base branch.
+	CodehostCapabilityAutomaticRebase CodehostCapability = "AutomaticRebase"
 )
 
 type CodehostCapabilities map[CodehostCapability]bool
@@ -23,11 +31,11 @@ type CodehostCapabilities map[CodehostCapability]bool
 // results.
 func GetSupportedExternalServices() map[string]CodehostCapabilities {
 	supportedExternalServices := map[string]CodehostCapabilities{
-		extsvc.TypeGitHub:          {CodehostCapabilityLabels: true, CodehostCapabilityDraftChangesets: true},
-		extsvc.TypeBitbucketServer: {},
-		extsvc.TypeGitLab:          {CodehostCapabilityLabels: true, CodehostCapabilityDraftChangesets: true},
-		extsvc.TypeBitbucketCloud:  {},
-		extsvc.TypeAzureDevOps:     {CodehostCapabilityDraftChangesets: true},
+		extsvc.TypeGitHub:          {CodehostCapabilityLabels: true, CodehostCapabilityDraftChangesets: true, CodehostCapabilityStackedChangesets: true, CodehostCapabilityAutomaticRebase: true},
+		extsvc.TypeBitbucketServer: {CodehostCapabilityStackedChangesets: true, CodehostCapabilityAutomaticRebase: true},
+		extsvc.TypeGitLab:          {CodehostCapabilityLabels: true, CodehostCapabilityDraftChangesets: true, CodehostCapabilityStackedChangesets: true, CodehostCapabilityAutomaticRebase: true},
+		extsvc.TypeBitbucketCloud:  {CodehostCapabilityStackedChangesets: true, CodehostCapabilityAutomaticRebase: true},
+		extsvc.TypeAzureDevOps:     {CodehostCapabilityDraftChangesets: true, CodehostCapabilityStackedChangesets: true, CodehostCapabilityAutomaticRebase: true},
 		extsvc.TypeGerrit:          {CodehostCapabilityDraftChangesets: true},
 	}
 	if c := conf.Get(); c.ExperimentalFeatures != nil && c.ExperimentalFeatures.BatchChangesEnablePerforce {
@@ -4501,6 +4501,9 @@ type MockWorkerStore[T workerutil.Record] struct {
 	// DequeueFunc is an instance of a mock function object controlling the
 	// behavior of the method Dequeue.
 	DequeueFunc *WorkerStoreDequeueFunc[T]
+	// ExecutionLogsWritableFunc is an instance of a mock function object
+	// controlling the behavior of the method ExecutionLogsWritable.
+	ExecutionLogsWritableFunc *WorkerStoreExecutionLogsWritableFunc[T]
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
 	HandleFunc *WorkerStoreHandleFunc[T]
@@ -4522,6 +4525,9 @@ type MockWorkerStore[T workerutil.Record] struct {
 	// QueuedCountFunc is an instance of a mock function object controlling
 	// the behavior of the method QueuedCount.
 	QueuedCountFunc *WorkerStoreQueuedCountFunc[T]
+	// QueuedCountByTenantFunc is an instance of a mock function object
+	// controlling the behavior of the method QueuedCountByTenant.
+	QueuedCountByTenantFunc *WorkerStoreQueuedCountByTenantFunc[T]
 	// RequeueFunc is an instance of a mock function object controlling the
 	// behavior of the method Requeue.
 	RequeueFunc *WorkerStoreRequeueFunc[T]
@@ -4550,6 +4556,11 @@ func NewMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				return
 			},
 		},
+		ExecutionLogsWritableFunc: &WorkerStoreExecutionLogsWritableFunc[T]{
+			defaultHook: func(context.Context, int, store1.ExecutionLogEntryOptions) (r0 bool, r1 error) {
+				return
+			},
+		},
 		HandleFunc: &WorkerStoreHandleFunc[T]{
 			defaultHook: func() (r0 basestore.TransactableHandle) {
 				return
@@ -4585,6 +4596,11 @@ func NewMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				return
 			},
 		},
+		QueuedCountByTenantFunc: &WorkerStoreQueuedCountByTenantFunc[T]{
+			defaultHook: func(context.Context, bool) (r0 map[string]int, r1 error) {
+				return
+			},
+		},
 		RequeueFunc: &WorkerStoreRequeueFunc[T]{
 			defaultHook: func(context.Context, int, time.Time) (r0 error) {
 				return
@@ -4622,6 +4638,11 @@ func NewStrictMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				panic("unexpected invocation of MockWorkerStore.Dequeue")
 			},
 		},
+		ExecutionLogsWritableFunc: &WorkerStoreExecutionLogsWritableFunc[T]{
+			defaultHook: func(context.Context, int, store1.ExecutionLogEntryOptions) (bool, error) {
+				panic("unexpected invocation of MockWorkerStore.ExecutionLogsWritable")
+			},
+		},
 		HandleFunc: &WorkerStoreHandleFunc[T]{
 			defaultHook: func() basestore.TransactableHandle {
 				panic("unexpected invocation of MockWorkerStore.Handle")
@@ -4657,6 +4678,11 @@ func NewStrictMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				panic("unexpected invocation of MockWorkerStore.QueuedCount")
 			},
 		},
+		QueuedCountByTenantFunc: &WorkerStoreQueuedCountByTenantFunc[T]{
+			defaultHook: func(context.Context, bool) (map[string]int, error) {
+				panic("unexpected invocation of MockWorkerStore.QueuedCountByTenant")
+			},
+		},
 		RequeueFunc: &WorkerStoreRequeueFunc[T]{
 			defaultHook: func(context.Context, int, time.Time) error {
 				panic("unexpected invocation of MockWorkerStore.Requeue")
@@ -4691,6 +4717,9 @@ func NewMockWorkerStoreFrom[T workerutil.Record](i store1.Store[T]) *MockWorkerS
 		DequeueFunc: &WorkerStoreDequeueFunc[T]{
 			defaultHook: i.Dequeue,
 		},
+		ExecutionLogsWritableFunc: &WorkerStoreExecutionLogsWritableFunc[T]{
+			defaultHook: i.ExecutionLogsWritable,
+		},
 		HandleFunc: &WorkerStoreHandleFunc[T]{
 			defaultHook: i.Handle,
 		},
@@ -4712,6 +4741,9 @@ func NewMockWorkerStoreFrom[T workerutil.Record](i store1.Store[T]) *MockWorkerS
 		QueuedCountFunc: &WorkerStoreQueuedCountFunc[T]{
 			defaultHook: i.QueuedCount,
 		},
+		QueuedCountByTenantFunc: &WorkerStoreQueuedCountByTenantFunc[T]{
+			defaultHook: i.QueuedCountByTenant,
+		},
 		RequeueFunc: &WorkerStoreRequeueFunc[T]{
 			defaultHook: i.Requeue,
 		},
@@ -4958,6 +4990,120 @@ func (c WorkerStoreDequeueFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// WorkerStoreExecutionLogsWritableFunc describes the behavior when the
+// ExecutionLogsWritable method of the parent MockWorkerStore instance is
+// invoked.
+type WorkerStoreExecutionLogsWritableFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, int, store1.ExecutionLogEntryOptions) (bool, error)
+	hooks       []func(context.Context, int, store1.ExecutionLogEntryOptions) (bool, error)
+	history     []WorkerStoreExecutionLogsWritableFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// ExecutionLogsWritable delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) ExecutionLogsWritable(v0 context.Context, v1 int, v2 store1.ExecutionLogEntryOptions) (bool, error) {
+	r0, r1 := m.ExecutionLogsWritableFunc.nextHook()(v0, v1, v2)
+	m.ExecutionLogsWritableFunc.appendCall(WorkerStoreExecutionLogsWritableFuncCall[T]{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ExecutionLogsWritable method of the parent MockWorkerStore instance is
+// invoked and the hook queue is empty.
+func (f *WorkerStoreExecutionLogsWritableFunc[T]) SetDefaultHook(hook func(context.Context, int, store1.ExecutionLogEntryOptions) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ExecutionLogsWritable method of the parent MockWorkerStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *WorkerStoreExecutionLogsWritableFunc[T]) PushHook(hook func(context.Context, int, store1.ExecutionLogEntryOptions) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreExecutionLogsWritableFunc[T]) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, store1.ExecutionLogEntryOptions) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreExecutionLogsWritableFunc[T]) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, store1.ExecutionLogEntryOptions) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *WorkerStoreExecutionLogsWritableFunc[T]) nextHook() func(context.Context, int, store1.ExecutionLogEntryOptions) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreExecutionLogsWritableFunc[T]) appendCall(r0 WorkerStoreExecutionLogsWritableFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreExecutionLogsWritableFuncCall
+// objects describing the invocations of this function.
+func (f *WorkerStoreExecutionLogsWritableFunc[T]) History() []WorkerStoreExecutionLogsWritableFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreExecutionLogsWritableFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreExecutionLogsWritableFuncCall is an object that describes an
+// invocation of method ExecutionLogsWritable on an instance of
+// MockWorkerStore.
+type WorkerStoreExecutionLogsWritableFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 store1.ExecutionLogEntryOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreExecutionLogsWritableFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreExecutionLogsWritableFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // WorkerStoreHandleFunc describes the behavior when the Handle method of
 // the parent MockWorkerStore instance is invoked.
 type WorkerStoreHandleFunc[T workerutil.Record] struct {
@@ -5724,6 +5870,116 @@ func (c WorkerStoreQueuedCountFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// WorkerStoreQueuedCountByTenantFunc describes the behavior when the
+// QueuedCountByTenant method of the parent MockWorkerStore instance is
+// invoked.
+type WorkerStoreQueuedCountByTenantFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, bool) (map[string]int, error)
+	hooks       []func(context.Context, bool) (map[string]int, error)
+	history     []WorkerStoreQueuedCountByTenantFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// QueuedCountByTenant delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) QueuedCountByTenant(v0 context.Context, v1 bool) (map[string]int, error) {
+	r0, r1 := m.QueuedCountByTenantFunc.nextHook()(v0, v1)
+	m.QueuedCountByTenantFunc.appendCall(WorkerStoreQueuedCountByTenantFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the QueuedCountByTenant
+// method of the parent MockWorkerStore instance is invoked and the hook
+// queue is empty.
+func (f *WorkerStoreQueuedCountByTenantFunc[T]) SetDefaultHook(hook func(context.Context, bool) (map[string]int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// QueuedCountByTenant method of the parent MockWorkerStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *WorkerStoreQueuedCountByTenantFunc[T]) PushHook(hook func(context.Context, bool) (map[string]int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreQueuedCountByTenantFunc[T]) SetDefaultReturn(r0 map[string]int, r1 error) {
+	f.SetDefaultHook(func(context.Context, bool) (map[string]int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreQueuedCountByTenantFunc[T]) PushReturn(r0 map[string]int, r1 error) {
+	f.PushHook(func(context.Context, bool) (map[string]int, error) {
+		return r0, r1
+	})
+}
+
+func (f *WorkerStoreQueuedCountByTenantFunc[T]) nextHook() func(context.Context, bool) (map[string]int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreQueuedCountByTenantFunc[T]) appendCall(r0 WorkerStoreQueuedCountByTenantFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreQueuedCountByTenantFuncCall
+// objects describing the invocations of this function.
+func (f *WorkerStoreQueuedCountByTenantFunc[T]) History() []WorkerStoreQueuedCountByTenantFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreQueuedCountByTenantFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreQueuedCountByTenantFuncCall is an object that describes an
+// invocation of method QueuedCountByTenant on an instance of
+// MockWorkerStore.
+type WorkerStoreQueuedCountByTenantFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[string]int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreQueuedCountByTenantFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreQueuedCountByTenantFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // WorkerStoreRequeueFunc describes the behavior when the Requeue method of
 // the parent MockWorkerStore instance is invoked.
 type WorkerStoreRequeueFunc[T workerutil.Record] struct {
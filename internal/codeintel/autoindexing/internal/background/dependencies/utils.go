@@ -8,6 +8,7 @@ import (
 
 	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/executor"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
 )
@@ -23,15 +24,24 @@ const stalledIndexMaxAge = time.Second * 25
 // "queued" on its next reset.
 const indexMaxNumResets = 3
 
+// maxConcurrentIndexesPerRepository is the maximum number of indexes of the same repository
+// that are processed at the same time, so that a single repository cannot occupy all executors.
+var maxConcurrentIndexesPerRepository = env.MustGetInt("PRECISE_CODE_INTEL_AUTO_INDEX_MAXIMUM_CONCURRENT_JOBS_PER_REPOSITORY", 0, "The maximum number of auto-indexing jobs of a single repository processed at the same time. Zero means no limit.")
+
 var IndexWorkerStoreOptions = dbworkerstore.Options[uploadsshared.Index]{
-	Name:              "codeintel_index",
-	TableName:         "lsif_indexes",
-	ViewName:          "lsif_indexes_with_repository_name u",
-	ColumnExpressions: indexColumnsWithNullRank,
-	Scan:              dbworkerstore.BuildWorkerScan(scanIndex),
-	OrderByExpression: sqlf.Sprintf("(u.enqueuer_user_id > 0) DESC, u.queued_at, u.id"),
-	StalledMaxAge:     stalledIndexMaxAge,
-	MaxNumResets:      indexMaxNumResets,
+	Name:               "codeintel_index",
+	TableName:          "lsif_indexes",
+	ViewName:           "lsif_indexes_with_repository_name u",
+	ColumnExpressions:  indexColumnsWithNullRank,
+	Scan:               dbworkerstore.BuildWorkerScan(scanIndex),
+	OrderByExpression:  sqlf.Sprintf("(u.enqueuer_user_id > 0) DESC, u.queued_at, u.id"),
+	PriorityExpression: sqlf.Sprintf("u.priority"),
+	// Automatically scheduled indexes share the executors fairly with the indexes enqueued by each user.
+	FairShareExpression:      sqlf.Sprintf("u.enqueuer_user_id"),
+	ConcurrencyKeyExpression: sqlf.Sprintf("u.repository_id"),
+	MaxConcurrencyPerKey:     maxConcurrentIndexesPerRepository,
+	StalledMaxAge:            stalledIndexMaxAge,
+	MaxNumResets:             indexMaxNumResets,
 }
 
 var indexColumnsWithNullRank = []*sqlf.Query{
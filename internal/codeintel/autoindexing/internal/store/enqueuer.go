@@ -122,6 +122,12 @@ func (s *store) InsertIndexes(ctx context.Context, indexes []shared.Index) (_ []
 
 	actor := actor.FromContext(ctx)
 
+	// Indexes enqueued by a user are dequeued before automatically scheduled indexes.
+	priority := executor.JobPriorityNormal
+	if actor.UID > 0 {
+		priority = executor.JobPriorityHigh
+	}
+
 	values := make([]*sqlf.Query, 0, len(indexes))
 	for _, index := range indexes {
 		if index.DockerSteps == nil {
@@ -135,7 +141,7 @@ func (s *store) InsertIndexes(ctx context.Context, indexes []shared.Index) (_ []
 		}
 
 		values = append(values, sqlf.Sprintf(
-			"(%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)",
+			"(%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)",
 			index.State,
 			index.Commit,
 			index.RepositoryID,
@@ -148,6 +154,7 @@ func (s *store) InsertIndexes(ctx context.Context, indexes []shared.Index) (_ []
 			pq.Array(index.ExecutionLogs),
 			pq.Array(index.RequestedEnvVars),
 			actor.UID,
+			priority,
 		))
 	}
 
@@ -190,7 +197,8 @@ INSERT INTO lsif_indexes (
 	outfile,
 	execution_logs,
 	requested_envvars,
-	enqueuer_user_id
+	enqueuer_user_id,
+	priority
 )
 VALUES %s
 RETURNING id
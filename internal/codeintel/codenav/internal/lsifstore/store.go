@@ -35,6 +35,9 @@ type LsifStore interface {
 	GetDiagnostics(ctx context.Context, bundleID int, prefix string, limit, offset int) ([]shared.Diagnostic, int, error)
 	SCIPDocument(ctx context.Context, id int, path string) (_ *scip.Document, err error)
 
+	// Symbol search
+	SearchSymbolDefinitions(ctx context.Context, uploadID int, filter shared.SymbolFilter, limit int) ([]shared.SymbolDefinition, error)
+
 	// Extraction methods
 	ExtractDefinitionLocationsFromPosition(ctx context.Context, locationKey LocationKey) ([]shared.Location, []string, error)
 	ExtractReferenceLocationsFromPosition(ctx context.Context, locationKey LocationKey) ([]shared.Location, []string, error)
@@ -0,0 +1,254 @@
+package lsifstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/ranges"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// minSymbolDefinitionsBatchSize is the minimum number of symbols read per query by
+// SearchSymbolDefinitions.
+const minSymbolDefinitionsBatchSize = 100
+
+// SearchSymbolDefinitions returns the definitions of the non-local symbols of the given upload
+// that match the given filter. Definitions are ordered by path and symbol name, and at most
+// limit definitions are returned.
+func (s *store) SearchSymbolDefinitions(ctx context.Context, uploadID int, filter shared.SymbolFilter, limit int) (_ []shared.SymbolDefinition, err error) {
+	ctx, trace, endObservation := s.operations.searchSymbolDefinitions.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+		attribute.String("nameSubstring", filter.NameSubstring),
+		attribute.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	nameCondition := sqlf.Sprintf("TRUE")
+	if filter.NameSubstring != "" {
+		if filter.IgnoreCase {
+			nameCondition = sqlf.Sprintf("strpos(lower(sn.name), lower(%s)) > 0", filter.NameSubstring)
+		} else {
+			nameCondition = sqlf.Sprintf("strpos(sn.name, %s) > 0", filter.NameSubstring)
+		}
+	}
+
+	var (
+		numScanned  int
+		definitions []shared.SymbolDefinition
+		lastPath    string
+		lastName    string
+	)
+
+	// The match function of the filter is an arbitrary function of the symbol name (e.g., a Go
+	// regular expression over one of its descriptors), so it's applied here rather than in the
+	// database. As it may reject symbols, we read batches of symbols after the last one seen
+	// until we've collected enough definitions.
+	batchSize := limit
+	if batchSize < minSymbolDefinitionsBatchSize {
+		batchSize = minSymbolDefinitionsBatchSize
+	}
+	for len(definitions) < limit {
+		batch, err := s.scanSymbolDefinitionRows(s.db.Query(ctx, sqlf.Sprintf(
+			symbolDefinitionsQuery,
+			uploadID,
+			uploadID,
+			uploadID,
+			lastPath,
+			lastName,
+			nameCondition,
+			batchSize,
+		)))
+		if err != nil {
+			return nil, err
+		}
+		numScanned += len(batch)
+
+		var candidates []shared.SymbolDefinition
+		for _, row := range batch {
+			if filter.MatchKind == nil && len(definitions)+len(candidates) >= limit {
+				break
+			}
+			if !filter.Match(row.path, row.symbolName) {
+				continue
+			}
+
+			definitionRanges, err := ranges.DecodeRanges(row.definitionRanges)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, r := range definitionRanges {
+				candidates = append(candidates, shared.SymbolDefinition{
+					DumpID: uploadID,
+					Path:   row.path,
+					Symbol: row.symbolName,
+					Range:  translateRange(r),
+				})
+			}
+		}
+
+		// Kinds are only known from the documents defining the symbols, so they're
+		// matched per batch before the limit is applied.
+		if filter.MatchKind != nil {
+			if err := s.setSymbolDefinitionKinds(ctx, uploadID, candidates); err != nil {
+				return nil, err
+			}
+
+			filtered := candidates[:0]
+			for _, candidate := range candidates {
+				if filter.MatchKind(candidate.Symbol, candidate.Kind) {
+					filtered = append(filtered, candidate)
+				}
+			}
+			candidates = filtered
+		}
+		definitions = append(definitions, candidates...)
+
+		if len(batch) < batchSize {
+			break
+		}
+		lastPath, lastName = batch[len(batch)-1].path, batch[len(batch)-1].symbolName
+	}
+	trace.AddEvent("scanned",
+		attribute.Int("numScanned", numScanned),
+		attribute.Int("numDefinitions", len(definitions)))
+
+	if len(definitions) > limit {
+		definitions = definitions[:limit]
+	}
+
+	if filter.MatchKind == nil {
+		if err := s.setSymbolDefinitionKinds(ctx, uploadID, definitions); err != nil {
+			return nil, err
+		}
+	}
+
+	return definitions, nil
+}
+
+type symbolDefinitionRow struct {
+	path             string
+	symbolName       string
+	definitionRanges []byte
+}
+
+func (s *store) scanSymbolDefinitionRows(rows *sql.Rows, queryErr error) (_ []symbolDefinitionRow, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var values []symbolDefinitionRow
+	for rows.Next() {
+		var value symbolDefinitionRow
+		if err := rows.Scan(&value.path, &value.symbolName, &value.definitionRanges); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+const symbolDefinitionsQuery = `
+WITH RECURSIVE
+defined_symbols AS (
+	SELECT ss.symbol_id, ss.document_lookup_id, ss.definition_ranges
+	FROM codeintel_scip_symbols ss
+	WHERE
+		ss.upload_id = %s AND
+		ss.definition_ranges IS NOT NULL
+),
+
+-- Reconstruct the names of the defined symbols. We do a recursive walk starting at
+-- the leaves of the trie for the given upload and prepend the name segment of each
+-- parent until we reach a root (a node without a prefix).
+symbol_names(symbol_id, prefix_id, name) AS (
+	(
+		SELECT ssn.id, ssn.prefix_id, ssn.name_segment
+		FROM codeintel_scip_symbol_names ssn
+		WHERE
+			ssn.upload_id = %s AND
+			ssn.id IN (SELECT symbol_id FROM defined_symbols)
+	) UNION (
+		SELECT sn.symbol_id, ssn.prefix_id, ssn.name_segment || sn.name
+		FROM symbol_names sn
+		JOIN codeintel_scip_symbol_names ssn ON
+			ssn.upload_id = %s AND
+			ssn.id = sn.prefix_id
+	)
+)
+SELECT
+	sid.document_path,
+	sn.name,
+	ds.definition_ranges
+FROM defined_symbols ds
+JOIN symbol_names sn ON sn.symbol_id = ds.symbol_id AND sn.prefix_id IS NULL
+JOIN codeintel_scip_document_lookup sid ON sid.id = ds.document_lookup_id
+WHERE
+	sn.name NOT LIKE 'local %%' AND
+	(sid.document_path, sn.name) > (%s, %s) AND
+	%s
+ORDER BY sid.document_path, sn.name
+LIMIT %s
+`
+
+// setSymbolDefinitionKinds sets the kind of the given definitions from the symbol information
+// of the documents defining them. Indexers that do not report kinds leave them unspecified.
+func (s *store) setSymbolDefinitionKinds(ctx context.Context, uploadID int, definitions []shared.SymbolDefinition) error {
+	if len(definitions) == 0 {
+		return nil
+	}
+
+	paths := make([]string, 0, len(definitions))
+	seen := make(map[string]struct{}, len(definitions))
+	for _, definition := range definitions {
+		if _, ok := seen[definition.Path]; !ok {
+			seen[definition.Path] = struct{}{}
+			paths = append(paths, definition.Path)
+		}
+	}
+
+	documents, err := s.scanDocumentData(s.db.Query(ctx, sqlf.Sprintf(
+		symbolDefinitionDocumentsQuery,
+		uploadID,
+		pq.Array(paths),
+	)))
+	if err != nil {
+		return err
+	}
+
+	kinds := map[string]scip.SymbolInformation_Kind{}
+	for _, document := range documents {
+		for _, symbol := range document.SCIPData.Symbols {
+			if symbol.Kind != scip.SymbolInformation_UnspecifiedKind {
+				kinds[symbol.Symbol] = symbol.Kind
+			}
+		}
+	}
+
+	for i := range definitions {
+		definitions[i].Kind = kinds[definitions[i].Symbol]
+	}
+
+	return nil
+}
+
+const symbolDefinitionDocumentsQuery = `
+SELECT
+	sid.upload_id,
+	sid.document_path,
+	sd.raw_scip_payload
+FROM codeintel_scip_document_lookup sid
+JOIN codeintel_scip_documents sd ON sd.id = sid.document_id
+WHERE
+	sid.upload_id = %s AND
+	sid.document_path = ANY(%s)
+`
@@ -0,0 +1,87 @@
+package lsifstore
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+)
+
+func TestSearchSymbolDefinitions(t *testing.T) {
+	store := populateTestStore(t)
+
+	t.Run("filtered", func(t *testing.T) {
+		filter := shared.SymbolFilter{
+			NameSubstring: "querylsif",
+			IgnoreCase:    true,
+			Match: func(path, symbolName string) bool {
+				return strings.HasSuffix(symbolName, "/queryLSIF().")
+			},
+		}
+
+		definitions, err := store.SearchSymbolDefinitions(context.Background(), testSCIPUploadID, filter, 10)
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+
+		// `export async function queryLSIF<P extends { query: string; uri: string }, R>(`
+		//                        ^^^^^^^^^
+		expected := []shared.SymbolDefinition{
+			{
+				DumpID: testSCIPUploadID,
+				Path:   "template/src/lsif/api.ts",
+				Symbol: "scip-typescript npm template 0.0.0-DEVELOPMENT src/lsif/`api.ts`/queryLSIF().",
+				Range:  newRange(14, 22, 14, 31),
+			},
+		}
+		if diff := cmp.Diff(expected, definitions); diff != "" {
+			t.Errorf("unexpected definitions (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		filter := shared.SymbolFilter{Match: func(path, symbolName string) bool { return true }}
+
+		definitions, err := store.SearchSymbolDefinitions(context.Background(), testSCIPUploadID, filter, 3)
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		if len(definitions) != 3 {
+			t.Errorf("unexpected number of definitions. want=%d have=%d", 3, len(definitions))
+		}
+	})
+	t.Run("kind filtered before limit", func(t *testing.T) {
+		filter := shared.SymbolFilter{
+			Match: func(path, symbolName string) bool { return true },
+			MatchKind: func(symbolName string, kind scip.SymbolInformation_Kind) bool {
+				return strings.HasSuffix(symbolName, "/queryLSIF().")
+			},
+		}
+
+		definitions, err := store.SearchSymbolDefinitions(context.Background(), testSCIPUploadID, filter, 1)
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		if len(definitions) != 1 || !strings.HasSuffix(definitions[0].Symbol, "/queryLSIF().") {
+			t.Errorf("unexpected definitions: %v", definitions)
+		}
+	})
+	t.Run("case-sensitive name substring", func(t *testing.T) {
+		filter := shared.SymbolFilter{
+			NameSubstring: "querylsif",
+			Match:         func(path, symbolName string) bool { return true },
+		}
+
+		definitions, err := store.SearchSymbolDefinitions(context.Background(), testSCIPUploadID, filter, 10)
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		if len(definitions) != 0 {
+			t.Errorf("unexpected definitions: %v", definitions)
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/sourcegraph/log"
@@ -880,6 +881,125 @@ func (s *Service) GetClosestDumpsForBlob(ctx context.Context, repositoryID int,
 	return filtered, nil
 }
 
+// SearchSymbols returns the definitions of the symbols that match the given filter, read from
+// the precise indexes uploaded for exactly the given commit. Paths given to the filter's match
+// function and returned are relative to the repository root. The returned function reports whether a path
+// is analyzed by one of these indexes; it is nil if there is no such index. Callers should fall
+// back to search-based symbol data for the paths that are not covered.
+func (s *Service) SearchSymbols(ctx context.Context, repositoryID int, commit string, filter shared.SymbolFilter, limit int) (_ []shared.SymbolDefinition, covered func(path string) bool, err error) {
+	ctx, trace, endObservation := s.operations.searchSymbols.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.String("commit", commit),
+		attribute.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	candidates, err := s.uploadSvc.InferClosestUploads(ctx, repositoryID, commit, "", false, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Only use indexes of the requested commit. Definitions read from an index of a
+	// nearby commit may have since moved or been removed.
+	uploads := make([]uploadsshared.Dump, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.Commit == commit {
+			uploads = append(uploads, candidate)
+		}
+	}
+	trace.AddEvent("exactCommitUploads",
+		attribute.Int("numCandidates", len(candidates)),
+		attribute.String("uploads", uploadIDsToString(uploads)))
+
+	if len(uploads) == 0 {
+		return nil, nil, nil
+	}
+
+	var definitions []shared.SymbolDefinition
+	for _, upload := range uploads {
+		if len(definitions) >= limit {
+			break
+		}
+
+		root := upload.Root
+		uploadFilter := filter
+		uploadFilter.Match = func(path, symbolName string) bool {
+			return filter.Match(root+path, symbolName)
+		}
+		uploadDefinitions, err := s.lsifstore.SearchSymbolDefinitions(ctx, upload.ID, uploadFilter, limit-len(definitions))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "lsifstore.SearchSymbolDefinitions")
+		}
+
+		for _, definition := range uploadDefinitions {
+			definition.Path = root + definition.Path
+			definitions = append(definitions, definition)
+		}
+	}
+
+	return definitions, uploadsCoverPath(uploads), nil
+}
+
+// uploadsCoverPath returns a function that reports whether a path is analyzed by one of
+// the given uploads, i.e. whether it is within the root of an upload and has an extension
+// of the language of the upload's indexer. The uploads of unknown indexers cover every
+// path within their root.
+func uploadsCoverPath(uploads []uploadsshared.Dump) func(path string) bool {
+	return func(path string) bool {
+		extension := strings.ToLower(filepath.Ext(path))
+		for _, upload := range uploads {
+			if !strings.HasPrefix(path, upload.Root) {
+				continue
+			}
+			extensions := uploadsshared.IndexerFromName(upload.Indexer).Extensions()
+			if len(extensions) == 0 || slices.Contains(extensions, extension) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// CountReferences returns the number of references to the given SCIP symbol in the precise
+// indexes visible from the given commit. The returned flag is false if no index is visible
+// from the commit, in which case the number of references is unknown.
+func (s *Service) CountReferences(ctx context.Context, repositoryID int, commit, symbolName string) (_ int, _ bool, err error) {
+	ctx, trace, endObservation := s.operations.countReferences.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.String("commit", commit),
+		attribute.String("symbolName", symbolName),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	candidates, err := s.uploadSvc.InferClosestUploads(ctx, repositoryID, commit, "", false, "")
+	if err != nil {
+		return 0, false, err
+	}
+
+	commitChecker := NewCommitCache(s.repoStore, s.gitserver)
+	commitChecker.SetResolvableCommit(repositoryID, commit)
+
+	uploads, err := filterUploadsWithCommits(ctx, commitChecker, copyDumps(candidates))
+	if err != nil {
+		return 0, false, err
+	}
+	trace.AddEvent("visibleUploads",
+		attribute.Int("numCandidates", len(candidates)),
+		attribute.String("uploads", uploadIDsToString(uploads)))
+
+	if len(uploads) == 0 {
+		return 0, false, nil
+	}
+
+	monikers := []precise.QualifiedMonikerData{{MonikerData: precise.MonikerData{Scheme: "scip", Identifier: symbolName}}}
+	_, totalCount, err := s.getBulkMonikerLocations(ctx, uploads, monikers, "references", 1, 0)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return totalCount, true, nil
+}
+
 // filterUploadsWithCommits removes the uploads for commits which are unknown to gitserver from the given
 // slice. The slice is filtered in-place and returned (to update the slice length).
 func filterUploadsWithCommits(ctx context.Context, commitCache CommitCache, uploads []uploadsshared.Dump) ([]uploadsshared.Dump, error) {
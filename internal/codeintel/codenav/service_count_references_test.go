@@ -0,0 +1,75 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func TestCountReferences(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	mockUploadSvc.InferClosestUploadsFunc.SetDefaultReturn([]uploadsshared.Dump{
+		{ID: 50, RepositoryID: 42, Commit: mockCommit},
+		{ID: 51, RepositoryID: 42, Commit: "older"},
+		{ID: 52, RepositoryID: 42, Commit: "unknown"},
+	}, nil)
+	mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(ctx context.Context, rcs []api.RepoCommit) (exists []bool, _ error) {
+		for _, rc := range rcs {
+			exists = append(exists, rc.CommitID != "unknown")
+		}
+		return
+	})
+	mockLsifStore.GetBulkMonikerLocationsFunc.SetDefaultReturn([]shared.Location{{DumpID: 50, Path: "a.go"}}, 7, nil)
+
+	symbolName := "scip-go gomod github.com/example/pkg v1.0.0 `github.com/example/pkg`/OldFunc()."
+	count, ok, err := svc.CountReferences(context.Background(), 42, mockCommit, symbolName)
+	if err != nil {
+		t.Fatalf("unexpected error counting references: %s", err)
+	}
+	if !ok {
+		t.Fatalf("expected precise index to be found")
+	}
+	if count != 7 {
+		t.Errorf("unexpected count. want=%d have=%d", 7, count)
+	}
+
+	if history := mockLsifStore.GetBulkMonikerLocationsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of calls to GetBulkMonikerLocations. want=%d have=%d", 1, len(history))
+	} else {
+		call := history[0]
+		if call.Arg1 != "references" {
+			t.Errorf("unexpected table name. want=%q have=%q", "references", call.Arg1)
+		}
+		// Uploads for commits unknown to gitserver are not used
+		if diff := cmp.Diff([]int{50, 51}, call.Arg2); diff != "" {
+			t.Errorf("unexpected upload ids (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]precise.MonikerData{{Scheme: "scip", Identifier: symbolName}}, call.Arg3); diff != "" {
+			t.Errorf("unexpected monikers (-want +got):\n%s", diff)
+		}
+	}
+
+	// No visible uploads
+	mockUploadSvc.InferClosestUploadsFunc.SetDefaultReturn(nil, nil)
+	if _, ok, err := svc.CountReferences(context.Background(), 42, mockCommit, symbolName); err != nil {
+		t.Fatalf("unexpected error counting references: %s", err)
+	} else if ok {
+		t.Errorf("expected no precise index to be found")
+	}
+}
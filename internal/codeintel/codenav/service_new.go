@@ -13,6 +13,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/collections"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 func (s *Service) GetDefinitions(
@@ -76,7 +77,9 @@ func (s *Service) GetPrototypes(
 		s.operations.getPrototypes, // operation
 		"definitions",              // N.B.: we're looking for definitions of interfaces
 		false,                      // includeReferencingIndexes
-		LocationExtractorFunc(s.lsifstore.ExtractPrototypeLocationsFromPosition),
+		LocationExtractorFunc(func(ctx context.Context, locationKey lsifstore.LocationKey) ([]shared.Location, []string, error) {
+			return s.extractPrototypeLocationsFromPosition(ctx, requestState, locationKey)
+		}),
 	)
 }
 
@@ -117,6 +120,111 @@ func (f LocationExtractorFunc) Extract(ctx context.Context, locationKey lsifstor
 	return f(ctx, locationKey)
 }
 
+// remotePrototypeDefinitionLimit is the maximum number of definitions of a symbol that are read
+// when resolving the prototypes of a symbol defined in another index.
+const remotePrototypeDefinitionLimit = 10
+
+// extractPrototypeLocationsFromPosition extracts prototype locations and the names of the prototype
+// symbols of the symbol at the given location. Implementation relationships are only stored with the
+// symbol's definition, so for symbols defined in another index (e.g., that of a dependency) the names
+// of the prototypes are read from the uploads defining the symbol instead. The remote phase resolves
+// the definitions of these prototypes as usual.
+func (s *Service) extractPrototypeLocationsFromPosition(
+	ctx context.Context,
+	requestState RequestState,
+	locationKey lsifstore.LocationKey,
+) ([]shared.Location, []string, error) {
+	locations, symbolNames, err := s.lsifstore.ExtractPrototypeLocationsFromPosition(ctx, locationKey)
+	if err != nil || len(symbolNames) > 0 {
+		return locations, symbolNames, err
+	}
+
+	symbolNames, err = s.getRemotePrototypeSymbolNames(ctx, requestState, locationKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return locations, symbolNames, nil
+}
+
+// getRemotePrototypeSymbolNames returns the names of the symbols implemented by the imported symbols
+// at the given location. Uploads defining the imported symbols are found via their monikers, and the
+// implementation relationships are read at each definition in these uploads.
+func (s *Service) getRemotePrototypeSymbolNames(
+	ctx context.Context,
+	requestState RequestState,
+	locationKey lsifstore.LocationKey,
+) ([]string, error) {
+	rangeMonikers, err := s.lsifstore.GetMonikersByPosition(ctx, locationKey.UploadID, locationKey.Path, locationKey.Line, locationKey.Character)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifstore.GetMonikersByPosition")
+	}
+
+	var importedSymbolNames []string
+	for _, monikers := range rangeMonikers {
+		for _, moniker := range monikers {
+			if moniker.Kind == precise.Import && !strings.HasPrefix(moniker.Identifier, skipPrefix) {
+				importedSymbolNames = append(importedSymbolNames, moniker.Identifier)
+			}
+		}
+	}
+	monikers, err := symbolsToMonikers(importedSymbolNames)
+	if err != nil || len(monikers) == 0 {
+		return nil, err
+	}
+
+	uploads, err := s.getUploadsWithDefinitionsForMonikers(ctx, monikers, requestState)
+	if err != nil || len(uploads) == 0 {
+		return nil, err
+	}
+	uploadIDs := make([]int, 0, len(uploads))
+	for _, upload := range uploads {
+		uploadIDs = append(uploadIDs, upload.ID)
+	}
+
+	monikerArgs := make([]precise.MonikerData, 0, len(monikers))
+	for _, moniker := range monikers {
+		monikerArgs = append(monikerArgs, moniker.MonikerData)
+	}
+	definitions, _, err := s.lsifstore.GetMinimalBulkMonikerLocations(
+		ctx,
+		"definitions",
+		uploadIDs,
+		nil, // skipPaths
+		monikerArgs,
+		remotePrototypeDefinitionLimit,
+		0, // offset
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifstore.GetMinimalBulkMonikerLocations")
+	}
+
+	importedSymbols := collections.NewSet(importedSymbolNames...)
+	prototypeSymbolNames := collections.NewSet[string]()
+	for _, definition := range definitions {
+		definitionMonikers, err := s.lsifstore.GetMonikersByPosition(ctx, definition.DumpID, definition.Path, definition.Range.Start.Line, definition.Range.Start.Character)
+		if err != nil {
+			return nil, errors.Wrap(err, "lsifstore.GetMonikersByPosition")
+		}
+
+		// Each set of monikers belongs to a single occurrence: the moniker of the occurrence's symbol
+		// is followed by the monikers of the symbols it implements.
+		for _, monikers := range definitionMonikers {
+			if len(monikers) == 0 || !importedSymbols.Has(monikers[0].Identifier) {
+				continue
+			}
+
+			for _, moniker := range monikers[1:] {
+				if moniker.Kind == precise.Implementation {
+					prototypeSymbolNames.Add(moniker.Identifier)
+				}
+			}
+		}
+	}
+
+	return prototypeSymbolNames.Sorted(compareStrings), nil
+}
+
 func (s *Service) gatherLocations(
 	ctx context.Context,
 	args PositionalRequestArgs,
@@ -509,4 +509,208 @@ func TestGetImplementations(t *testing.T) {
 			t.Errorf("unexpected locations (-want +got):\n%s", diff)
 		}
 	})
+	t.Run("remote", func(t *testing.T) {
+		// Set up mocks
+		mockRepoStore := defaultMockRepoStore()
+		mockLsifStore := NewMockLsifStore()
+		mockUploadSvc := NewMockUploadService()
+		mockGitserverClient := gitserver.NewMockClient()
+		hunkCache, _ := NewHunkCache(50)
+
+		// Init service
+		svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+		// Set up request state
+		mockRequestState := RequestState{}
+		mockRequestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+		mockRequestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{}, mockCommit, mockPath, hunkCache)
+		uploads := []uploadsshared.Dump{
+			{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+			{ID: 51, Commit: "deadbeef", Root: "sub2/"},
+		}
+		mockRequestState.SetUploadsDataLoader(uploads)
+
+		// The interface is defined in a dependency; implementations live in the dependency
+		// and in another repository referencing the dependency
+		definitionUploads := []uploadsshared.Dump{
+			{ID: 150, RepositoryID: 43, Commit: "deadbeef1", Root: "lib/"},
+		}
+		mockUploadSvc.GetDumpsWithDefinitionsForMonikersFunc.PushReturn(definitionUploads, nil)
+
+		referenceUploads := []uploadsshared.Dump{
+			{ID: 250, RepositoryID: 44, Commit: "deadbeef2", Root: "app/"},
+		}
+		mockUploadSvc.GetDumpsByIDsFunc.PushReturn(nil, nil) // empty
+		mockUploadSvc.GetDumpsByIDsFunc.PushReturn(referenceUploads, nil)
+		mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{250}, 0, 1, nil)
+
+		mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(ctx context.Context, rcs []api.RepoCommit) (exists []bool, _ error) {
+			for range rcs {
+				exists = append(exists, true)
+			}
+			return
+		})
+
+		symbolName := "scip-go gomod github.com/example/lib v1.0.0 `lib`/Reader#"
+		mockLsifStore.ExtractImplementationLocationsFromPositionFunc.PushReturn([]shared.Location{
+			{DumpID: 51, Path: "a.go", Range: testRange1},
+		}, []string{symbolName}, nil)
+
+		mockLsifStore.GetMinimalBulkMonikerLocationsFunc.PushReturn([]shared.Location{
+			{DumpID: 150, Path: "reader.go", Range: testRange2},
+		}, 1, nil) // definition uploads
+		mockLsifStore.GetMinimalBulkMonikerLocationsFunc.PushReturn([]shared.Location{
+			{DumpID: 250, Path: "reader.go", Range: testRange3},
+			{DumpID: 250, Path: "writer.go", Range: testRange4},
+		}, 2, nil) // referencing uploads
+
+		mockCursor := Cursor{}
+		mockRequest := PositionalRequestArgs{
+			RequestArgs: RequestArgs{
+				RepositoryID: 42,
+				Commit:       mockCommit,
+				Limit:        2,
+			},
+			Path:      mockPath,
+			Line:      10,
+			Character: 20,
+		}
+
+		// The first page is filled by local and definition upload results
+		adjustedLocations, nextCursor, err := svc.GetImplementations(context.Background(), mockRequest, mockRequestState, mockCursor)
+		if err != nil {
+			t.Fatalf("unexpected error querying implementations: %s", err)
+		}
+		expectedLocations := []shared.UploadLocation{
+			{Dump: uploads[1], Path: "sub2/a.go", TargetCommit: "deadbeef", TargetRange: testRange1},
+			{Dump: definitionUploads[0], Path: "lib/reader.go", TargetCommit: "deadbeef1", TargetRange: testRange2},
+		}
+		if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+			t.Errorf("unexpected locations (-want +got):\n%s", diff)
+		}
+		if nextCursor.Phase != "remote" {
+			t.Fatalf("unexpected cursor phase. want=%q have=%q", "remote", nextCursor.Phase)
+		}
+
+		// The second page continues with the referencing uploads
+		adjustedLocations, nextCursor, err = svc.GetImplementations(context.Background(), mockRequest, mockRequestState, nextCursor)
+		if err != nil {
+			t.Fatalf("unexpected error querying implementations: %s", err)
+		}
+		expectedLocations = []shared.UploadLocation{
+			{Dump: referenceUploads[0], Path: "app/reader.go", TargetCommit: "deadbeef2", TargetRange: testRange3},
+			{Dump: referenceUploads[0], Path: "app/writer.go", TargetCommit: "deadbeef2", TargetRange: testRange4},
+		}
+		if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+			t.Errorf("unexpected locations (-want +got):\n%s", diff)
+		}
+
+		if history := mockLsifStore.GetMinimalBulkMonikerLocationsFunc.History(); len(history) != 2 {
+			t.Fatalf("unexpected call count for lsifstore.GetMinimalBulkMonikerLocations. want=%d have=%d", 2, len(history))
+		} else {
+			if history[0].Arg1 != "implementations" {
+				t.Errorf("unexpected table name. want=%q have=%q", "implementations", history[0].Arg1)
+			}
+			if diff := cmp.Diff([]int{50, 51, 150}, history[0].Arg2); diff != "" {
+				t.Errorf("unexpected ids (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff([]int{250}, history[1].Arg2); diff != "" {
+				t.Errorf("unexpected ids (-want +got):\n%s", diff)
+			}
+		}
+	})
+}
+
+func TestGetPrototypes(t *testing.T) {
+	t.Run("remote", func(t *testing.T) {
+		// Set up mocks
+		mockRepoStore := defaultMockRepoStore()
+		mockLsifStore := NewMockLsifStore()
+		mockUploadSvc := NewMockUploadService()
+		mockGitserverClient := gitserver.NewMockClient()
+		hunkCache, _ := NewHunkCache(50)
+
+		// Init service
+		svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+		// Set up request state
+		mockRequestState := RequestState{}
+		mockRequestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+		mockRequestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{}, mockCommit, mockPath, hunkCache)
+		uploads := []uploadsshared.Dump{
+			{ID: 51, Commit: "deadbeef", Root: "sub2/"},
+		}
+		mockRequestState.SetUploadsDataLoader(uploads)
+
+		mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(ctx context.Context, rcs []api.RepoCommit) (exists []bool, _ error) {
+			for range rcs {
+				exists = append(exists, true)
+			}
+			return
+		})
+
+		// The target position references a method defined in a dependency, which implements
+		// a method of an interface defined in another dependency. The relationship between
+		// the two is only available in the index of the first dependency.
+		symbolName := "scip-go gomod github.com/example/lib v1.0.0 `lib`/File#Read()."
+		prototypeName := "scip-go gomod github.com/example/io v1.0.0 `io`/Reader#Read()."
+
+		implementationUploads := []uploadsshared.Dump{{ID: 150, RepositoryID: 43, Commit: "deadbeef1", Root: "lib/"}}
+		prototypeUploads := []uploadsshared.Dump{{ID: 160, RepositoryID: 44, Commit: "deadbeef2"}}
+		mockUploadSvc.GetDumpsWithDefinitionsForMonikersFunc.PushReturn(implementationUploads, nil)
+		mockUploadSvc.GetDumpsWithDefinitionsForMonikersFunc.PushReturn(prototypeUploads, nil)
+
+		mockLsifStore.GetMonikersByPositionFunc.SetDefaultHook(func(ctx context.Context, uploadID int, path string, line, character int) ([][]precise.MonikerData, error) {
+			switch uploadID {
+			case 51:
+				return [][]precise.MonikerData{{{Kind: precise.Import, Scheme: "scip-go", Identifier: symbolName}}}, nil
+			case 150:
+				return [][]precise.MonikerData{{
+					{Kind: precise.Export, Scheme: "scip-go", Identifier: symbolName},
+					{Kind: precise.Implementation, Scheme: "scip-go", Identifier: prototypeName},
+				}}, nil
+			}
+			return nil, nil
+		})
+
+		mockLsifStore.GetMinimalBulkMonikerLocationsFunc.PushReturn([]shared.Location{
+			{DumpID: 150, Path: "file.go", Range: testRange1},
+		}, 1, nil) // definition of the symbol
+		mockLsifStore.GetMinimalBulkMonikerLocationsFunc.PushReturn([]shared.Location{
+			{DumpID: 160, Path: "io.go", Range: testRange2},
+		}, 1, nil) // definition of the prototype
+
+		mockRequest := PositionalRequestArgs{
+			RequestArgs: RequestArgs{
+				RepositoryID: 42,
+				Commit:       mockCommit,
+				Limit:        50,
+			},
+			Path:      mockPath,
+			Line:      10,
+			Character: 20,
+		}
+		adjustedLocations, _, err := svc.GetPrototypes(context.Background(), mockRequest, mockRequestState, Cursor{})
+		if err != nil {
+			t.Fatalf("unexpected error querying prototypes: %s", err)
+		}
+
+		expectedLocations := []shared.UploadLocation{
+			{Dump: prototypeUploads[0], Path: "io.go", TargetCommit: "deadbeef2", TargetRange: testRange2},
+		}
+		if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+			t.Errorf("unexpected locations (-want +got):\n%s", diff)
+		}
+
+		if history := mockLsifStore.GetMinimalBulkMonikerLocationsFunc.History(); len(history) != 2 {
+			t.Fatalf("unexpected call count for lsifstore.GetMinimalBulkMonikerLocations. want=%d have=%d", 2, len(history))
+		} else {
+			if diff := cmp.Diff([]int{150}, history[0].Arg2); diff != "" {
+				t.Errorf("unexpected ids (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff([]precise.MonikerData{{Scheme: "scip-go", Identifier: prototypeName}}, history[1].Arg4); diff != "" {
+				t.Errorf("unexpected monikers (-want +got):\n%s", diff)
+			}
+		}
+	})
 }
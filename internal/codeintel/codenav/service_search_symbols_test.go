@@ -0,0 +1,97 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestSearchSymbols(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	mockUploadSvc.InferClosestUploadsFunc.SetDefaultReturn([]uploadsshared.Dump{
+		{ID: 50, Commit: mockCommit, Root: "sub1/", Indexer: "scip-go"},
+		{ID: 51, Commit: "older", Root: "sub2/", Indexer: "scip-go"},
+		{ID: 52, Commit: mockCommit, Root: "sub3/", Indexer: "custom-indexer"},
+	}, nil)
+	mockLsifStore.SearchSymbolDefinitionsFunc.SetDefaultHook(func(_ context.Context, uploadID int, filter shared.SymbolFilter, limit int) ([]shared.SymbolDefinition, error) {
+		var definitions []shared.SymbolDefinition
+		for _, symbolName := range []string{"a", "b"} {
+			if filter.Match("main.go", symbolName) && len(definitions) < limit {
+				definitions = append(definitions, shared.SymbolDefinition{DumpID: uploadID, Path: "main.go", Symbol: symbolName})
+			}
+		}
+		return definitions, nil
+	})
+
+	var filteredPaths []string
+	filter := shared.SymbolFilter{
+		NameSubstring: "a",
+		Match: func(path, symbolName string) bool {
+			filteredPaths = append(filteredPaths, path)
+			return symbolName == "a"
+		},
+	}
+
+	definitions, covered, err := svc.SearchSymbols(context.Background(), 42, mockCommit, filter, 10)
+	if err != nil {
+		t.Fatalf("unexpected error searching symbols: %s", err)
+	}
+	if covered == nil {
+		t.Fatalf("expected precise index to be found")
+	}
+
+	expectedDefinitions := []shared.SymbolDefinition{
+		{DumpID: 50, Path: "sub1/main.go", Symbol: "a"},
+		{DumpID: 52, Path: "sub3/main.go", Symbol: "a"},
+	}
+	if diff := cmp.Diff(expectedDefinitions, definitions); diff != "" {
+		t.Errorf("unexpected definitions (-want +got):\n%s", diff)
+	}
+
+	for _, call := range mockLsifStore.SearchSymbolDefinitionsFunc.History() {
+		if call.Arg2.NameSubstring != "a" {
+			t.Errorf("unexpected name substring. want=%q have=%q", "a", call.Arg2.NameSubstring)
+		}
+	}
+
+	expectedFilteredPaths := []string{"sub1/main.go", "sub1/main.go", "sub3/main.go", "sub3/main.go"}
+	if diff := cmp.Diff(expectedFilteredPaths, filteredPaths); diff != "" {
+		t.Errorf("unexpected filtered paths (-want +got):\n%s", diff)
+	}
+
+	// Paths are covered by the uploads of their root and language. Uploads of
+	// unknown indexers cover all of their root.
+	for path, expected := range map[string]bool{
+		"sub1/main.go":     true,
+		"sub1/README.md":   false,
+		"sub2/main.go":     false,
+		"sub3/main.rs":     true,
+		"other/main.go":    false,
+		"sub1/pkg/util.GO": true,
+	} {
+		if got := covered(path); got != expected {
+			t.Errorf("unexpected coverage of %q: want=%v have=%v", path, expected, got)
+		}
+	}
+
+	// Uploads for other commits are not used
+	if _, covered, err := svc.SearchSymbols(context.Background(), 42, "other", filter, 10); err != nil {
+		t.Fatalf("unexpected error searching symbols: %s", err)
+	} else if covered != nil {
+		t.Errorf("expected no precise index to be found")
+	}
+}
@@ -1,6 +1,8 @@
 package shared
 
 import (
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
@@ -38,6 +40,32 @@ type UploadLocation struct {
 	TargetRange  Range
 }
 
+// SymbolDefinition is the location of the definition of a SCIP symbol within a
+// particular dump. Kind is the kind given by the symbol's information, if any.
+type SymbolDefinition struct {
+	DumpID int
+	Path   string
+	Symbol string
+	Kind   scip.SymbolInformation_Kind
+	Range  Range
+}
+
+// SymbolFilter selects the symbols of a precise symbol search.
+type SymbolFilter struct {
+	// NameSubstring, if non-empty, is a substring of the name of every matching
+	// symbol. It narrows down the symbols read from the database before Match is
+	// applied.
+	NameSubstring string
+	// IgnoreCase is whether NameSubstring is matched case-insensitively.
+	IgnoreCase bool
+	// Match reports whether the symbol with the given name, defined in the given
+	// path, matches.
+	Match func(path, symbolName string) bool
+	// MatchKind, if non-nil, reports whether the symbol with the given name and
+	// kind matches. It's only applied to symbols accepted by Match.
+	MatchKind func(symbolName string, kind scip.SymbolInformation_Kind) bool
+}
+
 type SnapshotData struct {
 	DocumentOffset int
 	Symbol         string
@@ -1,17 +1,81 @@
 package downloader
 
 import (
+	"strings"
 	"time"
 
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/lsifuploadstore"
 	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type Config struct {
 	env.BaseConfig
 
 	DownloaderInterval time.Duration
+	AdvisorySources    []string
+
+	GitHubAdvisoryDatabaseURL string
+	GovulndbURL               string
+	OSVDirectory              string
+	OSVUploadStorePrefix      string
+	CustomFeedURL             string
+	CustomFeedToken           string
+	CustomFeedNamespace       string
+
+	LSIFUploadStoreConfig *lsifuploadstore.Config
 }
 
 func (c *Config) Load() {
 	c.DownloaderInterval = c.GetInterval("CODEINTEL_SENTINEL_DOWNLOADER_INTERVAL", "1h", "How frequently to sync the vulnerability database.")
+
+	sources := c.Get("CODEINTEL_SENTINEL_ADVISORY_SOURCES", sourceGitHub, "A comma-separated list of the advisory databases to sync. Supported sources are github, govulndb, osv-directory, osv-uploadstore, and custom-feed.")
+	for _, source := range strings.Split(sources, ",") {
+		source = strings.ToLower(strings.TrimSpace(source))
+		if source == "" {
+			continue
+		}
+		if !isSupportedSource(source) {
+			c.AddError(errors.Errorf("invalid advisory source %q for CODEINTEL_SENTINEL_ADVISORY_SOURCES: must be one of %s", source, strings.Join(supportedSources, ", ")))
+			continue
+		}
+
+		c.AdvisorySources = append(c.AdvisorySources, source)
+	}
+
+	c.GitHubAdvisoryDatabaseURL = c.Get("CODEINTEL_SENTINEL_GITHUB_ADVISORY_DATABASE_URL", advisoryDatabaseURL, "The URL of a zip archive of the GitHub advisory database.")
+	c.GovulndbURL = c.Get("CODEINTEL_SENTINEL_GOVULNDB_URL", govulndbAdvisoryDatabaseURL, "The URL of a zip archive of the Go vulnerability database.")
+
+	if c.hasSource(sourceOSVDirectory) {
+		c.OSVDirectory = c.Get("CODEINTEL_SENTINEL_OSV_DIRECTORY", "", "A local directory containing OSV advisories as JSON files. Subdirectories are included.")
+	}
+	if c.hasSource(sourceOSVUploadStore) {
+		c.OSVUploadStorePrefix = c.Get("CODEINTEL_SENTINEL_OSV_UPLOADSTORE_PREFIX", "sentinel/osv/", "The key prefix of OSV advisories stored as JSON files in the precise code intel upload bucket.")
+		c.LSIFUploadStoreConfig = &lsifuploadstore.Config{}
+		c.LSIFUploadStoreConfig.Load()
+	}
+	if c.hasSource(sourceCustomFeed) {
+		c.CustomFeedURL = c.Get("CODEINTEL_SENTINEL_CUSTOM_FEED_URL", "", "The URL of a custom advisory feed, serving either a zip archive of OSV JSON files or a JSON array of OSV advisories.")
+		c.CustomFeedToken = c.GetOptional("CODEINTEL_SENTINEL_CUSTOM_FEED_TOKEN", "An optional bearer token sent with requests to the custom advisory feed.")
+		c.CustomFeedNamespace = c.Get("CODEINTEL_SENTINEL_CUSTOM_FEED_NAMESPACE", "custom", "The namespace recorded for the affected packages of advisories from the custom advisory feed.")
+	}
+}
+
+func (c *Config) Validate() error {
+	var errs error
+	errs = errors.Append(errs, c.BaseConfig.Validate())
+	if c.LSIFUploadStoreConfig != nil {
+		errs = errors.Append(errs, c.LSIFUploadStoreConfig.Validate())
+	}
+	return errs
+}
+
+func (c *Config) hasSource(source string) bool {
+	for _, s := range c.AdvisorySources {
+		if s == source {
+			return true
+		}
+	}
+
+	return false
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 func NewCVEDownloader(store store.Store, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
@@ -17,33 +18,35 @@ func NewCVEDownloader(store store.Store, observationCtx *observation.Context, co
 		store:  store,
 		logger: log.Scoped("sentinel.parser"),
 	}
+	cveParser.sources = newVulnerabilitySources(observationCtx, cveParser, config)
 	metrics := newMetrics(observationCtx)
 
 	return goroutine.NewPeriodicGoroutine(
 		actor.WithInternalActor(context.Background()),
 		goroutine.HandlerFunc(func(ctx context.Context) error {
-			vulnerabilities, err := cveParser.handle(ctx)
-			if err != nil {
-				return err
+			vulnerabilities, sourceErr := cveParser.handle(ctx)
+			if len(vulnerabilities) == 0 {
+				return sourceErr
 			}
 
 			numVulnerabilitiesInserted, err := store.InsertVulnerabilities(ctx, vulnerabilities)
 			if err != nil {
-				return err
+				return errors.Append(sourceErr, err)
 			}
 
 			metrics.numVulnerabilitiesInserted.Add(float64(numVulnerabilitiesInserted))
-			return nil
+			return sourceErr
 		}),
 		goroutine.WithName("codeintel.sentinel-cve-downloader"),
-		goroutine.WithDescription("Periodically syncs GitHub advisory records into Postgres."),
+		goroutine.WithDescription("Periodically syncs vulnerability advisory records into Postgres."),
 		goroutine.WithInterval(config.DownloaderInterval),
 	)
 }
 
 type CVEParser struct {
-	store  store.Store
-	logger log.Logger
+	store   store.Store
+	logger  log.Logger
+	sources []VulnerabilitySource
 }
 
 func NewCVEParser() *CVEParser {
@@ -52,6 +55,24 @@ func NewCVEParser() *CVEParser {
 	}
 }
 
-func (parser *CVEParser) handle(ctx context.Context) ([]shared.Vulnerability, error) {
-	return parser.ReadGitHubAdvisoryDB(ctx, false)
+// handle reads the advisories of all configured sources. A source that fails to sync does
+// not prevent the advisories of the other sources from being inserted; its error is returned
+// along with the advisories that could be read.
+func (parser *CVEParser) handle(ctx context.Context) (vulnerabilities []shared.Vulnerability, errs error) {
+	for _, source := range parser.sources {
+		sourceVulnerabilities, err := source.Vulnerabilities(ctx)
+		if err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "failed to sync advisory source %q", source.Name()))
+			continue
+		}
+
+		parser.logger.Debug(
+			"read advisories",
+			log.String("source", source.Name()),
+			log.Int("numVulnerabilities", len(sourceVulnerabilities)),
+		)
+		vulnerabilities = append(vulnerabilities, sourceVulnerabilities...)
+	}
+
+	return vulnerabilities, errs
 }
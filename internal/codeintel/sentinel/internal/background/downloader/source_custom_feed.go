@@ -0,0 +1,87 @@
+package downloader
+
+// Read vulnerabilities from a custom advisory feed supplied by a site admin, such as advisories
+// published by an internal security team. The feed serves OSV-format advisories, either as a zip
+// archive of JSON files or as a JSON array.
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// customFeedSource reads the advisories served at the given URL.
+type customFeedSource struct {
+	parser    *CVEParser
+	url       string
+	token     string
+	namespace string
+}
+
+func (s *customFeedSource) Name() string {
+	return sourceCustomFeed
+}
+
+func (s *customFeedSource) Vulnerabilities(ctx context.Context) ([]shared.Vulnerability, error) {
+	r, err := download(ctx, s.url, s.token)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := OSVHandler{Namespace: s.namespace, DataSource: s.url}
+	if !isZipArchive(content) {
+		return s.parser.parseOSVJSON(bytes.NewReader(content), handler)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, err
+	}
+
+	var vulns []shared.Vulnerability
+	for _, f := range zr.File {
+		if filepath.Ext(f.Name) != ".json" {
+			continue
+		}
+
+		fileVulns, err := s.readFile(f, handler)
+		if err != nil {
+			return nil, err
+		}
+
+		vulns = append(vulns, fileVulns...)
+	}
+
+	return vulns, nil
+}
+
+func (s *customFeedSource) readFile(f *zip.File, handler OSVHandler) ([]shared.Vulnerability, error) {
+	r, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	vulns, err := s.parser.parseOSVJSON(r, handler)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %q", f.Name)
+	}
+
+	return vulns, nil
+}
+
+// isZipArchive returns true if the given content starts with the signature of a zip archive.
+func isZipArchive(content []byte) bool {
+	return bytes.HasPrefix(content, []byte("PK\x03\x04"))
+}
@@ -9,7 +9,6 @@ import (
 	"context"
 	"encoding/json"
 	"io"
-	"net/http"
 	"os"
 	"path/filepath"
 	"time"
@@ -33,17 +32,28 @@ func (parser *CVEParser) ReadGitHubAdvisoryDB(ctx context.Context, useLocalCache
 		return parser.ParseGitHubAdvisoryDB(zipReader)
 	}
 
-	resp, err := http.Get(advisoryDatabaseURL)
+	source := &gitHubAdvisorySource{parser: parser, url: advisoryDatabaseURL}
+	return source.Vulnerabilities(ctx)
+}
+
+// gitHubAdvisorySource reads a zip archive of the GHSA database from the given URL.
+type gitHubAdvisorySource struct {
+	parser *CVEParser
+	url    string
+}
+
+func (s *gitHubAdvisorySource) Name() string {
+	return sourceGitHub
+}
+
+func (s *gitHubAdvisorySource) Vulnerabilities(ctx context.Context) ([]shared.Vulnerability, error) {
+	r, err := download(ctx, s.url, "")
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		return nil, errors.Newf("unexpected status code %d", resp.StatusCode)
-	}
+	defer r.Close()
 
-	return parser.ParseGitHubAdvisoryDB(resp.Body)
+	return s.parser.ParseGitHubAdvisoryDB(r)
 }
 
 func (parser *CVEParser) ParseGitHubAdvisoryDB(ghsaReader io.Reader) (vulns []shared.Vulnerability, err error) {
@@ -9,7 +9,6 @@ import (
 	"context"
 	"encoding/json"
 	"io"
-	"net/http"
 	"os"
 	"path/filepath"
 
@@ -32,17 +31,28 @@ func (parser *CVEParser) ReadGoVulnDb(ctx context.Context, useLocalCache bool) (
 		return parser.ParseGovulndbAdvisoryDB(zipReader)
 	}
 
-	resp, err := http.Get(govulndbAdvisoryDatabaseURL)
+	source := &govulndbSource{parser: parser, url: govulndbAdvisoryDatabaseURL}
+	return source.Vulnerabilities(ctx)
+}
+
+// govulndbSource reads a zip archive of the Go vulnerability database from the given URL.
+type govulndbSource struct {
+	parser *CVEParser
+	url    string
+}
+
+func (s *govulndbSource) Name() string {
+	return sourceGovulndb
+}
+
+func (s *govulndbSource) Vulnerabilities(ctx context.Context) ([]shared.Vulnerability, error) {
+	r, err := download(ctx, s.url, "")
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		return nil, errors.Newf("unexpected status code %d", resp.StatusCode)
-	}
+	defer r.Close()
 
-	return parser.ParseGitHubAdvisoryDB(resp.Body)
+	return s.parser.ParseGovulndbAdvisoryDB(r)
 }
 
 func (parser *CVEParser) ParseGovulndbAdvisoryDB(govulndbReader io.Reader) (vulns []shared.Vulnerability, err error) {
@@ -1,7 +1,10 @@
 package downloader
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -74,6 +77,69 @@ type DataSourceHandler interface {
 	affectedHandler(OSVAffected, *shared.AffectedPackage) error // Handle provider-specific data at the OSV.Affected level
 }
 
+// OSVHandler handles OSV advisories that carry no provider-specific data, such as those read
+// from a local directory or a custom advisory feed. Ecosystems are named as in the GHSA database.
+type OSVHandler struct {
+	// Namespace is recorded for the affected packages of each advisory.
+	Namespace string
+
+	// DataSource is recorded for advisories without an ADVISORY reference.
+	DataSource string
+}
+
+func (h OSVHandler) topLevelHandler(o OSV, v *shared.Vulnerability) error {
+	v.DataSource = h.DataSource
+	for _, reference := range o.References {
+		if reference.Type == "ADVISORY" {
+			v.DataSource = reference.URL
+			break
+		}
+	}
+
+	return nil
+}
+
+func (h OSVHandler) affectedHandler(a OSVAffected, affectedPackage *shared.AffectedPackage) error {
+	affectedPackage.Language = githubEcosystemToLanguage(a.Package.Ecosystem)
+	affectedPackage.Namespace = h.Namespace
+
+	return nil
+}
+
+// parseOSVJSON converts the advisories of the given JSON payload, which is either a single OSV
+// document or an array of OSV documents.
+func (parser *CVEParser) parseOSVJSON(r io.Reader, dataSourceHandler DataSourceHandler) ([]shared.Vulnerability, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var osvVulns []OSV
+	if trimmed := bytes.TrimSpace(content); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &osvVulns); err != nil {
+			return nil, err
+		}
+	} else {
+		var osvVuln OSV
+		if err := json.Unmarshal(trimmed, &osvVuln); err != nil {
+			return nil, err
+		}
+		osvVulns = append(osvVulns, osvVuln)
+	}
+
+	vulns := make([]shared.Vulnerability, 0, len(osvVulns))
+	for _, osvVuln := range osvVulns {
+		convertedVuln, err := parser.osvToVuln(osvVuln, dataSourceHandler)
+		if err != nil {
+			return nil, err
+		}
+
+		vulns = append(vulns, convertedVuln)
+	}
+
+	return vulns, nil
+}
+
 // osvToVuln converts an OSV-formatted vulnerability to Sourcegraph's internal Vulnerability format
 func (parser *CVEParser) osvToVuln(o OSV, dataSourceHandler DataSourceHandler) (vuln shared.Vulnerability, err error) {
 	// Core sections:
@@ -178,8 +244,7 @@ func (parser *CVEParser) osvToVuln(o OSV, dataSourceHandler DataSourceHandler) (
 					"unexpected number of affected versions (>1)",
 					log.String("type", "dataWarning"),
 					log.String("sourceID", v.SourceID),
-					log.String("actual", v.CVSSVector),
-					log.String("err", err.Error()),
+					log.String("actualNumVersions", fmt.Sprint(len(affected.Versions))),
 				)
 			}
 			ap.VersionConstraint = append(ap.VersionConstraint, "="+affected.Versions[0])
@@ -0,0 +1,56 @@
+package downloader
+
+// Read vulnerabilities from OSV-format JSON files in a local directory. This allows instances
+// without access to public advisory databases to sync a mirrored or internal set of advisories.
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// osvDirectorySource reads all JSON files in the given directory and its subdirectories.
+type osvDirectorySource struct {
+	parser *CVEParser
+	dir    string
+}
+
+func (s *osvDirectorySource) Name() string {
+	return sourceOSVDirectory
+}
+
+func (s *osvDirectorySource) Vulnerabilities(ctx context.Context) (vulns []shared.Vulnerability, err error) {
+	handler := OSVHandler{Namespace: "osv", DataSource: "file://" + s.dir}
+
+	err = filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		fileVulns, err := s.parser.parseOSVJSON(f, handler)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse %q", path)
+		}
+
+		vulns = append(vulns, fileVulns...)
+		return nil
+	})
+
+	return vulns, err
+}
@@ -0,0 +1,76 @@
+package downloader
+
+// Read vulnerabilities from OSV-format JSON files in the precise code intel upload bucket. This
+// allows instances without access to public advisory databases to sync advisories that have been
+// copied into blob storage.
+
+import (
+	"context"
+	"path"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// osvUploadStoreSource reads all JSON objects with the given key prefix.
+type osvUploadStoreSource struct {
+	parser   *CVEParser
+	prefix   string
+	newStore func(ctx context.Context) (uploadstore.Store, error)
+	store    uploadstore.Store
+}
+
+func (s *osvUploadStoreSource) Name() string {
+	return sourceOSVUploadStore
+}
+
+func (s *osvUploadStoreSource) Vulnerabilities(ctx context.Context) (vulns []shared.Vulnerability, err error) {
+	if s.store == nil {
+		store, err := s.newStore(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create upload store")
+		}
+		s.store = store
+	}
+
+	keys, err := s.store.List(ctx, s.prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := OSVHandler{Namespace: "osv", DataSource: "uploadstore:" + s.prefix}
+	for keys.Next() {
+		key := keys.Current()
+		if path.Ext(key) != ".json" {
+			continue
+		}
+
+		keyVulns, err := s.readObject(ctx, key, handler)
+		if err != nil {
+			return nil, err
+		}
+
+		vulns = append(vulns, keyVulns...)
+	}
+	if err := keys.Err(); err != nil {
+		return nil, err
+	}
+
+	return vulns, nil
+}
+
+func (s *osvUploadStoreSource) readObject(ctx context.Context, key string, handler OSVHandler) ([]shared.Vulnerability, error) {
+	r, err := s.store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	vulns, err := s.parser.parseOSVJSON(r, handler)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %q", key)
+	}
+
+	return vulns, nil
+}
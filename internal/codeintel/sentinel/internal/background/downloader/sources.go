@@ -0,0 +1,111 @@
+package downloader
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/lsifuploadstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// VulnerabilitySource is an advisory database synced by the downloader.
+type VulnerabilitySource interface {
+	// Name identifies the source in logs.
+	Name() string
+
+	// Vulnerabilities returns the advisories of the source converted to the internal
+	// Vulnerability format.
+	Vulnerabilities(ctx context.Context) ([]shared.Vulnerability, error)
+}
+
+const (
+	sourceGitHub         = "github"
+	sourceGovulndb       = "govulndb"
+	sourceOSVDirectory   = "osv-directory"
+	sourceOSVUploadStore = "osv-uploadstore"
+	sourceCustomFeed     = "custom-feed"
+)
+
+var supportedSources = []string{
+	sourceGitHub,
+	sourceGovulndb,
+	sourceOSVDirectory,
+	sourceOSVUploadStore,
+	sourceCustomFeed,
+}
+
+func isSupportedSource(source string) bool {
+	for _, s := range supportedSources {
+		if s == source {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newVulnerabilitySources creates the advisory sources enabled in the given config.
+func newVulnerabilitySources(observationCtx *observation.Context, parser *CVEParser, config *Config) []VulnerabilitySource {
+	sources := make([]VulnerabilitySource, 0, len(config.AdvisorySources))
+	for _, source := range config.AdvisorySources {
+		switch source {
+		case sourceGitHub:
+			sources = append(sources, &gitHubAdvisorySource{parser: parser, url: config.GitHubAdvisoryDatabaseURL})
+
+		case sourceGovulndb:
+			sources = append(sources, &govulndbSource{parser: parser, url: config.GovulndbURL})
+
+		case sourceOSVDirectory:
+			sources = append(sources, &osvDirectorySource{parser: parser, dir: config.OSVDirectory})
+
+		case sourceOSVUploadStore:
+			storeConfig := config.LSIFUploadStoreConfig
+			sources = append(sources, &osvUploadStoreSource{
+				parser: parser,
+				prefix: config.OSVUploadStorePrefix,
+				newStore: func(ctx context.Context) (uploadstore.Store, error) {
+					return lsifuploadstore.New(ctx, observationCtx, storeConfig)
+				},
+			})
+
+		case sourceCustomFeed:
+			sources = append(sources, &customFeedSource{
+				parser:    parser,
+				url:       config.CustomFeedURL,
+				token:     config.CustomFeedToken,
+				namespace: config.CustomFeedNamespace,
+			})
+		}
+	}
+
+	return sources
+}
+
+// download fetches the content at the given URL. If a token is supplied, it is sent as a
+// bearer token. The caller must close the returned reader.
+func download(ctx context.Context, url, token string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	// N.B.: advisory databases are large archives, so we don't use a client with a request timeout
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.Newf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
@@ -0,0 +1,295 @@
+package downloader
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/iterator"
+)
+
+const testAdvisory = `{
+	"id": "INTERNAL-2023-0001",
+	"summary": "Token leak in example client",
+	"affected": [{
+		"package": {"ecosystem": "Go", "name": "github.com/example/client"},
+		"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "1.2.3"}]}]
+	}],
+	"references": [{"type": "ADVISORY", "url": "https://security.example.com/INTERNAL-2023-0001"}]
+}`
+
+const testAdvisories = `[
+	{
+		"id": "INTERNAL-2023-0002",
+		"affected": [{"package": {"ecosystem": "npm", "name": "example-ui"}, "versions": ["2.0.0"]}]
+	},
+	{
+		"id": "INTERNAL-2023-0003",
+		"affected": [{"package": {"ecosystem": "PyPI", "name": "example-tools"}, "versions": ["0.1.0", "0.1.1"]}]
+	}
+]`
+
+func TestConfigAdvisorySources(t *testing.T) {
+	for _, testCase := range []struct {
+		name            string
+		env             map[string]string
+		expectedSources []string
+		expectedErr     string
+	}{
+		{
+			name:            "default",
+			expectedSources: []string{sourceGitHub},
+		},
+		{
+			name: "multiple sources",
+			env: map[string]string{
+				"CODEINTEL_SENTINEL_ADVISORY_SOURCES": "github, GOVULNDB,osv-directory",
+				"CODEINTEL_SENTINEL_OSV_DIRECTORY":    "/advisories",
+			},
+			expectedSources: []string{sourceGitHub, sourceGovulndb, sourceOSVDirectory},
+		},
+		{
+			name:        "unknown source",
+			env:         map[string]string{"CODEINTEL_SENTINEL_ADVISORY_SOURCES": "github,nvd"},
+			expectedErr: `invalid advisory source "nvd"`,
+		},
+		{
+			name:        "missing directory",
+			env:         map[string]string{"CODEINTEL_SENTINEL_ADVISORY_SOURCES": "osv-directory"},
+			expectedErr: "CODEINTEL_SENTINEL_OSV_DIRECTORY",
+		},
+		{
+			name:        "missing feed URL",
+			env:         map[string]string{"CODEINTEL_SENTINEL_ADVISORY_SOURCES": "custom-feed"},
+			expectedErr: "CODEINTEL_SENTINEL_CUSTOM_FEED_URL",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := Config{}
+			config.SetMockGetter(mapGetter(testCase.env))
+			config.Load()
+
+			err := config.Validate()
+			if testCase.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedErr) {
+					t.Fatalf("unexpected error. want=%q have=%v", testCase.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expectedSources, config.AdvisorySources); diff != "" {
+				t.Errorf("unexpected sources (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOSVDirectorySource(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a.json"), testAdvisory)
+	writeFile(t, filepath.Join(dir, "nested", "b.json"), testAdvisories)
+	writeFile(t, filepath.Join(dir, "README.md"), "not an advisory")
+
+	source := &osvDirectorySource{parser: newTestParser(t), dir: dir}
+	vulns, err := source.Vulnerabilities(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []summary{
+		{SourceID: "INTERNAL-2023-0001", DataSource: "https://security.example.com/INTERNAL-2023-0001", PackageName: "github.com/example/client", Language: "go", Namespace: "osv", VersionConstraint: []string{">=0", "<1.2.3"}},
+		{SourceID: "INTERNAL-2023-0002", DataSource: "file://" + dir, PackageName: "example-ui", Language: "Javascript", Namespace: "osv", VersionConstraint: []string{"=2.0.0"}},
+		{SourceID: "INTERNAL-2023-0003", DataSource: "file://" + dir, PackageName: "example-tools", Language: "python", Namespace: "osv", VersionConstraint: []string{"=0.1.0"}},
+	}
+	if diff := cmp.Diff(expected, summarize(vulns)); diff != "" {
+		t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+	}
+}
+
+func TestOSVUploadStoreSource(t *testing.T) {
+	objects := map[string]string{
+		"sentinel/osv/a.json":        testAdvisory,
+		"sentinel/osv/nested/b.json": testAdvisories,
+		"sentinel/osv/index.txt":     "not an advisory",
+	}
+
+	store := mocks.NewMockStore()
+	store.ListFunc.SetDefaultHook(func(ctx context.Context, prefix string) (*iterator.Iterator[string], error) {
+		var keys []string
+		for key := range objects {
+			if strings.HasPrefix(key, prefix) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		return iterator.From(keys), nil
+	})
+	store.GetFunc.SetDefaultHook(func(ctx context.Context, key string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(objects[key])), nil
+	})
+
+	source := &osvUploadStoreSource{
+		parser:   newTestParser(t),
+		prefix:   "sentinel/osv/",
+		newStore: func(ctx context.Context) (uploadstore.Store, error) { return store, nil },
+	}
+	vulns, err := source.Vulnerabilities(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var sourceIDs []string
+	for _, vuln := range vulns {
+		sourceIDs = append(sourceIDs, vuln.SourceID)
+	}
+	if diff := cmp.Diff([]string{"INTERNAL-2023-0001", "INTERNAL-2023-0002", "INTERNAL-2023-0003"}, sourceIDs); diff != "" {
+		t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+	}
+	if len(store.GetFunc.History()) != 2 {
+		t.Errorf("unexpected number of objects read. want=%d have=%d", 2, len(store.GetFunc.History()))
+	}
+}
+
+func TestCustomFeedSource(t *testing.T) {
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for name, content := range map[string]string{"advisories/a.json": testAdvisory, "advisories/b.json": testAdvisories} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/feed.zip":
+			_, _ = w.Write(archive.Bytes())
+		case "/feed.json":
+			_, _ = w.Write([]byte(testAdvisories))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	for _, testCase := range []struct {
+		path              string
+		token             string
+		expectedSourceIDs []string
+		expectedErr       string
+	}{
+		{path: "/feed.zip", token: "secret", expectedSourceIDs: []string{"INTERNAL-2023-0001", "INTERNAL-2023-0002", "INTERNAL-2023-0003"}},
+		{path: "/feed.json", token: "secret", expectedSourceIDs: []string{"INTERNAL-2023-0002", "INTERNAL-2023-0003"}},
+		{path: "/feed.json", expectedErr: "unexpected status code 401"},
+	} {
+		t.Run(testCase.path, func(t *testing.T) {
+			source := &customFeedSource{
+				parser:    newTestParser(t),
+				url:       server.URL + testCase.path,
+				token:     testCase.token,
+				namespace: "security-team",
+			}
+			vulns, err := source.Vulnerabilities(context.Background())
+			if testCase.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedErr) {
+					t.Fatalf("unexpected error. want=%q have=%v", testCase.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var sourceIDs []string
+			for _, vuln := range vulns {
+				sourceIDs = append(sourceIDs, vuln.SourceID)
+				for _, affectedPackage := range vuln.AffectedPackages {
+					if affectedPackage.Namespace != "security-team" {
+						t.Errorf("unexpected namespace. want=%q have=%q", "security-team", affectedPackage.Namespace)
+					}
+				}
+			}
+			sort.Strings(sourceIDs)
+			if diff := cmp.Diff(testCase.expectedSourceIDs, sourceIDs); diff != "" {
+				t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type summary struct {
+	SourceID          string
+	DataSource        string
+	PackageName       string
+	Language          string
+	Namespace         string
+	VersionConstraint []string
+}
+
+func summarize(vulns []shared.Vulnerability) (summaries []summary) {
+	for _, vuln := range vulns {
+		for _, affectedPackage := range vuln.AffectedPackages {
+			summaries = append(summaries, summary{
+				SourceID:          vuln.SourceID,
+				DataSource:        vuln.DataSource,
+				PackageName:       affectedPackage.PackageName,
+				Language:          affectedPackage.Language,
+				Namespace:         affectedPackage.Namespace,
+				VersionConstraint: affectedPackage.VersionConstraint,
+			})
+		}
+	}
+
+	return summaries
+}
+
+func newTestParser(t *testing.T) *CVEParser {
+	return &CVEParser{logger: logtest.Scoped(t)}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mapGetter(env map[string]string) func(name, defaultValue, description string) string {
+	return func(name, defaultValue, description string) string {
+		if v, ok := env[name]; ok {
+			return v
+		}
+
+		return defaultValue
+	}
+}
@@ -93,6 +93,12 @@ var extensions = map[string][]string{
 	"TypeScript": {".js", ".jsx", ".ts", ".tsx"},
 }
 
+// Extensions returns the file extensions of the languages analyzed by the indexer.
+// It returns nil for indexers of unknown languages.
+func (i CodeIntelIndexer) Extensions() []string {
+	return extensions[i.LanguageKey]
+}
+
 var imageToIndexer = func() map[string]CodeIntelIndexer {
 	m := map[string]CodeIntelIndexer{}
 	for _, indexer := range allIndexers {
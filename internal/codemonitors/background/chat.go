@@ -0,0 +1,257 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func sendChatNotification(ctx context.Context, format database.ChatWebhookFormat, url string, args actionArgs) error {
+	payload, err := chatPayload(format, args)
+	if err != nil {
+		return err
+	}
+	return postChatWebhook(ctx, httpcli.ExternalDoer, url, payload)
+}
+
+// chatPayload returns the message summarizing the results of a code monitor
+// in the given format. It contains the same summary as the Slack message.
+func chatPayload(format database.ChatWebhookFormat, args actionArgs) (any, error) {
+	switch format {
+	case database.ChatWebhookFormatTeams:
+		return teamsPayload(args), nil
+	case database.ChatWebhookFormatMattermost:
+		return mattermostPayload(args), nil
+	default:
+		return nil, errors.Errorf("unknown chat webhook format %q", format)
+	}
+}
+
+// teamsMessage is a Microsoft Teams incoming webhook message with a single
+// adaptive card. See
+// https://learn.microsoft.com/en-us/microsoftteams/platform/webhooks-and-connectors/how-to/connectors-using#send-adaptive-cards-using-an-incoming-webhook
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string    `json:"contentType"`
+	Content     teamsCard `json:"content"`
+}
+
+type teamsCard struct {
+	Schema  string             `json:"$schema"`
+	Type    string             `json:"type"`
+	Version string             `json:"version"`
+	Body    []teamsCardElement `json:"body"`
+	MSTeams teamsCardOptions   `json:"msteams"`
+}
+
+type teamsCardOptions struct {
+	Width string `json:"width"`
+}
+
+// teamsCardElement is either a TextBlock, whose text supports a subset of
+// Markdown, or a RichTextBlock of text runs, whose text is rendered as is.
+type teamsCardElement struct {
+	Type    string         `json:"type"`
+	Text    string         `json:"text,omitempty"`
+	Wrap    bool           `json:"wrap,omitempty"`
+	Inlines []teamsTextRun `json:"inlines,omitempty"`
+}
+
+type teamsTextRun struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	FontType string `json:"fontType,omitempty"`
+}
+
+func newTeamsMessage(body []teamsCardElement) *teamsMessage {
+	return &teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content: teamsCard{
+				Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+				Type:    "AdaptiveCard",
+				Version: "1.4",
+				Body:    body,
+				MSTeams: teamsCardOptions{Width: "Full"},
+			},
+		}},
+	}
+}
+
+func teamsPayload(args actionArgs) *teamsMessage {
+	newMarkdownBlock := func(s string) teamsCardElement {
+		return teamsCardElement{Type: "TextBlock", Text: s, Wrap: true}
+	}
+	newCodeBlock := func(s string) teamsCardElement {
+		return teamsCardElement{
+			Type:    "RichTextBlock",
+			Inlines: []teamsTextRun{{Type: "TextRun", Text: s, FontType: "Monospace"}},
+		}
+	}
+
+	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
+
+	body := []teamsCardElement{
+		newMarkdownBlock(fmt.Sprintf(
+			"%s's Sourcegraph Code monitor, **%s**, detected **%d** new matches.",
+			args.MonitorOwnerName,
+			args.MonitorDescription,
+			totalCount,
+		)),
+	}
+
+	if args.IncludeResults {
+		for _, result := range truncatedResults {
+			resultType := "Message"
+			if result.DiffPreview != nil {
+				resultType = "Diff"
+			}
+			body = append(body, newMarkdownBlock(fmt.Sprintf(
+				"%s match: [%s@%s](%s)",
+				resultType,
+				result.Repo.Name,
+				result.Commit.ID.Short(),
+				getCommitURL(args.ExternalURL, string(result.Repo.Name), string(result.Commit.ID), args.UTMSource),
+			)))
+			body = append(body, newCodeBlock(strings.TrimSuffix(truncateMatchContent(result), "\n")))
+		}
+		if truncatedCount > 0 {
+			body = append(body, newMarkdownBlock(fmt.Sprintf(
+				"...and [%d more matches](%s).",
+				truncatedCount,
+				getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
+			)))
+		}
+	} else {
+		body = append(body, newMarkdownBlock(fmt.Sprintf(
+			"[View results](%s)",
+			getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
+		)))
+	}
+
+	body = append(body, newMarkdownBlock(fmt.Sprintf(
+		"If you are %s, you can [edit your code monitor](%s)",
+		args.MonitorOwnerName,
+		getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource),
+	)))
+	return newTeamsMessage(body)
+}
+
+// mattermostMessage is a Mattermost incoming webhook message. See
+// https://developers.mattermost.com/integrate/webhooks/incoming/
+type mattermostMessage struct {
+	Text string `json:"text"`
+}
+
+func mattermostPayload(args actionArgs) *mattermostMessage {
+	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
+
+	paragraphs := []string{
+		fmt.Sprintf(
+			"%s's Sourcegraph Code monitor, **%s**, detected **%d** new matches.",
+			args.MonitorOwnerName,
+			args.MonitorDescription,
+			totalCount,
+		),
+	}
+
+	if args.IncludeResults {
+		for _, result := range truncatedResults {
+			resultType := "Message"
+			if result.DiffPreview != nil {
+				resultType = "Diff"
+			}
+			paragraphs = append(paragraphs, fmt.Sprintf(
+				"%s match: [%s@%s](%s)\n%s",
+				resultType,
+				result.Repo.Name,
+				result.Commit.ID.Short(),
+				getCommitURL(args.ExternalURL, string(result.Repo.Name), string(result.Commit.ID), args.UTMSource),
+				formatFencedCodeBlock(truncateMatchContent(result)),
+			))
+		}
+		if truncatedCount > 0 {
+			paragraphs = append(paragraphs, fmt.Sprintf(
+				"...and [%d more matches](%s).",
+				truncatedCount,
+				getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
+			))
+		}
+	} else {
+		paragraphs = append(paragraphs, fmt.Sprintf(
+			"[View results](%s)",
+			getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
+		))
+	}
+
+	paragraphs = append(paragraphs, fmt.Sprintf(
+		"If you are %s, you can [edit your code monitor](%s)",
+		args.MonitorOwnerName,
+		getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource),
+	))
+	return &mattermostMessage{Text: strings.Join(paragraphs, "\n\n")}
+}
+
+func formatFencedCodeBlock(s string) string {
+	return fmt.Sprintf("```\n%s\n```", strings.TrimSuffix(strings.ReplaceAll(s, "```", "\\`\\`\\`"), "\n"))
+}
+
+func postChatWebhook(ctx context.Context, doer httpcli.Doer, url string, payload any) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "marshal failed")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(raw))
+	if err != nil {
+		return errors.Wrap(err, "failed new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to post webhook")
+	}
+	defer resp.Body.Close()
+
+	// Teams webhooks created with Workflows respond with 202 Accepted.
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return StatusCodeError{
+			Code:   resp.StatusCode,
+			Status: resp.Status,
+			Body:   string(body),
+		}
+	}
+
+	return nil
+}
+
+func SendTestChatWebhook(ctx context.Context, doer httpcli.Doer, format database.ChatWebhookFormat, description, url string) error {
+	text := fmt.Sprintf("Test message for Code Monitor '%s'", description)
+
+	var payload any
+	switch format {
+	case database.ChatWebhookFormatTeams:
+		payload = newTeamsMessage([]teamsCardElement{{Type: "TextBlock", Text: text, Wrap: true}})
+	case database.ChatWebhookFormatMattermost:
+		payload = &mattermostMessage{Text: text}
+	default:
+		return errors.Errorf("unknown chat webhook format %q", format)
+	}
+
+	return postChatWebhook(ctx, doer, url, payload)
+}
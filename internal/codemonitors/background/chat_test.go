@@ -0,0 +1,114 @@
+package background
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+func TestChatWebhook(t *testing.T) {
+	t.Parallel()
+	eu, err := url.Parse("https://sourcegraph.com")
+	require.NoError(t, err)
+
+	action := actionArgs{
+		MonitorDescription: "My test monitor",
+		MonitorOwnerName:   "Camden Cheek",
+		ExternalURL:        eu,
+		Query:              "repo:camdentest -file:id_rsa.pub BEGIN",
+		Results:            []*result.CommitMatch{&diffResultMock, &commitResultMock},
+		IncludeResults:     false,
+	}
+
+	for _, format := range []database.ChatWebhookFormat{database.ChatWebhookFormatTeams, database.ChatWebhookFormatMattermost} {
+		format := format
+		t.Run(string(format), func(t *testing.T) {
+			jsonChatPayload := func(a actionArgs) autogold.Raw {
+				payload, err := chatPayload(format, a)
+				require.NoError(t, err)
+				b, err := json.MarshalIndent(payload, " ", " ")
+				require.NoError(t, err)
+				return autogold.Raw(b)
+			}
+
+			t.Run("no error", func(t *testing.T) {
+				s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					b, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					autogold.ExpectFile(t, autogold.Raw(b))
+					w.WriteHeader(202)
+				}))
+				defer s.Close()
+
+				payload, err := chatPayload(format, action)
+				require.NoError(t, err)
+				err = postChatWebhook(context.Background(), s.Client(), s.URL, payload)
+				require.NoError(t, err)
+			})
+
+			t.Run("error is returned", func(t *testing.T) {
+				s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(400)
+				}))
+				defer s.Close()
+
+				payload, err := chatPayload(format, action)
+				require.NoError(t, err)
+				err = postChatWebhook(context.Background(), s.Client(), s.URL, payload)
+				require.Error(t, err)
+			})
+
+			t.Run("golden with results", func(t *testing.T) {
+				actionCopy := action
+				actionCopy.IncludeResults = true
+				autogold.ExpectFile(t, jsonChatPayload(actionCopy))
+			})
+
+			t.Run("golden with truncated results", func(t *testing.T) {
+				actionCopy := action
+				actionCopy.IncludeResults = true
+				// quadruple the number of results
+				actionCopy.Results = append(actionCopy.Results, actionCopy.Results...)
+				actionCopy.Results = append(actionCopy.Results, actionCopy.Results...)
+				autogold.ExpectFile(t, jsonChatPayload(actionCopy))
+			})
+
+			t.Run("golden without results", func(t *testing.T) {
+				autogold.ExpectFile(t, jsonChatPayload(action))
+			})
+		})
+	}
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := chatPayload("IRC", action)
+		require.Error(t, err)
+	})
+}
+
+func TestTriggerTestChatWebhookAction(t *testing.T) {
+	for _, format := range []database.ChatWebhookFormat{database.ChatWebhookFormatTeams, database.ChatWebhookFormatMattermost} {
+		format := format
+		t.Run(string(format), func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				autogold.ExpectFile(t, autogold.Raw(b))
+				w.WriteHeader(200)
+			}))
+			defer s.Close()
+
+			err := SendTestChatWebhook(context.Background(), s.Client(), format, "My test monitor", s.URL)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package background
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// filterNotifiedResults drops the results the action of the given job already
+// notified about, and records the remaining ones as notified. A result is
+// dropped if all the files it matches in its commit were notified about
+// before, either by an earlier job or by an earlier result of the same job.
+func filterNotifiedResults(ctx context.Context, s database.CodeMonitorStore, j *database.ActionJob, results []*result.CommitMatch) ([]*result.CommitMatch, error) {
+	if len(results) == 0 {
+		return results, nil
+	}
+
+	commitIDs := make([]api.CommitID, 0, len(results))
+	for _, r := range results {
+		commitIDs = append(commitIDs, r.Commit.ID)
+	}
+	notified, err := s.ListNotifiedMatches(ctx, j, commitIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "ListNotifiedMatches")
+	}
+
+	seen := make(map[database.NotifiedMatch]struct{}, len(notified))
+	for _, m := range notified {
+		seen[m] = struct{}{}
+	}
+
+	var (
+		filtered   []*result.CommitMatch
+		newMatches []database.NotifiedMatch
+	)
+	for _, r := range results {
+		isNew := false
+		for _, m := range notifiedMatches(r) {
+			if _, ok := seen[m]; ok {
+				continue
+			}
+			seen[m] = struct{}{}
+			newMatches = append(newMatches, m)
+			isNew = true
+		}
+		if isNew {
+			filtered = append(filtered, r)
+		}
+	}
+
+	if err := s.CreateNotifiedMatches(ctx, j, newMatches); err != nil {
+		return nil, errors.Wrap(err, "CreateNotifiedMatches")
+	}
+	return filtered, nil
+}
+
+// notifiedMatches returns the files of the commit the given result matches.
+// Matches of the commit message are not tied to a file and are recorded with
+// an empty path.
+func notifiedMatches(r *result.CommitMatch) []database.NotifiedMatch {
+	match := database.NotifiedMatch{RepoID: r.Repo.ID, CommitID: r.Commit.ID}
+	if len(r.Diff) == 0 {
+		return []database.NotifiedMatch{match}
+	}
+
+	matches := make([]database.NotifiedMatch, 0, len(r.Diff))
+	for _, f := range r.Diff {
+		match.Path = f.NewName
+		if match.Path == "/dev/null" {
+			match.Path = f.OrigName
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
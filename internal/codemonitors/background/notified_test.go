@@ -0,0 +1,58 @@
+package background
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestFilterNotifiedResults(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"}
+	diffMatch := func(commit api.CommitID, paths ...string) *result.CommitMatch {
+		m := &result.CommitMatch{Repo: repo, Commit: gitdomain.Commit{ID: commit}}
+		for _, p := range paths {
+			m.Diff = append(m.Diff, result.DiffFile{OrigName: p, NewName: p})
+		}
+		return m
+	}
+
+	results := []*result.CommitMatch{
+		diffMatch("c1", "a.go", "b.go"),                  // a.go is new
+		diffMatch("c1", "b.go"),                          // b.go was notified
+		{Repo: repo, Commit: gitdomain.Commit{ID: "c2"}}, // message match
+		{Repo: repo, Commit: gitdomain.Commit{ID: "c2"}}, // duplicate of the above
+		diffMatch("c3", "removed.go"),                    // deleted file
+		diffMatch("c1", "a.go"),                          // seen earlier in this batch
+	}
+
+	results[4].Diff[0].NewName = "/dev/null"
+
+	store := dbmocks.NewMockCodeMonitorStore()
+	store.ListNotifiedMatchesFunc.SetDefaultReturn([]database.NotifiedMatch{{RepoID: 1, CommitID: "c1", Path: "b.go"}}, nil)
+
+	job := &database.ActionJob{ID: 1, Webhook: pointers.Ptr(int64(2))}
+	got, err := filterNotifiedResults(context.Background(), store, job, results)
+	require.NoError(t, err)
+	require.Equal(t, []*result.CommitMatch{results[0], results[2], results[4]}, got)
+
+	listCalls := store.ListNotifiedMatchesFunc.History()
+	require.Len(t, listCalls, 1)
+	require.Equal(t, job, listCalls[0].Arg1)
+
+	createCalls := store.CreateNotifiedMatchesFunc.History()
+	require.Len(t, createCalls, 1)
+	require.Equal(t, []database.NotifiedMatch{
+		{RepoID: 1, CommitID: "c1", Path: "a.go"},
+		{RepoID: 1, CommitID: "c2"},
+		{RepoID: 1, CommitID: "c3", Path: "removed.go"},
+	}, createCalls[0].Arg2)
+}
@@ -11,11 +11,13 @@ import (
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
@@ -42,7 +44,7 @@ func newTriggerQueryRunner(ctx context.Context, observationCtx *observation.Cont
 
 	store := createDBWorkerStoreForTriggerJobs(observationCtx, db)
 
-	worker := dbworker.NewWorker[*database.TriggerJob](ctx, store, &queryRunner{db: db}, options)
+	worker := dbworker.NewWorker[*database.TriggerJob](ctx, store, &queryRunner{db: db, gitserverClient: gitserver.NewClient("monitors.paths")}, options)
 	return worker
 }
 
@@ -80,13 +82,16 @@ func newTriggerQueryResetter(_ context.Context, observationCtx *observation.Cont
 func newTriggerJobsLogDeleter(ctx context.Context, store database.CodeMonitorStore) goroutine.BackgroundRoutine {
 	deleteLogs := goroutine.HandlerFunc(
 		func(ctx context.Context) error {
-			return store.DeleteOldTriggerJobs(ctx, eventRetentionInDays)
+			if err := store.DeleteOldTriggerJobs(ctx, eventRetentionInDays); err != nil {
+				return err
+			}
+			return store.DeleteOldNotifiedMatches(ctx, eventRetentionInDays)
 		})
 	return goroutine.NewPeriodicGoroutine(
 		ctx,
 		deleteLogs,
 		goroutine.WithName("code_monitors.trigger_jobs_log_deleter"),
-		goroutine.WithDescription("deletes code job logs from code monitor triggers"),
+		goroutine.WithDescription("deletes code job logs from code monitor triggers and matches notified by code monitor actions"),
 		goroutine.WithInterval(60*time.Minute),
 	)
 }
@@ -153,7 +158,8 @@ func createDBWorkerStoreForActionJobs(observationCtx *observation.Context, s dat
 }
 
 type queryRunner struct {
-	db database.DB
+	db              database.DB
+	gitserverClient gitserver.Client
 }
 
 func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob *database.TriggerJob) (err error) {
@@ -181,7 +187,22 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 	ctx = actor.WithActor(ctx, actor.FromUser(m.UserID))
 	ctx = featureflag.WithFlags(ctx, r.db.FeatureFlags())
 
-	results, searchErr := codemonitors.Search(ctx, logger, r.db, q.QueryString, m.ID)
+	queryString := q.QueryString
+	var (
+		results   []*result.CommitMatch
+		searchErr error
+	)
+	if q.PathTrigger != nil {
+		// Path triggers are evaluated against the modified files of new commits,
+		// and we record the equivalent diff search to link to from notifications.
+		queryString, err = codemonitors.PathTriggerQuery(ctx, r.db, q.PathTrigger)
+		if err != nil {
+			return errors.Wrap(err, "PathTriggerQuery")
+		}
+		results, searchErr = codemonitors.SearchPaths(ctx, r.db, r.gitserverClient, authz.DefaultSubRepoPermsChecker, m.ID, q.PathTrigger)
+	} else {
+		results, searchErr = codemonitors.Search(ctx, logger, r.db, q.QueryString, m.ID)
+	}
 
 	// Log next_run and latest_result to table cm_queries.
 	newLatestResult := latestResultTime(q.LatestResult, results, searchErr)
@@ -196,7 +217,7 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 	}
 
 	// Log the actual query we ran and whether we got any new results.
-	err = cm.UpdateTriggerJobWithResults(ctx, triggerJob.ID, q.QueryString, results)
+	err = cm.UpdateTriggerJobWithResults(ctx, triggerJob.ID, queryString, results)
 	if err != nil {
 		return errors.Wrap(err, "UpdateTriggerJobWithResults")
 	}
@@ -223,12 +244,14 @@ func (r *actionRunner) Handle(ctx context.Context, logger log.Logger, j *databas
 		return errors.Wrap(r.handleWebhook(ctx, j), "Webhook")
 	case j.SlackWebhook != nil:
 		return errors.Wrap(r.handleSlackWebhook(ctx, j), "SlackWebhook")
+	case j.ChatWebhook != nil:
+		return errors.Wrap(r.handleChatWebhook(ctx, j), "ChatWebhook")
 	default:
-		return errors.New("job must be one of type email, webhook, or slack webhook")
+		return errors.New("job must be one of type email, webhook, slack webhook, or chat webhook")
 	}
 }
 
-func (r *actionRunner) handleEmail(ctx context.Context, j *database.ActionJob) error {
+func (r *actionRunner) handleEmail(ctx context.Context, j *database.ActionJob) (err error) {
 	s, err := r.CodeMonitorStore.Transact(ctx)
 	if err != nil {
 		return err
@@ -245,6 +268,15 @@ func (r *actionRunner) handleEmail(ctx context.Context, j *database.ActionJob) e
 		return errors.Wrap(err, "GetEmailAction")
 	}
 
+	results, err := filterNotifiedResults(ctx, s, j, m.Results)
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		// All the results were notified about before.
+		return nil
+	}
+
 	recs, err := s.ListRecipients(ctx, database.ListRecipientsOpts{EmailID: j.Email})
 	if err != nil {
 		return errors.Wrap(err, "ListRecipients")
@@ -262,7 +294,7 @@ func (r *actionRunner) handleEmail(ctx context.Context, j *database.ActionJob) e
 		UTMSource:          utmSourceEmail,
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
-		Results:            m.Results,
+		Results:            results,
 		IncludeResults:     e.IncludeResults,
 	}
 
@@ -286,7 +318,7 @@ func (r *actionRunner) handleEmail(ctx context.Context, j *database.ActionJob) e
 	return nil
 }
 
-func (r *actionRunner) handleWebhook(ctx context.Context, j *database.ActionJob) error {
+func (r *actionRunner) handleWebhook(ctx context.Context, j *database.ActionJob) (err error) {
 	s, err := r.CodeMonitorStore.Transact(ctx)
 	if err != nil {
 		return err
@@ -303,6 +335,15 @@ func (r *actionRunner) handleWebhook(ctx context.Context, j *database.ActionJob)
 		return errors.Wrap(err, "GetWebhookAction")
 	}
 
+	results, err := filterNotifiedResults(ctx, s, j, m.Results)
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		// All the results were notified about before.
+		return nil
+	}
+
 	externalURL, err := url.Parse(conf.Get().ExternalURL)
 	if err != nil {
 		return err
@@ -315,14 +356,14 @@ func (r *actionRunner) handleWebhook(ctx context.Context, j *database.ActionJob)
 		UTMSource:          "code-monitor-webhook",
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
-		Results:            m.Results,
+		Results:            results,
 		IncludeResults:     w.IncludeResults,
 	}
 
 	return sendWebhookNotification(ctx, w.URL, args)
 }
 
-func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *database.ActionJob) error {
+func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *database.ActionJob) (err error) {
 	s, err := r.CodeMonitorStore.Transact(ctx)
 	if err != nil {
 		return err
@@ -339,6 +380,15 @@ func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *database.Actio
 		return errors.Wrap(err, "GetSlackWebhookAction")
 	}
 
+	results, err := filterNotifiedResults(ctx, s, j, m.Results)
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		// All the results were notified about before.
+		return nil
+	}
+
 	externalURL, err := url.Parse(conf.Get().ExternalURL)
 	if err != nil {
 		return err
@@ -351,13 +401,58 @@ func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *database.Actio
 		UTMSource:          "code-monitor-slack-webhook",
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
-		Results:            m.Results,
+		Results:            results,
 		IncludeResults:     w.IncludeResults,
 	}
 
 	return sendSlackNotification(ctx, w.URL, args)
 }
 
+func (r *actionRunner) handleChatWebhook(ctx context.Context, j *database.ActionJob) (err error) {
+	s, err := r.CodeMonitorStore.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = s.Done(err) }()
+
+	m, err := s.GetActionJobMetadata(ctx, j.ID)
+	if err != nil {
+		return errors.Wrap(err, "GetActionJobMetadata")
+	}
+
+	w, err := s.GetChatWebhookAction(ctx, *j.ChatWebhook)
+	if err != nil {
+		return errors.Wrap(err, "GetChatWebhookAction")
+	}
+
+	results, err := filterNotifiedResults(ctx, s, j, m.Results)
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		// All the results were notified about before.
+		return nil
+	}
+
+	externalURL, err := url.Parse(conf.Get().ExternalURL)
+	if err != nil {
+		return err
+	}
+
+	args := actionArgs{
+		MonitorDescription: m.Description,
+		MonitorID:          w.Monitor,
+		ExternalURL:        externalURL,
+		UTMSource:          "code-monitor-chat-webhook",
+		Query:              m.Query,
+		MonitorOwnerName:   m.OwnerName,
+		Results:            results,
+		IncludeResults:     w.IncludeResults,
+	}
+
+	return sendChatNotification(ctx, w.Format, w.URL, args)
+}
+
 type StatusCodeError struct {
 	Code   int
 	Status string
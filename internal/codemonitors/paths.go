@@ -0,0 +1,376 @@
+package codemonitors
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	gitprotocol "github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
+	"github.com/sourcegraph/sourcegraph/internal/paths"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// MaxPathTriggerRepos is the maximum number of repositories a path trigger can watch.
+const MaxPathTriggerRepos = 100
+
+// ValidatePathTrigger returns an error if the given path trigger cannot be evaluated.
+func ValidatePathTrigger(trigger database.PathTrigger) error {
+	if len(trigger.RepoIDs) == 0 {
+		return errors.New("path trigger must watch at least one repository")
+	}
+	if len(trigger.RepoIDs) > MaxPathTriggerRepos {
+		return errors.Errorf("path trigger cannot watch more than %d repositories", MaxPathTriggerRepos)
+	}
+	if len(trigger.PathPatterns) == 0 && trigger.Owner == "" {
+		return errors.New("path trigger must have path patterns or an owner")
+	}
+	for _, pattern := range trigger.PathPatterns {
+		if _, err := paths.Compile(pattern); err != nil {
+			return errors.Wrapf(err, "invalid path pattern %q", pattern)
+		}
+	}
+	return nil
+}
+
+// SearchPaths returns the commits of the repositories watched by the given
+// path trigger that were added to their default branch since the last search,
+// and modify files that match the trigger. Like Search, it records the
+// searched commits of every repository, and the first search of a repository
+// only records its commits. Files the actor of ctx cannot read because of
+// sub-repo permissions are omitted, as in the search pipeline.
+func SearchPaths(ctx context.Context, db database.DB, gs gitserver.Client, checker authz.SubRepoPermissionChecker, monitorID int64, trigger *database.PathTrigger) ([]*result.CommitMatch, error) {
+	if err := ValidatePathTrigger(*trigger); err != nil {
+		return nil, errcode.MakeNonRetryable(err)
+	}
+	matcher, err := newPathMatcher(trigger)
+	if err != nil {
+		return nil, errcode.MakeNonRetryable(err)
+	}
+
+	// Repositories the owner of the monitor cannot access anymore are omitted.
+	repos, err := db.Repos().GetByIDs(ctx, trigger.RepoIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	cm := db.CodeMonitors()
+	var (
+		results []*result.CommitMatch
+		errs    error
+	)
+	for _, repo := range repos {
+		commitHashes, err := gs.ResolveRevisions(ctx, repo.Name, []gitprotocol.RevisionSpecifier{{}})
+		if err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "resolve default branch of %s", repo.Name))
+			continue
+		}
+
+		lastSearched, err := cm.GetLastSearched(ctx, monitorID, repo.ID)
+		if err != nil {
+			return nil, err
+		}
+		if lastSearched == nil || stringsEqual(commitHashes, lastSearched) {
+			// Nothing to search if this is the first time we see the repository, or
+			// if it hasn't changed since the last search.
+			if err := cm.UpsertLastSearched(ctx, monitorID, repo.ID, commitHashes); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		matches, searchErr := searchModifiedFiles(ctx, gs, repo, commitHashes, lastSearched)
+		// As in hookWithID, always save the searched commits to not notify twice
+		// for the same commits.
+		if err := cm.UpsertLastSearched(ctx, monitorID, repo.ID, commitHashes); err != nil {
+			return nil, err
+		}
+		if searchErr != nil {
+			errs = errors.Append(errs, errors.Wrapf(searchErr, "search %s failed, some commits may be skipped", repo.Name))
+			continue
+		}
+		// 🚨 SECURITY: gitserver does not apply sub-repo permissions, so we filter
+		// the modified files before they are matched and sent in notifications.
+		matches, err = filterSubRepoPaths(ctx, checker, repo.Name, matches)
+		if err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "sub-repo permissions of %s", repo.Name))
+			continue
+		}
+		if len(matches) == 0 {
+			continue
+		}
+
+		repoMatcher, err := matcher.forRepo(ctx, db, gs, repo, api.CommitID(commitHashes[0]))
+		if err != nil {
+			errs = errors.Append(errs, err)
+			continue
+		}
+		for _, match := range matches {
+			if repoMatcher.matchesAny(match.ModifiedFiles) {
+				results = append(results, match)
+			}
+		}
+	}
+	return results, errs
+}
+
+// SnapshotPaths resolves the default branch of the repositories watched by
+// the given path trigger, so that only commits added later trigger the
+// monitor.
+func SnapshotPaths(ctx context.Context, db database.DB, gs gitserver.Client, trigger database.PathTrigger) (map[api.RepoID][]string, error) {
+	repos, err := db.Repos().GetByIDs(ctx, trigger.RepoIDs...)
+	if err != nil {
+		return nil, err
+	}
+	resolvedRevisions := make(map[api.RepoID][]string, len(repos))
+	for _, repo := range repos {
+		commitHashes, err := gs.ResolveRevisions(ctx, repo.Name, []gitprotocol.RevisionSpecifier{{}})
+		if err != nil {
+			return nil, err
+		}
+		resolvedRevisions[repo.ID] = commitHashes
+	}
+	return resolvedRevisions, nil
+}
+
+// PathTriggerQuery returns the diff search equivalent to the given path
+// trigger, recorded with the results of the trigger and linked from the
+// notifications of the monitor.
+func PathTriggerQuery(ctx context.Context, db database.DB, trigger *database.PathTrigger) (string, error) {
+	repos, err := db.Repos().GetByIDs(ctx, trigger.RepoIDs...)
+	if err != nil {
+		return "", err
+	}
+	repoNames := make([]api.RepoName, 0, len(repos))
+	for _, repo := range repos {
+		repoNames = append(repoNames, repo.Name)
+	}
+	return pathTriggerQuery(repoNames, trigger), nil
+}
+
+func pathTriggerQuery(repoNames []api.RepoName, trigger *database.PathTrigger) string {
+	quoted := make([]string, 0, len(repoNames))
+	for _, name := range repoNames {
+		quoted = append(quoted, regexp.QuoteMeta(string(name)))
+	}
+	q := []string{"type:diff", fmt.Sprintf("repo:^(%s)$", strings.Join(quoted, "|"))}
+	if len(trigger.PathPatterns) > 0 {
+		regexps := make([]string, 0, len(trigger.PathPatterns))
+		for _, pattern := range trigger.PathPatterns {
+			regexps = append(regexps, globToRegexp(pattern))
+		}
+		q = append(q, fmt.Sprintf("file:%s", strings.Join(regexps, "|")))
+	}
+	if trigger.Owner != "" {
+		q = append(q, fmt.Sprintf("file:has.owner(%s)", trigger.Owner))
+	}
+	return strings.Join(q, " ")
+}
+
+// searchModifiedFiles returns the commits reachable from commitHashes but not
+// from excludedHashes, along with the files they modify.
+func searchModifiedFiles(ctx context.Context, gs gitserver.Client, repo *types.Repo, commitHashes, excludedHashes []string) ([]*result.CommitMatch, error) {
+	revs := make([]gitprotocol.RevisionSpecifier, 0, len(commitHashes)+len(excludedHashes))
+	for _, hash := range commitHashes {
+		revs = append(revs, gitprotocol.RevisionSpecifier{RevSpec: hash})
+	}
+	for _, exclude := range excludedHashes {
+		revs = append(revs, gitprotocol.RevisionSpecifier{RevSpec: "^" + exclude})
+	}
+
+	var matches []*result.CommitMatch
+	minimalRepo := types.MinimalRepo{ID: repo.ID, Name: repo.Name, Stars: repo.Stars}
+	_, err := gs.Search(ctx, &gitprotocol.SearchRequest{
+		Repo:                 repo.Name,
+		Revisions:            revs,
+		Query:                &gitprotocol.Boolean{Value: true},
+		IncludeModifiedFiles: true,
+	}, func(in []gitprotocol.CommitMatch) {
+		for _, m := range in {
+			matches = append(matches, commitMatch(minimalRepo, m))
+		}
+	})
+	return matches, err
+}
+
+// filterSubRepoPaths removes the modified files the actor of ctx cannot read
+// from the matches, and drops the matches that only modify such files.
+func filterSubRepoPaths(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, matches []*result.CommitMatch) ([]*result.CommitMatch, error) {
+	enabled, err := authz.SubRepoEnabledForRepo(ctx, checker, repo)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return matches, nil
+	}
+
+	a := actor.FromContext(ctx)
+	filtered := matches[:0]
+	for _, match := range matches {
+		files, err := authz.FilterActorPaths(ctx, checker, a, repo, match.ModifiedFiles)
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			continue
+		}
+		match.ModifiedFiles = files
+		filtered = append(filtered, match)
+	}
+	return filtered, nil
+}
+
+func commitMatch(repo types.MinimalRepo, in gitprotocol.CommitMatch) *result.CommitMatch {
+	return &result.CommitMatch{
+		Commit: gitdomain.Commit{
+			ID: in.Oid,
+			Author: gitdomain.Signature{
+				Name:  in.Author.Name,
+				Email: in.Author.Email,
+				Date:  in.Author.Date,
+			},
+			Committer: &gitdomain.Signature{
+				Name:  in.Committer.Name,
+				Email: in.Committer.Email,
+				Date:  in.Committer.Date,
+			},
+			Message: gitdomain.Message(in.Message.Content),
+			Parents: in.Parents,
+		},
+		Repo:           repo,
+		MessagePreview: &in.Message,
+		ModifiedFiles:  in.ModifiedFiles,
+	}
+}
+
+// pathMatcher tells whether files match a path trigger.
+type pathMatcher struct {
+	globs []*paths.GlobPattern
+	owner string
+	// ruleset is the ownership ruleset of the repository, only set for
+	// triggers with an owner.
+	ruleset *codeowners.Ruleset
+}
+
+func newPathMatcher(trigger *database.PathTrigger) (*pathMatcher, error) {
+	m := &pathMatcher{owner: trigger.Owner}
+	for _, pattern := range trigger.PathPatterns {
+		glob, err := paths.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		m.globs = append(m.globs, glob)
+	}
+	return m, nil
+}
+
+// forRepo returns a matcher using the ownership rules of the given repository
+// at the given commit.
+func (m *pathMatcher) forRepo(ctx context.Context, db database.DB, gs gitserver.Client, repo *types.Repo, commitID api.CommitID) (*pathMatcher, error) {
+	if m.owner == "" {
+		return m, nil
+	}
+	ruleset, err := own.NewService(gs, db).RulesetForRepo(ctx, repo.Name, repo.ID, commitID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "ownership rules of %s", repo.Name)
+	}
+	return &pathMatcher{globs: m.globs, owner: m.owner, ruleset: ruleset}, nil
+}
+
+func (m *pathMatcher) matchesAny(files []string) bool {
+	for _, file := range files {
+		if m.matches(file) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *pathMatcher) matches(file string) bool {
+	if !strings.HasPrefix(file, "/") {
+		file = "/" + file
+	}
+	if len(m.globs) > 0 {
+		matched := false
+		for _, glob := range m.globs {
+			if glob.Match(file) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if m.owner != "" {
+		if m.ruleset == nil {
+			return false
+		}
+		for _, o := range m.ruleset.Match(file).GetOwner() {
+			if ownerMatches(o, m.owner) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// ownerMatches tells whether the given owner of an ownership rule is the
+// given owner of a path trigger, written as in ownership rules.
+func ownerMatches(o *codeownerspb.Owner, owner string) bool {
+	want := codeowners.ParseOwner(owner)
+	if want.GetEmail() != "" {
+		return strings.EqualFold(o.GetEmail(), want.GetEmail())
+	}
+	return o.GetHandle() != "" && strings.EqualFold(o.GetHandle(), want.GetHandle())
+}
+
+// globToRegexp translates a CODEOWNERS-style glob pattern to a regular
+// expression matching the same paths, with no leading slash.
+func globToRegexp(pattern string) string {
+	var b strings.Builder
+	if strings.HasPrefix(pattern, "/") {
+		b.WriteString("^")
+	} else {
+		b.WriteString("(^|/)")
+	}
+	parts := strings.Split(strings.Trim(pattern, "/"), "/")
+	for i, part := range parts {
+		last := i == len(parts)-1
+		switch {
+		case part == "**" && last:
+			b.WriteString(".*")
+		case part == "**":
+			// Matches any number of directories, including none.
+			b.WriteString("(.*/)?")
+		default:
+			quoted := make([]string, 0, strings.Count(part, "*")+1)
+			for _, literal := range strings.Split(part, "*") {
+				quoted = append(quoted, regexp.QuoteMeta(literal))
+			}
+			b.WriteString(strings.Join(quoted, "[^/]*"))
+			if !last {
+				b.WriteString("/")
+			}
+		}
+	}
+	if strings.HasSuffix(pattern, "/") {
+		b.WriteString("/")
+	} else if !strings.HasSuffix(pattern, "/**") {
+		b.WriteString("$")
+	}
+	return b.String()
+}
@@ -0,0 +1,162 @@
+package codemonitors
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+func TestValidatePathTrigger(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		trigger database.PathTrigger
+		wantErr bool
+	}{
+		{
+			name:    "patterns",
+			trigger: database.PathTrigger{RepoIDs: []api.RepoID{1}, PathPatterns: []string{"/docs/**"}},
+		},
+		{
+			name:    "owner",
+			trigger: database.PathTrigger{RepoIDs: []api.RepoID{1}, Owner: "@sourcegraph/search"},
+		},
+		{
+			name:    "no repositories",
+			trigger: database.PathTrigger{PathPatterns: []string{"*.go"}},
+			wantErr: true,
+		},
+		{
+			name:    "no patterns nor owner",
+			trigger: database.PathTrigger{RepoIDs: []api.RepoID{1}},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidatePathTrigger(tc.trigger)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPathMatcher(t *testing.T) {
+	file, err := codeowners.Parse(strings.NewReader(`
+*.go @sourcegraph/backend
+/client/ @sourcegraph/frontend
+/client/**/*.go alice@example.com
+`))
+	require.NoError(t, err)
+	ruleset := codeowners.NewRuleset(codeowners.GitRulesetSource{}, file)
+
+	for _, tc := range []struct {
+		name    string
+		trigger database.PathTrigger
+		want    map[string]bool
+	}{
+		{
+			name:    "patterns",
+			trigger: database.PathTrigger{PathPatterns: []string{"/docs/", "*.md"}},
+			want: map[string]bool{
+				"docs/index.html":        true,
+				"client/README.md":       true,
+				"cmd/frontend/main.go":   false,
+				"client/docs/index.html": false,
+			},
+		},
+		{
+			name:    "team owner",
+			trigger: database.PathTrigger{Owner: "@SourceGraph/Backend"},
+			want: map[string]bool{
+				"cmd/frontend/main.go":  true,
+				"client/web/index.ts":   false,
+				"client/web/gen/gen.go": false,
+			},
+		},
+		{
+			name:    "email owner",
+			trigger: database.PathTrigger{Owner: "alice@example.com"},
+			want: map[string]bool{
+				"client/web/gen/gen.go": true,
+				"cmd/frontend/main.go":  false,
+			},
+		},
+		{
+			name:    "patterns and owner",
+			trigger: database.PathTrigger{PathPatterns: []string{"/client/web/"}, Owner: "sourcegraph/frontend"},
+			want: map[string]bool{
+				"client/web/index.ts":    true,
+				"client/shared/index.ts": false,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := newPathMatcher(&tc.trigger)
+			require.NoError(t, err)
+			m.ruleset = ruleset
+			for file, want := range tc.want {
+				assert.Equal(t, want, m.matches(file), file)
+			}
+		})
+	}
+}
+
+func TestPathTriggerQuery(t *testing.T) {
+	got := pathTriggerQuery(
+		[]api.RepoName{"github.com/sourcegraph/sourcegraph", "github.com/sourcegraph/zoekt"},
+		&database.PathTrigger{
+			PathPatterns: []string{"/docs/", "*.md", "/client/**/*.go", "/dev/**"},
+			Owner:        "@sourcegraph/frontend",
+		},
+	)
+	want := `type:diff repo:^(github\.com/sourcegraph/sourcegraph|github\.com/sourcegraph/zoekt)$ file:^docs/|(^|/)[^/]*\.md$|^client/(.*/)?[^/]*\.go$|^dev/.* file:has.owner(@sourcegraph/frontend)`
+	assert.Equal(t, want, got)
+}
+
+func TestFilterSubRepoPaths(t *testing.T) {
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.EnabledForRepoFunc.SetDefaultHook(func(ctx context.Context, repo api.RepoName) (bool, error) {
+		return repo == "restricted", nil
+	})
+	checker.FilePermissionsFuncFunc.SetDefaultHook(func(ctx context.Context, userID int32, repo api.RepoName) (authz.FilePermissionFunc, error) {
+		return func(path string) (authz.Perms, error) {
+			if strings.HasPrefix(path, "secret/") {
+				return authz.None, nil
+			}
+			return authz.Read, nil
+		}, nil
+	})
+
+	newMatches := func() []*result.CommitMatch {
+		return []*result.CommitMatch{
+			{ModifiedFiles: []string{"docs/a.md", "secret/b.md"}},
+			{ModifiedFiles: []string{"secret/c.md"}},
+		}
+	}
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+
+	t.Run("repo without sub-repo permissions", func(t *testing.T) {
+		got, err := filterSubRepoPaths(ctx, checker, "public", newMatches())
+		require.NoError(t, err)
+		assert.Equal(t, newMatches(), got)
+	})
+
+	t.Run("repo with sub-repo permissions", func(t *testing.T) {
+		got, err := filterSubRepoPaths(ctx, checker, "restricted", newMatches())
+		require.NoError(t, err)
+		assert.Equal(t, []*result.CommitMatch{{ModifiedFiles: []string{"docs/a.md"}}}, got)
+	})
+}
@@ -18,6 +18,7 @@ type ActionJob struct {
 	Email        *int64
 	Webhook      *int64
 	SlackWebhook *int64
+	ChatWebhook  *int64
 	TriggerEvent int32
 
 	// Fields demanded by any dbworker.
@@ -39,6 +40,41 @@ func (a *ActionJob) RecordUID() string {
 	return strconv.FormatInt(int64(a.ID), 10)
 }
 
+// ActionSchedule is when a code monitor action is run.
+type ActionSchedule string
+
+const (
+	// ActionScheduleImmediate actions are run after every trigger run with results.
+	ActionScheduleImmediate ActionSchedule = "IMMEDIATE"
+	// ActionScheduleHourly and ActionScheduleDaily actions are run at most once
+	// per hour or day, at the start of the next hour or day (UTC) after a
+	// trigger run with results. They include the results of all the trigger
+	// runs since they last ran.
+	ActionScheduleHourly ActionSchedule = "HOURLY"
+	ActionScheduleDaily  ActionSchedule = "DAILY"
+)
+
+func (s ActionSchedule) orImmediate() ActionSchedule {
+	if s == "" {
+		return ActionScheduleImmediate
+	}
+	return s
+}
+
+// NextDigestTime returns when a digest action with this schedule enqueued at
+// the given time should run.
+func (s ActionSchedule) NextDigestTime(now time.Time) time.Time {
+	now = now.UTC()
+	switch s {
+	case ActionScheduleHourly:
+		return now.Truncate(time.Hour).Add(time.Hour)
+	case ActionScheduleDaily:
+		return time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+	default:
+		return now
+	}
+}
+
 type ActionJobMetadata struct {
 	Description string
 	MonitorID   int64
@@ -56,6 +92,7 @@ var ActionJobColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_action_jobs.email"),
 	sqlf.Sprintf("cm_action_jobs.webhook"),
 	sqlf.Sprintf("cm_action_jobs.slack_webhook"),
+	sqlf.Sprintf("cm_action_jobs.chat_webhook"),
 	sqlf.Sprintf("cm_action_jobs.trigger_event"),
 	sqlf.Sprintf("cm_action_jobs.state"),
 	sqlf.Sprintf("cm_action_jobs.failure_message"),
@@ -87,6 +124,10 @@ type ListActionJobsOpts struct {
 	// the given slack webhook action. Refers to cm_slack_webhooks(id)
 	SlackWebhookID *int
 
+	// ChatWebhookID, if set, will filter to only actions jobs that are
+	// executing the given chat webhook action. Refers to cm_chat_webhooks(id)
+	ChatWebhookID *int
+
 	// First, if defined, limits the operation to only the first n results
 	First *int
 
@@ -110,6 +151,9 @@ func (o ListActionJobsOpts) Conds() *sqlf.Query {
 	if o.SlackWebhookID != nil {
 		conds = append(conds, sqlf.Sprintf("slack_webhook = %s", *o.SlackWebhookID))
 	}
+	if o.ChatWebhookID != nil {
+		conds = append(conds, sqlf.Sprintf("chat_webhook = %s", *o.ChatWebhookID))
+	}
 	if o.After != nil {
 		conds = append(conds, sqlf.Sprintf("id > %s", *o.After))
 	}
@@ -169,54 +213,90 @@ func (s *codeMonitorStore) CountActionJobs(ctx context.Context, opts ListActionJ
 	return count, err
 }
 
+// Actions that already have a queued job are not enqueued again. For digest
+// actions, this queued job waits for the next digest time, and collects the
+// results of the following trigger runs.
 const enqueueActionEmailFmtStr = `
 WITH due_emails AS (
-	SELECT id
+	SELECT id, schedule
 	FROM cm_emails
 	WHERE monitor = %s
 		AND enabled = true
-	EXCEPT
-	SELECT DISTINCT email as id FROM cm_action_jobs
-	WHERE state = 'queued'
-		OR state = 'processing'
+		AND id NOT IN (
+			SELECT email FROM cm_action_jobs
+			WHERE email IS NOT NULL
+				AND (state = 'queued' OR state = 'processing')
+		)
 ), due_webhooks AS (
-	SELECT id
+	SELECT id, schedule
 	FROM cm_webhooks
 	WHERE monitor = %s
 		AND enabled = true
-	EXCEPT
-	SELECT DISTINCT webhook as id FROM cm_action_jobs
-	WHERE state = 'queued'
-		OR state = 'processing'
+		AND id NOT IN (
+			SELECT webhook FROM cm_action_jobs
+			WHERE webhook IS NOT NULL
+				AND (state = 'queued' OR state = 'processing')
+		)
 ), due_slack_webhooks AS (
-	SELECT id
+	SELECT id, schedule
 	FROM cm_slack_webhooks
 	WHERE monitor = %s
 		AND enabled = true
-	EXCEPT
-	SELECT DISTINCT slack_webhook as id FROM cm_action_jobs
-	WHERE state = 'queued'
-		OR state = 'processing'
+		AND id NOT IN (
+			SELECT slack_webhook FROM cm_action_jobs
+			WHERE slack_webhook IS NOT NULL
+				AND (state = 'queued' OR state = 'processing')
+		)
+), due_chat_webhooks AS (
+	SELECT id, schedule
+	FROM cm_chat_webhooks
+	WHERE monitor = %s
+		AND enabled = true
+		AND id NOT IN (
+			SELECT chat_webhook FROM cm_action_jobs
+			WHERE chat_webhook IS NOT NULL
+				AND (state = 'queued' OR state = 'processing')
+		)
 )
-INSERT INTO cm_action_jobs (email, webhook, slack_webhook, trigger_event)
-SELECT id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer from due_emails
+INSERT INTO cm_action_jobs (email, webhook, slack_webhook, chat_webhook, trigger_event, process_after)
+SELECT id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer, %s from due_emails
 UNION
-SELECT CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), %s::integer from due_webhooks
+SELECT CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer, %s from due_webhooks
 UNION
-SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, %s::integer from due_slack_webhooks
-ORDER BY 1, 2, 3
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), %s::integer, %s from due_slack_webhooks
+UNION
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, %s::integer, %s from due_chat_webhooks
+ORDER BY 1, 2, 3, 4
 RETURNING %s
 `
 
+const actionJobProcessAfterFmtStr = `
+CASE schedule WHEN %s THEN %s::timestamptz WHEN %s THEN %s::timestamptz ELSE NULL::timestamptz END
+`
+
 func (s *codeMonitorStore) EnqueueActionJobsForMonitor(ctx context.Context, monitorID int64, triggerJobID int32) ([]*ActionJob, error) {
+	now := s.Now()
+	processAfter := sqlf.Sprintf(
+		actionJobProcessAfterFmtStr,
+		ActionScheduleHourly,
+		ActionScheduleHourly.NextDigestTime(now),
+		ActionScheduleDaily,
+		ActionScheduleDaily.NextDigestTime(now),
+	)
 	q := sqlf.Sprintf(
 		enqueueActionEmailFmtStr,
 		monitorID,
 		monitorID,
 		monitorID,
+		monitorID,
+		triggerJobID,
+		processAfter,
 		triggerJobID,
+		processAfter,
 		triggerJobID,
+		processAfter,
 		triggerJobID,
+		processAfter,
 		sqlf.Join(ActionJobColumns, ","),
 	)
 	rows, err := s.Query(ctx, q)
@@ -233,28 +313,79 @@ SELECT
 	ctj.query_string,
 	cm.id AS monitorID,
 	ctj.search_results,
-	CASE WHEN LENGTH(users.display_name) > 0 THEN users.display_name ELSE users.username END
+	CASE WHEN LENGTH(users.display_name) > 0 THEN users.display_name ELSE users.username END,
+	COALESCE(ce.schedule, cw.schedule, csw.schedule, cchw.schedule)
 FROM cm_action_jobs caj
 INNER JOIN cm_trigger_jobs ctj on caj.trigger_event = ctj.id
 INNER JOIN cm_queries cq on cq.id = ctj.query
 INNER JOIN cm_monitors cm on cm.id = cq.monitor
 INNER JOIN users on cm.namespace_user_id = users.id
+LEFT JOIN cm_emails ce on ce.id = caj.email
+LEFT JOIN cm_webhooks cw on cw.id = caj.webhook
+LEFT JOIN cm_slack_webhooks csw on csw.id = caj.slack_webhook
+LEFT JOIN cm_chat_webhooks cchw on cchw.id = caj.chat_webhook
 WHERE caj.id = %s
 `
 
-// GetActionJobMetada returns the set of fields needed to execute all action jobs
+// getDigestResultsFmtStr selects the results of the trigger runs since the
+// last time the action of a digest action job ran, newest first. If it never
+// ran, that's since the trigger run which enqueued the job. Results of trigger
+// runs which were already notified are deduplicated by the action runner.
+const getDigestResultsFmtStr = `
+SELECT ctj.search_results
+FROM cm_action_jobs caj
+INNER JOIN cm_trigger_jobs trigger_event on trigger_event.id = caj.trigger_event
+INNER JOIN cm_trigger_jobs ctj on ctj.query = trigger_event.query
+WHERE caj.id = %s
+	AND ctj.state = 'completed'
+	AND jsonb_array_length(ctj.search_results) > 0
+	AND ctj.id >= COALESCE((
+		SELECT MAX(prev.trigger_event) + 1
+		FROM cm_action_jobs prev
+		WHERE prev.id <> caj.id
+			AND prev.state = 'completed'
+			AND (prev.email = caj.email OR prev.webhook = caj.webhook OR prev.slack_webhook = caj.slack_webhook OR prev.chat_webhook = caj.chat_webhook)
+	), caj.trigger_event)
+ORDER BY ctj.id DESC
+`
+
+// GetActionJobMetada returns the set of fields needed to execute all action jobs.
+// For digest actions, the results are those of all the trigger runs since the
+// action last ran.
 func (s *codeMonitorStore) GetActionJobMetadata(ctx context.Context, jobID int32) (*ActionJobMetadata, error) {
 	row := s.Store.QueryRow(ctx, sqlf.Sprintf(getActionJobMetadataFmtStr, jobID))
-	var resultsJSON []byte
+	var (
+		resultsJSON []byte
+		schedule    ActionSchedule
+	)
 	m := &ActionJobMetadata{}
-	err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &resultsJSON, &m.OwnerName)
+	err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &resultsJSON, &m.OwnerName, &schedule)
 	if err != nil {
 		return nil, err
 	}
-	if err := json.Unmarshal(resultsJSON, &m.Results); err != nil {
+	if schedule == ActionScheduleImmediate {
+		if err := json.Unmarshal(resultsJSON, &m.Results); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	rows, err := s.Store.Query(ctx, sqlf.Sprintf(getDigestResultsFmtStr, jobID))
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	defer rows.Close()
+	for rows.Next() {
+		var results []*result.CommitMatch
+		if err := rows.Scan(&resultsJSON); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(resultsJSON, &results); err != nil {
+			return nil, err
+		}
+		m.Results = append(m.Results, results...)
+	}
+	return m, rows.Err()
 }
 
 const actionJobForIDFmtStr = `
@@ -288,6 +419,7 @@ func ScanActionJob(row dbutil.Scanner) (*ActionJob, error) {
 		&aj.Email,
 		&aj.Webhook,
 		&aj.SlackWebhook,
+		&aj.ChatWebhook,
 		&aj.TriggerEvent,
 		&aj.State,
 		&aj.FailureMessage,
@@ -7,7 +7,9 @@ import (
 	"github.com/keegancsmith/sqlf"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
 func TestEnqueueActionEmailsForQueryIDInt64QueryByRecordID(t *testing.T) {
@@ -96,3 +98,77 @@ func TestScanActionJob(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int(actionJobID), job.RecordID())
 }
+
+func TestActionScheduleNextDigestTime(t *testing.T) {
+	now := time.Date(2023, 11, 30, 17, 42, 5, 0, time.FixedZone("UTC-2", -2*60*60))
+
+	require.Equal(t, time.Date(2023, 11, 30, 20, 0, 0, 0, time.UTC), ActionScheduleHourly.NextDigestTime(now))
+	require.Equal(t, time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), ActionScheduleDaily.NextDigestTime(now))
+	require.Equal(t, now.UTC(), ActionScheduleImmediate.NextDigestTime(now))
+}
+
+func TestGetActionJobMetadataDigest(t *testing.T) {
+	ctx, db, s := newTestStore(t)
+	_, _, userCTX := newTestUser(ctx, t, db)
+	fixtures := s.insertTestMonitor(userCTX, t)
+
+	// The first email action is a daily digest, the second one is sent immediately.
+	err := s.Exec(ctx, sqlf.Sprintf("UPDATE cm_emails SET schedule = %s WHERE id = %s", ActionScheduleDaily, fixtures.emails[0].ID))
+	require.NoError(t, err)
+
+	runTrigger := func(state string, repoNames ...api.RepoName) int32 {
+		t.Helper()
+		triggerJobs, err := s.EnqueueQueryTriggerJobs(ctx)
+		require.NoError(t, err)
+		require.Len(t, triggerJobs, 1)
+
+		results := make([]*result.CommitMatch, 0, len(repoNames))
+		for _, name := range repoNames {
+			results = append(results, &result.CommitMatch{Repo: types.MinimalRepo{Name: name}})
+		}
+		require.NoError(t, s.UpdateTriggerJobWithResults(ctx, triggerJobs[0].ID, testQuery, results))
+		require.NoError(t, s.Exec(ctx, sqlf.Sprintf("UPDATE cm_trigger_jobs SET state = %s WHERE id = %s", state, triggerJobs[0].ID)))
+		return triggerJobs[0].ID
+	}
+
+	enqueueDigest := func(triggerJobID int32) (digest, immediate *ActionJob) {
+		t.Helper()
+		actionJobs, err := s.EnqueueActionJobsForMonitor(ctx, fixtures.monitor.ID, triggerJobID)
+		require.NoError(t, err)
+		require.Len(t, actionJobs, 2)
+		return actionJobs[0], actionJobs[1]
+	}
+
+	repoNames := func(actionJobID int32) []api.RepoName {
+		t.Helper()
+		m, err := s.GetActionJobMetadata(ctx, actionJobID)
+		require.NoError(t, err)
+		names := make([]api.RepoName, 0, len(m.Results))
+		for _, r := range m.Results {
+			names = append(names, r.Repo.Name)
+		}
+		return names
+	}
+
+	complete := func(actionJobs ...*ActionJob) {
+		t.Helper()
+		for _, aj := range actionJobs {
+			require.NoError(t, s.Exec(ctx, sqlf.Sprintf("UPDATE cm_action_jobs SET state = 'completed' WHERE id = %s", aj.ID)))
+		}
+	}
+
+	// A digest that never ran contains the results of the trigger run that enqueued it.
+	digest, immediate := enqueueDigest(runTrigger("completed", "a"))
+	require.Equal(t, []api.RepoName{"a"}, repoNames(digest.ID))
+	require.Equal(t, []api.RepoName{"a"}, repoNames(immediate.ID))
+	complete(digest, immediate)
+
+	// Afterwards, a digest contains the results of all completed trigger runs since
+	// it last ran, newest first. Runs without results or which failed are skipped.
+	runTrigger("completed", "b", "c")
+	runTrigger("completed")
+	runTrigger("failed", "d")
+	digest, immediate = enqueueDigest(runTrigger("completed", "e"))
+	require.Equal(t, []api.RepoName{"e", "b", "c"}, repoNames(digest.ID))
+	require.Equal(t, []api.RepoName{"e"}, repoNames(immediate.ID))
+}
@@ -0,0 +1,243 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// ChatWebhookFormat is the format of the messages a chat webhook action sends.
+type ChatWebhookFormat string
+
+const (
+	// ChatWebhookFormatTeams messages are Microsoft Teams adaptive cards.
+	ChatWebhookFormatTeams ChatWebhookFormat = "TEAMS"
+	// ChatWebhookFormatMattermost messages are Mattermost incoming webhook
+	// payloads.
+	ChatWebhookFormatMattermost ChatWebhookFormat = "MATTERMOST"
+)
+
+type ChatWebhookAction struct {
+	ID             int64
+	Monitor        int64
+	Format         ChatWebhookFormat
+	Enabled        bool
+	URL            string
+	IncludeResults bool
+	Schedule       ActionSchedule
+
+	CreatedBy int32
+	CreatedAt time.Time
+	ChangedBy int32
+	ChangedAt time.Time
+}
+
+type ChatWebhookActionArgs struct {
+	Format         ChatWebhookFormat
+	Enabled        bool
+	IncludeResults bool
+	URL            string
+	Schedule       ActionSchedule
+}
+
+const updateChatWebhookActionQuery = `
+UPDATE cm_chat_webhooks
+SET format = %s,
+	enabled = %s,
+	include_results = %s,
+	url = %s,
+	schedule = %s,
+	changed_by = %s,
+	changed_at = %s
+WHERE
+	id = %s
+	AND EXISTS (
+		SELECT 1 FROM cm_monitors
+		WHERE cm_monitors.id = cm_chat_webhooks.monitor
+			AND %s
+	)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) UpdateChatWebhookAction(ctx context.Context, id int64, args *ChatWebhookActionArgs) (*ChatWebhookAction, error) {
+	a := actor.FromContext(ctx)
+
+	user, err := a.User(ctx, s.userStore)
+	if err != nil {
+		return nil, err
+	}
+
+	q := sqlf.Sprintf(
+		updateChatWebhookActionQuery,
+		args.Format,
+		args.Enabled,
+		args.IncludeResults,
+		args.URL,
+		args.Schedule.orImmediate(),
+		a.UID,
+		s.Now(),
+		id,
+		namespaceScopeQuery(user),
+		sqlf.Join(chatWebhookActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanChatWebhookAction(row)
+}
+
+const createChatWebhookActionQuery = `
+INSERT INTO cm_chat_webhooks
+(monitor, format, enabled, include_results, url, schedule, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) CreateChatWebhookAction(ctx context.Context, monitorID int64, args *ChatWebhookActionArgs) (*ChatWebhookAction, error) {
+	now := s.Now()
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		createChatWebhookActionQuery,
+		monitorID,
+		args.Format,
+		args.Enabled,
+		args.IncludeResults,
+		args.URL,
+		args.Schedule.orImmediate(),
+		a.UID,
+		now,
+		a.UID,
+		now,
+		sqlf.Join(chatWebhookActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanChatWebhookAction(row)
+}
+
+const deleteChatWebhookActionQuery = `
+DELETE FROM cm_chat_webhooks
+WHERE id in (%s)
+	AND MONITOR = %s
+`
+
+func (s *codeMonitorStore) DeleteChatWebhookActions(ctx context.Context, monitorID int64, webhookIDs ...int64) error {
+	if len(webhookIDs) == 0 {
+		return nil
+	}
+
+	deleteIDs := make([]*sqlf.Query, 0, len(webhookIDs))
+	for _, ids := range webhookIDs {
+		deleteIDs = append(deleteIDs, sqlf.Sprintf("%d", ids))
+	}
+	q := sqlf.Sprintf(
+		deleteChatWebhookActionQuery,
+		sqlf.Join(deleteIDs, ","),
+		monitorID,
+	)
+
+	return s.Exec(ctx, q)
+}
+
+const countChatWebhookActionsQuery = `
+SELECT COUNT(*)
+FROM cm_chat_webhooks
+WHERE monitor = %s;
+`
+
+func (s *codeMonitorStore) CountChatWebhookActions(ctx context.Context, monitorID int64) (int, error) {
+	var count int
+	err := s.QueryRow(ctx, sqlf.Sprintf(countChatWebhookActionsQuery, monitorID)).Scan(&count)
+	return count, err
+}
+
+const getChatWebhookActionQuery = `
+SELECT %s -- ChatWebhookActionColumns
+FROM cm_chat_webhooks
+WHERE id = %s
+`
+
+func (s *codeMonitorStore) GetChatWebhookAction(ctx context.Context, id int64) (*ChatWebhookAction, error) {
+	q := sqlf.Sprintf(
+		getChatWebhookActionQuery,
+		sqlf.Join(chatWebhookActionColumns, ","),
+		id,
+	)
+	row := s.QueryRow(ctx, q)
+	return scanChatWebhookAction(row)
+}
+
+const listChatWebhookActionsQuery = `
+SELECT %s -- ChatWebhookActionColumns
+FROM cm_chat_webhooks
+WHERE %s
+ORDER BY id ASC
+LIMIT %s;
+`
+
+func (s *codeMonitorStore) ListChatWebhookActions(ctx context.Context, opts ListActionsOpts) ([]*ChatWebhookAction, error) {
+	q := sqlf.Sprintf(
+		listChatWebhookActionsQuery,
+		sqlf.Join(chatWebhookActionColumns, ","),
+		opts.Conds(),
+		opts.Limit(),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanChatWebhookActions(rows)
+}
+
+// chatWebhookActionColumns is the set of columns in the cm_chat_webhooks table
+// This must be kept in sync with scanChatWebhookAction
+var chatWebhookActionColumns = []*sqlf.Query{
+	sqlf.Sprintf("cm_chat_webhooks.id"),
+	sqlf.Sprintf("cm_chat_webhooks.monitor"),
+	sqlf.Sprintf("cm_chat_webhooks.format"),
+	sqlf.Sprintf("cm_chat_webhooks.enabled"),
+	sqlf.Sprintf("cm_chat_webhooks.url"),
+	sqlf.Sprintf("cm_chat_webhooks.include_results"),
+	sqlf.Sprintf("cm_chat_webhooks.schedule"),
+	sqlf.Sprintf("cm_chat_webhooks.created_by"),
+	sqlf.Sprintf("cm_chat_webhooks.created_at"),
+	sqlf.Sprintf("cm_chat_webhooks.changed_by"),
+	sqlf.Sprintf("cm_chat_webhooks.changed_at"),
+}
+
+func scanChatWebhookActions(rows *sql.Rows) ([]*ChatWebhookAction, error) {
+	var ws []*ChatWebhookAction
+	for rows.Next() {
+		w, err := scanChatWebhookAction(rows)
+		if err != nil {
+			return nil, err
+		}
+		ws = append(ws, w)
+	}
+	return ws, rows.Err()
+}
+
+// scanChatWebhookAction scans a ChatWebhookAction from a *sql.Row or *sql.Rows.
+// It must be kept in sync with chatWebhookActionColumns.
+func scanChatWebhookAction(scanner dbutil.Scanner) (*ChatWebhookAction, error) {
+	var w ChatWebhookAction
+	err := scanner.Scan(
+		&w.ID,
+		&w.Monitor,
+		&w.Format,
+		&w.Enabled,
+		&w.URL,
+		&w.IncludeResults,
+		&w.Schedule,
+		&w.CreatedBy,
+		&w.CreatedAt,
+		&w.ChangedBy,
+		&w.ChangedAt,
+	)
+	return &w, err
+}
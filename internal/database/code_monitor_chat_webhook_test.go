@@ -0,0 +1,199 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestCodeMonitorStoreChatWebhooks(t *testing.T) {
+	ctx := context.Background()
+	teams := &ChatWebhookActionArgs{
+		Format:  ChatWebhookFormatTeams,
+		Enabled: true,
+		URL:     "https://icanhazcheezburger.webhook.office.com/webhookb2/1",
+	}
+	mattermost := &ChatWebhookActionArgs{
+		Format:         ChatWebhookFormatMattermost,
+		IncludeResults: true,
+		URL:            "https://mattermost.icanhazcheezburger.com/hooks/1",
+		Schedule:       ActionScheduleDaily,
+	}
+
+	logger := logtest.Scoped(t)
+
+	t.Run("CreateThenGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateChatWebhookAction(ctx, fixtures.monitor.ID, teams)
+		require.NoError(t, err)
+		require.Equal(t, ChatWebhookFormatTeams, action.Format)
+		require.Equal(t, ActionScheduleImmediate, action.Schedule)
+
+		got, err := s.GetChatWebhookAction(ctx, action.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, action, got)
+	})
+
+	t.Run("CreateUpdateGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateChatWebhookAction(ctx, fixtures.monitor.ID, teams)
+		require.NoError(t, err)
+
+		updated, err := s.UpdateChatWebhookAction(ctx, action.ID, mattermost)
+		require.NoError(t, err)
+		require.Equal(t, ChatWebhookFormatMattermost, updated.Format)
+		require.Equal(t, false, updated.Enabled)
+		require.Equal(t, true, updated.IncludeResults)
+		require.Equal(t, mattermost.URL, updated.URL)
+		require.Equal(t, ActionScheduleDaily, updated.Schedule)
+
+		got, err := s.GetChatWebhookAction(ctx, action.ID)
+		require.NoError(t, err)
+		require.Equal(t, updated, got)
+	})
+
+	t.Run("ErrorOnUpdateNonexistent", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+
+		_, err := s.UpdateChatWebhookAction(ctx, 383838, mattermost)
+		require.Error(t, err)
+	})
+
+	t.Run("CreateDeleteGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action1, err := s.CreateChatWebhookAction(ctx, fixtures.monitor.ID, teams)
+		require.NoError(t, err)
+
+		action2, err := s.CreateChatWebhookAction(ctx, fixtures.monitor.ID, mattermost)
+		require.NoError(t, err)
+
+		err = s.DeleteChatWebhookActions(ctx, fixtures.monitor.ID, action1.ID)
+		require.NoError(t, err)
+
+		_, err = s.GetChatWebhookAction(ctx, action1.ID)
+		require.Error(t, err)
+
+		_, err = s.GetChatWebhookAction(ctx, action2.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("CountCreateList", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		count, err := s.CountChatWebhookActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+
+		_, err = s.CreateChatWebhookAction(ctx, fixtures.monitor.ID, teams)
+		require.NoError(t, err)
+
+		_, err = s.CreateChatWebhookAction(ctx, fixtures.monitor.ID, mattermost)
+		require.NoError(t, err)
+
+		count, err = s.CountChatWebhookActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		actions, err := s.ListChatWebhookActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID})
+		require.NoError(t, err)
+		require.Len(t, actions, 2)
+
+		first := 1
+		actions, err = s.ListChatWebhookActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID, First: &first})
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+	})
+
+	t.Run("EnqueueActionJobs", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateChatWebhookAction(ctx, fixtures.monitor.ID, teams)
+		require.NoError(t, err)
+
+		triggerJobs, err := s.EnqueueQueryTriggerJobs(ctx)
+		require.NoError(t, err)
+		require.Len(t, triggerJobs, 1)
+
+		actionJobs, err := s.EnqueueActionJobsForMonitor(ctx, fixtures.monitor.ID, triggerJobs[0].ID)
+		require.NoError(t, err)
+
+		var chatJobs []*ActionJob
+		for _, j := range actionJobs {
+			if j.ChatWebhook != nil {
+				chatJobs = append(chatJobs, j)
+			}
+		}
+		require.Len(t, chatJobs, 1)
+		require.Equal(t, action.ID, *chatJobs[0].ChatWebhook)
+	})
+
+	t.Run("Update permissions", func(t *testing.T) {
+		ctx, db, s := newTestStore(t)
+		uid1 := insertTestUser(ctx, t, db, "u1", false)
+		ctx1 := actor.WithActor(ctx, actor.FromUser(uid1))
+		uid2 := insertTestUser(ctx, t, db, "u2", false)
+		ctx2 := actor.WithActor(ctx, actor.FromUser(uid2))
+		uid3 := insertTestUser(ctx, t, db, "u3", true)
+		ctx3 := actor.WithActor(ctx, actor.FromUser(uid3))
+		fixtures := s.insertTestMonitor(ctx1, t)
+		_ = s.insertTestMonitor(ctx2, t)
+
+		wa, err := s.CreateChatWebhookAction(ctx1, fixtures.monitor.ID, teams)
+		require.NoError(t, err)
+
+		// User1 can update it
+		_, err = s.UpdateChatWebhookAction(ctx1, wa.ID, mattermost)
+		require.NoError(t, err)
+
+		// User2 cannot update it
+		_, err = s.UpdateChatWebhookAction(ctx2, wa.ID, teams)
+		require.Error(t, err)
+
+		// User3 can update it
+		_, err = s.UpdateChatWebhookAction(ctx3, wa.ID, teams)
+		require.NoError(t, err)
+
+		wa, err = s.GetChatWebhookAction(ctx1, wa.ID)
+		require.NoError(t, err)
+		require.Equal(t, teams.URL, wa.URL)
+	})
+}
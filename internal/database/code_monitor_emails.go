@@ -18,6 +18,7 @@ type EmailAction struct {
 	Priority       string
 	Header         string
 	IncludeResults bool
+	Schedule       ActionSchedule
 	CreatedBy      int32
 	CreatedAt      time.Time
 	ChangedBy      int32
@@ -30,6 +31,7 @@ SET enabled = %s,
     include_results = %s,
 	priority = %s,
 	header = %s,
+	schedule = %s,
 	changed_by = %s,
 	changed_at = %s
 WHERE
@@ -47,6 +49,7 @@ type EmailActionArgs struct {
 	IncludeResults bool
 	Priority       string
 	Header         string
+	Schedule       ActionSchedule
 }
 
 func (s *codeMonitorStore) UpdateEmailAction(ctx context.Context, id int64, args *EmailActionArgs) (*EmailAction, error) {
@@ -63,6 +66,7 @@ func (s *codeMonitorStore) UpdateEmailAction(ctx context.Context, id int64, args
 		args.IncludeResults,
 		args.Priority,
 		args.Header,
+		args.Schedule.orImmediate(),
 		a.UID,
 		s.Now(),
 		id,
@@ -76,8 +80,8 @@ func (s *codeMonitorStore) UpdateEmailAction(ctx context.Context, id int64, args
 
 const createActionEmailFmtStr = `
 INSERT INTO cm_emails
-(monitor, enabled, include_results, priority, header, created_by, created_at, changed_by, changed_at)
-VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s)
+(monitor, enabled, include_results, priority, header, schedule, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s,%s)
 RETURNING %s;
 `
 
@@ -91,6 +95,7 @@ func (s *codeMonitorStore) CreateEmailAction(ctx context.Context, monitorID int6
 		args.IncludeResults,
 		args.Priority,
 		args.Header,
+		args.Schedule.orImmediate(),
 		a.UID,
 		now,
 		a.UID,
@@ -208,6 +213,7 @@ var emailsColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_emails.priority"),
 	sqlf.Sprintf("cm_emails.header"),
 	sqlf.Sprintf("cm_emails.include_results"),
+	sqlf.Sprintf("cm_emails.schedule"),
 	sqlf.Sprintf("cm_emails.created_by"),
 	sqlf.Sprintf("cm_emails.created_at"),
 	sqlf.Sprintf("cm_emails.changed_by"),
@@ -237,6 +243,7 @@ func scanEmail(scanner dbutil.Scanner) (*EmailAction, error) {
 		&m.Priority,
 		&m.Header,
 		&m.IncludeResults,
+		&m.Schedule,
 		&m.CreatedBy,
 		&m.CreatedAt,
 		&m.ChangedBy,
@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NotifiedMatch is a file of a commit a code monitor action notified about.
+type NotifiedMatch struct {
+	RepoID   api.RepoID
+	CommitID api.CommitID
+	// Path is a file modified by the commit, or empty for matches of the
+	// commit message.
+	Path string
+}
+
+const listNotifiedMatchesFmtStr = `
+SELECT repo_id, commit_id, path
+FROM cm_notified_matches
+WHERE %s
+	AND commit_id = ANY(%s)
+`
+
+// ListNotifiedMatches returns the matches of the given commits the action of
+// the given job already notified about.
+func (s *codeMonitorStore) ListNotifiedMatches(ctx context.Context, job *ActionJob, commitIDs []api.CommitID) ([]NotifiedMatch, error) {
+	if len(commitIDs) == 0 {
+		return nil, nil
+	}
+	actionCond, err := actionJobActionCond(job)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(commitIDs))
+	for _, id := range commitIDs {
+		ids = append(ids, string(id))
+	}
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(listNotifiedMatchesFmtStr, actionCond, pq.Array(ids)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matches []NotifiedMatch
+	for rows.Next() {
+		var m NotifiedMatch
+		if err := rows.Scan(&m.RepoID, &m.CommitID, &m.Path); err != nil {
+			return nil, err
+		}
+		matches = append(matches, m)
+	}
+	return matches, rows.Err()
+}
+
+const createNotifiedMatchesFmtStr = `
+INSERT INTO cm_notified_matches (email, webhook, slack_webhook, chat_webhook, repo_id, commit_id, path, notified_at)
+VALUES %s
+ON CONFLICT DO NOTHING
+`
+
+// CreateNotifiedMatches records that the action of the given job notified
+// about the given matches.
+func (s *codeMonitorStore) CreateNotifiedMatches(ctx context.Context, job *ActionJob, matches []NotifiedMatch) error {
+	if len(matches) == 0 {
+		return nil
+	}
+	if _, err := actionJobActionCond(job); err != nil {
+		return err
+	}
+	now := s.Now()
+	values := make([]*sqlf.Query, 0, len(matches))
+	for _, m := range matches {
+		values = append(values, sqlf.Sprintf(
+			"(%s, %s, %s, %s, %s, %s, %s, %s)",
+			job.Email,
+			job.Webhook,
+			job.SlackWebhook,
+			job.ChatWebhook,
+			m.RepoID,
+			m.CommitID,
+			m.Path,
+			now,
+		))
+	}
+	return s.Exec(ctx, sqlf.Sprintf(createNotifiedMatchesFmtStr, sqlf.Join(values, ", ")))
+}
+
+const deleteOldNotifiedMatchesFmtStr = `
+DELETE FROM cm_notified_matches
+WHERE notified_at < (NOW() - (%s * '1 day'::interval));
+`
+
+// DeleteOldNotifiedMatches deletes the notified matches which are older than
+// 'retention' days.
+func (s *codeMonitorStore) DeleteOldNotifiedMatches(ctx context.Context, retentionInDays int) error {
+	return s.Exec(ctx, sqlf.Sprintf(deleteOldNotifiedMatchesFmtStr, retentionInDays))
+}
+
+// actionJobActionCond returns the condition on the action columns selecting
+// the action of the given job.
+func actionJobActionCond(job *ActionJob) (*sqlf.Query, error) {
+	switch {
+	case job.Email != nil:
+		return sqlf.Sprintf("email = %s", *job.Email), nil
+	case job.Webhook != nil:
+		return sqlf.Sprintf("webhook = %s", *job.Webhook), nil
+	case job.SlackWebhook != nil:
+		return sqlf.Sprintf("slack_webhook = %s", *job.SlackWebhook), nil
+	case job.ChatWebhook != nil:
+		return sqlf.Sprintf("chat_webhook = %s", *job.ChatWebhook), nil
+	default:
+		return nil, errors.New("job must be one of type email, webhook, slack webhook, or chat webhook")
+	}
+}
@@ -5,15 +5,20 @@ import (
 	"time"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 )
 
+// QueryTrigger is the trigger of a code monitor. It is either a search query,
+// or a path trigger if PathTrigger is set, in which case QueryString is empty.
 type QueryTrigger struct {
 	ID           int64
 	Monitor      int64
 	QueryString  string
+	PathTrigger  *PathTrigger
 	NextRun      time.Time
 	LatestResult *time.Time
 	CreatedBy    int32
@@ -22,6 +27,18 @@ type QueryTrigger struct {
 	ChangedAt    time.Time
 }
 
+// PathTrigger fires for new commits of the given repositories that modify a
+// file matching any of PathPatterns, and owned by Owner according to the
+// ownership rules of the repository. Either of PathPatterns and Owner can be
+// empty, in which case it doesn't restrict the modified files.
+type PathTrigger struct {
+	RepoIDs []api.RepoID
+	// PathPatterns are CODEOWNERS-style glob patterns.
+	PathPatterns []string
+	// Owner is the handle or email of an owner, as used in ownership rules.
+	Owner string
+}
+
 // queryColumns is the set of columns in cm_queries
 // It must be kept in sync with scanTriggerQuery
 var queryColumns = []*sqlf.Query{
@@ -34,22 +51,37 @@ var queryColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_queries.created_at"),
 	sqlf.Sprintf("cm_queries.changed_by"),
 	sqlf.Sprintf("cm_queries.changed_at"),
+	sqlf.Sprintf("cm_queries.repo_ids"),
+	sqlf.Sprintf("cm_queries.path_patterns"),
+	sqlf.Sprintf("cm_queries.owner"),
 }
 
 const createTriggerQueryFmtStr = `
 INSERT INTO cm_queries
-(monitor, query, created_by, created_at, changed_by, changed_at, next_run, latest_result)
-VALUES (%s,%s,%s,%s,%s,%s,%s,%s)
+(monitor, query, repo_ids, path_patterns, owner, created_by, created_at, changed_by, changed_at, next_run, latest_result)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s)
 RETURNING %s;
 `
 
 func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int64, query string) (*QueryTrigger, error) {
+	return s.createTrigger(ctx, monitorID, query, PathTrigger{})
+}
+
+func (s *codeMonitorStore) CreatePathTrigger(ctx context.Context, monitorID int64, trigger PathTrigger) (*QueryTrigger, error) {
+	return s.createTrigger(ctx, monitorID, "", trigger)
+}
+
+func (s *codeMonitorStore) createTrigger(ctx context.Context, monitorID int64, query string, trigger PathTrigger) (*QueryTrigger, error) {
 	now := s.Now()
 	a := actor.FromContext(ctx)
+	repoIDs, pathPatterns, owner := pathTriggerColumns(trigger)
 	q := sqlf.Sprintf(
 		createTriggerQueryFmtStr,
 		monitorID,
 		query,
+		repoIDs,
+		pathPatterns,
+		owner,
 		a.UID,
 		now,
 		a.UID,
@@ -65,6 +97,9 @@ func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int
 const updateTriggerQueryFmtStr = `
 UPDATE cm_queries
 SET query = %s,
+	repo_ids = %s,
+	path_patterns = %s,
+	owner = %s,
 	changed_by = %s,
 	changed_at = %s,
 	latest_result = %s
@@ -79,6 +114,14 @@ RETURNING %s;
 `
 
 func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, query string) error {
+	return s.updateTrigger(ctx, id, query, PathTrigger{})
+}
+
+func (s *codeMonitorStore) UpdatePathTrigger(ctx context.Context, id int64, trigger PathTrigger) error {
+	return s.updateTrigger(ctx, id, "", trigger)
+}
+
+func (s *codeMonitorStore) updateTrigger(ctx context.Context, id int64, query string, trigger PathTrigger) error {
 	now := s.Now()
 	a := actor.FromContext(ctx)
 
@@ -87,9 +130,13 @@ func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, que
 		return err
 	}
 
+	repoIDs, pathPatterns, owner := pathTriggerColumns(trigger)
 	q := sqlf.Sprintf(
 		updateTriggerQueryFmtStr,
 		query,
+		repoIDs,
+		pathPatterns,
+		owner,
 		a.UID,
 		now,
 		now,
@@ -163,10 +210,32 @@ func (s *codeMonitorStore) SetQueryTriggerNextRun(ctx context.Context, triggerQu
 	return s.Exec(ctx, q)
 }
 
+// pathTriggerColumns returns the values of the path trigger columns of
+// cm_queries for the given trigger. The zero trigger resets the columns.
+func pathTriggerColumns(trigger PathTrigger) (repoIDs, pathPatterns any, owner *string) {
+	ids := make([]int32, 0, len(trigger.RepoIDs))
+	for _, id := range trigger.RepoIDs {
+		ids = append(ids, int32(id))
+	}
+	patterns := trigger.PathPatterns
+	if patterns == nil {
+		patterns = []string{}
+	}
+	if trigger.Owner != "" {
+		owner = &trigger.Owner
+	}
+	return pq.Array(ids), pq.Array(patterns), owner
+}
+
 // scanQueryTrigger scans a *sql.Rows or *sql.Row into a MonitorQuery
 // It must be kept in sync with queryColumns
 func scanTriggerQuery(scanner dbutil.Scanner) (*QueryTrigger, error) {
-	m := &QueryTrigger{}
+	var (
+		m            = &QueryTrigger{}
+		repoIDs      []int32
+		pathPatterns []string
+		owner        *string
+	)
 	err := scanner.Scan(
 		&m.ID,
 		&m.Monitor,
@@ -177,6 +246,22 @@ func scanTriggerQuery(scanner dbutil.Scanner) (*QueryTrigger, error) {
 		&m.CreatedAt,
 		&m.ChangedBy,
 		&m.ChangedAt,
+		pq.Array(&repoIDs),
+		pq.Array(&pathPatterns),
+		&owner,
 	)
-	return m, err
+	if err != nil {
+		return m, err
+	}
+	// Path triggers always watch at least one repository.
+	if len(repoIDs) > 0 {
+		m.PathTrigger = &PathTrigger{PathPatterns: pathPatterns}
+		for _, id := range repoIDs {
+			m.PathTrigger.RepoIDs = append(m.PathTrigger.RepoIDs, api.RepoID(id))
+		}
+		if owner != nil {
+			m.PathTrigger.Owner = *owner
+		}
+	}
+	return m, nil
 }
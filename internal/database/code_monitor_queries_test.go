@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 )
 
 func TestQueryTriggerForJob(t *testing.T) {
@@ -80,6 +81,44 @@ func TestUpdateTrigger(t *testing.T) {
 	require.Equal(t, qt.QueryString, "query1")
 }
 
+func TestPathTrigger(t *testing.T) {
+	ctx, db, s := newTestStore(t)
+	_, _, userCTX := newTestUser(ctx, t, db)
+	fixtures := s.insertTestMonitor(userCTX, t)
+
+	// Query triggers have no path trigger.
+	require.Nil(t, fixtures.query.PathTrigger)
+
+	trigger := PathTrigger{
+		RepoIDs:      []api.RepoID{1, 2},
+		PathPatterns: []string{"/docs/", "*.md"},
+		Owner:        "@sourcegraph/docs",
+	}
+	err := s.UpdatePathTrigger(userCTX, fixtures.query.ID, trigger)
+	require.NoError(t, err)
+
+	qt, err := s.GetQueryTriggerForMonitor(userCTX, fixtures.monitor.ID)
+	require.NoError(t, err)
+	require.Equal(t, "", qt.QueryString)
+	require.Equal(t, &trigger, qt.PathTrigger)
+
+	// Switching back to a query resets the path trigger.
+	err = s.UpdateQueryTrigger(userCTX, fixtures.query.ID, "query1")
+	require.NoError(t, err)
+
+	qt, err = s.GetQueryTriggerForMonitor(userCTX, fixtures.monitor.ID)
+	require.NoError(t, err)
+	require.Equal(t, "query1", qt.QueryString)
+	require.Nil(t, qt.PathTrigger)
+
+	// Path triggers without an owner are stored as such.
+	m, err := s.CreateMonitor(userCTX, MonitorArgs{Description: testDescription, Enabled: true, NamespaceUserID: &fixtures.monitor.UserID})
+	require.NoError(t, err)
+	qt, err = s.CreatePathTrigger(userCTX, m.ID, PathTrigger{RepoIDs: []api.RepoID{1}, PathPatterns: []string{"*.go"}})
+	require.NoError(t, err)
+	require.Equal(t, &PathTrigger{RepoIDs: []api.RepoID{1}, PathPatterns: []string{"*.go"}}, qt.PathTrigger)
+}
+
 func TestResetTriggerQueryTimestamps(t *testing.T) {
 	ctx, db, s := newTestStore(t)
 	_, _, userCTX := newTestUser(ctx, t, db)
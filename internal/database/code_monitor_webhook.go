@@ -17,6 +17,7 @@ type WebhookAction struct {
 	Enabled        bool
 	URL            string
 	IncludeResults bool
+	Schedule       ActionSchedule
 
 	CreatedBy int32
 	CreatedAt time.Time
@@ -29,6 +30,7 @@ UPDATE cm_webhooks
 SET enabled = %s,
     include_results = %s,
 	url = %s,
+	schedule = %s,
 	changed_by = %s,
 	changed_at = %s
 WHERE
@@ -41,7 +43,7 @@ WHERE
 RETURNING %s;
 `
 
-func (s *codeMonitorStore) UpdateWebhookAction(ctx context.Context, id int64, enabled, includeResults bool, url string) (*WebhookAction, error) {
+func (s *codeMonitorStore) UpdateWebhookAction(ctx context.Context, id int64, enabled, includeResults bool, url string, schedule ActionSchedule) (*WebhookAction, error) {
 	a := actor.FromContext(ctx)
 
 	user, err := a.User(ctx, s.userStore)
@@ -54,6 +56,7 @@ func (s *codeMonitorStore) UpdateWebhookAction(ctx context.Context, id int64, en
 		enabled,
 		includeResults,
 		url,
+		schedule.orImmediate(),
 		a.UID,
 		s.Now(),
 		id,
@@ -67,12 +70,12 @@ func (s *codeMonitorStore) UpdateWebhookAction(ctx context.Context, id int64, en
 
 const createWebhookActionQuery = `
 INSERT INTO cm_webhooks
-(monitor, enabled, include_results, url, created_by, created_at, changed_by, changed_at)
-VALUES (%s,%s,%s,%s,%s,%s,%s,%s)
+(monitor, enabled, include_results, url, schedule, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s)
 RETURNING %s;
 `
 
-func (s *codeMonitorStore) CreateWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string) (*WebhookAction, error) {
+func (s *codeMonitorStore) CreateWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string, schedule ActionSchedule) (*WebhookAction, error) {
 	now := s.Now()
 	a := actor.FromContext(ctx)
 	q := sqlf.Sprintf(
@@ -81,6 +84,7 @@ func (s *codeMonitorStore) CreateWebhookAction(ctx context.Context, monitorID in
 		enabled,
 		includeResults,
 		url,
+		schedule.orImmediate(),
 		a.UID,
 		now,
 		a.UID,
@@ -175,6 +179,7 @@ var webhookActionColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_webhooks.enabled"),
 	sqlf.Sprintf("cm_webhooks.url"),
 	sqlf.Sprintf("cm_webhooks.include_results"),
+	sqlf.Sprintf("cm_webhooks.schedule"),
 	sqlf.Sprintf("cm_webhooks.created_by"),
 	sqlf.Sprintf("cm_webhooks.created_at"),
 	sqlf.Sprintf("cm_webhooks.changed_by"),
@@ -203,6 +208,7 @@ func scanWebhookAction(scanner dbutil.Scanner) (*WebhookAction, error) {
 		&w.Enabled,
 		&w.URL,
 		&w.IncludeResults,
+		&w.Schedule,
 		&w.CreatedBy,
 		&w.CreatedAt,
 		&w.ChangedBy,
@@ -27,7 +27,7 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		s := CodeMonitorsWith(db)
 		fixtures := s.insertTestMonitor(ctx, t)
 
-		action, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1)
+		action, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1, ActionScheduleImmediate)
 		require.NoError(t, err)
 
 		got, err := s.GetWebhookAction(ctx, action.ID)
@@ -44,13 +44,14 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		s := CodeMonitorsWith(db)
 		fixtures := s.insertTestMonitor(ctx, t)
 
-		action, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1)
+		action, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1, ActionScheduleImmediate)
 		require.NoError(t, err)
 
-		updated, err := s.UpdateWebhookAction(ctx, action.ID, false, false, url2)
+		updated, err := s.UpdateWebhookAction(ctx, action.ID, false, false, url2, ActionScheduleDaily)
 		require.NoError(t, err)
 		require.Equal(t, false, updated.Enabled)
 		require.Equal(t, url2, updated.URL)
+		require.Equal(t, ActionScheduleDaily, updated.Schedule)
 
 		got, err := s.GetWebhookAction(ctx, action.ID)
 		require.NoError(t, err)
@@ -64,7 +65,7 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		_, _, ctx := newTestUser(ctx, t, db)
 		s := CodeMonitorsWith(db)
 
-		_, err := s.UpdateWebhookAction(ctx, 383838, false, false, url2)
+		_, err := s.UpdateWebhookAction(ctx, 383838, false, false, url2, ActionScheduleImmediate)
 		require.Error(t, err)
 	})
 
@@ -76,10 +77,10 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		s := CodeMonitorsWith(db)
 		fixtures := s.insertTestMonitor(ctx, t)
 
-		action1, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1)
+		action1, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1, ActionScheduleImmediate)
 		require.NoError(t, err)
 
-		action2, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1)
+		action2, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1, ActionScheduleImmediate)
 		require.NoError(t, err)
 
 		err = s.DeleteWebhookActions(ctx, fixtures.monitor.ID, action1.ID)
@@ -104,7 +105,7 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 0, count)
 
-		_, err = s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1)
+		_, err = s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1, ActionScheduleImmediate)
 		require.NoError(t, err)
 
 		count, err = s.CountWebhookActions(ctx, fixtures.monitor.ID)
@@ -124,10 +125,10 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, actions, 0)
 
-		_, err = s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1)
+		_, err = s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1, ActionScheduleImmediate)
 		require.NoError(t, err)
 
-		_, err = s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url2)
+		_, err = s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url2, ActionScheduleImmediate)
 		require.NoError(t, err)
 
 		actions2, err := s.ListWebhookActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID})
@@ -151,19 +152,19 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		fixtures := s.insertTestMonitor(ctx1, t)
 		_ = s.insertTestMonitor(ctx2, t)
 
-		wa, err := s.CreateWebhookAction(ctx1, fixtures.monitor.ID, true, true, "https://true.com")
+		wa, err := s.CreateWebhookAction(ctx1, fixtures.monitor.ID, true, true, "https://true.com", ActionScheduleImmediate)
 		require.NoError(t, err)
 
 		// User1 can update it
-		_, err = s.UpdateWebhookAction(ctx1, wa.ID, true, true, "https://false.com")
+		_, err = s.UpdateWebhookAction(ctx1, wa.ID, true, true, "https://false.com", ActionScheduleImmediate)
 		require.NoError(t, err)
 
 		// User2 cannot update it
-		_, err = s.UpdateWebhookAction(ctx2, wa.ID, true, true, "https://truer.com")
+		_, err = s.UpdateWebhookAction(ctx2, wa.ID, true, true, "https://truer.com", ActionScheduleImmediate)
 		require.Error(t, err)
 
 		// User3 can update it
-		_, err = s.UpdateWebhookAction(ctx3, wa.ID, true, true, "https://false.com")
+		_, err = s.UpdateWebhookAction(ctx3, wa.ID, true, true, "https://false.com", ActionScheduleImmediate)
 		require.NoError(t, err)
 
 		wa, err = s.GetWebhookAction(ctx1, wa.ID)
@@ -42,6 +42,8 @@ type CodeMonitorStore interface {
 
 	CreateQueryTrigger(ctx context.Context, monitorID int64, query string) (*QueryTrigger, error)
 	UpdateQueryTrigger(ctx context.Context, id int64, query string) error
+	CreatePathTrigger(ctx context.Context, monitorID int64, trigger PathTrigger) (*QueryTrigger, error)
+	UpdatePathTrigger(ctx context.Context, id int64, trigger PathTrigger) error
 	GetQueryTriggerForMonitor(ctx context.Context, monitorID int64) (*QueryTrigger, error)
 	ResetQueryTriggerTimestamps(ctx context.Context, queryID int64) error
 	SetQueryTriggerNextRun(ctx context.Context, triggerQueryID int64, next time.Time, latestResults time.Time) error
@@ -59,20 +61,27 @@ type CodeMonitorStore interface {
 	GetEmailAction(ctx context.Context, emailID int64) (*EmailAction, error)
 	ListEmailActions(context.Context, ListActionsOpts) ([]*EmailAction, error)
 
-	UpdateWebhookAction(_ context.Context, id int64, enabled, includeResults bool, url string) (*WebhookAction, error)
-	CreateWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string) (*WebhookAction, error)
+	UpdateWebhookAction(_ context.Context, id int64, enabled, includeResults bool, url string, schedule ActionSchedule) (*WebhookAction, error)
+	CreateWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string, schedule ActionSchedule) (*WebhookAction, error)
 	DeleteWebhookActions(ctx context.Context, monitorID int64, ids ...int64) error
 	CountWebhookActions(ctx context.Context, monitorID int64) (int, error)
 	GetWebhookAction(ctx context.Context, id int64) (*WebhookAction, error)
 	ListWebhookActions(context.Context, ListActionsOpts) ([]*WebhookAction, error)
 
-	UpdateSlackWebhookAction(_ context.Context, id int64, enabled, includeResults bool, url string) (*SlackWebhookAction, error)
-	CreateSlackWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string) (*SlackWebhookAction, error)
+	UpdateSlackWebhookAction(_ context.Context, id int64, enabled, includeResults bool, url string, schedule ActionSchedule) (*SlackWebhookAction, error)
+	CreateSlackWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string, schedule ActionSchedule) (*SlackWebhookAction, error)
 	DeleteSlackWebhookActions(ctx context.Context, monitorID int64, ids ...int64) error
 	CountSlackWebhookActions(ctx context.Context, monitorID int64) (int, error)
 	GetSlackWebhookAction(ctx context.Context, id int64) (*SlackWebhookAction, error)
 	ListSlackWebhookActions(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)
 
+	UpdateChatWebhookAction(_ context.Context, id int64, _ *ChatWebhookActionArgs) (*ChatWebhookAction, error)
+	CreateChatWebhookAction(ctx context.Context, monitorID int64, _ *ChatWebhookActionArgs) (*ChatWebhookAction, error)
+	DeleteChatWebhookActions(ctx context.Context, monitorID int64, ids ...int64) error
+	CountChatWebhookActions(ctx context.Context, monitorID int64) (int, error)
+	GetChatWebhookAction(ctx context.Context, id int64) (*ChatWebhookAction, error)
+	ListChatWebhookActions(context.Context, ListActionsOpts) ([]*ChatWebhookAction, error)
+
 	CreateRecipient(ctx context.Context, emailID int64, userID, orgID *int32) (*Recipient, error)
 	DeleteRecipients(ctx context.Context, emailID int64) error
 	ListRecipients(context.Context, ListRecipientsOpts) ([]*Recipient, error)
@@ -84,6 +93,10 @@ type CodeMonitorStore interface {
 	GetActionJob(ctx context.Context, jobID int32) (*ActionJob, error)
 	EnqueueActionJobsForMonitor(ctx context.Context, monitorID int64, triggerJob int32) ([]*ActionJob, error)
 
+	ListNotifiedMatches(ctx context.Context, job *ActionJob, commitIDs []api.CommitID) ([]NotifiedMatch, error)
+	CreateNotifiedMatches(ctx context.Context, job *ActionJob, matches []NotifiedMatch) error
+	DeleteOldNotifiedMatches(ctx context.Context, retentionInDays int) error
+
 	// HasAnyLastSearched returns whether there have ever been any repo-aware code monitor
 	// searches executed for this code monitor. This should only be needed during the transition
 	// version so that we don't detect every repo as a new repo and search their entire history
@@ -37,6 +37,7 @@ type DB interface {
 	GitHubApps() gha.GitHubAppsStore
 	GitserverRepos() GitserverRepoStore
 	GitserverLocalClone() GitserverLocalCloneStore
+	GitserverRepoPushMirrors() GitserverRepoPushMirrorStore
 	GlobalState() GlobalStateStore
 	NamespacePermissions() NamespacePermissionStore
 	Namespaces() NamespaceStore
@@ -47,6 +48,7 @@ type DB interface {
 	OutboundWebhookJobs(encryption.Key) OutboundWebhookJobStore
 	OutboundWebhookLogs(encryption.Key) OutboundWebhookLogStore
 	OwnershipStats() OwnershipStatsStore
+	OwnCoverageReports() OwnCoverageReportStore
 	RecentContributionSignals() RecentContributionSignalStore
 	Perms() PermsStore
 	Permissions() PermissionStore
@@ -55,6 +57,7 @@ type DB interface {
 	RedisKeyValue() RedisKeyValueStore
 	Repos() RepoStore
 	RepoCommitsChangelists() RepoCommitsChangelistsStore
+	RepoCommitsHgChangesets() RepoCommitsHgChangesetsStore
 	RepoKVPs() RepoKVPStore
 	RepoPaths() RepoPathStore
 	RolePermissions() RolePermissionStore
@@ -194,6 +197,10 @@ func (d *db) GitserverLocalClone() GitserverLocalCloneStore {
 	return GitserverLocalCloneStoreWith(d.Store)
 }
 
+func (d *db) GitserverRepoPushMirrors() GitserverRepoPushMirrorStore {
+	return GitserverRepoPushMirrorsWith(d.Store)
+}
+
 func (d *db) GlobalState() GlobalStateStore {
 	return GlobalStateWith(d.Store)
 }
@@ -234,6 +241,10 @@ func (d *db) OwnershipStats() OwnershipStatsStore {
 	return &ownershipStats{d.Store}
 }
 
+func (d *db) OwnCoverageReports() OwnCoverageReportStore {
+	return OwnCoverageReportsWith(d.logger, d.Store)
+}
+
 func (d *db) RecentContributionSignals() RecentContributionSignalStore {
 	return RecentContributionSignalStoreWith(d.Store)
 }
@@ -266,6 +277,10 @@ func (d *db) RepoCommitsChangelists() RepoCommitsChangelistsStore {
 	return RepoCommitsChangelistsWith(d.logger, d.Store)
 }
 
+func (d *db) RepoCommitsHgChangesets() RepoCommitsHgChangesetsStore {
+	return RepoCommitsHgChangesetsWith(d.logger, d.Store)
+}
+
 func (d *db) RepoKVPs() RepoKVPStore {
 	return &repoKVPStore{d.Store}
 }
@@ -6213,6 +6213,9 @@ type MockCodeMonitorStore struct {
 	// CountActionJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountActionJobs.
 	CountActionJobsFunc *CodeMonitorStoreCountActionJobsFunc
+	// CountChatWebhookActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method CountChatWebhookActions.
+	CountChatWebhookActionsFunc *CodeMonitorStoreCountChatWebhookActionsFunc
 	// CountMonitorsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountMonitors.
 	CountMonitorsFunc *CodeMonitorStoreCountMonitorsFunc
@@ -6228,12 +6231,21 @@ type MockCodeMonitorStore struct {
 	// CountWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountWebhookActions.
 	CountWebhookActionsFunc *CodeMonitorStoreCountWebhookActionsFunc
+	// CreateChatWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateChatWebhookAction.
+	CreateChatWebhookActionFunc *CodeMonitorStoreCreateChatWebhookActionFunc
 	// CreateEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateEmailAction.
 	CreateEmailActionFunc *CodeMonitorStoreCreateEmailActionFunc
 	// CreateMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateMonitor.
 	CreateMonitorFunc *CodeMonitorStoreCreateMonitorFunc
+	// CreateNotifiedMatchesFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateNotifiedMatches.
+	CreateNotifiedMatchesFunc *CodeMonitorStoreCreateNotifiedMatchesFunc
+	// CreatePathTriggerFunc is an instance of a mock function object
+	// controlling the behavior of the method CreatePathTrigger.
+	CreatePathTriggerFunc *CodeMonitorStoreCreatePathTriggerFunc
 	// CreateQueryTriggerFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateQueryTrigger.
 	CreateQueryTriggerFunc *CodeMonitorStoreCreateQueryTriggerFunc
@@ -6246,12 +6258,18 @@ type MockCodeMonitorStore struct {
 	// CreateWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateWebhookAction.
 	CreateWebhookActionFunc *CodeMonitorStoreCreateWebhookActionFunc
+	// DeleteChatWebhookActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteChatWebhookActions.
+	DeleteChatWebhookActionsFunc *CodeMonitorStoreDeleteChatWebhookActionsFunc
 	// DeleteEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteEmailActions.
 	DeleteEmailActionsFunc *CodeMonitorStoreDeleteEmailActionsFunc
 	// DeleteMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteMonitor.
 	DeleteMonitorFunc *CodeMonitorStoreDeleteMonitorFunc
+	// DeleteOldNotifiedMatchesFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteOldNotifiedMatches.
+	DeleteOldNotifiedMatchesFunc *CodeMonitorStoreDeleteOldNotifiedMatchesFunc
 	// DeleteOldTriggerJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteOldTriggerJobs.
 	DeleteOldTriggerJobsFunc *CodeMonitorStoreDeleteOldTriggerJobsFunc
@@ -6284,6 +6302,9 @@ type MockCodeMonitorStore struct {
 	// GetActionJobMetadataFunc is an instance of a mock function object
 	// controlling the behavior of the method GetActionJobMetadata.
 	GetActionJobMetadataFunc *CodeMonitorStoreGetActionJobMetadataFunc
+	// GetChatWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method GetChatWebhookAction.
+	GetChatWebhookActionFunc *CodeMonitorStoreGetChatWebhookActionFunc
 	// GetEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetEmailAction.
 	GetEmailActionFunc *CodeMonitorStoreGetEmailActionFunc
@@ -6315,12 +6336,18 @@ type MockCodeMonitorStore struct {
 	// ListActionJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListActionJobs.
 	ListActionJobsFunc *CodeMonitorStoreListActionJobsFunc
+	// ListChatWebhookActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListChatWebhookActions.
+	ListChatWebhookActionsFunc *CodeMonitorStoreListChatWebhookActionsFunc
 	// ListEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListEmailActions.
 	ListEmailActionsFunc *CodeMonitorStoreListEmailActionsFunc
 	// ListMonitorsFunc is an instance of a mock function object controlling
 	// the behavior of the method ListMonitors.
 	ListMonitorsFunc *CodeMonitorStoreListMonitorsFunc
+	// ListNotifiedMatchesFunc is an instance of a mock function object
+	// controlling the behavior of the method ListNotifiedMatches.
+	ListNotifiedMatchesFunc *CodeMonitorStoreListNotifiedMatchesFunc
 	// ListQueryTriggerJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListQueryTriggerJobs.
 	ListQueryTriggerJobsFunc *CodeMonitorStoreListQueryTriggerJobsFunc
@@ -6346,6 +6373,9 @@ type MockCodeMonitorStore struct {
 	// TransactFunc is an instance of a mock function object controlling the
 	// behavior of the method Transact.
 	TransactFunc *CodeMonitorStoreTransactFunc
+	// UpdateChatWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateChatWebhookAction.
+	UpdateChatWebhookActionFunc *CodeMonitorStoreUpdateChatWebhookActionFunc
 	// UpdateEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateEmailAction.
 	UpdateEmailActionFunc *CodeMonitorStoreUpdateEmailActionFunc
@@ -6355,6 +6385,9 @@ type MockCodeMonitorStore struct {
 	// UpdateMonitorEnabledFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateMonitorEnabled.
 	UpdateMonitorEnabledFunc *CodeMonitorStoreUpdateMonitorEnabledFunc
+	// UpdatePathTriggerFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdatePathTrigger.
+	UpdatePathTriggerFunc *CodeMonitorStoreUpdatePathTriggerFunc
 	// UpdateQueryTriggerFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateQueryTrigger.
 	UpdateQueryTriggerFunc *CodeMonitorStoreUpdateQueryTriggerFunc
@@ -6388,6 +6421,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CountChatWebhookActionsFunc: &CodeMonitorStoreCountChatWebhookActionsFunc{
+			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
+				return
+			},
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: func(context.Context, *int32) (r0 int32, r1 error) {
 				return
@@ -6413,6 +6451,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CreateChatWebhookActionFunc: &CodeMonitorStoreCreateChatWebhookActionFunc{
+			defaultHook: func(context.Context, int64, *database.ChatWebhookActionArgs) (r0 *database.ChatWebhookAction, r1 error) {
+				return
+			},
+		},
 		CreateEmailActionFunc: &CodeMonitorStoreCreateEmailActionFunc{
 			defaultHook: func(context.Context, int64, *database.EmailActionArgs) (r0 *database.EmailAction, r1 error) {
 				return
@@ -6423,6 +6466,16 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CreateNotifiedMatchesFunc: &CodeMonitorStoreCreateNotifiedMatchesFunc{
+			defaultHook: func(context.Context, *database.ActionJob, []database.NotifiedMatch) (r0 error) {
+				return
+			},
+		},
+		CreatePathTriggerFunc: &CodeMonitorStoreCreatePathTriggerFunc{
+			defaultHook: func(context.Context, int64, database.PathTrigger) (r0 *database.QueryTrigger, r1 error) {
+				return
+			},
+		},
 		CreateQueryTriggerFunc: &CodeMonitorStoreCreateQueryTriggerFunc{
 			defaultHook: func(context.Context, int64, string) (r0 *database.QueryTrigger, r1 error) {
 				return
@@ -6434,12 +6487,17 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		CreateSlackWebhookActionFunc: &CodeMonitorStoreCreateSlackWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *database.SlackWebhookAction, r1 error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionSchedule) (r0 *database.SlackWebhookAction, r1 error) {
 				return
 			},
 		},
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *database.WebhookAction, r1 error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionSchedule) (r0 *database.WebhookAction, r1 error) {
+				return
+			},
+		},
+		DeleteChatWebhookActionsFunc: &CodeMonitorStoreDeleteChatWebhookActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) (r0 error) {
 				return
 			},
 		},
@@ -6453,6 +6511,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		DeleteOldNotifiedMatchesFunc: &CodeMonitorStoreDeleteOldNotifiedMatchesFunc{
+			defaultHook: func(context.Context, int) (r0 error) {
+				return
+			},
+		},
 		DeleteOldTriggerJobsFunc: &CodeMonitorStoreDeleteOldTriggerJobsFunc{
 			defaultHook: func(context.Context, int) (r0 error) {
 				return
@@ -6503,6 +6566,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		GetChatWebhookActionFunc: &CodeMonitorStoreGetChatWebhookActionFunc{
+			defaultHook: func(context.Context, int64) (r0 *database.ChatWebhookAction, r1 error) {
+				return
+			},
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: func(context.Context, int64) (r0 *database.EmailAction, r1 error) {
 				return
@@ -6553,6 +6621,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		ListChatWebhookActionsFunc: &CodeMonitorStoreListChatWebhookActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) (r0 []*database.ChatWebhookAction, r1 error) {
+				return
+			},
+		},
 		ListEmailActionsFunc: &CodeMonitorStoreListEmailActionsFunc{
 			defaultHook: func(context.Context, database.ListActionsOpts) (r0 []*database.EmailAction, r1 error) {
 				return
@@ -6563,6 +6636,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		ListNotifiedMatchesFunc: &CodeMonitorStoreListNotifiedMatchesFunc{
+			defaultHook: func(context.Context, *database.ActionJob, []api.CommitID) (r0 []database.NotifiedMatch, r1 error) {
+				return
+			},
+		},
 		ListQueryTriggerJobsFunc: &CodeMonitorStoreListQueryTriggerJobsFunc{
 			defaultHook: func(context.Context, database.ListTriggerJobsOpts) (r0 []*database.TriggerJob, r1 error) {
 				return
@@ -6603,6 +6681,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpdateChatWebhookActionFunc: &CodeMonitorStoreUpdateChatWebhookActionFunc{
+			defaultHook: func(context.Context, int64, *database.ChatWebhookActionArgs) (r0 *database.ChatWebhookAction, r1 error) {
+				return
+			},
+		},
 		UpdateEmailActionFunc: &CodeMonitorStoreUpdateEmailActionFunc{
 			defaultHook: func(context.Context, int64, *database.EmailActionArgs) (r0 *database.EmailAction, r1 error) {
 				return
@@ -6618,13 +6701,18 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpdatePathTriggerFunc: &CodeMonitorStoreUpdatePathTriggerFunc{
+			defaultHook: func(context.Context, int64, database.PathTrigger) (r0 error) {
+				return
+			},
+		},
 		UpdateQueryTriggerFunc: &CodeMonitorStoreUpdateQueryTriggerFunc{
 			defaultHook: func(context.Context, int64, string) (r0 error) {
 				return
 			},
 		},
 		UpdateSlackWebhookActionFunc: &CodeMonitorStoreUpdateSlackWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *database.SlackWebhookAction, r1 error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionSchedule) (r0 *database.SlackWebhookAction, r1 error) {
 				return
 			},
 		},
@@ -6634,7 +6722,7 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		UpdateWebhookActionFunc: &CodeMonitorStoreUpdateWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *database.WebhookAction, r1 error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionSchedule) (r0 *database.WebhookAction, r1 error) {
 				return
 			},
 		},
@@ -6660,6 +6748,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CountActionJobs")
 			},
 		},
+		CountChatWebhookActionsFunc: &CodeMonitorStoreCountChatWebhookActionsFunc{
+			defaultHook: func(context.Context, int64) (int, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CountChatWebhookActions")
+			},
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: func(context.Context, *int32) (int32, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CountMonitors")
@@ -6685,6 +6778,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CountWebhookActions")
 			},
 		},
+		CreateChatWebhookActionFunc: &CodeMonitorStoreCreateChatWebhookActionFunc{
+			defaultHook: func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CreateChatWebhookAction")
+			},
+		},
 		CreateEmailActionFunc: &CodeMonitorStoreCreateEmailActionFunc{
 			defaultHook: func(context.Context, int64, *database.EmailActionArgs) (*database.EmailAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateEmailAction")
@@ -6695,6 +6793,16 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateMonitor")
 			},
 		},
+		CreateNotifiedMatchesFunc: &CodeMonitorStoreCreateNotifiedMatchesFunc{
+			defaultHook: func(context.Context, *database.ActionJob, []database.NotifiedMatch) error {
+				panic("unexpected invocation of MockCodeMonitorStore.CreateNotifiedMatches")
+			},
+		},
+		CreatePathTriggerFunc: &CodeMonitorStoreCreatePathTriggerFunc{
+			defaultHook: func(context.Context, int64, database.PathTrigger) (*database.QueryTrigger, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CreatePathTrigger")
+			},
+		},
 		CreateQueryTriggerFunc: &CodeMonitorStoreCreateQueryTriggerFunc{
 			defaultHook: func(context.Context, int64, string) (*database.QueryTrigger, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateQueryTrigger")
@@ -6706,15 +6814,20 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		CreateSlackWebhookActionFunc: &CodeMonitorStoreCreateSlackWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateSlackWebhookAction")
 			},
 		},
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateWebhookAction")
 			},
 		},
+		DeleteChatWebhookActionsFunc: &CodeMonitorStoreDeleteChatWebhookActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteChatWebhookActions")
+			},
+		},
 		DeleteEmailActionsFunc: &CodeMonitorStoreDeleteEmailActionsFunc{
 			defaultHook: func(context.Context, []int64, int64) error {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteEmailActions")
@@ -6725,6 +6838,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteMonitor")
 			},
 		},
+		DeleteOldNotifiedMatchesFunc: &CodeMonitorStoreDeleteOldNotifiedMatchesFunc{
+			defaultHook: func(context.Context, int) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteOldNotifiedMatches")
+			},
+		},
 		DeleteOldTriggerJobsFunc: &CodeMonitorStoreDeleteOldTriggerJobsFunc{
 			defaultHook: func(context.Context, int) error {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteOldTriggerJobs")
@@ -6775,6 +6893,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.GetActionJobMetadata")
 			},
 		},
+		GetChatWebhookActionFunc: &CodeMonitorStoreGetChatWebhookActionFunc{
+			defaultHook: func(context.Context, int64) (*database.ChatWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetChatWebhookAction")
+			},
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: func(context.Context, int64) (*database.EmailAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.GetEmailAction")
@@ -6825,6 +6948,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.ListActionJobs")
 			},
 		},
+		ListChatWebhookActionsFunc: &CodeMonitorStoreListChatWebhookActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) ([]*database.ChatWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ListChatWebhookActions")
+			},
+		},
 		ListEmailActionsFunc: &CodeMonitorStoreListEmailActionsFunc{
 			defaultHook: func(context.Context, database.ListActionsOpts) ([]*database.EmailAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.ListEmailActions")
@@ -6835,6 +6963,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.ListMonitors")
 			},
 		},
+		ListNotifiedMatchesFunc: &CodeMonitorStoreListNotifiedMatchesFunc{
+			defaultHook: func(context.Context, *database.ActionJob, []api.CommitID) ([]database.NotifiedMatch, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ListNotifiedMatches")
+			},
+		},
 		ListQueryTriggerJobsFunc: &CodeMonitorStoreListQueryTriggerJobsFunc{
 			defaultHook: func(context.Context, database.ListTriggerJobsOpts) ([]*database.TriggerJob, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.ListQueryTriggerJobs")
@@ -6875,6 +7008,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.Transact")
 			},
 		},
+		UpdateChatWebhookActionFunc: &CodeMonitorStoreUpdateChatWebhookActionFunc{
+			defaultHook: func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateChatWebhookAction")
+			},
+		},
 		UpdateEmailActionFunc: &CodeMonitorStoreUpdateEmailActionFunc{
 			defaultHook: func(context.Context, int64, *database.EmailActionArgs) (*database.EmailAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateEmailAction")
@@ -6890,13 +7028,18 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateMonitorEnabled")
 			},
 		},
+		UpdatePathTriggerFunc: &CodeMonitorStoreUpdatePathTriggerFunc{
+			defaultHook: func(context.Context, int64, database.PathTrigger) error {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdatePathTrigger")
+			},
+		},
 		UpdateQueryTriggerFunc: &CodeMonitorStoreUpdateQueryTriggerFunc{
 			defaultHook: func(context.Context, int64, string) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateQueryTrigger")
 			},
 		},
 		UpdateSlackWebhookActionFunc: &CodeMonitorStoreUpdateSlackWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateSlackWebhookAction")
 			},
 		},
@@ -6906,7 +7049,7 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		UpdateWebhookActionFunc: &CodeMonitorStoreUpdateWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateWebhookAction")
 			},
 		},
@@ -6929,6 +7072,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CountActionJobsFunc: &CodeMonitorStoreCountActionJobsFunc{
 			defaultHook: i.CountActionJobs,
 		},
+		CountChatWebhookActionsFunc: &CodeMonitorStoreCountChatWebhookActionsFunc{
+			defaultHook: i.CountChatWebhookActions,
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: i.CountMonitors,
 		},
@@ -6944,12 +7090,21 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CountWebhookActionsFunc: &CodeMonitorStoreCountWebhookActionsFunc{
 			defaultHook: i.CountWebhookActions,
 		},
+		CreateChatWebhookActionFunc: &CodeMonitorStoreCreateChatWebhookActionFunc{
+			defaultHook: i.CreateChatWebhookAction,
+		},
 		CreateEmailActionFunc: &CodeMonitorStoreCreateEmailActionFunc{
 			defaultHook: i.CreateEmailAction,
 		},
 		CreateMonitorFunc: &CodeMonitorStoreCreateMonitorFunc{
 			defaultHook: i.CreateMonitor,
 		},
+		CreateNotifiedMatchesFunc: &CodeMonitorStoreCreateNotifiedMatchesFunc{
+			defaultHook: i.CreateNotifiedMatches,
+		},
+		CreatePathTriggerFunc: &CodeMonitorStoreCreatePathTriggerFunc{
+			defaultHook: i.CreatePathTrigger,
+		},
 		CreateQueryTriggerFunc: &CodeMonitorStoreCreateQueryTriggerFunc{
 			defaultHook: i.CreateQueryTrigger,
 		},
@@ -6962,12 +7117,18 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
 			defaultHook: i.CreateWebhookAction,
 		},
+		DeleteChatWebhookActionsFunc: &CodeMonitorStoreDeleteChatWebhookActionsFunc{
+			defaultHook: i.DeleteChatWebhookActions,
+		},
 		DeleteEmailActionsFunc: &CodeMonitorStoreDeleteEmailActionsFunc{
 			defaultHook: i.DeleteEmailActions,
 		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: i.DeleteMonitor,
 		},
+		DeleteOldNotifiedMatchesFunc: &CodeMonitorStoreDeleteOldNotifiedMatchesFunc{
+			defaultHook: i.DeleteOldNotifiedMatches,
+		},
 		DeleteOldTriggerJobsFunc: &CodeMonitorStoreDeleteOldTriggerJobsFunc{
 			defaultHook: i.DeleteOldTriggerJobs,
 		},
@@ -6998,6 +7159,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		GetActionJobMetadataFunc: &CodeMonitorStoreGetActionJobMetadataFunc{
 			defaultHook: i.GetActionJobMetadata,
 		},
+		GetChatWebhookActionFunc: &CodeMonitorStoreGetChatWebhookActionFunc{
+			defaultHook: i.GetChatWebhookAction,
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: i.GetEmailAction,
 		},
@@ -7028,12 +7192,18 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		ListActionJobsFunc: &CodeMonitorStoreListActionJobsFunc{
 			defaultHook: i.ListActionJobs,
 		},
+		ListChatWebhookActionsFunc: &CodeMonitorStoreListChatWebhookActionsFunc{
+			defaultHook: i.ListChatWebhookActions,
+		},
 		ListEmailActionsFunc: &CodeMonitorStoreListEmailActionsFunc{
 			defaultHook: i.ListEmailActions,
 		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: i.ListMonitors,
 		},
+		ListNotifiedMatchesFunc: &CodeMonitorStoreListNotifiedMatchesFunc{
+			defaultHook: i.ListNotifiedMatches,
+		},
 		ListQueryTriggerJobsFunc: &CodeMonitorStoreListQueryTriggerJobsFunc{
 			defaultHook: i.ListQueryTriggerJobs,
 		},
@@ -7058,6 +7228,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		TransactFunc: &CodeMonitorStoreTransactFunc{
 			defaultHook: i.Transact,
 		},
+		UpdateChatWebhookActionFunc: &CodeMonitorStoreUpdateChatWebhookActionFunc{
+			defaultHook: i.UpdateChatWebhookAction,
+		},
 		UpdateEmailActionFunc: &CodeMonitorStoreUpdateEmailActionFunc{
 			defaultHook: i.UpdateEmailAction,
 		},
@@ -7067,6 +7240,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		UpdateMonitorEnabledFunc: &CodeMonitorStoreUpdateMonitorEnabledFunc{
 			defaultHook: i.UpdateMonitorEnabled,
 		},
+		UpdatePathTriggerFunc: &CodeMonitorStoreUpdatePathTriggerFunc{
+			defaultHook: i.UpdatePathTrigger,
+		},
 		UpdateQueryTriggerFunc: &CodeMonitorStoreUpdateQueryTriggerFunc{
 			defaultHook: i.UpdateQueryTrigger,
 		},
@@ -7295,6 +7471,118 @@ func (c CodeMonitorStoreCountActionJobsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCountChatWebhookActionsFunc describes the behavior when
+// the CountChatWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreCountChatWebhookActionsFunc struct {
+	defaultHook func(context.Context, int64) (int, error)
+	hooks       []func(context.Context, int64) (int, error)
+	history     []CodeMonitorStoreCountChatWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// CountChatWebhookActions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CountChatWebhookActions(v0 context.Context, v1 int64) (int, error) {
+	r0, r1 := m.CountChatWebhookActionsFunc.nextHook()(v0, v1)
+	m.CountChatWebhookActionsFunc.appendCall(CodeMonitorStoreCountChatWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CountChatWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCountChatWebhookActionsFunc) SetDefaultHook(hook func(context.Context, int64) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountChatWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCountChatWebhookActionsFunc) PushHook(hook func(context.Context, int64) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCountChatWebhookActionsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCountChatWebhookActionsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCountChatWebhookActionsFunc) nextHook() func(context.Context, int64) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCountChatWebhookActionsFunc) appendCall(r0 CodeMonitorStoreCountChatWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCountChatWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCountChatWebhookActionsFunc) History() []CodeMonitorStoreCountChatWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCountChatWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCountChatWebhookActionsFuncCall is an object that
+// describes an invocation of method CountChatWebhookActions on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreCountChatWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCountChatWebhookActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCountChatWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCountMonitorsFunc describes the behavior when the
 // CountMonitors method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -7851,6 +8139,121 @@ func (c CodeMonitorStoreCountWebhookActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCreateChatWebhookActionFunc describes the behavior when
+// the CreateChatWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreCreateChatWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error)
+	hooks       []func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error)
+	history     []CodeMonitorStoreCreateChatWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateChatWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateChatWebhookAction(v0 context.Context, v1 int64, v2 *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error) {
+	r0, r1 := m.CreateChatWebhookActionFunc.nextHook()(v0, v1, v2)
+	m.CreateChatWebhookActionFunc.appendCall(CodeMonitorStoreCreateChatWebhookActionFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateChatWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCreateChatWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateChatWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCreateChatWebhookActionFunc) PushHook(hook func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCreateChatWebhookActionFunc) SetDefaultReturn(r0 *database.ChatWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCreateChatWebhookActionFunc) PushReturn(r0 *database.ChatWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreateChatWebhookActionFunc) nextHook() func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateChatWebhookActionFunc) appendCall(r0 CodeMonitorStoreCreateChatWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCreateChatWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCreateChatWebhookActionFunc) History() []CodeMonitorStoreCreateChatWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateChatWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateChatWebhookActionFuncCall is an object that
+// describes an invocation of method CreateChatWebhookAction on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreCreateChatWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *database.ChatWebhookActionArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.ChatWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateChatWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateChatWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCreateEmailActionFunc describes the behavior when the
 // CreateEmailAction method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -8075,6 +8478,232 @@ func (c CodeMonitorStoreCreateMonitorFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCreateNotifiedMatchesFunc describes the behavior when the
+// CreateNotifiedMatches method of the parent MockCodeMonitorStore instance
+// is invoked.
+type CodeMonitorStoreCreateNotifiedMatchesFunc struct {
+	defaultHook func(context.Context, *database.ActionJob, []database.NotifiedMatch) error
+	hooks       []func(context.Context, *database.ActionJob, []database.NotifiedMatch) error
+	history     []CodeMonitorStoreCreateNotifiedMatchesFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateNotifiedMatches delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateNotifiedMatches(v0 context.Context, v1 *database.ActionJob, v2 []database.NotifiedMatch) error {
+	r0 := m.CreateNotifiedMatchesFunc.nextHook()(v0, v1, v2)
+	m.CreateNotifiedMatchesFunc.appendCall(CodeMonitorStoreCreateNotifiedMatchesFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateNotifiedMatches method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCreateNotifiedMatchesFunc) SetDefaultHook(hook func(context.Context, *database.ActionJob, []database.NotifiedMatch) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateNotifiedMatches method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreCreateNotifiedMatchesFunc) PushHook(hook func(context.Context, *database.ActionJob, []database.NotifiedMatch) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCreateNotifiedMatchesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *database.ActionJob, []database.NotifiedMatch) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCreateNotifiedMatchesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *database.ActionJob, []database.NotifiedMatch) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreCreateNotifiedMatchesFunc) nextHook() func(context.Context, *database.ActionJob, []database.NotifiedMatch) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateNotifiedMatchesFunc) appendCall(r0 CodeMonitorStoreCreateNotifiedMatchesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCreateNotifiedMatchesFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCreateNotifiedMatchesFunc) History() []CodeMonitorStoreCreateNotifiedMatchesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateNotifiedMatchesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateNotifiedMatchesFuncCall is an object that describes
+// an invocation of method CreateNotifiedMatches on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreCreateNotifiedMatchesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *database.ActionJob
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []database.NotifiedMatch
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateNotifiedMatchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateNotifiedMatchesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CodeMonitorStoreCreatePathTriggerFunc describes the behavior when the
+// CreatePathTrigger method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreCreatePathTriggerFunc struct {
+	defaultHook func(context.Context, int64, database.PathTrigger) (*database.QueryTrigger, error)
+	hooks       []func(context.Context, int64, database.PathTrigger) (*database.QueryTrigger, error)
+	history     []CodeMonitorStoreCreatePathTriggerFuncCall
+	mutex       sync.Mutex
+}
+
+// CreatePathTrigger delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreatePathTrigger(v0 context.Context, v1 int64, v2 database.PathTrigger) (*database.QueryTrigger, error) {
+	r0, r1 := m.CreatePathTriggerFunc.nextHook()(v0, v1, v2)
+	m.CreatePathTriggerFunc.appendCall(CodeMonitorStoreCreatePathTriggerFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreatePathTrigger
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreCreatePathTriggerFunc) SetDefaultHook(hook func(context.Context, int64, database.PathTrigger) (*database.QueryTrigger, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreatePathTrigger method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreCreatePathTriggerFunc) PushHook(hook func(context.Context, int64, database.PathTrigger) (*database.QueryTrigger, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCreatePathTriggerFunc) SetDefaultReturn(r0 *database.QueryTrigger, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, database.PathTrigger) (*database.QueryTrigger, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCreatePathTriggerFunc) PushReturn(r0 *database.QueryTrigger, r1 error) {
+	f.PushHook(func(context.Context, int64, database.PathTrigger) (*database.QueryTrigger, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreatePathTriggerFunc) nextHook() func(context.Context, int64, database.PathTrigger) (*database.QueryTrigger, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreatePathTriggerFunc) appendCall(r0 CodeMonitorStoreCreatePathTriggerFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreCreatePathTriggerFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreCreatePathTriggerFunc) History() []CodeMonitorStoreCreatePathTriggerFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreatePathTriggerFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreatePathTriggerFuncCall is an object that describes an
+// invocation of method CreatePathTrigger on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreCreatePathTriggerFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 database.PathTrigger
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.QueryTrigger
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreatePathTriggerFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreatePathTriggerFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCreateQueryTriggerFunc describes the behavior when the
 // CreateQueryTrigger method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -8310,24 +8939,24 @@ func (c CodeMonitorStoreCreateRecipientFuncCall) Results() []interface{} {
 // the CreateSlackWebhookAction method of the parent MockCodeMonitorStore
 // instance is invoked.
 type CodeMonitorStoreCreateSlackWebhookActionFunc struct {
-	defaultHook func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)
-	hooks       []func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)
+	defaultHook func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error)
 	history     []CodeMonitorStoreCreateSlackWebhookActionFuncCall
 	mutex       sync.Mutex
 }
 
 // CreateSlackWebhookAction delegates to the next hook function in the queue
 // and stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) CreateSlackWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*database.SlackWebhookAction, error) {
-	r0, r1 := m.CreateSlackWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.CreateSlackWebhookActionFunc.appendCall(CodeMonitorStoreCreateSlackWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+func (m *MockCodeMonitorStore) CreateSlackWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string, v5 database.ActionSchedule) (*database.SlackWebhookAction, error) {
+	r0, r1 := m.CreateSlackWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.CreateSlackWebhookActionFunc.appendCall(CodeMonitorStoreCreateSlackWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
 // CreateSlackWebhookAction method of the parent MockCodeMonitorStore
 // instance is invoked and the hook queue is empty.
-func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)) {
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error)) {
 	f.defaultHook = hook
 }
 
@@ -8336,7 +8965,7 @@ func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) SetDefaultHook(hook func(
 // instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
-func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)) {
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8345,19 +8974,19 @@ func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) PushHook(hook func(contex
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) SetDefaultReturn(r0 *database.SlackWebhookAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) PushReturn(r0 *database.SlackWebhookAction, r1 error) {
-	f.PushHook(func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8407,6 +9036,9 @@ type CodeMonitorStoreCreateSlackWebhookActionFuncCall struct {
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
 	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 database.ActionSchedule
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *database.SlackWebhookAction
@@ -8418,7 +9050,7 @@ type CodeMonitorStoreCreateSlackWebhookActionFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreCreateSlackWebhookActionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
@@ -8431,24 +9063,24 @@ func (c CodeMonitorStoreCreateSlackWebhookActionFuncCall) Results() []interface{
 // CreateWebhookAction method of the parent MockCodeMonitorStore instance is
 // invoked.
 type CodeMonitorStoreCreateWebhookActionFunc struct {
-	defaultHook func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)
-	hooks       []func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)
+	defaultHook func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error)
 	history     []CodeMonitorStoreCreateWebhookActionFuncCall
 	mutex       sync.Mutex
 }
 
 // CreateWebhookAction delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) CreateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*database.WebhookAction, error) {
-	r0, r1 := m.CreateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.CreateWebhookActionFunc.appendCall(CodeMonitorStoreCreateWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+func (m *MockCodeMonitorStore) CreateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string, v5 database.ActionSchedule) (*database.WebhookAction, error) {
+	r0, r1 := m.CreateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.CreateWebhookActionFunc.appendCall(CodeMonitorStoreCreateWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the CreateWebhookAction
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
-func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)) {
+func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error)) {
 	f.defaultHook = hook
 }
 
@@ -8457,7 +9089,7 @@ func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultHook(hook func(conte
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *CodeMonitorStoreCreateWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)) {
+func (f *CodeMonitorStoreCreateWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8466,19 +9098,19 @@ func (f *CodeMonitorStoreCreateWebhookActionFunc) PushHook(hook func(context.Con
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultReturn(r0 *database.WebhookAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreCreateWebhookActionFunc) PushReturn(r0 *database.WebhookAction, r1 error) {
-	f.PushHook(func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreCreateWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+func (f *CodeMonitorStoreCreateWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8527,6 +9159,9 @@ type CodeMonitorStoreCreateWebhookActionFuncCall struct {
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
 	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 database.ActionSchedule
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *database.WebhookAction
@@ -8538,7 +9173,7 @@ type CodeMonitorStoreCreateWebhookActionFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreCreateWebhookActionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
@@ -8547,6 +9182,125 @@ func (c CodeMonitorStoreCreateWebhookActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreDeleteChatWebhookActionsFunc describes the behavior when
+// the DeleteChatWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreDeleteChatWebhookActionsFunc struct {
+	defaultHook func(context.Context, int64, ...int64) error
+	hooks       []func(context.Context, int64, ...int64) error
+	history     []CodeMonitorStoreDeleteChatWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteChatWebhookActions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteChatWebhookActions(v0 context.Context, v1 int64, v2 ...int64) error {
+	r0 := m.DeleteChatWebhookActionsFunc.nextHook()(v0, v1, v2...)
+	m.DeleteChatWebhookActionsFunc.appendCall(CodeMonitorStoreDeleteChatWebhookActionsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteChatWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreDeleteChatWebhookActionsFunc) SetDefaultHook(hook func(context.Context, int64, ...int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteChatWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreDeleteChatWebhookActionsFunc) PushHook(hook func(context.Context, int64, ...int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteChatWebhookActionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteChatWebhookActionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteChatWebhookActionsFunc) nextHook() func(context.Context, int64, ...int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteChatWebhookActionsFunc) appendCall(r0 CodeMonitorStoreDeleteChatWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreDeleteChatWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreDeleteChatWebhookActionsFunc) History() []CodeMonitorStoreDeleteChatWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteChatWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteChatWebhookActionsFuncCall is an object that
+// describes an invocation of method DeleteChatWebhookActions on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreDeleteChatWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c CodeMonitorStoreDeleteChatWebhookActionsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteChatWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreDeleteEmailActionsFunc describes the behavior when the
 // DeleteEmailActions method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -8765,6 +9519,115 @@ func (c CodeMonitorStoreDeleteMonitorFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreDeleteOldNotifiedMatchesFunc describes the behavior when
+// the DeleteOldNotifiedMatches method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreDeleteOldNotifiedMatchesFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []CodeMonitorStoreDeleteOldNotifiedMatchesFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteOldNotifiedMatches delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteOldNotifiedMatches(v0 context.Context, v1 int) error {
+	r0 := m.DeleteOldNotifiedMatchesFunc.nextHook()(v0, v1)
+	m.DeleteOldNotifiedMatchesFunc.appendCall(CodeMonitorStoreDeleteOldNotifiedMatchesFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteOldNotifiedMatches method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreDeleteOldNotifiedMatchesFunc) SetDefaultHook(hook func(context.Context, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteOldNotifiedMatches method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreDeleteOldNotifiedMatchesFunc) PushHook(hook func(context.Context, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteOldNotifiedMatchesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteOldNotifiedMatchesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteOldNotifiedMatchesFunc) nextHook() func(context.Context, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteOldNotifiedMatchesFunc) appendCall(r0 CodeMonitorStoreDeleteOldNotifiedMatchesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreDeleteOldNotifiedMatchesFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreDeleteOldNotifiedMatchesFunc) History() []CodeMonitorStoreDeleteOldNotifiedMatchesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteOldNotifiedMatchesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteOldNotifiedMatchesFuncCall is an object that
+// describes an invocation of method DeleteOldNotifiedMatches on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreDeleteOldNotifiedMatchesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreDeleteOldNotifiedMatchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteOldNotifiedMatchesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreDeleteOldTriggerJobsFunc describes the behavior when the
 // DeleteOldTriggerJobs method of the parent MockCodeMonitorStore instance
 // is invoked.
@@ -9872,6 +10735,118 @@ func (c CodeMonitorStoreGetActionJobMetadataFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreGetChatWebhookActionFunc describes the behavior when the
+// GetChatWebhookAction method of the parent MockCodeMonitorStore instance
+// is invoked.
+type CodeMonitorStoreGetChatWebhookActionFunc struct {
+	defaultHook func(context.Context, int64) (*database.ChatWebhookAction, error)
+	hooks       []func(context.Context, int64) (*database.ChatWebhookAction, error)
+	history     []CodeMonitorStoreGetChatWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// GetChatWebhookAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetChatWebhookAction(v0 context.Context, v1 int64) (*database.ChatWebhookAction, error) {
+	r0, r1 := m.GetChatWebhookActionFunc.nextHook()(v0, v1)
+	m.GetChatWebhookActionFunc.appendCall(CodeMonitorStoreGetChatWebhookActionFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetChatWebhookAction
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreGetChatWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64) (*database.ChatWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetChatWebhookAction method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreGetChatWebhookActionFunc) PushHook(hook func(context.Context, int64) (*database.ChatWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetChatWebhookActionFunc) SetDefaultReturn(r0 *database.ChatWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*database.ChatWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetChatWebhookActionFunc) PushReturn(r0 *database.ChatWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64) (*database.ChatWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetChatWebhookActionFunc) nextHook() func(context.Context, int64) (*database.ChatWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetChatWebhookActionFunc) appendCall(r0 CodeMonitorStoreGetChatWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreGetChatWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreGetChatWebhookActionFunc) History() []CodeMonitorStoreGetChatWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetChatWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetChatWebhookActionFuncCall is an object that describes
+// an invocation of method GetChatWebhookAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetChatWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.ChatWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetChatWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetChatWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreGetEmailActionFunc describes the behavior when the
 // GetEmailAction method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -10971,6 +11946,118 @@ func (c CodeMonitorStoreListActionJobsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreListChatWebhookActionsFunc describes the behavior when
+// the ListChatWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreListChatWebhookActionsFunc struct {
+	defaultHook func(context.Context, database.ListActionsOpts) ([]*database.ChatWebhookAction, error)
+	hooks       []func(context.Context, database.ListActionsOpts) ([]*database.ChatWebhookAction, error)
+	history     []CodeMonitorStoreListChatWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListChatWebhookActions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListChatWebhookActions(v0 context.Context, v1 database.ListActionsOpts) ([]*database.ChatWebhookAction, error) {
+	r0, r1 := m.ListChatWebhookActionsFunc.nextHook()(v0, v1)
+	m.ListChatWebhookActionsFunc.appendCall(CodeMonitorStoreListChatWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListChatWebhookActions method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreListChatWebhookActionsFunc) SetDefaultHook(hook func(context.Context, database.ListActionsOpts) ([]*database.ChatWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListChatWebhookActions method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreListChatWebhookActionsFunc) PushHook(hook func(context.Context, database.ListActionsOpts) ([]*database.ChatWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreListChatWebhookActionsFunc) SetDefaultReturn(r0 []*database.ChatWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListActionsOpts) ([]*database.ChatWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreListChatWebhookActionsFunc) PushReturn(r0 []*database.ChatWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, database.ListActionsOpts) ([]*database.ChatWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListChatWebhookActionsFunc) nextHook() func(context.Context, database.ListActionsOpts) ([]*database.ChatWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListChatWebhookActionsFunc) appendCall(r0 CodeMonitorStoreListChatWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreListChatWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreListChatWebhookActionsFunc) History() []CodeMonitorStoreListChatWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListChatWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListChatWebhookActionsFuncCall is an object that
+// describes an invocation of method ListChatWebhookActions on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreListChatWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ListActionsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.ChatWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListChatWebhookActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListChatWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreListEmailActionsFunc describes the behavior when the
 // ListEmailActions method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -11191,6 +12278,120 @@ func (c CodeMonitorStoreListMonitorsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreListNotifiedMatchesFunc describes the behavior when the
+// ListNotifiedMatches method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreListNotifiedMatchesFunc struct {
+	defaultHook func(context.Context, *database.ActionJob, []api.CommitID) ([]database.NotifiedMatch, error)
+	hooks       []func(context.Context, *database.ActionJob, []api.CommitID) ([]database.NotifiedMatch, error)
+	history     []CodeMonitorStoreListNotifiedMatchesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListNotifiedMatches delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListNotifiedMatches(v0 context.Context, v1 *database.ActionJob, v2 []api.CommitID) ([]database.NotifiedMatch, error) {
+	r0, r1 := m.ListNotifiedMatchesFunc.nextHook()(v0, v1, v2)
+	m.ListNotifiedMatchesFunc.appendCall(CodeMonitorStoreListNotifiedMatchesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListNotifiedMatches
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreListNotifiedMatchesFunc) SetDefaultHook(hook func(context.Context, *database.ActionJob, []api.CommitID) ([]database.NotifiedMatch, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListNotifiedMatches method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreListNotifiedMatchesFunc) PushHook(hook func(context.Context, *database.ActionJob, []api.CommitID) ([]database.NotifiedMatch, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreListNotifiedMatchesFunc) SetDefaultReturn(r0 []database.NotifiedMatch, r1 error) {
+	f.SetDefaultHook(func(context.Context, *database.ActionJob, []api.CommitID) ([]database.NotifiedMatch, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreListNotifiedMatchesFunc) PushReturn(r0 []database.NotifiedMatch, r1 error) {
+	f.PushHook(func(context.Context, *database.ActionJob, []api.CommitID) ([]database.NotifiedMatch, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListNotifiedMatchesFunc) nextHook() func(context.Context, *database.ActionJob, []api.CommitID) ([]database.NotifiedMatch, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListNotifiedMatchesFunc) appendCall(r0 CodeMonitorStoreListNotifiedMatchesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreListNotifiedMatchesFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreListNotifiedMatchesFunc) History() []CodeMonitorStoreListNotifiedMatchesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListNotifiedMatchesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListNotifiedMatchesFuncCall is an object that describes
+// an invocation of method ListNotifiedMatches on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreListNotifiedMatchesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *database.ActionJob
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []database.NotifiedMatch
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListNotifiedMatchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListNotifiedMatchesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreListQueryTriggerJobsFunc describes the behavior when the
 // ListQueryTriggerJobs method of the parent MockCodeMonitorStore instance
 // is invoked.
@@ -12064,6 +13265,121 @@ func (c CodeMonitorStoreTransactFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpdateChatWebhookActionFunc describes the behavior when
+// the UpdateChatWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreUpdateChatWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error)
+	hooks       []func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error)
+	history     []CodeMonitorStoreUpdateChatWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateChatWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateChatWebhookAction(v0 context.Context, v1 int64, v2 *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error) {
+	r0, r1 := m.UpdateChatWebhookActionFunc.nextHook()(v0, v1, v2)
+	m.UpdateChatWebhookActionFunc.appendCall(CodeMonitorStoreUpdateChatWebhookActionFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateChatWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpdateChatWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateChatWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreUpdateChatWebhookActionFunc) PushHook(hook func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateChatWebhookActionFunc) SetDefaultReturn(r0 *database.ChatWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateChatWebhookActionFunc) PushReturn(r0 *database.ChatWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreUpdateChatWebhookActionFunc) nextHook() func(context.Context, int64, *database.ChatWebhookActionArgs) (*database.ChatWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateChatWebhookActionFunc) appendCall(r0 CodeMonitorStoreUpdateChatWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpdateChatWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreUpdateChatWebhookActionFunc) History() []CodeMonitorStoreUpdateChatWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateChatWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateChatWebhookActionFuncCall is an object that
+// describes an invocation of method UpdateChatWebhookAction on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreUpdateChatWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *database.ChatWebhookActionArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.ChatWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateChatWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateChatWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreUpdateEmailActionFunc describes the behavior when the
 // UpdateEmailAction method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -12406,6 +13722,117 @@ func (c CodeMonitorStoreUpdateMonitorEnabledFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpdatePathTriggerFunc describes the behavior when the
+// UpdatePathTrigger method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreUpdatePathTriggerFunc struct {
+	defaultHook func(context.Context, int64, database.PathTrigger) error
+	hooks       []func(context.Context, int64, database.PathTrigger) error
+	history     []CodeMonitorStoreUpdatePathTriggerFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdatePathTrigger delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdatePathTrigger(v0 context.Context, v1 int64, v2 database.PathTrigger) error {
+	r0 := m.UpdatePathTriggerFunc.nextHook()(v0, v1, v2)
+	m.UpdatePathTriggerFunc.appendCall(CodeMonitorStoreUpdatePathTriggerFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the UpdatePathTrigger
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreUpdatePathTriggerFunc) SetDefaultHook(hook func(context.Context, int64, database.PathTrigger) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdatePathTrigger method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreUpdatePathTriggerFunc) PushHook(hook func(context.Context, int64, database.PathTrigger) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdatePathTriggerFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, database.PathTrigger) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdatePathTriggerFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, database.PathTrigger) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreUpdatePathTriggerFunc) nextHook() func(context.Context, int64, database.PathTrigger) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdatePathTriggerFunc) appendCall(r0 CodeMonitorStoreUpdatePathTriggerFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreUpdatePathTriggerFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreUpdatePathTriggerFunc) History() []CodeMonitorStoreUpdatePathTriggerFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdatePathTriggerFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdatePathTriggerFuncCall is an object that describes an
+// invocation of method UpdatePathTrigger on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreUpdatePathTriggerFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 database.PathTrigger
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdatePathTriggerFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdatePathTriggerFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreUpdateQueryTriggerFunc describes the behavior when the
 // UpdateQueryTrigger method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -12521,24 +13948,24 @@ func (c CodeMonitorStoreUpdateQueryTriggerFuncCall) Results() []interface{} {
 // the UpdateSlackWebhookAction method of the parent MockCodeMonitorStore
 // instance is invoked.
 type CodeMonitorStoreUpdateSlackWebhookActionFunc struct {
-	defaultHook func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)
-	hooks       []func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)
+	defaultHook func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error)
 	history     []CodeMonitorStoreUpdateSlackWebhookActionFuncCall
 	mutex       sync.Mutex
 }
 
 // UpdateSlackWebhookAction delegates to the next hook function in the queue
 // and stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) UpdateSlackWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*database.SlackWebhookAction, error) {
-	r0, r1 := m.UpdateSlackWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.UpdateSlackWebhookActionFunc.appendCall(CodeMonitorStoreUpdateSlackWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+func (m *MockCodeMonitorStore) UpdateSlackWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string, v5 database.ActionSchedule) (*database.SlackWebhookAction, error) {
+	r0, r1 := m.UpdateSlackWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.UpdateSlackWebhookActionFunc.appendCall(CodeMonitorStoreUpdateSlackWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
 // UpdateSlackWebhookAction method of the parent MockCodeMonitorStore
 // instance is invoked and the hook queue is empty.
-func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)) {
+func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error)) {
 	f.defaultHook = hook
 }
 
@@ -12547,7 +13974,7 @@ func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) SetDefaultHook(hook func(
 // instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
-func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)) {
+func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12556,19 +13983,19 @@ func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) PushHook(hook func(contex
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) SetDefaultReturn(r0 *database.SlackWebhookAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) PushReturn(r0 *database.SlackWebhookAction, r1 error) {
-	f.PushHook(func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.SlackWebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12618,6 +14045,9 @@ type CodeMonitorStoreUpdateSlackWebhookActionFuncCall struct {
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
 	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 database.ActionSchedule
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *database.SlackWebhookAction
@@ -12629,7 +14059,7 @@ type CodeMonitorStoreUpdateSlackWebhookActionFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreUpdateSlackWebhookActionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
@@ -12757,24 +14187,24 @@ func (c CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall) Results() []interfa
 // UpdateWebhookAction method of the parent MockCodeMonitorStore instance is
 // invoked.
 type CodeMonitorStoreUpdateWebhookActionFunc struct {
-	defaultHook func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)
-	hooks       []func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)
+	defaultHook func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error)
 	history     []CodeMonitorStoreUpdateWebhookActionFuncCall
 	mutex       sync.Mutex
 }
 
 // UpdateWebhookAction delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) UpdateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*database.WebhookAction, error) {
-	r0, r1 := m.UpdateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.UpdateWebhookActionFunc.appendCall(CodeMonitorStoreUpdateWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+func (m *MockCodeMonitorStore) UpdateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string, v5 database.ActionSchedule) (*database.WebhookAction, error) {
+	r0, r1 := m.UpdateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.UpdateWebhookActionFunc.appendCall(CodeMonitorStoreUpdateWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the UpdateWebhookAction
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
-func (f *CodeMonitorStoreUpdateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)) {
+func (f *CodeMonitorStoreUpdateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error)) {
 	f.defaultHook = hook
 }
 
@@ -12783,7 +14213,7 @@ func (f *CodeMonitorStoreUpdateWebhookActionFunc) SetDefaultHook(hook func(conte
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *CodeMonitorStoreUpdateWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)) {
+func (f *CodeMonitorStoreUpdateWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12792,19 +14222,19 @@ func (f *CodeMonitorStoreUpdateWebhookActionFunc) PushHook(hook func(context.Con
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreUpdateWebhookActionFunc) SetDefaultReturn(r0 *database.WebhookAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreUpdateWebhookActionFunc) PushReturn(r0 *database.WebhookAction, r1 error) {
-	f.PushHook(func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreUpdateWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+func (f *CodeMonitorStoreUpdateWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string, database.ActionSchedule) (*database.WebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12853,6 +14283,9 @@ type CodeMonitorStoreUpdateWebhookActionFuncCall struct {
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
 	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 database.ActionSchedule
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *database.WebhookAction
@@ -12864,7 +14297,7 @@ type CodeMonitorStoreUpdateWebhookActionFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreUpdateWebhookActionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
@@ -14955,6 +16388,9 @@ type MockDB struct {
 	// GitserverLocalCloneFunc is an instance of a mock function object
 	// controlling the behavior of the method GitserverLocalClone.
 	GitserverLocalCloneFunc *DBGitserverLocalCloneFunc
+	// GitserverRepoPushMirrorsFunc is an instance of a mock function object
+	// controlling the behavior of the method GitserverRepoPushMirrors.
+	GitserverRepoPushMirrorsFunc *DBGitserverRepoPushMirrorsFunc
 	// GitserverReposFunc is an instance of a mock function object
 	// controlling the behavior of the method GitserverRepos.
 	GitserverReposFunc *DBGitserverReposFunc
@@ -14988,6 +16424,9 @@ type MockDB struct {
 	// OutboundWebhooksFunc is an instance of a mock function object
 	// controlling the behavior of the method OutboundWebhooks.
 	OutboundWebhooksFunc *DBOutboundWebhooksFunc
+	// OwnCoverageReportsFunc is an instance of a mock function object
+	// controlling the behavior of the method OwnCoverageReports.
+	OwnCoverageReportsFunc *DBOwnCoverageReportsFunc
 	// OwnSignalConfigurationsFunc is an instance of a mock function object
 	// controlling the behavior of the method OwnSignalConfigurations.
 	OwnSignalConfigurationsFunc *DBOwnSignalConfigurationsFunc
@@ -15025,6 +16464,9 @@ type MockDB struct {
 	// RepoCommitsChangelistsFunc is an instance of a mock function object
 	// controlling the behavior of the method RepoCommitsChangelists.
 	RepoCommitsChangelistsFunc *DBRepoCommitsChangelistsFunc
+	// RepoCommitsHgChangesetsFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoCommitsHgChangesets.
+	RepoCommitsHgChangesetsFunc *DBRepoCommitsHgChangesetsFunc
 	// RepoKVPsFunc is an instance of a mock function object controlling the
 	// behavior of the method RepoKVPs.
 	RepoKVPsFunc *DBRepoKVPsFunc
@@ -15201,6 +16643,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		GitserverRepoPushMirrorsFunc: &DBGitserverRepoPushMirrorsFunc{
+			defaultHook: func() (r0 database.GitserverRepoPushMirrorStore) {
+				return
+			},
+		},
 		GitserverReposFunc: &DBGitserverReposFunc{
 			defaultHook: func() (r0 database.GitserverRepoStore) {
 				return
@@ -15256,6 +16703,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		OwnCoverageReportsFunc: &DBOwnCoverageReportsFunc{
+			defaultHook: func() (r0 database.OwnCoverageReportStore) {
+				return
+			},
+		},
 		OwnSignalConfigurationsFunc: &DBOwnSignalConfigurationsFunc{
 			defaultHook: func() (r0 database.SignalConfigurationStore) {
 				return
@@ -15316,6 +16768,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		RepoCommitsHgChangesetsFunc: &DBRepoCommitsHgChangesetsFunc{
+			defaultHook: func() (r0 database.RepoCommitsHgChangesetsStore) {
+				return
+			},
+		},
 		RepoKVPsFunc: &DBRepoKVPsFunc{
 			defaultHook: func() (r0 database.RepoKVPStore) {
 				return
@@ -15538,6 +16995,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.GitserverLocalClone")
 			},
 		},
+		GitserverRepoPushMirrorsFunc: &DBGitserverRepoPushMirrorsFunc{
+			defaultHook: func() database.GitserverRepoPushMirrorStore {
+				panic("unexpected invocation of MockDB.GitserverRepoPushMirrors")
+			},
+		},
 		GitserverReposFunc: &DBGitserverReposFunc{
 			defaultHook: func() database.GitserverRepoStore {
 				panic("unexpected invocation of MockDB.GitserverRepos")
@@ -15593,6 +17055,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.OutboundWebhooks")
 			},
 		},
+		OwnCoverageReportsFunc: &DBOwnCoverageReportsFunc{
+			defaultHook: func() database.OwnCoverageReportStore {
+				panic("unexpected invocation of MockDB.OwnCoverageReports")
+			},
+		},
 		OwnSignalConfigurationsFunc: &DBOwnSignalConfigurationsFunc{
 			defaultHook: func() database.SignalConfigurationStore {
 				panic("unexpected invocation of MockDB.OwnSignalConfigurations")
@@ -15653,6 +17120,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.RepoCommitsChangelists")
 			},
 		},
+		RepoCommitsHgChangesetsFunc: &DBRepoCommitsHgChangesetsFunc{
+			defaultHook: func() database.RepoCommitsHgChangesetsStore {
+				panic("unexpected invocation of MockDB.RepoCommitsHgChangesets")
+			},
+		},
 		RepoKVPsFunc: &DBRepoKVPsFunc{
 			defaultHook: func() database.RepoKVPStore {
 				panic("unexpected invocation of MockDB.RepoKVPs")
@@ -15835,6 +17307,9 @@ func NewMockDBFrom(i database.DB) *MockDB {
 		GitserverLocalCloneFunc: &DBGitserverLocalCloneFunc{
 			defaultHook: i.GitserverLocalClone,
 		},
+		GitserverRepoPushMirrorsFunc: &DBGitserverRepoPushMirrorsFunc{
+			defaultHook: i.GitserverRepoPushMirrors,
+		},
 		GitserverReposFunc: &DBGitserverReposFunc{
 			defaultHook: i.GitserverRepos,
 		},
@@ -15868,6 +17343,9 @@ func NewMockDBFrom(i database.DB) *MockDB {
 		OutboundWebhooksFunc: &DBOutboundWebhooksFunc{
 			defaultHook: i.OutboundWebhooks,
 		},
+		OwnCoverageReportsFunc: &DBOwnCoverageReportsFunc{
+			defaultHook: i.OwnCoverageReports,
+		},
 		OwnSignalConfigurationsFunc: &DBOwnSignalConfigurationsFunc{
 			defaultHook: i.OwnSignalConfigurations,
 		},
@@ -15904,6 +17382,9 @@ func NewMockDBFrom(i database.DB) *MockDB {
 		RepoCommitsChangelistsFunc: &DBRepoCommitsChangelistsFunc{
 			defaultHook: i.RepoCommitsChangelists,
 		},
+		RepoCommitsHgChangesetsFunc: &DBRepoCommitsHgChangesetsFunc{
+			defaultHook: i.RepoCommitsHgChangesets,
+		},
 		RepoKVPsFunc: &DBRepoKVPsFunc{
 			defaultHook: i.RepoKVPs,
 		},
@@ -17968,6 +19449,105 @@ func (c DBGitserverLocalCloneFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBGitserverRepoPushMirrorsFunc describes the behavior when the
+// GitserverRepoPushMirrors method of the parent MockDB instance is invoked.
+type DBGitserverRepoPushMirrorsFunc struct {
+	defaultHook func() database.GitserverRepoPushMirrorStore
+	hooks       []func() database.GitserverRepoPushMirrorStore
+	history     []DBGitserverRepoPushMirrorsFuncCall
+	mutex       sync.Mutex
+}
+
+// GitserverRepoPushMirrors delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDB) GitserverRepoPushMirrors() database.GitserverRepoPushMirrorStore {
+	r0 := m.GitserverRepoPushMirrorsFunc.nextHook()()
+	m.GitserverRepoPushMirrorsFunc.appendCall(DBGitserverRepoPushMirrorsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// GitserverRepoPushMirrors method of the parent MockDB instance is invoked
+// and the hook queue is empty.
+func (f *DBGitserverRepoPushMirrorsFunc) SetDefaultHook(hook func() database.GitserverRepoPushMirrorStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GitserverRepoPushMirrors method of the parent MockDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *DBGitserverRepoPushMirrorsFunc) PushHook(hook func() database.GitserverRepoPushMirrorStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBGitserverRepoPushMirrorsFunc) SetDefaultReturn(r0 database.GitserverRepoPushMirrorStore) {
+	f.SetDefaultHook(func() database.GitserverRepoPushMirrorStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBGitserverRepoPushMirrorsFunc) PushReturn(r0 database.GitserverRepoPushMirrorStore) {
+	f.PushHook(func() database.GitserverRepoPushMirrorStore {
+		return r0
+	})
+}
+
+func (f *DBGitserverRepoPushMirrorsFunc) nextHook() func() database.GitserverRepoPushMirrorStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBGitserverRepoPushMirrorsFunc) appendCall(r0 DBGitserverRepoPushMirrorsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBGitserverRepoPushMirrorsFuncCall objects
+// describing the invocations of this function.
+func (f *DBGitserverRepoPushMirrorsFunc) History() []DBGitserverRepoPushMirrorsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBGitserverRepoPushMirrorsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBGitserverRepoPushMirrorsFuncCall is an object that describes an
+// invocation of method GitserverRepoPushMirrors on an instance of MockDB.
+type DBGitserverRepoPushMirrorsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.GitserverRepoPushMirrorStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBGitserverRepoPushMirrorsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBGitserverRepoPushMirrorsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBGitserverReposFunc describes the behavior when the GitserverRepos
 // method of the parent MockDB instance is invoked.
 type DBGitserverReposFunc struct {
@@ -19061,6 +20641,105 @@ func (c DBOutboundWebhooksFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBOwnCoverageReportsFunc describes the behavior when the OwnCoverageReports
+// method of the parent MockDB instance is invoked.
+type DBOwnCoverageReportsFunc struct {
+	defaultHook func() database.OwnCoverageReportStore
+	hooks       []func() database.OwnCoverageReportStore
+	history     []DBOwnCoverageReportsFuncCall
+	mutex       sync.Mutex
+}
+
+// OwnCoverageReports delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDB) OwnCoverageReports() database.OwnCoverageReportStore {
+	r0 := m.OwnCoverageReportsFunc.nextHook()()
+	m.OwnCoverageReportsFunc.appendCall(DBOwnCoverageReportsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the OwnCoverageReports
+// method of the parent MockDB instance is invoked and the hook queue is
+// empty.
+func (f *DBOwnCoverageReportsFunc) SetDefaultHook(hook func() database.OwnCoverageReportStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// OwnCoverageReports method of the parent MockDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBOwnCoverageReportsFunc) PushHook(hook func() database.OwnCoverageReportStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBOwnCoverageReportsFunc) SetDefaultReturn(r0 database.OwnCoverageReportStore) {
+	f.SetDefaultHook(func() database.OwnCoverageReportStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBOwnCoverageReportsFunc) PushReturn(r0 database.OwnCoverageReportStore) {
+	f.PushHook(func() database.OwnCoverageReportStore {
+		return r0
+	})
+}
+
+func (f *DBOwnCoverageReportsFunc) nextHook() func() database.OwnCoverageReportStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBOwnCoverageReportsFunc) appendCall(r0 DBOwnCoverageReportsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBOwnCoverageReportsFuncCall objects describing
+// the invocations of this function.
+func (f *DBOwnCoverageReportsFunc) History() []DBOwnCoverageReportsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBOwnCoverageReportsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBOwnCoverageReportsFuncCall is an object that describes an invocation of
+// method OwnCoverageReports on an instance of MockDB.
+type DBOwnCoverageReportsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.OwnCoverageReportStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBOwnCoverageReportsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBOwnCoverageReportsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBOwnSignalConfigurationsFunc describes the behavior when the
 // OwnSignalConfigurations method of the parent MockDB instance is invoked.
 type DBOwnSignalConfigurationsFunc struct {
@@ -20280,6 +21959,105 @@ func (c DBRepoCommitsChangelistsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBRepoCommitsHgChangesetsFunc describes the behavior when the
+// RepoCommitsHgChangesets method of the parent MockDB instance is invoked.
+type DBRepoCommitsHgChangesetsFunc struct {
+	defaultHook func() database.RepoCommitsHgChangesetsStore
+	hooks       []func() database.RepoCommitsHgChangesetsStore
+	history     []DBRepoCommitsHgChangesetsFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoCommitsHgChangesets delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDB) RepoCommitsHgChangesets() database.RepoCommitsHgChangesetsStore {
+	r0 := m.RepoCommitsHgChangesetsFunc.nextHook()()
+	m.RepoCommitsHgChangesetsFunc.appendCall(DBRepoCommitsHgChangesetsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// RepoCommitsHgChangesets method of the parent MockDB instance is invoked
+// and the hook queue is empty.
+func (f *DBRepoCommitsHgChangesetsFunc) SetDefaultHook(hook func() database.RepoCommitsHgChangesetsStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoCommitsHgChangesets method of the parent MockDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *DBRepoCommitsHgChangesetsFunc) PushHook(hook func() database.RepoCommitsHgChangesetsStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBRepoCommitsHgChangesetsFunc) SetDefaultReturn(r0 database.RepoCommitsHgChangesetsStore) {
+	f.SetDefaultHook(func() database.RepoCommitsHgChangesetsStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBRepoCommitsHgChangesetsFunc) PushReturn(r0 database.RepoCommitsHgChangesetsStore) {
+	f.PushHook(func() database.RepoCommitsHgChangesetsStore {
+		return r0
+	})
+}
+
+func (f *DBRepoCommitsHgChangesetsFunc) nextHook() func() database.RepoCommitsHgChangesetsStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBRepoCommitsHgChangesetsFunc) appendCall(r0 DBRepoCommitsHgChangesetsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBRepoCommitsHgChangesetsFuncCall objects
+// describing the invocations of this function.
+func (f *DBRepoCommitsHgChangesetsFunc) History() []DBRepoCommitsHgChangesetsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBRepoCommitsHgChangesetsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBRepoCommitsHgChangesetsFuncCall is an object that describes an
+// invocation of method RepoCommitsHgChangesets on an instance of MockDB.
+type DBRepoCommitsHgChangesetsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.RepoCommitsHgChangesetsStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBRepoCommitsHgChangesetsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBRepoCommitsHgChangesetsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBRepoKVPsFunc describes the behavior when the RepoKVPs method of the
 // parent MockDB instance is invoked.
 type DBRepoKVPsFunc struct {
@@ -37073,388 +38851,164 @@ func (c GitserverLocalCloneStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// MockGitserverRepoStore is a mock implementation of the GitserverRepoStore
-// interface (from the package
+// MockGitserverRepoPushMirrorStore is a mock implementation of the
+// GitserverRepoPushMirrorStore interface (from the package
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
 // testing.
-type MockGitserverRepoStore struct {
-	// GetByIDFunc is an instance of a mock function object controlling the
-	// behavior of the method GetByID.
-	GetByIDFunc *GitserverRepoStoreGetByIDFunc
-	// GetByNameFunc is an instance of a mock function object controlling
-	// the behavior of the method GetByName.
-	GetByNameFunc *GitserverRepoStoreGetByNameFunc
-	// GetByNamesFunc is an instance of a mock function object controlling
-	// the behavior of the method GetByNames.
-	GetByNamesFunc *GitserverRepoStoreGetByNamesFunc
-	// GetGitserverGitDirSizeFunc is an instance of a mock function object
-	// controlling the behavior of the method GetGitserverGitDirSize.
-	GetGitserverGitDirSizeFunc *GitserverRepoStoreGetGitserverGitDirSizeFunc
-	// GetLastSyncOutputFunc is an instance of a mock function object
-	// controlling the behavior of the method GetLastSyncOutput.
-	GetLastSyncOutputFunc *GitserverRepoStoreGetLastSyncOutputFunc
+type MockGitserverRepoPushMirrorStore struct {
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
-	HandleFunc *GitserverRepoStoreHandleFunc
-	// IteratePurgeableReposFunc is an instance of a mock function object
-	// controlling the behavior of the method IteratePurgeableRepos.
-	IteratePurgeableReposFunc *GitserverRepoStoreIteratePurgeableReposFunc
-	// IterateRepoGitserverStatusFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// IterateRepoGitserverStatus.
-	IterateRepoGitserverStatusFunc *GitserverRepoStoreIterateRepoGitserverStatusFunc
-	// ListReposWithLastErrorFunc is an instance of a mock function object
-	// controlling the behavior of the method ListReposWithLastError.
-	ListReposWithLastErrorFunc *GitserverRepoStoreListReposWithLastErrorFunc
-	// LogCorruptionFunc is an instance of a mock function object
-	// controlling the behavior of the method LogCorruption.
-	LogCorruptionFunc *GitserverRepoStoreLogCorruptionFunc
-	// SetCloneStatusFunc is an instance of a mock function object
-	// controlling the behavior of the method SetCloneStatus.
-	SetCloneStatusFunc *GitserverRepoStoreSetCloneStatusFunc
-	// SetCloningProgressFunc is an instance of a mock function object
-	// controlling the behavior of the method SetCloningProgress.
-	SetCloningProgressFunc *GitserverRepoStoreSetCloningProgressFunc
-	// SetLastErrorFunc is an instance of a mock function object controlling
-	// the behavior of the method SetLastError.
-	SetLastErrorFunc *GitserverRepoStoreSetLastErrorFunc
-	// SetLastFetchedFunc is an instance of a mock function object
-	// controlling the behavior of the method SetLastFetched.
-	SetLastFetchedFunc *GitserverRepoStoreSetLastFetchedFunc
-	// SetLastOutputFunc is an instance of a mock function object
-	// controlling the behavior of the method SetLastOutput.
-	SetLastOutputFunc *GitserverRepoStoreSetLastOutputFunc
-	// SetRepoSizeFunc is an instance of a mock function object controlling
-	// the behavior of the method SetRepoSize.
-	SetRepoSizeFunc *GitserverRepoStoreSetRepoSizeFunc
-	// TotalErroredCloudDefaultReposFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// TotalErroredCloudDefaultRepos.
-	TotalErroredCloudDefaultReposFunc *GitserverRepoStoreTotalErroredCloudDefaultReposFunc
-	// UpdateFunc is an instance of a mock function object controlling the
-	// behavior of the method Update.
-	UpdateFunc *GitserverRepoStoreUpdateFunc
-	// UpdateRepoSizesFunc is an instance of a mock function object
-	// controlling the behavior of the method UpdateRepoSizes.
-	UpdateRepoSizesFunc *GitserverRepoStoreUpdateRepoSizesFunc
+	HandleFunc *GitserverRepoPushMirrorStoreHandleFunc
+	// ListByRepoFunc is an instance of a mock function object controlling
+	// the behavior of the method ListByRepo.
+	ListByRepoFunc *GitserverRepoPushMirrorStoreListByRepoFunc
+	// ListDueFunc is an instance of a mock function object controlling the
+	// behavior of the method ListDue.
+	ListDueFunc *GitserverRepoPushMirrorStoreListDueFunc
+	// RecordFailureFunc is an instance of a mock function object
+	// controlling the behavior of the method RecordFailure.
+	RecordFailureFunc *GitserverRepoPushMirrorStoreRecordFailureFunc
+	// RecordSuccessFunc is an instance of a mock function object
+	// controlling the behavior of the method RecordSuccess.
+	RecordSuccessFunc *GitserverRepoPushMirrorStoreRecordSuccessFunc
 	// WithFunc is an instance of a mock function object controlling the
 	// behavior of the method With.
-	WithFunc *GitserverRepoStoreWithFunc
+	WithFunc *GitserverRepoPushMirrorStoreWithFunc
 }
 
-// NewMockGitserverRepoStore creates a new mock of the GitserverRepoStore
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockGitserverRepoStore() *MockGitserverRepoStore {
-	return &MockGitserverRepoStore{
-		GetByIDFunc: &GitserverRepoStoreGetByIDFunc{
-			defaultHook: func(context.Context, api.RepoID) (r0 *types.GitserverRepo, r1 error) {
-				return
-			},
-		},
-		GetByNameFunc: &GitserverRepoStoreGetByNameFunc{
-			defaultHook: func(context.Context, api.RepoName) (r0 *types.GitserverRepo, r1 error) {
-				return
-			},
-		},
-		GetByNamesFunc: &GitserverRepoStoreGetByNamesFunc{
-			defaultHook: func(context.Context, ...api.RepoName) (r0 map[api.RepoName]*types.GitserverRepo, r1 error) {
-				return
-			},
-		},
-		GetGitserverGitDirSizeFunc: &GitserverRepoStoreGetGitserverGitDirSizeFunc{
-			defaultHook: func(context.Context) (r0 int64, r1 error) {
-				return
-			},
-		},
-		GetLastSyncOutputFunc: &GitserverRepoStoreGetLastSyncOutputFunc{
-			defaultHook: func(context.Context, api.RepoName) (r0 string, r1 bool, r2 error) {
-				return
-			},
-		},
-		HandleFunc: &GitserverRepoStoreHandleFunc{
+// NewMockGitserverRepoPushMirrorStore creates a new mock of the
+// GitserverRepoPushMirrorStore interface. All methods return zero values
+// for all results, unless overwritten.
+func NewMockGitserverRepoPushMirrorStore() *MockGitserverRepoPushMirrorStore {
+	return &MockGitserverRepoPushMirrorStore{
+		HandleFunc: &GitserverRepoPushMirrorStoreHandleFunc{
 			defaultHook: func() (r0 basestore.TransactableHandle) {
 				return
 			},
 		},
-		IteratePurgeableReposFunc: &GitserverRepoStoreIteratePurgeableReposFunc{
-			defaultHook: func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) (r0 error) {
-				return
-			},
-		},
-		IterateRepoGitserverStatusFunc: &GitserverRepoStoreIterateRepoGitserverStatusFunc{
-			defaultHook: func(context.Context, database.IterateRepoGitserverStatusOptions) (r0 []types.RepoGitserverStatus, r1 int, r2 error) {
-				return
-			},
-		},
-		ListReposWithLastErrorFunc: &GitserverRepoStoreListReposWithLastErrorFunc{
-			defaultHook: func(context.Context) (r0 []api.RepoName, r1 error) {
-				return
-			},
-		},
-		LogCorruptionFunc: &GitserverRepoStoreLogCorruptionFunc{
-			defaultHook: func(context.Context, api.RepoName, string, string) (r0 error) {
-				return
-			},
-		},
-		SetCloneStatusFunc: &GitserverRepoStoreSetCloneStatusFunc{
-			defaultHook: func(context.Context, api.RepoName, types.CloneStatus, string) (r0 error) {
-				return
-			},
-		},
-		SetCloningProgressFunc: &GitserverRepoStoreSetCloningProgressFunc{
-			defaultHook: func(context.Context, api.RepoName, string) (r0 error) {
-				return
-			},
-		},
-		SetLastErrorFunc: &GitserverRepoStoreSetLastErrorFunc{
-			defaultHook: func(context.Context, api.RepoName, string, string) (r0 error) {
-				return
-			},
-		},
-		SetLastFetchedFunc: &GitserverRepoStoreSetLastFetchedFunc{
-			defaultHook: func(context.Context, api.RepoName, database.GitserverFetchData) (r0 error) {
-				return
-			},
-		},
-		SetLastOutputFunc: &GitserverRepoStoreSetLastOutputFunc{
-			defaultHook: func(context.Context, api.RepoName, string) (r0 error) {
-				return
-			},
-		},
-		SetRepoSizeFunc: &GitserverRepoStoreSetRepoSizeFunc{
-			defaultHook: func(context.Context, api.RepoName, int64, string) (r0 error) {
+		ListByRepoFunc: &GitserverRepoPushMirrorStoreListByRepoFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 []*types.GitserverRepoPushMirror, r1 error) {
 				return
 			},
 		},
-		TotalErroredCloudDefaultReposFunc: &GitserverRepoStoreTotalErroredCloudDefaultReposFunc{
-			defaultHook: func(context.Context) (r0 int, r1 error) {
+		ListDueFunc: &GitserverRepoPushMirrorStoreListDueFunc{
+			defaultHook: func(context.Context, string, int) (r0 []*types.GitserverRepoPushMirror, r1 error) {
 				return
 			},
 		},
-		UpdateFunc: &GitserverRepoStoreUpdateFunc{
-			defaultHook: func(context.Context, ...*types.GitserverRepo) (r0 error) {
+		RecordFailureFunc: &GitserverRepoPushMirrorStoreRecordFailureFunc{
+			defaultHook: func(context.Context, api.RepoID, string, string, time.Duration, time.Duration) (r0 error) {
 				return
 			},
 		},
-		UpdateRepoSizesFunc: &GitserverRepoStoreUpdateRepoSizesFunc{
-			defaultHook: func(context.Context, string, map[api.RepoName]int64) (r0 int, r1 error) {
+		RecordSuccessFunc: &GitserverRepoPushMirrorStoreRecordSuccessFunc{
+			defaultHook: func(context.Context, api.RepoID, string, time.Time) (r0 error) {
 				return
 			},
 		},
-		WithFunc: &GitserverRepoStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) (r0 database.GitserverRepoStore) {
+		WithFunc: &GitserverRepoPushMirrorStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 database.GitserverRepoPushMirrorStore) {
 				return
 			},
 		},
 	}
 }
 
-// NewStrictMockGitserverRepoStore creates a new mock of the
-// GitserverRepoStore interface. All methods panic on invocation, unless
-// overwritten.
-func NewStrictMockGitserverRepoStore() *MockGitserverRepoStore {
-	return &MockGitserverRepoStore{
-		GetByIDFunc: &GitserverRepoStoreGetByIDFunc{
-			defaultHook: func(context.Context, api.RepoID) (*types.GitserverRepo, error) {
-				panic("unexpected invocation of MockGitserverRepoStore.GetByID")
-			},
-		},
-		GetByNameFunc: &GitserverRepoStoreGetByNameFunc{
-			defaultHook: func(context.Context, api.RepoName) (*types.GitserverRepo, error) {
-				panic("unexpected invocation of MockGitserverRepoStore.GetByName")
-			},
-		},
-		GetByNamesFunc: &GitserverRepoStoreGetByNamesFunc{
-			defaultHook: func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error) {
-				panic("unexpected invocation of MockGitserverRepoStore.GetByNames")
-			},
-		},
-		GetGitserverGitDirSizeFunc: &GitserverRepoStoreGetGitserverGitDirSizeFunc{
-			defaultHook: func(context.Context) (int64, error) {
-				panic("unexpected invocation of MockGitserverRepoStore.GetGitserverGitDirSize")
-			},
-		},
-		GetLastSyncOutputFunc: &GitserverRepoStoreGetLastSyncOutputFunc{
-			defaultHook: func(context.Context, api.RepoName) (string, bool, error) {
-				panic("unexpected invocation of MockGitserverRepoStore.GetLastSyncOutput")
-			},
-		},
-		HandleFunc: &GitserverRepoStoreHandleFunc{
+// NewStrictMockGitserverRepoPushMirrorStore creates a new mock of the
+// GitserverRepoPushMirrorStore interface. All methods panic on invocation,
+// unless overwritten.
+func NewStrictMockGitserverRepoPushMirrorStore() *MockGitserverRepoPushMirrorStore {
+	return &MockGitserverRepoPushMirrorStore{
+		HandleFunc: &GitserverRepoPushMirrorStoreHandleFunc{
 			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockGitserverRepoStore.Handle")
-			},
-		},
-		IteratePurgeableReposFunc: &GitserverRepoStoreIteratePurgeableReposFunc{
-			defaultHook: func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error {
-				panic("unexpected invocation of MockGitserverRepoStore.IteratePurgeableRepos")
-			},
-		},
-		IterateRepoGitserverStatusFunc: &GitserverRepoStoreIterateRepoGitserverStatusFunc{
-			defaultHook: func(context.Context, database.IterateRepoGitserverStatusOptions) ([]types.RepoGitserverStatus, int, error) {
-				panic("unexpected invocation of MockGitserverRepoStore.IterateRepoGitserverStatus")
-			},
-		},
-		ListReposWithLastErrorFunc: &GitserverRepoStoreListReposWithLastErrorFunc{
-			defaultHook: func(context.Context) ([]api.RepoName, error) {
-				panic("unexpected invocation of MockGitserverRepoStore.ListReposWithLastError")
-			},
-		},
-		LogCorruptionFunc: &GitserverRepoStoreLogCorruptionFunc{
-			defaultHook: func(context.Context, api.RepoName, string, string) error {
-				panic("unexpected invocation of MockGitserverRepoStore.LogCorruption")
-			},
-		},
-		SetCloneStatusFunc: &GitserverRepoStoreSetCloneStatusFunc{
-			defaultHook: func(context.Context, api.RepoName, types.CloneStatus, string) error {
-				panic("unexpected invocation of MockGitserverRepoStore.SetCloneStatus")
-			},
-		},
-		SetCloningProgressFunc: &GitserverRepoStoreSetCloningProgressFunc{
-			defaultHook: func(context.Context, api.RepoName, string) error {
-				panic("unexpected invocation of MockGitserverRepoStore.SetCloningProgress")
+				panic("unexpected invocation of MockGitserverRepoPushMirrorStore.Handle")
 			},
 		},
-		SetLastErrorFunc: &GitserverRepoStoreSetLastErrorFunc{
-			defaultHook: func(context.Context, api.RepoName, string, string) error {
-				panic("unexpected invocation of MockGitserverRepoStore.SetLastError")
-			},
-		},
-		SetLastFetchedFunc: &GitserverRepoStoreSetLastFetchedFunc{
-			defaultHook: func(context.Context, api.RepoName, database.GitserverFetchData) error {
-				panic("unexpected invocation of MockGitserverRepoStore.SetLastFetched")
-			},
-		},
-		SetLastOutputFunc: &GitserverRepoStoreSetLastOutputFunc{
-			defaultHook: func(context.Context, api.RepoName, string) error {
-				panic("unexpected invocation of MockGitserverRepoStore.SetLastOutput")
-			},
-		},
-		SetRepoSizeFunc: &GitserverRepoStoreSetRepoSizeFunc{
-			defaultHook: func(context.Context, api.RepoName, int64, string) error {
-				panic("unexpected invocation of MockGitserverRepoStore.SetRepoSize")
+		ListByRepoFunc: &GitserverRepoPushMirrorStoreListByRepoFunc{
+			defaultHook: func(context.Context, api.RepoID) ([]*types.GitserverRepoPushMirror, error) {
+				panic("unexpected invocation of MockGitserverRepoPushMirrorStore.ListByRepo")
 			},
 		},
-		TotalErroredCloudDefaultReposFunc: &GitserverRepoStoreTotalErroredCloudDefaultReposFunc{
-			defaultHook: func(context.Context) (int, error) {
-				panic("unexpected invocation of MockGitserverRepoStore.TotalErroredCloudDefaultRepos")
+		ListDueFunc: &GitserverRepoPushMirrorStoreListDueFunc{
+			defaultHook: func(context.Context, string, int) ([]*types.GitserverRepoPushMirror, error) {
+				panic("unexpected invocation of MockGitserverRepoPushMirrorStore.ListDue")
 			},
 		},
-		UpdateFunc: &GitserverRepoStoreUpdateFunc{
-			defaultHook: func(context.Context, ...*types.GitserverRepo) error {
-				panic("unexpected invocation of MockGitserverRepoStore.Update")
+		RecordFailureFunc: &GitserverRepoPushMirrorStoreRecordFailureFunc{
+			defaultHook: func(context.Context, api.RepoID, string, string, time.Duration, time.Duration) error {
+				panic("unexpected invocation of MockGitserverRepoPushMirrorStore.RecordFailure")
 			},
 		},
-		UpdateRepoSizesFunc: &GitserverRepoStoreUpdateRepoSizesFunc{
-			defaultHook: func(context.Context, string, map[api.RepoName]int64) (int, error) {
-				panic("unexpected invocation of MockGitserverRepoStore.UpdateRepoSizes")
+		RecordSuccessFunc: &GitserverRepoPushMirrorStoreRecordSuccessFunc{
+			defaultHook: func(context.Context, api.RepoID, string, time.Time) error {
+				panic("unexpected invocation of MockGitserverRepoPushMirrorStore.RecordSuccess")
 			},
 		},
-		WithFunc: &GitserverRepoStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) database.GitserverRepoStore {
-				panic("unexpected invocation of MockGitserverRepoStore.With")
+		WithFunc: &GitserverRepoPushMirrorStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) database.GitserverRepoPushMirrorStore {
+				panic("unexpected invocation of MockGitserverRepoPushMirrorStore.With")
 			},
 		},
 	}
 }
 
-// NewMockGitserverRepoStoreFrom creates a new mock of the
-// MockGitserverRepoStore interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockGitserverRepoStoreFrom(i database.GitserverRepoStore) *MockGitserverRepoStore {
-	return &MockGitserverRepoStore{
-		GetByIDFunc: &GitserverRepoStoreGetByIDFunc{
-			defaultHook: i.GetByID,
-		},
-		GetByNameFunc: &GitserverRepoStoreGetByNameFunc{
-			defaultHook: i.GetByName,
-		},
-		GetByNamesFunc: &GitserverRepoStoreGetByNamesFunc{
-			defaultHook: i.GetByNames,
-		},
-		GetGitserverGitDirSizeFunc: &GitserverRepoStoreGetGitserverGitDirSizeFunc{
-			defaultHook: i.GetGitserverGitDirSize,
-		},
-		GetLastSyncOutputFunc: &GitserverRepoStoreGetLastSyncOutputFunc{
-			defaultHook: i.GetLastSyncOutput,
-		},
-		HandleFunc: &GitserverRepoStoreHandleFunc{
+// NewMockGitserverRepoPushMirrorStoreFrom creates a new mock of the
+// MockGitserverRepoPushMirrorStore interface. All methods delegate to the
+// given implementation, unless overwritten.
+func NewMockGitserverRepoPushMirrorStoreFrom(i database.GitserverRepoPushMirrorStore) *MockGitserverRepoPushMirrorStore {
+	return &MockGitserverRepoPushMirrorStore{
+		HandleFunc: &GitserverRepoPushMirrorStoreHandleFunc{
 			defaultHook: i.Handle,
 		},
-		IteratePurgeableReposFunc: &GitserverRepoStoreIteratePurgeableReposFunc{
-			defaultHook: i.IteratePurgeableRepos,
+		ListByRepoFunc: &GitserverRepoPushMirrorStoreListByRepoFunc{
+			defaultHook: i.ListByRepo,
 		},
-		IterateRepoGitserverStatusFunc: &GitserverRepoStoreIterateRepoGitserverStatusFunc{
-			defaultHook: i.IterateRepoGitserverStatus,
+		ListDueFunc: &GitserverRepoPushMirrorStoreListDueFunc{
+			defaultHook: i.ListDue,
 		},
-		ListReposWithLastErrorFunc: &GitserverRepoStoreListReposWithLastErrorFunc{
-			defaultHook: i.ListReposWithLastError,
+		RecordFailureFunc: &GitserverRepoPushMirrorStoreRecordFailureFunc{
+			defaultHook: i.RecordFailure,
 		},
-		LogCorruptionFunc: &GitserverRepoStoreLogCorruptionFunc{
-			defaultHook: i.LogCorruption,
+		RecordSuccessFunc: &GitserverRepoPushMirrorStoreRecordSuccessFunc{
+			defaultHook: i.RecordSuccess,
 		},
-		SetCloneStatusFunc: &GitserverRepoStoreSetCloneStatusFunc{
-			defaultHook: i.SetCloneStatus,
-		},
-		SetCloningProgressFunc: &GitserverRepoStoreSetCloningProgressFunc{
-			defaultHook: i.SetCloningProgress,
-		},
-		SetLastErrorFunc: &GitserverRepoStoreSetLastErrorFunc{
-			defaultHook: i.SetLastError,
-		},
-		SetLastFetchedFunc: &GitserverRepoStoreSetLastFetchedFunc{
-			defaultHook: i.SetLastFetched,
-		},
-		SetLastOutputFunc: &GitserverRepoStoreSetLastOutputFunc{
-			defaultHook: i.SetLastOutput,
-		},
-		SetRepoSizeFunc: &GitserverRepoStoreSetRepoSizeFunc{
-			defaultHook: i.SetRepoSize,
-		},
-		TotalErroredCloudDefaultReposFunc: &GitserverRepoStoreTotalErroredCloudDefaultReposFunc{
-			defaultHook: i.TotalErroredCloudDefaultRepos,
-		},
-		UpdateFunc: &GitserverRepoStoreUpdateFunc{
-			defaultHook: i.Update,
-		},
-		UpdateRepoSizesFunc: &GitserverRepoStoreUpdateRepoSizesFunc{
-			defaultHook: i.UpdateRepoSizes,
-		},
-		WithFunc: &GitserverRepoStoreWithFunc{
-			defaultHook: i.With,
+		WithFunc: &GitserverRepoPushMirrorStoreWithFunc{
+			defaultHook: i.With,
 		},
 	}
 }
 
-// GitserverRepoStoreGetByIDFunc describes the behavior when the GetByID
-// method of the parent MockGitserverRepoStore instance is invoked.
-type GitserverRepoStoreGetByIDFunc struct {
-	defaultHook func(context.Context, api.RepoID) (*types.GitserverRepo, error)
-	hooks       []func(context.Context, api.RepoID) (*types.GitserverRepo, error)
-	history     []GitserverRepoStoreGetByIDFuncCall
+// GitserverRepoPushMirrorStoreHandleFunc describes the behavior when the
+// Handle method of the parent MockGitserverRepoPushMirrorStore instance is
+// invoked.
+type GitserverRepoPushMirrorStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []GitserverRepoPushMirrorStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByID delegates to the next hook function in the queue and stores the
+// Handle delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockGitserverRepoStore) GetByID(v0 context.Context, v1 api.RepoID) (*types.GitserverRepo, error) {
-	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
-	m.GetByIDFunc.appendCall(GitserverRepoStoreGetByIDFuncCall{v0, v1, r0, r1})
-	return r0, r1
+func (m *MockGitserverRepoPushMirrorStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(GitserverRepoPushMirrorStoreHandleFuncCall{r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the GetByID method of
-// the parent MockGitserverRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *GitserverRepoStoreGetByIDFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*types.GitserverRepo, error)) {
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockGitserverRepoPushMirrorStore instance is invoked and the hook
+// queue is empty.
+func (f *GitserverRepoPushMirrorStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByID method of the parent MockGitserverRepoStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *GitserverRepoStoreGetByIDFunc) PushHook(hook func(context.Context, api.RepoID) (*types.GitserverRepo, error)) {
+// Handle method of the parent MockGitserverRepoPushMirrorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverRepoPushMirrorStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -37462,20 +39016,20 @@ func (f *GitserverRepoStoreGetByIDFunc) PushHook(hook func(context.Context, api.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverRepoStoreGetByIDFunc) SetDefaultReturn(r0 *types.GitserverRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID) (*types.GitserverRepo, error) {
-		return r0, r1
+func (f *GitserverRepoPushMirrorStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverRepoStoreGetByIDFunc) PushReturn(r0 *types.GitserverRepo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoID) (*types.GitserverRepo, error) {
-		return r0, r1
+func (f *GitserverRepoPushMirrorStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
 	})
 }
 
-func (f *GitserverRepoStoreGetByIDFunc) nextHook() func(context.Context, api.RepoID) (*types.GitserverRepo, error) {
+func (f *GitserverRepoPushMirrorStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -37488,81 +39042,75 @@ func (f *GitserverRepoStoreGetByIDFunc) nextHook() func(context.Context, api.Rep
 	return hook
 }
 
-func (f *GitserverRepoStoreGetByIDFunc) appendCall(r0 GitserverRepoStoreGetByIDFuncCall) {
+func (f *GitserverRepoPushMirrorStoreHandleFunc) appendCall(r0 GitserverRepoPushMirrorStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitserverRepoStoreGetByIDFuncCall objects
-// describing the invocations of this function.
-func (f *GitserverRepoStoreGetByIDFunc) History() []GitserverRepoStoreGetByIDFuncCall {
+// History returns a sequence of GitserverRepoPushMirrorStoreHandleFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverRepoPushMirrorStoreHandleFunc) History() []GitserverRepoPushMirrorStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverRepoStoreGetByIDFuncCall, len(f.history))
+	history := make([]GitserverRepoPushMirrorStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverRepoStoreGetByIDFuncCall is an object that describes an
-// invocation of method GetByID on an instance of MockGitserverRepoStore.
-type GitserverRepoStoreGetByIDFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoID
+// GitserverRepoPushMirrorStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of
+// MockGitserverRepoPushMirrorStore.
+type GitserverRepoPushMirrorStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.GitserverRepo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitserverRepoStoreGetByIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c GitserverRepoPushMirrorStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverRepoStoreGetByIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c GitserverRepoPushMirrorStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// GitserverRepoStoreGetByNameFunc describes the behavior when the GetByName
-// method of the parent MockGitserverRepoStore instance is invoked.
-type GitserverRepoStoreGetByNameFunc struct {
-	defaultHook func(context.Context, api.RepoName) (*types.GitserverRepo, error)
-	hooks       []func(context.Context, api.RepoName) (*types.GitserverRepo, error)
-	history     []GitserverRepoStoreGetByNameFuncCall
+// GitserverRepoPushMirrorStoreListByRepoFunc describes the behavior when
+// the ListByRepo method of the parent MockGitserverRepoPushMirrorStore
+// instance is invoked.
+type GitserverRepoPushMirrorStoreListByRepoFunc struct {
+	defaultHook func(context.Context, api.RepoID) ([]*types.GitserverRepoPushMirror, error)
+	hooks       []func(context.Context, api.RepoID) ([]*types.GitserverRepoPushMirror, error)
+	history     []GitserverRepoPushMirrorStoreListByRepoFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByName delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockGitserverRepoStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.GitserverRepo, error) {
-	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
-	m.GetByNameFunc.appendCall(GitserverRepoStoreGetByNameFuncCall{v0, v1, r0, r1})
+// ListByRepo delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverRepoPushMirrorStore) ListByRepo(v0 context.Context, v1 api.RepoID) ([]*types.GitserverRepoPushMirror, error) {
+	r0, r1 := m.ListByRepoFunc.nextHook()(v0, v1)
+	m.ListByRepoFunc.appendCall(GitserverRepoPushMirrorStoreListByRepoFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByName method of
-// the parent MockGitserverRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *GitserverRepoStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types.GitserverRepo, error)) {
+// SetDefaultHook sets function that is called when the ListByRepo method of
+// the parent MockGitserverRepoPushMirrorStore instance is invoked and the
+// hook queue is empty.
+func (f *GitserverRepoPushMirrorStoreListByRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoID) ([]*types.GitserverRepoPushMirror, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByName method of the parent MockGitserverRepoStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *GitserverRepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types.GitserverRepo, error)) {
+// ListByRepo method of the parent MockGitserverRepoPushMirrorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverRepoPushMirrorStoreListByRepoFunc) PushHook(hook func(context.Context, api.RepoID) ([]*types.GitserverRepoPushMirror, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -37570,20 +39118,20 @@ func (f *GitserverRepoStoreGetByNameFunc) PushHook(hook func(context.Context, ap
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverRepoStoreGetByNameFunc) SetDefaultReturn(r0 *types.GitserverRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (*types.GitserverRepo, error) {
+func (f *GitserverRepoPushMirrorStoreListByRepoFunc) SetDefaultReturn(r0 []*types.GitserverRepoPushMirror, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) ([]*types.GitserverRepoPushMirror, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverRepoStoreGetByNameFunc) PushReturn(r0 *types.GitserverRepo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName) (*types.GitserverRepo, error) {
+func (f *GitserverRepoPushMirrorStoreListByRepoFunc) PushReturn(r0 []*types.GitserverRepoPushMirror, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) ([]*types.GitserverRepoPushMirror, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverRepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types.GitserverRepo, error) {
+func (f *GitserverRepoPushMirrorStoreListByRepoFunc) nextHook() func(context.Context, api.RepoID) ([]*types.GitserverRepoPushMirror, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -37596,35 +39144,37 @@ func (f *GitserverRepoStoreGetByNameFunc) nextHook() func(context.Context, api.R
 	return hook
 }
 
-func (f *GitserverRepoStoreGetByNameFunc) appendCall(r0 GitserverRepoStoreGetByNameFuncCall) {
+func (f *GitserverRepoPushMirrorStoreListByRepoFunc) appendCall(r0 GitserverRepoPushMirrorStoreListByRepoFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitserverRepoStoreGetByNameFuncCall objects
-// describing the invocations of this function.
-func (f *GitserverRepoStoreGetByNameFunc) History() []GitserverRepoStoreGetByNameFuncCall {
+// History returns a sequence of
+// GitserverRepoPushMirrorStoreListByRepoFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverRepoPushMirrorStoreListByRepoFunc) History() []GitserverRepoPushMirrorStoreListByRepoFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverRepoStoreGetByNameFuncCall, len(f.history))
+	history := make([]GitserverRepoPushMirrorStoreListByRepoFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverRepoStoreGetByNameFuncCall is an object that describes an
-// invocation of method GetByName on an instance of MockGitserverRepoStore.
-type GitserverRepoStoreGetByNameFuncCall struct {
+// GitserverRepoPushMirrorStoreListByRepoFuncCall is an object that
+// describes an invocation of method ListByRepo on an instance of
+// MockGitserverRepoPushMirrorStore.
+type GitserverRepoPushMirrorStoreListByRepoFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoName
+	Arg1 api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.GitserverRepo
+	Result0 []*types.GitserverRepoPushMirror
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -37632,46 +39182,47 @@ type GitserverRepoStoreGetByNameFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitserverRepoStoreGetByNameFuncCall) Args() []interface{} {
+func (c GitserverRepoPushMirrorStoreListByRepoFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverRepoStoreGetByNameFuncCall) Results() []interface{} {
+func (c GitserverRepoPushMirrorStoreListByRepoFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitserverRepoStoreGetByNamesFunc describes the behavior when the
-// GetByNames method of the parent MockGitserverRepoStore instance is
+// GitserverRepoPushMirrorStoreListDueFunc describes the behavior when the
+// ListDue method of the parent MockGitserverRepoPushMirrorStore instance is
 // invoked.
-type GitserverRepoStoreGetByNamesFunc struct {
-	defaultHook func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error)
-	hooks       []func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error)
-	history     []GitserverRepoStoreGetByNamesFuncCall
+type GitserverRepoPushMirrorStoreListDueFunc struct {
+	defaultHook func(context.Context, string, int) ([]*types.GitserverRepoPushMirror, error)
+	hooks       []func(context.Context, string, int) ([]*types.GitserverRepoPushMirror, error)
+	history     []GitserverRepoPushMirrorStoreListDueFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByNames delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockGitserverRepoStore) GetByNames(v0 context.Context, v1 ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error) {
-	r0, r1 := m.GetByNamesFunc.nextHook()(v0, v1...)
-	m.GetByNamesFunc.appendCall(GitserverRepoStoreGetByNamesFuncCall{v0, v1, r0, r1})
+// ListDue delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverRepoPushMirrorStore) ListDue(v0 context.Context, v1 string, v2 int) ([]*types.GitserverRepoPushMirror, error) {
+	r0, r1 := m.ListDueFunc.nextHook()(v0, v1, v2)
+	m.ListDueFunc.appendCall(GitserverRepoPushMirrorStoreListDueFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByNames method of
-// the parent MockGitserverRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *GitserverRepoStoreGetByNamesFunc) SetDefaultHook(hook func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error)) {
+// SetDefaultHook sets function that is called when the ListDue method of
+// the parent MockGitserverRepoPushMirrorStore instance is invoked and the
+// hook queue is empty.
+func (f *GitserverRepoPushMirrorStoreListDueFunc) SetDefaultHook(hook func(context.Context, string, int) ([]*types.GitserverRepoPushMirror, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByNames method of the parent MockGitserverRepoStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *GitserverRepoStoreGetByNamesFunc) PushHook(hook func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error)) {
+// ListDue method of the parent MockGitserverRepoPushMirrorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverRepoPushMirrorStoreListDueFunc) PushHook(hook func(context.Context, string, int) ([]*types.GitserverRepoPushMirror, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -37679,20 +39230,20 @@ func (f *GitserverRepoStoreGetByNamesFunc) PushHook(hook func(context.Context, .
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverRepoStoreGetByNamesFunc) SetDefaultReturn(r0 map[api.RepoName]*types.GitserverRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error) {
+func (f *GitserverRepoPushMirrorStoreListDueFunc) SetDefaultReturn(r0 []*types.GitserverRepoPushMirror, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, int) ([]*types.GitserverRepoPushMirror, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverRepoStoreGetByNamesFunc) PushReturn(r0 map[api.RepoName]*types.GitserverRepo, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error) {
+func (f *GitserverRepoPushMirrorStoreListDueFunc) PushReturn(r0 []*types.GitserverRepoPushMirror, r1 error) {
+	f.PushHook(func(context.Context, string, int) ([]*types.GitserverRepoPushMirror, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverRepoStoreGetByNamesFunc) nextHook() func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error) {
+func (f *GitserverRepoPushMirrorStoreListDueFunc) nextHook() func(context.Context, string, int) ([]*types.GitserverRepoPushMirror, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -37705,90 +39256,87 @@ func (f *GitserverRepoStoreGetByNamesFunc) nextHook() func(context.Context, ...a
 	return hook
 }
 
-func (f *GitserverRepoStoreGetByNamesFunc) appendCall(r0 GitserverRepoStoreGetByNamesFuncCall) {
+func (f *GitserverRepoPushMirrorStoreListDueFunc) appendCall(r0 GitserverRepoPushMirrorStoreListDueFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitserverRepoStoreGetByNamesFuncCall
+// History returns a sequence of GitserverRepoPushMirrorStoreListDueFuncCall
 // objects describing the invocations of this function.
-func (f *GitserverRepoStoreGetByNamesFunc) History() []GitserverRepoStoreGetByNamesFuncCall {
+func (f *GitserverRepoPushMirrorStoreListDueFunc) History() []GitserverRepoPushMirrorStoreListDueFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverRepoStoreGetByNamesFuncCall, len(f.history))
+	history := make([]GitserverRepoPushMirrorStoreListDueFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverRepoStoreGetByNamesFuncCall is an object that describes an
-// invocation of method GetByNames on an instance of MockGitserverRepoStore.
-type GitserverRepoStoreGetByNamesFuncCall struct {
+// GitserverRepoPushMirrorStoreListDueFuncCall is an object that describes
+// an invocation of method ListDue on an instance of
+// MockGitserverRepoPushMirrorStore.
+type GitserverRepoPushMirrorStoreListDueFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoName
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 map[api.RepoName]*types.GitserverRepo
+	Result0 []*types.GitserverRepoPushMirror
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c GitserverRepoStoreGetByNamesFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c GitserverRepoPushMirrorStoreListDueFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverRepoStoreGetByNamesFuncCall) Results() []interface{} {
+func (c GitserverRepoPushMirrorStoreListDueFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitserverRepoStoreGetGitserverGitDirSizeFunc describes the behavior when
-// the GetGitserverGitDirSize method of the parent MockGitserverRepoStore
+// GitserverRepoPushMirrorStoreRecordFailureFunc describes the behavior when
+// the RecordFailure method of the parent MockGitserverRepoPushMirrorStore
 // instance is invoked.
-type GitserverRepoStoreGetGitserverGitDirSizeFunc struct {
-	defaultHook func(context.Context) (int64, error)
-	hooks       []func(context.Context) (int64, error)
-	history     []GitserverRepoStoreGetGitserverGitDirSizeFuncCall
+type GitserverRepoPushMirrorStoreRecordFailureFunc struct {
+	defaultHook func(context.Context, api.RepoID, string, string, time.Duration, time.Duration) error
+	hooks       []func(context.Context, api.RepoID, string, string, time.Duration, time.Duration) error
+	history     []GitserverRepoPushMirrorStoreRecordFailureFuncCall
 	mutex       sync.Mutex
 }
 
-// GetGitserverGitDirSize delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockGitserverRepoStore) GetGitserverGitDirSize(v0 context.Context) (int64, error) {
-	r0, r1 := m.GetGitserverGitDirSizeFunc.nextHook()(v0)
-	m.GetGitserverGitDirSizeFunc.appendCall(GitserverRepoStoreGetGitserverGitDirSizeFuncCall{v0, r0, r1})
-	return r0, r1
+// RecordFailure delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverRepoPushMirrorStore) RecordFailure(v0 context.Context, v1 api.RepoID, v2 string, v3 string, v4 time.Duration, v5 time.Duration) error {
+	r0 := m.RecordFailureFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.RecordFailureFunc.appendCall(GitserverRepoPushMirrorStoreRecordFailureFuncCall{v0, v1, v2, v3, v4, v5, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// GetGitserverGitDirSize method of the parent MockGitserverRepoStore
-// instance is invoked and the hook queue is empty.
-func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) SetDefaultHook(hook func(context.Context) (int64, error)) {
+// SetDefaultHook sets function that is called when the RecordFailure method
+// of the parent MockGitserverRepoPushMirrorStore instance is invoked and
+// the hook queue is empty.
+func (f *GitserverRepoPushMirrorStoreRecordFailureFunc) SetDefaultHook(hook func(context.Context, api.RepoID, string, string, time.Duration, time.Duration) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetGitserverGitDirSize method of the parent MockGitserverRepoStore
+// RecordFailure method of the parent MockGitserverRepoPushMirrorStore
 // instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
-func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) PushHook(hook func(context.Context) (int64, error)) {
+func (f *GitserverRepoPushMirrorStoreRecordFailureFunc) PushHook(hook func(context.Context, api.RepoID, string, string, time.Duration, time.Duration) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -37796,20 +39344,20 @@ func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) PushHook(hook func(contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) SetDefaultReturn(r0 int64, r1 error) {
-	f.SetDefaultHook(func(context.Context) (int64, error) {
-		return r0, r1
+func (f *GitserverRepoPushMirrorStoreRecordFailureFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, string, string, time.Duration, time.Duration) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) PushReturn(r0 int64, r1 error) {
-	f.PushHook(func(context.Context) (int64, error) {
-		return r0, r1
+func (f *GitserverRepoPushMirrorStoreRecordFailureFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID, string, string, time.Duration, time.Duration) error {
+		return r0
 	})
 }
 
-func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) nextHook() func(context.Context) (int64, error) {
+func (f *GitserverRepoPushMirrorStoreRecordFailureFunc) nextHook() func(context.Context, api.RepoID, string, string, time.Duration, time.Duration) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -37822,194 +39370,94 @@ func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) nextHook() func(context.C
 	return hook
 }
 
-func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) appendCall(r0 GitserverRepoStoreGetGitserverGitDirSizeFuncCall) {
+func (f *GitserverRepoPushMirrorStoreRecordFailureFunc) appendCall(r0 GitserverRepoPushMirrorStoreRecordFailureFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// GitserverRepoStoreGetGitserverGitDirSizeFuncCall objects describing the
+// GitserverRepoPushMirrorStoreRecordFailureFuncCall objects describing the
 // invocations of this function.
-func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) History() []GitserverRepoStoreGetGitserverGitDirSizeFuncCall {
+func (f *GitserverRepoPushMirrorStoreRecordFailureFunc) History() []GitserverRepoPushMirrorStoreRecordFailureFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverRepoStoreGetGitserverGitDirSizeFuncCall, len(f.history))
+	history := make([]GitserverRepoPushMirrorStoreRecordFailureFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverRepoStoreGetGitserverGitDirSizeFuncCall is an object that
-// describes an invocation of method GetGitserverGitDirSize on an instance
-// of MockGitserverRepoStore.
-type GitserverRepoStoreGetGitserverGitDirSizeFuncCall struct {
+// GitserverRepoPushMirrorStoreRecordFailureFuncCall is an object that
+// describes an invocation of method RecordFailure on an instance of
+// MockGitserverRepoPushMirrorStore.
+type GitserverRepoPushMirrorStoreRecordFailureFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 int64
-	// Result1 is the value of the 2nd result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result1 error
-}
-
-// Args returns an interface slice containing the arguments of this
-// invocation.
-func (c GitserverRepoStoreGetGitserverGitDirSizeFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
-}
-
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c GitserverRepoStoreGetGitserverGitDirSizeFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
-}
-
-// GitserverRepoStoreGetLastSyncOutputFunc describes the behavior when the
-// GetLastSyncOutput method of the parent MockGitserverRepoStore instance is
-// invoked.
-type GitserverRepoStoreGetLastSyncOutputFunc struct {
-	defaultHook func(context.Context, api.RepoName) (string, bool, error)
-	hooks       []func(context.Context, api.RepoName) (string, bool, error)
-	history     []GitserverRepoStoreGetLastSyncOutputFuncCall
-	mutex       sync.Mutex
-}
-
-// GetLastSyncOutput delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockGitserverRepoStore) GetLastSyncOutput(v0 context.Context, v1 api.RepoName) (string, bool, error) {
-	r0, r1, r2 := m.GetLastSyncOutputFunc.nextHook()(v0, v1)
-	m.GetLastSyncOutputFunc.appendCall(GitserverRepoStoreGetLastSyncOutputFuncCall{v0, v1, r0, r1, r2})
-	return r0, r1, r2
-}
-
-// SetDefaultHook sets function that is called when the GetLastSyncOutput
-// method of the parent MockGitserverRepoStore instance is invoked and the
-// hook queue is empty.
-func (f *GitserverRepoStoreGetLastSyncOutputFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (string, bool, error)) {
-	f.defaultHook = hook
-}
-
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// GetLastSyncOutput method of the parent MockGitserverRepoStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *GitserverRepoStoreGetLastSyncOutputFunc) PushHook(hook func(context.Context, api.RepoName) (string, bool, error)) {
-	f.mutex.Lock()
-	f.hooks = append(f.hooks, hook)
-	f.mutex.Unlock()
-}
-
-// SetDefaultReturn calls SetDefaultHook with a function that returns the
-// given values.
-func (f *GitserverRepoStoreGetLastSyncOutputFunc) SetDefaultReturn(r0 string, r1 bool, r2 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (string, bool, error) {
-		return r0, r1, r2
-	})
-}
-
-// PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverRepoStoreGetLastSyncOutputFunc) PushReturn(r0 string, r1 bool, r2 error) {
-	f.PushHook(func(context.Context, api.RepoName) (string, bool, error) {
-		return r0, r1, r2
-	})
-}
-
-func (f *GitserverRepoStoreGetLastSyncOutputFunc) nextHook() func(context.Context, api.RepoName) (string, bool, error) {
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
-
-	if len(f.hooks) == 0 {
-		return f.defaultHook
-	}
-
-	hook := f.hooks[0]
-	f.hooks = f.hooks[1:]
-	return hook
-}
-
-func (f *GitserverRepoStoreGetLastSyncOutputFunc) appendCall(r0 GitserverRepoStoreGetLastSyncOutputFuncCall) {
-	f.mutex.Lock()
-	f.history = append(f.history, r0)
-	f.mutex.Unlock()
-}
-
-// History returns a sequence of GitserverRepoStoreGetLastSyncOutputFuncCall
-// objects describing the invocations of this function.
-func (f *GitserverRepoStoreGetLastSyncOutputFunc) History() []GitserverRepoStoreGetLastSyncOutputFuncCall {
-	f.mutex.Lock()
-	history := make([]GitserverRepoStoreGetLastSyncOutputFuncCall, len(f.history))
-	copy(history, f.history)
-	f.mutex.Unlock()
-
-	return history
-}
-
-// GitserverRepoStoreGetLastSyncOutputFuncCall is an object that describes
-// an invocation of method GetLastSyncOutput on an instance of
-// MockGitserverRepoStore.
-type GitserverRepoStoreGetLastSyncOutputFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg1 api.RepoName
-	// Result0 is the value of the 1st result returned from this method
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
-	Result0 string
-	// Result1 is the value of the 2nd result returned from this method
+	Arg4 time.Duration
+	// Arg5 is the value of the 6th argument passed to this method
 	// invocation.
-	Result1 bool
-	// Result2 is the value of the 3rd result returned from this method
+	Arg5 time.Duration
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result2 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitserverRepoStoreGetLastSyncOutputFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c GitserverRepoPushMirrorStoreRecordFailureFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverRepoStoreGetLastSyncOutputFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c GitserverRepoPushMirrorStoreRecordFailureFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// GitserverRepoStoreHandleFunc describes the behavior when the Handle
-// method of the parent MockGitserverRepoStore instance is invoked.
-type GitserverRepoStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []GitserverRepoStoreHandleFuncCall
+// GitserverRepoPushMirrorStoreRecordSuccessFunc describes the behavior when
+// the RecordSuccess method of the parent MockGitserverRepoPushMirrorStore
+// instance is invoked.
+type GitserverRepoPushMirrorStoreRecordSuccessFunc struct {
+	defaultHook func(context.Context, api.RepoID, string, time.Time) error
+	hooks       []func(context.Context, api.RepoID, string, time.Time) error
+	history     []GitserverRepoPushMirrorStoreRecordSuccessFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockGitserverRepoStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(GitserverRepoStoreHandleFuncCall{r0})
+// RecordSuccess delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverRepoPushMirrorStore) RecordSuccess(v0 context.Context, v1 api.RepoID, v2 string, v3 time.Time) error {
+	r0 := m.RecordSuccessFunc.nextHook()(v0, v1, v2, v3)
+	m.RecordSuccessFunc.appendCall(GitserverRepoPushMirrorStoreRecordSuccessFuncCall{v0, v1, v2, v3, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockGitserverRepoStore instance is invoked and the hook queue is
-// empty.
-func (f *GitserverRepoStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the RecordSuccess method
+// of the parent MockGitserverRepoPushMirrorStore instance is invoked and
+// the hook queue is empty.
+func (f *GitserverRepoPushMirrorStoreRecordSuccessFunc) SetDefaultHook(hook func(context.Context, api.RepoID, string, time.Time) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockGitserverRepoStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *GitserverRepoStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+// RecordSuccess method of the parent MockGitserverRepoPushMirrorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverRepoPushMirrorStoreRecordSuccessFunc) PushHook(hook func(context.Context, api.RepoID, string, time.Time) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -38017,20 +39465,20 @@ func (f *GitserverRepoStoreHandleFunc) PushHook(hook func() basestore.Transactab
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverRepoStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
+func (f *GitserverRepoPushMirrorStoreRecordSuccessFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, string, time.Time) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverRepoStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
+func (f *GitserverRepoPushMirrorStoreRecordSuccessFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID, string, time.Time) error {
 		return r0
 	})
 }
 
-func (f *GitserverRepoStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *GitserverRepoPushMirrorStoreRecordSuccessFunc) nextHook() func(context.Context, api.RepoID, string, time.Time) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -38043,74 +39491,88 @@ func (f *GitserverRepoStoreHandleFunc) nextHook() func() basestore.TransactableH
 	return hook
 }
 
-func (f *GitserverRepoStoreHandleFunc) appendCall(r0 GitserverRepoStoreHandleFuncCall) {
+func (f *GitserverRepoPushMirrorStoreRecordSuccessFunc) appendCall(r0 GitserverRepoPushMirrorStoreRecordSuccessFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitserverRepoStoreHandleFuncCall objects
-// describing the invocations of this function.
-func (f *GitserverRepoStoreHandleFunc) History() []GitserverRepoStoreHandleFuncCall {
+// History returns a sequence of
+// GitserverRepoPushMirrorStoreRecordSuccessFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverRepoPushMirrorStoreRecordSuccessFunc) History() []GitserverRepoPushMirrorStoreRecordSuccessFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverRepoStoreHandleFuncCall, len(f.history))
+	history := make([]GitserverRepoPushMirrorStoreRecordSuccessFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverRepoStoreHandleFuncCall is an object that describes an
-// invocation of method Handle on an instance of MockGitserverRepoStore.
-type GitserverRepoStoreHandleFuncCall struct {
+// GitserverRepoPushMirrorStoreRecordSuccessFuncCall is an object that
+// describes an invocation of method RecordSuccess on an instance of
+// MockGitserverRepoPushMirrorStore.
+type GitserverRepoPushMirrorStoreRecordSuccessFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitserverRepoStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c GitserverRepoPushMirrorStoreRecordSuccessFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverRepoStoreHandleFuncCall) Results() []interface{} {
+func (c GitserverRepoPushMirrorStoreRecordSuccessFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// GitserverRepoStoreIteratePurgeableReposFunc describes the behavior when
-// the IteratePurgeableRepos method of the parent MockGitserverRepoStore
-// instance is invoked.
-type GitserverRepoStoreIteratePurgeableReposFunc struct {
-	defaultHook func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error
-	hooks       []func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error
-	history     []GitserverRepoStoreIteratePurgeableReposFuncCall
+// GitserverRepoPushMirrorStoreWithFunc describes the behavior when the With
+// method of the parent MockGitserverRepoPushMirrorStore instance is
+// invoked.
+type GitserverRepoPushMirrorStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) database.GitserverRepoPushMirrorStore
+	hooks       []func(basestore.ShareableStore) database.GitserverRepoPushMirrorStore
+	history     []GitserverRepoPushMirrorStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
-// IteratePurgeableRepos delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockGitserverRepoStore) IteratePurgeableRepos(v0 context.Context, v1 database.IteratePurgableReposOptions, v2 func(repo api.RepoName) error) error {
-	r0 := m.IteratePurgeableReposFunc.nextHook()(v0, v1, v2)
-	m.IteratePurgeableReposFunc.appendCall(GitserverRepoStoreIteratePurgeableReposFuncCall{v0, v1, v2, r0})
+// With delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverRepoPushMirrorStore) With(v0 basestore.ShareableStore) database.GitserverRepoPushMirrorStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(GitserverRepoPushMirrorStoreWithFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// IteratePurgeableRepos method of the parent MockGitserverRepoStore
-// instance is invoked and the hook queue is empty.
-func (f *GitserverRepoStoreIteratePurgeableReposFunc) SetDefaultHook(hook func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error) {
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockGitserverRepoPushMirrorStore instance is invoked and the hook
+// queue is empty.
+func (f *GitserverRepoPushMirrorStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) database.GitserverRepoPushMirrorStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IteratePurgeableRepos method of the parent MockGitserverRepoStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *GitserverRepoStoreIteratePurgeableReposFunc) PushHook(hook func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error) {
+// With method of the parent MockGitserverRepoPushMirrorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverRepoPushMirrorStoreWithFunc) PushHook(hook func(basestore.ShareableStore) database.GitserverRepoPushMirrorStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -38118,20 +39580,20 @@ func (f *GitserverRepoStoreIteratePurgeableReposFunc) PushHook(hook func(context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverRepoStoreIteratePurgeableReposFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error {
+func (f *GitserverRepoPushMirrorStoreWithFunc) SetDefaultReturn(r0 database.GitserverRepoPushMirrorStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) database.GitserverRepoPushMirrorStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverRepoStoreIteratePurgeableReposFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error {
+func (f *GitserverRepoPushMirrorStoreWithFunc) PushReturn(r0 database.GitserverRepoPushMirrorStore) {
+	f.PushHook(func(basestore.ShareableStore) database.GitserverRepoPushMirrorStore {
 		return r0
 	})
 }
 
-func (f *GitserverRepoStoreIteratePurgeableReposFunc) nextHook() func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error {
+func (f *GitserverRepoPushMirrorStoreWithFunc) nextHook() func(basestore.ShareableStore) database.GitserverRepoPushMirrorStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -38144,82 +39606,1194 @@ func (f *GitserverRepoStoreIteratePurgeableReposFunc) nextHook() func(context.Co
 	return hook
 }
 
-func (f *GitserverRepoStoreIteratePurgeableReposFunc) appendCall(r0 GitserverRepoStoreIteratePurgeableReposFuncCall) {
+func (f *GitserverRepoPushMirrorStoreWithFunc) appendCall(r0 GitserverRepoPushMirrorStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// GitserverRepoStoreIteratePurgeableReposFuncCall objects describing the
-// invocations of this function.
-func (f *GitserverRepoStoreIteratePurgeableReposFunc) History() []GitserverRepoStoreIteratePurgeableReposFuncCall {
+// History returns a sequence of GitserverRepoPushMirrorStoreWithFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverRepoPushMirrorStoreWithFunc) History() []GitserverRepoPushMirrorStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverRepoStoreIteratePurgeableReposFuncCall, len(f.history))
+	history := make([]GitserverRepoPushMirrorStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverRepoStoreIteratePurgeableReposFuncCall is an object that
-// describes an invocation of method IteratePurgeableRepos on an instance of
-// MockGitserverRepoStore.
-type GitserverRepoStoreIteratePurgeableReposFuncCall struct {
+// GitserverRepoPushMirrorStoreWithFuncCall is an object that describes an
+// invocation of method With on an instance of
+// MockGitserverRepoPushMirrorStore.
+type GitserverRepoPushMirrorStoreWithFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 database.IteratePurgableReposOptions
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 func(repo api.RepoName) error
+	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 database.GitserverRepoPushMirrorStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitserverRepoStoreIteratePurgeableReposFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c GitserverRepoPushMirrorStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverRepoStoreIteratePurgeableReposFuncCall) Results() []interface{} {
+func (c GitserverRepoPushMirrorStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// GitserverRepoStoreIterateRepoGitserverStatusFunc describes the behavior
-// when the IterateRepoGitserverStatus method of the parent
-// MockGitserverRepoStore instance is invoked.
-type GitserverRepoStoreIterateRepoGitserverStatusFunc struct {
-	defaultHook func(context.Context, database.IterateRepoGitserverStatusOptions) ([]types.RepoGitserverStatus, int, error)
-	hooks       []func(context.Context, database.IterateRepoGitserverStatusOptions) ([]types.RepoGitserverStatus, int, error)
-	history     []GitserverRepoStoreIterateRepoGitserverStatusFuncCall
-	mutex       sync.Mutex
-}
-
-// IterateRepoGitserverStatus delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockGitserverRepoStore) IterateRepoGitserverStatus(v0 context.Context, v1 database.IterateRepoGitserverStatusOptions) ([]types.RepoGitserverStatus, int, error) {
-	r0, r1, r2 := m.IterateRepoGitserverStatusFunc.nextHook()(v0, v1)
-	m.IterateRepoGitserverStatusFunc.appendCall(GitserverRepoStoreIterateRepoGitserverStatusFuncCall{v0, v1, r0, r1, r2})
-	return r0, r1, r2
-}
-
-// SetDefaultHook sets function that is called when the
-// IterateRepoGitserverStatus method of the parent MockGitserverRepoStore
-// instance is invoked and the hook queue is empty.
-func (f *GitserverRepoStoreIterateRepoGitserverStatusFunc) SetDefaultHook(hook func(context.Context, database.IterateRepoGitserverStatusOptions) ([]types.RepoGitserverStatus, int, error)) {
-	f.defaultHook = hook
-}
-
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// IterateRepoGitserverStatus method of the parent MockGitserverRepoStore
-// instance invokes the hook at the front of the queue and discards it.
+// MockGitserverRepoStore is a mock implementation of the GitserverRepoStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockGitserverRepoStore struct {
+	// GetByIDFunc is an instance of a mock function object controlling the
+	// behavior of the method GetByID.
+	GetByIDFunc *GitserverRepoStoreGetByIDFunc
+	// GetByNameFunc is an instance of a mock function object controlling
+	// the behavior of the method GetByName.
+	GetByNameFunc *GitserverRepoStoreGetByNameFunc
+	// GetByNamesFunc is an instance of a mock function object controlling
+	// the behavior of the method GetByNames.
+	GetByNamesFunc *GitserverRepoStoreGetByNamesFunc
+	// GetGitserverGitDirSizeFunc is an instance of a mock function object
+	// controlling the behavior of the method GetGitserverGitDirSize.
+	GetGitserverGitDirSizeFunc *GitserverRepoStoreGetGitserverGitDirSizeFunc
+	// GetLastSyncOutputFunc is an instance of a mock function object
+	// controlling the behavior of the method GetLastSyncOutput.
+	GetLastSyncOutputFunc *GitserverRepoStoreGetLastSyncOutputFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *GitserverRepoStoreHandleFunc
+	// IteratePurgeableReposFunc is an instance of a mock function object
+	// controlling the behavior of the method IteratePurgeableRepos.
+	IteratePurgeableReposFunc *GitserverRepoStoreIteratePurgeableReposFunc
+	// IterateRepoGitserverStatusFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// IterateRepoGitserverStatus.
+	IterateRepoGitserverStatusFunc *GitserverRepoStoreIterateRepoGitserverStatusFunc
+	// ListReposWithLastErrorFunc is an instance of a mock function object
+	// controlling the behavior of the method ListReposWithLastError.
+	ListReposWithLastErrorFunc *GitserverRepoStoreListReposWithLastErrorFunc
+	// LogCorruptionFunc is an instance of a mock function object
+	// controlling the behavior of the method LogCorruption.
+	LogCorruptionFunc *GitserverRepoStoreLogCorruptionFunc
+	// SetCloneStatusFunc is an instance of a mock function object
+	// controlling the behavior of the method SetCloneStatus.
+	SetCloneStatusFunc *GitserverRepoStoreSetCloneStatusFunc
+	// SetCloningProgressFunc is an instance of a mock function object
+	// controlling the behavior of the method SetCloningProgress.
+	SetCloningProgressFunc *GitserverRepoStoreSetCloningProgressFunc
+	// SetLastErrorFunc is an instance of a mock function object controlling
+	// the behavior of the method SetLastError.
+	SetLastErrorFunc *GitserverRepoStoreSetLastErrorFunc
+	// SetLastFetchedFunc is an instance of a mock function object
+	// controlling the behavior of the method SetLastFetched.
+	SetLastFetchedFunc *GitserverRepoStoreSetLastFetchedFunc
+	// SetLastOutputFunc is an instance of a mock function object
+	// controlling the behavior of the method SetLastOutput.
+	SetLastOutputFunc *GitserverRepoStoreSetLastOutputFunc
+	// SetRepoSizeFunc is an instance of a mock function object controlling
+	// the behavior of the method SetRepoSize.
+	SetRepoSizeFunc *GitserverRepoStoreSetRepoSizeFunc
+	// TotalErroredCloudDefaultReposFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// TotalErroredCloudDefaultRepos.
+	TotalErroredCloudDefaultReposFunc *GitserverRepoStoreTotalErroredCloudDefaultReposFunc
+	// UpdateFunc is an instance of a mock function object controlling the
+	// behavior of the method Update.
+	UpdateFunc *GitserverRepoStoreUpdateFunc
+	// UpdateRepoSizesFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateRepoSizes.
+	UpdateRepoSizesFunc *GitserverRepoStoreUpdateRepoSizesFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *GitserverRepoStoreWithFunc
+}
+
+// NewMockGitserverRepoStore creates a new mock of the GitserverRepoStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockGitserverRepoStore() *MockGitserverRepoStore {
+	return &MockGitserverRepoStore{
+		GetByIDFunc: &GitserverRepoStoreGetByIDFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 *types.GitserverRepo, r1 error) {
+				return
+			},
+		},
+		GetByNameFunc: &GitserverRepoStoreGetByNameFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 *types.GitserverRepo, r1 error) {
+				return
+			},
+		},
+		GetByNamesFunc: &GitserverRepoStoreGetByNamesFunc{
+			defaultHook: func(context.Context, ...api.RepoName) (r0 map[api.RepoName]*types.GitserverRepo, r1 error) {
+				return
+			},
+		},
+		GetGitserverGitDirSizeFunc: &GitserverRepoStoreGetGitserverGitDirSizeFunc{
+			defaultHook: func(context.Context) (r0 int64, r1 error) {
+				return
+			},
+		},
+		GetLastSyncOutputFunc: &GitserverRepoStoreGetLastSyncOutputFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 string, r1 bool, r2 error) {
+				return
+			},
+		},
+		HandleFunc: &GitserverRepoStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		IteratePurgeableReposFunc: &GitserverRepoStoreIteratePurgeableReposFunc{
+			defaultHook: func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) (r0 error) {
+				return
+			},
+		},
+		IterateRepoGitserverStatusFunc: &GitserverRepoStoreIterateRepoGitserverStatusFunc{
+			defaultHook: func(context.Context, database.IterateRepoGitserverStatusOptions) (r0 []types.RepoGitserverStatus, r1 int, r2 error) {
+				return
+			},
+		},
+		ListReposWithLastErrorFunc: &GitserverRepoStoreListReposWithLastErrorFunc{
+			defaultHook: func(context.Context) (r0 []api.RepoName, r1 error) {
+				return
+			},
+		},
+		LogCorruptionFunc: &GitserverRepoStoreLogCorruptionFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) (r0 error) {
+				return
+			},
+		},
+		SetCloneStatusFunc: &GitserverRepoStoreSetCloneStatusFunc{
+			defaultHook: func(context.Context, api.RepoName, types.CloneStatus, string) (r0 error) {
+				return
+			},
+		},
+		SetCloningProgressFunc: &GitserverRepoStoreSetCloningProgressFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (r0 error) {
+				return
+			},
+		},
+		SetLastErrorFunc: &GitserverRepoStoreSetLastErrorFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) (r0 error) {
+				return
+			},
+		},
+		SetLastFetchedFunc: &GitserverRepoStoreSetLastFetchedFunc{
+			defaultHook: func(context.Context, api.RepoName, database.GitserverFetchData) (r0 error) {
+				return
+			},
+		},
+		SetLastOutputFunc: &GitserverRepoStoreSetLastOutputFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (r0 error) {
+				return
+			},
+		},
+		SetRepoSizeFunc: &GitserverRepoStoreSetRepoSizeFunc{
+			defaultHook: func(context.Context, api.RepoName, int64, string) (r0 error) {
+				return
+			},
+		},
+		TotalErroredCloudDefaultReposFunc: &GitserverRepoStoreTotalErroredCloudDefaultReposFunc{
+			defaultHook: func(context.Context) (r0 int, r1 error) {
+				return
+			},
+		},
+		UpdateFunc: &GitserverRepoStoreUpdateFunc{
+			defaultHook: func(context.Context, ...*types.GitserverRepo) (r0 error) {
+				return
+			},
+		},
+		UpdateRepoSizesFunc: &GitserverRepoStoreUpdateRepoSizesFunc{
+			defaultHook: func(context.Context, string, map[api.RepoName]int64) (r0 int, r1 error) {
+				return
+			},
+		},
+		WithFunc: &GitserverRepoStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 database.GitserverRepoStore) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockGitserverRepoStore creates a new mock of the
+// GitserverRepoStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockGitserverRepoStore() *MockGitserverRepoStore {
+	return &MockGitserverRepoStore{
+		GetByIDFunc: &GitserverRepoStoreGetByIDFunc{
+			defaultHook: func(context.Context, api.RepoID) (*types.GitserverRepo, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.GetByID")
+			},
+		},
+		GetByNameFunc: &GitserverRepoStoreGetByNameFunc{
+			defaultHook: func(context.Context, api.RepoName) (*types.GitserverRepo, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.GetByName")
+			},
+		},
+		GetByNamesFunc: &GitserverRepoStoreGetByNamesFunc{
+			defaultHook: func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.GetByNames")
+			},
+		},
+		GetGitserverGitDirSizeFunc: &GitserverRepoStoreGetGitserverGitDirSizeFunc{
+			defaultHook: func(context.Context) (int64, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.GetGitserverGitDirSize")
+			},
+		},
+		GetLastSyncOutputFunc: &GitserverRepoStoreGetLastSyncOutputFunc{
+			defaultHook: func(context.Context, api.RepoName) (string, bool, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.GetLastSyncOutput")
+			},
+		},
+		HandleFunc: &GitserverRepoStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockGitserverRepoStore.Handle")
+			},
+		},
+		IteratePurgeableReposFunc: &GitserverRepoStoreIteratePurgeableReposFunc{
+			defaultHook: func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error {
+				panic("unexpected invocation of MockGitserverRepoStore.IteratePurgeableRepos")
+			},
+		},
+		IterateRepoGitserverStatusFunc: &GitserverRepoStoreIterateRepoGitserverStatusFunc{
+			defaultHook: func(context.Context, database.IterateRepoGitserverStatusOptions) ([]types.RepoGitserverStatus, int, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.IterateRepoGitserverStatus")
+			},
+		},
+		ListReposWithLastErrorFunc: &GitserverRepoStoreListReposWithLastErrorFunc{
+			defaultHook: func(context.Context) ([]api.RepoName, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.ListReposWithLastError")
+			},
+		},
+		LogCorruptionFunc: &GitserverRepoStoreLogCorruptionFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) error {
+				panic("unexpected invocation of MockGitserverRepoStore.LogCorruption")
+			},
+		},
+		SetCloneStatusFunc: &GitserverRepoStoreSetCloneStatusFunc{
+			defaultHook: func(context.Context, api.RepoName, types.CloneStatus, string) error {
+				panic("unexpected invocation of MockGitserverRepoStore.SetCloneStatus")
+			},
+		},
+		SetCloningProgressFunc: &GitserverRepoStoreSetCloningProgressFunc{
+			defaultHook: func(context.Context, api.RepoName, string) error {
+				panic("unexpected invocation of MockGitserverRepoStore.SetCloningProgress")
+			},
+		},
+		SetLastErrorFunc: &GitserverRepoStoreSetLastErrorFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) error {
+				panic("unexpected invocation of MockGitserverRepoStore.SetLastError")
+			},
+		},
+		SetLastFetchedFunc: &GitserverRepoStoreSetLastFetchedFunc{
+			defaultHook: func(context.Context, api.RepoName, database.GitserverFetchData) error {
+				panic("unexpected invocation of MockGitserverRepoStore.SetLastFetched")
+			},
+		},
+		SetLastOutputFunc: &GitserverRepoStoreSetLastOutputFunc{
+			defaultHook: func(context.Context, api.RepoName, string) error {
+				panic("unexpected invocation of MockGitserverRepoStore.SetLastOutput")
+			},
+		},
+		SetRepoSizeFunc: &GitserverRepoStoreSetRepoSizeFunc{
+			defaultHook: func(context.Context, api.RepoName, int64, string) error {
+				panic("unexpected invocation of MockGitserverRepoStore.SetRepoSize")
+			},
+		},
+		TotalErroredCloudDefaultReposFunc: &GitserverRepoStoreTotalErroredCloudDefaultReposFunc{
+			defaultHook: func(context.Context) (int, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.TotalErroredCloudDefaultRepos")
+			},
+		},
+		UpdateFunc: &GitserverRepoStoreUpdateFunc{
+			defaultHook: func(context.Context, ...*types.GitserverRepo) error {
+				panic("unexpected invocation of MockGitserverRepoStore.Update")
+			},
+		},
+		UpdateRepoSizesFunc: &GitserverRepoStoreUpdateRepoSizesFunc{
+			defaultHook: func(context.Context, string, map[api.RepoName]int64) (int, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.UpdateRepoSizes")
+			},
+		},
+		WithFunc: &GitserverRepoStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) database.GitserverRepoStore {
+				panic("unexpected invocation of MockGitserverRepoStore.With")
+			},
+		},
+	}
+}
+
+// NewMockGitserverRepoStoreFrom creates a new mock of the
+// MockGitserverRepoStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockGitserverRepoStoreFrom(i database.GitserverRepoStore) *MockGitserverRepoStore {
+	return &MockGitserverRepoStore{
+		GetByIDFunc: &GitserverRepoStoreGetByIDFunc{
+			defaultHook: i.GetByID,
+		},
+		GetByNameFunc: &GitserverRepoStoreGetByNameFunc{
+			defaultHook: i.GetByName,
+		},
+		GetByNamesFunc: &GitserverRepoStoreGetByNamesFunc{
+			defaultHook: i.GetByNames,
+		},
+		GetGitserverGitDirSizeFunc: &GitserverRepoStoreGetGitserverGitDirSizeFunc{
+			defaultHook: i.GetGitserverGitDirSize,
+		},
+		GetLastSyncOutputFunc: &GitserverRepoStoreGetLastSyncOutputFunc{
+			defaultHook: i.GetLastSyncOutput,
+		},
+		HandleFunc: &GitserverRepoStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		IteratePurgeableReposFunc: &GitserverRepoStoreIteratePurgeableReposFunc{
+			defaultHook: i.IteratePurgeableRepos,
+		},
+		IterateRepoGitserverStatusFunc: &GitserverRepoStoreIterateRepoGitserverStatusFunc{
+			defaultHook: i.IterateRepoGitserverStatus,
+		},
+		ListReposWithLastErrorFunc: &GitserverRepoStoreListReposWithLastErrorFunc{
+			defaultHook: i.ListReposWithLastError,
+		},
+		LogCorruptionFunc: &GitserverRepoStoreLogCorruptionFunc{
+			defaultHook: i.LogCorruption,
+		},
+		SetCloneStatusFunc: &GitserverRepoStoreSetCloneStatusFunc{
+			defaultHook: i.SetCloneStatus,
+		},
+		SetCloningProgressFunc: &GitserverRepoStoreSetCloningProgressFunc{
+			defaultHook: i.SetCloningProgress,
+		},
+		SetLastErrorFunc: &GitserverRepoStoreSetLastErrorFunc{
+			defaultHook: i.SetLastError,
+		},
+		SetLastFetchedFunc: &GitserverRepoStoreSetLastFetchedFunc{
+			defaultHook: i.SetLastFetched,
+		},
+		SetLastOutputFunc: &GitserverRepoStoreSetLastOutputFunc{
+			defaultHook: i.SetLastOutput,
+		},
+		SetRepoSizeFunc: &GitserverRepoStoreSetRepoSizeFunc{
+			defaultHook: i.SetRepoSize,
+		},
+		TotalErroredCloudDefaultReposFunc: &GitserverRepoStoreTotalErroredCloudDefaultReposFunc{
+			defaultHook: i.TotalErroredCloudDefaultRepos,
+		},
+		UpdateFunc: &GitserverRepoStoreUpdateFunc{
+			defaultHook: i.Update,
+		},
+		UpdateRepoSizesFunc: &GitserverRepoStoreUpdateRepoSizesFunc{
+			defaultHook: i.UpdateRepoSizes,
+		},
+		WithFunc: &GitserverRepoStoreWithFunc{
+			defaultHook: i.With,
+		},
+	}
+}
+
+// GitserverRepoStoreGetByIDFunc describes the behavior when the GetByID
+// method of the parent MockGitserverRepoStore instance is invoked.
+type GitserverRepoStoreGetByIDFunc struct {
+	defaultHook func(context.Context, api.RepoID) (*types.GitserverRepo, error)
+	hooks       []func(context.Context, api.RepoID) (*types.GitserverRepo, error)
+	history     []GitserverRepoStoreGetByIDFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByID delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) GetByID(v0 context.Context, v1 api.RepoID) (*types.GitserverRepo, error) {
+	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
+	m.GetByIDFunc.appendCall(GitserverRepoStoreGetByIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByID method of
+// the parent MockGitserverRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *GitserverRepoStoreGetByIDFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*types.GitserverRepo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByID method of the parent MockGitserverRepoStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverRepoStoreGetByIDFunc) PushHook(hook func(context.Context, api.RepoID) (*types.GitserverRepo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreGetByIDFunc) SetDefaultReturn(r0 *types.GitserverRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (*types.GitserverRepo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreGetByIDFunc) PushReturn(r0 *types.GitserverRepo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (*types.GitserverRepo, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverRepoStoreGetByIDFunc) nextHook() func(context.Context, api.RepoID) (*types.GitserverRepo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreGetByIDFunc) appendCall(r0 GitserverRepoStoreGetByIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverRepoStoreGetByIDFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverRepoStoreGetByIDFunc) History() []GitserverRepoStoreGetByIDFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreGetByIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreGetByIDFuncCall is an object that describes an
+// invocation of method GetByID on an instance of MockGitserverRepoStore.
+type GitserverRepoStoreGetByIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.GitserverRepo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverRepoStoreGetByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreGetByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverRepoStoreGetByNameFunc describes the behavior when the GetByName
+// method of the parent MockGitserverRepoStore instance is invoked.
+type GitserverRepoStoreGetByNameFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*types.GitserverRepo, error)
+	hooks       []func(context.Context, api.RepoName) (*types.GitserverRepo, error)
+	history     []GitserverRepoStoreGetByNameFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByName delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.GitserverRepo, error) {
+	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
+	m.GetByNameFunc.appendCall(GitserverRepoStoreGetByNameFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByName method of
+// the parent MockGitserverRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *GitserverRepoStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types.GitserverRepo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByName method of the parent MockGitserverRepoStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverRepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types.GitserverRepo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreGetByNameFunc) SetDefaultReturn(r0 *types.GitserverRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*types.GitserverRepo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreGetByNameFunc) PushReturn(r0 *types.GitserverRepo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*types.GitserverRepo, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverRepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types.GitserverRepo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreGetByNameFunc) appendCall(r0 GitserverRepoStoreGetByNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverRepoStoreGetByNameFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverRepoStoreGetByNameFunc) History() []GitserverRepoStoreGetByNameFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreGetByNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreGetByNameFuncCall is an object that describes an
+// invocation of method GetByName on an instance of MockGitserverRepoStore.
+type GitserverRepoStoreGetByNameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.GitserverRepo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverRepoStoreGetByNameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreGetByNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverRepoStoreGetByNamesFunc describes the behavior when the
+// GetByNames method of the parent MockGitserverRepoStore instance is
+// invoked.
+type GitserverRepoStoreGetByNamesFunc struct {
+	defaultHook func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error)
+	hooks       []func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error)
+	history     []GitserverRepoStoreGetByNamesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByNames delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) GetByNames(v0 context.Context, v1 ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error) {
+	r0, r1 := m.GetByNamesFunc.nextHook()(v0, v1...)
+	m.GetByNamesFunc.appendCall(GitserverRepoStoreGetByNamesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByNames method of
+// the parent MockGitserverRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *GitserverRepoStoreGetByNamesFunc) SetDefaultHook(hook func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByNames method of the parent MockGitserverRepoStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverRepoStoreGetByNamesFunc) PushHook(hook func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreGetByNamesFunc) SetDefaultReturn(r0 map[api.RepoName]*types.GitserverRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreGetByNamesFunc) PushReturn(r0 map[api.RepoName]*types.GitserverRepo, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverRepoStoreGetByNamesFunc) nextHook() func(context.Context, ...api.RepoName) (map[api.RepoName]*types.GitserverRepo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreGetByNamesFunc) appendCall(r0 GitserverRepoStoreGetByNamesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverRepoStoreGetByNamesFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverRepoStoreGetByNamesFunc) History() []GitserverRepoStoreGetByNamesFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreGetByNamesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreGetByNamesFuncCall is an object that describes an
+// invocation of method GetByNames on an instance of MockGitserverRepoStore.
+type GitserverRepoStoreGetByNamesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[api.RepoName]*types.GitserverRepo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverRepoStoreGetByNamesFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreGetByNamesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverRepoStoreGetGitserverGitDirSizeFunc describes the behavior when
+// the GetGitserverGitDirSize method of the parent MockGitserverRepoStore
+// instance is invoked.
+type GitserverRepoStoreGetGitserverGitDirSizeFunc struct {
+	defaultHook func(context.Context) (int64, error)
+	hooks       []func(context.Context) (int64, error)
+	history     []GitserverRepoStoreGetGitserverGitDirSizeFuncCall
+	mutex       sync.Mutex
+}
+
+// GetGitserverGitDirSize delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) GetGitserverGitDirSize(v0 context.Context) (int64, error) {
+	r0, r1 := m.GetGitserverGitDirSizeFunc.nextHook()(v0)
+	m.GetGitserverGitDirSizeFunc.appendCall(GitserverRepoStoreGetGitserverGitDirSizeFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetGitserverGitDirSize method of the parent MockGitserverRepoStore
+// instance is invoked and the hook queue is empty.
+func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) SetDefaultHook(hook func(context.Context) (int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetGitserverGitDirSize method of the parent MockGitserverRepoStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) PushHook(hook func(context.Context) (int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) SetDefaultReturn(r0 int64, r1 error) {
+	f.SetDefaultHook(func(context.Context) (int64, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) PushReturn(r0 int64, r1 error) {
+	f.PushHook(func(context.Context) (int64, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) nextHook() func(context.Context) (int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) appendCall(r0 GitserverRepoStoreGetGitserverGitDirSizeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverRepoStoreGetGitserverGitDirSizeFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverRepoStoreGetGitserverGitDirSizeFunc) History() []GitserverRepoStoreGetGitserverGitDirSizeFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreGetGitserverGitDirSizeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreGetGitserverGitDirSizeFuncCall is an object that
+// describes an invocation of method GetGitserverGitDirSize on an instance
+// of MockGitserverRepoStore.
+type GitserverRepoStoreGetGitserverGitDirSizeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverRepoStoreGetGitserverGitDirSizeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreGetGitserverGitDirSizeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverRepoStoreGetLastSyncOutputFunc describes the behavior when the
+// GetLastSyncOutput method of the parent MockGitserverRepoStore instance is
+// invoked.
+type GitserverRepoStoreGetLastSyncOutputFunc struct {
+	defaultHook func(context.Context, api.RepoName) (string, bool, error)
+	hooks       []func(context.Context, api.RepoName) (string, bool, error)
+	history     []GitserverRepoStoreGetLastSyncOutputFuncCall
+	mutex       sync.Mutex
+}
+
+// GetLastSyncOutput delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) GetLastSyncOutput(v0 context.Context, v1 api.RepoName) (string, bool, error) {
+	r0, r1, r2 := m.GetLastSyncOutputFunc.nextHook()(v0, v1)
+	m.GetLastSyncOutputFunc.appendCall(GitserverRepoStoreGetLastSyncOutputFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetLastSyncOutput
+// method of the parent MockGitserverRepoStore instance is invoked and the
+// hook queue is empty.
+func (f *GitserverRepoStoreGetLastSyncOutputFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (string, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetLastSyncOutput method of the parent MockGitserverRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverRepoStoreGetLastSyncOutputFunc) PushHook(hook func(context.Context, api.RepoName) (string, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreGetLastSyncOutputFunc) SetDefaultReturn(r0 string, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (string, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreGetLastSyncOutputFunc) PushReturn(r0 string, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, api.RepoName) (string, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *GitserverRepoStoreGetLastSyncOutputFunc) nextHook() func(context.Context, api.RepoName) (string, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreGetLastSyncOutputFunc) appendCall(r0 GitserverRepoStoreGetLastSyncOutputFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverRepoStoreGetLastSyncOutputFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverRepoStoreGetLastSyncOutputFunc) History() []GitserverRepoStoreGetLastSyncOutputFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreGetLastSyncOutputFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreGetLastSyncOutputFuncCall is an object that describes
+// an invocation of method GetLastSyncOutput on an instance of
+// MockGitserverRepoStore.
+type GitserverRepoStoreGetLastSyncOutputFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverRepoStoreGetLastSyncOutputFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreGetLastSyncOutputFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// GitserverRepoStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockGitserverRepoStore instance is invoked.
+type GitserverRepoStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []GitserverRepoStoreHandleFuncCall
+	mutex       sync.Mutex
+}
+
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(GitserverRepoStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockGitserverRepoStore instance is invoked and the hook queue is
+// empty.
+func (f *GitserverRepoStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockGitserverRepoStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverRepoStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *GitserverRepoStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreHandleFunc) appendCall(r0 GitserverRepoStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverRepoStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverRepoStoreHandleFunc) History() []GitserverRepoStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockGitserverRepoStore.
+type GitserverRepoStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverRepoStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitserverRepoStoreIteratePurgeableReposFunc describes the behavior when
+// the IteratePurgeableRepos method of the parent MockGitserverRepoStore
+// instance is invoked.
+type GitserverRepoStoreIteratePurgeableReposFunc struct {
+	defaultHook func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error
+	hooks       []func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error
+	history     []GitserverRepoStoreIteratePurgeableReposFuncCall
+	mutex       sync.Mutex
+}
+
+// IteratePurgeableRepos delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) IteratePurgeableRepos(v0 context.Context, v1 database.IteratePurgableReposOptions, v2 func(repo api.RepoName) error) error {
+	r0 := m.IteratePurgeableReposFunc.nextHook()(v0, v1, v2)
+	m.IteratePurgeableReposFunc.appendCall(GitserverRepoStoreIteratePurgeableReposFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// IteratePurgeableRepos method of the parent MockGitserverRepoStore
+// instance is invoked and the hook queue is empty.
+func (f *GitserverRepoStoreIteratePurgeableReposFunc) SetDefaultHook(hook func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// IteratePurgeableRepos method of the parent MockGitserverRepoStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverRepoStoreIteratePurgeableReposFunc) PushHook(hook func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreIteratePurgeableReposFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreIteratePurgeableReposFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error {
+		return r0
+	})
+}
+
+func (f *GitserverRepoStoreIteratePurgeableReposFunc) nextHook() func(context.Context, database.IteratePurgableReposOptions, func(repo api.RepoName) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreIteratePurgeableReposFunc) appendCall(r0 GitserverRepoStoreIteratePurgeableReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverRepoStoreIteratePurgeableReposFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverRepoStoreIteratePurgeableReposFunc) History() []GitserverRepoStoreIteratePurgeableReposFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreIteratePurgeableReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreIteratePurgeableReposFuncCall is an object that
+// describes an invocation of method IteratePurgeableRepos on an instance of
+// MockGitserverRepoStore.
+type GitserverRepoStoreIteratePurgeableReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.IteratePurgableReposOptions
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 func(repo api.RepoName) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverRepoStoreIteratePurgeableReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreIteratePurgeableReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitserverRepoStoreIterateRepoGitserverStatusFunc describes the behavior
+// when the IterateRepoGitserverStatus method of the parent
+// MockGitserverRepoStore instance is invoked.
+type GitserverRepoStoreIterateRepoGitserverStatusFunc struct {
+	defaultHook func(context.Context, database.IterateRepoGitserverStatusOptions) ([]types.RepoGitserverStatus, int, error)
+	hooks       []func(context.Context, database.IterateRepoGitserverStatusOptions) ([]types.RepoGitserverStatus, int, error)
+	history     []GitserverRepoStoreIterateRepoGitserverStatusFuncCall
+	mutex       sync.Mutex
+}
+
+// IterateRepoGitserverStatus delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) IterateRepoGitserverStatus(v0 context.Context, v1 database.IterateRepoGitserverStatusOptions) ([]types.RepoGitserverStatus, int, error) {
+	r0, r1, r2 := m.IterateRepoGitserverStatusFunc.nextHook()(v0, v1)
+	m.IterateRepoGitserverStatusFunc.appendCall(GitserverRepoStoreIterateRepoGitserverStatusFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// IterateRepoGitserverStatus method of the parent MockGitserverRepoStore
+// instance is invoked and the hook queue is empty.
+func (f *GitserverRepoStoreIterateRepoGitserverStatusFunc) SetDefaultHook(hook func(context.Context, database.IterateRepoGitserverStatusOptions) ([]types.RepoGitserverStatus, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// IterateRepoGitserverStatus method of the parent MockGitserverRepoStore
+// instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
 func (f *GitserverRepoStoreIterateRepoGitserverStatusFunc) PushHook(hook func(context.Context, database.IterateRepoGitserverStatusOptions) ([]types.RepoGitserverStatus, int, error)) {
@@ -48092,35 +50666,455 @@ func NewMockOutboundWebhookStoreFrom(i database.OutboundWebhookStore) *MockOutbo
 	}
 }
 
-// OutboundWebhookStoreCountFunc describes the behavior when the Count
+// OutboundWebhookStoreCountFunc describes the behavior when the Count
+// method of the parent MockOutboundWebhookStore instance is invoked.
+type OutboundWebhookStoreCountFunc struct {
+	defaultHook func(context.Context, database.OutboundWebhookCountOpts) (int64, error)
+	hooks       []func(context.Context, database.OutboundWebhookCountOpts) (int64, error)
+	history     []OutboundWebhookStoreCountFuncCall
+	mutex       sync.Mutex
+}
+
+// Count delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockOutboundWebhookStore) Count(v0 context.Context, v1 database.OutboundWebhookCountOpts) (int64, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(OutboundWebhookStoreCountFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockOutboundWebhookStore instance is invoked and the hook queue is
+// empty.
+func (f *OutboundWebhookStoreCountFunc) SetDefaultHook(hook func(context.Context, database.OutboundWebhookCountOpts) (int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Count method of the parent MockOutboundWebhookStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *OutboundWebhookStoreCountFunc) PushHook(hook func(context.Context, database.OutboundWebhookCountOpts) (int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OutboundWebhookStoreCountFunc) SetDefaultReturn(r0 int64, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.OutboundWebhookCountOpts) (int64, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OutboundWebhookStoreCountFunc) PushReturn(r0 int64, r1 error) {
+	f.PushHook(func(context.Context, database.OutboundWebhookCountOpts) (int64, error) {
+		return r0, r1
+	})
+}
+
+func (f *OutboundWebhookStoreCountFunc) nextHook() func(context.Context, database.OutboundWebhookCountOpts) (int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OutboundWebhookStoreCountFunc) appendCall(r0 OutboundWebhookStoreCountFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of OutboundWebhookStoreCountFuncCall objects
+// describing the invocations of this function.
+func (f *OutboundWebhookStoreCountFunc) History() []OutboundWebhookStoreCountFuncCall {
+	f.mutex.Lock()
+	history := make([]OutboundWebhookStoreCountFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OutboundWebhookStoreCountFuncCall is an object that describes an
+// invocation of method Count on an instance of MockOutboundWebhookStore.
+type OutboundWebhookStoreCountFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.OutboundWebhookCountOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OutboundWebhookStoreCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OutboundWebhookStoreCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// OutboundWebhookStoreCreateFunc describes the behavior when the Create
+// method of the parent MockOutboundWebhookStore instance is invoked.
+type OutboundWebhookStoreCreateFunc struct {
+	defaultHook func(context.Context, *types.OutboundWebhook) error
+	hooks       []func(context.Context, *types.OutboundWebhook) error
+	history     []OutboundWebhookStoreCreateFuncCall
+	mutex       sync.Mutex
+}
+
+// Create delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockOutboundWebhookStore) Create(v0 context.Context, v1 *types.OutboundWebhook) error {
+	r0 := m.CreateFunc.nextHook()(v0, v1)
+	m.CreateFunc.appendCall(OutboundWebhookStoreCreateFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Create method of the
+// parent MockOutboundWebhookStore instance is invoked and the hook queue is
+// empty.
+func (f *OutboundWebhookStoreCreateFunc) SetDefaultHook(hook func(context.Context, *types.OutboundWebhook) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Create method of the parent MockOutboundWebhookStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *OutboundWebhookStoreCreateFunc) PushHook(hook func(context.Context, *types.OutboundWebhook) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OutboundWebhookStoreCreateFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *types.OutboundWebhook) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OutboundWebhookStoreCreateFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *types.OutboundWebhook) error {
+		return r0
+	})
+}
+
+func (f *OutboundWebhookStoreCreateFunc) nextHook() func(context.Context, *types.OutboundWebhook) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OutboundWebhookStoreCreateFunc) appendCall(r0 OutboundWebhookStoreCreateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of OutboundWebhookStoreCreateFuncCall objects
+// describing the invocations of this function.
+func (f *OutboundWebhookStoreCreateFunc) History() []OutboundWebhookStoreCreateFuncCall {
+	f.mutex.Lock()
+	history := make([]OutboundWebhookStoreCreateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OutboundWebhookStoreCreateFuncCall is an object that describes an
+// invocation of method Create on an instance of MockOutboundWebhookStore.
+type OutboundWebhookStoreCreateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *types.OutboundWebhook
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OutboundWebhookStoreCreateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OutboundWebhookStoreCreateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// OutboundWebhookStoreDeleteFunc describes the behavior when the Delete
+// method of the parent MockOutboundWebhookStore instance is invoked.
+type OutboundWebhookStoreDeleteFunc struct {
+	defaultHook func(context.Context, int64) error
+	hooks       []func(context.Context, int64) error
+	history     []OutboundWebhookStoreDeleteFuncCall
+	mutex       sync.Mutex
+}
+
+// Delete delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockOutboundWebhookStore) Delete(v0 context.Context, v1 int64) error {
+	r0 := m.DeleteFunc.nextHook()(v0, v1)
+	m.DeleteFunc.appendCall(OutboundWebhookStoreDeleteFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Delete method of the
+// parent MockOutboundWebhookStore instance is invoked and the hook queue is
+// empty.
+func (f *OutboundWebhookStoreDeleteFunc) SetDefaultHook(hook func(context.Context, int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Delete method of the parent MockOutboundWebhookStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *OutboundWebhookStoreDeleteFunc) PushHook(hook func(context.Context, int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OutboundWebhookStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OutboundWebhookStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64) error {
+		return r0
+	})
+}
+
+func (f *OutboundWebhookStoreDeleteFunc) nextHook() func(context.Context, int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OutboundWebhookStoreDeleteFunc) appendCall(r0 OutboundWebhookStoreDeleteFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of OutboundWebhookStoreDeleteFuncCall objects
+// describing the invocations of this function.
+func (f *OutboundWebhookStoreDeleteFunc) History() []OutboundWebhookStoreDeleteFuncCall {
+	f.mutex.Lock()
+	history := make([]OutboundWebhookStoreDeleteFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OutboundWebhookStoreDeleteFuncCall is an object that describes an
+// invocation of method Delete on an instance of MockOutboundWebhookStore.
+type OutboundWebhookStoreDeleteFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OutboundWebhookStoreDeleteFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OutboundWebhookStoreDeleteFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// OutboundWebhookStoreDoneFunc describes the behavior when the Done method
+// of the parent MockOutboundWebhookStore instance is invoked.
+type OutboundWebhookStoreDoneFunc struct {
+	defaultHook func(error) error
+	hooks       []func(error) error
+	history     []OutboundWebhookStoreDoneFuncCall
+	mutex       sync.Mutex
+}
+
+// Done delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockOutboundWebhookStore) Done(v0 error) error {
+	r0 := m.DoneFunc.nextHook()(v0)
+	m.DoneFunc.appendCall(OutboundWebhookStoreDoneFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Done method of the
+// parent MockOutboundWebhookStore instance is invoked and the hook queue is
+// empty.
+func (f *OutboundWebhookStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Done method of the parent MockOutboundWebhookStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *OutboundWebhookStoreDoneFunc) PushHook(hook func(error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OutboundWebhookStoreDoneFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OutboundWebhookStoreDoneFunc) PushReturn(r0 error) {
+	f.PushHook(func(error) error {
+		return r0
+	})
+}
+
+func (f *OutboundWebhookStoreDoneFunc) nextHook() func(error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OutboundWebhookStoreDoneFunc) appendCall(r0 OutboundWebhookStoreDoneFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of OutboundWebhookStoreDoneFuncCall objects
+// describing the invocations of this function.
+func (f *OutboundWebhookStoreDoneFunc) History() []OutboundWebhookStoreDoneFuncCall {
+	f.mutex.Lock()
+	history := make([]OutboundWebhookStoreDoneFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OutboundWebhookStoreDoneFuncCall is an object that describes an
+// invocation of method Done on an instance of MockOutboundWebhookStore.
+type OutboundWebhookStoreDoneFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OutboundWebhookStoreDoneFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OutboundWebhookStoreDoneFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// OutboundWebhookStoreGetByIDFunc describes the behavior when the GetByID
 // method of the parent MockOutboundWebhookStore instance is invoked.
-type OutboundWebhookStoreCountFunc struct {
-	defaultHook func(context.Context, database.OutboundWebhookCountOpts) (int64, error)
-	hooks       []func(context.Context, database.OutboundWebhookCountOpts) (int64, error)
-	history     []OutboundWebhookStoreCountFuncCall
+type OutboundWebhookStoreGetByIDFunc struct {
+	defaultHook func(context.Context, int64) (*types.OutboundWebhook, error)
+	hooks       []func(context.Context, int64) (*types.OutboundWebhook, error)
+	history     []OutboundWebhookStoreGetByIDFuncCall
 	mutex       sync.Mutex
 }
 
-// Count delegates to the next hook function in the queue and stores the
+// GetByID delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) Count(v0 context.Context, v1 database.OutboundWebhookCountOpts) (int64, error) {
-	r0, r1 := m.CountFunc.nextHook()(v0, v1)
-	m.CountFunc.appendCall(OutboundWebhookStoreCountFuncCall{v0, v1, r0, r1})
+func (m *MockOutboundWebhookStore) GetByID(v0 context.Context, v1 int64) (*types.OutboundWebhook, error) {
+	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
+	m.GetByIDFunc.appendCall(OutboundWebhookStoreGetByIDFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Count method of the
-// parent MockOutboundWebhookStore instance is invoked and the hook queue is
-// empty.
-func (f *OutboundWebhookStoreCountFunc) SetDefaultHook(hook func(context.Context, database.OutboundWebhookCountOpts) (int64, error)) {
+// SetDefaultHook sets function that is called when the GetByID method of
+// the parent MockOutboundWebhookStore instance is invoked and the hook
+// queue is empty.
+func (f *OutboundWebhookStoreGetByIDFunc) SetDefaultHook(hook func(context.Context, int64) (*types.OutboundWebhook, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Count method of the parent MockOutboundWebhookStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreCountFunc) PushHook(hook func(context.Context, database.OutboundWebhookCountOpts) (int64, error)) {
+// GetByID method of the parent MockOutboundWebhookStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *OutboundWebhookStoreGetByIDFunc) PushHook(hook func(context.Context, int64) (*types.OutboundWebhook, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48128,20 +51122,20 @@ func (f *OutboundWebhookStoreCountFunc) PushHook(hook func(context.Context, data
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreCountFunc) SetDefaultReturn(r0 int64, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.OutboundWebhookCountOpts) (int64, error) {
+func (f *OutboundWebhookStoreGetByIDFunc) SetDefaultReturn(r0 *types.OutboundWebhook, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*types.OutboundWebhook, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreCountFunc) PushReturn(r0 int64, r1 error) {
-	f.PushHook(func(context.Context, database.OutboundWebhookCountOpts) (int64, error) {
+func (f *OutboundWebhookStoreGetByIDFunc) PushReturn(r0 *types.OutboundWebhook, r1 error) {
+	f.PushHook(func(context.Context, int64) (*types.OutboundWebhook, error) {
 		return r0, r1
 	})
 }
 
-func (f *OutboundWebhookStoreCountFunc) nextHook() func(context.Context, database.OutboundWebhookCountOpts) (int64, error) {
+func (f *OutboundWebhookStoreGetByIDFunc) nextHook() func(context.Context, int64) (*types.OutboundWebhook, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48154,35 +51148,35 @@ func (f *OutboundWebhookStoreCountFunc) nextHook() func(context.Context, databas
 	return hook
 }
 
-func (f *OutboundWebhookStoreCountFunc) appendCall(r0 OutboundWebhookStoreCountFuncCall) {
+func (f *OutboundWebhookStoreGetByIDFunc) appendCall(r0 OutboundWebhookStoreGetByIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreCountFuncCall objects
+// History returns a sequence of OutboundWebhookStoreGetByIDFuncCall objects
 // describing the invocations of this function.
-func (f *OutboundWebhookStoreCountFunc) History() []OutboundWebhookStoreCountFuncCall {
+func (f *OutboundWebhookStoreGetByIDFunc) History() []OutboundWebhookStoreGetByIDFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreCountFuncCall, len(f.history))
+	history := make([]OutboundWebhookStoreGetByIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreCountFuncCall is an object that describes an
-// invocation of method Count on an instance of MockOutboundWebhookStore.
-type OutboundWebhookStoreCountFuncCall struct {
+// OutboundWebhookStoreGetByIDFuncCall is an object that describes an
+// invocation of method GetByID on an instance of MockOutboundWebhookStore.
+type OutboundWebhookStoreGetByIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.OutboundWebhookCountOpts
+	Arg1 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int64
+	Result0 *types.OutboundWebhook
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -48190,45 +51184,45 @@ type OutboundWebhookStoreCountFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreCountFuncCall) Args() []interface{} {
+func (c OutboundWebhookStoreGetByIDFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreCountFuncCall) Results() []interface{} {
+func (c OutboundWebhookStoreGetByIDFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// OutboundWebhookStoreCreateFunc describes the behavior when the Create
+// OutboundWebhookStoreHandleFunc describes the behavior when the Handle
 // method of the parent MockOutboundWebhookStore instance is invoked.
-type OutboundWebhookStoreCreateFunc struct {
-	defaultHook func(context.Context, *types.OutboundWebhook) error
-	hooks       []func(context.Context, *types.OutboundWebhook) error
-	history     []OutboundWebhookStoreCreateFuncCall
+type OutboundWebhookStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []OutboundWebhookStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// Create delegates to the next hook function in the queue and stores the
+// Handle delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) Create(v0 context.Context, v1 *types.OutboundWebhook) error {
-	r0 := m.CreateFunc.nextHook()(v0, v1)
-	m.CreateFunc.appendCall(OutboundWebhookStoreCreateFuncCall{v0, v1, r0})
+func (m *MockOutboundWebhookStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(OutboundWebhookStoreHandleFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Create method of the
+// SetDefaultHook sets function that is called when the Handle method of the
 // parent MockOutboundWebhookStore instance is invoked and the hook queue is
 // empty.
-func (f *OutboundWebhookStoreCreateFunc) SetDefaultHook(hook func(context.Context, *types.OutboundWebhook) error) {
+func (f *OutboundWebhookStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Create method of the parent MockOutboundWebhookStore instance invokes the
+// Handle method of the parent MockOutboundWebhookStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreCreateFunc) PushHook(hook func(context.Context, *types.OutboundWebhook) error) {
+func (f *OutboundWebhookStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48236,20 +51230,20 @@ func (f *OutboundWebhookStoreCreateFunc) PushHook(hook func(context.Context, *ty
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreCreateFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, *types.OutboundWebhook) error {
+func (f *OutboundWebhookStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreCreateFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, *types.OutboundWebhook) error {
+func (f *OutboundWebhookStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *OutboundWebhookStoreCreateFunc) nextHook() func(context.Context, *types.OutboundWebhook) error {
+func (f *OutboundWebhookStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48262,78 +51256,180 @@ func (f *OutboundWebhookStoreCreateFunc) nextHook() func(context.Context, *types
 	return hook
 }
 
-func (f *OutboundWebhookStoreCreateFunc) appendCall(r0 OutboundWebhookStoreCreateFuncCall) {
+func (f *OutboundWebhookStoreHandleFunc) appendCall(r0 OutboundWebhookStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreCreateFuncCall objects
+// History returns a sequence of OutboundWebhookStoreHandleFuncCall objects
 // describing the invocations of this function.
-func (f *OutboundWebhookStoreCreateFunc) History() []OutboundWebhookStoreCreateFuncCall {
+func (f *OutboundWebhookStoreHandleFunc) History() []OutboundWebhookStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreCreateFuncCall, len(f.history))
+	history := make([]OutboundWebhookStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreCreateFuncCall is an object that describes an
-// invocation of method Create on an instance of MockOutboundWebhookStore.
-type OutboundWebhookStoreCreateFuncCall struct {
+// OutboundWebhookStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockOutboundWebhookStore.
+type OutboundWebhookStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OutboundWebhookStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OutboundWebhookStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// OutboundWebhookStoreListFunc describes the behavior when the List method
+// of the parent MockOutboundWebhookStore instance is invoked.
+type OutboundWebhookStoreListFunc struct {
+	defaultHook func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error)
+	hooks       []func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error)
+	history     []OutboundWebhookStoreListFuncCall
+	mutex       sync.Mutex
+}
+
+// List delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockOutboundWebhookStore) List(v0 context.Context, v1 database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(OutboundWebhookStoreListFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockOutboundWebhookStore instance is invoked and the hook queue is
+// empty.
+func (f *OutboundWebhookStoreListFunc) SetDefaultHook(hook func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// List method of the parent MockOutboundWebhookStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *OutboundWebhookStoreListFunc) PushHook(hook func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OutboundWebhookStoreListFunc) SetDefaultReturn(r0 []*types.OutboundWebhook, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OutboundWebhookStoreListFunc) PushReturn(r0 []*types.OutboundWebhook, r1 error) {
+	f.PushHook(func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error) {
+		return r0, r1
+	})
+}
+
+func (f *OutboundWebhookStoreListFunc) nextHook() func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OutboundWebhookStoreListFunc) appendCall(r0 OutboundWebhookStoreListFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of OutboundWebhookStoreListFuncCall objects
+// describing the invocations of this function.
+func (f *OutboundWebhookStoreListFunc) History() []OutboundWebhookStoreListFuncCall {
+	f.mutex.Lock()
+	history := make([]OutboundWebhookStoreListFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OutboundWebhookStoreListFuncCall is an object that describes an
+// invocation of method List on an instance of MockOutboundWebhookStore.
+type OutboundWebhookStoreListFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *types.OutboundWebhook
+	Arg1 database.OutboundWebhookListOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []*types.OutboundWebhook
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreCreateFuncCall) Args() []interface{} {
+func (c OutboundWebhookStoreListFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreCreateFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c OutboundWebhookStoreListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// OutboundWebhookStoreDeleteFunc describes the behavior when the Delete
+// OutboundWebhookStoreQueryFunc describes the behavior when the Query
 // method of the parent MockOutboundWebhookStore instance is invoked.
-type OutboundWebhookStoreDeleteFunc struct {
-	defaultHook func(context.Context, int64) error
-	hooks       []func(context.Context, int64) error
-	history     []OutboundWebhookStoreDeleteFuncCall
+type OutboundWebhookStoreQueryFunc struct {
+	defaultHook func(context.Context, *sqlf.Query) (*sql.Rows, error)
+	hooks       []func(context.Context, *sqlf.Query) (*sql.Rows, error)
+	history     []OutboundWebhookStoreQueryFuncCall
 	mutex       sync.Mutex
 }
 
-// Delete delegates to the next hook function in the queue and stores the
+// Query delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) Delete(v0 context.Context, v1 int64) error {
-	r0 := m.DeleteFunc.nextHook()(v0, v1)
-	m.DeleteFunc.appendCall(OutboundWebhookStoreDeleteFuncCall{v0, v1, r0})
-	return r0
+func (m *MockOutboundWebhookStore) Query(v0 context.Context, v1 *sqlf.Query) (*sql.Rows, error) {
+	r0, r1 := m.QueryFunc.nextHook()(v0, v1)
+	m.QueryFunc.appendCall(OutboundWebhookStoreQueryFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Delete method of the
+// SetDefaultHook sets function that is called when the Query method of the
 // parent MockOutboundWebhookStore instance is invoked and the hook queue is
 // empty.
-func (f *OutboundWebhookStoreDeleteFunc) SetDefaultHook(hook func(context.Context, int64) error) {
+func (f *OutboundWebhookStoreQueryFunc) SetDefaultHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Delete method of the parent MockOutboundWebhookStore instance invokes the
+// Query method of the parent MockOutboundWebhookStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreDeleteFunc) PushHook(hook func(context.Context, int64) error) {
+func (f *OutboundWebhookStoreQueryFunc) PushHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48341,20 +51437,20 @@ func (f *OutboundWebhookStoreDeleteFunc) PushHook(hook func(context.Context, int
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreDeleteFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int64) error {
-		return r0
+func (f *OutboundWebhookStoreQueryFunc) SetDefaultReturn(r0 *sql.Rows, r1 error) {
+	f.SetDefaultHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreDeleteFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int64) error {
-		return r0
+func (f *OutboundWebhookStoreQueryFunc) PushReturn(r0 *sql.Rows, r1 error) {
+	f.PushHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+		return r0, r1
 	})
 }
 
-func (f *OutboundWebhookStoreDeleteFunc) nextHook() func(context.Context, int64) error {
+func (f *OutboundWebhookStoreQueryFunc) nextHook() func(context.Context, *sqlf.Query) (*sql.Rows, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48367,78 +51463,82 @@ func (f *OutboundWebhookStoreDeleteFunc) nextHook() func(context.Context, int64)
 	return hook
 }
 
-func (f *OutboundWebhookStoreDeleteFunc) appendCall(r0 OutboundWebhookStoreDeleteFuncCall) {
+func (f *OutboundWebhookStoreQueryFunc) appendCall(r0 OutboundWebhookStoreQueryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreDeleteFuncCall objects
+// History returns a sequence of OutboundWebhookStoreQueryFuncCall objects
 // describing the invocations of this function.
-func (f *OutboundWebhookStoreDeleteFunc) History() []OutboundWebhookStoreDeleteFuncCall {
+func (f *OutboundWebhookStoreQueryFunc) History() []OutboundWebhookStoreQueryFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreDeleteFuncCall, len(f.history))
+	history := make([]OutboundWebhookStoreQueryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreDeleteFuncCall is an object that describes an
-// invocation of method Delete on an instance of MockOutboundWebhookStore.
-type OutboundWebhookStoreDeleteFuncCall struct {
+// OutboundWebhookStoreQueryFuncCall is an object that describes an
+// invocation of method Query on an instance of MockOutboundWebhookStore.
+type OutboundWebhookStoreQueryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int64
+	Arg1 *sqlf.Query
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *sql.Rows
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreDeleteFuncCall) Args() []interface{} {
+func (c OutboundWebhookStoreQueryFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreDeleteFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c OutboundWebhookStoreQueryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// OutboundWebhookStoreDoneFunc describes the behavior when the Done method
-// of the parent MockOutboundWebhookStore instance is invoked.
-type OutboundWebhookStoreDoneFunc struct {
-	defaultHook func(error) error
-	hooks       []func(error) error
-	history     []OutboundWebhookStoreDoneFuncCall
+// OutboundWebhookStoreToJobStoreFunc describes the behavior when the
+// ToJobStore method of the parent MockOutboundWebhookStore instance is
+// invoked.
+type OutboundWebhookStoreToJobStoreFunc struct {
+	defaultHook func() database.OutboundWebhookJobStore
+	hooks       []func() database.OutboundWebhookJobStore
+	history     []OutboundWebhookStoreToJobStoreFuncCall
 	mutex       sync.Mutex
 }
 
-// Done delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) Done(v0 error) error {
-	r0 := m.DoneFunc.nextHook()(v0)
-	m.DoneFunc.appendCall(OutboundWebhookStoreDoneFuncCall{v0, r0})
+// ToJobStore delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockOutboundWebhookStore) ToJobStore() database.OutboundWebhookJobStore {
+	r0 := m.ToJobStoreFunc.nextHook()()
+	m.ToJobStoreFunc.appendCall(OutboundWebhookStoreToJobStoreFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Done method of the
-// parent MockOutboundWebhookStore instance is invoked and the hook queue is
-// empty.
-func (f *OutboundWebhookStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+// SetDefaultHook sets function that is called when the ToJobStore method of
+// the parent MockOutboundWebhookStore instance is invoked and the hook
+// queue is empty.
+func (f *OutboundWebhookStoreToJobStoreFunc) SetDefaultHook(hook func() database.OutboundWebhookJobStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Done method of the parent MockOutboundWebhookStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreDoneFunc) PushHook(hook func(error) error) {
+// ToJobStore method of the parent MockOutboundWebhookStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *OutboundWebhookStoreToJobStoreFunc) PushHook(hook func() database.OutboundWebhookJobStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48446,20 +51546,20 @@ func (f *OutboundWebhookStoreDoneFunc) PushHook(hook func(error) error) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreDoneFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(error) error {
+func (f *OutboundWebhookStoreToJobStoreFunc) SetDefaultReturn(r0 database.OutboundWebhookJobStore) {
+	f.SetDefaultHook(func() database.OutboundWebhookJobStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreDoneFunc) PushReturn(r0 error) {
-	f.PushHook(func(error) error {
+func (f *OutboundWebhookStoreToJobStoreFunc) PushReturn(r0 database.OutboundWebhookJobStore) {
+	f.PushHook(func() database.OutboundWebhookJobStore {
 		return r0
 	})
 }
 
-func (f *OutboundWebhookStoreDoneFunc) nextHook() func(error) error {
+func (f *OutboundWebhookStoreToJobStoreFunc) nextHook() func() database.OutboundWebhookJobStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48472,75 +51572,174 @@ func (f *OutboundWebhookStoreDoneFunc) nextHook() func(error) error {
 	return hook
 }
 
-func (f *OutboundWebhookStoreDoneFunc) appendCall(r0 OutboundWebhookStoreDoneFuncCall) {
+func (f *OutboundWebhookStoreToJobStoreFunc) appendCall(r0 OutboundWebhookStoreToJobStoreFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreDoneFuncCall objects
-// describing the invocations of this function.
-func (f *OutboundWebhookStoreDoneFunc) History() []OutboundWebhookStoreDoneFuncCall {
+// History returns a sequence of OutboundWebhookStoreToJobStoreFuncCall
+// objects describing the invocations of this function.
+func (f *OutboundWebhookStoreToJobStoreFunc) History() []OutboundWebhookStoreToJobStoreFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreDoneFuncCall, len(f.history))
+	history := make([]OutboundWebhookStoreToJobStoreFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreDoneFuncCall is an object that describes an
-// invocation of method Done on an instance of MockOutboundWebhookStore.
-type OutboundWebhookStoreDoneFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
+// OutboundWebhookStoreToJobStoreFuncCall is an object that describes an
+// invocation of method ToJobStore on an instance of
+// MockOutboundWebhookStore.
+type OutboundWebhookStoreToJobStoreFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Arg0 error
+	Result0 database.OutboundWebhookJobStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OutboundWebhookStoreToJobStoreFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OutboundWebhookStoreToJobStoreFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// OutboundWebhookStoreToLogStoreFunc describes the behavior when the
+// ToLogStore method of the parent MockOutboundWebhookStore instance is
+// invoked.
+type OutboundWebhookStoreToLogStoreFunc struct {
+	defaultHook func() database.OutboundWebhookLogStore
+	hooks       []func() database.OutboundWebhookLogStore
+	history     []OutboundWebhookStoreToLogStoreFuncCall
+	mutex       sync.Mutex
+}
+
+// ToLogStore delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockOutboundWebhookStore) ToLogStore() database.OutboundWebhookLogStore {
+	r0 := m.ToLogStoreFunc.nextHook()()
+	m.ToLogStoreFunc.appendCall(OutboundWebhookStoreToLogStoreFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the ToLogStore method of
+// the parent MockOutboundWebhookStore instance is invoked and the hook
+// queue is empty.
+func (f *OutboundWebhookStoreToLogStoreFunc) SetDefaultHook(hook func() database.OutboundWebhookLogStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ToLogStore method of the parent MockOutboundWebhookStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *OutboundWebhookStoreToLogStoreFunc) PushHook(hook func() database.OutboundWebhookLogStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OutboundWebhookStoreToLogStoreFunc) SetDefaultReturn(r0 database.OutboundWebhookLogStore) {
+	f.SetDefaultHook(func() database.OutboundWebhookLogStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OutboundWebhookStoreToLogStoreFunc) PushReturn(r0 database.OutboundWebhookLogStore) {
+	f.PushHook(func() database.OutboundWebhookLogStore {
+		return r0
+	})
+}
+
+func (f *OutboundWebhookStoreToLogStoreFunc) nextHook() func() database.OutboundWebhookLogStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OutboundWebhookStoreToLogStoreFunc) appendCall(r0 OutboundWebhookStoreToLogStoreFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of OutboundWebhookStoreToLogStoreFuncCall
+// objects describing the invocations of this function.
+func (f *OutboundWebhookStoreToLogStoreFunc) History() []OutboundWebhookStoreToLogStoreFuncCall {
+	f.mutex.Lock()
+	history := make([]OutboundWebhookStoreToLogStoreFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OutboundWebhookStoreToLogStoreFuncCall is an object that describes an
+// invocation of method ToLogStore on an instance of
+// MockOutboundWebhookStore.
+type OutboundWebhookStoreToLogStoreFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 database.OutboundWebhookLogStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreDoneFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c OutboundWebhookStoreToLogStoreFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreDoneFuncCall) Results() []interface{} {
+func (c OutboundWebhookStoreToLogStoreFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// OutboundWebhookStoreGetByIDFunc describes the behavior when the GetByID
+// OutboundWebhookStoreTransactFunc describes the behavior when the Transact
 // method of the parent MockOutboundWebhookStore instance is invoked.
-type OutboundWebhookStoreGetByIDFunc struct {
-	defaultHook func(context.Context, int64) (*types.OutboundWebhook, error)
-	hooks       []func(context.Context, int64) (*types.OutboundWebhook, error)
-	history     []OutboundWebhookStoreGetByIDFuncCall
+type OutboundWebhookStoreTransactFunc struct {
+	defaultHook func(context.Context) (database.OutboundWebhookStore, error)
+	hooks       []func(context.Context) (database.OutboundWebhookStore, error)
+	history     []OutboundWebhookStoreTransactFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByID delegates to the next hook function in the queue and stores the
+// Transact delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) GetByID(v0 context.Context, v1 int64) (*types.OutboundWebhook, error) {
-	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
-	m.GetByIDFunc.appendCall(OutboundWebhookStoreGetByIDFuncCall{v0, v1, r0, r1})
+func (m *MockOutboundWebhookStore) Transact(v0 context.Context) (database.OutboundWebhookStore, error) {
+	r0, r1 := m.TransactFunc.nextHook()(v0)
+	m.TransactFunc.appendCall(OutboundWebhookStoreTransactFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByID method of
+// SetDefaultHook sets function that is called when the Transact method of
 // the parent MockOutboundWebhookStore instance is invoked and the hook
 // queue is empty.
-func (f *OutboundWebhookStoreGetByIDFunc) SetDefaultHook(hook func(context.Context, int64) (*types.OutboundWebhook, error)) {
+func (f *OutboundWebhookStoreTransactFunc) SetDefaultHook(hook func(context.Context) (database.OutboundWebhookStore, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByID method of the parent MockOutboundWebhookStore instance invokes
+// Transact method of the parent MockOutboundWebhookStore instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreGetByIDFunc) PushHook(hook func(context.Context, int64) (*types.OutboundWebhook, error)) {
+func (f *OutboundWebhookStoreTransactFunc) PushHook(hook func(context.Context) (database.OutboundWebhookStore, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48548,20 +51747,20 @@ func (f *OutboundWebhookStoreGetByIDFunc) PushHook(hook func(context.Context, in
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreGetByIDFunc) SetDefaultReturn(r0 *types.OutboundWebhook, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64) (*types.OutboundWebhook, error) {
+func (f *OutboundWebhookStoreTransactFunc) SetDefaultReturn(r0 database.OutboundWebhookStore, r1 error) {
+	f.SetDefaultHook(func(context.Context) (database.OutboundWebhookStore, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreGetByIDFunc) PushReturn(r0 *types.OutboundWebhook, r1 error) {
-	f.PushHook(func(context.Context, int64) (*types.OutboundWebhook, error) {
+func (f *OutboundWebhookStoreTransactFunc) PushReturn(r0 database.OutboundWebhookStore, r1 error) {
+	f.PushHook(func(context.Context) (database.OutboundWebhookStore, error) {
 		return r0, r1
 	})
 }
 
-func (f *OutboundWebhookStoreGetByIDFunc) nextHook() func(context.Context, int64) (*types.OutboundWebhook, error) {
+func (f *OutboundWebhookStoreTransactFunc) nextHook() func(context.Context) (database.OutboundWebhookStore, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48574,35 +51773,32 @@ func (f *OutboundWebhookStoreGetByIDFunc) nextHook() func(context.Context, int64
 	return hook
 }
 
-func (f *OutboundWebhookStoreGetByIDFunc) appendCall(r0 OutboundWebhookStoreGetByIDFuncCall) {
+func (f *OutboundWebhookStoreTransactFunc) appendCall(r0 OutboundWebhookStoreTransactFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreGetByIDFuncCall objects
-// describing the invocations of this function.
-func (f *OutboundWebhookStoreGetByIDFunc) History() []OutboundWebhookStoreGetByIDFuncCall {
+// History returns a sequence of OutboundWebhookStoreTransactFuncCall
+// objects describing the invocations of this function.
+func (f *OutboundWebhookStoreTransactFunc) History() []OutboundWebhookStoreTransactFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreGetByIDFuncCall, len(f.history))
+	history := make([]OutboundWebhookStoreTransactFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreGetByIDFuncCall is an object that describes an
-// invocation of method GetByID on an instance of MockOutboundWebhookStore.
-type OutboundWebhookStoreGetByIDFuncCall struct {
+// OutboundWebhookStoreTransactFuncCall is an object that describes an
+// invocation of method Transact on an instance of MockOutboundWebhookStore.
+type OutboundWebhookStoreTransactFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.OutboundWebhook
+	Result0 database.OutboundWebhookStore
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -48610,45 +51806,45 @@ type OutboundWebhookStoreGetByIDFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreGetByIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c OutboundWebhookStoreTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreGetByIDFuncCall) Results() []interface{} {
+func (c OutboundWebhookStoreTransactFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// OutboundWebhookStoreHandleFunc describes the behavior when the Handle
+// OutboundWebhookStoreUpdateFunc describes the behavior when the Update
 // method of the parent MockOutboundWebhookStore instance is invoked.
-type OutboundWebhookStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []OutboundWebhookStoreHandleFuncCall
+type OutboundWebhookStoreUpdateFunc struct {
+	defaultHook func(context.Context, *types.OutboundWebhook) error
+	hooks       []func(context.Context, *types.OutboundWebhook) error
+	history     []OutboundWebhookStoreUpdateFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
+// Update delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(OutboundWebhookStoreHandleFuncCall{r0})
+func (m *MockOutboundWebhookStore) Update(v0 context.Context, v1 *types.OutboundWebhook) error {
+	r0 := m.UpdateFunc.nextHook()(v0, v1)
+	m.UpdateFunc.appendCall(OutboundWebhookStoreUpdateFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
+// SetDefaultHook sets function that is called when the Update method of the
 // parent MockOutboundWebhookStore instance is invoked and the hook queue is
 // empty.
-func (f *OutboundWebhookStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+func (f *OutboundWebhookStoreUpdateFunc) SetDefaultHook(hook func(context.Context, *types.OutboundWebhook) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockOutboundWebhookStore instance invokes the
+// Update method of the parent MockOutboundWebhookStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+func (f *OutboundWebhookStoreUpdateFunc) PushHook(hook func(context.Context, *types.OutboundWebhook) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48656,20 +51852,20 @@ func (f *OutboundWebhookStoreHandleFunc) PushHook(hook func() basestore.Transact
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
+func (f *OutboundWebhookStoreUpdateFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *types.OutboundWebhook) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
+func (f *OutboundWebhookStoreUpdateFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *types.OutboundWebhook) error {
 		return r0
 	})
 }
 
-func (f *OutboundWebhookStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *OutboundWebhookStoreUpdateFunc) nextHook() func(context.Context, *types.OutboundWebhook) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48682,72 +51878,78 @@ func (f *OutboundWebhookStoreHandleFunc) nextHook() func() basestore.Transactabl
 	return hook
 }
 
-func (f *OutboundWebhookStoreHandleFunc) appendCall(r0 OutboundWebhookStoreHandleFuncCall) {
+func (f *OutboundWebhookStoreUpdateFunc) appendCall(r0 OutboundWebhookStoreUpdateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreHandleFuncCall objects
+// History returns a sequence of OutboundWebhookStoreUpdateFuncCall objects
 // describing the invocations of this function.
-func (f *OutboundWebhookStoreHandleFunc) History() []OutboundWebhookStoreHandleFuncCall {
+func (f *OutboundWebhookStoreUpdateFunc) History() []OutboundWebhookStoreUpdateFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreHandleFuncCall, len(f.history))
+	history := make([]OutboundWebhookStoreUpdateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreHandleFuncCall is an object that describes an
-// invocation of method Handle on an instance of MockOutboundWebhookStore.
-type OutboundWebhookStoreHandleFuncCall struct {
+// OutboundWebhookStoreUpdateFuncCall is an object that describes an
+// invocation of method Update on an instance of MockOutboundWebhookStore.
+type OutboundWebhookStoreUpdateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *types.OutboundWebhook
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c OutboundWebhookStoreUpdateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreHandleFuncCall) Results() []interface{} {
+func (c OutboundWebhookStoreUpdateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// OutboundWebhookStoreListFunc describes the behavior when the List method
+// OutboundWebhookStoreWithFunc describes the behavior when the With method
 // of the parent MockOutboundWebhookStore instance is invoked.
-type OutboundWebhookStoreListFunc struct {
-	defaultHook func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error)
-	hooks       []func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error)
-	history     []OutboundWebhookStoreListFuncCall
+type OutboundWebhookStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) database.OutboundWebhookStore
+	hooks       []func(basestore.ShareableStore) database.OutboundWebhookStore
+	history     []OutboundWebhookStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
-// List delegates to the next hook function in the queue and stores the
+// With delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) List(v0 context.Context, v1 database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error) {
-	r0, r1 := m.ListFunc.nextHook()(v0, v1)
-	m.ListFunc.appendCall(OutboundWebhookStoreListFuncCall{v0, v1, r0, r1})
-	return r0, r1
+func (m *MockOutboundWebhookStore) With(v0 basestore.ShareableStore) database.OutboundWebhookStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(OutboundWebhookStoreWithFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the List method of the
+// SetDefaultHook sets function that is called when the With method of the
 // parent MockOutboundWebhookStore instance is invoked and the hook queue is
 // empty.
-func (f *OutboundWebhookStoreListFunc) SetDefaultHook(hook func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error)) {
+func (f *OutboundWebhookStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) database.OutboundWebhookStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// List method of the parent MockOutboundWebhookStore instance invokes the
+// With method of the parent MockOutboundWebhookStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreListFunc) PushHook(hook func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error)) {
+func (f *OutboundWebhookStoreWithFunc) PushHook(hook func(basestore.ShareableStore) database.OutboundWebhookStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48755,20 +51957,20 @@ func (f *OutboundWebhookStoreListFunc) PushHook(hook func(context.Context, datab
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreListFunc) SetDefaultReturn(r0 []*types.OutboundWebhook, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error) {
-		return r0, r1
+func (f *OutboundWebhookStoreWithFunc) SetDefaultReturn(r0 database.OutboundWebhookStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) database.OutboundWebhookStore {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreListFunc) PushReturn(r0 []*types.OutboundWebhook, r1 error) {
-	f.PushHook(func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error) {
-		return r0, r1
+func (f *OutboundWebhookStoreWithFunc) PushReturn(r0 database.OutboundWebhookStore) {
+	f.PushHook(func(basestore.ShareableStore) database.OutboundWebhookStore {
+		return r0
 	})
 }
 
-func (f *OutboundWebhookStoreListFunc) nextHook() func(context.Context, database.OutboundWebhookListOpts) ([]*types.OutboundWebhook, error) {
+func (f *OutboundWebhookStoreWithFunc) nextHook() func(basestore.ShareableStore) database.OutboundWebhookStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48781,81 +51983,202 @@ func (f *OutboundWebhookStoreListFunc) nextHook() func(context.Context, database
 	return hook
 }
 
-func (f *OutboundWebhookStoreListFunc) appendCall(r0 OutboundWebhookStoreListFuncCall) {
+func (f *OutboundWebhookStoreWithFunc) appendCall(r0 OutboundWebhookStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreListFuncCall objects
+// History returns a sequence of OutboundWebhookStoreWithFuncCall objects
 // describing the invocations of this function.
-func (f *OutboundWebhookStoreListFunc) History() []OutboundWebhookStoreListFuncCall {
+func (f *OutboundWebhookStoreWithFunc) History() []OutboundWebhookStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreListFuncCall, len(f.history))
+	history := make([]OutboundWebhookStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreListFuncCall is an object that describes an
-// invocation of method List on an instance of MockOutboundWebhookStore.
-type OutboundWebhookStoreListFuncCall struct {
+// OutboundWebhookStoreWithFuncCall is an object that describes an
+// invocation of method With on an instance of MockOutboundWebhookStore.
+type OutboundWebhookStoreWithFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 database.OutboundWebhookListOpts
+	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.OutboundWebhook
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 database.OutboundWebhookStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreListFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c OutboundWebhookStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreListFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c OutboundWebhookStoreWithFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// OutboundWebhookStoreQueryFunc describes the behavior when the Query
-// method of the parent MockOutboundWebhookStore instance is invoked.
-type OutboundWebhookStoreQueryFunc struct {
-	defaultHook func(context.Context, *sqlf.Query) (*sql.Rows, error)
-	hooks       []func(context.Context, *sqlf.Query) (*sql.Rows, error)
-	history     []OutboundWebhookStoreQueryFuncCall
+// MockOwnCoverageReportStore is a mock implementation of the
+// OwnCoverageReportStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockOwnCoverageReportStore struct {
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *OwnCoverageReportStoreCountFunc
+	// GetByRepoIDFunc is an instance of a mock function object controlling
+	// the behavior of the method GetByRepoID.
+	GetByRepoIDFunc *OwnCoverageReportStoreGetByRepoIDFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *OwnCoverageReportStoreHandleFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *OwnCoverageReportStoreListFunc
+	// UpsertFunc is an instance of a mock function object controlling the
+	// behavior of the method Upsert.
+	UpsertFunc *OwnCoverageReportStoreUpsertFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *OwnCoverageReportStoreWithFunc
+}
+
+// NewMockOwnCoverageReportStore creates a new mock of the
+// OwnCoverageReportStore interface. All methods return zero values for all
+// results, unless overwritten.
+func NewMockOwnCoverageReportStore() *MockOwnCoverageReportStore {
+	return &MockOwnCoverageReportStore{
+		CountFunc: &OwnCoverageReportStoreCountFunc{
+			defaultHook: func(context.Context) (r0 int, r1 error) {
+				return
+			},
+		},
+		GetByRepoIDFunc: &OwnCoverageReportStoreGetByRepoIDFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 *types1.OwnCoverageReport, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &OwnCoverageReportStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListFunc: &OwnCoverageReportStoreListFunc{
+			defaultHook: func(context.Context, database.ListOwnCoverageReportsOpts) (r0 []*types1.OwnCoverageReport, r1 error) {
+				return
+			},
+		},
+		UpsertFunc: &OwnCoverageReportStoreUpsertFunc{
+			defaultHook: func(context.Context, *types1.OwnCoverageReport) (r0 error) {
+				return
+			},
+		},
+		WithFunc: &OwnCoverageReportStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 database.OwnCoverageReportStore) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockOwnCoverageReportStore creates a new mock of the
+// OwnCoverageReportStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockOwnCoverageReportStore() *MockOwnCoverageReportStore {
+	return &MockOwnCoverageReportStore{
+		CountFunc: &OwnCoverageReportStoreCountFunc{
+			defaultHook: func(context.Context) (int, error) {
+				panic("unexpected invocation of MockOwnCoverageReportStore.Count")
+			},
+		},
+		GetByRepoIDFunc: &OwnCoverageReportStoreGetByRepoIDFunc{
+			defaultHook: func(context.Context, api.RepoID) (*types1.OwnCoverageReport, error) {
+				panic("unexpected invocation of MockOwnCoverageReportStore.GetByRepoID")
+			},
+		},
+		HandleFunc: &OwnCoverageReportStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockOwnCoverageReportStore.Handle")
+			},
+		},
+		ListFunc: &OwnCoverageReportStoreListFunc{
+			defaultHook: func(context.Context, database.ListOwnCoverageReportsOpts) ([]*types1.OwnCoverageReport, error) {
+				panic("unexpected invocation of MockOwnCoverageReportStore.List")
+			},
+		},
+		UpsertFunc: &OwnCoverageReportStoreUpsertFunc{
+			defaultHook: func(context.Context, *types1.OwnCoverageReport) error {
+				panic("unexpected invocation of MockOwnCoverageReportStore.Upsert")
+			},
+		},
+		WithFunc: &OwnCoverageReportStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) database.OwnCoverageReportStore {
+				panic("unexpected invocation of MockOwnCoverageReportStore.With")
+			},
+		},
+	}
+}
+
+// NewMockOwnCoverageReportStoreFrom creates a new mock of the
+// MockOwnCoverageReportStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockOwnCoverageReportStoreFrom(i database.OwnCoverageReportStore) *MockOwnCoverageReportStore {
+	return &MockOwnCoverageReportStore{
+		CountFunc: &OwnCoverageReportStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		GetByRepoIDFunc: &OwnCoverageReportStoreGetByRepoIDFunc{
+			defaultHook: i.GetByRepoID,
+		},
+		HandleFunc: &OwnCoverageReportStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListFunc: &OwnCoverageReportStoreListFunc{
+			defaultHook: i.List,
+		},
+		UpsertFunc: &OwnCoverageReportStoreUpsertFunc{
+			defaultHook: i.Upsert,
+		},
+		WithFunc: &OwnCoverageReportStoreWithFunc{
+			defaultHook: i.With,
+		},
+	}
+}
+
+// OwnCoverageReportStoreCountFunc describes the behavior when the Count
+// method of the parent MockOwnCoverageReportStore instance is invoked.
+type OwnCoverageReportStoreCountFunc struct {
+	defaultHook func(context.Context) (int, error)
+	hooks       []func(context.Context) (int, error)
+	history     []OwnCoverageReportStoreCountFuncCall
 	mutex       sync.Mutex
 }
 
-// Query delegates to the next hook function in the queue and stores the
+// Count delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) Query(v0 context.Context, v1 *sqlf.Query) (*sql.Rows, error) {
-	r0, r1 := m.QueryFunc.nextHook()(v0, v1)
-	m.QueryFunc.appendCall(OutboundWebhookStoreQueryFuncCall{v0, v1, r0, r1})
+func (m *MockOwnCoverageReportStore) Count(v0 context.Context) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0)
+	m.CountFunc.appendCall(OwnCoverageReportStoreCountFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Query method of the
-// parent MockOutboundWebhookStore instance is invoked and the hook queue is
-// empty.
-func (f *OutboundWebhookStoreQueryFunc) SetDefaultHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockOwnCoverageReportStore instance is invoked and the hook queue
+// is empty.
+func (f *OwnCoverageReportStoreCountFunc) SetDefaultHook(hook func(context.Context) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Query method of the parent MockOutboundWebhookStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreQueryFunc) PushHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
+// Count method of the parent MockOwnCoverageReportStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *OwnCoverageReportStoreCountFunc) PushHook(hook func(context.Context) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48863,20 +52186,20 @@ func (f *OutboundWebhookStoreQueryFunc) PushHook(hook func(context.Context, *sql
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreQueryFunc) SetDefaultReturn(r0 *sql.Rows, r1 error) {
-	f.SetDefaultHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+func (f *OwnCoverageReportStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreQueryFunc) PushReturn(r0 *sql.Rows, r1 error) {
-	f.PushHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+func (f *OwnCoverageReportStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *OutboundWebhookStoreQueryFunc) nextHook() func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+func (f *OwnCoverageReportStoreCountFunc) nextHook() func(context.Context) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48889,35 +52212,32 @@ func (f *OutboundWebhookStoreQueryFunc) nextHook() func(context.Context, *sqlf.Q
 	return hook
 }
 
-func (f *OutboundWebhookStoreQueryFunc) appendCall(r0 OutboundWebhookStoreQueryFuncCall) {
+func (f *OwnCoverageReportStoreCountFunc) appendCall(r0 OwnCoverageReportStoreCountFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreQueryFuncCall objects
+// History returns a sequence of OwnCoverageReportStoreCountFuncCall objects
 // describing the invocations of this function.
-func (f *OutboundWebhookStoreQueryFunc) History() []OutboundWebhookStoreQueryFuncCall {
+func (f *OwnCoverageReportStoreCountFunc) History() []OwnCoverageReportStoreCountFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreQueryFuncCall, len(f.history))
+	history := make([]OwnCoverageReportStoreCountFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreQueryFuncCall is an object that describes an
-// invocation of method Query on an instance of MockOutboundWebhookStore.
-type OutboundWebhookStoreQueryFuncCall struct {
+// OwnCoverageReportStoreCountFuncCall is an object that describes an
+// invocation of method Count on an instance of MockOwnCoverageReportStore.
+type OwnCoverageReportStoreCountFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 *sqlf.Query
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *sql.Rows
+	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -48925,46 +52245,47 @@ type OutboundWebhookStoreQueryFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreQueryFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c OwnCoverageReportStoreCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreQueryFuncCall) Results() []interface{} {
+func (c OwnCoverageReportStoreCountFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// OutboundWebhookStoreToJobStoreFunc describes the behavior when the
-// ToJobStore method of the parent MockOutboundWebhookStore instance is
+// OwnCoverageReportStoreGetByRepoIDFunc describes the behavior when the
+// GetByRepoID method of the parent MockOwnCoverageReportStore instance is
 // invoked.
-type OutboundWebhookStoreToJobStoreFunc struct {
-	defaultHook func() database.OutboundWebhookJobStore
-	hooks       []func() database.OutboundWebhookJobStore
-	history     []OutboundWebhookStoreToJobStoreFuncCall
+type OwnCoverageReportStoreGetByRepoIDFunc struct {
+	defaultHook func(context.Context, api.RepoID) (*types1.OwnCoverageReport, error)
+	hooks       []func(context.Context, api.RepoID) (*types1.OwnCoverageReport, error)
+	history     []OwnCoverageReportStoreGetByRepoIDFuncCall
 	mutex       sync.Mutex
 }
 
-// ToJobStore delegates to the next hook function in the queue and stores
+// GetByRepoID delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) ToJobStore() database.OutboundWebhookJobStore {
-	r0 := m.ToJobStoreFunc.nextHook()()
-	m.ToJobStoreFunc.appendCall(OutboundWebhookStoreToJobStoreFuncCall{r0})
-	return r0
+func (m *MockOwnCoverageReportStore) GetByRepoID(v0 context.Context, v1 api.RepoID) (*types1.OwnCoverageReport, error) {
+	r0, r1 := m.GetByRepoIDFunc.nextHook()(v0, v1)
+	m.GetByRepoIDFunc.appendCall(OwnCoverageReportStoreGetByRepoIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ToJobStore method of
-// the parent MockOutboundWebhookStore instance is invoked and the hook
+// SetDefaultHook sets function that is called when the GetByRepoID method
+// of the parent MockOwnCoverageReportStore instance is invoked and the hook
 // queue is empty.
-func (f *OutboundWebhookStoreToJobStoreFunc) SetDefaultHook(hook func() database.OutboundWebhookJobStore) {
+func (f *OwnCoverageReportStoreGetByRepoIDFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*types1.OwnCoverageReport, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ToJobStore method of the parent MockOutboundWebhookStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreToJobStoreFunc) PushHook(hook func() database.OutboundWebhookJobStore) {
+// GetByRepoID method of the parent MockOwnCoverageReportStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *OwnCoverageReportStoreGetByRepoIDFunc) PushHook(hook func(context.Context, api.RepoID) (*types1.OwnCoverageReport, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -48972,20 +52293,20 @@ func (f *OutboundWebhookStoreToJobStoreFunc) PushHook(hook func() database.Outbo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreToJobStoreFunc) SetDefaultReturn(r0 database.OutboundWebhookJobStore) {
-	f.SetDefaultHook(func() database.OutboundWebhookJobStore {
-		return r0
+func (f *OwnCoverageReportStoreGetByRepoIDFunc) SetDefaultReturn(r0 *types1.OwnCoverageReport, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (*types1.OwnCoverageReport, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreToJobStoreFunc) PushReturn(r0 database.OutboundWebhookJobStore) {
-	f.PushHook(func() database.OutboundWebhookJobStore {
-		return r0
+func (f *OwnCoverageReportStoreGetByRepoIDFunc) PushReturn(r0 *types1.OwnCoverageReport, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (*types1.OwnCoverageReport, error) {
+		return r0, r1
 	})
 }
 
-func (f *OutboundWebhookStoreToJobStoreFunc) nextHook() func() database.OutboundWebhookJobStore {
+func (f *OwnCoverageReportStoreGetByRepoIDFunc) nextHook() func(context.Context, api.RepoID) (*types1.OwnCoverageReport, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -48998,74 +52319,82 @@ func (f *OutboundWebhookStoreToJobStoreFunc) nextHook() func() database.Outbound
 	return hook
 }
 
-func (f *OutboundWebhookStoreToJobStoreFunc) appendCall(r0 OutboundWebhookStoreToJobStoreFuncCall) {
+func (f *OwnCoverageReportStoreGetByRepoIDFunc) appendCall(r0 OwnCoverageReportStoreGetByRepoIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreToJobStoreFuncCall
+// History returns a sequence of OwnCoverageReportStoreGetByRepoIDFuncCall
 // objects describing the invocations of this function.
-func (f *OutboundWebhookStoreToJobStoreFunc) History() []OutboundWebhookStoreToJobStoreFuncCall {
+func (f *OwnCoverageReportStoreGetByRepoIDFunc) History() []OwnCoverageReportStoreGetByRepoIDFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreToJobStoreFuncCall, len(f.history))
+	history := make([]OwnCoverageReportStoreGetByRepoIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreToJobStoreFuncCall is an object that describes an
-// invocation of method ToJobStore on an instance of
-// MockOutboundWebhookStore.
-type OutboundWebhookStoreToJobStoreFuncCall struct {
+// OwnCoverageReportStoreGetByRepoIDFuncCall is an object that describes an
+// invocation of method GetByRepoID on an instance of
+// MockOwnCoverageReportStore.
+type OwnCoverageReportStoreGetByRepoIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.OutboundWebhookJobStore
+	Result0 *types1.OwnCoverageReport
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreToJobStoreFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c OwnCoverageReportStoreGetByRepoIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreToJobStoreFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c OwnCoverageReportStoreGetByRepoIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// OutboundWebhookStoreToLogStoreFunc describes the behavior when the
-// ToLogStore method of the parent MockOutboundWebhookStore instance is
-// invoked.
-type OutboundWebhookStoreToLogStoreFunc struct {
-	defaultHook func() database.OutboundWebhookLogStore
-	hooks       []func() database.OutboundWebhookLogStore
-	history     []OutboundWebhookStoreToLogStoreFuncCall
+// OwnCoverageReportStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockOwnCoverageReportStore instance is invoked.
+type OwnCoverageReportStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []OwnCoverageReportStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// ToLogStore delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) ToLogStore() database.OutboundWebhookLogStore {
-	r0 := m.ToLogStoreFunc.nextHook()()
-	m.ToLogStoreFunc.appendCall(OutboundWebhookStoreToLogStoreFuncCall{r0})
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockOwnCoverageReportStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(OwnCoverageReportStoreHandleFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the ToLogStore method of
-// the parent MockOutboundWebhookStore instance is invoked and the hook
-// queue is empty.
-func (f *OutboundWebhookStoreToLogStoreFunc) SetDefaultHook(hook func() database.OutboundWebhookLogStore) {
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockOwnCoverageReportStore instance is invoked and the hook queue
+// is empty.
+func (f *OwnCoverageReportStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ToLogStore method of the parent MockOutboundWebhookStore instance invokes
+// Handle method of the parent MockOwnCoverageReportStore instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreToLogStoreFunc) PushHook(hook func() database.OutboundWebhookLogStore) {
+func (f *OwnCoverageReportStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -49073,20 +52402,20 @@ func (f *OutboundWebhookStoreToLogStoreFunc) PushHook(hook func() database.Outbo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreToLogStoreFunc) SetDefaultReturn(r0 database.OutboundWebhookLogStore) {
-	f.SetDefaultHook(func() database.OutboundWebhookLogStore {
+func (f *OwnCoverageReportStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreToLogStoreFunc) PushReturn(r0 database.OutboundWebhookLogStore) {
-	f.PushHook(func() database.OutboundWebhookLogStore {
+func (f *OwnCoverageReportStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *OutboundWebhookStoreToLogStoreFunc) nextHook() func() database.OutboundWebhookLogStore {
+func (f *OwnCoverageReportStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -49099,73 +52428,72 @@ func (f *OutboundWebhookStoreToLogStoreFunc) nextHook() func() database.Outbound
 	return hook
 }
 
-func (f *OutboundWebhookStoreToLogStoreFunc) appendCall(r0 OutboundWebhookStoreToLogStoreFuncCall) {
+func (f *OwnCoverageReportStoreHandleFunc) appendCall(r0 OwnCoverageReportStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreToLogStoreFuncCall
+// History returns a sequence of OwnCoverageReportStoreHandleFuncCall
 // objects describing the invocations of this function.
-func (f *OutboundWebhookStoreToLogStoreFunc) History() []OutboundWebhookStoreToLogStoreFuncCall {
+func (f *OwnCoverageReportStoreHandleFunc) History() []OwnCoverageReportStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreToLogStoreFuncCall, len(f.history))
+	history := make([]OwnCoverageReportStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreToLogStoreFuncCall is an object that describes an
-// invocation of method ToLogStore on an instance of
-// MockOutboundWebhookStore.
-type OutboundWebhookStoreToLogStoreFuncCall struct {
+// OwnCoverageReportStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockOwnCoverageReportStore.
+type OwnCoverageReportStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.OutboundWebhookLogStore
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreToLogStoreFuncCall) Args() []interface{} {
+func (c OwnCoverageReportStoreHandleFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreToLogStoreFuncCall) Results() []interface{} {
+func (c OwnCoverageReportStoreHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// OutboundWebhookStoreTransactFunc describes the behavior when the Transact
-// method of the parent MockOutboundWebhookStore instance is invoked.
-type OutboundWebhookStoreTransactFunc struct {
-	defaultHook func(context.Context) (database.OutboundWebhookStore, error)
-	hooks       []func(context.Context) (database.OutboundWebhookStore, error)
-	history     []OutboundWebhookStoreTransactFuncCall
+// OwnCoverageReportStoreListFunc describes the behavior when the List
+// method of the parent MockOwnCoverageReportStore instance is invoked.
+type OwnCoverageReportStoreListFunc struct {
+	defaultHook func(context.Context, database.ListOwnCoverageReportsOpts) ([]*types1.OwnCoverageReport, error)
+	hooks       []func(context.Context, database.ListOwnCoverageReportsOpts) ([]*types1.OwnCoverageReport, error)
+	history     []OwnCoverageReportStoreListFuncCall
 	mutex       sync.Mutex
 }
 
-// Transact delegates to the next hook function in the queue and stores the
+// List delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) Transact(v0 context.Context) (database.OutboundWebhookStore, error) {
-	r0, r1 := m.TransactFunc.nextHook()(v0)
-	m.TransactFunc.appendCall(OutboundWebhookStoreTransactFuncCall{v0, r0, r1})
+func (m *MockOwnCoverageReportStore) List(v0 context.Context, v1 database.ListOwnCoverageReportsOpts) ([]*types1.OwnCoverageReport, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(OwnCoverageReportStoreListFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Transact method of
-// the parent MockOutboundWebhookStore instance is invoked and the hook
-// queue is empty.
-func (f *OutboundWebhookStoreTransactFunc) SetDefaultHook(hook func(context.Context) (database.OutboundWebhookStore, error)) {
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockOwnCoverageReportStore instance is invoked and the hook queue
+// is empty.
+func (f *OwnCoverageReportStoreListFunc) SetDefaultHook(hook func(context.Context, database.ListOwnCoverageReportsOpts) ([]*types1.OwnCoverageReport, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Transact method of the parent MockOutboundWebhookStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreTransactFunc) PushHook(hook func(context.Context) (database.OutboundWebhookStore, error)) {
+// List method of the parent MockOwnCoverageReportStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *OwnCoverageReportStoreListFunc) PushHook(hook func(context.Context, database.ListOwnCoverageReportsOpts) ([]*types1.OwnCoverageReport, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -49173,20 +52501,20 @@ func (f *OutboundWebhookStoreTransactFunc) PushHook(hook func(context.Context) (
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreTransactFunc) SetDefaultReturn(r0 database.OutboundWebhookStore, r1 error) {
-	f.SetDefaultHook(func(context.Context) (database.OutboundWebhookStore, error) {
+func (f *OwnCoverageReportStoreListFunc) SetDefaultReturn(r0 []*types1.OwnCoverageReport, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListOwnCoverageReportsOpts) ([]*types1.OwnCoverageReport, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreTransactFunc) PushReturn(r0 database.OutboundWebhookStore, r1 error) {
-	f.PushHook(func(context.Context) (database.OutboundWebhookStore, error) {
+func (f *OwnCoverageReportStoreListFunc) PushReturn(r0 []*types1.OwnCoverageReport, r1 error) {
+	f.PushHook(func(context.Context, database.ListOwnCoverageReportsOpts) ([]*types1.OwnCoverageReport, error) {
 		return r0, r1
 	})
 }
 
-func (f *OutboundWebhookStoreTransactFunc) nextHook() func(context.Context) (database.OutboundWebhookStore, error) {
+func (f *OwnCoverageReportStoreListFunc) nextHook() func(context.Context, database.ListOwnCoverageReportsOpts) ([]*types1.OwnCoverageReport, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -49199,32 +52527,35 @@ func (f *OutboundWebhookStoreTransactFunc) nextHook() func(context.Context) (dat
 	return hook
 }
 
-func (f *OutboundWebhookStoreTransactFunc) appendCall(r0 OutboundWebhookStoreTransactFuncCall) {
+func (f *OwnCoverageReportStoreListFunc) appendCall(r0 OwnCoverageReportStoreListFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreTransactFuncCall
-// objects describing the invocations of this function.
-func (f *OutboundWebhookStoreTransactFunc) History() []OutboundWebhookStoreTransactFuncCall {
+// History returns a sequence of OwnCoverageReportStoreListFuncCall objects
+// describing the invocations of this function.
+func (f *OwnCoverageReportStoreListFunc) History() []OwnCoverageReportStoreListFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreTransactFuncCall, len(f.history))
+	history := make([]OwnCoverageReportStoreListFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreTransactFuncCall is an object that describes an
-// invocation of method Transact on an instance of MockOutboundWebhookStore.
-type OutboundWebhookStoreTransactFuncCall struct {
+// OwnCoverageReportStoreListFuncCall is an object that describes an
+// invocation of method List on an instance of MockOwnCoverageReportStore.
+type OwnCoverageReportStoreListFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ListOwnCoverageReportsOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.OutboundWebhookStore
+	Result0 []*types1.OwnCoverageReport
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -49232,45 +52563,45 @@ type OutboundWebhookStoreTransactFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreTransactFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c OwnCoverageReportStoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreTransactFuncCall) Results() []interface{} {
+func (c OwnCoverageReportStoreListFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// OutboundWebhookStoreUpdateFunc describes the behavior when the Update
-// method of the parent MockOutboundWebhookStore instance is invoked.
-type OutboundWebhookStoreUpdateFunc struct {
-	defaultHook func(context.Context, *types.OutboundWebhook) error
-	hooks       []func(context.Context, *types.OutboundWebhook) error
-	history     []OutboundWebhookStoreUpdateFuncCall
+// OwnCoverageReportStoreUpsertFunc describes the behavior when the Upsert
+// method of the parent MockOwnCoverageReportStore instance is invoked.
+type OwnCoverageReportStoreUpsertFunc struct {
+	defaultHook func(context.Context, *types1.OwnCoverageReport) error
+	hooks       []func(context.Context, *types1.OwnCoverageReport) error
+	history     []OwnCoverageReportStoreUpsertFuncCall
 	mutex       sync.Mutex
 }
 
-// Update delegates to the next hook function in the queue and stores the
+// Upsert delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) Update(v0 context.Context, v1 *types.OutboundWebhook) error {
-	r0 := m.UpdateFunc.nextHook()(v0, v1)
-	m.UpdateFunc.appendCall(OutboundWebhookStoreUpdateFuncCall{v0, v1, r0})
+func (m *MockOwnCoverageReportStore) Upsert(v0 context.Context, v1 *types1.OwnCoverageReport) error {
+	r0 := m.UpsertFunc.nextHook()(v0, v1)
+	m.UpsertFunc.appendCall(OwnCoverageReportStoreUpsertFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Update method of the
-// parent MockOutboundWebhookStore instance is invoked and the hook queue is
-// empty.
-func (f *OutboundWebhookStoreUpdateFunc) SetDefaultHook(hook func(context.Context, *types.OutboundWebhook) error) {
+// SetDefaultHook sets function that is called when the Upsert method of the
+// parent MockOwnCoverageReportStore instance is invoked and the hook queue
+// is empty.
+func (f *OwnCoverageReportStoreUpsertFunc) SetDefaultHook(hook func(context.Context, *types1.OwnCoverageReport) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Update method of the parent MockOutboundWebhookStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreUpdateFunc) PushHook(hook func(context.Context, *types.OutboundWebhook) error) {
+// Upsert method of the parent MockOwnCoverageReportStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *OwnCoverageReportStoreUpsertFunc) PushHook(hook func(context.Context, *types1.OwnCoverageReport) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -49278,20 +52609,20 @@ func (f *OutboundWebhookStoreUpdateFunc) PushHook(hook func(context.Context, *ty
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreUpdateFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, *types.OutboundWebhook) error {
+func (f *OwnCoverageReportStoreUpsertFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *types1.OwnCoverageReport) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreUpdateFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, *types.OutboundWebhook) error {
+func (f *OwnCoverageReportStoreUpsertFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *types1.OwnCoverageReport) error {
 		return r0
 	})
 }
 
-func (f *OutboundWebhookStoreUpdateFunc) nextHook() func(context.Context, *types.OutboundWebhook) error {
+func (f *OwnCoverageReportStoreUpsertFunc) nextHook() func(context.Context, *types1.OwnCoverageReport) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -49304,32 +52635,32 @@ func (f *OutboundWebhookStoreUpdateFunc) nextHook() func(context.Context, *types
 	return hook
 }
 
-func (f *OutboundWebhookStoreUpdateFunc) appendCall(r0 OutboundWebhookStoreUpdateFuncCall) {
+func (f *OwnCoverageReportStoreUpsertFunc) appendCall(r0 OwnCoverageReportStoreUpsertFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreUpdateFuncCall objects
-// describing the invocations of this function.
-func (f *OutboundWebhookStoreUpdateFunc) History() []OutboundWebhookStoreUpdateFuncCall {
+// History returns a sequence of OwnCoverageReportStoreUpsertFuncCall
+// objects describing the invocations of this function.
+func (f *OwnCoverageReportStoreUpsertFunc) History() []OwnCoverageReportStoreUpsertFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreUpdateFuncCall, len(f.history))
+	history := make([]OwnCoverageReportStoreUpsertFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreUpdateFuncCall is an object that describes an
-// invocation of method Update on an instance of MockOutboundWebhookStore.
-type OutboundWebhookStoreUpdateFuncCall struct {
+// OwnCoverageReportStoreUpsertFuncCall is an object that describes an
+// invocation of method Upsert on an instance of MockOwnCoverageReportStore.
+type OwnCoverageReportStoreUpsertFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *types.OutboundWebhook
+	Arg1 *types1.OwnCoverageReport
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -49337,45 +52668,45 @@ type OutboundWebhookStoreUpdateFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreUpdateFuncCall) Args() []interface{} {
+func (c OwnCoverageReportStoreUpsertFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreUpdateFuncCall) Results() []interface{} {
+func (c OwnCoverageReportStoreUpsertFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// OutboundWebhookStoreWithFunc describes the behavior when the With method
-// of the parent MockOutboundWebhookStore instance is invoked.
-type OutboundWebhookStoreWithFunc struct {
-	defaultHook func(basestore.ShareableStore) database.OutboundWebhookStore
-	hooks       []func(basestore.ShareableStore) database.OutboundWebhookStore
-	history     []OutboundWebhookStoreWithFuncCall
+// OwnCoverageReportStoreWithFunc describes the behavior when the With
+// method of the parent MockOwnCoverageReportStore instance is invoked.
+type OwnCoverageReportStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) database.OwnCoverageReportStore
+	hooks       []func(basestore.ShareableStore) database.OwnCoverageReportStore
+	history     []OwnCoverageReportStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
 // With delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockOutboundWebhookStore) With(v0 basestore.ShareableStore) database.OutboundWebhookStore {
+func (m *MockOwnCoverageReportStore) With(v0 basestore.ShareableStore) database.OwnCoverageReportStore {
 	r0 := m.WithFunc.nextHook()(v0)
-	m.WithFunc.appendCall(OutboundWebhookStoreWithFuncCall{v0, r0})
+	m.WithFunc.appendCall(OwnCoverageReportStoreWithFuncCall{v0, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the With method of the
-// parent MockOutboundWebhookStore instance is invoked and the hook queue is
-// empty.
-func (f *OutboundWebhookStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) database.OutboundWebhookStore) {
+// parent MockOwnCoverageReportStore instance is invoked and the hook queue
+// is empty.
+func (f *OwnCoverageReportStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) database.OwnCoverageReportStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// With method of the parent MockOutboundWebhookStore instance invokes the
+// With method of the parent MockOwnCoverageReportStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *OutboundWebhookStoreWithFunc) PushHook(hook func(basestore.ShareableStore) database.OutboundWebhookStore) {
+func (f *OwnCoverageReportStoreWithFunc) PushHook(hook func(basestore.ShareableStore) database.OwnCoverageReportStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -49383,20 +52714,20 @@ func (f *OutboundWebhookStoreWithFunc) PushHook(hook func(basestore.ShareableSto
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *OutboundWebhookStoreWithFunc) SetDefaultReturn(r0 database.OutboundWebhookStore) {
-	f.SetDefaultHook(func(basestore.ShareableStore) database.OutboundWebhookStore {
+func (f *OwnCoverageReportStoreWithFunc) SetDefaultReturn(r0 database.OwnCoverageReportStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) database.OwnCoverageReportStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *OutboundWebhookStoreWithFunc) PushReturn(r0 database.OutboundWebhookStore) {
-	f.PushHook(func(basestore.ShareableStore) database.OutboundWebhookStore {
+func (f *OwnCoverageReportStoreWithFunc) PushReturn(r0 database.OwnCoverageReportStore) {
+	f.PushHook(func(basestore.ShareableStore) database.OwnCoverageReportStore {
 		return r0
 	})
 }
 
-func (f *OutboundWebhookStoreWithFunc) nextHook() func(basestore.ShareableStore) database.OutboundWebhookStore {
+func (f *OwnCoverageReportStoreWithFunc) nextHook() func(basestore.ShareableStore) database.OwnCoverageReportStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -49409,43 +52740,43 @@ func (f *OutboundWebhookStoreWithFunc) nextHook() func(basestore.ShareableStore)
 	return hook
 }
 
-func (f *OutboundWebhookStoreWithFunc) appendCall(r0 OutboundWebhookStoreWithFuncCall) {
+func (f *OwnCoverageReportStoreWithFunc) appendCall(r0 OwnCoverageReportStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of OutboundWebhookStoreWithFuncCall objects
+// History returns a sequence of OwnCoverageReportStoreWithFuncCall objects
 // describing the invocations of this function.
-func (f *OutboundWebhookStoreWithFunc) History() []OutboundWebhookStoreWithFuncCall {
+func (f *OwnCoverageReportStoreWithFunc) History() []OwnCoverageReportStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]OutboundWebhookStoreWithFuncCall, len(f.history))
+	history := make([]OwnCoverageReportStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// OutboundWebhookStoreWithFuncCall is an object that describes an
-// invocation of method With on an instance of MockOutboundWebhookStore.
-type OutboundWebhookStoreWithFuncCall struct {
+// OwnCoverageReportStoreWithFuncCall is an object that describes an
+// invocation of method With on an instance of MockOwnCoverageReportStore.
+type OwnCoverageReportStoreWithFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.OutboundWebhookStore
+	Result0 database.OwnCoverageReportStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c OutboundWebhookStoreWithFuncCall) Args() []interface{} {
+func (c OwnCoverageReportStoreWithFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c OutboundWebhookStoreWithFuncCall) Results() []interface{} {
+func (c OwnCoverageReportStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
@@ -59110,6 +62441,430 @@ func (c RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall) Results() []
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// MockRepoCommitsHgChangesetsStore is a mock implementation of the
+// RepoCommitsHgChangesetsStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockRepoCommitsHgChangesetsStore struct {
+	// BatchInsertCommitSHAsWithHgChangesetIDFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// BatchInsertCommitSHAsWithHgChangesetID.
+	BatchInsertCommitSHAsWithHgChangesetIDFunc *RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc
+	// GetLatestForRepoFunc is an instance of a mock function object
+	// controlling the behavior of the method GetLatestForRepo.
+	GetLatestForRepoFunc *RepoCommitsHgChangesetsStoreGetLatestForRepoFunc
+	// GetRepoCommitHgChangesetFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRepoCommitHgChangeset.
+	GetRepoCommitHgChangesetFunc *RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc
+}
+
+// NewMockRepoCommitsHgChangesetsStore creates a new mock of the
+// RepoCommitsHgChangesetsStore interface. All methods return zero values
+// for all results, unless overwritten.
+func NewMockRepoCommitsHgChangesetsStore() *MockRepoCommitsHgChangesetsStore {
+	return &MockRepoCommitsHgChangesetsStore{
+		BatchInsertCommitSHAsWithHgChangesetIDFunc: &RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc{
+			defaultHook: func(context.Context, api.RepoID, []types.HgChangeset) (r0 error) {
+				return
+			},
+		},
+		GetLatestForRepoFunc: &RepoCommitsHgChangesetsStoreGetLatestForRepoFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 *types.RepoCommitHgChangeset, r1 error) {
+				return
+			},
+		},
+		GetRepoCommitHgChangesetFunc: &RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc{
+			defaultHook: func(context.Context, api.RepoID, string) (r0 *types.RepoCommitHgChangeset, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRepoCommitsHgChangesetsStore creates a new mock of the
+// RepoCommitsHgChangesetsStore interface. All methods panic on invocation,
+// unless overwritten.
+func NewStrictMockRepoCommitsHgChangesetsStore() *MockRepoCommitsHgChangesetsStore {
+	return &MockRepoCommitsHgChangesetsStore{
+		BatchInsertCommitSHAsWithHgChangesetIDFunc: &RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc{
+			defaultHook: func(context.Context, api.RepoID, []types.HgChangeset) error {
+				panic("unexpected invocation of MockRepoCommitsHgChangesetsStore.BatchInsertCommitSHAsWithHgChangesetID")
+			},
+		},
+		GetLatestForRepoFunc: &RepoCommitsHgChangesetsStoreGetLatestForRepoFunc{
+			defaultHook: func(context.Context, api.RepoID) (*types.RepoCommitHgChangeset, error) {
+				panic("unexpected invocation of MockRepoCommitsHgChangesetsStore.GetLatestForRepo")
+			},
+		},
+		GetRepoCommitHgChangesetFunc: &RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc{
+			defaultHook: func(context.Context, api.RepoID, string) (*types.RepoCommitHgChangeset, error) {
+				panic("unexpected invocation of MockRepoCommitsHgChangesetsStore.GetRepoCommitHgChangeset")
+			},
+		},
+	}
+}
+
+// NewMockRepoCommitsHgChangesetsStoreFrom creates a new mock of the
+// MockRepoCommitsHgChangesetsStore interface. All methods delegate to the
+// given implementation, unless overwritten.
+func NewMockRepoCommitsHgChangesetsStoreFrom(i database.RepoCommitsHgChangesetsStore) *MockRepoCommitsHgChangesetsStore {
+	return &MockRepoCommitsHgChangesetsStore{
+		BatchInsertCommitSHAsWithHgChangesetIDFunc: &RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc{
+			defaultHook: i.BatchInsertCommitSHAsWithHgChangesetID,
+		},
+		GetLatestForRepoFunc: &RepoCommitsHgChangesetsStoreGetLatestForRepoFunc{
+			defaultHook: i.GetLatestForRepo,
+		},
+		GetRepoCommitHgChangesetFunc: &RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc{
+			defaultHook: i.GetRepoCommitHgChangeset,
+		},
+	}
+}
+
+// RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc
+// describes the behavior when the BatchInsertCommitSHAsWithHgChangesetID
+// method of the parent MockRepoCommitsHgChangesetsStore instance is
+// invoked.
+type RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc struct {
+	defaultHook func(context.Context, api.RepoID, []types.HgChangeset) error
+	hooks       []func(context.Context, api.RepoID, []types.HgChangeset) error
+	history     []RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFuncCall
+	mutex       sync.Mutex
+}
+
+// BatchInsertCommitSHAsWithHgChangesetID delegates to the next hook
+// function in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockRepoCommitsHgChangesetsStore) BatchInsertCommitSHAsWithHgChangesetID(v0 context.Context, v1 api.RepoID, v2 []types.HgChangeset) error {
+	r0 := m.BatchInsertCommitSHAsWithHgChangesetIDFunc.nextHook()(v0, v1, v2)
+	m.BatchInsertCommitSHAsWithHgChangesetIDFunc.appendCall(RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// BatchInsertCommitSHAsWithHgChangesetID method of the parent
+// MockRepoCommitsHgChangesetsStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc) SetDefaultHook(hook func(context.Context, api.RepoID, []types.HgChangeset) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// BatchInsertCommitSHAsWithHgChangesetID method of the parent
+// MockRepoCommitsHgChangesetsStore instance invokes the hook at the front
+// of the queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc) PushHook(hook func(context.Context, api.RepoID, []types.HgChangeset) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, []types.HgChangeset) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID, []types.HgChangeset) error {
+		return r0
+	})
+}
+
+func (f *RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc) nextHook() func(context.Context, api.RepoID, []types.HgChangeset) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc) appendCall(r0 RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFuncCall
+// objects describing the invocations of this function.
+func (f *RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFunc) History() []RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFuncCall
+// is an object that describes an invocation of method
+// BatchInsertCommitSHAsWithHgChangesetID on an instance of
+// MockRepoCommitsHgChangesetsStore.
+type RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []types.HgChangeset
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoCommitsHgChangesetsStoreBatchInsertCommitSHAsWithHgChangesetIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoCommitsHgChangesetsStoreGetLatestForRepoFunc describes the behavior
+// when the GetLatestForRepo method of the parent
+// MockRepoCommitsHgChangesetsStore instance is invoked.
+type RepoCommitsHgChangesetsStoreGetLatestForRepoFunc struct {
+	defaultHook func(context.Context, api.RepoID) (*types.RepoCommitHgChangeset, error)
+	hooks       []func(context.Context, api.RepoID) (*types.RepoCommitHgChangeset, error)
+	history     []RepoCommitsHgChangesetsStoreGetLatestForRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// GetLatestForRepo delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoCommitsHgChangesetsStore) GetLatestForRepo(v0 context.Context, v1 api.RepoID) (*types.RepoCommitHgChangeset, error) {
+	r0, r1 := m.GetLatestForRepoFunc.nextHook()(v0, v1)
+	m.GetLatestForRepoFunc.appendCall(RepoCommitsHgChangesetsStoreGetLatestForRepoFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetLatestForRepo
+// method of the parent MockRepoCommitsHgChangesetsStore instance is invoked
+// and the hook queue is empty.
+func (f *RepoCommitsHgChangesetsStoreGetLatestForRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*types.RepoCommitHgChangeset, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetLatestForRepo method of the parent MockRepoCommitsHgChangesetsStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RepoCommitsHgChangesetsStoreGetLatestForRepoFunc) PushHook(hook func(context.Context, api.RepoID) (*types.RepoCommitHgChangeset, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoCommitsHgChangesetsStoreGetLatestForRepoFunc) SetDefaultReturn(r0 *types.RepoCommitHgChangeset, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (*types.RepoCommitHgChangeset, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoCommitsHgChangesetsStoreGetLatestForRepoFunc) PushReturn(r0 *types.RepoCommitHgChangeset, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (*types.RepoCommitHgChangeset, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoCommitsHgChangesetsStoreGetLatestForRepoFunc) nextHook() func(context.Context, api.RepoID) (*types.RepoCommitHgChangeset, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoCommitsHgChangesetsStoreGetLatestForRepoFunc) appendCall(r0 RepoCommitsHgChangesetsStoreGetLatestForRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoCommitsHgChangesetsStoreGetLatestForRepoFuncCall objects describing
+// the invocations of this function.
+func (f *RepoCommitsHgChangesetsStoreGetLatestForRepoFunc) History() []RepoCommitsHgChangesetsStoreGetLatestForRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoCommitsHgChangesetsStoreGetLatestForRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoCommitsHgChangesetsStoreGetLatestForRepoFuncCall is an object that
+// describes an invocation of method GetLatestForRepo on an instance of
+// MockRepoCommitsHgChangesetsStore.
+type RepoCommitsHgChangesetsStoreGetLatestForRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.RepoCommitHgChangeset
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoCommitsHgChangesetsStoreGetLatestForRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoCommitsHgChangesetsStoreGetLatestForRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc describes the
+// behavior when the GetRepoCommitHgChangeset method of the parent
+// MockRepoCommitsHgChangesetsStore instance is invoked.
+type RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc struct {
+	defaultHook func(context.Context, api.RepoID, string) (*types.RepoCommitHgChangeset, error)
+	hooks       []func(context.Context, api.RepoID, string) (*types.RepoCommitHgChangeset, error)
+	history     []RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepoCommitHgChangeset delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRepoCommitsHgChangesetsStore) GetRepoCommitHgChangeset(v0 context.Context, v1 api.RepoID, v2 string) (*types.RepoCommitHgChangeset, error) {
+	r0, r1 := m.GetRepoCommitHgChangesetFunc.nextHook()(v0, v1, v2)
+	m.GetRepoCommitHgChangesetFunc.appendCall(RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetRepoCommitHgChangeset method of the parent
+// MockRepoCommitsHgChangesetsStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc) SetDefaultHook(hook func(context.Context, api.RepoID, string) (*types.RepoCommitHgChangeset, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepoCommitHgChangeset method of the parent
+// MockRepoCommitsHgChangesetsStore instance invokes the hook at the front
+// of the queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc) PushHook(hook func(context.Context, api.RepoID, string) (*types.RepoCommitHgChangeset, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc) SetDefaultReturn(r0 *types.RepoCommitHgChangeset, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, string) (*types.RepoCommitHgChangeset, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc) PushReturn(r0 *types.RepoCommitHgChangeset, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID, string) (*types.RepoCommitHgChangeset, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc) nextHook() func(context.Context, api.RepoID, string) (*types.RepoCommitHgChangeset, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc) appendCall(r0 RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFuncCall objects
+// describing the invocations of this function.
+func (f *RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFunc) History() []RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFuncCall is an object
+// that describes an invocation of method GetRepoCommitHgChangeset on an
+// instance of MockRepoCommitsHgChangesetsStore.
+type RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.RepoCommitHgChangeset
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoCommitsHgChangesetsStoreGetRepoCommitHgChangesetFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // MockRepoPathStore is a mock implementation of the RepoPathStore interface
 // (from the package github.com/sourcegraph/sourcegraph/internal/database)
 // used for unit testing.
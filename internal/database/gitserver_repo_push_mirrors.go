@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// GitserverRepoPushMirrorStore records the status of pushing repos to their
+// push mirrors.
+type GitserverRepoPushMirrorStore interface {
+	basestore.ShareableStore
+	With(other basestore.ShareableStore) GitserverRepoPushMirrorStore
+
+	// ListByRepo returns the push status of the repo for every push mirror it
+	// was pushed to at least once, ordered by the name of the push mirror.
+	ListByRepo(ctx context.Context, repoID api.RepoID) ([]*types.GitserverRepoPushMirror, error)
+	// ListDue returns at most limit failed pushes of repos on the given
+	// gitserver shard that are due to be retried.
+	ListDue(ctx context.Context, shardID string, limit int) ([]*types.GitserverRepoPushMirror, error)
+	// RecordSuccess records that the push of the repo to the push mirror that
+	// started at startedAt succeeded.
+	RecordSuccess(ctx context.Context, repoID api.RepoID, remoteName string, startedAt time.Time) error
+	// RecordFailure records a failed push of the repo to the push mirror. The
+	// push is retried after minBackoff, which doubles with every consecutive
+	// failure up to maxBackoff.
+	RecordFailure(ctx context.Context, repoID api.RepoID, remoteName, message string, minBackoff, maxBackoff time.Duration) error
+}
+
+type gitserverRepoPushMirrorStore struct {
+	*basestore.Store
+}
+
+var _ GitserverRepoPushMirrorStore = (*gitserverRepoPushMirrorStore)(nil)
+
+// GitserverRepoPushMirrorsWith instantiates and returns a new
+// GitserverRepoPushMirrorStore using the other store handle.
+func GitserverRepoPushMirrorsWith(other basestore.ShareableStore) GitserverRepoPushMirrorStore {
+	return &gitserverRepoPushMirrorStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+func (s *gitserverRepoPushMirrorStore) With(other basestore.ShareableStore) GitserverRepoPushMirrorStore {
+	return &gitserverRepoPushMirrorStore{Store: s.Store.With(other)}
+}
+
+const gitserverRepoPushMirrorColumns = `
+	m.repo_id,
+	r.name,
+	m.remote_name,
+	m.last_pushed_at,
+	m.last_attempt_at,
+	m.last_error,
+	m.failures,
+	m.next_attempt_at
+`
+
+const listGitserverRepoPushMirrorsByRepoFmtStr = `
+SELECT` + gitserverRepoPushMirrorColumns + `
+FROM gitserver_repo_push_mirrors m
+JOIN repo r ON r.id = m.repo_id
+WHERE m.repo_id = %s
+ORDER BY m.remote_name
+`
+
+func (s *gitserverRepoPushMirrorStore) ListByRepo(ctx context.Context, repoID api.RepoID) ([]*types.GitserverRepoPushMirror, error) {
+	return scanGitserverRepoPushMirrors(s.Query(ctx, sqlf.Sprintf(listGitserverRepoPushMirrorsByRepoFmtStr, repoID)))
+}
+
+const listDueGitserverRepoPushMirrorsFmtStr = `
+SELECT` + gitserverRepoPushMirrorColumns + `
+FROM gitserver_repo_push_mirrors m
+JOIN repo r ON r.id = m.repo_id
+JOIN gitserver_repos gr ON gr.repo_id = m.repo_id
+WHERE
+	m.next_attempt_at <= NOW()
+	AND gr.shard_id = %s
+	AND r.deleted_at IS NULL
+	AND r.blocked IS NULL
+ORDER BY m.next_attempt_at
+LIMIT %s
+`
+
+func (s *gitserverRepoPushMirrorStore) ListDue(ctx context.Context, shardID string, limit int) ([]*types.GitserverRepoPushMirror, error) {
+	return scanGitserverRepoPushMirrors(s.Query(ctx, sqlf.Sprintf(listDueGitserverRepoPushMirrorsFmtStr, shardID, limit)))
+}
+
+const recordGitserverRepoPushMirrorSuccessFmtStr = `
+INSERT INTO gitserver_repo_push_mirrors (repo_id, remote_name, last_pushed_at, last_attempt_at)
+VALUES (%s, %s, %s, %s)
+ON CONFLICT (repo_id, remote_name) DO UPDATE SET
+	last_pushed_at = EXCLUDED.last_pushed_at,
+	last_attempt_at = EXCLUDED.last_attempt_at,
+	last_error = NULL,
+	failures = 0,
+	next_attempt_at = NULL
+`
+
+func (s *gitserverRepoPushMirrorStore) RecordSuccess(ctx context.Context, repoID api.RepoID, remoteName string, startedAt time.Time) error {
+	return s.Exec(ctx, sqlf.Sprintf(recordGitserverRepoPushMirrorSuccessFmtStr, repoID, remoteName, startedAt, startedAt))
+}
+
+// recordGitserverRepoPushMirrorFailureFmtStr computes the backoff from the
+// number of failures before the current one, so that the first retry happens
+// after the minimum backoff.
+const recordGitserverRepoPushMirrorFailureFmtStr = `
+INSERT INTO gitserver_repo_push_mirrors (repo_id, remote_name, last_attempt_at, last_error, failures, next_attempt_at)
+VALUES (%s, %s, NOW(), %s, 1, NOW() + make_interval(secs => %s))
+ON CONFLICT (repo_id, remote_name) DO UPDATE SET
+	last_attempt_at = EXCLUDED.last_attempt_at,
+	last_error = EXCLUDED.last_error,
+	failures = gitserver_repo_push_mirrors.failures + 1,
+	next_attempt_at = NOW() + make_interval(secs => LEAST(%s * power(2, gitserver_repo_push_mirrors.failures), %s))
+`
+
+func (s *gitserverRepoPushMirrorStore) RecordFailure(ctx context.Context, repoID api.RepoID, remoteName, message string, minBackoff, maxBackoff time.Duration) error {
+	return s.Exec(ctx, sqlf.Sprintf(
+		recordGitserverRepoPushMirrorFailureFmtStr,
+		repoID,
+		remoteName,
+		message,
+		minBackoff.Seconds(),
+		minBackoff.Seconds(),
+		maxBackoff.Seconds(),
+	))
+}
+
+var scanGitserverRepoPushMirrors = basestore.NewSliceScanner(scanGitserverRepoPushMirror)
+
+func scanGitserverRepoPushMirror(scanner dbutil.Scanner) (*types.GitserverRepoPushMirror, error) {
+	var m types.GitserverRepoPushMirror
+	if err := scanner.Scan(
+		&m.RepoID,
+		&m.RepoName,
+		&m.RemoteName,
+		&dbutil.NullTime{Time: &m.LastPushedAt},
+		&m.LastAttemptAt,
+		&dbutil.NullString{S: &m.LastError},
+		&m.Failures,
+		&dbutil.NullTime{Time: &m.NextAttemptAt},
+	); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
@@ -0,0 +1,96 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestGitserverRepoPushMirrors(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+
+	repo, gitserverRepo := createTestRepo(ctx, t, db, &createTestRepoPayload{
+		Name: "github.com/sourcegraph/repo",
+	})
+	gitserverRepo.ShardID = "gitserver-0"
+	require.NoError(t, db.GitserverRepos().Update(ctx, gitserverRepo))
+
+	s := db.GitserverRepoPushMirrors()
+
+	pushedAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond).UTC()
+	require.NoError(t, s.RecordSuccess(ctx, repo.ID, "dr", pushedAt))
+	require.NoError(t, s.RecordFailure(ctx, repo.ID, "airgap", "connection refused", 0, time.Hour))
+
+	mirrors, err := s.ListByRepo(ctx, repo.ID)
+	require.NoError(t, err)
+	require.Len(t, mirrors, 2)
+
+	require.Equal(t, "airgap", mirrors[0].RemoteName)
+	require.Equal(t, repo.Name, mirrors[0].RepoName)
+	require.True(t, mirrors[0].LastPushedAt.IsZero())
+	require.Equal(t, "connection refused", mirrors[0].LastError)
+	require.Equal(t, 1, mirrors[0].Failures)
+	require.False(t, mirrors[0].NextAttemptAt.IsZero())
+
+	require.Equal(t, &types.GitserverRepoPushMirror{
+		RepoID:        repo.ID,
+		RepoName:      repo.Name,
+		RemoteName:    "dr",
+		LastPushedAt:  pushedAt,
+		LastAttemptAt: pushedAt,
+	}, normalizePushMirror(mirrors[1]))
+
+	t.Run("ListDue", func(t *testing.T) {
+		due, err := s.ListDue(ctx, "gitserver-0", 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		require.Equal(t, "airgap", due[0].RemoteName)
+
+		due, err = s.ListDue(ctx, "gitserver-1", 10)
+		require.NoError(t, err)
+		require.Empty(t, due)
+	})
+
+	t.Run("backoff", func(t *testing.T) {
+		require.NoError(t, s.RecordFailure(ctx, repo.ID, "airgap", "connection refused", time.Minute, time.Hour))
+		require.NoError(t, s.RecordFailure(ctx, repo.ID, "airgap", "connection refused", time.Minute, time.Hour))
+
+		mirrors, err := s.ListByRepo(ctx, repo.ID)
+		require.NoError(t, err)
+		require.Equal(t, 3, mirrors[0].Failures)
+		// The third failure is retried after 4 minutes.
+		require.WithinDuration(t, mirrors[0].LastAttemptAt.Add(4*time.Minute), mirrors[0].NextAttemptAt, time.Second)
+
+		due, err := s.ListDue(ctx, "gitserver-0", 10)
+		require.NoError(t, err)
+		require.Empty(t, due)
+	})
+
+	t.Run("success resets failures", func(t *testing.T) {
+		require.NoError(t, s.RecordSuccess(ctx, repo.ID, "airgap", pushedAt))
+
+		mirrors, err := s.ListByRepo(ctx, repo.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, mirrors[0].Failures)
+		require.Empty(t, mirrors[0].LastError)
+		require.True(t, mirrors[0].NextAttemptAt.IsZero())
+	})
+}
+
+func normalizePushMirror(m *types.GitserverRepoPushMirror) *types.GitserverRepoPushMirror {
+	m.LastPushedAt = m.LastPushedAt.UTC()
+	m.LastAttemptAt = m.LastAttemptAt.UTC()
+	return m
+}
@@ -0,0 +1,233 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+)
+
+// OwnCoverageReportStore stores the ownership coverage reports computed by the
+// own coverage background job, one per repository.
+type OwnCoverageReportStore interface {
+	basestore.ShareableStore
+	With(other basestore.ShareableStore) OwnCoverageReportStore
+
+	// Upsert replaces the coverage report of the repository of the given report.
+	Upsert(ctx context.Context, report *types.OwnCoverageReport) error
+	// GetByRepoID returns the coverage report of the given repository, or an
+	// OwnCoverageReportNotFoundError if none was computed yet.
+	GetByRepoID(ctx context.Context, repoID api.RepoID) (*types.OwnCoverageReport, error)
+	// List returns the coverage reports of the repositories visible to the
+	// actor, ordered from the least to the most covered repository.
+	List(ctx context.Context, opts ListOwnCoverageReportsOpts) ([]*types.OwnCoverageReport, error)
+	// Count returns the number of coverage reports of the repositories visible
+	// to the actor.
+	Count(ctx context.Context) (int, error)
+}
+
+type ListOwnCoverageReportsOpts struct {
+	*LimitOffset
+}
+
+type OwnCoverageReportNotFoundError struct {
+	repoID api.RepoID
+}
+
+func (e OwnCoverageReportNotFoundError) Error() string {
+	return fmt.Sprintf("ownership coverage report not found for repo %d", e.repoID)
+}
+
+func (OwnCoverageReportNotFoundError) NotFound() bool {
+	return true
+}
+
+type ownCoverageReportStore struct {
+	*basestore.Store
+	logger log.Logger
+}
+
+var _ OwnCoverageReportStore = (*ownCoverageReportStore)(nil)
+
+// OwnCoverageReportsWith instantiates and returns a new OwnCoverageReportStore
+// using the other store handle.
+func OwnCoverageReportsWith(logger log.Logger, other basestore.ShareableStore) OwnCoverageReportStore {
+	return &ownCoverageReportStore{
+		Store:  basestore.NewWithHandle(other.Handle()),
+		logger: logger,
+	}
+}
+
+func (s *ownCoverageReportStore) With(other basestore.ShareableStore) OwnCoverageReportStore {
+	return &ownCoverageReportStore{
+		Store:  s.Store.With(other),
+		logger: s.logger,
+	}
+}
+
+const upsertOwnCoverageReportFmtStr = `
+INSERT INTO own_coverage_reports (
+	repo_id,
+	commit_id,
+	total_file_count,
+	owned_file_count,
+	unowned_directories,
+	unmatched_rules,
+	unresolved_owners,
+	updated_at
+)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s)
+ON CONFLICT (repo_id) DO UPDATE SET
+	commit_id = EXCLUDED.commit_id,
+	total_file_count = EXCLUDED.total_file_count,
+	owned_file_count = EXCLUDED.owned_file_count,
+	unowned_directories = EXCLUDED.unowned_directories,
+	unmatched_rules = EXCLUDED.unmatched_rules,
+	unresolved_owners = EXCLUDED.unresolved_owners,
+	updated_at = EXCLUDED.updated_at
+`
+
+func (s *ownCoverageReportStore) Upsert(ctx context.Context, report *types.OwnCoverageReport) error {
+	if report.UpdatedAt.IsZero() {
+		report.UpdatedAt = timeutil.Now()
+	}
+
+	unmatchedRules, err := json.Marshal(nonNilSlice(report.UnmatchedRules))
+	if err != nil {
+		return err
+	}
+	unresolvedOwners, err := json.Marshal(nonNilSlice(report.UnresolvedOwners))
+	if err != nil {
+		return err
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(
+		upsertOwnCoverageReportFmtStr,
+		report.RepoID,
+		report.CommitID,
+		report.TotalFileCount,
+		report.OwnedFileCount,
+		pq.Array(nonNilSlice(report.UnownedDirectories)),
+		unmatchedRules,
+		unresolvedOwners,
+		report.UpdatedAt,
+	))
+}
+
+const ownCoverageReportColumns = `
+	ocr.repo_id,
+	ocr.commit_id,
+	ocr.total_file_count,
+	ocr.owned_file_count,
+	ocr.unowned_directories,
+	ocr.unmatched_rules,
+	ocr.unresolved_owners,
+	ocr.updated_at
+`
+
+const getOwnCoverageReportFmtStr = `
+SELECT` + ownCoverageReportColumns + `
+FROM own_coverage_reports ocr
+WHERE ocr.repo_id = %s
+`
+
+func (s *ownCoverageReportStore) GetByRepoID(ctx context.Context, repoID api.RepoID) (*types.OwnCoverageReport, error) {
+	report, ok, err := scanFirstOwnCoverageReport(s.Query(ctx, sqlf.Sprintf(getOwnCoverageReportFmtStr, repoID)))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, OwnCoverageReportNotFoundError{repoID: repoID}
+	}
+	return report, nil
+}
+
+const listOwnCoverageReportsFmtStr = `
+SELECT` + ownCoverageReportColumns + `
+FROM own_coverage_reports ocr
+JOIN repo ON repo.id = ocr.repo_id
+WHERE
+	repo.deleted_at IS NULL
+	AND repo.blocked IS NULL
+	AND (%s) -- populates authzConds
+ORDER BY
+	CASE WHEN ocr.total_file_count = 0 THEN 0 ELSE ocr.owned_file_count::float / ocr.total_file_count END ASC,
+	ocr.repo_id ASC
+%s
+`
+
+func (s *ownCoverageReportStore) List(ctx context.Context, opts ListOwnCoverageReportsOpts) ([]*types.OwnCoverageReport, error) {
+	authzConds, err := AuthzQueryConds(ctx, NewDBWith(s.logger, s))
+	if err != nil {
+		return nil, err
+	}
+	return scanOwnCoverageReports(s.Query(ctx, sqlf.Sprintf(listOwnCoverageReportsFmtStr, authzConds, opts.LimitOffset.SQL())))
+}
+
+const countOwnCoverageReportsFmtStr = `
+SELECT COUNT(*)
+FROM own_coverage_reports ocr
+JOIN repo ON repo.id = ocr.repo_id
+WHERE
+	repo.deleted_at IS NULL
+	AND repo.blocked IS NULL
+	AND (%s) -- populates authzConds
+`
+
+func (s *ownCoverageReportStore) Count(ctx context.Context) (int, error) {
+	authzConds, err := AuthzQueryConds(ctx, NewDBWith(s.logger, s))
+	if err != nil {
+		return 0, err
+	}
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(countOwnCoverageReportsFmtStr, authzConds)))
+	return count, err
+}
+
+var (
+	scanOwnCoverageReports     = basestore.NewSliceScanner(scanOwnCoverageReport)
+	scanFirstOwnCoverageReport = basestore.NewFirstScanner(scanOwnCoverageReport)
+)
+
+func scanOwnCoverageReport(scanner dbutil.Scanner) (*types.OwnCoverageReport, error) {
+	var (
+		r                                types.OwnCoverageReport
+		unmatchedRules, unresolvedOwners []byte
+	)
+	if err := scanner.Scan(
+		&r.RepoID,
+		&r.CommitID,
+		&r.TotalFileCount,
+		&r.OwnedFileCount,
+		pq.Array(&r.UnownedDirectories),
+		&unmatchedRules,
+		&unresolvedOwners,
+		&r.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(unmatchedRules, &r.UnmatchedRules); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(unresolvedOwners, &r.UnresolvedOwners); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// nonNilSlice returns an empty slice for nil, so that empty lists are stored as
+// empty arrays rather than NULL.
+func nonNilSlice[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
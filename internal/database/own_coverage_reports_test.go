@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	owntypes "github.com/sourcegraph/sourcegraph/internal/own/types"
+)
+
+func TestOwnCoverageReports_UpsertGet(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+	createRepos(t, ctx, db.Repos(), 1)
+	store := db.OwnCoverageReports()
+
+	_, err := store.GetByRepoID(ctx, 1)
+	assert.True(t, errcode.IsNotFound(err))
+
+	updatedAt := time.Date(2023, 11, 16, 10, 0, 0, 0, time.UTC)
+	report := &owntypes.OwnCoverageReport{
+		RepoID:             1,
+		CommitID:           api.CommitID("deadbeef"),
+		TotalFileCount:     10,
+		OwnedFileCount:     4,
+		UnownedDirectories: []string{"docs", "tools"},
+		UnmatchedRules:     []owntypes.OwnCoverageRule{{Pattern: "/legacy/", FilePath: "CODEOWNERS", LineNumber: 3}},
+		UnresolvedOwners: []owntypes.OwnCoverageUnresolvedOwner{{
+			Handle: "ghost",
+			Rules:  []owntypes.OwnCoverageRule{{Pattern: "*.go", FilePath: "CODEOWNERS", LineNumber: 1}},
+		}},
+		UpdatedAt: updatedAt,
+	}
+	require.NoError(t, store.Upsert(ctx, report))
+	got, err := store.GetByRepoID(ctx, 1)
+	require.NoError(t, err)
+	got.UpdatedAt = got.UpdatedAt.UTC()
+	assert.Equal(t, report, got)
+
+	// Upserting again replaces the report, and stores empty lists as such.
+	report = &owntypes.OwnCoverageReport{
+		RepoID:         1,
+		CommitID:       api.CommitID("cafebabe"),
+		TotalFileCount: 10,
+		OwnedFileCount: 10,
+		UpdatedAt:      updatedAt.Add(time.Hour),
+	}
+	require.NoError(t, store.Upsert(ctx, report))
+	got, err = store.GetByRepoID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, api.CommitID("cafebabe"), got.CommitID)
+	assert.Equal(t, 10, got.OwnedFileCount)
+	assert.Empty(t, got.UnownedDirectories)
+	assert.Empty(t, got.UnmatchedRules)
+	assert.Empty(t, got.UnresolvedOwners)
+}
+
+func TestOwnCoverageReports_ListCount(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	ctx := actor.WithInternalActor(context.Background())
+	createRepos(t, ctx, db.Repos(), 3)
+	store := db.OwnCoverageReports()
+
+	for _, report := range []*owntypes.OwnCoverageReport{
+		{RepoID: 1, TotalFileCount: 10, OwnedFileCount: 9},
+		{RepoID: 2, TotalFileCount: 10, OwnedFileCount: 1},
+		{RepoID: 3, TotalFileCount: 0, OwnedFileCount: 0},
+	} {
+		require.NoError(t, store.Upsert(ctx, report))
+	}
+
+	count, err := store.Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	// Reports are listed from the least to the most covered repository.
+	reports, err := store.List(ctx, ListOwnCoverageReportsOpts{})
+	require.NoError(t, err)
+	var repoIDs []api.RepoID
+	for _, r := range reports {
+		repoIDs = append(repoIDs, r.RepoID)
+	}
+	assert.Equal(t, []api.RepoID{3, 2, 1}, repoIDs)
+
+	reports, err = store.List(ctx, ListOwnCoverageReportsOpts{LimitOffset: &LimitOffset{Limit: 1, Offset: 1}})
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, api.RepoID(2), reports[0].RepoID)
+
+	// Reports of deleted repositories are not listed.
+	require.NoError(t, db.Repos().Delete(ctx, 2))
+	count, err = store.Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
@@ -0,0 +1,153 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type RepoCommitsHgChangesetsStore interface {
+	// BatchInsertCommitSHAsWithHgChangesetID will insert rows into the
+	// repo_commits_hg_changesets table in batches. Rows for changesets that are
+	// already mapped are skipped.
+	BatchInsertCommitSHAsWithHgChangesetID(context.Context, api.RepoID, []types.HgChangeset) error
+	// GetLatestForRepo will return the most recently mapped commit of the repo.
+	GetLatestForRepo(ctx context.Context, repoID api.RepoID) (*types.RepoCommitHgChangeset, error)
+
+	// GetRepoCommitHgChangeset will return the matching row from the table for
+	// the given repo ID and the given changeset ID. The changeset ID may be
+	// abbreviated, as long as it is unambiguous.
+	GetRepoCommitHgChangeset(ctx context.Context, repoID api.RepoID, changesetID string) (*types.RepoCommitHgChangeset, error)
+}
+
+type repoCommitsHgChangesetsStore struct {
+	*basestore.Store
+	logger log.Logger
+}
+
+var _ RepoCommitsHgChangesetsStore = (*repoCommitsHgChangesetsStore)(nil)
+
+func RepoCommitsHgChangesetsWith(logger log.Logger, other basestore.ShareableStore) RepoCommitsHgChangesetsStore {
+	return &repoCommitsHgChangesetsStore{
+		logger: logger,
+		Store:  basestore.NewWithHandle(other.Handle()),
+	}
+}
+
+func (s *repoCommitsHgChangesetsStore) BatchInsertCommitSHAsWithHgChangesetID(ctx context.Context, repoID api.RepoID, changesets []types.HgChangeset) error {
+	return s.WithTransact(ctx, func(tx *basestore.Store) error {
+		inserter := batch.NewInserterWithConflict(ctx, tx.Handle(), "repo_commits_hg_changesets", batch.MaxNumPostgresParameters, "ON CONFLICT DO NOTHING", "repo_id", "commit_sha", "hg_changeset_id")
+		for _, item := range changesets {
+			if err := inserter.Insert(
+				ctx,
+				int32(repoID),
+				dbutil.CommitBytea(item.CommitSHA),
+				item.ChangesetID,
+			); err != nil {
+				return err
+			}
+		}
+		return inserter.Flush(ctx)
+	})
+}
+
+var getLatestHgChangesetForRepoFmtStr = `
+SELECT
+	id,
+	repo_id,
+	commit_sha,
+	hg_changeset_id
+FROM
+	repo_commits_hg_changesets
+WHERE
+	repo_id = %s
+ORDER BY
+	id DESC
+LIMIT 1`
+
+func (s *repoCommitsHgChangesetsStore) GetLatestForRepo(ctx context.Context, repoID api.RepoID) (*types.RepoCommitHgChangeset, error) {
+	q := sqlf.Sprintf(getLatestHgChangesetForRepoFmtStr, repoID)
+	return scanRepoCommitHgChangesetRow(s.QueryRow(ctx, q))
+}
+
+func scanRepoCommitHgChangesetRow(scanner dbutil.Scanner) (*types.RepoCommitHgChangeset, error) {
+	var r types.RepoCommitHgChangeset
+	if err := scanner.Scan(
+		&r.ID,
+		&r.RepoID,
+		&r.CommitSHA,
+		&r.HgChangesetID,
+	); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// getRepoCommitHgChangesetFmtStr fetches at most two rows, so that an
+// abbreviated changeset ID matching more than one changeset can be detected.
+var getRepoCommitHgChangesetFmtStr = `
+SELECT
+	id,
+	repo_id,
+	commit_sha,
+	hg_changeset_id
+FROM
+	repo_commits_hg_changesets
+WHERE
+	repo_id = %s
+	AND hg_changeset_id LIKE %s
+ORDER BY
+	hg_changeset_id
+LIMIT 2
+`
+
+func (s *repoCommitsHgChangesetsStore) GetRepoCommitHgChangeset(ctx context.Context, repoID api.RepoID, changesetID string) (_ *types.RepoCommitHgChangeset, err error) {
+	q := sqlf.Sprintf(getRepoCommitHgChangesetFmtStr, repoID, changesetID+"%")
+
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var matches []*types.RepoCommitHgChangeset
+	for rows.Next() {
+		r, err := scanRepoCommitHgChangesetRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, r)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, &HgChangesetNotFoundError{RepoID: repoID, ID: changesetID}
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, errors.Newf("ambiguous Mercurial changeset ID %q", changesetID)
+	}
+}
+
+// HgChangesetNotFoundError is an error that reports a Mercurial changeset
+// that has not been mapped to a commit.
+type HgChangesetNotFoundError struct {
+	RepoID api.RepoID
+	ID     string
+}
+
+func (e *HgChangesetNotFoundError) NotFound() bool { return true }
+
+func (e *HgChangesetNotFoundError) Error() string {
+	return fmt.Sprintf("hg changeset ID not found. repo=%d, changeset id=%s", e.RepoID, e.ID)
+}
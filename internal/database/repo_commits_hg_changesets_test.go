@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestRepoCommitsHgChangesets(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+
+	err := db.Repos().Create(ctx, &types.Repo{ID: 1, Name: "foo"})
+	require.NoError(t, err, "failed to insert repo")
+
+	commitSHA1 := "98d3ec26623660f17f6c298943f55aa339aa894a"
+	commitSHA2 := "4b6152a804c4c177f5fe0dfd61e71cacb64d64dd"
+	commitSHA3 := "e9c83398bbd4c4e9481fd20f100a7e49d68d7510"
+
+	changeset1 := "d4b9c8bd0a0b35e0a7a1ef2c3c8a3c8f9a8d2e1f"
+	changeset2 := "d4b9c8bd0a0b8e9f3a2b1c4d5e6f708192a3b4c5"
+	changeset3 := "0c1d2e3f405162738495a6b7c8d9e0f1a2b3c4d5"
+
+	s := RepoCommitsHgChangesetsWith(logger, db)
+
+	data := []types.HgChangeset{
+		{CommitSHA: api.CommitID(commitSHA1), ChangesetID: changeset1},
+		{CommitSHA: api.CommitID(commitSHA2), ChangesetID: changeset2},
+	}
+	require.NoError(t, s.BatchInsertCommitSHAsWithHgChangesetID(ctx, 1, data))
+
+	// Changesets that are already mapped are skipped.
+	data = []types.HgChangeset{
+		{CommitSHA: api.CommitID(commitSHA2), ChangesetID: changeset2},
+		{CommitSHA: api.CommitID(commitSHA3), ChangesetID: changeset3},
+	}
+	require.NoError(t, s.BatchInsertCommitSHAsWithHgChangesetID(ctx, 1, data))
+
+	t.Run("GetLatestForRepo", func(t *testing.T) {
+		t.Run("existing repo", func(t *testing.T) {
+			repoCommit, err := s.GetLatestForRepo(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, &types.RepoCommitHgChangeset{
+				ID:            4,
+				RepoID:        1,
+				CommitSHA:     dbutil.CommitBytea(commitSHA3),
+				HgChangesetID: changeset3,
+			}, repoCommit)
+		})
+
+		t.Run("non existing repo", func(t *testing.T) {
+			repoCommit, err := s.GetLatestForRepo(ctx, 2)
+			require.True(t, errors.Is(err, sql.ErrNoRows))
+			require.Nil(t, repoCommit)
+		})
+	})
+
+	t.Run("GetRepoCommitHgChangeset", func(t *testing.T) {
+		t.Run("full changeset ID", func(t *testing.T) {
+			got, err := s.GetRepoCommitHgChangeset(ctx, 1, changeset1)
+			require.NoError(t, err)
+			if diff := cmp.Diff(&types.RepoCommitHgChangeset{
+				ID:            1,
+				RepoID:        1,
+				CommitSHA:     dbutil.CommitBytea(commitSHA1),
+				HgChangesetID: changeset1,
+			}, got); diff != "" {
+				t.Errorf("mismatched row, (-want, +got)\n%s", diff)
+			}
+		})
+
+		t.Run("abbreviated changeset ID", func(t *testing.T) {
+			got, err := s.GetRepoCommitHgChangeset(ctx, 1, changeset3[:12])
+			require.NoError(t, err)
+			require.Equal(t, dbutil.CommitBytea(commitSHA3), got.CommitSHA)
+		})
+
+		t.Run("ambiguous changeset ID", func(t *testing.T) {
+			_, err := s.GetRepoCommitHgChangeset(ctx, 1, changeset1[:12])
+			require.Error(t, err)
+			require.False(t, errcode.IsNotFound(err))
+		})
+
+		t.Run("non existing row", func(t *testing.T) {
+			_, err := s.GetRepoCommitHgChangeset(ctx, 2, changeset1)
+			var notFoundError *HgChangesetNotFoundError
+			require.True(t, errors.As(err, &notFoundError), "got %v", err)
+			require.Equal(t, api.RepoID(2), notFoundError.RepoID)
+			require.Equal(t, changeset1, notFoundError.ID)
+		})
+	})
+}
@@ -592,6 +592,11 @@ type ReposListOptions struct {
 	// A set of filters to select only repos with the given set of topics
 	TopicFilters []RepoTopicFilter
 
+	// A set of filters to select only repos whose size on disk, as reported
+	// by gitserver, matches all the filters. Repos whose size is not known
+	// yet are never selected.
+	SizeFilters []RepoSizeFilter
+
 	// CaseSensitivePatterns determines if IncludePatterns and ExcludePattern are treated
 	// with case sensitivity or not.
 	CaseSensitivePatterns bool
@@ -745,6 +750,15 @@ type RepoTopicFilter struct {
 	Negated bool
 }
 
+type RepoSizeFilter struct {
+	// Comparator is one of ">", ">=", "<" or "<=".
+	Comparator string
+	Bytes      int64
+	// If negated is true, this filter will select only repos
+	// whose size does _not_ satisfy the comparison
+	Negated bool
+}
+
 type RepoListOrderBy []RepoListSort
 
 func (r RepoListOrderBy) SQL() *sqlf.Query {
@@ -1147,7 +1161,7 @@ func (s *repoStore) listSQL(ctx context.Context, tr trace.Trace, opt ReposListOp
 		where = append(where, sqlf.Sprintf("dscr.search_context_id = %d", opt.SearchContextID))
 	}
 
-	if opt.NoCloned || opt.OnlyCloned || opt.FailedFetch || opt.OnlyCorrupted || opt.joinGitserverRepos ||
+	if opt.NoCloned || opt.OnlyCloned || opt.FailedFetch || opt.OnlyCorrupted || opt.joinGitserverRepos || len(opt.SizeFilters) > 0 ||
 		opt.CloneStatus != types.CloneStatusUnknown || containsSizeField(opt.OrderBy) || (opt.PaginationArgs != nil && containsOrderBySizeField(opt.PaginationArgs.OrderBy)) {
 		joins = append(joins, sqlf.Sprintf("JOIN gitserver_repos gr ON gr.repo_id = repo.id"))
 	}
@@ -1201,6 +1215,24 @@ func (s *repoStore) listSQL(ctx context.Context, tr trace.Trace, opt ReposListOp
 		where = append(where, sqlf.Join(ands, "AND"))
 	}
 
+	if len(opt.SizeFilters) > 0 {
+		var ands []*sqlf.Query
+		for _, filter := range opt.SizeFilters {
+			switch filter.Comparator {
+			case ">", ">=", "<", "<=":
+			default:
+				return nil, errors.Errorf("invalid repo size comparator %q", filter.Comparator)
+			}
+
+			cond := sqlf.Sprintf("gr.repo_size_bytes "+filter.Comparator+" %s", filter.Bytes)
+			if filter.Negated {
+				cond = sqlf.Sprintf("NOT (%s)", cond)
+			}
+			ands = append(ands, cond)
+		}
+		where = append(where, sqlf.Join(ands, "AND"))
+	}
+
 	baseConds := sqlf.Sprintf("TRUE")
 	if !opt.IncludeDeleted {
 		baseConds = sqlf.Sprintf("repo.deleted_at IS NULL")
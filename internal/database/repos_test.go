@@ -1385,6 +1385,64 @@ func TestRepos_List_topics(t *testing.T) {
 	}
 }
 
+func TestRepos_List_sizes(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	ctx := actor.WithInternalActor(context.Background())
+
+	small := mustCreate(ctx, t, db, &types.Repo{Name: "small"})
+	medium := mustCreate(ctx, t, db, &types.Repo{Name: "medium"})
+	large := mustCreate(ctx, t, db, &types.Repo{Name: "large"})
+	// The size of this repo is not known yet, so it never matches.
+	mustCreate(ctx, t, db, &types.Repo{Name: "unknown"})
+
+	if _, err := db.GitserverRepos().UpdateRepoSizes(ctx, shardID, map[api.RepoName]int64{
+		small.Name:  10,
+		medium.Name: 100,
+		large.Name:  1000,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		opt  ReposListOptions
+		want []api.RepoName
+	}{
+		{"greater than", ReposListOptions{SizeFilters: []RepoSizeFilter{{Comparator: ">", Bytes: 100}}}, []api.RepoName{large.Name}},
+		{"greater than or equal", ReposListOptions{SizeFilters: []RepoSizeFilter{{Comparator: ">=", Bytes: 100}}}, []api.RepoName{medium.Name, large.Name}},
+		{"less than", ReposListOptions{SizeFilters: []RepoSizeFilter{{Comparator: "<", Bytes: 100}}}, []api.RepoName{small.Name}},
+		{"not less than", ReposListOptions{SizeFilters: []RepoSizeFilter{{Comparator: "<", Bytes: 100, Negated: true}}}, []api.RepoName{medium.Name, large.Name}},
+		{
+			"range",
+			ReposListOptions{SizeFilters: []RepoSizeFilter{{Comparator: ">", Bytes: 10}, {Comparator: "<=", Bytes: 100}}},
+			[]api.RepoName{medium.Name},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repos, err := db.Repos().List(ctx, test.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []api.RepoName
+			for _, r := range repos {
+				names = append(names, r.Name)
+			}
+			require.Equal(t, test.want, names)
+		})
+	}
+
+	_, err := db.Repos().List(ctx, ReposListOptions{SizeFilters: []RepoSizeFilter{{Comparator: "; DROP TABLE repo", Bytes: 1}}})
+	require.Error(t, err)
+}
+
 func TestRepos_ListMinimalRepos(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
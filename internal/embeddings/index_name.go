@@ -24,3 +24,9 @@ func GetRepoEmbeddingIndexNameDeprecated(repoName api.RepoName) RepoEmbeddingInd
 func GetRepoEmbeddingIndexName(repoID api.RepoID) RepoEmbeddingIndexName {
 	return RepoEmbeddingIndexName(fmt.Sprintf(`%d.embeddingindex`, repoID))
 }
+
+// GetRepoEmbeddingIVFIndexName returns the name of the IVF index that is stored
+// next to the embedding index of the repo.
+func GetRepoEmbeddingIVFIndexName(repoID api.RepoID) RepoEmbeddingIndexName {
+	return RepoEmbeddingIndexName(fmt.Sprintf(`%d.embeddingindex.ivf`, repoID))
+}
@@ -129,6 +129,25 @@ func DownloadRepoEmbeddingIndex(ctx context.Context, uploadStore uploadstore.Sto
 	return index, nil
 }
 
+// UploadRepoEmbeddingIVFIndex uploads the IVF index of the repo, which is stored next to
+// its embedding index.
+func UploadRepoEmbeddingIVFIndex(ctx context.Context, uploadStore uploadstore.Store, repoID api.RepoID, index *RepoEmbeddingIVFIndex) (err error) {
+	key := string(GetRepoEmbeddingIVFIndexName(repoID))
+	tr, ctx := trace.New(ctx, "UploadRepoEmbeddingIVFIndex", attribute.String("key", key))
+	defer tr.EndWithErr(&err)
+
+	return UploadIndex(ctx, uploadStore, key, index)
+}
+
+// DownloadRepoEmbeddingIVFIndex downloads the IVF index of the repo.
+func DownloadRepoEmbeddingIVFIndex(ctx context.Context, uploadStore uploadstore.Store, repoID api.RepoID) (_ *RepoEmbeddingIVFIndex, err error) {
+	key := string(GetRepoEmbeddingIVFIndexName(repoID))
+	tr, ctx := trace.New(ctx, "DownloadRepoEmbeddingIVFIndex", attribute.String("key", key))
+	defer tr.EndWithErr(&err)
+
+	return DownloadIndex[RepoEmbeddingIVFIndex](ctx, uploadStore, key)
+}
+
 func downloadRepoEmbeddingIndex(ctx context.Context, uploadStore uploadstore.Store, key string) (_ *RepoEmbeddingIndex, err error) {
 	tr, ctx := trace.New(ctx, "DownloadRepoEmbeddingIndex", attribute.String("key", key))
 	defer tr.EndWithErr(&err)
@@ -0,0 +1,240 @@
+package embeddings
+
+import (
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+
+	"github.com/sourcegraph/conc"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+const (
+	// ivfMinRows is the minimum number of rows for which an IVF index is built.
+	// Smaller indexes are cheap enough to search exhaustively.
+	ivfMinRows = 10_000
+	// ivfTrainingRowsPerList is the number of sampled rows per list that are used
+	// to compute the centroids.
+	ivfTrainingRowsPerList = 64
+	// ivfTrainingIterations is the number of k-means iterations used to compute
+	// the centroids.
+	ivfTrainingIterations = 10
+)
+
+// IVFIndex is an inverted file index over the rows of an EmbeddingIndex. The
+// rows are partitioned into lists by their nearest centroid, which are computed
+// with k-means clustering. A search only scans the rows of the lists whose
+// centroids are nearest to the query, trading recall for latency.
+type IVFIndex struct {
+	// NumRows is the number of rows of the embedding index the IVF index was built
+	// for. An IVF index can only be used with an embedding index of the same size.
+	NumRows int
+	// Centroids holds the quantized centroid of each list, with the same column
+	// dimension as the embedding index.
+	Centroids []int8
+	// ListOffsets holds the start of each list in Rows, followed by len(Rows).
+	ListOffsets []int32
+	// Rows holds the rows of the embedding index, grouped by list.
+	Rows []int32
+}
+
+// NumLists returns the number of lists of the index.
+func (ivf *IVFIndex) NumLists() int {
+	return len(ivf.ListOffsets) - 1
+}
+
+func (ivf *IVFIndex) list(i int) []int32 {
+	return ivf.Rows[ivf.ListOffsets[i]:ivf.ListOffsets[i+1]]
+}
+
+func (ivf *IVFIndex) EstimateSize() uint64 {
+	return uint64(len(ivf.Centroids) + len(ivf.ListOffsets)*4 + len(ivf.Rows)*4)
+}
+
+// BuildIVFIndex partitions the rows of the index into lists of similar rows.
+// The number of lists is the square root of the number of rows. It returns nil
+// if the index has too few rows to benefit from an IVF index.
+func BuildIVFIndex(index *EmbeddingIndex) *IVFIndex {
+	numRows := len(index.RowMetadata)
+	if numRows < ivfMinRows || index.ColumnDimension == 0 {
+		return nil
+	}
+	numLists := int(math.Sqrt(float64(numRows)))
+	numWorkers := runtime.GOMAXPROCS(0)
+
+	// Use a fixed seed, so that the same index always results in the same lists.
+	prng := rand.New(rand.NewSource(0))
+	sample := prng.Perm(numRows)[:min(numRows, numLists*ivfTrainingRowsPerList)]
+
+	// The centroids are initialized with randomly chosen rows.
+	centroids := make([]int8, 0, numLists*index.ColumnDimension)
+	for _, row := range sample[:numLists] {
+		centroids = append(centroids, index.Row(row)...)
+	}
+
+	sums := make([]float32, numLists*index.ColumnDimension)
+	counts := make([]int, numLists)
+	for iteration := 0; iteration < ivfTrainingIterations; iteration++ {
+		assignments := assignRows(index, centroids, sample, numWorkers)
+
+		for i := range sums {
+			sums[i] = 0
+		}
+		for i := range counts {
+			counts[i] = 0
+		}
+		for i, list := range assignments {
+			sum := sums[list*index.ColumnDimension : (list+1)*index.ColumnDimension]
+			for j, v := range index.Row(sample[i]) {
+				sum[j] += float32(v)
+			}
+			counts[list]++
+		}
+
+		for list := 0; list < numLists; list++ {
+			// Keep the previous centroid of empty lists.
+			if counts[list] == 0 {
+				continue
+			}
+			centroid := centroids[list*index.ColumnDimension : (list+1)*index.ColumnDimension]
+			Quantize(normalize(sums[list*index.ColumnDimension:(list+1)*index.ColumnDimension]), centroid)
+		}
+	}
+
+	rows := make([]int, numRows)
+	for i := range rows {
+		rows[i] = i
+	}
+	assignments := assignRows(index, centroids, rows, numWorkers)
+
+	// Group the rows by list with a counting sort, so that the rows of each list stay in order.
+	listOffsets := make([]int32, numLists+1)
+	for _, list := range assignments {
+		listOffsets[list+1]++
+	}
+	for list := 0; list < numLists; list++ {
+		listOffsets[list+1] += listOffsets[list]
+	}
+	cursors := make([]int32, numLists)
+	copy(cursors, listOffsets)
+	listRows := make([]int32, numRows)
+	for row, list := range assignments {
+		listRows[cursors[list]] = int32(row)
+		cursors[list]++
+	}
+
+	return &IVFIndex{
+		NumRows:     numRows,
+		Centroids:   centroids,
+		ListOffsets: listOffsets,
+		Rows:        listRows,
+	}
+}
+
+// assignRows returns the nearest centroid of each of the given rows.
+func assignRows(index *EmbeddingIndex, centroids []int8, rows []int, numWorkers int) []int {
+	numLists := len(centroids) / index.ColumnDimension
+	assignments := make([]int, len(rows))
+
+	var wg conc.WaitGroup
+	for _, partialRows := range splitRows(len(rows), numWorkers, 0) {
+		partialRows := partialRows
+		wg.Go(func() {
+			for i := partialRows.start; i < partialRows.end; i++ {
+				row := index.Row(rows[i])
+				best, bestScore := 0, int32(math.MinInt32)
+				for list := 0; list < numLists; list++ {
+					if score := Dot(row, centroids[list*index.ColumnDimension:(list+1)*index.ColumnDimension]); score > bestScore {
+						best, bestScore = list, score
+					}
+				}
+				assignments[i] = best
+			}
+		})
+	}
+	wg.Wait()
+
+	return assignments
+}
+
+func normalize(v []float32) []float32 {
+	var norm float64
+	for _, x := range v {
+		norm += float64(x) * float64(x)
+	}
+	norm = math.Sqrt(norm)
+
+	normalized := make([]float32, len(v))
+	if norm == 0 {
+		return normalized
+	}
+	for i, x := range v {
+		normalized[i] = float32(float64(x) / norm)
+	}
+	return normalized
+}
+
+// candidateRows returns the rows of the numProbes lists whose centroids are
+// nearest to the query.
+func (ivf *IVFIndex) candidateRows(query []int8, numProbes int) []int32 {
+	numLists := ivf.NumLists()
+	columnDimension := len(ivf.Centroids) / numLists
+
+	scores := make([]int32, numLists)
+	lists := make([]int, numLists)
+	for list := 0; list < numLists; list++ {
+		scores[list] = Dot(query, ivf.Centroids[list*columnDimension:(list+1)*columnDimension])
+		lists[list] = list
+	}
+	sort.Slice(lists, func(i, j int) bool { return scores[lists[i]] > scores[lists[j]] })
+
+	numCandidates := 0
+	for _, list := range lists[:min(numLists, numProbes)] {
+		numCandidates += len(ivf.list(list))
+	}
+	candidates := make([]int32, 0, numCandidates)
+	for _, list := range lists[:min(numLists, numProbes)] {
+		candidates = append(candidates, ivf.list(list)...)
+	}
+	return candidates
+}
+
+// RepoEmbeddingIVFIndex holds the IVF indexes of the code and text indexes of a
+// RepoEmbeddingIndex. It is stored next to the embedding index.
+type RepoEmbeddingIVFIndex struct {
+	Revision  api.CommitID
+	CodeIndex *IVFIndex
+	TextIndex *IVFIndex
+}
+
+// BuildRepoEmbeddingIVFIndex builds the IVF indexes of the code and text indexes.
+func BuildRepoEmbeddingIVFIndex(index *RepoEmbeddingIndex) *RepoEmbeddingIVFIndex {
+	return &RepoEmbeddingIVFIndex{
+		Revision:  index.Revision,
+		CodeIndex: BuildIVFIndex(&index.CodeIndex),
+		TextIndex: BuildIVFIndex(&index.TextIndex),
+	}
+}
+
+// SetIVFIndex attaches the IVF indexes to the code and text indexes. IVF indexes
+// that were built for a different revision or a different number of rows are
+// ignored.
+func (i *RepoEmbeddingIndex) SetIVFIndex(ivf *RepoEmbeddingIVFIndex) {
+	if ivf == nil || ivf.Revision != i.Revision {
+		return
+	}
+	i.CodeIndex.setIVFIndex(ivf.CodeIndex)
+	i.TextIndex.setIVFIndex(ivf.TextIndex)
+}
+
+func (index *EmbeddingIndex) setIVFIndex(ivf *IVFIndex) {
+	if ivf == nil || ivf.NumRows != len(index.RowMetadata) || len(ivf.Rows) != ivf.NumRows {
+		return
+	}
+	if ivf.NumLists() <= 0 || len(ivf.Centroids) != ivf.NumLists()*index.ColumnDimension || ivf.ListOffsets[ivf.NumLists()] != int32(len(ivf.Rows)) {
+		return
+	}
+	index.IVF = ivf
+}
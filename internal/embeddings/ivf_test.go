@@ -0,0 +1,172 @@
+package embeddings
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// getClusteredEmbeddingIndex returns an index with normalized rows drawn around
+// numClusters random centers. Unlike uniformly random rows, clustered rows
+// resemble real embeddings, which are not spread evenly.
+func getClusteredEmbeddingIndex(prng *rand.Rand, numRows, columnDimension, numClusters int) *EmbeddingIndex {
+	centers := make([][]float32, numClusters)
+	for i := range centers {
+		centers[i] = make([]float32, columnDimension)
+		for j := range centers[i] {
+			centers[i][j] = float32(prng.NormFloat64())
+		}
+	}
+
+	index := &EmbeddingIndex{
+		Embeddings:      make([]int8, 0, numRows*columnDimension),
+		ColumnDimension: columnDimension,
+		RowMetadata:     make([]RepoEmbeddingRowMetadata, numRows),
+	}
+	row := make([]float32, columnDimension)
+	for i := 0; i < numRows; i++ {
+		center := centers[prng.Intn(numClusters)]
+		for j := range row {
+			row[j] = center[j] + 2*float32(prng.NormFloat64())
+		}
+		index.Embeddings = append(index.Embeddings, Quantize(normalize(row), nil)...)
+		index.RowMetadata[i] = RepoEmbeddingRowMetadata{FileName: fmt.Sprintf("%d", i)}
+	}
+	return index
+}
+
+func TestBuildIVFIndex(t *testing.T) {
+	prng := rand.New(rand.NewSource(0))
+
+	t.Run("too few rows", func(t *testing.T) {
+		index := getClusteredEmbeddingIndex(prng, ivfMinRows-1, 64, 10)
+		require.Nil(t, BuildIVFIndex(index))
+	})
+
+	index := getClusteredEmbeddingIndex(prng, ivfMinRows, 64, 10)
+	ivf := BuildIVFIndex(index)
+	require.NotNil(t, ivf)
+	require.Equal(t, 100, ivf.NumLists())
+	require.Len(t, ivf.Centroids, 100*64)
+
+	// Every row belongs to exactly one list.
+	seen := make([]bool, ivfMinRows)
+	for list := 0; list < ivf.NumLists(); list++ {
+		for _, row := range ivf.list(list) {
+			require.False(t, seen[row])
+			seen[row] = true
+		}
+	}
+	for _, ok := range seen {
+		require.True(t, ok)
+	}
+}
+
+func TestApproximateSimilaritySearch(t *testing.T) {
+	prng := rand.New(rand.NewSource(0))
+	index := getClusteredEmbeddingIndex(prng, ivfMinRows, 64, 10)
+	query := index.Row(42)
+
+	repoIndex := &RepoEmbeddingIndex{Revision: "abc", CodeIndex: *index}
+	repoIndex.SetIVFIndex(BuildRepoEmbeddingIVFIndex(repoIndex))
+	require.NotNil(t, repoIndex.CodeIndex.IVF)
+
+	exhaustive := repoIndex.CodeIndex.SimilaritySearch(query, 10, WorkerOptions{NumWorkers: 4}, SearchOptions{}, "", "")
+
+	t.Run("all lists", func(t *testing.T) {
+		opts := SearchOptions{NumProbes: repoIndex.CodeIndex.IVF.NumLists()}
+		results := repoIndex.CodeIndex.SimilaritySearch(query, 10, WorkerOptions{NumWorkers: 4}, opts, "", "")
+		require.Equal(t, scores(exhaustive), scores(results))
+	})
+
+	t.Run("one list", func(t *testing.T) {
+		results := repoIndex.CodeIndex.SimilaritySearch(query, 10, WorkerOptions{NumWorkers: 4}, SearchOptions{NumProbes: 1}, "", "")
+		// The query is a row of the index, so it is in the nearest list.
+		require.Equal(t, "42", results[0].FileName)
+	})
+
+	t.Run("mismatched revision", func(t *testing.T) {
+		other := &RepoEmbeddingIndex{Revision: "def", CodeIndex: *index}
+		other.CodeIndex.IVF = nil
+		other.SetIVFIndex(BuildRepoEmbeddingIVFIndex(repoIndex))
+		require.Nil(t, other.CodeIndex.IVF)
+	})
+
+	t.Run("mismatched rows", func(t *testing.T) {
+		ivf := BuildRepoEmbeddingIVFIndex(repoIndex)
+		ivf.CodeIndex.NumRows--
+		other := &RepoEmbeddingIndex{Revision: "abc", CodeIndex: *index}
+		other.CodeIndex.IVF = nil
+		other.SetIVFIndex(ivf)
+		require.Nil(t, other.CodeIndex.IVF)
+	})
+}
+
+func TestRepoEmbeddingIVFIndexStorage(t *testing.T) {
+	prng := rand.New(rand.NewSource(0))
+	index := &RepoEmbeddingIndex{
+		Revision:  "abc",
+		CodeIndex: *getClusteredEmbeddingIndex(prng, ivfMinRows, 64, 10),
+	}
+	ivf := BuildRepoEmbeddingIVFIndex(index)
+
+	ctx := context.Background()
+	uploadStore := newMockUploadStore()
+	require.NoError(t, UploadRepoEmbeddingIVFIndex(ctx, uploadStore, 1, ivf))
+
+	downloaded, err := DownloadRepoEmbeddingIVFIndex(ctx, uploadStore, 1)
+	require.NoError(t, err)
+	require.Equal(t, ivf, downloaded)
+}
+
+func scores(results []EmbeddingSearchResult) []int32 {
+	s := make([]int32, len(results))
+	for i, r := range results {
+		s[i] = r.ScoreDetails.Score
+	}
+	return s
+}
+
+// BenchmarkApproximateSimilaritySearch compares the latency and the recall of the
+// approximate search against the exhaustive search for a range of probes.
+func BenchmarkApproximateSimilaritySearch(b *testing.B) {
+	prng := rand.New(rand.NewSource(0))
+
+	numRows := 250_000
+	numResults := 20
+	numQueries := 50
+	columnDimension := 1536
+	index := getClusteredEmbeddingIndex(prng, numRows, columnDimension, 1000)
+	index.IVF = BuildIVFIndex(index)
+
+	queries := make([][]int8, numQueries)
+	expected := make([]map[string]struct{}, numQueries)
+	for i := range queries {
+		queries[i] = index.Row(prng.Intn(numRows))
+		expected[i] = map[string]struct{}{}
+		for _, result := range index.SimilaritySearch(queries[i], numResults, WorkerOptions{NumWorkers: 8}, SearchOptions{}, "", "") {
+			expected[i][result.FileName] = struct{}{}
+		}
+	}
+
+	b.ResetTimer()
+
+	for _, numProbes := range []int{0, 1, 4, 16, 32, 64} {
+		b.Run(fmt.Sprintf("numProbes=%d", numProbes), func(b *testing.B) {
+			found := 0
+			for n := 0; n < b.N; n++ {
+				i := n % numQueries
+				results := index.SimilaritySearch(queries[i], numResults, WorkerOptions{NumWorkers: 8}, SearchOptions{NumProbes: numProbes}, "", "")
+				for _, result := range results {
+					if _, ok := expected[i][result.FileName]; ok {
+						found++
+					}
+				}
+			}
+			b.ReportMetric(float64(found)/float64(b.N*numResults), "recall")
+		})
+	}
+}
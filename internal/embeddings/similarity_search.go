@@ -81,6 +81,7 @@ type WorkerOptions struct {
 }
 
 // SimilaritySearch finds the `nResults` most similar rows to a query vector. It uses the cosine similarity metric.
+// When opts.NumProbes is set and the index has an IVF index, only the rows of the nearest lists are searched.
 // IMPORTANT: The vectors in the embedding index have to be normalized for similarity search to work correctly.
 func (index *EmbeddingIndex) SimilaritySearch(
 	query []int8,
@@ -100,23 +101,35 @@ func (index *EmbeddingIndex) SimilaritySearch(
 	// We need at least 1 worker.
 	numWorkers := max(1, workerOptions.NumWorkers)
 
+	var candidates []int32
+	if opts.NumProbes > 0 && index.IVF != nil {
+		candidates = index.IVF.candidateRows(query, opts.NumProbes)
+		numRows = len(candidates)
+	}
+
 	// Split index rows among the workers. Each worker will run a partial similarity search on the assigned rows.
 	rowsPerWorker := splitRows(numRows, numWorkers, workerOptions.MinRowsToSplit)
 	heaps := make([]*nearestNeighborsHeap, len(rowsPerWorker))
 
+	search := func(workerIdx int) {
+		if candidates != nil {
+			heaps[workerIdx] = index.partialCandidateSimilaritySearch(query, numResults, candidates, rowsPerWorker[workerIdx], opts)
+		} else {
+			heaps[workerIdx] = index.partialSimilaritySearch(query, numResults, rowsPerWorker[workerIdx], opts)
+		}
+	}
+
 	if len(rowsPerWorker) > 1 {
 		var wg conc.WaitGroup
 		for workerIdx := 0; workerIdx < len(rowsPerWorker); workerIdx++ {
 			// Capture the loop variable value so we can use it in the closure below.
 			workerIdx := workerIdx
-			wg.Go(func() {
-				heaps[workerIdx] = index.partialSimilaritySearch(query, numResults, rowsPerWorker[workerIdx], opts)
-			})
+			wg.Go(func() { search(workerIdx) })
 		}
 		wg.Wait()
 	} else {
 		// Run the similarity search directly when we have a single worker to eliminate the concurrency overhead.
-		heaps[0] = index.partialSimilaritySearch(query, numResults, rowsPerWorker[0], opts)
+		search(0)
 	}
 
 	// Collect all heap neighbors from workers into a single array.
@@ -129,10 +142,11 @@ func (index *EmbeddingIndex) SimilaritySearch(
 	// And re-sort it according to the score (descending).
 	sort.Slice(neighbors, func(i, j int) bool { return neighbors[i].scoreDetails.Score > neighbors[j].scoreDetails.Score })
 
-	// Take top neighbors and return them as results.
-	results := make([]EmbeddingSearchResult, numResults)
+	// Take top neighbors and return them as results. An approximate search can
+	// find fewer neighbors than requested.
+	results := make([]EmbeddingSearchResult, min(numResults, len(neighbors)))
 
-	for idx := 0; idx < min(numResults, len(neighbors)); idx++ {
+	for idx := range results {
 		metadata := index.RowMetadata[neighbors[idx].index]
 		results[idx] = EmbeddingSearchResult{
 			RepoName:     repoName,
@@ -155,24 +169,43 @@ func (index *EmbeddingIndex) partialSimilaritySearch(query []int8, numResults in
 	numResults = min(nRows, numResults)
 
 	nnHeap := newNearestNeighborsHeap()
-	for i := partialRows.start; i < partialRows.start+numResults; i++ {
-		scoreDetails := index.score(query, i, opts)
-		heap.Push(nnHeap, nearestNeighbor{index: i, scoreDetails: scoreDetails})
-	}
-
-	for i := partialRows.start + numResults; i < partialRows.end; i++ {
-		scoreDetails := index.score(query, i, opts)
-		// Add row if it has greater similarity than the smallest similarity in the heap.
-		// This way we ensure keep a set of the highest similarities in the heap.
-		if scoreDetails.Score > nnHeap.Peek().scoreDetails.Score {
-			heap.Pop(nnHeap)
-			heap.Push(nnHeap, nearestNeighbor{index: i, scoreDetails: scoreDetails})
-		}
+	for i := partialRows.start; i < partialRows.end; i++ {
+		nnHeap.pushBounded(nearestNeighbor{index: i, scoreDetails: index.score(query, i, opts)}, numResults)
 	}
 
 	return nnHeap
 }
 
+// partialCandidateSimilaritySearch is like partialSimilaritySearch, but it only searches the
+// candidate rows in the given range of candidates.
+func (index *EmbeddingIndex) partialCandidateSimilaritySearch(query []int8, numResults int, candidates []int32, partialRows partialRows, opts SearchOptions) *nearestNeighborsHeap {
+	nRows := partialRows.end - partialRows.start
+	if nRows <= 0 {
+		return nil
+	}
+	numResults = min(nRows, numResults)
+
+	nnHeap := newNearestNeighborsHeap()
+	for _, row := range candidates[partialRows.start:partialRows.end] {
+		i := int(row)
+		nnHeap.pushBounded(nearestNeighbor{index: i, scoreDetails: index.score(query, i, opts)}, numResults)
+	}
+
+	return nnHeap
+}
+
+// pushBounded adds the neighbor to the heap while it holds fewer than maxLen neighbors.
+// Afterwards, it only replaces the neighbor with the smallest score if the new neighbor
+// has a greater score. This way we keep a set of the highest scores in the heap.
+func (nn *nearestNeighborsHeap) pushBounded(neighbor nearestNeighbor, maxLen int) {
+	if nn.Len() < maxLen {
+		heap.Push(nn, neighbor)
+	} else if neighbor.scoreDetails.Score > nn.Peek().scoreDetails.Score {
+		heap.Pop(nn)
+		heap.Push(nn, neighbor)
+	}
+}
+
 const (
 	scoreFileRankWeight   int32 = 1
 	scoreSimilarityWeight int32 = 2
@@ -218,4 +251,8 @@ func max(a, b int) int {
 
 type SearchOptions struct {
 	UseDocumentRanks bool
+	// NumProbes is the number of lists of the IVF index that are searched. Searching
+	// more lists improves recall at the cost of latency. If it is 0, or the index has
+	// no IVF index, all rows are searched.
+	NumProbes int
 }
@@ -14,6 +14,10 @@ type EmbeddingIndex struct {
 	ColumnDimension int
 	RowMetadata     []RepoEmbeddingRowMetadata
 	Ranks           []float32
+
+	// IVF is the optional IVF index used for approximate similarity search. It is
+	// stored separately from the embedding index.
+	IVF *IVFIndex
 }
 
 // Row returns the embeddings for the nth row in the index
@@ -22,7 +26,11 @@ func (index *EmbeddingIndex) Row(n int) []int8 {
 }
 
 func (index *EmbeddingIndex) EstimateSize() uint64 {
-	return uint64(len(index.Embeddings) + len(index.RowMetadata)*(16+8+8) + len(index.Ranks)*4)
+	size := uint64(len(index.Embeddings) + len(index.RowMetadata)*(16+8+8) + len(index.Ranks)*4)
+	if index.IVF != nil {
+		size += index.IVF.EstimateSize()
+	}
+	return size
 }
 
 // Validate will return a non-nil error if the fields on index break an
@@ -38,6 +46,9 @@ func (index *EmbeddingIndex) Validate() error {
 
 // Filter removes all files from the index that are in the set and updates the ranks
 func (index *EmbeddingIndex) filter(set map[string]struct{}, ranks types.RepoPathRanks) {
+	// The IVF index refers to rows by position, so it is invalid once rows are removed.
+	index.IVF = nil
+
 	// We can reset Ranks here because we are anyway going to update them based on
 	// "ranks".
 	index.Ranks = make([]float32, 0, len(index.RowMetadata))
@@ -64,6 +75,7 @@ func (index *EmbeddingIndex) filter(set map[string]struct{}, ranks types.RepoPat
 }
 
 func (index *EmbeddingIndex) append(other EmbeddingIndex) {
+	index.IVF = nil
 	index.RowMetadata = append(index.RowMetadata, other.RowMetadata...)
 	index.Ranks = append(index.Ranks, other.Ranks...)
 	index.Embeddings = append(index.Embeddings, other.Embeddings...)
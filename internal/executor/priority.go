@@ -0,0 +1,14 @@
+package executor
+
+// JobPriority is the priority class of an executor job. Jobs of a higher priority class
+// are dequeued before jobs of a lower priority class, regardless of their position in
+// the queue.
+type JobPriority int
+
+const (
+	// JobPriorityNormal is the default priority of executor jobs.
+	JobPriorityNormal JobPriority = 0
+	// JobPriorityHigh is the priority of jobs a user explicitly requested and is
+	// actively waiting for.
+	JobPriorityHigh JobPriority = 1
+)
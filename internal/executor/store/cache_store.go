@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+)
+
+// JobCacheStore is the store for interacting with the executor_job_caches table. The
+// table tracks the size and usage of the job caches kept in the upload store, so that
+// the least recently used caches can be evicted.
+type JobCacheStore interface {
+	// Upsert records the size of the cache stored under key and marks it as used.
+	Upsert(ctx context.Context, key string, size int64) error
+	// Touch marks the cache stored under key as used. It returns false if the cache does not exist.
+	Touch(ctx context.Context, key string) (bool, error)
+	// Exists checks if the cache stored under key exists.
+	Exists(ctx context.Context, key string) (bool, error)
+	// Delete deletes the cache stored under key.
+	Delete(ctx context.Context, key string) error
+	// EvictLeastRecentlyUsed deletes the least recently used caches until the total size of the
+	// remaining caches does not exceed maxTotalSize. It returns the keys of the deleted caches.
+	EvictLeastRecentlyUsed(ctx context.Context, maxTotalSize int64) ([]string, error)
+}
+
+type jobCacheStore struct {
+	*basestore.Store
+}
+
+// NewJobCacheStore creates a new JobCacheStore.
+func NewJobCacheStore(db database.DB) JobCacheStore {
+	return &jobCacheStore{Store: basestore.NewWithHandle(db.Handle())}
+}
+
+func (s *jobCacheStore) Upsert(ctx context.Context, key string, size int64) error {
+	return s.Exec(ctx, sqlf.Sprintf(upsertExecutorJobCacheFmtstr, key, size))
+}
+
+const upsertExecutorJobCacheFmtstr = `
+INSERT INTO executor_job_caches (key, size_bytes)
+VALUES (%s, %s)
+ON CONFLICT (key) DO UPDATE SET size_bytes = EXCLUDED.size_bytes, last_accessed_at = NOW()
+`
+
+func (s *jobCacheStore) Touch(ctx context.Context, key string) (bool, error) {
+	_, ok, err := basestore.ScanFirstString(s.Query(ctx, sqlf.Sprintf(touchExecutorJobCacheFmtstr, key)))
+	return ok, err
+}
+
+const touchExecutorJobCacheFmtstr = `
+UPDATE executor_job_caches SET last_accessed_at = NOW()
+WHERE key = %s
+RETURNING key
+`
+
+func (s *jobCacheStore) Exists(ctx context.Context, key string) (bool, error) {
+	exists, _, err := basestore.ScanFirstBool(s.Query(ctx, sqlf.Sprintf(existsExecutorJobCacheFmtstr, key)))
+	return exists, err
+}
+
+const existsExecutorJobCacheFmtstr = `
+SELECT EXISTS(SELECT 1 FROM executor_job_caches WHERE key = %s)
+`
+
+func (s *jobCacheStore) Delete(ctx context.Context, key string) error {
+	return s.Exec(ctx, sqlf.Sprintf(deleteExecutorJobCacheFmtstr, key))
+}
+
+const deleteExecutorJobCacheFmtstr = `
+DELETE FROM executor_job_caches WHERE key = %s
+`
+
+func (s *jobCacheStore) EvictLeastRecentlyUsed(ctx context.Context, maxTotalSize int64) ([]string, error) {
+	return basestore.ScanStrings(s.Query(ctx, sqlf.Sprintf(evictExecutorJobCachesFmtstr, maxTotalSize)))
+}
+
+const evictExecutorJobCachesFmtstr = `
+WITH ranked AS (
+	SELECT
+		key,
+		SUM(size_bytes) OVER (ORDER BY last_accessed_at DESC, key) AS cumulative_size
+	FROM executor_job_caches
+)
+DELETE FROM executor_job_caches
+WHERE key IN (SELECT key FROM ranked WHERE cumulative_size > %s)
+RETURNING key
+`
@@ -0,0 +1,73 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/executor/store"
+)
+
+func TestJobCacheStore(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	cacheStore := store.NewJobCacheStore(db)
+
+	ctx := context.Background()
+
+	exists, err := cacheStore.Exists(ctx, "test/github.com/foo/bar/-/a")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	found, err := cacheStore.Touch(ctx, "test/github.com/foo/bar/-/a")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, cacheStore.Upsert(ctx, "test/github.com/foo/bar/-/a", 10))
+	require.NoError(t, cacheStore.Upsert(ctx, "test/github.com/foo/bar/-/b", 20))
+	require.NoError(t, cacheStore.Upsert(ctx, "test/github.com/foo/bar/-/c", 30))
+	// Replacing the content of a cache updates its size.
+	require.NoError(t, cacheStore.Upsert(ctx, "test/github.com/foo/bar/-/c", 40))
+
+	exists, err = cacheStore.Exists(ctx, "test/github.com/foo/bar/-/a")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// Make a the least recently used cache, followed by c and b.
+	for key, age := range map[string]string{
+		"test/github.com/foo/bar/-/a": "3 hours",
+		"test/github.com/foo/bar/-/b": "1 hour",
+		"test/github.com/foo/bar/-/c": "2 hours",
+	} {
+		_, err := db.ExecContext(ctx, "UPDATE executor_job_caches SET last_accessed_at = NOW() - $2::interval WHERE key = $1", key, age)
+		require.NoError(t, err)
+	}
+
+	evicted, err := cacheStore.EvictLeastRecentlyUsed(ctx, 60)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"test/github.com/foo/bar/-/a"}, evicted)
+
+	// Using c makes b the least recently used cache.
+	found, err = cacheStore.Touch(ctx, "test/github.com/foo/bar/-/c")
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	evicted, err = cacheStore.EvictLeastRecentlyUsed(ctx, 50)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"test/github.com/foo/bar/-/b"}, evicted)
+
+	evicted, err = cacheStore.EvictLeastRecentlyUsed(ctx, 50)
+	require.NoError(t, err)
+	assert.Empty(t, evicted)
+
+	require.NoError(t, cacheStore.Delete(ctx, "test/github.com/foo/bar/-/c"))
+
+	var count int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM executor_job_caches").Scan(&count))
+	assert.Zero(t, count)
+}
@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// JobLogChunk is a piece of the output of a command run by an executor job.
+type JobLogChunk struct {
+	ID        int64
+	EntryID   int
+	EntryKey  string
+	Data      string
+	CreatedAt time.Time
+}
+
+// JobLogStore is the store for interacting with the executor_job_log_chunks table. Executors
+// append the output of their commands while they run, so that the logs of a job can be tailed
+// and searched before the job completes.
+type JobLogStore interface {
+	// Append appends a chunk of output to the log entry of the job.
+	Append(ctx context.Context, queue string, jobID, entryID int, entryKey, data string) error
+	// List returns up to limit chunks of the job with an ID greater than afterID, in the
+	// order they were appended.
+	List(ctx context.Context, queue string, jobID int, afterID int64, limit int) ([]JobLogChunk, error)
+	// DeleteForJob deletes all chunks of the job.
+	DeleteForJob(ctx context.Context, queue string, jobID int) error
+	// DeleteOlderThan deletes the chunks that were appended more than maxAge ago. It returns the
+	// number of deleted chunks.
+	DeleteOlderThan(ctx context.Context, maxAge time.Duration) (int, error)
+}
+
+type jobLogStore struct {
+	*basestore.Store
+}
+
+// NewJobLogStore creates a new JobLogStore.
+func NewJobLogStore(db database.DB) JobLogStore {
+	return &jobLogStore{Store: basestore.NewWithHandle(db.Handle())}
+}
+
+func (s *jobLogStore) Append(ctx context.Context, queue string, jobID, entryID int, entryKey, data string) error {
+	return s.Exec(ctx, sqlf.Sprintf(appendExecutorJobLogChunkFmtstr, queue, jobID, entryID, entryKey, data))
+}
+
+const appendExecutorJobLogChunkFmtstr = `
+INSERT INTO executor_job_log_chunks (queue, job_id, entry_id, entry_key, data)
+VALUES (%s, %s, %s, %s, %s)
+`
+
+func (s *jobLogStore) List(ctx context.Context, queue string, jobID int, afterID int64, limit int) ([]JobLogChunk, error) {
+	return scanJobLogChunks(s.Query(ctx, sqlf.Sprintf(listExecutorJobLogChunksFmtstr, queue, jobID, afterID, limit)))
+}
+
+const listExecutorJobLogChunksFmtstr = `
+SELECT id, entry_id, entry_key, data, created_at
+FROM executor_job_log_chunks
+WHERE queue = %s AND job_id = %s AND id > %s
+ORDER BY id
+LIMIT %s
+`
+
+var scanJobLogChunks = basestore.NewSliceScanner(func(s dbutil.Scanner) (chunk JobLogChunk, err error) {
+	err = s.Scan(&chunk.ID, &chunk.EntryID, &chunk.EntryKey, &chunk.Data, &chunk.CreatedAt)
+	return chunk, err
+})
+
+func (s *jobLogStore) DeleteForJob(ctx context.Context, queue string, jobID int) error {
+	return s.Exec(ctx, sqlf.Sprintf(deleteExecutorJobLogChunksForJobFmtstr, queue, jobID))
+}
+
+const deleteExecutorJobLogChunksForJobFmtstr = `
+DELETE FROM executor_job_log_chunks WHERE queue = %s AND job_id = %s
+`
+
+func (s *jobLogStore) DeleteOlderThan(ctx context.Context, maxAge time.Duration) (int, error) {
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(deleteExecutorJobLogChunksOlderThanFmtstr, int(maxAge/time.Second))))
+	return count, err
+}
+
+const deleteExecutorJobLogChunksOlderThanFmtstr = `
+WITH deleted AS (
+	DELETE FROM executor_job_log_chunks
+	WHERE created_at < NOW() - (%s * '1 second'::interval)
+	RETURNING 1
+)
+SELECT COUNT(*) FROM deleted
+`
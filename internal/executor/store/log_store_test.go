@@ -0,0 +1,65 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/executor/store"
+)
+
+func TestJobLogStore(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	logStore := store.NewJobLogStore(db)
+
+	ctx := context.Background()
+
+	require.NoError(t, logStore.Append(ctx, "batches", 1, 1, "setup.git.init", "Initialized empty Git repository\n"))
+	require.NoError(t, logStore.Append(ctx, "batches", 1, 2, "step.docker.step.0.run", "hello\n"))
+	require.NoError(t, logStore.Append(ctx, "batches", 1, 2, "step.docker.step.0.run", "world\n"))
+	// Chunks of other jobs and queues are not returned.
+	require.NoError(t, logStore.Append(ctx, "batches", 2, 1, "setup.git.init", "other job\n"))
+	require.NoError(t, logStore.Append(ctx, "codeintel", 1, 1, "setup.git.init", "other queue\n"))
+
+	chunks, err := logStore.List(ctx, "batches", 1, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+	assert.Equal(t, "setup.git.init", chunks[0].EntryKey)
+	assert.Equal(t, 2, chunks[1].EntryID)
+	assert.Equal(t, "hello\n", chunks[1].Data)
+	assert.Equal(t, "world\n", chunks[2].Data)
+
+	// Tailing the logs continues after the last chunk seen.
+	tail, err := logStore.List(ctx, "batches", 1, chunks[1].ID, 100)
+	require.NoError(t, err)
+	require.Len(t, tail, 1)
+	assert.Equal(t, chunks[2].ID, tail[0].ID)
+
+	limited, err := logStore.List(ctx, "batches", 1, 0, 1)
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+	assert.Equal(t, chunks[0].ID, limited[0].ID)
+
+	require.NoError(t, logStore.DeleteForJob(ctx, "batches", 1))
+	chunks, err = logStore.List(ctx, "batches", 1, 0, 100)
+	require.NoError(t, err)
+	assert.Empty(t, chunks)
+
+	_, err = db.ExecContext(ctx, "UPDATE executor_job_log_chunks SET created_at = NOW() - '2 days'::interval WHERE queue = 'codeintel'")
+	require.NoError(t, err)
+
+	deleted, err := logStore.DeleteOlderThan(ctx, 24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	chunks, err = logStore.List(ctx, "batches", 2, 0, 100)
+	require.NoError(t, err)
+	assert.Len(t, chunks, 1)
+}
@@ -9,8 +9,1195 @@ package store
 import (
 	"context"
 	"sync"
+	"time"
 )
 
+// MockJobCacheStore is a mock implementation of the JobCacheStore interface
+// (from the package
+// github.com/sourcegraph/sourcegraph/internal/executor/store) used for unit
+// testing.
+type MockJobCacheStore struct {
+	// DeleteFunc is an instance of a mock function object controlling the
+	// behavior of the method Delete.
+	DeleteFunc *JobCacheStoreDeleteFunc
+	// EvictLeastRecentlyUsedFunc is an instance of a mock function object
+	// controlling the behavior of the method EvictLeastRecentlyUsed.
+	EvictLeastRecentlyUsedFunc *JobCacheStoreEvictLeastRecentlyUsedFunc
+	// ExistsFunc is an instance of a mock function object controlling the
+	// behavior of the method Exists.
+	ExistsFunc *JobCacheStoreExistsFunc
+	// TouchFunc is an instance of a mock function object controlling the
+	// behavior of the method Touch.
+	TouchFunc *JobCacheStoreTouchFunc
+	// UpsertFunc is an instance of a mock function object controlling the
+	// behavior of the method Upsert.
+	UpsertFunc *JobCacheStoreUpsertFunc
+}
+
+// NewMockJobCacheStore creates a new mock of the JobCacheStore interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockJobCacheStore() *MockJobCacheStore {
+	return &MockJobCacheStore{
+		DeleteFunc: &JobCacheStoreDeleteFunc{
+			defaultHook: func(context.Context, string) (r0 error) {
+				return
+			},
+		},
+		EvictLeastRecentlyUsedFunc: &JobCacheStoreEvictLeastRecentlyUsedFunc{
+			defaultHook: func(context.Context, int64) (r0 []string, r1 error) {
+				return
+			},
+		},
+		ExistsFunc: &JobCacheStoreExistsFunc{
+			defaultHook: func(context.Context, string) (r0 bool, r1 error) {
+				return
+			},
+		},
+		TouchFunc: &JobCacheStoreTouchFunc{
+			defaultHook: func(context.Context, string) (r0 bool, r1 error) {
+				return
+			},
+		},
+		UpsertFunc: &JobCacheStoreUpsertFunc{
+			defaultHook: func(context.Context, string, int64) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockJobCacheStore creates a new mock of the JobCacheStore
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockJobCacheStore() *MockJobCacheStore {
+	return &MockJobCacheStore{
+		DeleteFunc: &JobCacheStoreDeleteFunc{
+			defaultHook: func(context.Context, string) error {
+				panic("unexpected invocation of MockJobCacheStore.Delete")
+			},
+		},
+		EvictLeastRecentlyUsedFunc: &JobCacheStoreEvictLeastRecentlyUsedFunc{
+			defaultHook: func(context.Context, int64) ([]string, error) {
+				panic("unexpected invocation of MockJobCacheStore.EvictLeastRecentlyUsed")
+			},
+		},
+		ExistsFunc: &JobCacheStoreExistsFunc{
+			defaultHook: func(context.Context, string) (bool, error) {
+				panic("unexpected invocation of MockJobCacheStore.Exists")
+			},
+		},
+		TouchFunc: &JobCacheStoreTouchFunc{
+			defaultHook: func(context.Context, string) (bool, error) {
+				panic("unexpected invocation of MockJobCacheStore.Touch")
+			},
+		},
+		UpsertFunc: &JobCacheStoreUpsertFunc{
+			defaultHook: func(context.Context, string, int64) error {
+				panic("unexpected invocation of MockJobCacheStore.Upsert")
+			},
+		},
+	}
+}
+
+// NewMockJobCacheStoreFrom creates a new mock of the MockJobCacheStore
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockJobCacheStoreFrom(i JobCacheStore) *MockJobCacheStore {
+	return &MockJobCacheStore{
+		DeleteFunc: &JobCacheStoreDeleteFunc{
+			defaultHook: i.Delete,
+		},
+		EvictLeastRecentlyUsedFunc: &JobCacheStoreEvictLeastRecentlyUsedFunc{
+			defaultHook: i.EvictLeastRecentlyUsed,
+		},
+		ExistsFunc: &JobCacheStoreExistsFunc{
+			defaultHook: i.Exists,
+		},
+		TouchFunc: &JobCacheStoreTouchFunc{
+			defaultHook: i.Touch,
+		},
+		UpsertFunc: &JobCacheStoreUpsertFunc{
+			defaultHook: i.Upsert,
+		},
+	}
+}
+
+// JobCacheStoreDeleteFunc describes the behavior when the Delete method of
+// the parent MockJobCacheStore instance is invoked.
+type JobCacheStoreDeleteFunc struct {
+	defaultHook func(context.Context, string) error
+	hooks       []func(context.Context, string) error
+	history     []JobCacheStoreDeleteFuncCall
+	mutex       sync.Mutex
+}
+
+// Delete delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockJobCacheStore) Delete(v0 context.Context, v1 string) error {
+	r0 := m.DeleteFunc.nextHook()(v0, v1)
+	m.DeleteFunc.appendCall(JobCacheStoreDeleteFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Delete method of the
+// parent MockJobCacheStore instance is invoked and the hook queue is empty.
+func (f *JobCacheStoreDeleteFunc) SetDefaultHook(hook func(context.Context, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Delete method of the parent MockJobCacheStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *JobCacheStoreDeleteFunc) PushHook(hook func(context.Context, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *JobCacheStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *JobCacheStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string) error {
+		return r0
+	})
+}
+
+func (f *JobCacheStoreDeleteFunc) nextHook() func(context.Context, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *JobCacheStoreDeleteFunc) appendCall(r0 JobCacheStoreDeleteFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of JobCacheStoreDeleteFuncCall objects
+// describing the invocations of this function.
+func (f *JobCacheStoreDeleteFunc) History() []JobCacheStoreDeleteFuncCall {
+	f.mutex.Lock()
+	history := make([]JobCacheStoreDeleteFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// JobCacheStoreDeleteFuncCall is an object that describes an invocation of
+// method Delete on an instance of MockJobCacheStore.
+type JobCacheStoreDeleteFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c JobCacheStoreDeleteFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c JobCacheStoreDeleteFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// JobCacheStoreEvictLeastRecentlyUsedFunc describes the behavior when the
+// EvictLeastRecentlyUsed method of the parent MockJobCacheStore instance is
+// invoked.
+type JobCacheStoreEvictLeastRecentlyUsedFunc struct {
+	defaultHook func(context.Context, int64) ([]string, error)
+	hooks       []func(context.Context, int64) ([]string, error)
+	history     []JobCacheStoreEvictLeastRecentlyUsedFuncCall
+	mutex       sync.Mutex
+}
+
+// EvictLeastRecentlyUsed delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockJobCacheStore) EvictLeastRecentlyUsed(v0 context.Context, v1 int64) ([]string, error) {
+	r0, r1 := m.EvictLeastRecentlyUsedFunc.nextHook()(v0, v1)
+	m.EvictLeastRecentlyUsedFunc.appendCall(JobCacheStoreEvictLeastRecentlyUsedFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// EvictLeastRecentlyUsed method of the parent MockJobCacheStore instance is
+// invoked and the hook queue is empty.
+func (f *JobCacheStoreEvictLeastRecentlyUsedFunc) SetDefaultHook(hook func(context.Context, int64) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// EvictLeastRecentlyUsed method of the parent MockJobCacheStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *JobCacheStoreEvictLeastRecentlyUsedFunc) PushHook(hook func(context.Context, int64) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *JobCacheStoreEvictLeastRecentlyUsedFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *JobCacheStoreEvictLeastRecentlyUsedFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, int64) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *JobCacheStoreEvictLeastRecentlyUsedFunc) nextHook() func(context.Context, int64) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *JobCacheStoreEvictLeastRecentlyUsedFunc) appendCall(r0 JobCacheStoreEvictLeastRecentlyUsedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of JobCacheStoreEvictLeastRecentlyUsedFuncCall
+// objects describing the invocations of this function.
+func (f *JobCacheStoreEvictLeastRecentlyUsedFunc) History() []JobCacheStoreEvictLeastRecentlyUsedFuncCall {
+	f.mutex.Lock()
+	history := make([]JobCacheStoreEvictLeastRecentlyUsedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// JobCacheStoreEvictLeastRecentlyUsedFuncCall is an object that describes
+// an invocation of method EvictLeastRecentlyUsed on an instance of
+// MockJobCacheStore.
+type JobCacheStoreEvictLeastRecentlyUsedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c JobCacheStoreEvictLeastRecentlyUsedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c JobCacheStoreEvictLeastRecentlyUsedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// JobCacheStoreExistsFunc describes the behavior when the Exists method of
+// the parent MockJobCacheStore instance is invoked.
+type JobCacheStoreExistsFunc struct {
+	defaultHook func(context.Context, string) (bool, error)
+	hooks       []func(context.Context, string) (bool, error)
+	history     []JobCacheStoreExistsFuncCall
+	mutex       sync.Mutex
+}
+
+// Exists delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockJobCacheStore) Exists(v0 context.Context, v1 string) (bool, error) {
+	r0, r1 := m.ExistsFunc.nextHook()(v0, v1)
+	m.ExistsFunc.appendCall(JobCacheStoreExistsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Exists method of the
+// parent MockJobCacheStore instance is invoked and the hook queue is empty.
+func (f *JobCacheStoreExistsFunc) SetDefaultHook(hook func(context.Context, string) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Exists method of the parent MockJobCacheStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *JobCacheStoreExistsFunc) PushHook(hook func(context.Context, string) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *JobCacheStoreExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *JobCacheStoreExistsFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *JobCacheStoreExistsFunc) nextHook() func(context.Context, string) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *JobCacheStoreExistsFunc) appendCall(r0 JobCacheStoreExistsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of JobCacheStoreExistsFuncCall objects
+// describing the invocations of this function.
+func (f *JobCacheStoreExistsFunc) History() []JobCacheStoreExistsFuncCall {
+	f.mutex.Lock()
+	history := make([]JobCacheStoreExistsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// JobCacheStoreExistsFuncCall is an object that describes an invocation of
+// method Exists on an instance of MockJobCacheStore.
+type JobCacheStoreExistsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c JobCacheStoreExistsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c JobCacheStoreExistsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// JobCacheStoreTouchFunc describes the behavior when the Touch method of
+// the parent MockJobCacheStore instance is invoked.
+type JobCacheStoreTouchFunc struct {
+	defaultHook func(context.Context, string) (bool, error)
+	hooks       []func(context.Context, string) (bool, error)
+	history     []JobCacheStoreTouchFuncCall
+	mutex       sync.Mutex
+}
+
+// Touch delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockJobCacheStore) Touch(v0 context.Context, v1 string) (bool, error) {
+	r0, r1 := m.TouchFunc.nextHook()(v0, v1)
+	m.TouchFunc.appendCall(JobCacheStoreTouchFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Touch method of the
+// parent MockJobCacheStore instance is invoked and the hook queue is empty.
+func (f *JobCacheStoreTouchFunc) SetDefaultHook(hook func(context.Context, string) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Touch method of the parent MockJobCacheStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *JobCacheStoreTouchFunc) PushHook(hook func(context.Context, string) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *JobCacheStoreTouchFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *JobCacheStoreTouchFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *JobCacheStoreTouchFunc) nextHook() func(context.Context, string) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *JobCacheStoreTouchFunc) appendCall(r0 JobCacheStoreTouchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of JobCacheStoreTouchFuncCall objects
+// describing the invocations of this function.
+func (f *JobCacheStoreTouchFunc) History() []JobCacheStoreTouchFuncCall {
+	f.mutex.Lock()
+	history := make([]JobCacheStoreTouchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// JobCacheStoreTouchFuncCall is an object that describes an invocation of
+// method Touch on an instance of MockJobCacheStore.
+type JobCacheStoreTouchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c JobCacheStoreTouchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c JobCacheStoreTouchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// JobCacheStoreUpsertFunc describes the behavior when the Upsert method of
+// the parent MockJobCacheStore instance is invoked.
+type JobCacheStoreUpsertFunc struct {
+	defaultHook func(context.Context, string, int64) error
+	hooks       []func(context.Context, string, int64) error
+	history     []JobCacheStoreUpsertFuncCall
+	mutex       sync.Mutex
+}
+
+// Upsert delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockJobCacheStore) Upsert(v0 context.Context, v1 string, v2 int64) error {
+	r0 := m.UpsertFunc.nextHook()(v0, v1, v2)
+	m.UpsertFunc.appendCall(JobCacheStoreUpsertFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Upsert method of the
+// parent MockJobCacheStore instance is invoked and the hook queue is empty.
+func (f *JobCacheStoreUpsertFunc) SetDefaultHook(hook func(context.Context, string, int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Upsert method of the parent MockJobCacheStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *JobCacheStoreUpsertFunc) PushHook(hook func(context.Context, string, int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *JobCacheStoreUpsertFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *JobCacheStoreUpsertFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, int64) error {
+		return r0
+	})
+}
+
+func (f *JobCacheStoreUpsertFunc) nextHook() func(context.Context, string, int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *JobCacheStoreUpsertFunc) appendCall(r0 JobCacheStoreUpsertFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of JobCacheStoreUpsertFuncCall objects
+// describing the invocations of this function.
+func (f *JobCacheStoreUpsertFunc) History() []JobCacheStoreUpsertFuncCall {
+	f.mutex.Lock()
+	history := make([]JobCacheStoreUpsertFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// JobCacheStoreUpsertFuncCall is an object that describes an invocation of
+// method Upsert on an instance of MockJobCacheStore.
+type JobCacheStoreUpsertFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c JobCacheStoreUpsertFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c JobCacheStoreUpsertFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockJobLogStore is a mock implementation of the JobLogStore interface
+// (from the package
+// github.com/sourcegraph/sourcegraph/internal/executor/store) used for unit
+// testing.
+type MockJobLogStore struct {
+	// AppendFunc is an instance of a mock function object controlling the
+	// behavior of the method Append.
+	AppendFunc *JobLogStoreAppendFunc
+	// DeleteForJobFunc is an instance of a mock function object controlling
+	// the behavior of the method DeleteForJob.
+	DeleteForJobFunc *JobLogStoreDeleteForJobFunc
+	// DeleteOlderThanFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteOlderThan.
+	DeleteOlderThanFunc *JobLogStoreDeleteOlderThanFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *JobLogStoreListFunc
+}
+
+// NewMockJobLogStore creates a new mock of the JobLogStore interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockJobLogStore() *MockJobLogStore {
+	return &MockJobLogStore{
+		AppendFunc: &JobLogStoreAppendFunc{
+			defaultHook: func(context.Context, string, int, int, string, string) (r0 error) {
+				return
+			},
+		},
+		DeleteForJobFunc: &JobLogStoreDeleteForJobFunc{
+			defaultHook: func(context.Context, string, int) (r0 error) {
+				return
+			},
+		},
+		DeleteOlderThanFunc: &JobLogStoreDeleteOlderThanFunc{
+			defaultHook: func(context.Context, time.Duration) (r0 int, r1 error) {
+				return
+			},
+		},
+		ListFunc: &JobLogStoreListFunc{
+			defaultHook: func(context.Context, string, int, int64, int) (r0 []JobLogChunk, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockJobLogStore creates a new mock of the JobLogStore interface.
+// All methods panic on invocation, unless overwritten.
+func NewStrictMockJobLogStore() *MockJobLogStore {
+	return &MockJobLogStore{
+		AppendFunc: &JobLogStoreAppendFunc{
+			defaultHook: func(context.Context, string, int, int, string, string) error {
+				panic("unexpected invocation of MockJobLogStore.Append")
+			},
+		},
+		DeleteForJobFunc: &JobLogStoreDeleteForJobFunc{
+			defaultHook: func(context.Context, string, int) error {
+				panic("unexpected invocation of MockJobLogStore.DeleteForJob")
+			},
+		},
+		DeleteOlderThanFunc: &JobLogStoreDeleteOlderThanFunc{
+			defaultHook: func(context.Context, time.Duration) (int, error) {
+				panic("unexpected invocation of MockJobLogStore.DeleteOlderThan")
+			},
+		},
+		ListFunc: &JobLogStoreListFunc{
+			defaultHook: func(context.Context, string, int, int64, int) ([]JobLogChunk, error) {
+				panic("unexpected invocation of MockJobLogStore.List")
+			},
+		},
+	}
+}
+
+// NewMockJobLogStoreFrom creates a new mock of the MockJobLogStore
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockJobLogStoreFrom(i JobLogStore) *MockJobLogStore {
+	return &MockJobLogStore{
+		AppendFunc: &JobLogStoreAppendFunc{
+			defaultHook: i.Append,
+		},
+		DeleteForJobFunc: &JobLogStoreDeleteForJobFunc{
+			defaultHook: i.DeleteForJob,
+		},
+		DeleteOlderThanFunc: &JobLogStoreDeleteOlderThanFunc{
+			defaultHook: i.DeleteOlderThan,
+		},
+		ListFunc: &JobLogStoreListFunc{
+			defaultHook: i.List,
+		},
+	}
+}
+
+// JobLogStoreAppendFunc describes the behavior when the Append method of
+// the parent MockJobLogStore instance is invoked.
+type JobLogStoreAppendFunc struct {
+	defaultHook func(context.Context, string, int, int, string, string) error
+	hooks       []func(context.Context, string, int, int, string, string) error
+	history     []JobLogStoreAppendFuncCall
+	mutex       sync.Mutex
+}
+
+// Append delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockJobLogStore) Append(v0 context.Context, v1 string, v2 int, v3 int, v4 string, v5 string) error {
+	r0 := m.AppendFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.AppendFunc.appendCall(JobLogStoreAppendFuncCall{v0, v1, v2, v3, v4, v5, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Append method of the
+// parent MockJobLogStore instance is invoked and the hook queue is empty.
+func (f *JobLogStoreAppendFunc) SetDefaultHook(hook func(context.Context, string, int, int, string, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Append method of the parent MockJobLogStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *JobLogStoreAppendFunc) PushHook(hook func(context.Context, string, int, int, string, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *JobLogStoreAppendFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, int, int, string, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *JobLogStoreAppendFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, int, int, string, string) error {
+		return r0
+	})
+}
+
+func (f *JobLogStoreAppendFunc) nextHook() func(context.Context, string, int, int, string, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *JobLogStoreAppendFunc) appendCall(r0 JobLogStoreAppendFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of JobLogStoreAppendFuncCall objects
+// describing the invocations of this function.
+func (f *JobLogStoreAppendFunc) History() []JobLogStoreAppendFuncCall {
+	f.mutex.Lock()
+	history := make([]JobLogStoreAppendFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// JobLogStoreAppendFuncCall is an object that describes an invocation of
+// method Append on an instance of MockJobLogStore.
+type JobLogStoreAppendFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c JobLogStoreAppendFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c JobLogStoreAppendFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// JobLogStoreDeleteForJobFunc describes the behavior when the DeleteForJob
+// method of the parent MockJobLogStore instance is invoked.
+type JobLogStoreDeleteForJobFunc struct {
+	defaultHook func(context.Context, string, int) error
+	hooks       []func(context.Context, string, int) error
+	history     []JobLogStoreDeleteForJobFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteForJob delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockJobLogStore) DeleteForJob(v0 context.Context, v1 string, v2 int) error {
+	r0 := m.DeleteForJobFunc.nextHook()(v0, v1, v2)
+	m.DeleteForJobFunc.appendCall(JobLogStoreDeleteForJobFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteForJob method
+// of the parent MockJobLogStore instance is invoked and the hook queue is
+// empty.
+func (f *JobLogStoreDeleteForJobFunc) SetDefaultHook(hook func(context.Context, string, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteForJob method of the parent MockJobLogStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *JobLogStoreDeleteForJobFunc) PushHook(hook func(context.Context, string, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *JobLogStoreDeleteForJobFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *JobLogStoreDeleteForJobFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, int) error {
+		return r0
+	})
+}
+
+func (f *JobLogStoreDeleteForJobFunc) nextHook() func(context.Context, string, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *JobLogStoreDeleteForJobFunc) appendCall(r0 JobLogStoreDeleteForJobFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of JobLogStoreDeleteForJobFuncCall objects
+// describing the invocations of this function.
+func (f *JobLogStoreDeleteForJobFunc) History() []JobLogStoreDeleteForJobFuncCall {
+	f.mutex.Lock()
+	history := make([]JobLogStoreDeleteForJobFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// JobLogStoreDeleteForJobFuncCall is an object that describes an invocation
+// of method DeleteForJob on an instance of MockJobLogStore.
+type JobLogStoreDeleteForJobFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c JobLogStoreDeleteForJobFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c JobLogStoreDeleteForJobFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// JobLogStoreDeleteOlderThanFunc describes the behavior when the
+// DeleteOlderThan method of the parent MockJobLogStore instance is invoked.
+type JobLogStoreDeleteOlderThanFunc struct {
+	defaultHook func(context.Context, time.Duration) (int, error)
+	hooks       []func(context.Context, time.Duration) (int, error)
+	history     []JobLogStoreDeleteOlderThanFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteOlderThan delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockJobLogStore) DeleteOlderThan(v0 context.Context, v1 time.Duration) (int, error) {
+	r0, r1 := m.DeleteOlderThanFunc.nextHook()(v0, v1)
+	m.DeleteOlderThanFunc.appendCall(JobLogStoreDeleteOlderThanFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the DeleteOlderThan
+// method of the parent MockJobLogStore instance is invoked and the hook
+// queue is empty.
+func (f *JobLogStoreDeleteOlderThanFunc) SetDefaultHook(hook func(context.Context, time.Duration) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteOlderThan method of the parent MockJobLogStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *JobLogStoreDeleteOlderThanFunc) PushHook(hook func(context.Context, time.Duration) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *JobLogStoreDeleteOlderThanFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *JobLogStoreDeleteOlderThanFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, time.Duration) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *JobLogStoreDeleteOlderThanFunc) nextHook() func(context.Context, time.Duration) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *JobLogStoreDeleteOlderThanFunc) appendCall(r0 JobLogStoreDeleteOlderThanFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of JobLogStoreDeleteOlderThanFuncCall objects
+// describing the invocations of this function.
+func (f *JobLogStoreDeleteOlderThanFunc) History() []JobLogStoreDeleteOlderThanFuncCall {
+	f.mutex.Lock()
+	history := make([]JobLogStoreDeleteOlderThanFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// JobLogStoreDeleteOlderThanFuncCall is an object that describes an
+// invocation of method DeleteOlderThan on an instance of MockJobLogStore.
+type JobLogStoreDeleteOlderThanFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Duration
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c JobLogStoreDeleteOlderThanFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c JobLogStoreDeleteOlderThanFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// JobLogStoreListFunc describes the behavior when the List method of the
+// parent MockJobLogStore instance is invoked.
+type JobLogStoreListFunc struct {
+	defaultHook func(context.Context, string, int, int64, int) ([]JobLogChunk, error)
+	hooks       []func(context.Context, string, int, int64, int) ([]JobLogChunk, error)
+	history     []JobLogStoreListFuncCall
+	mutex       sync.Mutex
+}
+
+// List delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockJobLogStore) List(v0 context.Context, v1 string, v2 int, v3 int64, v4 int) ([]JobLogChunk, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.ListFunc.appendCall(JobLogStoreListFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockJobLogStore instance is invoked and the hook queue is empty.
+func (f *JobLogStoreListFunc) SetDefaultHook(hook func(context.Context, string, int, int64, int) ([]JobLogChunk, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// List method of the parent MockJobLogStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *JobLogStoreListFunc) PushHook(hook func(context.Context, string, int, int64, int) ([]JobLogChunk, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *JobLogStoreListFunc) SetDefaultReturn(r0 []JobLogChunk, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, int, int64, int) ([]JobLogChunk, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *JobLogStoreListFunc) PushReturn(r0 []JobLogChunk, r1 error) {
+	f.PushHook(func(context.Context, string, int, int64, int) ([]JobLogChunk, error) {
+		return r0, r1
+	})
+}
+
+func (f *JobLogStoreListFunc) nextHook() func(context.Context, string, int, int64, int) ([]JobLogChunk, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *JobLogStoreListFunc) appendCall(r0 JobLogStoreListFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of JobLogStoreListFuncCall objects describing
+// the invocations of this function.
+func (f *JobLogStoreListFunc) History() []JobLogStoreListFuncCall {
+	f.mutex.Lock()
+	history := make([]JobLogStoreListFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// JobLogStoreListFuncCall is an object that describes an invocation of
+// method List on an instance of MockJobLogStore.
+type JobLogStoreListFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []JobLogChunk
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c JobLogStoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c JobLogStoreListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // MockJobTokenStore is a mock implementation of the JobTokenStore interface
 // (from the package
 // github.com/sourcegraph/sourcegraph/internal/executor/store) used for unit
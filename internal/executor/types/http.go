@@ -33,6 +33,13 @@ type UpdateExecutionLogEntryRequest struct {
 	executor.ExecutionLogEntry
 }
 
+type AppendExecutionLogChunkRequest struct {
+	JobOperationRequest
+	EntryID  int    `json:"entryId"`
+	EntryKey string `json:"entryKey"`
+	Data     string `json:"data"`
+}
+
 type MarkCompleteRequest struct {
 	JobOperationRequest
 }
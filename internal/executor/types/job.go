@@ -50,6 +50,11 @@ type Job struct {
 	// The file paths must be relative and within the working directory.
 	VirtualMachineFiles map[string]VirtualMachineFile `json:"files"`
 
+	// Caches describe directories in the workspace that are persisted between runs of
+	// jobs for the same repository and queue. They are restored before the docker steps
+	// are invoked and stored again after all steps have completed successfully.
+	Caches []JobCache `json:"caches,omitempty"`
+
 	// DockerSteps describe a series of docker run commands to be invoked in the
 	// workspace. This may be done inside or outside a Firecracker virtual
 	// machine.
@@ -86,6 +91,7 @@ func (j Job) MarshalJSON() ([]byte, error) {
 			FetchTags:           j.FetchTags,
 			ShallowClone:        j.ShallowClone,
 			SparseCheckout:      j.SparseCheckout,
+			Caches:              j.Caches,
 			DockerSteps:         j.DockerSteps,
 			CliSteps:            j.CliSteps,
 			RedactedValues:      j.RedactedValues,
@@ -147,6 +153,7 @@ func (j *Job) UnmarshalJSON(data []byte) error {
 		for k, v := range v2.VirtualMachineFiles {
 			j.VirtualMachineFiles[k] = VirtualMachineFile(v)
 		}
+		j.Caches = v2.Caches
 		j.DockerSteps = v2.DockerSteps
 		j.CliSteps = v2.CliSteps
 		j.RedactedValues = v2.RedactedValues
@@ -197,6 +204,7 @@ type v2Job struct {
 	ShallowClone        bool                            `json:"shallowClone"`
 	SparseCheckout      []string                        `json:"sparseCheckout"`
 	VirtualMachineFiles map[string]v2VirtualMachineFile `json:"files"`
+	Caches              []JobCache                      `json:"caches,omitempty"`
 	DockerSteps         []DockerStep                    `json:"dockerSteps"`
 	CliSteps            []CliStep                       `json:"cliSteps"`
 	RedactedValues      map[string]string               `json:"redactedValues"`
@@ -251,6 +259,22 @@ type v1VirtualMachineFile struct {
 	ModifiedAt time.Time `json:"modifiedAt,omitempty"`
 }
 
+// JobCachesBucket is the bucket in the files store that job caches are stored in.
+const JobCachesBucket = "caches"
+
+// JobCache is a directory in the workspace that is archived after a job completed
+// successfully and restored before the next job of the same repository and queue
+// runs, e.g. to avoid downloading the same dependencies on every run.
+type JobCache struct {
+	// Key identifies the cache within the repository and queue of the job. Jobs
+	// declaring the same key share the cached content.
+	Key string `json:"key"`
+
+	// Path is the directory to cache. The path must be relative and within the
+	// working directory.
+	Path string `json:"path"`
+}
+
 func (j Job) RecordID() int {
 	return j.ID
 }
@@ -94,6 +94,38 @@ func TestJob_MarshalJSON(t *testing.T) {
 		"redactedValues": {
 			"password": "foo"
 		}
+	}`,
+		},
+		{
+			name: "Caches",
+			input: Job{
+				Version:        2,
+				ID:             1,
+				RepositoryName: "my-repo",
+				Commit:         "xyz",
+				Caches: []JobCache{
+					{Key: "go-modules", Path: ".cache/go-modules"},
+				},
+			},
+			expected: `{
+		"version": 2,
+		"id": 1,
+		"token": "",
+		"repositoryName": "my-repo",
+		"repositoryDirectory": "",
+		"commit": "xyz",
+		"fetchTags": false,
+		"shallowClone": false,
+		"sparseCheckout": null,
+		"files": {},
+		"caches": [{
+			"key": "go-modules",
+			"path": ".cache/go-modules"
+		}],
+		"dockerAuthConfig": {},
+		"dockerSteps": null,
+		"cliSteps": null,
+		"redactedValues": null
 	}`,
 		},
 		{
@@ -264,6 +296,29 @@ func TestJob_UnmarshalJSON(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Caches",
+			input: `{
+	"version": 2,
+	"id": 1,
+	"repositoryName": "my-repo",
+	"commit": "xyz",
+	"caches": [{
+		"key": "go-modules",
+		"path": ".cache/go-modules"
+	}]
+}`,
+			expected: Job{
+				Version:             2,
+				ID:                  1,
+				RepositoryName:      "my-repo",
+				Commit:              "xyz",
+				VirtualMachineFiles: map[string]VirtualMachineFile{},
+				Caches: []JobCache{
+					{Key: "go-modules", Path: ".cache/go-modules"},
+				},
+			},
+		},
 		{
 			name: "4.2",
 			input: `{
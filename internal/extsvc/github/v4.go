@@ -887,6 +887,56 @@ func (c *V4Client) Releases(ctx context.Context, params *ReleasesParams) (*Relea
 	return &result.Repository.Releases, nil
 }
 
+// GetBranchStatusCheckRollupState returns the combined state of the commit
+// statuses and check runs of the latest commit of the given branch, e.g.
+// SUCCESS, FAILURE or PENDING. It returns an empty string if the commit has no
+// commit statuses or check runs.
+func (c *V4Client) GetBranchStatusCheckRollupState(ctx context.Context, owner, name, branch string) (string, error) {
+	const query = `
+		query($owner: String!, $name: String!, $ref: String!) {
+			repository(owner: $owner, name: $name) {
+				ref(qualifiedName: $ref) {
+					target {
+						... on Commit {
+							statusCheckRollup {
+								state
+							}
+						}
+					}
+				}
+			}
+		}
+	`
+
+	vars := map[string]any{
+		"owner": owner,
+		"name":  name,
+		"ref":   "refs/heads/" + abbreviateRef(branch),
+	}
+
+	var result struct {
+		Repository struct {
+			Ref *struct {
+				Target struct {
+					StatusCheckRollup *struct {
+						State string
+					}
+				}
+			}
+		}
+	}
+	if err := c.requestGraphQL(ctx, query, vars, &result); err != nil {
+		return "", err
+	}
+	if result.Repository.Ref == nil {
+		return "", errors.Errorf("branch %q not found", branch)
+	}
+	if rollup := result.Repository.Ref.Target.StatusCheckRollup; rollup != nil {
+		return rollup.State, nil
+	}
+	return "", nil
+}
+
 func graphQLErrorField(err graphqlError) log.Field {
 	return log.Object("err",
 		log.String("message", err.Message),
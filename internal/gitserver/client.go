@@ -892,12 +892,32 @@ func convertGRPCErrorToGitDomainError(err error) error {
 				CloneInProgress: payload.CloneInProgress,
 				CloneProgress:   payload.CloneProgress,
 			}
+
+		case *proto.RevisionNotFoundPayload:
+			return &gitdomain.RevisionNotFoundError{
+				Repo: api.RepoName(payload.GetRepo()),
+				Spec: payload.GetSpec(),
+			}
+
+		case *proto.FileNotFoundPayload:
+			return &os.PathError{
+				Op:   "open",
+				Path: string(payload.GetPath()),
+				Err:  os.ErrNotExist,
+			}
 		}
 	}
 
 	return err
 }
 
+// useTypedRPCs returns true if read operations should use the typed gRPC
+// endpoints instead of running git through Exec. Tests that run git locally
+// (see ClientMocks.LocalGitserver) keep using the Exec path.
+func useTypedRPCs(ctx context.Context) bool {
+	return conf.IsGRPCEnabled(ctx) && !ClientMocks.LocalGitserver
+}
+
 type CommandStatusError struct {
 	Message    string
 	StatusCode int32
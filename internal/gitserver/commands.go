@@ -25,6 +25,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/sourcegraph/go-diff/diff"
 
@@ -36,6 +37,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/fileutil"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/streamio"
 	"github.com/sourcegraph/sourcegraph/internal/honey"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
@@ -186,6 +188,29 @@ func (c *clientImplementor) ContributorCount(ctx context.Context, repo api.RepoN
 		return nil, err
 	}
 
+	if useTypedRPCs(ctx) {
+		client, err := c.clientSource.ClientForRepo(ctx, c.userAgent, repo)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := client.ContributorCounts(ctx, &proto.ContributorCountsRequest{
+			Repo:  string(repo),
+			Range: opt.Range,
+			After: opt.After,
+			Path:  []byte(opt.Path),
+		})
+		if err != nil {
+			return nil, convertGRPCErrorToGitDomainError(err)
+		}
+
+		var counts []*gitdomain.ContributorCount
+		for _, p := range res.GetCounts() {
+			counts = append(counts, gitdomain.ContributorCountFromProto(p))
+		}
+		return counts, nil
+	}
+
 	// We split the individual args for the shortlog command instead of -sne for easier arg checking in the allowlist.
 	args := []string{"shortlog", "-s", "-n", "-e", "--no-merges"}
 	if opt.After != "" {
@@ -623,6 +648,10 @@ func (c *clientImplementor) lsTreeUncached(ctx context.Context, repo api.RepoNam
 		return nil, err
 	}
 
+	if useTypedRPCs(ctx) {
+		return c.readDirGRPC(ctx, repo, commit, path, recurse)
+	}
+
 	args := []string{
 		"ls-tree",
 		"--long", // show size
@@ -752,6 +781,61 @@ func (c *clientImplementor) lsTreeUncached(ctx context.Context, repo api.RepoNam
 	return fis, nil
 }
 
+func (c *clientImplementor) readDirGRPC(ctx context.Context, repo api.RepoName, commit api.CommitID, path string, recurse bool) ([]fs.FileInfo, error) {
+	client, err := c.clientSource.ClientForRepo(ctx, c.userAgent, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.ReadDir(ctx, &proto.ReadDirRequest{
+		Repo:      string(repo),
+		CommitSha: string(commit),
+		Path:      []byte(path),
+		Recursive: recurse,
+	})
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+
+	fis := []fs.FileInfo{}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, convertGRPCErrorToGitDomainError(err)
+		}
+		for _, fi := range res.GetFileInfo() {
+			fis = append(fis, fileInfoFromProto(fi))
+		}
+	}
+	return fis, nil
+}
+
+func fileInfoFromProto(p *proto.FileInfo) fs.FileInfo {
+	var sys any
+	if sm := p.GetSubmodule(); sm != nil {
+		sys = gitdomain.Submodule{
+			URL:      sm.GetUrl(),
+			Path:     string(sm.GetPath()),
+			CommitID: api.CommitID(sm.GetCommitSha()),
+		}
+	} else if oid, err := decodeOID(p.GetBlobOid()); err == nil {
+		sys = objectInfo(oid)
+	}
+
+	return &fileutil.FileInfo{
+		Name_: string(p.GetName()),
+		Mode_: fs.FileMode(p.GetMode()),
+		Size_: p.GetSize(),
+		Sys_:  sys,
+	}
+}
+
 func decodeOID(sha string) (gitdomain.OID, error) {
 	oidBytes, err := hex.DecodeString(sha)
 	if err != nil {
@@ -829,9 +913,122 @@ func (c *clientImplementor) StreamBlameFile(ctx context.Context, repo api.RepoNa
 	})
 	defer endObservation(1, observation.Args{})
 
+	if useTypedRPCs(ctx) {
+		a := actor.FromContext(ctx)
+		hasAccess, err := authz.FilterActorPath(ctx, c.subRepoPermsChecker, a, repo, path)
+		if err != nil {
+			return nil, err
+		}
+		if !hasAccess {
+			return nil, errUnauthorizedStreamBlame{Repo: repo}
+		}
+		return c.blameGRPC(ctx, repo, path, opt)
+	}
+
 	return streamBlameFileCmd(ctx, c.subRepoPermsChecker, repo, path, opt, c.gitserverGitCommandFunc(repo))
 }
 
+// blameGRPC starts a Blame RPC and returns a HunkReader over its results. The
+// first response is read eagerly so that errors are returned immediately, like
+// they are by the Exec based implementation.
+func (c *clientImplementor) blameGRPC(ctx context.Context, repo api.RepoName, path string, opt *BlameOptions) (HunkReader, error) {
+	if opt == nil {
+		opt = &BlameOptions{}
+	}
+	if err := checkSpecArgSafety(string(opt.NewestCommit)); err != nil {
+		return nil, err
+	}
+
+	client, err := c.clientSource.ClientForRepo(ctx, c.userAgent, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &proto.BlameRequest{
+		Repo:             string(repo),
+		Commit:           string(opt.NewestCommit),
+		Path:             []byte(path),
+		IgnoreWhitespace: opt.IgnoreWhitespace,
+	}
+	if opt.StartLine != 0 || opt.EndLine != 0 {
+		req.Range = &proto.BlameRange{
+			StartLine: uint32(opt.StartLine),
+			EndLine:   uint32(opt.EndLine),
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := client.Blame(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+
+	first, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		cancel()
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+
+	return &grpcBlameHunkReader{
+		stream: stream,
+		first:  first,
+		err:    err,
+		cancel: cancel,
+	}, nil
+}
+
+// grpcBlameHunkReader is a HunkReader over the responses of a Blame RPC.
+type grpcBlameHunkReader struct {
+	stream proto.GitserverService_BlameClient
+	first  *proto.BlameResponse
+	err    error
+	cancel context.CancelFunc
+}
+
+func (r *grpcBlameHunkReader) Read() (*Hunk, error) {
+	if r.first != nil {
+		res := r.first
+		r.first = nil
+		return hunkFromProto(res.GetHunk()), nil
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	res, err := r.stream.Recv()
+	if err != nil {
+		if err != io.EOF {
+			err = convertGRPCErrorToGitDomainError(err)
+		}
+		r.err = err
+		return nil, err
+	}
+	return hunkFromProto(res.GetHunk()), nil
+}
+
+func (r *grpcBlameHunkReader) Close() error {
+	r.cancel()
+	return nil
+}
+
+func hunkFromProto(p *proto.BlameHunk) *Hunk {
+	return &Hunk{
+		StartLine: int(p.GetStartLine()),
+		EndLine:   int(p.GetEndLine()),
+		StartByte: int(p.GetStartByte()),
+		EndByte:   int(p.GetEndByte()),
+		CommitID:  api.CommitID(p.GetCommit()),
+		Author: gitdomain.Signature{
+			Name:  string(p.GetAuthor().GetName()),
+			Email: string(p.GetAuthor().GetEmail()),
+			Date:  p.GetAuthor().GetDate().AsTime(),
+		},
+		Message:  p.GetMessage(),
+		Filename: string(p.GetFilename()),
+	}
+}
+
 type errUnauthorizedStreamBlame struct {
 	Repo api.RepoName
 }
@@ -874,7 +1071,7 @@ func streamBlameFileCmd(ctx context.Context, checker authz.SubRepoPermissionChec
 		return nil, errors.WithMessage(err, fmt.Sprintf("git command %v failed", args))
 	}
 
-	return newBlameHunkReader(rc), nil
+	return NewBlameHunkReader(rc), nil
 }
 
 // BlameFile returns Git blame information about a file.
@@ -888,6 +1085,34 @@ func (c *clientImplementor) BlameFile(ctx context.Context, repo api.RepoName, pa
 	})
 	defer endObservation(1, observation.Args{})
 
+	if useTypedRPCs(ctx) {
+		a := actor.FromContext(ctx)
+		if hasAccess, err := authz.FilterActorPath(ctx, c.subRepoPermsChecker, a, repo, path); err != nil || !hasAccess {
+			return nil, err
+		}
+
+		hr, err := c.blameGRPC(ctx, repo, path, opt)
+		if err != nil {
+			return nil, err
+		}
+		defer hr.Close()
+
+		var hunks []*Hunk
+		for {
+			h, err := hr.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			hunks = append(hunks, h)
+		}
+		// Hunks are streamed in the order git computes them.
+		sort.Slice(hunks, func(i, j int) bool { return hunks[i].StartLine < hunks[j].StartLine })
+		return hunks, nil
+	}
+
 	return blameFileCmd(ctx, c.subRepoPermsChecker, c.gitserverGitCommandFunc(repo), path, opt, repo)
 }
 
@@ -1285,6 +1510,29 @@ func (c *clientImplementor) ListTags(ctx context.Context, repo api.RepoName, com
 	})
 	defer endObservation(1, observation.Args{})
 
+	if useTypedRPCs(ctx) {
+		refs, err := c.listRefsGRPC(ctx, &proto.ListRefsRequest{
+			Repo:           string(repo),
+			TagsOnly:       true,
+			PointsAtCommit: commitObjs,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var tags []*gitdomain.Tag
+		for _, ref := range refs {
+			tags = append(tags, &gitdomain.Tag{
+				Name:        ref.ShortName,
+				CommitID:    ref.CommitID,
+				CreatorDate: ref.CreatedDate,
+			})
+		}
+		// Match the order of `git tag --sort -creatordate`.
+		sort.SliceStable(tags, func(i, j int) bool { return tags[i].CreatorDate.After(tags[j].CreatorDate) })
+		return tags, nil
+	}
+
 	// Support both lightweight tags and tag objects. For creatordate, use an %(if) to prefer the
 	// taggerdate for tag objects, otherwise use the commit's committerdate (instead of just always
 	// using committerdate).
@@ -1388,6 +1636,23 @@ func (c *clientImplementor) MergeBase(ctx context.Context, repo api.RepoName, a,
 	})
 	defer endObservation(1, observation.Args{})
 
+	if useTypedRPCs(ctx) {
+		client, err := c.clientSource.ClientForRepo(ctx, c.userAgent, repo)
+		if err != nil {
+			return "", err
+		}
+
+		res, err := client.MergeBase(ctx, &proto.MergeBaseRequest{
+			Repo: string(repo),
+			Base: string(a),
+			Head: string(b),
+		})
+		if err != nil {
+			return "", convertGRPCErrorToGitDomainError(err)
+		}
+		return api.CommitID(res.GetMergeBaseCommitSha()), nil
+	}
+
 	cmd := c.gitCommand(repo, "merge-base", "--", string(a), string(b))
 	out, err := cmd.CombinedOutput(ctx)
 	if err != nil {
@@ -1442,6 +1707,26 @@ func (c *clientImplementor) GetBehindAhead(ctx context.Context, repo api.RepoNam
 		return nil, err
 	}
 
+	if useTypedRPCs(ctx) {
+		client, err := c.clientSource.ClientForRepo(ctx, c.userAgent, repo)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := client.Reachability(ctx, &proto.ReachabilityRequest{
+			Repo: string(repo),
+			Query: &proto.ReachabilityRequest_BehindAhead{BehindAhead: &proto.BehindAheadQuery{
+				Left:  left,
+				Right: right,
+			}},
+		})
+		if err != nil {
+			return nil, convertGRPCErrorToGitDomainError(err)
+		}
+		ba := res.GetBehindAhead()
+		return &gitdomain.BehindAhead{Behind: ba.GetBehind(), Ahead: ba.GetAhead()}, nil
+	}
+
 	cmd := c.gitCommand(repo, "rev-list", "--count", "--left-right", fmt.Sprintf("%s...%s", left, right))
 	out, err := cmd.Output(ctx)
 	if err != nil {
@@ -1824,6 +2109,37 @@ func (c *clientImplementor) CommitsUniqueToBranch(ctx context.Context, repo api.
 	})
 	defer endObservation(1, observation.Args{})
 
+	if useTypedRPCs(ctx) {
+		client, err := c.clientSource.ClientForRepo(ctx, c.userAgent, repo)
+		if err != nil {
+			return nil, err
+		}
+
+		q := &proto.CommitsUniqueToBranchQuery{
+			Branch:          branchName,
+			IsDefaultBranch: isDefaultBranch,
+		}
+		if maxAge != nil {
+			q.MaxAge = timestamppb.New(*maxAge)
+		}
+		res, err := client.Reachability(ctx, &proto.ReachabilityRequest{
+			Repo:  string(repo),
+			Query: &proto.ReachabilityRequest_CommitsUniqueToBranch{CommitsUniqueToBranch: q},
+		})
+		if err != nil {
+			return nil, convertGRPCErrorToGitDomainError(err)
+		}
+
+		commits := make(map[string]time.Time, len(res.GetCommitsUniqueToBranch().GetCommits()))
+		for _, commit := range res.GetCommitsUniqueToBranch().GetCommits() {
+			commits[commit.GetCommit()] = commit.GetCommitterDate().AsTime()
+		}
+		if authz.SubRepoEnabled(c.subRepoPermsChecker) {
+			return c.filterCommitsUniqueToBranch(ctx, repo, commits), nil
+		}
+		return commits, nil
+	}
+
 	args := []string{"log", "--pretty=format:%H:%cI"}
 	if maxAge != nil {
 		args = append(args, fmt.Sprintf("--after=%s", *maxAge))
@@ -1966,6 +2282,10 @@ func (c *clientImplementor) commitLog(ctx context.Context, repo api.RepoName, op
 }
 
 func (c *clientImplementor) getWrappedCommits(ctx context.Context, repo api.RepoName, opt CommitsOptions) ([]*wrappedCommit, error) {
+	if useTypedRPCs(ctx) {
+		return c.commitLogGRPC(ctx, repo, opt)
+	}
+
 	args, err := commitLogArgs([]string{"log", logFormatWithoutRefs}, opt)
 	if err != nil {
 		return nil, err
@@ -1982,6 +2302,62 @@ func (c *clientImplementor) getWrappedCommits(ctx context.Context, repo api.Repo
 	return wrappedCommits, nil
 }
 
+func (c *clientImplementor) commitLogGRPC(ctx context.Context, repo api.RepoName, opt CommitsOptions) ([]*wrappedCommit, error) {
+	if err := checkSpecArgSafety(opt.Range); err != nil {
+		return nil, err
+	}
+
+	client, err := c.clientSource.ClientForRepo(ctx, c.userAgent, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.CommitLog(ctx, &proto.CommitLogRequest{
+		Repo:                 string(repo),
+		Range:                opt.Range,
+		MaxCommits:           uint32(opt.N),
+		Skip:                 uint32(opt.Skip),
+		Author:               opt.Author,
+		After:                opt.After,
+		Before:               opt.Before,
+		Reverse:              opt.Reverse,
+		DateOrder:            opt.DateOrder,
+		MessageQuery:         opt.MessageQuery,
+		Path:                 []byte(opt.Path),
+		Follow:               opt.Follow,
+		IncludeModifiedFiles: opt.NameOnly,
+		NoEnsureRevision:     opt.NoEnsureRevision,
+	})
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+
+	var commits []*wrappedCommit
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, convertGRPCErrorToGitDomainError(err)
+		}
+		for _, p := range res.GetCommits() {
+			files := make([]string, 0, len(p.GetModifiedFiles()))
+			for _, f := range p.GetModifiedFiles() {
+				files = append(files, string(f))
+			}
+			commits = append(commits, &wrappedCommit{
+				Commit: gitdomain.CommitFromProto(p),
+				files:  files,
+			})
+		}
+	}
+	return commits, nil
+}
+
 func needMoreCommits(filtered []*gitdomain.Commit, commits []*wrappedCommit, opt CommitsOptions, checker authz.SubRepoPermissionChecker) bool {
 	if !authz.SubRepoEnabled(checker) {
 		return false
@@ -2448,6 +2824,25 @@ func (c *clientImplementor) BranchesContaining(ctx context.Context, repo api.Rep
 			return nil, err
 		}
 	}
+
+	if useTypedRPCs(ctx) {
+		client, err := c.clientSource.ClientForRepo(ctx, c.userAgent, repo)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := client.Reachability(ctx, &proto.ReachabilityRequest{
+			Repo: string(repo),
+			Query: &proto.ReachabilityRequest_BranchesContaining{BranchesContaining: &proto.BranchesContainingQuery{
+				Commit: string(commit),
+			}},
+		})
+		if err != nil {
+			return nil, convertGRPCErrorToGitDomainError(err)
+		}
+		return res.GetBranchesContaining().GetBranches(), nil
+	}
+
 	cmd := c.gitCommand(repo, "branch", "--contains", string(commit), "--format", "%(refname)")
 
 	out, err := cmd.CombinedOutput(ctx)
@@ -2487,6 +2882,25 @@ func (c *clientImplementor) RefDescriptions(ctx context.Context, repo api.RepoNa
 	})
 	defer endObservation(1, observation.Args{})
 
+	if useTypedRPCs(ctx) {
+		// HeadsOnly and TagsOnly each exclude the other, so all refs are listed,
+		// and refDescriptionsFromRefs skips those that are neither branches nor
+		// tags.
+		refs, err := c.listRefsGRPC(ctx, &proto.ListRefsRequest{
+			Repo:           string(repo),
+			PointsAtCommit: gitObjs,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		aggregate := refDescriptionsFromRefs(refs)
+		if authz.SubRepoEnabled(c.subRepoPermsChecker) {
+			return c.filterRefDescriptions(ctx, repo, aggregate), nil
+		}
+		return aggregate, nil
+	}
+
 	f := func(refPrefix string) (map[string][]gitdomain.RefDescription, error) {
 		format := strings.Join([]string{
 			derefField("objectname"),
@@ -2529,6 +2943,51 @@ func (c *clientImplementor) RefDescriptions(ctx context.Context, repo api.RepoNa
 	return aggregate, nil
 }
 
+// refDescriptionsFromRefs converts refs returned by the ListRefs RPC to a map
+// from commits to RefDescription objects, like parseRefDescriptions does for
+// the output of git for-each-ref.
+func refDescriptionsFromRefs(refs []gitdomain.Ref) map[string][]gitdomain.RefDescription {
+	refDescriptions := make(map[string][]gitdomain.RefDescription, len(refs))
+
+refLoop:
+	for _, ref := range refs {
+		var name string
+		for prefix, typ := range refPrefixes {
+			if ref.Type == typ {
+				name = strings.TrimPrefix(ref.Name, prefix)
+				break
+			}
+		}
+		if name == "" {
+			continue
+		}
+
+		// Tags attached to non-commit objects have no commit date, so fall back to
+		// the date of the tag itself.
+		createdDate := ref.CommitCreatedDate
+		if createdDate == nil && !ref.CreatedDate.IsZero() {
+			d := ref.CreatedDate
+			createdDate = &d
+		}
+
+		commit := string(ref.CommitID)
+		for _, candidate := range refDescriptions[commit] {
+			if candidate.Name == name && candidate.Type == ref.Type && candidate.IsDefaultBranch == ref.IsHead {
+				continue refLoop
+			}
+		}
+
+		refDescriptions[commit] = append(refDescriptions[commit], gitdomain.RefDescription{
+			Name:            name,
+			Type:            ref.Type,
+			IsDefaultBranch: ref.IsHead,
+			CreatedDate:     createdDate,
+		})
+	}
+
+	return refDescriptions
+}
+
 func derefField(field string) string {
 	return "%(if)%(*" + field + ")%(then)%(*" + field + ")%(else)%(" + field + ")%(end)"
 }
@@ -3000,9 +3459,45 @@ func (c *clientImplementor) ListRefs(ctx context.Context, repo api.RepoName) (_
 	})
 	defer endObservation(1, observation.Args{})
 
+	if useTypedRPCs(ctx) {
+		return c.listRefsGRPC(ctx, &proto.ListRefsRequest{Repo: string(repo)})
+	}
+
 	return c.showRef(ctx, repo)
 }
 
+// listRefsGRPC returns the refs matched by the given ListRefs RPC request,
+// sorted by name.
+func (c *clientImplementor) listRefsGRPC(ctx context.Context, req *proto.ListRefsRequest) ([]gitdomain.Ref, error) {
+	client, err := c.clientSource.ClientForRepo(ctx, c.userAgent, api.RepoName(req.GetRepo()))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.ListRefs(ctx, req)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+
+	var refs []gitdomain.Ref
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, convertGRPCErrorToGitDomainError(err)
+		}
+		for _, ref := range res.GetRefs() {
+			refs = append(refs, gitdomain.RefFromProto(ref))
+		}
+	}
+	return refs, nil
+}
+
 func (c *clientImplementor) showRef(ctx context.Context, repo api.RepoName, args ...string) ([]gitdomain.Ref, error) {
 	cmdArgs := append([]string{"show-ref"}, args...)
 	cmd := c.gitCommand(repo, cmdArgs...)
@@ -2160,6 +2160,32 @@ func TestParseRefDescriptions(t *testing.T) { // KEEP
 	}
 }
 
+func TestRefDescriptionsFromRefs(t *testing.T) {
+	commitDate := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	tagDate := time.Date(2023, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	refDescriptions := refDescriptionsFromRefs([]gitdomain.Ref{
+		{Name: "refs/heads/main", Type: gitdomain.RefTypeBranch, CommitID: "a", IsHead: true, CommitCreatedDate: &commitDate},
+		{Name: "refs/tags/v1", Type: gitdomain.RefTypeTag, CommitID: "a", CommitCreatedDate: &commitDate},
+		{Name: "refs/tags/tree", Type: gitdomain.RefTypeTag, CommitID: "b", CreatedDate: tagDate},
+		{Name: "refs/pull/1/head", Type: gitdomain.RefTypeUnknown, CommitID: "a", CommitCreatedDate: &commitDate},
+		{Name: "refs/remotes/origin/main", Type: gitdomain.RefTypeUnknown, CommitID: "c", CommitCreatedDate: &commitDate},
+	})
+
+	expected := map[string][]gitdomain.RefDescription{
+		"a": {
+			{Name: "main", Type: gitdomain.RefTypeBranch, IsDefaultBranch: true, CreatedDate: &commitDate},
+			{Name: "v1", Type: gitdomain.RefTypeTag, CreatedDate: &commitDate},
+		},
+		"b": {
+			{Name: "tree", Type: gitdomain.RefTypeTag, CreatedDate: &tagDate},
+		},
+	}
+	if diff := cmp.Diff(expected, refDescriptions); diff != "" {
+		t.Errorf("unexpected ref descriptions (-want +got):\n%s", diff)
+	}
+}
+
 func TestFilterRefDescriptions(t *testing.T) { // KEEP
 	ctx := actor.WithActor(context.Background(), &actor.Actor{
 		UID: 1,
@@ -3190,7 +3216,7 @@ func TestStreamBlameFile(t *testing.T) {
 func TestBlameHunkReader(t *testing.T) {
 	t.Run("OK matching hunks", func(t *testing.T) {
 		rc := io.NopCloser(strings.NewReader(testGitBlameOutputIncremental))
-		reader := newBlameHunkReader(rc)
+		reader := NewBlameHunkReader(rc)
 		defer reader.Close()
 
 		hunks := []*Hunk{}
@@ -3229,7 +3255,7 @@ func TestBlameHunkReader(t *testing.T) {
 
 	t.Run("OK parsing hunks", func(t *testing.T) {
 		rc := io.NopCloser(strings.NewReader(testGitBlameOutputIncremental2))
-		reader := newBlameHunkReader(rc)
+		reader := NewBlameHunkReader(rc)
 		defer reader.Close()
 
 		for {
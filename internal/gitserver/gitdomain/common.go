@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/gobwas/glob"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 
@@ -181,6 +182,47 @@ func (m Message) Body() string {
 	return strings.TrimSpace(message[i:])
 }
 
+func (c *Commit) ToProto() *proto.GitCommit {
+	parents := make([]string, 0, len(c.Parents))
+	for _, p := range c.Parents {
+		parents = append(parents, string(p))
+	}
+
+	var committer *proto.GitSignature
+	if c.Committer != nil {
+		committer = c.Committer.ToProto()
+	}
+
+	return &proto.GitCommit{
+		Oid:       string(c.ID),
+		Author:    c.Author.ToProto(),
+		Committer: committer,
+		Message:   []byte(c.Message),
+		Parents:   parents,
+	}
+}
+
+func CommitFromProto(p *proto.GitCommit) *Commit {
+	var parents []api.CommitID
+	for _, parent := range p.GetParents() {
+		parents = append(parents, api.CommitID(parent))
+	}
+
+	var committer *Signature
+	if p.GetCommitter() != nil {
+		s := SignatureFromProto(p.GetCommitter())
+		committer = &s
+	}
+
+	return &Commit{
+		ID:        api.CommitID(p.GetOid()),
+		Author:    SignatureFromProto(p.GetAuthor()),
+		Committer: committer,
+		Message:   Message(p.GetMessage()),
+		Parents:   parents,
+	}
+}
+
 // Signature represents a commit signature
 type Signature struct {
 	Name  string    `json:"Name,omitempty"`
@@ -188,6 +230,22 @@ type Signature struct {
 	Date  time.Time `json:"Date"`
 }
 
+func (s *Signature) ToProto() *proto.GitSignature {
+	return &proto.GitSignature{
+		Name:  []byte(s.Name),
+		Email: []byte(s.Email),
+		Date:  timestamppb.New(s.Date),
+	}
+}
+
+func SignatureFromProto(p *proto.GitSignature) Signature {
+	return Signature{
+		Name:  string(p.GetName()),
+		Email: string(p.GetEmail()),
+		Date:  p.GetDate().AsTime(),
+	}
+}
+
 type RefType int
 
 const (
@@ -196,6 +254,28 @@ const (
 	RefTypeTag
 )
 
+func (t RefType) ToProto() proto.GitRef_RefType {
+	switch t {
+	case RefTypeBranch:
+		return proto.GitRef_REF_TYPE_BRANCH
+	case RefTypeTag:
+		return proto.GitRef_REF_TYPE_TAG
+	default:
+		return proto.GitRef_REF_TYPE_UNSPECIFIED
+	}
+}
+
+func RefTypeFromProto(t proto.GitRef_RefType) RefType {
+	switch t {
+	case proto.GitRef_REF_TYPE_BRANCH:
+		return RefTypeBranch
+	case proto.GitRef_REF_TYPE_TAG:
+		return RefTypeTag
+	default:
+		return RefTypeUnknown
+	}
+}
+
 // RefDescription describes a commit at the head of a branch or tag.
 type RefDescription struct {
 	Name            string
@@ -215,6 +295,22 @@ func (p *ContributorCount) String() string {
 	return fmt.Sprintf("%d %s <%s>", p.Count, p.Name, p.Email)
 }
 
+func (p *ContributorCount) ToProto() *proto.ContributorCount {
+	return &proto.ContributorCount{
+		Name:  []byte(p.Name),
+		Email: []byte(p.Email),
+		Count: p.Count,
+	}
+}
+
+func ContributorCountFromProto(p *proto.ContributorCount) *ContributorCount {
+	return &ContributorCount{
+		Name:  string(p.GetName()),
+		Email: string(p.GetEmail()),
+		Count: p.GetCount(),
+	}
+}
+
 // A Tag is a VCS tag.
 type Tag struct {
 	Name         string `json:"Name,omitempty"`
@@ -230,8 +326,60 @@ func (p Tags) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
 
 // Ref describes a Git ref.
 type Ref struct {
-	Name     string // the full name of the ref (e.g., "refs/heads/mybranch")
+	Name      string // the full name of the ref (e.g., "refs/heads/mybranch")
+	ShortName string // the abbreviated name of the ref (e.g., "mybranch")
+	Type      RefType
+	// CommitID is the commit the ref points at. For annotated tags, this is the
+	// commit the tag is attached to.
 	CommitID api.CommitID
+	// RefOID is the ID of the object the ref points at. It only differs from
+	// CommitID for annotated tags, where it is the ID of the tag object.
+	RefOID api.CommitID
+	// CreatedDate is the date the ref's object was created: the tagger date for
+	// annotated tags, the committer date otherwise.
+	CreatedDate time.Time
+	// CommitCreatedDate is the committer date of CommitID, or nil if the ref
+	// doesn't point at a commit.
+	CommitCreatedDate *time.Time
+	// IsHead is true if HEAD points at this ref.
+	IsHead bool
+}
+
+func (r *Ref) ToProto() *proto.GitRef {
+	var commitCreatedDate *timestamppb.Timestamp
+	if r.CommitCreatedDate != nil {
+		commitCreatedDate = timestamppb.New(*r.CommitCreatedDate)
+	}
+
+	return &proto.GitRef{
+		RefName:         []byte(r.Name),
+		ShortRefName:    []byte(r.ShortName),
+		TargetCommit:    string(r.CommitID),
+		RefOid:          string(r.RefOID),
+		CreatedAt:       timestamppb.New(r.CreatedDate),
+		TargetCreatedAt: commitCreatedDate,
+		RefType:         r.Type.ToProto(),
+		IsHead:          r.IsHead,
+	}
+}
+
+func RefFromProto(p *proto.GitRef) Ref {
+	var commitCreatedDate *time.Time
+	if p.GetTargetCreatedAt() != nil {
+		t := p.GetTargetCreatedAt().AsTime()
+		commitCreatedDate = &t
+	}
+
+	return Ref{
+		Name:              string(p.GetRefName()),
+		ShortName:         string(p.GetShortRefName()),
+		Type:              RefTypeFromProto(p.GetRefType()),
+		CommitID:          api.CommitID(p.GetTargetCommit()),
+		RefOID:            api.CommitID(p.GetRefOid()),
+		CreatedDate:       p.GetCreatedAt().AsTime(),
+		CommitCreatedDate: commitCreatedDate,
+		IsHead:            p.GetIsHead(),
+	}
 }
 
 // BehindAhead is a set of behind/ahead counts.
@@ -25,9 +25,18 @@ type MockGitserverServiceClient struct {
 	// BatchLogFunc is an instance of a mock function object controlling the
 	// behavior of the method BatchLog.
 	BatchLogFunc *GitserverServiceClientBatchLogFunc
+	// BlameFunc is an instance of a mock function object controlling the
+	// behavior of the method Blame.
+	BlameFunc *GitserverServiceClientBlameFunc
 	// CheckPerforceCredentialsFunc is an instance of a mock function object
 	// controlling the behavior of the method CheckPerforceCredentials.
 	CheckPerforceCredentialsFunc *GitserverServiceClientCheckPerforceCredentialsFunc
+	// CommitLogFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitLog.
+	CommitLogFunc *GitserverServiceClientCommitLogFunc
+	// ContributorCountsFunc is an instance of a mock function object
+	// controlling the behavior of the method ContributorCounts.
+	ContributorCountsFunc *GitserverServiceClientContributorCountsFunc
 	// CreateCommitFromPatchBinaryFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// CreateCommitFromPatchBinary.
@@ -53,6 +62,12 @@ type MockGitserverServiceClient struct {
 	// ListGitoliteFunc is an instance of a mock function object controlling
 	// the behavior of the method ListGitolite.
 	ListGitoliteFunc *GitserverServiceClientListGitoliteFunc
+	// ListRefsFunc is an instance of a mock function object controlling the
+	// behavior of the method ListRefs.
+	ListRefsFunc *GitserverServiceClientListRefsFunc
+	// MergeBaseFunc is an instance of a mock function object controlling
+	// the behavior of the method MergeBase.
+	MergeBaseFunc *GitserverServiceClientMergeBaseFunc
 	// P4ExecFunc is an instance of a mock function object controlling the
 	// behavior of the method P4Exec.
 	P4ExecFunc *GitserverServiceClientP4ExecFunc
@@ -71,6 +86,12 @@ type MockGitserverServiceClient struct {
 	// PerforceUsersFunc is an instance of a mock function object
 	// controlling the behavior of the method PerforceUsers.
 	PerforceUsersFunc *GitserverServiceClientPerforceUsersFunc
+	// ReachabilityFunc is an instance of a mock function object controlling
+	// the behavior of the method Reachability.
+	ReachabilityFunc *GitserverServiceClientReachabilityFunc
+	// ReadDirFunc is an instance of a mock function object controlling the
+	// behavior of the method ReadDir.
+	ReadDirFunc *GitserverServiceClientReadDirFunc
 	// RepoCloneFunc is an instance of a mock function object controlling
 	// the behavior of the method RepoClone.
 	RepoCloneFunc *GitserverServiceClientRepoCloneFunc
@@ -103,11 +124,26 @@ func NewMockGitserverServiceClient() *MockGitserverServiceClient {
 				return
 			},
 		},
+		BlameFunc: &GitserverServiceClientBlameFunc{
+			defaultHook: func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (r0 v1.GitserverService_BlameClient, r1 error) {
+				return
+			},
+		},
 		CheckPerforceCredentialsFunc: &GitserverServiceClientCheckPerforceCredentialsFunc{
 			defaultHook: func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (r0 *v1.CheckPerforceCredentialsResponse, r1 error) {
 				return
 			},
 		},
+		CommitLogFunc: &GitserverServiceClientCommitLogFunc{
+			defaultHook: func(context.Context, *v1.CommitLogRequest, ...grpc.CallOption) (r0 v1.GitserverService_CommitLogClient, r1 error) {
+				return
+			},
+		},
+		ContributorCountsFunc: &GitserverServiceClientContributorCountsFunc{
+			defaultHook: func(context.Context, *v1.ContributorCountsRequest, ...grpc.CallOption) (r0 *v1.ContributorCountsResponse, r1 error) {
+				return
+			},
+		},
 		CreateCommitFromPatchBinaryFunc: &GitserverServiceClientCreateCommitFromPatchBinaryFunc{
 			defaultHook: func(context.Context, ...grpc.CallOption) (r0 v1.GitserverService_CreateCommitFromPatchBinaryClient, r1 error) {
 				return
@@ -148,6 +184,16 @@ func NewMockGitserverServiceClient() *MockGitserverServiceClient {
 				return
 			},
 		},
+		ListRefsFunc: &GitserverServiceClientListRefsFunc{
+			defaultHook: func(context.Context, *v1.ListRefsRequest, ...grpc.CallOption) (r0 v1.GitserverService_ListRefsClient, r1 error) {
+				return
+			},
+		},
+		MergeBaseFunc: &GitserverServiceClientMergeBaseFunc{
+			defaultHook: func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (r0 *v1.MergeBaseResponse, r1 error) {
+				return
+			},
+		},
 		P4ExecFunc: &GitserverServiceClientP4ExecFunc{
 			defaultHook: func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (r0 v1.GitserverService_P4ExecClient, r1 error) {
 				return
@@ -178,6 +224,16 @@ func NewMockGitserverServiceClient() *MockGitserverServiceClient {
 				return
 			},
 		},
+		ReachabilityFunc: &GitserverServiceClientReachabilityFunc{
+			defaultHook: func(context.Context, *v1.ReachabilityRequest, ...grpc.CallOption) (r0 *v1.ReachabilityResponse, r1 error) {
+				return
+			},
+		},
+		ReadDirFunc: &GitserverServiceClientReadDirFunc{
+			defaultHook: func(context.Context, *v1.ReadDirRequest, ...grpc.CallOption) (r0 v1.GitserverService_ReadDirClient, r1 error) {
+				return
+			},
+		},
 		RepoCloneFunc: &GitserverServiceClientRepoCloneFunc{
 			defaultHook: func(context.Context, *v1.RepoCloneRequest, ...grpc.CallOption) (r0 *v1.RepoCloneResponse, r1 error) {
 				return
@@ -221,11 +277,26 @@ func NewStrictMockGitserverServiceClient() *MockGitserverServiceClient {
 				panic("unexpected invocation of MockGitserverServiceClient.BatchLog")
 			},
 		},
+		BlameFunc: &GitserverServiceClientBlameFunc{
+			defaultHook: func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.Blame")
+			},
+		},
 		CheckPerforceCredentialsFunc: &GitserverServiceClientCheckPerforceCredentialsFunc{
 			defaultHook: func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error) {
 				panic("unexpected invocation of MockGitserverServiceClient.CheckPerforceCredentials")
 			},
 		},
+		CommitLogFunc: &GitserverServiceClientCommitLogFunc{
+			defaultHook: func(context.Context, *v1.CommitLogRequest, ...grpc.CallOption) (v1.GitserverService_CommitLogClient, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.CommitLog")
+			},
+		},
+		ContributorCountsFunc: &GitserverServiceClientContributorCountsFunc{
+			defaultHook: func(context.Context, *v1.ContributorCountsRequest, ...grpc.CallOption) (*v1.ContributorCountsResponse, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.ContributorCounts")
+			},
+		},
 		CreateCommitFromPatchBinaryFunc: &GitserverServiceClientCreateCommitFromPatchBinaryFunc{
 			defaultHook: func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error) {
 				panic("unexpected invocation of MockGitserverServiceClient.CreateCommitFromPatchBinary")
@@ -266,6 +337,16 @@ func NewStrictMockGitserverServiceClient() *MockGitserverServiceClient {
 				panic("unexpected invocation of MockGitserverServiceClient.ListGitolite")
 			},
 		},
+		ListRefsFunc: &GitserverServiceClientListRefsFunc{
+			defaultHook: func(context.Context, *v1.ListRefsRequest, ...grpc.CallOption) (v1.GitserverService_ListRefsClient, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.ListRefs")
+			},
+		},
+		MergeBaseFunc: &GitserverServiceClientMergeBaseFunc{
+			defaultHook: func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.MergeBase")
+			},
+		},
 		P4ExecFunc: &GitserverServiceClientP4ExecFunc{
 			defaultHook: func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error) {
 				panic("unexpected invocation of MockGitserverServiceClient.P4Exec")
@@ -296,6 +377,16 @@ func NewStrictMockGitserverServiceClient() *MockGitserverServiceClient {
 				panic("unexpected invocation of MockGitserverServiceClient.PerforceUsers")
 			},
 		},
+		ReachabilityFunc: &GitserverServiceClientReachabilityFunc{
+			defaultHook: func(context.Context, *v1.ReachabilityRequest, ...grpc.CallOption) (*v1.ReachabilityResponse, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.Reachability")
+			},
+		},
+		ReadDirFunc: &GitserverServiceClientReadDirFunc{
+			defaultHook: func(context.Context, *v1.ReadDirRequest, ...grpc.CallOption) (v1.GitserverService_ReadDirClient, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.ReadDir")
+			},
+		},
 		RepoCloneFunc: &GitserverServiceClientRepoCloneFunc{
 			defaultHook: func(context.Context, *v1.RepoCloneRequest, ...grpc.CallOption) (*v1.RepoCloneResponse, error) {
 				panic("unexpected invocation of MockGitserverServiceClient.RepoClone")
@@ -335,9 +426,18 @@ func NewMockGitserverServiceClientFrom(i v1.GitserverServiceClient) *MockGitserv
 		BatchLogFunc: &GitserverServiceClientBatchLogFunc{
 			defaultHook: i.BatchLog,
 		},
+		BlameFunc: &GitserverServiceClientBlameFunc{
+			defaultHook: i.Blame,
+		},
 		CheckPerforceCredentialsFunc: &GitserverServiceClientCheckPerforceCredentialsFunc{
 			defaultHook: i.CheckPerforceCredentials,
 		},
+		CommitLogFunc: &GitserverServiceClientCommitLogFunc{
+			defaultHook: i.CommitLog,
+		},
+		ContributorCountsFunc: &GitserverServiceClientContributorCountsFunc{
+			defaultHook: i.ContributorCounts,
+		},
 		CreateCommitFromPatchBinaryFunc: &GitserverServiceClientCreateCommitFromPatchBinaryFunc{
 			defaultHook: i.CreateCommitFromPatchBinary,
 		},
@@ -362,6 +462,12 @@ func NewMockGitserverServiceClientFrom(i v1.GitserverServiceClient) *MockGitserv
 		ListGitoliteFunc: &GitserverServiceClientListGitoliteFunc{
 			defaultHook: i.ListGitolite,
 		},
+		ListRefsFunc: &GitserverServiceClientListRefsFunc{
+			defaultHook: i.ListRefs,
+		},
+		MergeBaseFunc: &GitserverServiceClientMergeBaseFunc{
+			defaultHook: i.MergeBase,
+		},
 		P4ExecFunc: &GitserverServiceClientP4ExecFunc{
 			defaultHook: i.P4Exec,
 		},
@@ -380,6 +486,12 @@ func NewMockGitserverServiceClientFrom(i v1.GitserverServiceClient) *MockGitserv
 		PerforceUsersFunc: &GitserverServiceClientPerforceUsersFunc{
 			defaultHook: i.PerforceUsers,
 		},
+		ReachabilityFunc: &GitserverServiceClientReachabilityFunc{
+			defaultHook: i.Reachability,
+		},
+		ReadDirFunc: &GitserverServiceClientReadDirFunc{
+			defaultHook: i.ReadDir,
+		},
 		RepoCloneFunc: &GitserverServiceClientRepoCloneFunc{
 			defaultHook: i.RepoClone,
 		},
@@ -637,37 +749,35 @@ func (c GitserverServiceClientBatchLogFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitserverServiceClientCheckPerforceCredentialsFunc describes the behavior
-// when the CheckPerforceCredentials method of the parent
-// MockGitserverServiceClient instance is invoked.
-type GitserverServiceClientCheckPerforceCredentialsFunc struct {
-	defaultHook func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error)
-	hooks       []func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error)
-	history     []GitserverServiceClientCheckPerforceCredentialsFuncCall
+// GitserverServiceClientBlameFunc describes the behavior when the Blame
+// method of the parent MockGitserverServiceClient instance is invoked.
+type GitserverServiceClientBlameFunc struct {
+	defaultHook func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error)
+	hooks       []func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error)
+	history     []GitserverServiceClientBlameFuncCall
 	mutex       sync.Mutex
 }
 
-// CheckPerforceCredentials delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockGitserverServiceClient) CheckPerforceCredentials(v0 context.Context, v1 *v1.CheckPerforceCredentialsRequest, v2 ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error) {
-	r0, r1 := m.CheckPerforceCredentialsFunc.nextHook()(v0, v1, v2...)
-	m.CheckPerforceCredentialsFunc.appendCall(GitserverServiceClientCheckPerforceCredentialsFuncCall{v0, v1, v2, r0, r1})
+// Blame delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) Blame(v0 context.Context, v1 *v1.BlameRequest, v2 ...grpc.CallOption) (v1.GitserverService_BlameClient, error) {
+	r0, r1 := m.BlameFunc.nextHook()(v0, v1, v2...)
+	m.BlameFunc.appendCall(GitserverServiceClientBlameFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// CheckPerforceCredentials method of the parent MockGitserverServiceClient
-// instance is invoked and the hook queue is empty.
-func (f *GitserverServiceClientCheckPerforceCredentialsFunc) SetDefaultHook(hook func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error)) {
+// SetDefaultHook sets function that is called when the Blame method of the
+// parent MockGitserverServiceClient instance is invoked and the hook queue
+// is empty.
+func (f *GitserverServiceClientBlameFunc) SetDefaultHook(hook func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CheckPerforceCredentials method of the parent MockGitserverServiceClient
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *GitserverServiceClientCheckPerforceCredentialsFunc) PushHook(hook func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error)) {
+// Blame method of the parent MockGitserverServiceClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverServiceClientBlameFunc) PushHook(hook func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -675,20 +785,20 @@ func (f *GitserverServiceClientCheckPerforceCredentialsFunc) PushHook(hook func(
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverServiceClientCheckPerforceCredentialsFunc) SetDefaultReturn(r0 *v1.CheckPerforceCredentialsResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error) {
+func (f *GitserverServiceClientBlameFunc) SetDefaultReturn(r0 v1.GitserverService_BlameClient, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverServiceClientCheckPerforceCredentialsFunc) PushReturn(r0 *v1.CheckPerforceCredentialsResponse, r1 error) {
-	f.PushHook(func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error) {
+func (f *GitserverServiceClientBlameFunc) PushReturn(r0 v1.GitserverService_BlameClient, r1 error) {
+	f.PushHook(func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverServiceClientCheckPerforceCredentialsFunc) nextHook() func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error) {
+func (f *GitserverServiceClientBlameFunc) nextHook() func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -701,40 +811,38 @@ func (f *GitserverServiceClientCheckPerforceCredentialsFunc) nextHook() func(con
 	return hook
 }
 
-func (f *GitserverServiceClientCheckPerforceCredentialsFunc) appendCall(r0 GitserverServiceClientCheckPerforceCredentialsFuncCall) {
+func (f *GitserverServiceClientBlameFunc) appendCall(r0 GitserverServiceClientBlameFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// GitserverServiceClientCheckPerforceCredentialsFuncCall objects describing
-// the invocations of this function.
-func (f *GitserverServiceClientCheckPerforceCredentialsFunc) History() []GitserverServiceClientCheckPerforceCredentialsFuncCall {
+// History returns a sequence of GitserverServiceClientBlameFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverServiceClientBlameFunc) History() []GitserverServiceClientBlameFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverServiceClientCheckPerforceCredentialsFuncCall, len(f.history))
+	history := make([]GitserverServiceClientBlameFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverServiceClientCheckPerforceCredentialsFuncCall is an object that
-// describes an invocation of method CheckPerforceCredentials on an instance
-// of MockGitserverServiceClient.
-type GitserverServiceClientCheckPerforceCredentialsFuncCall struct {
+// GitserverServiceClientBlameFuncCall is an object that describes an
+// invocation of method Blame on an instance of MockGitserverServiceClient.
+type GitserverServiceClientBlameFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *v1.CheckPerforceCredentialsRequest
+	Arg1 *v1.BlameRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *v1.CheckPerforceCredentialsResponse
+	Result0 v1.GitserverService_BlameClient
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -744,7 +852,7 @@ type GitserverServiceClientCheckPerforceCredentialsFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c GitserverServiceClientCheckPerforceCredentialsFuncCall) Args() []interface{} {
+func (c GitserverServiceClientBlameFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -755,42 +863,41 @@ func (c GitserverServiceClientCheckPerforceCredentialsFuncCall) Args() []interfa
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverServiceClientCheckPerforceCredentialsFuncCall) Results() []interface{} {
+func (c GitserverServiceClientBlameFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitserverServiceClientCreateCommitFromPatchBinaryFunc describes the
-// behavior when the CreateCommitFromPatchBinary method of the parent
+// GitserverServiceClientCheckPerforceCredentialsFunc describes the behavior
+// when the CheckPerforceCredentials method of the parent
 // MockGitserverServiceClient instance is invoked.
-type GitserverServiceClientCreateCommitFromPatchBinaryFunc struct {
-	defaultHook func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error)
-	hooks       []func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error)
-	history     []GitserverServiceClientCreateCommitFromPatchBinaryFuncCall
+type GitserverServiceClientCheckPerforceCredentialsFunc struct {
+	defaultHook func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error)
+	hooks       []func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error)
+	history     []GitserverServiceClientCheckPerforceCredentialsFuncCall
 	mutex       sync.Mutex
 }
 
-// CreateCommitFromPatchBinary delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockGitserverServiceClient) CreateCommitFromPatchBinary(v0 context.Context, v1 ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error) {
-	r0, r1 := m.CreateCommitFromPatchBinaryFunc.nextHook()(v0, v1...)
-	m.CreateCommitFromPatchBinaryFunc.appendCall(GitserverServiceClientCreateCommitFromPatchBinaryFuncCall{v0, v1, r0, r1})
+// CheckPerforceCredentials delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) CheckPerforceCredentials(v0 context.Context, v1 *v1.CheckPerforceCredentialsRequest, v2 ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error) {
+	r0, r1 := m.CheckPerforceCredentialsFunc.nextHook()(v0, v1, v2...)
+	m.CheckPerforceCredentialsFunc.appendCall(GitserverServiceClientCheckPerforceCredentialsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// CreateCommitFromPatchBinary method of the parent
-// MockGitserverServiceClient instance is invoked and the hook queue is
-// empty.
-func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) SetDefaultHook(hook func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error)) {
+// CheckPerforceCredentials method of the parent MockGitserverServiceClient
+// instance is invoked and the hook queue is empty.
+func (f *GitserverServiceClientCheckPerforceCredentialsFunc) SetDefaultHook(hook func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CreateCommitFromPatchBinary method of the parent
-// MockGitserverServiceClient instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) PushHook(hook func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error)) {
+// CheckPerforceCredentials method of the parent MockGitserverServiceClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverServiceClientCheckPerforceCredentialsFunc) PushHook(hook func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -798,20 +905,20 @@ func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) PushHook(hook fu
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) SetDefaultReturn(r0 v1.GitserverService_CreateCommitFromPatchBinaryClient, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error) {
+func (f *GitserverServiceClientCheckPerforceCredentialsFunc) SetDefaultReturn(r0 *v1.CheckPerforceCredentialsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) PushReturn(r0 v1.GitserverService_CreateCommitFromPatchBinaryClient, r1 error) {
-	f.PushHook(func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error) {
+func (f *GitserverServiceClientCheckPerforceCredentialsFunc) PushReturn(r0 *v1.CheckPerforceCredentialsResponse, r1 error) {
+	f.PushHook(func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) nextHook() func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error) {
+func (f *GitserverServiceClientCheckPerforceCredentialsFunc) nextHook() func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -824,37 +931,40 @@ func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) nextHook() func(
 	return hook
 }
 
-func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) appendCall(r0 GitserverServiceClientCreateCommitFromPatchBinaryFuncCall) {
+func (f *GitserverServiceClientCheckPerforceCredentialsFunc) appendCall(r0 GitserverServiceClientCheckPerforceCredentialsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// GitserverServiceClientCreateCommitFromPatchBinaryFuncCall objects
-// describing the invocations of this function.
-func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) History() []GitserverServiceClientCreateCommitFromPatchBinaryFuncCall {
+// GitserverServiceClientCheckPerforceCredentialsFuncCall objects describing
+// the invocations of this function.
+func (f *GitserverServiceClientCheckPerforceCredentialsFunc) History() []GitserverServiceClientCheckPerforceCredentialsFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverServiceClientCreateCommitFromPatchBinaryFuncCall, len(f.history))
+	history := make([]GitserverServiceClientCheckPerforceCredentialsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverServiceClientCreateCommitFromPatchBinaryFuncCall is an object
-// that describes an invocation of method CreateCommitFromPatchBinary on an
-// instance of MockGitserverServiceClient.
-type GitserverServiceClientCreateCommitFromPatchBinaryFuncCall struct {
+// GitserverServiceClientCheckPerforceCredentialsFuncCall is an object that
+// describes an invocation of method CheckPerforceCredentials on an instance
+// of MockGitserverServiceClient.
+type GitserverServiceClientCheckPerforceCredentialsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.CheckPerforceCredentialsRequest
+	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
-	Arg1 []grpc.CallOption
+	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 v1.GitserverService_CreateCommitFromPatchBinaryClient
+	Result0 *v1.CheckPerforceCredentialsResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -864,51 +974,52 @@ type GitserverServiceClientCreateCommitFromPatchBinaryFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c GitserverServiceClientCreateCommitFromPatchBinaryFuncCall) Args() []interface{} {
+func (c GitserverServiceClientCheckPerforceCredentialsFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
+	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
 	}
 
-	return append([]interface{}{c.Arg0}, trailing...)
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverServiceClientCreateCommitFromPatchBinaryFuncCall) Results() []interface{} {
+func (c GitserverServiceClientCheckPerforceCredentialsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitserverServiceClientDiskInfoFunc describes the behavior when the
-// DiskInfo method of the parent MockGitserverServiceClient instance is
+// GitserverServiceClientCommitLogFunc describes the behavior when the
+// CommitLog method of the parent MockGitserverServiceClient instance is
 // invoked.
-type GitserverServiceClientDiskInfoFunc struct {
-	defaultHook func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error)
-	hooks       []func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error)
-	history     []GitserverServiceClientDiskInfoFuncCall
+type GitserverServiceClientCommitLogFunc struct {
+	defaultHook func(context.Context, *v1.CommitLogRequest, ...grpc.CallOption) (v1.GitserverService_CommitLogClient, error)
+	hooks       []func(context.Context, *v1.CommitLogRequest, ...grpc.CallOption) (v1.GitserverService_CommitLogClient, error)
+	history     []GitserverServiceClientCommitLogFuncCall
 	mutex       sync.Mutex
 }
 
-// DiskInfo delegates to the next hook function in the queue and stores the
+// CommitLog delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockGitserverServiceClient) DiskInfo(v0 context.Context, v1 *v1.DiskInfoRequest, v2 ...grpc.CallOption) (*v1.DiskInfoResponse, error) {
-	r0, r1 := m.DiskInfoFunc.nextHook()(v0, v1, v2...)
-	m.DiskInfoFunc.appendCall(GitserverServiceClientDiskInfoFuncCall{v0, v1, v2, r0, r1})
+func (m *MockGitserverServiceClient) CommitLog(v0 context.Context, v1 *v1.CommitLogRequest, v2 ...grpc.CallOption) (v1.GitserverService_CommitLogClient, error) {
+	r0, r1 := m.CommitLogFunc.nextHook()(v0, v1, v2...)
+	m.CommitLogFunc.appendCall(GitserverServiceClientCommitLogFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the DiskInfo method of
+// SetDefaultHook sets function that is called when the CommitLog method of
 // the parent MockGitserverServiceClient instance is invoked and the hook
 // queue is empty.
-func (f *GitserverServiceClientDiskInfoFunc) SetDefaultHook(hook func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error)) {
+func (f *GitserverServiceClientCommitLogFunc) SetDefaultHook(hook func(context.Context, *v1.CommitLogRequest, ...grpc.CallOption) (v1.GitserverService_CommitLogClient, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DiskInfo method of the parent MockGitserverServiceClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *GitserverServiceClientDiskInfoFunc) PushHook(hook func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error)) {
+// CommitLog method of the parent MockGitserverServiceClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverServiceClientCommitLogFunc) PushHook(hook func(context.Context, *v1.CommitLogRequest, ...grpc.CallOption) (v1.GitserverService_CommitLogClient, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -916,20 +1027,20 @@ func (f *GitserverServiceClientDiskInfoFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverServiceClientDiskInfoFunc) SetDefaultReturn(r0 *v1.DiskInfoResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error) {
+func (f *GitserverServiceClientCommitLogFunc) SetDefaultReturn(r0 v1.GitserverService_CommitLogClient, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.CommitLogRequest, ...grpc.CallOption) (v1.GitserverService_CommitLogClient, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverServiceClientDiskInfoFunc) PushReturn(r0 *v1.DiskInfoResponse, r1 error) {
-	f.PushHook(func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error) {
+func (f *GitserverServiceClientCommitLogFunc) PushReturn(r0 v1.GitserverService_CommitLogClient, r1 error) {
+	f.PushHook(func(context.Context, *v1.CommitLogRequest, ...grpc.CallOption) (v1.GitserverService_CommitLogClient, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverServiceClientDiskInfoFunc) nextHook() func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error) {
+func (f *GitserverServiceClientCommitLogFunc) nextHook() func(context.Context, *v1.CommitLogRequest, ...grpc.CallOption) (v1.GitserverService_CommitLogClient, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -942,39 +1053,39 @@ func (f *GitserverServiceClientDiskInfoFunc) nextHook() func(context.Context, *v
 	return hook
 }
 
-func (f *GitserverServiceClientDiskInfoFunc) appendCall(r0 GitserverServiceClientDiskInfoFuncCall) {
+func (f *GitserverServiceClientCommitLogFunc) appendCall(r0 GitserverServiceClientCommitLogFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitserverServiceClientDiskInfoFuncCall
+// History returns a sequence of GitserverServiceClientCommitLogFuncCall
 // objects describing the invocations of this function.
-func (f *GitserverServiceClientDiskInfoFunc) History() []GitserverServiceClientDiskInfoFuncCall {
+func (f *GitserverServiceClientCommitLogFunc) History() []GitserverServiceClientCommitLogFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverServiceClientDiskInfoFuncCall, len(f.history))
+	history := make([]GitserverServiceClientCommitLogFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverServiceClientDiskInfoFuncCall is an object that describes an
-// invocation of method DiskInfo on an instance of
+// GitserverServiceClientCommitLogFuncCall is an object that describes an
+// invocation of method CommitLog on an instance of
 // MockGitserverServiceClient.
-type GitserverServiceClientDiskInfoFuncCall struct {
+type GitserverServiceClientCommitLogFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *v1.DiskInfoRequest
+	Arg1 *v1.CommitLogRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *v1.DiskInfoResponse
+	Result0 v1.GitserverService_CommitLogClient
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -984,7 +1095,7 @@ type GitserverServiceClientDiskInfoFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c GitserverServiceClientDiskInfoFuncCall) Args() []interface{} {
+func (c GitserverServiceClientCommitLogFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -995,39 +1106,41 @@ func (c GitserverServiceClientDiskInfoFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverServiceClientDiskInfoFuncCall) Results() []interface{} {
+func (c GitserverServiceClientCommitLogFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitserverServiceClientExecFunc describes the behavior when the Exec
-// method of the parent MockGitserverServiceClient instance is invoked.
-type GitserverServiceClientExecFunc struct {
-	defaultHook func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error)
-	hooks       []func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error)
-	history     []GitserverServiceClientExecFuncCall
+// GitserverServiceClientContributorCountsFunc describes the behavior when
+// the ContributorCounts method of the parent MockGitserverServiceClient
+// instance is invoked.
+type GitserverServiceClientContributorCountsFunc struct {
+	defaultHook func(context.Context, *v1.ContributorCountsRequest, ...grpc.CallOption) (*v1.ContributorCountsResponse, error)
+	hooks       []func(context.Context, *v1.ContributorCountsRequest, ...grpc.CallOption) (*v1.ContributorCountsResponse, error)
+	history     []GitserverServiceClientContributorCountsFuncCall
 	mutex       sync.Mutex
 }
 
-// Exec delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockGitserverServiceClient) Exec(v0 context.Context, v1 *v1.ExecRequest, v2 ...grpc.CallOption) (v1.GitserverService_ExecClient, error) {
-	r0, r1 := m.ExecFunc.nextHook()(v0, v1, v2...)
-	m.ExecFunc.appendCall(GitserverServiceClientExecFuncCall{v0, v1, v2, r0, r1})
+// ContributorCounts delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) ContributorCounts(v0 context.Context, v1 *v1.ContributorCountsRequest, v2 ...grpc.CallOption) (*v1.ContributorCountsResponse, error) {
+	r0, r1 := m.ContributorCountsFunc.nextHook()(v0, v1, v2...)
+	m.ContributorCountsFunc.appendCall(GitserverServiceClientContributorCountsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Exec method of the
-// parent MockGitserverServiceClient instance is invoked and the hook queue
-// is empty.
-func (f *GitserverServiceClientExecFunc) SetDefaultHook(hook func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error)) {
+// SetDefaultHook sets function that is called when the ContributorCounts
+// method of the parent MockGitserverServiceClient instance is invoked and
+// the hook queue is empty.
+func (f *GitserverServiceClientContributorCountsFunc) SetDefaultHook(hook func(context.Context, *v1.ContributorCountsRequest, ...grpc.CallOption) (*v1.ContributorCountsResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Exec method of the parent MockGitserverServiceClient instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *GitserverServiceClientExecFunc) PushHook(hook func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error)) {
+// ContributorCounts method of the parent MockGitserverServiceClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverServiceClientContributorCountsFunc) PushHook(hook func(context.Context, *v1.ContributorCountsRequest, ...grpc.CallOption) (*v1.ContributorCountsResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1035,20 +1148,20 @@ func (f *GitserverServiceClientExecFunc) PushHook(hook func(context.Context, *v1
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverServiceClientExecFunc) SetDefaultReturn(r0 v1.GitserverService_ExecClient, r1 error) {
-	f.SetDefaultHook(func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error) {
+func (f *GitserverServiceClientContributorCountsFunc) SetDefaultReturn(r0 *v1.ContributorCountsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.ContributorCountsRequest, ...grpc.CallOption) (*v1.ContributorCountsResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverServiceClientExecFunc) PushReturn(r0 v1.GitserverService_ExecClient, r1 error) {
-	f.PushHook(func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error) {
+func (f *GitserverServiceClientContributorCountsFunc) PushReturn(r0 *v1.ContributorCountsResponse, r1 error) {
+	f.PushHook(func(context.Context, *v1.ContributorCountsRequest, ...grpc.CallOption) (*v1.ContributorCountsResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverServiceClientExecFunc) nextHook() func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error) {
+func (f *GitserverServiceClientContributorCountsFunc) nextHook() func(context.Context, *v1.ContributorCountsRequest, ...grpc.CallOption) (*v1.ContributorCountsResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1061,38 +1174,40 @@ func (f *GitserverServiceClientExecFunc) nextHook() func(context.Context, *v1.Ex
 	return hook
 }
 
-func (f *GitserverServiceClientExecFunc) appendCall(r0 GitserverServiceClientExecFuncCall) {
+func (f *GitserverServiceClientContributorCountsFunc) appendCall(r0 GitserverServiceClientContributorCountsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitserverServiceClientExecFuncCall objects
-// describing the invocations of this function.
-func (f *GitserverServiceClientExecFunc) History() []GitserverServiceClientExecFuncCall {
+// History returns a sequence of
+// GitserverServiceClientContributorCountsFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverServiceClientContributorCountsFunc) History() []GitserverServiceClientContributorCountsFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverServiceClientExecFuncCall, len(f.history))
+	history := make([]GitserverServiceClientContributorCountsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverServiceClientExecFuncCall is an object that describes an
-// invocation of method Exec on an instance of MockGitserverServiceClient.
-type GitserverServiceClientExecFuncCall struct {
+// GitserverServiceClientContributorCountsFuncCall is an object that
+// describes an invocation of method ContributorCounts on an instance of
+// MockGitserverServiceClient.
+type GitserverServiceClientContributorCountsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *v1.ExecRequest
+	Arg1 *v1.ContributorCountsRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 v1.GitserverService_ExecClient
+	Result0 *v1.ContributorCountsResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1102,7 +1217,7 @@ type GitserverServiceClientExecFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c GitserverServiceClientExecFuncCall) Args() []interface{} {
+func (c GitserverServiceClientContributorCountsFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -1113,12 +1228,370 @@ func (c GitserverServiceClientExecFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverServiceClientExecFuncCall) Results() []interface{} {
+func (c GitserverServiceClientContributorCountsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitserverServiceClientGetObjectFunc describes the behavior when the
-// GetObject method of the parent MockGitserverServiceClient instance is
+// GitserverServiceClientCreateCommitFromPatchBinaryFunc describes the
+// behavior when the CreateCommitFromPatchBinary method of the parent
+// MockGitserverServiceClient instance is invoked.
+type GitserverServiceClientCreateCommitFromPatchBinaryFunc struct {
+	defaultHook func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error)
+	hooks       []func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error)
+	history     []GitserverServiceClientCreateCommitFromPatchBinaryFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateCommitFromPatchBinary delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) CreateCommitFromPatchBinary(v0 context.Context, v1 ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error) {
+	r0, r1 := m.CreateCommitFromPatchBinaryFunc.nextHook()(v0, v1...)
+	m.CreateCommitFromPatchBinaryFunc.appendCall(GitserverServiceClientCreateCommitFromPatchBinaryFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateCommitFromPatchBinary method of the parent
+// MockGitserverServiceClient instance is invoked and the hook queue is
+// empty.
+func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) SetDefaultHook(hook func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateCommitFromPatchBinary method of the parent
+// MockGitserverServiceClient instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) PushHook(hook func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) SetDefaultReturn(r0 v1.GitserverService_CreateCommitFromPatchBinaryClient, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) PushReturn(r0 v1.GitserverService_CreateCommitFromPatchBinaryClient, r1 error) {
+	f.PushHook(func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) nextHook() func(context.Context, ...grpc.CallOption) (v1.GitserverService_CreateCommitFromPatchBinaryClient, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) appendCall(r0 GitserverServiceClientCreateCommitFromPatchBinaryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverServiceClientCreateCommitFromPatchBinaryFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverServiceClientCreateCommitFromPatchBinaryFunc) History() []GitserverServiceClientCreateCommitFromPatchBinaryFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientCreateCommitFromPatchBinaryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientCreateCommitFromPatchBinaryFuncCall is an object
+// that describes an invocation of method CreateCommitFromPatchBinary on an
+// instance of MockGitserverServiceClient.
+type GitserverServiceClientCreateCommitFromPatchBinaryFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 v1.GitserverService_CreateCommitFromPatchBinaryClient
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientCreateCommitFromPatchBinaryFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientCreateCommitFromPatchBinaryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverServiceClientDiskInfoFunc describes the behavior when the
+// DiskInfo method of the parent MockGitserverServiceClient instance is
+// invoked.
+type GitserverServiceClientDiskInfoFunc struct {
+	defaultHook func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error)
+	hooks       []func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error)
+	history     []GitserverServiceClientDiskInfoFuncCall
+	mutex       sync.Mutex
+}
+
+// DiskInfo delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) DiskInfo(v0 context.Context, v1 *v1.DiskInfoRequest, v2 ...grpc.CallOption) (*v1.DiskInfoResponse, error) {
+	r0, r1 := m.DiskInfoFunc.nextHook()(v0, v1, v2...)
+	m.DiskInfoFunc.appendCall(GitserverServiceClientDiskInfoFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the DiskInfo method of
+// the parent MockGitserverServiceClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverServiceClientDiskInfoFunc) SetDefaultHook(hook func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DiskInfo method of the parent MockGitserverServiceClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverServiceClientDiskInfoFunc) PushHook(hook func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientDiskInfoFunc) SetDefaultReturn(r0 *v1.DiskInfoResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientDiskInfoFunc) PushReturn(r0 *v1.DiskInfoResponse, r1 error) {
+	f.PushHook(func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientDiskInfoFunc) nextHook() func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientDiskInfoFunc) appendCall(r0 GitserverServiceClientDiskInfoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverServiceClientDiskInfoFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverServiceClientDiskInfoFunc) History() []GitserverServiceClientDiskInfoFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientDiskInfoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientDiskInfoFuncCall is an object that describes an
+// invocation of method DiskInfo on an instance of
+// MockGitserverServiceClient.
+type GitserverServiceClientDiskInfoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.DiskInfoRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *v1.DiskInfoResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientDiskInfoFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientDiskInfoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverServiceClientExecFunc describes the behavior when the Exec
+// method of the parent MockGitserverServiceClient instance is invoked.
+type GitserverServiceClientExecFunc struct {
+	defaultHook func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error)
+	hooks       []func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error)
+	history     []GitserverServiceClientExecFuncCall
+	mutex       sync.Mutex
+}
+
+// Exec delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) Exec(v0 context.Context, v1 *v1.ExecRequest, v2 ...grpc.CallOption) (v1.GitserverService_ExecClient, error) {
+	r0, r1 := m.ExecFunc.nextHook()(v0, v1, v2...)
+	m.ExecFunc.appendCall(GitserverServiceClientExecFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Exec method of the
+// parent MockGitserverServiceClient instance is invoked and the hook queue
+// is empty.
+func (f *GitserverServiceClientExecFunc) SetDefaultHook(hook func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Exec method of the parent MockGitserverServiceClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverServiceClientExecFunc) PushHook(hook func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientExecFunc) SetDefaultReturn(r0 v1.GitserverService_ExecClient, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientExecFunc) PushReturn(r0 v1.GitserverService_ExecClient, r1 error) {
+	f.PushHook(func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientExecFunc) nextHook() func(context.Context, *v1.ExecRequest, ...grpc.CallOption) (v1.GitserverService_ExecClient, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientExecFunc) appendCall(r0 GitserverServiceClientExecFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverServiceClientExecFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverServiceClientExecFunc) History() []GitserverServiceClientExecFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientExecFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientExecFuncCall is an object that describes an
+// invocation of method Exec on an instance of MockGitserverServiceClient.
+type GitserverServiceClientExecFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.ExecRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 v1.GitserverService_ExecClient
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientExecFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientExecFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverServiceClientGetObjectFunc describes the behavior when the
+// GetObject method of the parent MockGitserverServiceClient instance is
 // invoked.
 type GitserverServiceClientGetObjectFunc struct {
 	defaultHook func(context.Context, *v1.GetObjectRequest, ...grpc.CallOption) (*v1.GetObjectResponse, error)
@@ -1492,27 +1965,269 @@ type GitserverServiceClientIsRepoCloneableFunc struct {
 	mutex       sync.Mutex
 }
 
-// IsRepoCloneable delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockGitserverServiceClient) IsRepoCloneable(v0 context.Context, v1 *v1.IsRepoCloneableRequest, v2 ...grpc.CallOption) (*v1.IsRepoCloneableResponse, error) {
-	r0, r1 := m.IsRepoCloneableFunc.nextHook()(v0, v1, v2...)
-	m.IsRepoCloneableFunc.appendCall(GitserverServiceClientIsRepoCloneableFuncCall{v0, v1, v2, r0, r1})
+// IsRepoCloneable delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) IsRepoCloneable(v0 context.Context, v1 *v1.IsRepoCloneableRequest, v2 ...grpc.CallOption) (*v1.IsRepoCloneableResponse, error) {
+	r0, r1 := m.IsRepoCloneableFunc.nextHook()(v0, v1, v2...)
+	m.IsRepoCloneableFunc.appendCall(GitserverServiceClientIsRepoCloneableFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the IsRepoCloneable
+// method of the parent MockGitserverServiceClient instance is invoked and
+// the hook queue is empty.
+func (f *GitserverServiceClientIsRepoCloneableFunc) SetDefaultHook(hook func(context.Context, *v1.IsRepoCloneableRequest, ...grpc.CallOption) (*v1.IsRepoCloneableResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// IsRepoCloneable method of the parent MockGitserverServiceClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverServiceClientIsRepoCloneableFunc) PushHook(hook func(context.Context, *v1.IsRepoCloneableRequest, ...grpc.CallOption) (*v1.IsRepoCloneableResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientIsRepoCloneableFunc) SetDefaultReturn(r0 *v1.IsRepoCloneableResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.IsRepoCloneableRequest, ...grpc.CallOption) (*v1.IsRepoCloneableResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientIsRepoCloneableFunc) PushReturn(r0 *v1.IsRepoCloneableResponse, r1 error) {
+	f.PushHook(func(context.Context, *v1.IsRepoCloneableRequest, ...grpc.CallOption) (*v1.IsRepoCloneableResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientIsRepoCloneableFunc) nextHook() func(context.Context, *v1.IsRepoCloneableRequest, ...grpc.CallOption) (*v1.IsRepoCloneableResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientIsRepoCloneableFunc) appendCall(r0 GitserverServiceClientIsRepoCloneableFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverServiceClientIsRepoCloneableFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverServiceClientIsRepoCloneableFunc) History() []GitserverServiceClientIsRepoCloneableFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientIsRepoCloneableFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientIsRepoCloneableFuncCall is an object that describes
+// an invocation of method IsRepoCloneable on an instance of
+// MockGitserverServiceClient.
+type GitserverServiceClientIsRepoCloneableFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.IsRepoCloneableRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *v1.IsRepoCloneableResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientIsRepoCloneableFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientIsRepoCloneableFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverServiceClientListGitoliteFunc describes the behavior when the
+// ListGitolite method of the parent MockGitserverServiceClient instance is
+// invoked.
+type GitserverServiceClientListGitoliteFunc struct {
+	defaultHook func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error)
+	hooks       []func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error)
+	history     []GitserverServiceClientListGitoliteFuncCall
+	mutex       sync.Mutex
+}
+
+// ListGitolite delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) ListGitolite(v0 context.Context, v1 *v1.ListGitoliteRequest, v2 ...grpc.CallOption) (*v1.ListGitoliteResponse, error) {
+	r0, r1 := m.ListGitoliteFunc.nextHook()(v0, v1, v2...)
+	m.ListGitoliteFunc.appendCall(GitserverServiceClientListGitoliteFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListGitolite method
+// of the parent MockGitserverServiceClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverServiceClientListGitoliteFunc) SetDefaultHook(hook func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListGitolite method of the parent MockGitserverServiceClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverServiceClientListGitoliteFunc) PushHook(hook func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientListGitoliteFunc) SetDefaultReturn(r0 *v1.ListGitoliteResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientListGitoliteFunc) PushReturn(r0 *v1.ListGitoliteResponse, r1 error) {
+	f.PushHook(func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientListGitoliteFunc) nextHook() func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientListGitoliteFunc) appendCall(r0 GitserverServiceClientListGitoliteFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverServiceClientListGitoliteFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverServiceClientListGitoliteFunc) History() []GitserverServiceClientListGitoliteFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientListGitoliteFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientListGitoliteFuncCall is an object that describes an
+// invocation of method ListGitolite on an instance of
+// MockGitserverServiceClient.
+type GitserverServiceClientListGitoliteFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.ListGitoliteRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *v1.ListGitoliteResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientListGitoliteFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientListGitoliteFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverServiceClientListRefsFunc describes the behavior when the
+// ListRefs method of the parent MockGitserverServiceClient instance is
+// invoked.
+type GitserverServiceClientListRefsFunc struct {
+	defaultHook func(context.Context, *v1.ListRefsRequest, ...grpc.CallOption) (v1.GitserverService_ListRefsClient, error)
+	hooks       []func(context.Context, *v1.ListRefsRequest, ...grpc.CallOption) (v1.GitserverService_ListRefsClient, error)
+	history     []GitserverServiceClientListRefsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRefs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) ListRefs(v0 context.Context, v1 *v1.ListRefsRequest, v2 ...grpc.CallOption) (v1.GitserverService_ListRefsClient, error) {
+	r0, r1 := m.ListRefsFunc.nextHook()(v0, v1, v2...)
+	m.ListRefsFunc.appendCall(GitserverServiceClientListRefsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the IsRepoCloneable
-// method of the parent MockGitserverServiceClient instance is invoked and
-// the hook queue is empty.
-func (f *GitserverServiceClientIsRepoCloneableFunc) SetDefaultHook(hook func(context.Context, *v1.IsRepoCloneableRequest, ...grpc.CallOption) (*v1.IsRepoCloneableResponse, error)) {
+// SetDefaultHook sets function that is called when the ListRefs method of
+// the parent MockGitserverServiceClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverServiceClientListRefsFunc) SetDefaultHook(hook func(context.Context, *v1.ListRefsRequest, ...grpc.CallOption) (v1.GitserverService_ListRefsClient, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IsRepoCloneable method of the parent MockGitserverServiceClient instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *GitserverServiceClientIsRepoCloneableFunc) PushHook(hook func(context.Context, *v1.IsRepoCloneableRequest, ...grpc.CallOption) (*v1.IsRepoCloneableResponse, error)) {
+// ListRefs method of the parent MockGitserverServiceClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverServiceClientListRefsFunc) PushHook(hook func(context.Context, *v1.ListRefsRequest, ...grpc.CallOption) (v1.GitserverService_ListRefsClient, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1520,20 +2235,20 @@ func (f *GitserverServiceClientIsRepoCloneableFunc) PushHook(hook func(context.C
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverServiceClientIsRepoCloneableFunc) SetDefaultReturn(r0 *v1.IsRepoCloneableResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *v1.IsRepoCloneableRequest, ...grpc.CallOption) (*v1.IsRepoCloneableResponse, error) {
+func (f *GitserverServiceClientListRefsFunc) SetDefaultReturn(r0 v1.GitserverService_ListRefsClient, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.ListRefsRequest, ...grpc.CallOption) (v1.GitserverService_ListRefsClient, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverServiceClientIsRepoCloneableFunc) PushReturn(r0 *v1.IsRepoCloneableResponse, r1 error) {
-	f.PushHook(func(context.Context, *v1.IsRepoCloneableRequest, ...grpc.CallOption) (*v1.IsRepoCloneableResponse, error) {
+func (f *GitserverServiceClientListRefsFunc) PushReturn(r0 v1.GitserverService_ListRefsClient, r1 error) {
+	f.PushHook(func(context.Context, *v1.ListRefsRequest, ...grpc.CallOption) (v1.GitserverService_ListRefsClient, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverServiceClientIsRepoCloneableFunc) nextHook() func(context.Context, *v1.IsRepoCloneableRequest, ...grpc.CallOption) (*v1.IsRepoCloneableResponse, error) {
+func (f *GitserverServiceClientListRefsFunc) nextHook() func(context.Context, *v1.ListRefsRequest, ...grpc.CallOption) (v1.GitserverService_ListRefsClient, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1546,40 +2261,39 @@ func (f *GitserverServiceClientIsRepoCloneableFunc) nextHook() func(context.Cont
 	return hook
 }
 
-func (f *GitserverServiceClientIsRepoCloneableFunc) appendCall(r0 GitserverServiceClientIsRepoCloneableFuncCall) {
+func (f *GitserverServiceClientListRefsFunc) appendCall(r0 GitserverServiceClientListRefsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// GitserverServiceClientIsRepoCloneableFuncCall objects describing the
-// invocations of this function.
-func (f *GitserverServiceClientIsRepoCloneableFunc) History() []GitserverServiceClientIsRepoCloneableFuncCall {
+// History returns a sequence of GitserverServiceClientListRefsFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverServiceClientListRefsFunc) History() []GitserverServiceClientListRefsFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverServiceClientIsRepoCloneableFuncCall, len(f.history))
+	history := make([]GitserverServiceClientListRefsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverServiceClientIsRepoCloneableFuncCall is an object that describes
-// an invocation of method IsRepoCloneable on an instance of
+// GitserverServiceClientListRefsFuncCall is an object that describes an
+// invocation of method ListRefs on an instance of
 // MockGitserverServiceClient.
-type GitserverServiceClientIsRepoCloneableFuncCall struct {
+type GitserverServiceClientListRefsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *v1.IsRepoCloneableRequest
+	Arg1 *v1.ListRefsRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *v1.IsRepoCloneableResponse
+	Result0 v1.GitserverService_ListRefsClient
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1589,7 +2303,7 @@ type GitserverServiceClientIsRepoCloneableFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c GitserverServiceClientIsRepoCloneableFuncCall) Args() []interface{} {
+func (c GitserverServiceClientListRefsFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -1600,41 +2314,41 @@ func (c GitserverServiceClientIsRepoCloneableFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverServiceClientIsRepoCloneableFuncCall) Results() []interface{} {
+func (c GitserverServiceClientListRefsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitserverServiceClientListGitoliteFunc describes the behavior when the
-// ListGitolite method of the parent MockGitserverServiceClient instance is
+// GitserverServiceClientMergeBaseFunc describes the behavior when the
+// MergeBase method of the parent MockGitserverServiceClient instance is
 // invoked.
-type GitserverServiceClientListGitoliteFunc struct {
-	defaultHook func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error)
-	hooks       []func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error)
-	history     []GitserverServiceClientListGitoliteFuncCall
+type GitserverServiceClientMergeBaseFunc struct {
+	defaultHook func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error)
+	hooks       []func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error)
+	history     []GitserverServiceClientMergeBaseFuncCall
 	mutex       sync.Mutex
 }
 
-// ListGitolite delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockGitserverServiceClient) ListGitolite(v0 context.Context, v1 *v1.ListGitoliteRequest, v2 ...grpc.CallOption) (*v1.ListGitoliteResponse, error) {
-	r0, r1 := m.ListGitoliteFunc.nextHook()(v0, v1, v2...)
-	m.ListGitoliteFunc.appendCall(GitserverServiceClientListGitoliteFuncCall{v0, v1, v2, r0, r1})
+// MergeBase delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) MergeBase(v0 context.Context, v1 *v1.MergeBaseRequest, v2 ...grpc.CallOption) (*v1.MergeBaseResponse, error) {
+	r0, r1 := m.MergeBaseFunc.nextHook()(v0, v1, v2...)
+	m.MergeBaseFunc.appendCall(GitserverServiceClientMergeBaseFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ListGitolite method
-// of the parent MockGitserverServiceClient instance is invoked and the hook
+// SetDefaultHook sets function that is called when the MergeBase method of
+// the parent MockGitserverServiceClient instance is invoked and the hook
 // queue is empty.
-func (f *GitserverServiceClientListGitoliteFunc) SetDefaultHook(hook func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error)) {
+func (f *GitserverServiceClientMergeBaseFunc) SetDefaultHook(hook func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListGitolite method of the parent MockGitserverServiceClient instance
+// MergeBase method of the parent MockGitserverServiceClient instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *GitserverServiceClientListGitoliteFunc) PushHook(hook func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error)) {
+func (f *GitserverServiceClientMergeBaseFunc) PushHook(hook func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1642,20 +2356,20 @@ func (f *GitserverServiceClientListGitoliteFunc) PushHook(hook func(context.Cont
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverServiceClientListGitoliteFunc) SetDefaultReturn(r0 *v1.ListGitoliteResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error) {
+func (f *GitserverServiceClientMergeBaseFunc) SetDefaultReturn(r0 *v1.MergeBaseResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverServiceClientListGitoliteFunc) PushReturn(r0 *v1.ListGitoliteResponse, r1 error) {
-	f.PushHook(func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error) {
+func (f *GitserverServiceClientMergeBaseFunc) PushReturn(r0 *v1.MergeBaseResponse, r1 error) {
+	f.PushHook(func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverServiceClientListGitoliteFunc) nextHook() func(context.Context, *v1.ListGitoliteRequest, ...grpc.CallOption) (*v1.ListGitoliteResponse, error) {
+func (f *GitserverServiceClientMergeBaseFunc) nextHook() func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1668,39 +2382,39 @@ func (f *GitserverServiceClientListGitoliteFunc) nextHook() func(context.Context
 	return hook
 }
 
-func (f *GitserverServiceClientListGitoliteFunc) appendCall(r0 GitserverServiceClientListGitoliteFuncCall) {
+func (f *GitserverServiceClientMergeBaseFunc) appendCall(r0 GitserverServiceClientMergeBaseFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitserverServiceClientListGitoliteFuncCall
+// History returns a sequence of GitserverServiceClientMergeBaseFuncCall
 // objects describing the invocations of this function.
-func (f *GitserverServiceClientListGitoliteFunc) History() []GitserverServiceClientListGitoliteFuncCall {
+func (f *GitserverServiceClientMergeBaseFunc) History() []GitserverServiceClientMergeBaseFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverServiceClientListGitoliteFuncCall, len(f.history))
+	history := make([]GitserverServiceClientMergeBaseFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverServiceClientListGitoliteFuncCall is an object that describes an
-// invocation of method ListGitolite on an instance of
+// GitserverServiceClientMergeBaseFuncCall is an object that describes an
+// invocation of method MergeBase on an instance of
 // MockGitserverServiceClient.
-type GitserverServiceClientListGitoliteFuncCall struct {
+type GitserverServiceClientMergeBaseFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *v1.ListGitoliteRequest
+	Arg1 *v1.MergeBaseRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *v1.ListGitoliteResponse
+	Result0 *v1.MergeBaseResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1710,7 +2424,7 @@ type GitserverServiceClientListGitoliteFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c GitserverServiceClientListGitoliteFuncCall) Args() []interface{} {
+func (c GitserverServiceClientMergeBaseFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -1721,7 +2435,7 @@ func (c GitserverServiceClientListGitoliteFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverServiceClientListGitoliteFuncCall) Results() []interface{} {
+func (c GitserverServiceClientMergeBaseFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
@@ -2452,6 +3166,246 @@ func (c GitserverServiceClientPerforceUsersFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverServiceClientReachabilityFunc describes the behavior when the
+// Reachability method of the parent MockGitserverServiceClient instance is
+// invoked.
+type GitserverServiceClientReachabilityFunc struct {
+	defaultHook func(context.Context, *v1.ReachabilityRequest, ...grpc.CallOption) (*v1.ReachabilityResponse, error)
+	hooks       []func(context.Context, *v1.ReachabilityRequest, ...grpc.CallOption) (*v1.ReachabilityResponse, error)
+	history     []GitserverServiceClientReachabilityFuncCall
+	mutex       sync.Mutex
+}
+
+// Reachability delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) Reachability(v0 context.Context, v1 *v1.ReachabilityRequest, v2 ...grpc.CallOption) (*v1.ReachabilityResponse, error) {
+	r0, r1 := m.ReachabilityFunc.nextHook()(v0, v1, v2...)
+	m.ReachabilityFunc.appendCall(GitserverServiceClientReachabilityFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Reachability method
+// of the parent MockGitserverServiceClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverServiceClientReachabilityFunc) SetDefaultHook(hook func(context.Context, *v1.ReachabilityRequest, ...grpc.CallOption) (*v1.ReachabilityResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Reachability method of the parent MockGitserverServiceClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverServiceClientReachabilityFunc) PushHook(hook func(context.Context, *v1.ReachabilityRequest, ...grpc.CallOption) (*v1.ReachabilityResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientReachabilityFunc) SetDefaultReturn(r0 *v1.ReachabilityResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.ReachabilityRequest, ...grpc.CallOption) (*v1.ReachabilityResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientReachabilityFunc) PushReturn(r0 *v1.ReachabilityResponse, r1 error) {
+	f.PushHook(func(context.Context, *v1.ReachabilityRequest, ...grpc.CallOption) (*v1.ReachabilityResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientReachabilityFunc) nextHook() func(context.Context, *v1.ReachabilityRequest, ...grpc.CallOption) (*v1.ReachabilityResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientReachabilityFunc) appendCall(r0 GitserverServiceClientReachabilityFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverServiceClientReachabilityFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverServiceClientReachabilityFunc) History() []GitserverServiceClientReachabilityFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientReachabilityFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientReachabilityFuncCall is an object that describes an
+// invocation of method Reachability on an instance of
+// MockGitserverServiceClient.
+type GitserverServiceClientReachabilityFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.ReachabilityRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *v1.ReachabilityResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientReachabilityFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientReachabilityFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverServiceClientReadDirFunc describes the behavior when the ReadDir
+// method of the parent MockGitserverServiceClient instance is invoked.
+type GitserverServiceClientReadDirFunc struct {
+	defaultHook func(context.Context, *v1.ReadDirRequest, ...grpc.CallOption) (v1.GitserverService_ReadDirClient, error)
+	hooks       []func(context.Context, *v1.ReadDirRequest, ...grpc.CallOption) (v1.GitserverService_ReadDirClient, error)
+	history     []GitserverServiceClientReadDirFuncCall
+	mutex       sync.Mutex
+}
+
+// ReadDir delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) ReadDir(v0 context.Context, v1 *v1.ReadDirRequest, v2 ...grpc.CallOption) (v1.GitserverService_ReadDirClient, error) {
+	r0, r1 := m.ReadDirFunc.nextHook()(v0, v1, v2...)
+	m.ReadDirFunc.appendCall(GitserverServiceClientReadDirFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ReadDir method of
+// the parent MockGitserverServiceClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverServiceClientReadDirFunc) SetDefaultHook(hook func(context.Context, *v1.ReadDirRequest, ...grpc.CallOption) (v1.GitserverService_ReadDirClient, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReadDir method of the parent MockGitserverServiceClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverServiceClientReadDirFunc) PushHook(hook func(context.Context, *v1.ReadDirRequest, ...grpc.CallOption) (v1.GitserverService_ReadDirClient, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientReadDirFunc) SetDefaultReturn(r0 v1.GitserverService_ReadDirClient, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.ReadDirRequest, ...grpc.CallOption) (v1.GitserverService_ReadDirClient, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientReadDirFunc) PushReturn(r0 v1.GitserverService_ReadDirClient, r1 error) {
+	f.PushHook(func(context.Context, *v1.ReadDirRequest, ...grpc.CallOption) (v1.GitserverService_ReadDirClient, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientReadDirFunc) nextHook() func(context.Context, *v1.ReadDirRequest, ...grpc.CallOption) (v1.GitserverService_ReadDirClient, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientReadDirFunc) appendCall(r0 GitserverServiceClientReadDirFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverServiceClientReadDirFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverServiceClientReadDirFunc) History() []GitserverServiceClientReadDirFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientReadDirFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientReadDirFuncCall is an object that describes an
+// invocation of method ReadDir on an instance of
+// MockGitserverServiceClient.
+type GitserverServiceClientReadDirFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.ReadDirRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 v1.GitserverService_ReadDirClient
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientReadDirFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientReadDirFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverServiceClientRepoCloneFunc describes the behavior when the
 // RepoClone method of the parent MockGitserverServiceClient instance is
 // invoked.
@@ -506,9 +506,10 @@ type RepoCloneProgressRequest struct {
 
 // RepoCloneProgress is information about the clone progress of a repo
 type RepoCloneProgress struct {
-	CloneInProgress bool   // whether the repository is currently being cloned
-	CloneProgress   string // a progress message from the running clone command.
-	Cloned          bool   // whether the repository has been cloned successfully
+	CloneInProgress bool                  // whether the repository is currently being cloned
+	CloneProgress   string                // a progress message from the running clone command.
+	Cloned          bool                  // whether the repository has been cloned successfully
+	ProgressDetails *CloneProgressDetails // structured progress of the running clone command, if any
 }
 
 func (r *RepoCloneProgress) ToProto() *proto.RepoCloneProgress {
@@ -516,6 +517,7 @@ func (r *RepoCloneProgress) ToProto() *proto.RepoCloneProgress {
 		CloneInProgress: r.CloneInProgress,
 		CloneProgress:   r.CloneProgress,
 		Cloned:          r.Cloned,
+		ProgressDetails: r.ProgressDetails.ToProto(),
 	}
 }
 
@@ -525,6 +527,61 @@ func (r *RepoCloneProgress) FromProto(p *proto.RepoCloneProgress) {
 		CloneProgress:   p.GetCloneProgress(),
 		Cloned:          p.GetCloned(),
 	}
+	if p.GetProgressDetails() != nil {
+		r.ProgressDetails = &CloneProgressDetails{}
+		r.ProgressDetails.FromProto(p.GetProgressDetails())
+	}
+}
+
+// CloneProgressDetails is structured progress information of a running clone.
+type CloneProgressDetails struct {
+	// Phase is the phase of the clone as reported by git, for example
+	// "Receiving objects" or "Resolving deltas".
+	Phase string
+	// ObjectsReceived is the number of objects processed in the current phase.
+	ObjectsReceived int64
+	// ObjectsTotal is the total number of objects to process in the current
+	// phase.
+	ObjectsTotal int64
+	// BytesReceived is the amount of data received from the remote so far.
+	BytesReceived int64
+	// ETA is the estimated time until the current phase completes. It is nil if
+	// it cannot be estimated yet.
+	ETA *time.Duration
+	// Resumed is whether the clone continues from a partial clone left behind
+	// by an earlier, interrupted attempt.
+	Resumed bool
+}
+
+func (d *CloneProgressDetails) ToProto() *proto.CloneProgressDetails {
+	if d == nil {
+		return nil
+	}
+	p := &proto.CloneProgressDetails{
+		Phase:           d.Phase,
+		ObjectsReceived: d.ObjectsReceived,
+		ObjectsTotal:    d.ObjectsTotal,
+		BytesReceived:   d.BytesReceived,
+		Resumed:         d.Resumed,
+	}
+	if d.ETA != nil {
+		p.Eta = durationpb.New(*d.ETA)
+	}
+	return p
+}
+
+func (d *CloneProgressDetails) FromProto(p *proto.CloneProgressDetails) {
+	*d = CloneProgressDetails{
+		Phase:           p.GetPhase(),
+		ObjectsReceived: p.GetObjectsReceived(),
+		ObjectsTotal:    p.GetObjectsTotal(),
+		BytesReceived:   p.GetBytesReceived(),
+		Resumed:         p.GetResumed(),
+	}
+	if p.GetEta() != nil {
+		eta := p.GetEta().AsDuration()
+		d.ETA = &eta
+	}
 }
 
 // RepoCloneProgressResponse is the response to a repository clone progress request
@@ -536,11 +593,7 @@ type RepoCloneProgressResponse struct {
 func (r *RepoCloneProgressResponse) ToProto() *proto.RepoCloneProgressResponse {
 	results := make(map[string]*proto.RepoCloneProgress, len(r.Results))
 	for k, v := range r.Results {
-		results[string(k)] = &proto.RepoCloneProgress{
-			CloneInProgress: v.CloneInProgress,
-			CloneProgress:   v.CloneProgress,
-			Cloned:          v.Cloned,
-		}
+		results[string(k)] = v.ToProto()
 	}
 	return &proto.RepoCloneProgressResponse{
 		Results: results,
@@ -550,11 +603,9 @@ func (r *RepoCloneProgressResponse) ToProto() *proto.RepoCloneProgressResponse {
 func (r *RepoCloneProgressResponse) FromProto(p *proto.RepoCloneProgressResponse) {
 	results := make(map[api.RepoName]*RepoCloneProgress, len(p.GetResults()))
 	for k, v := range p.GetResults() {
-		results[api.RepoName(k)] = &RepoCloneProgress{
-			CloneInProgress: v.GetCloneInProgress(),
-			CloneProgress:   v.GetCloneProgress(),
-			Cloned:          v.GetCloned(),
-		}
+		var progress RepoCloneProgress
+		progress.FromProto(v)
+		results[api.RepoName(k)] = &progress
 	}
 	*r = RepoCloneProgressResponse{
 		Results: results,
@@ -767,6 +818,9 @@ type CreateCommitFromPatchError struct {
 	Command string
 	// CombinedOutput is the combined stderr and stdout from running the command
 	CombinedOutput string
+	// PatchConflict is true if the error occurred because the patch does not
+	// apply to the base commit
+	PatchConflict bool
 }
 
 func (e *CreateCommitFromPatchError) ToProto() *proto.CreateCommitFromPatchError {
@@ -775,6 +829,7 @@ func (e *CreateCommitFromPatchError) ToProto() *proto.CreateCommitFromPatchError
 		InternalError:  e.InternalError,
 		Command:        e.Command,
 		CombinedOutput: e.CombinedOutput,
+		PatchConflict:  e.PatchConflict,
 	}
 }
 
@@ -784,6 +839,7 @@ func (e *CreateCommitFromPatchError) FromProto(p *proto.CreateCommitFromPatchErr
 		InternalError:  p.GetInternalError(),
 		Command:        p.GetCommand(),
 		CombinedOutput: p.GetCombinedOutput(),
+		PatchConflict:  p.GetPatchConflict(),
 	}
 }
 
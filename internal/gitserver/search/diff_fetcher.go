@@ -15,6 +15,7 @@ import (
 // started with StartDiffFetcher
 type DiffFetcher struct {
 	dir string
+	env []string
 
 	startOnce sync.Once
 	stdin     io.Writer
@@ -51,6 +52,7 @@ func (d *DiffFetcher) start() (err error) {
 			"--root",           // Treat the root commit as a big creation event (otherwise the diff would be empty)
 		)
 		d.cmd.Dir = d.dir
+		d.cmd.Env = d.env
 
 		var stdoutReader io.ReadCloser
 		stdoutReader, err = d.cmd.StdoutPipe()
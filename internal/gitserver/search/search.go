@@ -89,6 +89,8 @@ type CommitSearcher struct {
 	IncludeDiff          bool
 	IncludeModifiedFiles bool
 	RepoName             api.RepoName
+	// Env, if set, is the environment of the git commands run by the search.
+	Env []string
 }
 
 // Search runs a search for commits matching the given predicate across the revisions passed in as revisionArgs.
@@ -146,6 +148,7 @@ func (cs *CommitSearcher) gitArgs() []string {
 func (cs *CommitSearcher) feedBatches(ctx context.Context, jobs chan job, resultChans chan chan *protocol.CommitMatch) (err error) {
 	cmd := exec.CommandContext(ctx, "git", cs.gitArgs()...)
 	cmd.Dir = cs.RepoDir
+	cmd.Env = cs.Env
 	stdoutReader, err := cmd.StdoutPipe()
 	if err != nil {
 		return err
@@ -223,6 +226,7 @@ func (cs *CommitSearcher) runJobs(ctx context.Context, jobs chan job) error {
 	if err != nil {
 		return err
 	}
+	diffFetcher.env = cs.Env
 	defer diffFetcher.Stop()
 
 	startBuf := make([]byte, 1024)
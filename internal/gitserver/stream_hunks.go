@@ -25,7 +25,9 @@ type blameHunkReader struct {
 	commits map[api.CommitID]*Hunk
 }
 
-func newBlameHunkReader(rc io.ReadCloser) HunkReader {
+// NewBlameHunkReader returns a HunkReader that parses the output of
+// `git blame --porcelain --incremental` from rc.
+func NewBlameHunkReader(rc io.ReadCloser) HunkReader {
 	return &blameHunkReader{
 		rc:      rc,
 		sc:      bufio.NewScanner(rc),
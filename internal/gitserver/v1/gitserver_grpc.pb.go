@@ -41,6 +41,13 @@ const (
 	GitserverService_PerforceGroupMembers_FullMethodName        = "/gitserver.v1.GitserverService/PerforceGroupMembers"
 	GitserverService_IsPerforceSuperUser_FullMethodName         = "/gitserver.v1.GitserverService/IsPerforceSuperUser"
 	GitserverService_PerforceGetChangelist_FullMethodName       = "/gitserver.v1.GitserverService/PerforceGetChangelist"
+	GitserverService_ListRefs_FullMethodName                    = "/gitserver.v1.GitserverService/ListRefs"
+	GitserverService_ReadDir_FullMethodName                     = "/gitserver.v1.GitserverService/ReadDir"
+	GitserverService_Blame_FullMethodName                       = "/gitserver.v1.GitserverService/Blame"
+	GitserverService_CommitLog_FullMethodName                   = "/gitserver.v1.GitserverService/CommitLog"
+	GitserverService_MergeBase_FullMethodName                   = "/gitserver.v1.GitserverService/MergeBase"
+	GitserverService_ContributorCounts_FullMethodName           = "/gitserver.v1.GitserverService/ContributorCounts"
+	GitserverService_Reachability_FullMethodName                = "/gitserver.v1.GitserverService/Reachability"
 )
 
 // GitserverServiceClient is the client API for GitserverService service.
@@ -70,6 +77,13 @@ type GitserverServiceClient interface {
 	PerforceGroupMembers(ctx context.Context, in *PerforceGroupMembersRequest, opts ...grpc.CallOption) (*PerforceGroupMembersResponse, error)
 	IsPerforceSuperUser(ctx context.Context, in *IsPerforceSuperUserRequest, opts ...grpc.CallOption) (*IsPerforceSuperUserResponse, error)
 	PerforceGetChangelist(ctx context.Context, in *PerforceGetChangelistRequest, opts ...grpc.CallOption) (*PerforceGetChangelistResponse, error)
+	ListRefs(ctx context.Context, in *ListRefsRequest, opts ...grpc.CallOption) (GitserverService_ListRefsClient, error)
+	ReadDir(ctx context.Context, in *ReadDirRequest, opts ...grpc.CallOption) (GitserverService_ReadDirClient, error)
+	Blame(ctx context.Context, in *BlameRequest, opts ...grpc.CallOption) (GitserverService_BlameClient, error)
+	CommitLog(ctx context.Context, in *CommitLogRequest, opts ...grpc.CallOption) (GitserverService_CommitLogClient, error)
+	MergeBase(ctx context.Context, in *MergeBaseRequest, opts ...grpc.CallOption) (*MergeBaseResponse, error)
+	ContributorCounts(ctx context.Context, in *ContributorCountsRequest, opts ...grpc.CallOption) (*ContributorCountsResponse, error)
+	Reachability(ctx context.Context, in *ReachabilityRequest, opts ...grpc.CallOption) (*ReachabilityResponse, error)
 }
 
 type gitserverServiceClient struct {
@@ -396,6 +410,161 @@ func (c *gitserverServiceClient) PerforceGetChangelist(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *gitserverServiceClient) ListRefs(ctx context.Context, in *ListRefsRequest, opts ...grpc.CallOption) (GitserverService_ListRefsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[5], GitserverService_ListRefs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceListRefsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_ListRefsClient interface {
+	Recv() (*ListRefsResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceListRefsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceListRefsClient) Recv() (*ListRefsResponse, error) {
+	m := new(ListRefsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) ReadDir(ctx context.Context, in *ReadDirRequest, opts ...grpc.CallOption) (GitserverService_ReadDirClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[6], GitserverService_ReadDir_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceReadDirClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_ReadDirClient interface {
+	Recv() (*ReadDirResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceReadDirClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceReadDirClient) Recv() (*ReadDirResponse, error) {
+	m := new(ReadDirResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) Blame(ctx context.Context, in *BlameRequest, opts ...grpc.CallOption) (GitserverService_BlameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[7], GitserverService_Blame_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceBlameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_BlameClient interface {
+	Recv() (*BlameResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceBlameClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceBlameClient) Recv() (*BlameResponse, error) {
+	m := new(BlameResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) CommitLog(ctx context.Context, in *CommitLogRequest, opts ...grpc.CallOption) (GitserverService_CommitLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[8], GitserverService_CommitLog_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceCommitLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_CommitLogClient interface {
+	Recv() (*CommitLogResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceCommitLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceCommitLogClient) Recv() (*CommitLogResponse, error) {
+	m := new(CommitLogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) MergeBase(ctx context.Context, in *MergeBaseRequest, opts ...grpc.CallOption) (*MergeBaseResponse, error) {
+	out := new(MergeBaseResponse)
+	err := c.cc.Invoke(ctx, GitserverService_MergeBase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) ContributorCounts(ctx context.Context, in *ContributorCountsRequest, opts ...grpc.CallOption) (*ContributorCountsResponse, error) {
+	out := new(ContributorCountsResponse)
+	err := c.cc.Invoke(ctx, GitserverService_ContributorCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) Reachability(ctx context.Context, in *ReachabilityRequest, opts ...grpc.CallOption) (*ReachabilityResponse, error) {
+	out := new(ReachabilityResponse)
+	err := c.cc.Invoke(ctx, GitserverService_Reachability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GitserverServiceServer is the server API for GitserverService service.
 // All implementations must embed UnimplementedGitserverServiceServer
 // for forward compatibility
@@ -423,6 +592,13 @@ type GitserverServiceServer interface {
 	PerforceGroupMembers(context.Context, *PerforceGroupMembersRequest) (*PerforceGroupMembersResponse, error)
 	IsPerforceSuperUser(context.Context, *IsPerforceSuperUserRequest) (*IsPerforceSuperUserResponse, error)
 	PerforceGetChangelist(context.Context, *PerforceGetChangelistRequest) (*PerforceGetChangelistResponse, error)
+	ListRefs(*ListRefsRequest, GitserverService_ListRefsServer) error
+	ReadDir(*ReadDirRequest, GitserverService_ReadDirServer) error
+	Blame(*BlameRequest, GitserverService_BlameServer) error
+	CommitLog(*CommitLogRequest, GitserverService_CommitLogServer) error
+	MergeBase(context.Context, *MergeBaseRequest) (*MergeBaseResponse, error)
+	ContributorCounts(context.Context, *ContributorCountsRequest) (*ContributorCountsResponse, error)
+	Reachability(context.Context, *ReachabilityRequest) (*ReachabilityResponse, error)
 	mustEmbedUnimplementedGitserverServiceServer()
 }
 
@@ -496,6 +672,27 @@ func (UnimplementedGitserverServiceServer) IsPerforceSuperUser(context.Context,
 func (UnimplementedGitserverServiceServer) PerforceGetChangelist(context.Context, *PerforceGetChangelistRequest) (*PerforceGetChangelistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PerforceGetChangelist not implemented")
 }
+func (UnimplementedGitserverServiceServer) ListRefs(*ListRefsRequest, GitserverService_ListRefsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRefs not implemented")
+}
+func (UnimplementedGitserverServiceServer) ReadDir(*ReadDirRequest, GitserverService_ReadDirServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadDir not implemented")
+}
+func (UnimplementedGitserverServiceServer) Blame(*BlameRequest, GitserverService_BlameServer) error {
+	return status.Errorf(codes.Unimplemented, "method Blame not implemented")
+}
+func (UnimplementedGitserverServiceServer) CommitLog(*CommitLogRequest, GitserverService_CommitLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method CommitLog not implemented")
+}
+func (UnimplementedGitserverServiceServer) MergeBase(context.Context, *MergeBaseRequest) (*MergeBaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeBase not implemented")
+}
+func (UnimplementedGitserverServiceServer) ContributorCounts(context.Context, *ContributorCountsRequest) (*ContributorCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContributorCounts not implemented")
+}
+func (UnimplementedGitserverServiceServer) Reachability(context.Context, *ReachabilityRequest) (*ReachabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reachability not implemented")
+}
 func (UnimplementedGitserverServiceServer) mustEmbedUnimplementedGitserverServiceServer() {}
 
 // UnsafeGitserverServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -925,6 +1122,144 @@ func _GitserverService_PerforceGetChangelist_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GitserverService_ListRefs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRefsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).ListRefs(m, &gitserverServiceListRefsServer{stream})
+}
+
+type GitserverService_ListRefsServer interface {
+	Send(*ListRefsResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceListRefsServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceListRefsServer) Send(m *ListRefsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_ReadDir_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadDirRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).ReadDir(m, &gitserverServiceReadDirServer{stream})
+}
+
+type GitserverService_ReadDirServer interface {
+	Send(*ReadDirResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceReadDirServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceReadDirServer) Send(m *ReadDirResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_Blame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).Blame(m, &gitserverServiceBlameServer{stream})
+}
+
+type GitserverService_BlameServer interface {
+	Send(*BlameResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceBlameServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceBlameServer) Send(m *BlameResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_CommitLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CommitLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).CommitLog(m, &gitserverServiceCommitLogServer{stream})
+}
+
+type GitserverService_CommitLogServer interface {
+	Send(*CommitLogResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceCommitLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceCommitLogServer) Send(m *CommitLogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_MergeBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeBaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).MergeBase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_MergeBase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).MergeBase(ctx, req.(*MergeBaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_ContributorCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContributorCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).ContributorCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_ContributorCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).ContributorCounts(ctx, req.(*ContributorCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_Reachability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReachabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).Reachability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_Reachability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).Reachability(ctx, req.(*ReachabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GitserverService_ServiceDesc is the grpc.ServiceDesc for GitserverService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1000,6 +1335,18 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PerforceGetChangelist",
 			Handler:    _GitserverService_PerforceGetChangelist_Handler,
 		},
+		{
+			MethodName: "MergeBase",
+			Handler:    _GitserverService_MergeBase_Handler,
+		},
+		{
+			MethodName: "ContributorCounts",
+			Handler:    _GitserverService_ContributorCounts_Handler,
+		},
+		{
+			MethodName: "Reachability",
+			Handler:    _GitserverService_Reachability_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1027,6 +1374,26 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GitserverService_P4Exec_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListRefs",
+			Handler:       _GitserverService_ListRefs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReadDir",
+			Handler:       _GitserverService_ReadDir_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Blame",
+			Handler:       _GitserverService_Blame_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CommitLog",
+			Handler:       _GitserverService_CommitLog_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "gitserver.proto",
 }
@@ -2,6 +2,7 @@ package aggregation
 
 import (
 	"context"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -13,6 +14,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/insights/query/querybuilder"
 	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	sApi "github.com/sourcegraph/sourcegraph/internal/search/streaming/api"
@@ -88,6 +92,115 @@ func countAuthor(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
 	return nil, nil
 }
 
+func countLanguage(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+	var language string
+	switch match := r.(type) {
+	case *result.FileMatch:
+		language, _ = query.LanguageForPath(match.Path)
+	default:
+	}
+	if language != "" {
+		return map[MatchKey]int{{
+			RepoID: int32(r.RepoName().ID),
+			Repo:   string(r.RepoName().Name),
+			Group:  language,
+		}: r.ResultCount()}, nil
+	}
+	return nil, nil
+}
+
+func countFileExtension(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+	match, ok := r.(*result.FileMatch)
+	if !ok || match.Path == "" {
+		return nil, nil
+	}
+	extension := filepath.Ext(match.Path)
+	if extension == "" {
+		extension = types.NO_FILE_EXTENSION_TEXT
+	}
+	return map[MatchKey]int{{
+		RepoID: int32(r.RepoName().ID),
+		Repo:   string(r.RepoName().Name),
+		Group:  extension,
+	}: r.ResultCount()}, nil
+}
+
+// countCommitDate groups commits by the month they were committed in.
+func countCommitDate(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+	var date time.Time
+	switch match := r.(type) {
+	case *result.CommitMatch:
+		date = match.Commit.Author.Date
+		if committer := match.Commit.Committer; committer != nil && !committer.Date.IsZero() {
+			date = committer.Date
+		}
+	default:
+	}
+	if !date.IsZero() {
+		return map[MatchKey]int{{
+			RepoID: int32(r.RepoName().ID),
+			Repo:   string(r.RepoName().Name),
+			Group:  date.UTC().Format(types.COMMIT_DATE_AGGREGATION_LAYOUT),
+		}: r.ResultCount()}, nil
+	}
+	return nil, nil
+}
+
+// countOwnerFunc returns a count function grouping file matches by the owners the CODEOWNERS
+// ruleset of their repository assigns to them. Rulesets are fetched once per repository and commit.
+func countOwnerFunc(ctx context.Context, ownService own.Service) AggregationCountFunc {
+	type rulesetKey struct {
+		repoID   api.RepoID
+		commitID api.CommitID
+	}
+	var mu sync.Mutex
+	rulesets := map[rulesetKey]*codeowners.Ruleset{}
+
+	rulesetFor := func(match *result.FileMatch) (*codeowners.Ruleset, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		key := rulesetKey{repoID: match.Repo.ID, commitID: match.CommitID}
+		if ruleset, ok := rulesets[key]; ok {
+			return ruleset, nil
+		}
+		ruleset, err := ownService.RulesetForRepo(ctx, match.Repo.Name, match.Repo.ID, match.CommitID)
+		if err != nil {
+			return nil, errors.Wrap(err, "RulesetForRepo")
+		}
+		rulesets[key] = ruleset
+		return ruleset, nil
+	}
+
+	return func(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+		match, ok := r.(*result.FileMatch)
+		if !ok || match.Path == "" {
+			return nil, nil
+		}
+		ruleset, err := rulesetFor(match)
+		if err != nil {
+			return nil, err
+		}
+
+		matches := map[MatchKey]int{}
+		if ruleset != nil {
+			for _, owner := range ruleset.Match(match.Path).GetOwner() {
+				group := owner.GetHandle()
+				if group == "" {
+					group = owner.GetEmail()
+				}
+				if group == "" {
+					continue
+				}
+				matches[MatchKey{Repo: string(r.RepoName().Name), RepoID: int32(r.RepoName().ID), Group: group}] = r.ResultCount()
+			}
+		}
+		if len(matches) == 0 {
+			matches[MatchKey{Repo: string(r.RepoName().Name), RepoID: int32(r.RepoName().ID), Group: types.NO_OWNER_TEXT}] = r.ResultCount()
+		}
+		return matches, nil
+	}
+}
+
 func countCaptureGroupsFunc(querystring string) (AggregationCountFunc, error) {
 	pattern, err := getCasedPattern(querystring)
 	if err != nil {
@@ -168,12 +281,17 @@ func countRepoMetadata(r result.Match, repo *sTypes.Repo) (map[MatchKey]int, err
 	return matches, nil
 }
 
-func GetCountFuncForMode(query, patternType string, mode types.SearchAggregationMode) (AggregationCountFunc, error) {
+// GetCountFuncForMode returns the function counting search results for the given aggregation mode.
+// The own service is used to resolve the owners of files in OWNER_AGGREGATION_MODE.
+func GetCountFuncForMode(ctx context.Context, query, patternType string, mode types.SearchAggregationMode, ownService own.Service) (AggregationCountFunc, error) {
 	modeCountTypes := map[types.SearchAggregationMode]AggregationCountFunc{
-		types.REPO_AGGREGATION_MODE:          countRepo,
-		types.PATH_AGGREGATION_MODE:          countPath,
-		types.AUTHOR_AGGREGATION_MODE:        countAuthor,
-		types.REPO_METADATA_AGGREGATION_MODE: countRepoMetadata,
+		types.REPO_AGGREGATION_MODE:           countRepo,
+		types.PATH_AGGREGATION_MODE:           countPath,
+		types.AUTHOR_AGGREGATION_MODE:         countAuthor,
+		types.REPO_METADATA_AGGREGATION_MODE:  countRepoMetadata,
+		types.LANGUAGE_AGGREGATION_MODE:       countLanguage,
+		types.FILE_EXTENSION_AGGREGATION_MODE: countFileExtension,
+		types.COMMIT_DATE_AGGREGATION_MODE:    countCommitDate,
 	}
 
 	if mode == types.CAPTURE_GROUP_AGGREGATION_MODE {
@@ -184,6 +302,10 @@ func GetCountFuncForMode(query, patternType string, mode types.SearchAggregation
 		modeCountTypes[types.CAPTURE_GROUP_AGGREGATION_MODE] = captureGroupsCount
 	}
 
+	if mode == types.OWNER_AGGREGATION_MODE && ownService != nil {
+		modeCountTypes[types.OWNER_AGGREGATION_MODE] = countOwnerFunc(ctx, ownService)
+	}
+
 	modeCountFunc, ok := modeCountTypes[mode]
 	if !ok {
 		return nil, errors.Newf("unsupported aggregation mode: %s for query", mode)
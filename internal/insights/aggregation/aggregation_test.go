@@ -12,6 +12,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	dTypes "github.com/sourcegraph/sourcegraph/internal/types"
@@ -102,7 +105,7 @@ func commitMatch(repo, author string, date time.Time, repoID, numRanges int32, c
 
 	return &result.CommitMatch{
 		Commit: gitdomain.Commit{
-			Author:    gitdomain.Signature{Name: author},
+			Author:    gitdomain.Signature{Name: author, Date: date},
 			Committer: &gitdomain.Signature{},
 			Message:   gitdomain.Message(content),
 		},
@@ -260,7 +263,7 @@ func TestRepoAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode(context.Background(), "", "", tc.mode, nil)
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -330,7 +333,7 @@ func TestAuthorAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode(context.Background(), "", "", tc.mode, nil)
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -438,7 +441,7 @@ func TestPathAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode(context.Background(), "", "", tc.mode, nil)
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -649,7 +652,7 @@ func TestCaptureGroupAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, err := GetCountFuncForMode(tc.query, "regexp", tc.mode)
+			countFunc, err := GetCountFuncForMode(context.Background(), tc.query, "regexp", tc.mode, nil)
 			if err != nil {
 				t.Errorf("expected test not to error, got %v", err)
 				t.FailNow()
@@ -703,7 +706,7 @@ func TestRepoMetadataAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode(context.Background(), "", "", tc.mode, nil)
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, db)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -711,6 +714,130 @@ func TestRepoMetadataAggregation(t *testing.T) {
 	}
 }
 
+func TestFilePropertyAggregation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		mode        types.SearchAggregationMode
+		searchEvent streaming.SearchEvent
+		want        autogold.Value
+	}{
+		{
+			"No language for repo match",
+			types.LANGUAGE_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{repoMatch("myRepo", 1)},
+			},
+			autogold.Expect(map[string]int{}),
+		},
+		{
+			"counts by language",
+			types.LANGUAGE_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{
+					contentMatch("myRepo", "main.go", 1, "a", "b"),
+					pathMatch("myRepo", "dir/util.go", 1),
+					contentMatch("myRepo2", "setup.py", 2, "a"),
+					pathMatch("myRepo2", "unknown.notalanguage", 2),
+					pathMatch("myRepo2", "dir.d/Makefile", 2),
+					pathMatch("myRepo2", "docker/Dockerfile", 2),
+				},
+			},
+			autogold.Expect(map[string]int{"Dockerfile": 1, "Go": 3, "Makefile": 1, "Python": 1}),
+		},
+		{
+			"No file extension for commit match",
+			types.FILE_EXTENSION_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{commitMatch("repoA", "Author A", sampleDate, 1, 2, "a")},
+			},
+			autogold.Expect(map[string]int{}),
+		},
+		{
+			"counts by file extension",
+			types.FILE_EXTENSION_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{
+					contentMatch("myRepo", "main.go", 1, "a", "b"),
+					pathMatch("myRepo", "dir.d/Makefile", 1),
+					symbolMatch("myRepo2", "lib.test.ts", 2, "a", "b", "c"),
+				},
+			},
+			autogold.Expect(map[string]int{".go": 2, ".ts": 3, "No extension": 1}),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			aggregator := testAggregator{results: make(map[string]int)}
+			countFunc, _ := GetCountFuncForMode(context.Background(), "", "", tc.mode, nil)
+			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
+			sra.Send(tc.searchEvent)
+			tc.want.Equal(t, aggregator.results)
+		})
+	}
+}
+
+func TestCommitDateAggregation(t *testing.T) {
+	may := time.Date(2023, 5, 31, 23, 0, 0, 0, time.UTC)
+	june := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	aggregator := testAggregator{results: make(map[string]int)}
+	countFunc, _ := GetCountFuncForMode(context.Background(), "", "", types.COMMIT_DATE_AGGREGATION_MODE, nil)
+	sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, types.COMMIT_DATE_AGGREGATION_MODE, nil)
+	sra.Send(streaming.SearchEvent{
+		Results: []result.Match{
+			commitMatch("repoA", "Author A", may, 1, 2, "a"),
+			commitMatch("repoB", "Author B", june, 2, 2, "a"),
+			commitMatch("repoB", "Author C", june, 2, 2, "a"),
+			pathMatch("myRepo", "file.go", 1),
+		},
+	})
+	autogold.Expect(map[string]int{"2023-05": 2, "2023-06": 4}).Equal(t, aggregator.results)
+}
+
+type fakeOwnService struct {
+	own.Service
+	rulesets map[api.RepoName]*codeowners.Ruleset
+	calls    int
+}
+
+func (s *fakeOwnService) RulesetForRepo(_ context.Context, repoName api.RepoName, _ api.RepoID, _ api.CommitID) (*codeowners.Ruleset, error) {
+	s.calls++
+	return s.rulesets[repoName], nil
+}
+
+func TestOwnerAggregation(t *testing.T) {
+	ownService := &fakeOwnService{rulesets: map[api.RepoName]*codeowners.Ruleset{
+		"myRepo": codeowners.NewRuleset(codeowners.IngestedRulesetSource{}, &codeownerspb.File{
+			Rule: []*codeownerspb.Rule{
+				{Pattern: "*.go", Owner: []*codeownerspb.Owner{{Handle: "gophers"}, {Email: "alice@example.com"}}},
+				{Pattern: "/docs/", Owner: []*codeownerspb.Owner{{Handle: "writers"}}},
+			},
+		}),
+	}}
+
+	aggregator := testAggregator{results: make(map[string]int)}
+	countFunc, err := GetCountFuncForMode(context.Background(), "", "", types.OWNER_AGGREGATION_MODE, ownService)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, types.OWNER_AGGREGATION_MODE, nil)
+	sra.Send(streaming.SearchEvent{
+		Results: []result.Match{
+			contentMatch("myRepo", "main.go", 1, "a", "b"),
+			pathMatch("myRepo", "docs/index.md", 1),
+			pathMatch("myRepo", "README.md", 1),
+			pathMatch("myRepo2", "main.go", 2),
+			repoMatch("myRepo", 1),
+		},
+	})
+	autogold.Expect(map[string]int{"No owner": 2, "alice@example.com": 2, "gophers": 2, "writers": 1}).Equal(t, aggregator.results)
+
+	// Rulesets are fetched once per repository and commit.
+	if ownService.calls != 2 {
+		t.Errorf("unexpected number of ruleset fetches. want=%d have=%d", 2, ownService.calls)
+	}
+}
+
 func TestAggregationCancelation(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -736,7 +863,7 @@ func TestAggregationCancelation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, err := GetCountFuncForMode(tc.query, "regexp", tc.mode)
+			countFunc, err := GetCountFuncForMode(context.Background(), tc.query, "regexp", tc.mode, nil)
 			if err != nil {
 				t.Errorf("expected test not to error, got %v", err)
 				t.FailNow()
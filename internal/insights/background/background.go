@@ -40,8 +40,8 @@ type RepoStore interface {
 }
 
 // GetBackgroundJobs is the main entrypoint which starts background jobs for code insights. It is
-// called from the worker service.
-func GetBackgroundJobs(ctx context.Context, logger log.Logger, mainAppDB database.DB, insightsDB edb.InsightsDB) []goroutine.BackgroundRoutine {
+// called from the worker service. referenceCounter is used to backfill reference count series.
+func GetBackgroundJobs(ctx context.Context, logger log.Logger, mainAppDB database.DB, insightsDB edb.InsightsDB, referenceCounter queryrunner.ReferenceCounter) []goroutine.BackgroundRoutine {
 	insightPermStore := store.NewInsightPermissionStore(mainAppDB)
 	insightsStore := store.New(insightsDB, insightPermStore)
 
@@ -80,7 +80,7 @@ func GetBackgroundJobs(ctx context.Context, logger log.Logger, mainAppDB databas
 		historicRateLimiter := limiter.HistoricalWorkRate()
 		backfillConfig := pipeline.BackfillerConfig{
 			CompressionPlan:         compression.NewGitserverFilter(logger, gitserverClient.Scoped("compressionfilter")),
-			SearchHandlers:          queryrunner.GetSearchHandlers(),
+			SearchHandlers:          queryrunner.GetSearchHandlers(referenceCounter),
 			InsightStore:            insightsStore,
 			CommitClient:            gitserver.NewGitCommitClient(gitserverClient.Scoped("commitclient")),
 			SearchPlanWorkerLimit:   1,
@@ -118,8 +118,9 @@ func GetBackgroundJobs(ctx context.Context, logger log.Logger, mainAppDB databas
 }
 
 // GetBackgroundQueryRunnerJob is the main entrypoint for starting the background jobs for code
-// insights query runner. It is called from the worker service.
-func GetBackgroundQueryRunnerJob(ctx context.Context, logger log.Logger, mainAppDB database.DB, insightsDB edb.InsightsDB) []goroutine.BackgroundRoutine {
+// insights query runner. It is called from the worker service. referenceCounter is used to record
+// reference count series.
+func GetBackgroundQueryRunnerJob(ctx context.Context, logger log.Logger, mainAppDB database.DB, insightsDB edb.InsightsDB, referenceCounter queryrunner.ReferenceCounter) []goroutine.BackgroundRoutine {
 	insightPermStore := store.NewInsightPermissionStore(mainAppDB)
 	insightsStore := store.New(insightsDB, insightPermStore)
 
@@ -138,7 +139,7 @@ func GetBackgroundQueryRunnerJob(ctx context.Context, logger log.Logger, mainApp
 	return []goroutine.BackgroundRoutine{
 		// Register the query-runner worker and resetter, which executes search queries and records
 		// results to the insights DB.
-		queryrunner.NewWorker(ctx, logger.Scoped("queryrunner.Worker"), workerStore, insightsStore, repoStore, queryRunnerWorkerMetrics, seachQueryLimiter),
+		queryrunner.NewWorker(ctx, logger.Scoped("queryrunner.Worker"), workerStore, insightsStore, repoStore, referenceCounter, queryRunnerWorkerMetrics, seachQueryLimiter),
 		queryrunner.NewResetter(ctx, logger.Scoped("queryrunner.Resetter"), workerStore, queryRunnerResetterMetrics),
 		queryrunner.NewCleaner(ctx, observationCtx, workerBaseStore),
 	}
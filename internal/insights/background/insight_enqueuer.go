@@ -145,6 +145,10 @@ func (ie *InsightEnqueuer) EnqueueSingle(
 	var err error
 
 	basicQuery := querybuilder.BasicQuery(series.Query)
+	if series.GenerationMethod == types.ReferenceCount {
+		// The query of reference count series is a symbol, the job only lists the repositories of the series.
+		basicQuery = querybuilder.RepositoriesQuery
+	}
 	var modifiedQuery querybuilder.BasicQuery
 	var finalQuery string
 
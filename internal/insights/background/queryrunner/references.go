@@ -0,0 +1,90 @@
+package queryrunner
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/insights/query/querybuilder"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	itypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ReferenceCounter counts the references to SCIP symbols in precise code intelligence indexes.
+type ReferenceCounter interface {
+	// CountReferences returns the number of references to the given symbol in the indexes visible
+	// from the given commit. The returned flag is false if no index is visible from the commit.
+	CountReferences(ctx context.Context, repositoryID int, commit, symbolName string) (int, bool, error)
+}
+
+type streamRepoProvider func(context.Context, string) ([]itypes.MinimalRepo, error)
+type revisionResolver func(ctx context.Context, repo api.RepoName, revision string) (api.CommitID, error)
+
+// generateReferenceCountRecordings records the number of references to symbolName in each repository
+// listed by the repository query of the job. Historical jobs target a single revision, all others count
+// the references at the HEAD of each repository.
+func generateReferenceCountRecordings(ctx context.Context, job *SearchJob, symbolName string, recordTime time.Time, repoProvider streamRepoProvider, resolveRevision revisionResolver, counter ReferenceCounter, logger log.Logger) ([]store.RecordSeriesPointArgs, error) {
+	revision, err := querybuilder.QueryRevision(querybuilder.BasicQuery(job.SearchQuery))
+	if err != nil {
+		return nil, errors.Wrap(err, "QueryRevision")
+	}
+	if revision == "" {
+		revision = "HEAD"
+	}
+
+	repos, err := repoProvider(ctx, job.SearchQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := authz.DefaultSubRepoPermsChecker
+	var recordings []store.RecordSeriesPointArgs
+
+	for _, repo := range repos {
+		// sub-repo permissions filtering. If the repo supports it, then it should be excluded from the results
+		subRepoEnabled, subRepoErr := authz.SubRepoEnabledForRepoID(ctx, checker, repo.ID)
+		if subRepoErr != nil {
+			logger.Error("sub-repo permissions check errored", log.String("seriesID", job.SeriesID), log.String("repo", string(repo.Name)), log.Error(subRepoErr))
+			continue
+		}
+		if subRepoEnabled {
+			continue
+		}
+
+		commit, err := resolveRevision(ctx, repo.Name, revision)
+		if err != nil {
+			if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) || gitdomain.IsRepoNotExist(err) {
+				continue // no error - repo may not be cloned yet (or not even pushed to code host yet)
+			}
+			return nil, errors.Wrapf(err, "resolving revision %q of %s", revision, repo.Name)
+		}
+
+		count, found, err := counter.CountReferences(ctx, int(repo.ID), string(commit), symbolName)
+		if err != nil {
+			return nil, errors.Wrap(err, "CountReferences")
+		}
+		if !found {
+			// Without a precise index the number of references is unknown, not zero, so no point is recorded.
+			continue
+		}
+		recordings = append(recordings, toRecording(job, float64(count), recordTime, string(repo.Name), repo.ID, nil)...)
+	}
+
+	return recordings, nil
+}
+
+func makeReferenceCountHandler(repoProvider streamRepoProvider, resolveRevision revisionResolver, counter ReferenceCounter) InsightsHandler {
+	return func(ctx context.Context, job *SearchJob, series *types.InsightSeries, recordTime time.Time) ([]store.RecordSeriesPointArgs, error) {
+		recordings, err := generateReferenceCountRecordings(ctx, job, series.Query, recordTime, repoProvider, resolveRevision, counter, log.Scoped("ReferenceCountRecordingsGenerator"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "referenceCountHandler")
+		}
+		return recordings, nil
+	}
+}
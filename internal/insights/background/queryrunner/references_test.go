@@ -0,0 +1,70 @@
+package queryrunner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	dbtypes "github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type fakeReferenceCounter map[string]int
+
+func (c fakeReferenceCounter) CountReferences(_ context.Context, _ int, commit, _ string) (int, bool, error) {
+	count, ok := c[commit]
+	return count, ok, nil
+}
+
+func TestGenerateReferenceCountRecordings(t *testing.T) {
+	date := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	symbolName := "scip-go gomod github.com/example/pkg v1.0.0 `github.com/example/pkg`/OldFunc()."
+
+	repos := func(context.Context, string) ([]dbtypes.MinimalRepo, error) {
+		return []dbtypes.MinimalRepo{
+			{ID: 1, Name: "github.com/sourcegraph/indexed"},
+			{ID: 2, Name: "github.com/sourcegraph/unindexed"},
+			{ID: 3, Name: "github.com/sourcegraph/notcloned"},
+		}, nil
+	}
+	var resolved []string
+	resolveRevision := func(_ context.Context, repo api.RepoName, revision string) (api.CommitID, error) {
+		resolved = append(resolved, revision)
+		switch repo {
+		case "github.com/sourcegraph/notcloned":
+			return "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: revision}
+		case "github.com/sourcegraph/unindexed":
+			return "unindexed", nil
+		}
+		return api.CommitID("commit-" + revision), nil
+	}
+	counter := fakeReferenceCounter{"commit-HEAD": 7, "commit-abc": 3}
+
+	t.Run("current recording", func(t *testing.T) {
+		resolved = nil
+		job := SearchJob{SeriesID: "testseries1", SearchQuery: "type:repo repo:sourcegraph", RecordTime: &date}
+
+		recordings, err := generateReferenceCountRecordings(context.Background(), &job, symbolName, date, repos, resolveRevision, counter, logtest.Scoped(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		autogold.Expect([]string{"github.com/sourcegraph/indexed 1 2021-12-01 00:00:00 +0000 UTC  7.000000"}).Equal(t, stringify(recordings))
+		autogold.Expect([]string{"HEAD", "HEAD", "HEAD"}).Equal(t, resolved)
+	})
+
+	t.Run("historical recording", func(t *testing.T) {
+		resolved = nil
+		job := SearchJob{SeriesID: "testseries1", SearchQuery: "type:repo count:all repo:^github\\.com/sourcegraph/indexed$@abc", RecordTime: &date}
+
+		recordings, err := generateReferenceCountRecordings(context.Background(), &job, symbolName, date, repos, resolveRevision, counter, logtest.Scoped(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		autogold.Expect([]string{"github.com/sourcegraph/indexed 1 2021-12-01 00:00:00 +0000 UTC  3.000000"}).Equal(t, stringify(recordings))
+		autogold.Expect([]string{"abc", "abc", "abc"}).Equal(t, resolved)
+	})
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/insights/discovery"
+	"github.com/sourcegraph/sourcegraph/internal/insights/query"
 	"github.com/sourcegraph/sourcegraph/internal/insights/query/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/insights/store"
 	"github.com/sourcegraph/sourcegraph/internal/insights/types"
@@ -20,7 +22,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 )
 
-func GetSearchHandlers() map[types.GenerationMethod]InsightsHandler {
+// GetSearchHandlers returns the handlers of the series generation methods. Reference count series are only
+// handled if referenceCounter is not nil.
+func GetSearchHandlers(referenceCounter ReferenceCounter) map[types.GenerationMethod]InsightsHandler {
 	searchStream := func(ctx context.Context, query string) (*streaming.TabulationResult, error) {
 		tr, ctx := trace.New(ctx, "CodeInsightsSearch.searchStream")
 		defer tr.End()
@@ -56,12 +60,22 @@ func GetSearchHandlers() map[types.GenerationMethod]InsightsHandler {
 		return streamResults, nil
 	}
 
-	return map[types.GenerationMethod]InsightsHandler{
+	handlers := map[types.GenerationMethod]InsightsHandler{
 		types.MappingCompute: makeMappingComputeHandler(computeTextExtraSearch),
 		types.SearchCompute:  makeComputeHandler(computeSearchStream),
 		types.Search:         makeSearchHandler(searchStream),
 	}
 
+	if referenceCounter != nil {
+		repoSearch := query.NewStreamingRepoQueryExecutor(log.Scoped("ReferenceCountRepoSearch")).ExecuteRepoList
+		gitserverClient := gitserver.NewClient("insights.referencecount")
+		resolveRevision := func(ctx context.Context, repo api.RepoName, revision string) (api.CommitID, error) {
+			return gitserverClient.ResolveRevision(ctx, repo, revision, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+		}
+		handlers[types.ReferenceCount] = makeReferenceCountHandler(repoSearch, resolveRevision, referenceCounter)
+	}
+
+	return handlers
 }
 
 func toRecording(record *SearchJob, value float64, recordTime time.Time, repoName string, repoID api.RepoID, capture *string) []store.RecordSeriesPointArgs {
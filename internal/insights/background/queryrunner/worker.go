@@ -38,8 +38,9 @@ import (
 //
 
 // NewWorker returns a worker that will execute search queries and insert information about the
-// results into the code insights database.
-func NewWorker(ctx context.Context, logger log.Logger, workerStore *workerStoreExtra, insightsStore *store.Store, repoStore discovery.RepoStore, metrics workerutil.WorkerObservability, limiter *ratelimit.InstrumentedLimiter) *workerutil.Worker[*Job] {
+// results into the code insights database. Jobs of reference count series are only executed if
+// referenceCounter is not nil.
+func NewWorker(ctx context.Context, logger log.Logger, workerStore *workerStoreExtra, insightsStore *store.Store, repoStore discovery.RepoStore, referenceCounter ReferenceCounter, metrics workerutil.WorkerObservability, limiter *ratelimit.InstrumentedLimiter) *workerutil.Worker[*Job] {
 	numHandlers := conf.Get().InsightsQueryWorkerConcurrency
 	if numHandlers <= 0 {
 		// Default concurrency is set to 5.
@@ -76,7 +77,7 @@ func NewWorker(ctx context.Context, logger log.Logger, workerStore *workerStoreE
 		limiter:         limiter,
 		metadadataStore: store.NewInsightStoreWith(insightsStore),
 		seriesCache:     sharedCache,
-		searchHandlers:  GetSearchHandlers(),
+		searchHandlers:  GetSearchHandlers(referenceCounter),
 		logger:          log.Scoped("insights.queryRunner.Handler"),
 	}, options)
 }
@@ -220,13 +220,16 @@ func makeHistoricalSearchJobFunc(logger log.Logger, commitClient GitCommitClient
 	return func(ctx context.Context, bctx *buildSeriesContext) (err error, job *queryrunner.SearchJob, preempted []store.RecordSeriesPointArgs) {
 		logger.Debug("making search job")
 		rawQuery := bctx.series.Query
-		containsRepo, err := querybuilder.ContainsField(rawQuery, query.FieldRepo)
-		if err != nil {
-			return err, nil, nil
-		}
-		if containsRepo {
-			// This maintains existing behavior that searches with a repo filter are ignored
-			return nil, nil, nil
+		referenceCount := bctx.series.GenerationMethod == types.ReferenceCount
+		if !referenceCount {
+			containsRepo, err := querybuilder.ContainsField(rawQuery, query.FieldRepo)
+			if err != nil {
+				return err, nil, nil
+			}
+			if containsRepo {
+				// This maintains existing behavior that searches with a repo filter are ignored
+				return nil, nil, nil
+			}
 		}
 
 		// Optimization: If the timeframe we're building data for starts (or ends) before the first commit in the
@@ -264,6 +267,17 @@ func makeHistoricalSearchJobFunc(logger log.Logger, commitClient GitCommitClient
 
 		// Construct the search query that will generate data for this repository and time (revision) tuple.
 		var newQueryStr string
+		if referenceCount {
+			// The query of reference count series is a symbol, the job only lists the repository at the revision.
+			job = &queryrunner.SearchJob{
+				SeriesID:        bctx.seriesID,
+				SearchQuery:     querybuilder.SingleRepoRevisionQuery(repoName, revision).String(),
+				RecordTime:      &bctx.execution.RecordingTime,
+				PersistMode:     string(store.RecordMode),
+				DependentFrames: bctx.execution.SharedRecordings,
+			}
+			return err, job, preempted
+		}
 		modifiedQuery, err := querybuilder.SingleRepoQuery(querybuilder.BasicQuery(rawQuery), repoName, revision, querybuilder.CodeInsightsQueryDefaults(len(bctx.series.Repositories) == 0))
 		if err != nil {
 			err = errors.Append(err, errors.Wrap(err, "SingleRepoQuery"))
@@ -262,6 +262,33 @@ func MultiRepoQuery(query BasicQuery, repos []string, defaultParams searchquery.
 	return modified, nil
 }
 
+// RepositoriesQuery is the base query of series that are not generated from search results, such as reference
+// count series. Combined with the same repository filters as search queries, it lists the repositories such a series
+// operates over.
+const RepositoriesQuery BasicQuery = "type:repo"
+
+// SingleRepoRevisionQuery generates a query that lists the given repo at the given revision.
+func SingleRepoRevisionQuery(repo, revision string) BasicQuery {
+	return forRepoRevision(withCountAll(RepositoriesQuery), repo, revision)
+}
+
+// QueryRevision returns the revision targeted by the repo filter of a query, such as one generated by
+// SingleRepoRevisionQuery. It returns an empty string if the query does not target a single revision.
+func QueryRevision(query BasicQuery) (string, error) {
+	plan, err := searchquery.Pipeline(searchquery.Init(string(query), searchquery.SearchTypeLiteral))
+	if err != nil {
+		return "", err
+	}
+
+	for _, basic := range plan {
+		repoFilters, _ := basic.Repositories()
+		if len(repoFilters) == 1 && len(repoFilters[0].Revs) == 1 {
+			return repoFilters[0].Revs[0].RevSpec, nil
+		}
+	}
+	return "", nil
+}
+
 type MapType string
 
 const (
@@ -428,6 +455,64 @@ func AddRepoMetadataFilter(query BasicQuery, repoMeta string) (BasicQuery, error
 	return BasicQuery(searchquery.StringHuman(mutatedQuery.ToQ())), nil
 }
 
+// AddLanguageFilter restricts a query to files of the given language, as detected by go-enry.
+func AddLanguageFilter(query BasicQuery, language string) (BasicQuery, error) {
+	// Languages are matched by alias, which is the lowercase name with spaces replaced by underscores.
+	return addParameters(query, searchquery.Parameter{
+		Field: searchquery.FieldLang,
+		Value: strings.ReplaceAll(strings.ToLower(language), " ", "_"),
+	})
+}
+
+func AddFileExtensionFilter(query BasicQuery, extension string) (BasicQuery, error) {
+	if extension == types.NO_FILE_EXTENSION_TEXT {
+		return query, errors.New("Can't search for files without extension")
+	}
+	return addParameters(query, searchquery.Parameter{
+		Field: searchquery.FieldFile,
+		Value: regexp.QuoteMeta(extension) + "$",
+	})
+}
+
+// AddCommitDateFilter restricts a commit or diff query to the month given in the
+// types.COMMIT_DATE_AGGREGATION_LAYOUT layout.
+func AddCommitDateFilter(query BasicQuery, month string) (BasicQuery, error) {
+	start, err := time.Parse(types.COMMIT_DATE_AGGREGATION_LAYOUT, month)
+	if err != nil {
+		return query, errors.Wrap(err, "parsing month")
+	}
+	return addParameters(query,
+		searchquery.Parameter{Field: searchquery.FieldAfter, Value: start.Format(time.DateOnly)},
+		searchquery.Parameter{Field: searchquery.FieldBefore, Value: start.AddDate(0, 1, 0).Format(time.DateOnly)},
+	)
+}
+
+func AddOwnerFilter(query BasicQuery, owner string) (BasicQuery, error) {
+	if owner == types.NO_OWNER_TEXT {
+		return query, errors.New("Can't search for no owner")
+	}
+	return addParameters(query, searchquery.Parameter{
+		Field: searchquery.FieldFile,
+		Value: fmt.Sprint("has.owner(", owner, ")"),
+	})
+}
+
+// addParameters appends the given parameters to every step of the query as is.
+func addParameters(query BasicQuery, parameters ...searchquery.Parameter) (BasicQuery, error) {
+	plan, err := searchquery.Pipeline(searchquery.Init(string(query), searchquery.SearchTypeLiteral))
+	if err != nil {
+		return "", err
+	}
+
+	mutatedQuery := searchquery.MapPlan(plan, func(basic searchquery.Basic) searchquery.Basic {
+		modified := make([]searchquery.Parameter, 0, len(basic.Parameters)+len(parameters))
+		modified = append(modified, basic.Parameters...)
+		modified = append(modified, parameters...)
+		return basic.MapParameters(modified)
+	})
+	return BasicQuery(searchquery.StringHuman(mutatedQuery.ToQ())), nil
+}
+
 func buildFilterText(raw string) string {
 	quoted := regexp.QuoteMeta(raw)
 	if strings.Contains(raw, " ") {
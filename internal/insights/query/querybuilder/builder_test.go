@@ -8,6 +8,7 @@ import (
 	"github.com/hexops/autogold/v2"
 
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -394,6 +395,41 @@ func TestIsSingleRepoQuery(t *testing.T) {
 	}
 }
 
+func TestQueryRevision(t *testing.T) {
+	tests := []struct {
+		name       string
+		inputQuery BasicQuery
+		want       string
+	}{
+		{
+			name:       "single repo revision query",
+			inputQuery: SingleRepoRevisionQuery("github.com/sourcegraph/sourcegraph", "abc123"),
+			want:       "abc123",
+		},
+		{
+			name:       "single repo without revision",
+			inputQuery: `type:repo repo:^github\.com/sourcegraph/sourcegraph$`,
+			want:       "",
+		},
+		{
+			name:       "query without repo filter",
+			inputQuery: RepositoriesQuery,
+			want:       "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := QueryRevision(test.inputQuery)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("%s failed (want/got): %s", test.name, diff)
+			}
+		})
+	}
+}
+
 func TestIsSingleRepoQueryMultipleSteps(t *testing.T) {
 
 	tests := []struct {
@@ -658,6 +694,83 @@ func Test_addRepoMetadataFilter(t *testing.T) {
 	}
 }
 
+func TestAddAggregationFilters(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		filter func(BasicQuery, string) (BasicQuery, error)
+		value  string
+		want   autogold.Value
+	}{
+		{
+			name:   "language",
+			input:  "myquery repo:supergreat",
+			filter: AddLanguageFilter,
+			value:  "Go",
+			want:   autogold.Expect(BasicQuery("repo:supergreat lang:go myquery")),
+		},
+		{
+			name:   "language with spaces",
+			input:  "myquery",
+			filter: AddLanguageFilter,
+			value:  "Protocol Buffer",
+			want:   autogold.Expect(BasicQuery("lang:protocol_buffer myquery")),
+		},
+		{
+			name:   "file extension",
+			input:  "(myquery repo:supergreat) or (big repo:asdf)",
+			filter: AddFileExtensionFilter,
+			value:  ".go",
+			want:   autogold.Expect(BasicQuery("(repo:supergreat file:\\.go$ myquery OR repo:asdf file:\\.go$ big)")),
+		},
+		{
+			name:   "no file extension",
+			input:  "myquery",
+			filter: AddFileExtensionFilter,
+			value:  types.NO_FILE_EXTENSION_TEXT,
+			want:   autogold.Expect("Can't search for files without extension"),
+		},
+		{
+			name:   "commit date",
+			input:  "type:commit fix",
+			filter: AddCommitDateFilter,
+			value:  "2023-12",
+			want:   autogold.Expect(BasicQuery("type:commit after:2023-12-01 before:2024-01-01 fix")),
+		},
+		{
+			name:   "invalid commit date",
+			input:  "type:commit fix",
+			filter: AddCommitDateFilter,
+			value:  "December",
+			want:   autogold.Expect(`parsing month: parsing time "December" as "2006-01": cannot parse "December" as "2006"`),
+		},
+		{
+			name:   "owner",
+			input:  "myquery",
+			filter: AddOwnerFilter,
+			value:  "sourcegraph/search",
+			want:   autogold.Expect(BasicQuery("file:has.owner(sourcegraph/search) myquery")),
+		},
+		{
+			name:   "no owner",
+			input:  "myquery",
+			filter: AddOwnerFilter,
+			value:  types.NO_OWNER_TEXT,
+			want:   autogold.Expect("Can't search for no owner"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.filter(BasicQuery(test.input), test.value)
+			if err != nil {
+				test.want.Equal(t, err.Error())
+			} else {
+				test.want.Equal(t, got)
+			}
+		})
+	}
+}
+
 func TestRepositoryScopeQuery(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -174,6 +174,16 @@ func (h *newBackfillHandler) Handle(ctx context.Context, logger log.Logger, job
 }
 
 func parseQuery(series types.InsightSeries) (query.Plan, error) {
+	if series.GenerationMethod == types.ReferenceCount {
+		// The query of reference count series is a symbol. Its references are read from precise
+		// indexes, which costs about as much as listing the repositories of the series.
+		plan, err := querybuilder.ParseQuery(querybuilder.RepositoriesQuery.String(), "literal")
+		if err != nil {
+			return nil, errors.Wrap(err, "ParseQuery")
+		}
+		return plan, nil
+	}
+
 	if series.GeneratedFromCaptureGroups {
 		seriesQuery, err := compute.Parse(series.Query)
 		if err != nil {
@@ -131,6 +131,9 @@ const (
 	SearchCompute  GenerationMethod = "search-compute"
 	LanguageStats  GenerationMethod = "language-stats"
 	MappingCompute GenerationMethod = "mapping-compute"
+	// ReferenceCount series count the references to the SCIP symbol in their query,
+	// read from precise code intelligence indexes instead of search results.
+	ReferenceCount GenerationMethod = "reference-count"
 )
 
 type Dashboard struct {
@@ -209,14 +212,18 @@ type RecordingTime struct {
 type SearchAggregationMode string
 
 const (
-	REPO_AGGREGATION_MODE          SearchAggregationMode = "REPO"
-	PATH_AGGREGATION_MODE          SearchAggregationMode = "PATH"
-	AUTHOR_AGGREGATION_MODE        SearchAggregationMode = "AUTHOR"
-	CAPTURE_GROUP_AGGREGATION_MODE SearchAggregationMode = "CAPTURE_GROUP"
-	REPO_METADATA_AGGREGATION_MODE SearchAggregationMode = "REPO_METADATA"
+	REPO_AGGREGATION_MODE           SearchAggregationMode = "REPO"
+	PATH_AGGREGATION_MODE           SearchAggregationMode = "PATH"
+	AUTHOR_AGGREGATION_MODE         SearchAggregationMode = "AUTHOR"
+	CAPTURE_GROUP_AGGREGATION_MODE  SearchAggregationMode = "CAPTURE_GROUP"
+	REPO_METADATA_AGGREGATION_MODE  SearchAggregationMode = "REPO_METADATA"
+	LANGUAGE_AGGREGATION_MODE       SearchAggregationMode = "LANGUAGE"
+	FILE_EXTENSION_AGGREGATION_MODE SearchAggregationMode = "FILE_EXTENSION"
+	COMMIT_DATE_AGGREGATION_MODE    SearchAggregationMode = "COMMIT_DATE"
+	OWNER_AGGREGATION_MODE          SearchAggregationMode = "OWNER"
 )
 
-var SearchAggregationModes = []SearchAggregationMode{REPO_AGGREGATION_MODE, PATH_AGGREGATION_MODE, AUTHOR_AGGREGATION_MODE, CAPTURE_GROUP_AGGREGATION_MODE, REPO_METADATA_AGGREGATION_MODE}
+var SearchAggregationModes = []SearchAggregationMode{REPO_AGGREGATION_MODE, PATH_AGGREGATION_MODE, AUTHOR_AGGREGATION_MODE, CAPTURE_GROUP_AGGREGATION_MODE, REPO_METADATA_AGGREGATION_MODE, LANGUAGE_AGGREGATION_MODE, FILE_EXTENSION_AGGREGATION_MODE, COMMIT_DATE_AGGREGATION_MODE, OWNER_AGGREGATION_MODE}
 
 type AggregationNotAvailableReasonType string
 
@@ -229,5 +236,11 @@ const (
 )
 
 const (
-	NO_REPO_METADATA_TEXT = "No metadata"
+	NO_REPO_METADATA_TEXT  = "No metadata"
+	NO_FILE_EXTENSION_TEXT = "No extension"
+	NO_OWNER_TEXT          = "No owner"
 )
+
+// COMMIT_DATE_AGGREGATION_LAYOUT is the layout of the monthly buckets commits are grouped by in
+// COMMIT_DATE_AGGREGATION_MODE.
+const COMMIT_DATE_AGGREGATION_LAYOUT = "2006-01"
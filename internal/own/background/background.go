@@ -194,6 +194,8 @@ func (h *handler) Handle(ctx context.Context, lgr log.Logger, record *Job) error
 		delegate = handleRecentContributors
 	case types.Analytics:
 		delegate = handleAnalytics
+	case types.Coverage:
+		delegate = handleCoverage
 	default:
 		return errcode.MakeNonRetryable(errors.New("unsupported own index job type"))
 	}
@@ -0,0 +1,195 @@
+package background
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
+	"github.com/sourcegraph/sourcegraph/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// maxUnownedDirectories limits the number of unowned directories stored
+	// in a coverage report.
+	maxUnownedDirectories = 100
+	// maxRulesPerUnresolvedOwner limits the number of rules stored for every
+	// unresolved owner of a coverage report.
+	maxRulesPerUnresolvedOwner = 10
+)
+
+func handleCoverage(ctx context.Context, lgr log.Logger, repoId api.RepoID, db database.DB, subRepoPermsCache *rcache.Cache) error {
+	// 🚨 SECURITY: we use the internal actor because the background indexer is not associated with any user,
+	// and needs to see all repos and files.
+	internalCtx := actor.WithInternalActor(ctx)
+	indexer := newCoverageIndexer(gitserver.NewClient("own.coverageindexer"), db, subRepoPermsCache, lgr)
+	err := indexer.indexRepo(internalCtx, repoId, authz.DefaultSubRepoPermsChecker)
+	if err != nil {
+		lgr.Error("own coverage indexing failure", log.String("msg", err.Error()))
+	}
+	return err
+}
+
+type coverageIndexer struct {
+	client            gitserver.Client
+	db                database.DB
+	logger            log.Logger
+	subRepoPermsCache rcache.Cache
+}
+
+func newCoverageIndexer(client gitserver.Client, db database.DB, subRepoPermsCache *rcache.Cache, lgr log.Logger) *coverageIndexer {
+	return &coverageIndexer{client: client, db: db, subRepoPermsCache: *subRepoPermsCache, logger: lgr}
+}
+
+var ownCoverageReportsCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "src",
+	Name:      "own_coverage_reports_computed_total",
+})
+
+func (r *coverageIndexer) indexRepo(ctx context.Context, repoId api.RepoID, checker authz.SubRepoPermissionChecker) error {
+	// Coverage reports list paths of the repository, so skip repos with sub-repo perms.
+	isSubRepoPermsRepo, err := isSubRepoPermsRepo(ctx, repoId, r.subRepoPermsCache, checker)
+	if err != nil {
+		return errcode.MakeNonRetryable(err)
+	} else if isSubRepoPermsRepo {
+		r.logger.Debug("skipping own coverage report due to the repo having subrepo perms enabled", log.Int32("repoID", int32(repoId)))
+		return nil
+	}
+
+	repo, err := r.db.Repos().Get(ctx, repoId)
+	if err != nil {
+		return errors.Wrap(err, "repoStore.Get")
+	}
+	commitID, err := r.client.ResolveRevision(ctx, repo.Name, "HEAD", gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+	if err != nil {
+		return errcode.MakeNonRetryable(errors.Wrapf(err, "cannot resolve HEAD"))
+	}
+	files, err := r.client.LsFiles(ctx, repo.Name, commitID)
+	if err != nil {
+		return errors.Wrap(err, "ls-files")
+	}
+	ruleset, err := own.NewService(r.client, r.db).RulesetForRepo(ctx, repo.Name, repo.ID, commitID)
+	if err != nil {
+		return errors.Wrap(err, "RulesetForRepo")
+	}
+
+	report, owners := computeCoverageReport(files, ruleset)
+	report.RepoID = repo.ID
+	report.CommitID = commitID
+
+	// Resolve all the owners named by the rules at once, and report the ones
+	// that match no user or team.
+	repoContext := &own.RepoContext{Name: repo.Name, CodeHostKind: repo.ExternalRepo.ServiceType}
+	bag := own.EmptyBag()
+	for _, o := range owners {
+		bag.Add(own.Reference{RepoContext: repoContext, Handle: o.Handle, Email: o.Email})
+	}
+	bag.Resolve(ctx, r.db)
+	for _, o := range owners {
+		if _, ok := bag.FindResolved(own.Reference{RepoContext: repoContext, Handle: o.Handle, Email: o.Email}); !ok {
+			report.UnresolvedOwners = append(report.UnresolvedOwners, o)
+		}
+	}
+
+	if err := r.db.OwnCoverageReports().Upsert(ctx, report); err != nil {
+		return errors.Wrap(err, "OwnCoverageReports.Upsert")
+	}
+	ownCoverageReportsCounter.Inc()
+	return nil
+}
+
+// computeCoverageReport computes the coverage of the given files by the given
+// ruleset, which may be nil, and lints its rules. It also returns all the
+// owners named by the rules, along with the rules naming them, for the caller
+// to report the ones that cannot be resolved.
+func computeCoverageReport(files []string, ruleset *codeowners.Ruleset) (*types.OwnCoverageReport, []types.OwnCoverageUnresolvedOwner) {
+	report := &types.OwnCoverageReport{TotalFileCount: len(files)}
+
+	// hasOwnedFile tells for every directory whether any file in its tree is owned.
+	hasOwnedFile := map[string]bool{}
+	for _, file := range files {
+		owned := ruleset != nil && len(ruleset.Match(file).GetOwner()) > 0
+		if owned {
+			report.OwnedFileCount++
+		}
+		for dir := parentDir(file); ; dir = parentDir(dir) {
+			hasOwnedFile[dir] = hasOwnedFile[dir] || owned
+			if dir == "" {
+				break
+			}
+		}
+	}
+	for dir, owned := range hasOwnedFile {
+		// Only report the topmost unowned directories.
+		if !owned && (dir == "" || hasOwnedFile[parentDir(dir)]) {
+			report.UnownedDirectories = append(report.UnownedDirectories, dir)
+		}
+	}
+	sort.Strings(report.UnownedDirectories)
+	if len(report.UnownedDirectories) > maxUnownedDirectories {
+		report.UnownedDirectories = report.UnownedDirectories[:maxUnownedDirectories]
+	}
+
+	if ruleset == nil {
+		return report, nil
+	}
+
+	for _, rule := range ruleset.UnmatchedRules(files) {
+		report.UnmatchedRules = append(report.UnmatchedRules, coverageRule(ruleset, rule))
+	}
+
+	var owners []types.OwnCoverageUnresolvedOwner
+	type ownerKey struct{ handle, email string }
+	ownerIndexes := map[ownerKey]int{}
+	for _, rule := range ruleset.GetFile().GetRule() {
+		for _, o := range rule.GetOwner() {
+			key := ownerKey{handle: o.GetHandle(), email: o.GetEmail()}
+			i, ok := ownerIndexes[key]
+			if !ok {
+				i = len(owners)
+				ownerIndexes[key] = i
+				owners = append(owners, types.OwnCoverageUnresolvedOwner{Handle: key.handle, Email: key.email})
+			}
+			if len(owners[i].Rules) < maxRulesPerUnresolvedOwner {
+				owners[i].Rules = append(owners[i].Rules, coverageRule(ruleset, rule))
+			}
+		}
+	}
+	return report, owners
+}
+
+func coverageRule(ruleset *codeowners.Ruleset, rule *codeownerspb.Rule) types.OwnCoverageRule {
+	r := types.OwnCoverageRule{
+		Pattern:    rule.GetPattern(),
+		LineNumber: rule.GetLineNumber(),
+	}
+	if source, ok := ruleset.GetRuleSource(rule).(codeowners.GitRulesetSource); ok {
+		r.FilePath = source.Path
+	}
+	return r
+}
+
+// parentDir returns the parent directory of the given repository-relative
+// path, or "" for the repository root.
+func parentDir(p string) string {
+	dir := path.Dir(strings.TrimPrefix(p, "/"))
+	if dir == "." {
+		return ""
+	}
+	return dir
+}
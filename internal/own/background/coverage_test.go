@@ -0,0 +1,59 @@
+package background
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
+	"github.com/sourcegraph/sourcegraph/internal/own/types"
+)
+
+func TestComputeCoverageReport(t *testing.T) {
+	files := []string{
+		"README.md",
+		"cmd/server/main.go",
+		"cmd/server/README.md",
+		"docs/index.md",
+		"docs/api/reference.md",
+		"internal/db/store.go",
+		"internal/db/testdata/dump.sql",
+		"tools/lint.sh",
+	}
+	source := codeowners.GitRulesetSource{Path: "CODEOWNERS"}
+	ruleset := codeowners.NewRuleset(source, &codeownerspb.File{
+		Rule: []*codeownerspb.Rule{
+			{Pattern: "*.go", Owner: []*codeownerspb.Owner{{Handle: "go-team"}}, LineNumber: 1},
+			{Pattern: "/internal/db/", Owner: []*codeownerspb.Owner{{Handle: "db-team"}, {Email: "dba@example.com"}}, LineNumber: 2},
+			{Pattern: "/legacy/", Owner: []*codeownerspb.Owner{{Handle: "go-team"}}, LineNumber: 3},
+			// Matching rules without owners reset ownership.
+			{Pattern: "/cmd/", LineNumber: 4},
+		},
+	})
+
+	report, owners := computeCoverageReport(files, ruleset)
+	assert.Equal(t, &types.OwnCoverageReport{
+		TotalFileCount:     8,
+		OwnedFileCount:     2,
+		UnownedDirectories: []string{"cmd", "docs", "tools"},
+		UnmatchedRules:     []types.OwnCoverageRule{{Pattern: "/legacy/", FilePath: "CODEOWNERS", LineNumber: 3}},
+	}, report)
+	assert.Equal(t, []types.OwnCoverageUnresolvedOwner{
+		{Handle: "go-team", Rules: []types.OwnCoverageRule{
+			{Pattern: "*.go", FilePath: "CODEOWNERS", LineNumber: 1},
+			{Pattern: "/legacy/", FilePath: "CODEOWNERS", LineNumber: 3},
+		}},
+		{Handle: "db-team", Rules: []types.OwnCoverageRule{{Pattern: "/internal/db/", FilePath: "CODEOWNERS", LineNumber: 2}}},
+		{Email: "dba@example.com", Rules: []types.OwnCoverageRule{{Pattern: "/internal/db/", FilePath: "CODEOWNERS", LineNumber: 2}}},
+	}, owners)
+}
+
+func TestComputeCoverageReportWithoutRuleset(t *testing.T) {
+	report, owners := computeCoverageReport([]string{"main.go", "pkg/lib.go"}, nil)
+	assert.Equal(t, &types.OwnCoverageReport{
+		TotalFileCount:     2,
+		UnownedDirectories: []string{""},
+	}, report)
+	assert.Empty(t, owners)
+}
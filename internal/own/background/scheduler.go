@@ -37,6 +37,10 @@ var QueuePerRepoIndexJobs = []IndexJobType{
 		Name:            types.Analytics,
 		IndexInterval:   time.Hour * 24,
 		RefreshInterval: time.Hour * 24,
+	}, {
+		Name:            types.Coverage,
+		IndexInterval:   time.Hour * 24,
+		RefreshInterval: time.Hour * 24,
 	},
 }
 
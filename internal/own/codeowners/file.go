@@ -36,6 +36,9 @@ type Ruleset struct {
 	rules        []*CompiledRule
 	source       RulesetSource
 	codeHostType string
+	// ruleSources holds the sources of rules that were not taken from the
+	// source of the ruleset, like rules merged from OWNERS files.
+	ruleSources map[*codeownerspb.Rule]RulesetSource
 }
 
 func NewRuleset(source RulesetSource, proto *codeownerspb.File) *Ruleset {
@@ -57,6 +60,15 @@ func (r *Ruleset) GetSource() RulesetSource {
 	return r.source
 }
 
+// GetRuleSource returns the source of the given rule of this ruleset. This is
+// the source of the ruleset, unless the ruleset was merged from multiple files.
+func (r *Ruleset) GetRuleSource(rule *codeownerspb.Rule) RulesetSource {
+	if source, ok := r.ruleSources[rule]; ok {
+		return source
+	}
+	return r.source
+}
+
 func (r *Ruleset) GetCodeHostType() string {
 	return r.codeHostType
 }
@@ -83,6 +95,34 @@ func (x *Ruleset) Match(path string) *codeownerspb.Rule {
 	return nil
 }
 
+// UnmatchedRules returns the rules of this ruleset which pattern matches none
+// of the given paths, in the order of the ruleset.
+func (x *Ruleset) UnmatchedRules(paths []string) []*codeownerspb.Rule {
+	unmatched := make([]*CompiledRule, len(x.rules))
+	copy(unmatched, x.rules)
+	for _, path := range paths {
+		if len(unmatched) == 0 {
+			break
+		}
+		if path == "" || path[0] != '/' {
+			path = "/" + path
+		}
+		remaining := unmatched[:0]
+		for _, rule := range unmatched {
+			if !rule.match(path) {
+				remaining = append(remaining, rule)
+			}
+		}
+		unmatched = remaining
+	}
+
+	rules := make([]*codeownerspb.Rule, 0, len(unmatched))
+	for _, rule := range unmatched {
+		rules = append(rules, rule.proto)
+	}
+	return rules
+}
+
 type CompiledRule struct {
 	proto       *codeownerspb.Rule
 	glob        *paths.GlobPattern
@@ -255,6 +255,23 @@ func TestFileOwnersOrder(t *testing.T) {
 	assert.Equal(t, wantOwner, got.GetOwner())
 }
 
+func TestFileOwnersUnmatchedRules(t *testing.T) {
+	rules := []*codeownerspb.Rule{
+		{Pattern: "*.go", Owner: []*codeownerspb.Owner{{Handle: "go-owner"}}},
+		{Pattern: "/docs/", Owner: []*codeownerspb.Owner{{Handle: "docs-owner"}}},
+		{Pattern: "/src/legacy/**", Owner: []*codeownerspb.Owner{{Handle: "legacy-owner"}}},
+		{Pattern: "README.md", Owner: []*codeownerspb.Owner{{Handle: "readme-owner"}}},
+	}
+	rs := codeowners.NewRuleset(codeowners.IngestedRulesetSource{}, &codeownerspb.File{Rule: rules})
+
+	// Paths may or may not have a leading slash.
+	got := rs.UnmatchedRules([]string{"src/main.go", "/docs/index.md"})
+	assert.Equal(t, []*codeownerspb.Rule{rules[2], rules[3]}, got)
+
+	assert.Equal(t, rules, rs.UnmatchedRules(nil))
+	assert.Empty(t, rs.UnmatchedRules([]string{"src/legacy/main.go", "docs/README.md"}))
+}
+
 func BenchmarkOwnersMatchLiteral(b *testing.B) {
 	pattern := "/main/src/foo/bar/README.md"
 	paths := []string{
@@ -0,0 +1,390 @@
+package codeowners
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
+)
+
+// OwnersFileName is the name of the per-directory ownership files used by
+// Chromium and Gerrit.
+const OwnersFileName = "OWNERS"
+
+// NewOwnersFilesRuleset merges the Chromium/Gerrit-style OWNERS files at the
+// given repository-relative paths into a single ruleset. readFile returns the
+// contents of a file in the repository, and is also used to resolve includes.
+//
+// An OWNERS file lists the owners of the directory it is in, one per line,
+// and supports the following directives:
+//
+//   - `set noparent` stops the inheritance of owners from parent directories.
+//   - `per-file <glob>[,<glob>...]=<owner>[,<owner>...]` adds owners for the
+//     files of the directory matching the globs. The owners can also be
+//     `set noparent` or an include.
+//   - `file://<path>` (relative to the repository root), `file:<path>` and
+//     `include <path>` (relative to the directory, unless starting with `/`)
+//     add the owners listed in another file. `set noparent` and `per-file`
+//     directives of the included file are ignored.
+//
+// The wildcard owner `*` (anyone can approve) does not name an owner, and is
+// dropped. Owners of a directory also own all of its subdirectories, unless
+// they are reset by `set noparent`. Since only one rule matches a path, every
+// rule of the merged ruleset contains the inherited owners as well.
+//
+// Lines that cannot be parsed are logged and skipped, so that a single invalid
+// line does not hide the ownership defined by all other OWNERS files.
+func NewOwnersFilesRuleset(logger log.Logger, repo api.RepoID, commit api.CommitID, paths []string, readFile func(path string) ([]byte, error)) (*Ruleset, error) {
+	m := &ownersFilesMerger{logger: logger, readFile: readFile}
+	dirs := make([]string, 0, len(paths))
+	files := make(map[string]*ownersFile, len(paths))
+	for _, p := range paths {
+		p = strings.TrimPrefix(p, "/")
+		content, err := readFile(p)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parseOwnersFile(logger, p, content)
+		if err != nil {
+			return nil, err
+		}
+		dir := path.Dir(p)
+		files[dir] = f
+		dirs = append(dirs, dir)
+	}
+
+	// Rules of parent directories need to come first, as the rule furthest
+	// down the ruleset wins.
+	sort.Slice(dirs, func(i, j int) bool {
+		if di, dj := dirDepth(dirs[i]), dirDepth(dirs[j]); di != dj {
+			return di < dj
+		}
+		return dirs[i] < dirs[j]
+	})
+
+	var (
+		rules       []*codeownerspb.Rule
+		ruleSources = map[*codeownerspb.Rule]RulesetSource{}
+		effective   = map[string][]string{}
+	)
+	addRule := func(f *ownersFile, pattern string, owners []string, lineNumber int32) {
+		r := &codeownerspb.Rule{
+			Pattern:    pattern,
+			LineNumber: lineNumber,
+		}
+		for _, ownerText := range owners {
+			r.Owner = append(r.Owner, ParseOwner(ownerText))
+		}
+		rules = append(rules, r)
+		ruleSources[r] = GitRulesetSource{Repo: repo, Commit: commit, Path: f.path}
+	}
+
+	for _, dir := range dirs {
+		f := files[dir]
+		own, err := m.resolve(f.path, f.owners)
+		if err != nil {
+			return nil, err
+		}
+
+		var inherited []string
+		if !f.noParent {
+			inherited = effective[nearestOwnedAncestor(dir, files)]
+		}
+		owners := dedupeOwners(own, inherited)
+		effective[dir] = owners
+
+		// Without owners of its own, the rule of a directory would be the same
+		// as the rule of its parent.
+		if len(own) > 0 || f.noParent {
+			addRule(f, dirPattern(dir), owners, f.firstLine)
+		}
+
+		for _, pf := range f.perFile {
+			perFileOwners, err := m.resolve(f.path, pf.owners)
+			if err != nil {
+				return nil, err
+			}
+			if !pf.noParent {
+				perFileOwners = dedupeOwners(perFileOwners, owners)
+			}
+			addRule(f, filePattern(dir, pf.glob), perFileOwners, pf.lineNumber)
+		}
+	}
+
+	var source RulesetSource
+	if len(dirs) > 0 {
+		source = GitRulesetSource{Repo: repo, Commit: commit, Path: files[dirs[0]].path}
+	}
+	rs := NewRuleset(source, &codeownerspb.File{Rule: rules})
+	rs.ruleSources = ruleSources
+	return rs, nil
+}
+
+// ownersFile is a parsed OWNERS file.
+type ownersFile struct {
+	path     string
+	noParent bool
+	owners   []ownersEntry
+	perFile  []*perFileEntry
+	// firstLine is the line number of the first owner of the file.
+	firstLine int32
+}
+
+// ownersEntry is either an owner or an include of another OWNERS file.
+type ownersEntry struct {
+	owner   string
+	include string
+}
+
+type perFileEntry struct {
+	glob       string
+	noParent   bool
+	owners     []ownersEntry
+	lineNumber int32
+}
+
+func parseOwnersFile(logger log.Logger, filePath string, content []byte) (*ownersFile, error) {
+	f := &ownersFile{path: filePath}
+	perFile := map[string]*perFileEntry{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	lineNumber := int32(0)
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		if i := strings.IndexRune(line, commentStart); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if isSetNoParent(line) {
+			f.noParent = true
+			continue
+		}
+
+		if strings.HasPrefix(line, "per-file ") {
+			globs, owners, ok := strings.Cut(strings.TrimPrefix(line, "per-file "), "=")
+			if !ok {
+				logInvalidOwnersLine(logger, filePath, lineNumber, line, "per-file directive without owners")
+				continue
+			}
+			var (
+				noParent bool
+				entries  []ownersEntry
+			)
+			for _, owner := range strings.Split(owners, ",") {
+				owner = strings.TrimSpace(owner)
+				if isSetNoParent(owner) {
+					noParent = true
+					continue
+				}
+				if entry, ok := parseOwnersEntry(filePath, owner); ok {
+					entries = append(entries, entry)
+				}
+			}
+			// All per-file directives of a glob apply together.
+			for _, glob := range strings.Split(globs, ",") {
+				if glob = strings.TrimSpace(glob); glob == "" {
+					continue
+				}
+				pf, ok := perFile[glob]
+				if !ok {
+					pf = &perFileEntry{glob: glob, lineNumber: lineNumber}
+					perFile[glob] = pf
+					f.perFile = append(f.perFile, pf)
+				}
+				pf.noParent = pf.noParent || noParent
+				pf.owners = append(pf.owners, entries...)
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, "set ") {
+			// Other options, such as `set noparent` variants of other tools, don't
+			// affect ownership.
+			continue
+		}
+		if len(strings.Fields(line)) > 1 && !strings.HasPrefix(line, "include ") {
+			logInvalidOwnersLine(logger, filePath, lineNumber, line, "failed to match owner")
+			continue
+		}
+
+		if entry, ok := parseOwnersEntry(filePath, line); ok {
+			f.owners = append(f.owners, entry)
+			if f.firstLine == 0 {
+				f.firstLine = lineNumber
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func logInvalidOwnersLine(logger log.Logger, filePath string, lineNumber int32, line, reason string) {
+	logger.Warn("skipping invalid line of OWNERS file",
+		log.String("path", filePath),
+		log.Int32("lineNumber", lineNumber),
+		log.String("line", line),
+		log.String("reason", reason))
+}
+
+func isSetNoParent(s string) bool {
+	return strings.Join(strings.Fields(s), " ") == "set noparent"
+}
+
+// parseOwnersEntry parses an owner or an include. Includes are returned as
+// repository-relative paths. The wildcard owner `*` is dropped.
+func parseOwnersEntry(filePath, s string) (ownersEntry, bool) {
+	var include string
+	switch {
+	case strings.HasPrefix(s, "file://"):
+		include = "/" + strings.TrimPrefix(s, "file://")
+	case strings.HasPrefix(s, "file:"):
+		include = strings.TrimPrefix(s, "file:")
+	case strings.HasPrefix(s, "include "):
+		include = strings.TrimSpace(strings.TrimPrefix(s, "include "))
+	}
+	if include != "" {
+		if !strings.HasPrefix(include, "/") {
+			include = path.Join(path.Dir(filePath), include)
+		}
+		return ownersEntry{include: strings.TrimPrefix(path.Clean(include), "/")}, true
+	}
+
+	if s == "" || s == "*" {
+		return ownersEntry{}, false
+	}
+	return ownersEntry{owner: s}, true
+}
+
+// ownersFilesMerger resolves includes of OWNERS files.
+type ownersFilesMerger struct {
+	logger   log.Logger
+	readFile func(path string) ([]byte, error)
+	// included caches the parsed included files, nil for missing files.
+	included map[string]*ownersFile
+}
+
+// resolve returns the owners of the given entries of the OWNERS file at
+// filePath, replacing includes with the owners of the included files.
+func (m *ownersFilesMerger) resolve(filePath string, entries []ownersEntry) ([]string, error) {
+	return m.resolveVisited(entries, map[string]struct{}{filePath: {}})
+}
+
+func (m *ownersFilesMerger) resolveVisited(entries []ownersEntry, visited map[string]struct{}) ([]string, error) {
+	var owners []string
+	for _, entry := range entries {
+		if entry.include == "" {
+			owners = append(owners, entry.owner)
+			continue
+		}
+		// Include cycles are not an error, the owners of every file are only
+		// collected once.
+		if _, ok := visited[entry.include]; ok {
+			continue
+		}
+		visited[entry.include] = struct{}{}
+
+		f, err := m.includedFile(entry.include)
+		if err != nil {
+			return nil, err
+		}
+		if f == nil {
+			continue
+		}
+		included, err := m.resolveVisited(f.owners, visited)
+		if err != nil {
+			return nil, err
+		}
+		owners = append(owners, included...)
+	}
+	return dedupeOwners(owners), nil
+}
+
+func (m *ownersFilesMerger) includedFile(filePath string) (*ownersFile, error) {
+	if f, ok := m.included[filePath]; ok {
+		return f, nil
+	}
+	if m.included == nil {
+		m.included = map[string]*ownersFile{}
+	}
+
+	content, err := m.readFile(filePath)
+	if err != nil {
+		// Missing includes are skipped, as they would be by Gerrit.
+		if os.IsNotExist(err) {
+			m.included[filePath] = nil
+			return nil, nil
+		}
+		return nil, err
+	}
+	f, err := parseOwnersFile(m.logger, filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	m.included[filePath] = f
+	return f, nil
+}
+
+// nearestOwnedAncestor returns the closest parent directory of dir that has
+// an OWNERS file, or "" if there is none.
+func nearestOwnedAncestor(dir string, files map[string]*ownersFile) string {
+	for dir != "." {
+		dir = path.Dir(dir)
+		if _, ok := files[dir]; ok {
+			return dir
+		}
+	}
+	return ""
+}
+
+func dedupeOwners(ownerLists ...[]string) []string {
+	var owners []string
+	seen := map[string]struct{}{}
+	for _, list := range ownerLists {
+		for _, owner := range list {
+			if _, ok := seen[owner]; ok {
+				continue
+			}
+			seen[owner] = struct{}{}
+			owners = append(owners, owner)
+		}
+	}
+	return owners
+}
+
+func dirDepth(dir string) int {
+	if dir == "." {
+		return 0
+	}
+	return strings.Count(dir, "/") + 1
+}
+
+// dirPattern returns the pattern matching all files in the given directory
+// and its subdirectories.
+func dirPattern(dir string) string {
+	if dir == "." {
+		return "*"
+	}
+	return "/" + dir + "/"
+}
+
+// filePattern returns the pattern matching files in the given directory with
+// a name matching glob.
+func filePattern(dir, glob string) string {
+	if dir == "." {
+		return "/" + glob
+	}
+	return "/" + dir + "/" + glob
+}
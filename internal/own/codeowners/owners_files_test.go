@@ -0,0 +1,114 @@
+package codeowners_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+)
+
+func TestNewOwnersFilesRuleset(t *testing.T) {
+	files := map[string]string{
+		"OWNERS": `# Owners of everything.
+root@example.com
+*
+per-file *.md=docs@example.com
+`,
+		"chrome/OWNERS": `chrome@example.com
+file://build/OWNERS
+per-file BUILD.gn,*.gni=set noparent
+per-file BUILD.gn,*.gni=file://build/OWNERS
+`,
+		"chrome/browser/OWNERS": `set noparent
+browser@example.com
+include ../../common/OWNERS # Relative include.
+`,
+		"chrome/browser/ui/OWNERS": `per-file *.cc=@ui-team
+`,
+		"build/OWNERS": `build@example.com
+set noparent
+per-file *.py=python@example.com
+file://common/OWNERS
+`,
+		"common/OWNERS": `common@example.com
+file://build/OWNERS
+`,
+	}
+	readFile := func(path string) ([]byte, error) {
+		content, ok := files[path]
+		if !ok {
+			return nil, os.ErrNotExist
+		}
+		return []byte(content), nil
+	}
+
+	rs, err := codeowners.NewOwnersFilesRuleset(logtest.Scoped(t), 1, "SHA", []string{
+		"chrome/browser/ui/OWNERS",
+		"chrome/browser/OWNERS",
+		"OWNERS",
+		"chrome/OWNERS",
+	}, readFile)
+	require.NoError(t, err)
+
+	want := `* root@example.com
+/*.md docs@example.com root@example.com
+/chrome/ chrome@example.com build@example.com common@example.com root@example.com
+/chrome/BUILD.gn build@example.com common@example.com
+/chrome/*.gni build@example.com common@example.com
+/chrome/browser/ browser@example.com common@example.com build@example.com
+/chrome/browser/ui/*.cc @ui-team browser@example.com common@example.com build@example.com
+`
+	assert.Equal(t, want, rs.Repr())
+
+	for path, wantOwners := range map[string]string{
+		"README.md":                      "docs@example.com root@example.com",
+		"chrome/BUILD.gn":                "build@example.com common@example.com",
+		"chrome/app/main.cc":             "chrome@example.com build@example.com common@example.com root@example.com",
+		"chrome/browser/ui/view.cc":      "@ui-team browser@example.com common@example.com build@example.com",
+		"chrome/browser/ui/view.h":       "browser@example.com common@example.com build@example.com",
+		"chrome/browser/ui/views/tab.cc": "browser@example.com common@example.com build@example.com",
+	} {
+		rule := rs.Match(path)
+		require.NotNil(t, rule, path)
+		var owners []string
+		for _, o := range rule.GetOwner() {
+			if o.GetHandle() != "" {
+				owners = append(owners, "@"+o.GetHandle())
+			} else {
+				owners = append(owners, o.GetEmail())
+			}
+		}
+		assert.Equal(t, wantOwners, joinOwners(owners), path)
+	}
+
+	rule := rs.Match("chrome/browser/ui/view.cc")
+	assert.Equal(t, codeowners.GitRulesetSource{Repo: 1, Commit: "SHA", Path: "chrome/browser/ui/OWNERS"}, rs.GetRuleSource(rule))
+	assert.Equal(t, int32(1), rule.GetLineNumber())
+	rule = rs.Match("chrome/app/main.cc")
+	assert.Equal(t, codeowners.GitRulesetSource{Repo: 1, Commit: "SHA", Path: "chrome/OWNERS"}, rs.GetRuleSource(rule))
+	assert.Equal(t, codeowners.GitRulesetSource{Repo: 1, Commit: "SHA", Path: "OWNERS"}, rs.GetSource())
+}
+
+func TestNewOwnersFilesRulesetInvalidLine(t *testing.T) {
+	readFile := func(path string) ([]byte, error) {
+		return []byte("owner@example.com\nper-file *.md\nnot an owner\nother@example.com\n"), nil
+	}
+	rs, err := codeowners.NewOwnersFilesRuleset(logtest.Scoped(t), 1, "SHA", []string{"OWNERS"}, readFile)
+	require.NoError(t, err)
+	assert.Equal(t, "* owner@example.com other@example.com\n", rs.Repr())
+}
+
+func joinOwners(owners []string) string {
+	var s string
+	for i, o := range owners {
+		if i > 0 {
+			s += " "
+		}
+		s += o
+	}
+	return s
+}
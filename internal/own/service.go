@@ -3,18 +3,25 @@ package own
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"os"
+	"path"
 	"strings"
 
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/sourcegraph/log"
+
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
 )
 
 // Service gives access to code ownership data.
-// At this point only data from CODEOWNERS file is presented, if available.
+// At this point only data from CODEOWNERS file, or from Chromium-style
+// per-directory OWNERS files, is presented, if available.
 type Service interface {
 	// RulesetForRepo returns a CODEOWNERS file ruleset from a given repository at given commit ID.
 	// If a CODEOWNERS file has been manually ingested for the repository, it will prioritise returning that file.
@@ -74,12 +81,14 @@ var _ Service = &service{}
 
 func NewService(g gitserver.Client, db database.DB) Service {
 	return &service{
+		logger:          log.Scoped("own"),
 		gitserverClient: g,
 		db:              db,
 	}
 }
 
 type service struct {
+	logger          log.Logger
 	gitserverClient gitserver.Client
 	db              database.DB
 }
@@ -98,7 +107,9 @@ var codeownersLocations = []string{
 }
 
 // RulesetForRepo makes a best effort attempt to return a CODEOWNERS file ruleset
-// from one of the possible codeownersLocations, or the ingested codeowners files. It returns nil if no match is found.
+// from one of the possible codeownersLocations, or the ingested codeowners files.
+// Without a CODEOWNERS file, the OWNERS files of the repository are merged into
+// a ruleset instead. It returns nil if no match is found.
 func (s *service) RulesetForRepo(ctx context.Context, repoName api.RepoName, repoID api.RepoID, commitID api.CommitID) (*codeowners.Ruleset, error) {
 	ingestedCodeowners, err := s.db.Codeowners().GetCodeownersForRepo(ctx, repoID)
 	if err != nil && !errcode.IsNotFound(err) {
@@ -128,6 +139,12 @@ func (s *service) RulesetForRepo(ctx context.Context, repoName api.RepoName, rep
 			return nil, err
 		}
 	}
+	if rs == nil {
+		rs, err = s.ownersFilesRuleset(ctx, repoName, repoID, commitID)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if rs == nil {
 		return nil, nil
 	}
@@ -141,6 +158,74 @@ func (s *service) RulesetForRepo(ctx context.Context, repoName api.RepoName, rep
 	return rs, nil
 }
 
+// ownersFilesPathspec matches the Chromium/Gerrit-style OWNERS files in every
+// directory of a repository.
+var ownersFilesPathspec = gitdomain.Pathspec(":(glob)**/" + codeowners.OwnersFileName)
+
+// ownersFilesCacheKey identifies the OWNERS files of a repository at a commit.
+type ownersFilesCacheKey struct {
+	repoID   api.RepoID
+	commitID api.CommitID
+}
+
+// ownersFilesCache holds the rulesets merged from the OWNERS files of the most
+// recently queried commits, or nil for commits without OWNERS files. Listing and
+// reading all OWNERS files is expensive for large repositories, and the files
+// cannot change for a given commit.
+var ownersFilesCache = func() *lru.Cache[ownersFilesCacheKey, *codeowners.Ruleset] {
+	cache, err := lru.New[ownersFilesCacheKey, *codeowners.Ruleset](ownersFilesCacheSize)
+	if err != nil {
+		// Errors should only ever occur if we change the value of
+		// ownersFilesCacheSize to be negative.
+		panic(fmt.Sprintf("failed to create LRU cache for OWNERS files: %v", err))
+	}
+	return cache
+}()
+
+const ownersFilesCacheSize = 100
+
+// ownersFilesRuleset returns the ruleset merged from all OWNERS files in the
+// repository, or nil if there are none. Results are cached per commit.
+func (s *service) ownersFilesRuleset(ctx context.Context, repoName api.RepoName, repoID api.RepoID, commitID api.CommitID) (*codeowners.Ruleset, error) {
+	key := ownersFilesCacheKey{repoID: repoID, commitID: commitID}
+	rs, ok := ownersFilesCache.Get(key)
+	if !ok {
+		var err error
+		rs, err = s.readOwnersFilesRuleset(ctx, repoName, repoID, commitID)
+		if err != nil {
+			return nil, err
+		}
+		ownersFilesCache.Add(key, rs)
+	}
+	if rs == nil {
+		return nil, nil
+	}
+	// The caller sets the code host type of the returned ruleset, so hand out
+	// a copy rather than the shared cached ruleset.
+	cp := *rs
+	return &cp, nil
+}
+
+func (s *service) readOwnersFilesRuleset(ctx context.Context, repoName api.RepoName, repoID api.RepoID, commitID api.CommitID) (*codeowners.Ruleset, error) {
+	files, err := s.gitserverClient.LsFiles(ctx, repoName, commitID, ownersFilesPathspec)
+	if err != nil {
+		return nil, err
+	}
+	// The pathspec also matches the files within directories named OWNERS.
+	var paths []string
+	for _, file := range files {
+		if path.Base(file) == codeowners.OwnersFileName {
+			paths = append(paths, file)
+		}
+	}
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	return codeowners.NewOwnersFilesRuleset(s.logger, repoID, commitID, paths, func(path string) ([]byte, error) {
+		return s.gitserverClient.ReadFile(ctx, repoName, commitID, path)
+	})
+}
+
 func (s *service) AssignedOwnership(ctx context.Context, repoID api.RepoID, _ api.CommitID) (AssignedOwners, error) {
 	summaries, err := s.db.AssignedOwners().ListAssignedOwnersForRepo(ctx, repoID)
 	if err != nil {
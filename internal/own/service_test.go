@@ -112,11 +112,52 @@ func TestOwnersCannotFindFile(t *testing.T) {
 	reposStore := dbmocks.NewMockRepoStore()
 	reposStore.GetFunc.SetDefaultReturn(&types2.Repo{ExternalRepo: api.ExternalRepoSpec{ServiceType: "github"}}, nil)
 	db.ReposFunc.SetDefaultReturn(reposStore)
+	ownersFilesCache.Purge()
 	got, err := NewService(git, db).RulesetForRepo(context.Background(), "repo", 1, "SHA")
 	require.NoError(t, err)
 	assert.Nil(t, got)
 }
 
+func TestOwnersServesOwnersFiles(t *testing.T) {
+	repo := repoFiles{
+		{"repo", "SHA", "OWNERS"}:                "root@example.com\n",
+		{"repo", "SHA", "src/OWNERS"}:            "set noparent\n@src-team\n",
+		{"repo", "SHA", "src/docs/OWNERS"}:       "per-file *.md=writer@example.com\n",
+		{"repo", "SHA", "tools/OWNERS/list.txt"}: "tools@example.com\n",
+	}
+	git := gitserver.NewMockClient()
+	git.ReadFileFunc.SetDefaultHook(repo.ReadFile)
+	// Files within directories named OWNERS are not OWNERS files.
+	git.LsFilesFunc.SetDefaultReturn([]string{"OWNERS", "src/docs/OWNERS", "src/OWNERS", "tools/OWNERS/list.txt"}, nil)
+
+	codeownersStore := dbmocks.NewMockCodeownersStore()
+	codeownersStore.GetCodeownersForRepoFunc.SetDefaultReturn(nil, database.CodeownersFileNotFoundError{})
+	db := dbmocks.NewMockDB()
+	db.CodeownersFunc.SetDefaultReturn(codeownersStore)
+	reposStore := dbmocks.NewMockRepoStore()
+	reposStore.GetFunc.SetDefaultReturn(&types2.Repo{ExternalRepo: api.ExternalRepoSpec{ServiceType: "github"}}, nil)
+	db.ReposFunc.SetDefaultReturn(reposStore)
+
+	ownersFilesCache.Purge()
+	got, err := NewService(git, db).RulesetForRepo(context.Background(), "repo", 1, "SHA")
+	require.NoError(t, err)
+	want := `* root@example.com
+/src/ @src-team
+/src/docs/*.md writer@example.com @src-team
+`
+	assert.Equal(t, want, got.Repr())
+
+	rule := got.Match("src/docs/index.md")
+	assert.Equal(t, codeowners.GitRulesetSource{Repo: 1, Commit: "SHA", Path: "src/docs/OWNERS"}, got.GetRuleSource(rule))
+	assert.Equal(t, "github", got.GetCodeHostType())
+
+	// The OWNERS files of a commit are only listed and read once.
+	cached, err := NewService(git, db).RulesetForRepo(context.Background(), "repo", 1, "SHA")
+	require.NoError(t, err)
+	assert.Equal(t, want, cached.Repr())
+	assert.Len(t, git.LsFilesFunc.History(), 1)
+}
+
 func TestOwnersServesIngestedFile(t *testing.T) {
 	t.Run("return manually ingested codeowners file", func(t *testing.T) {
 		codeownersProto := &codeownerspb.File{
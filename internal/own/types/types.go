@@ -21,4 +21,52 @@ const (
 	SignalRecentContributors = "recent-contributors"
 	SignalRecentViews        = "recent-views"
 	Analytics                = "analytics"
+	Coverage                 = "coverage"
 )
+
+// OwnCoverageReport is the ownership coverage of a repository computed by the
+// coverage background job, along with the issues found in its ownership rules.
+type OwnCoverageReport struct {
+	RepoID   api.RepoID
+	CommitID api.CommitID
+
+	// TotalFileCount is the number of files in the repository.
+	TotalFileCount int
+	// OwnedFileCount is the number of files matched by a rule with owners.
+	OwnedFileCount int
+	// UnownedDirectories are the directories that contain no owned file,
+	// excluding subdirectories of such directories. The repository root is "".
+	UnownedDirectories []string
+	// UnmatchedRules are the rules with a pattern that matches no file.
+	UnmatchedRules []OwnCoverageRule
+	// UnresolvedOwners are the owners named by rules that resolve to no
+	// Sourcegraph user or team.
+	UnresolvedOwners []OwnCoverageUnresolvedOwner
+
+	UpdatedAt time.Time
+}
+
+// OwnCoverageRule locates an ownership rule.
+type OwnCoverageRule struct {
+	Pattern string `json:"pattern"`
+	// FilePath is the path of the file the rule is defined in, empty for
+	// manually ingested CODEOWNERS files.
+	FilePath   string `json:"filePath"`
+	LineNumber int32  `json:"lineNumber"`
+}
+
+// OwnCoverageUnresolvedOwner is an owner that resolves to no user or team.
+type OwnCoverageUnresolvedOwner struct {
+	Handle string `json:"handle,omitempty"`
+	Email  string `json:"email,omitempty"`
+	// Rules are the rules that name the owner.
+	Rules []OwnCoverageRule `json:"rules"`
+}
+
+// Coverage returns the ratio of owned files in the repository, between 0 and 1.
+func (r *OwnCoverageReport) Coverage() float64 {
+	if r.TotalFileCount == 0 {
+		return 0
+	}
+	return float64(r.OwnedFileCount) / float64(r.TotalFileCount)
+}
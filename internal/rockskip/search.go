@@ -287,7 +287,7 @@ func (s *Service) querySymbols(ctx context.Context, args search.SymbolsParameter
 		lines := strings.Split(string(contents), "\n")
 
 		for _, symbol := range allSymbols {
-			if isMatch(symbol.Name) {
+			if isMatch(symbol.Name) && args.Kinds.Matches(result.Symbol{Kind: symbol.Kind}) {
 				if symbol.Line < 1 || symbol.Line > len(lines) {
 					log15.Warn("ctags returned an invalid line number", "path", path, "line", symbol.Line, "len(lines)", len(lines), "symbol", symbol.Name)
 					continue
@@ -369,6 +369,7 @@ func NewFlatJob(searchInputs *search.Inputs, f query.Flat) (job.Job, error) {
 			if !skipRepoSubsetSearch {
 				symbolSearchJob := &searcher.SymbolSearchJob{
 					PatternInfo: patternInfo,
+					Kinds:       toSymbolKindFilter(f.ToBasic()),
 					Limit:       maxResults,
 				}
 
@@ -773,6 +774,8 @@ func toRepoOptions(b query.Basic, userSettings *schema.Settings) search.RepoOpti
 		UseIndex:            b.Index(),
 		HasKVPs:             b.RepoHasKVPs(),
 		HasTopics:           b.RepoHasTopics(),
+		HasLanguages:        b.RepoHasLanguages(),
+		HasSizes:            b.RepoHasSizes(),
 	}
 }
 
@@ -828,10 +831,12 @@ func (b *jobBuilder) newZoektGlobalSearch(typ search.IndexedRequestType) (job.Jo
 
 	switch typ {
 	case search.SymbolRequest:
+		zoektParams.SymbolKinds = toSymbolKindFilter(b.query)
 		return &zoekt.GlobalSymbolSearchJob{
 			GlobalZoektQuery: globalZoektQuery,
 			ZoektParams:      zoektParams,
 			RepoOpts:         b.repoOptions,
+			PreciseSymbols:   b.preciseSymbolsParameters(),
 		}, nil
 	case search.TextRequest:
 		return &zoekt.GlobalTextSearchJob{
@@ -859,9 +864,11 @@ func (b *jobBuilder) newZoektSearch(typ search.IndexedRequestType) (job.Job, err
 
 	switch typ {
 	case search.SymbolRequest:
+		zoektParams.SymbolKinds = toSymbolKindFilter(b.query)
 		return &zoekt.SymbolSearchJob{
-			Query:       zoektQuery,
-			ZoektParams: zoektParams,
+			Query:          zoektQuery,
+			ZoektParams:    zoektParams,
+			PreciseSymbols: b.preciseSymbolsParameters(),
 		}, nil
 	case search.TextRequest:
 		return &zoekt.RepoSubsetTextSearchJob{
@@ -874,6 +881,36 @@ func (b *jobBuilder) newZoektSearch(typ search.IndexedRequestType) (job.Job, err
 	return nil, errors.Errorf("attempt to create unrecognized zoekt search with value %v", typ)
 }
 
+// preciseSymbolsParameters returns the parameters of the precise symbol search
+// of Zoekt symbol searches. It returns nil, which disables precise symbols, if
+// the query has more than a single pattern.
+func (b *jobBuilder) preciseSymbolsParameters() *search.SymbolsParameters {
+	if _, ok := b.query.Pattern.(query.Pattern); !ok && b.query.Pattern != nil {
+		return nil
+	}
+
+	patternInfo := toTextPatternInfo(b.query, b.resultTypes, int(b.fileMatchLimit))
+	if patternInfo.IsNegated {
+		return nil
+	}
+
+	return &search.SymbolsParameters{
+		Query:           patternInfo.Pattern,
+		IsRegExp:        patternInfo.IsRegExp,
+		IsCaseSensitive: patternInfo.IsCaseSensitive,
+		IncludePatterns: patternInfo.IncludePatterns,
+		ExcludePattern:  patternInfo.ExcludePattern,
+		Kinds:           toSymbolKindFilter(b.query),
+		First:           int(b.fileMatchLimit),
+	}
+}
+
+// toSymbolKindFilter returns the filter of the symbol.kind: values of the query.
+func toSymbolKindFilter(b query.Basic) result.SymbolKindFilter {
+	include, exclude := b.IncludeExcludeValues(query.FieldSymbolKind)
+	return result.SymbolKindFilter{Include: include, Exclude: exclude}
+}
+
 func zoektQueryPatternsAsRegexps(q zoektquery.Q) (res []*regexp.Regexp) {
 	zoektquery.VisitAtoms(q, func(zoektQ zoektquery.Q) {
 		switch typedQ := zoektQ.(type) {
@@ -1061,6 +1098,18 @@ func isGlobal(op search.RepoOptions) bool {
 		return false
 	}
 
+	// Zoekt does not know about repo sizes, so we depend on the database to
+	// handle this filter.
+	if len(op.HasSizes) > 0 {
+		return false
+	}
+
+	// repo:has.language() is handled during the repo resolution step, and we
+	// cannot depend on Zoekt for this information.
+	if len(op.HasLanguages) > 0 {
+		return false
+	}
+
 	// If a search context is specified, we do not know ahead of time whether
 	// the repos in the context are indexed and we need to go through the repo
 	// resolution process.
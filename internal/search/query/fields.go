@@ -19,6 +19,7 @@ const (
 	FieldVisibility         = "visibility"
 	FieldRev                = "rev"
 	FieldContext            = "context"
+	FieldSymbolKind         = "symbol.kind"
 
 	// For diff and commit search only:
 	FieldBefore    = "before"
@@ -55,6 +56,7 @@ var allFields = map[string]struct{}{
 	FieldVisibility:         empty,
 	FieldRepoHasFile:        empty,
 	FieldRepoHasCommitAfter: empty,
+	FieldSymbolKind:         empty,
 	FieldBefore:             empty,
 	"until":                 empty,
 	FieldAfter:              empty,
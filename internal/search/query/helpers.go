@@ -60,3 +60,14 @@ func LangToFileRegexp(lang string) string {
 	}
 	return UnionRegExps(patterns)
 }
+
+// LanguageForPath returns the language that lang: filters associate with the
+// file at path, using its file name first and its extension otherwise. This is
+// the inverse of LangToFileRegexp.
+func LanguageForPath(path string) (string, bool) {
+	if lang, _ := enry.GetLanguageByFilename(path); lang != "" {
+		return lang, true
+	}
+	lang, _ := enry.GetLanguageByExtension(path)
+	return lang, lang != ""
+}
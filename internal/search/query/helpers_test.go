@@ -72,3 +72,21 @@ func TestLangToFileRegexp(t *testing.T) {
 		})
 	}
 }
+
+func TestLanguageForPath(t *testing.T) {
+	for path, want := range map[string]string{
+		"main.go":              "Go",
+		"a/b/setup.py":         "Python",
+		"Makefile":             "Makefile",
+		"dir.d/Makefile":       "Makefile",
+		"a/Dockerfile":         "Dockerfile",
+		"a/BUILD.bazel":        "Starlark",
+		"unknown.notalanguage": "",
+		"a/notaDockerfile":     "",
+	} {
+		got, ok := LanguageForPath(path)
+		if got != want || ok != (want != "") {
+			t.Errorf("LanguageForPath(%q) = %q, %t, want %q", path, got, ok, want)
+		}
+	}
+}
@@ -594,10 +594,11 @@ func Delimit(s string, delimiter rune) string {
 }
 
 // ScanField scans an optional '-' at the beginning of a string, and then scans
-// one or more alphabetic characters until it encounters a ':'. The prefix
-// string is checked against valid fields. If it is valid, the function returns
-// the value before the colon, whether it's negated, and its length. In all
-// other cases it returns zero values.
+// one or more alphabetic characters (or '.' after the first character, for
+// fields like symbol.kind) until it encounters a ':'. The prefix string is
+// checked against valid fields. If it is valid, the function returns the value
+// before the colon, whether it's negated, and its length. In all other cases it
+// returns zero values.
 func ScanField(buf []byte) (string, bool, int) {
 	var count int
 	var r rune
@@ -620,7 +621,7 @@ func ScanField(buf []byte) (string, bool, int) {
 	success := false
 	for len(buf) > 0 {
 		r = next()
-		if strings.ContainsRune(allowed, r) {
+		if strings.ContainsRune(allowed, r) || (r == '.' && len(result) > 0 && result[len(result)-1] != '-') {
 			result = append(result, r)
 			continue
 		}
@@ -864,7 +865,7 @@ func (p *parser) ParsePattern(label labels) Pattern {
 
 // ParseParameter returns a leaf node corresponding to the syntax
 // (-?)field:<string> where : matches the first encountered colon, and field
-// must match ^[a-zA-Z]+ and be allowed by allFields. Field may optionally
+// must match ^[a-zA-Z][a-zA-Z.]* and be allowed by allFields. Field may optionally
 // be preceded by '-' which means the parameter is negated.
 func (p *parser) ParseParameter() (Parameter, bool, error) {
 	start := p.pos
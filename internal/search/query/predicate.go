@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/dustin/go-humanize"
+	"github.com/go-enry/go-enry/v2"
 	"github.com/grafana/regexp"
 	"github.com/grafana/regexp/syntax"
 
@@ -40,6 +42,8 @@ var DefaultPredicateRegistry = PredicateRegistry{
 		"has.key":               func() Predicate { return &RepoHasKeyPredicate{} },
 		"has.meta":              func() Predicate { return &RepoHasMetaPredicate{} },
 		"has.topic":             func() Predicate { return &RepoHasTopicPredicate{} },
+		"has.language":          func() Predicate { return &RepoHasLanguagePredicate{} },
+		"has.size":              func() Predicate { return &RepoHasSizePredicate{} },
 
 		// Deprecated predicates
 		"contains": func() Predicate { return &RepoContainsPredicate{} },
@@ -483,6 +487,83 @@ func (p *RepoHasTopicPredicate) Unmarshal(params string, negated bool) (err erro
 func (p *RepoHasTopicPredicate) Field() string { return FieldRepo }
 func (p *RepoHasTopicPredicate) Name() string  { return "has.topic" }
 
+/* repo:has.language(language) */
+
+// RepoHasLanguagePredicate represents the `repo:has.language()` predicate,
+// which filters to repos whose primary language, the language with the most
+// lines (or bytes, if lines are not counted) in the language inventory, is the
+// given language.
+type RepoHasLanguagePredicate struct {
+	// Language is the canonical name of the language, e.g. "Go".
+	Language string
+	Negated  bool
+}
+
+func (p *RepoHasLanguagePredicate) Unmarshal(params string, negated bool) (err error) {
+	params = strings.TrimSpace(params)
+	if len(params) == 0 {
+		return errors.New("language must be non-empty")
+	}
+	language, ok := enry.GetLanguageByAlias(params)
+	if !ok {
+		return errors.Errorf("unknown language %q in repo:has.language() predicate", params)
+	}
+	p.Language = language
+	p.Negated = negated
+	return nil
+}
+
+func (p *RepoHasLanguagePredicate) Field() string { return FieldRepo }
+func (p *RepoHasLanguagePredicate) Name() string  { return "has.language" }
+
+/* repo:has.size(comparison) */
+
+// RepoHasSizePredicate represents the `repo:has.size()` predicate, which
+// filters repos by the size on disk reported by gitserver, e.g.
+// `repo:has.size(>100MB)` or `repo:has.size(<=2GiB)`.
+type RepoHasSizePredicate struct {
+	// Comparator is one of ">", ">=", "<" or "<=".
+	Comparator string
+	Bytes      uint64
+	Negated    bool
+}
+
+// sizeComparators are ordered so that the two-character comparators are
+// matched before their one-character prefixes.
+var sizeComparators = []string{">=", "<=", ">", "<"}
+
+func (p *RepoHasSizePredicate) Unmarshal(params string, negated bool) (err error) {
+	params = strings.TrimSpace(params)
+
+	comparator := ""
+	for _, c := range sizeComparators {
+		if strings.HasPrefix(params, c) {
+			comparator = c
+			break
+		}
+	}
+	if comparator == "" {
+		return errors.Errorf("repo:has.size() argument %q must start with one of >, >=, < or <=", params)
+	}
+
+	size := strings.TrimSpace(params[len(comparator):])
+	if size == "" {
+		return errors.New("repo:has.size() argument is missing a size")
+	}
+	bytes, err := humanize.ParseBytes(size)
+	if err != nil {
+		return errors.Errorf("invalid size %q in repo:has.size() predicate, expected a size like 100MB or 2GiB", size)
+	}
+
+	p.Comparator = comparator
+	p.Bytes = bytes
+	p.Negated = negated
+	return nil
+}
+
+func (p *RepoHasSizePredicate) Field() string { return FieldRepo }
+func (p *RepoHasSizePredicate) Name() string  { return "has.size" }
+
 // RepoContainsPredicate represents the `repo:contains(file:a content:b)` predicate.
 // DEPRECATED: this syntax is deprecated in favor of `repo:contains.file`.
 type RepoContainsPredicate struct {
@@ -147,6 +147,77 @@ func TestRepoHasTopicPredicate(t *testing.T) {
 	})
 }
 
+func TestRepoHasLanguagePredicate(t *testing.T) {
+	t.Run("errors on empty", func(t *testing.T) {
+		var p RepoHasLanguagePredicate
+		err := p.Unmarshal("", false)
+		require.Error(t, err)
+	})
+
+	t.Run("errors on unknown language", func(t *testing.T) {
+		var p RepoHasLanguagePredicate
+		err := p.Unmarshal("notalanguage", false)
+		require.Error(t, err)
+	})
+
+	t.Run("resolves aliases and sets negated", func(t *testing.T) {
+		var p RepoHasLanguagePredicate
+		err := p.Unmarshal("golang", true)
+		require.NoError(t, err)
+		require.Equal(t, "Go", p.Language)
+		require.True(t, p.Negated)
+	})
+}
+
+func TestRepoHasSizePredicate(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		type test struct {
+			name     string
+			params   string
+			expected *RepoHasSizePredicate
+		}
+
+		valid := []test{
+			{`greater than`, `>100MB`, &RepoHasSizePredicate{Comparator: ">", Bytes: 100_000_000}},
+			{`greater than or equal`, `>=1KiB`, &RepoHasSizePredicate{Comparator: ">=", Bytes: 1024}},
+			{`less than`, `<2GB`, &RepoHasSizePredicate{Comparator: "<", Bytes: 2_000_000_000}},
+			{`less than or equal with spaces`, ` <= 10 MB `, &RepoHasSizePredicate{Comparator: "<=", Bytes: 10_000_000}},
+			{`bytes without unit`, `>512`, &RepoHasSizePredicate{Comparator: ">", Bytes: 512}},
+		}
+
+		for _, tc := range valid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &RepoHasSizePredicate{}
+				err := p.Unmarshal(tc.params, false)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !reflect.DeepEqual(tc.expected, p) {
+					t.Fatalf("expected %#v, got %#v", tc.expected, p)
+				}
+			})
+		}
+
+		invalid := []test{
+			{`empty`, ``, nil},
+			{`no comparator`, `100MB`, nil},
+			{`no size`, `>`, nil},
+			{`invalid size`, `>lots`, nil},
+		}
+
+		for _, tc := range invalid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &RepoHasSizePredicate{}
+				err := p.Unmarshal(tc.params, false)
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+			})
+		}
+	})
+}
+
 func TestRepoHasKVPMetaPredicate(t *testing.T) {
 	t.Run("Unmarshal", func(t *testing.T) {
 		type test struct {
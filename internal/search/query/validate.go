@@ -222,6 +222,13 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		return err
 	}
 
+	isValidSymbolKind := func() error {
+		if _, err := filter.SelectPathFromString(filter.Symbol + "." + value); err != nil || value == "" {
+			return errors.Errorf("invalid value %q for field %q. Valid values are symbol kinds like function, method, class, or variable", value, field)
+		}
+		return nil
+	}
+
 	isValidGitDate := func() error {
 		_, err := ParseGitDate(value, time.Now)
 		return err
@@ -298,6 +305,9 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 	case
 		FieldSelect:
 		return satisfies(isSingular, isNotNegated, isValidSelect)
+	case
+		FieldSymbolKind:
+		return satisfies(isValidSymbolKind)
 	default:
 		return isUnrecognizedField()
 	}
@@ -358,6 +368,24 @@ func validateCommitParameters(nodes []Node) error {
 	return nil
 }
 
+// Queries containing symbol.kind: without type:symbol are not valid, since the
+// filter only applies to symbol results.
+func validateSymbolKind(nodes []Node) error {
+	var seenSymbolKind, typeSymbolExists bool
+	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
+		if field == FieldSymbolKind {
+			seenSymbolKind = true
+		}
+		if field == FieldType && value == "symbol" {
+			typeSymbolExists = true
+		}
+	})
+	if seenSymbolKind && !typeSymbolExists {
+		return errors.Errorf(`your query contains the field '%s', which requires type:symbol in the query`, FieldSymbolKind)
+	}
+	return nil
+}
+
 func validateTypeStructural(nodes []Node) error {
 	seenStructural := false
 	seenType := false
@@ -498,6 +526,7 @@ func validate(nodes []Node) error {
 		validateRepoRevPair,
 		validateRepoHasFile,
 		validateCommitParameters,
+		validateSymbolKind,
 		validateTypeStructural,
 		validateRefGlobs,
 	)
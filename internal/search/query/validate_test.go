@@ -111,6 +111,14 @@ func TestValidation(t *testing.T) {
 			input: "type:symbol select:symbol.timelime",
 			want:  `invalid field "timelime" on select path "symbol.timelime"`,
 		},
+		{
+			input: "symbol.kind:function foo",
+			want:  `your query contains the field 'symbol.kind', which requires type:symbol in the query`,
+		},
+		{
+			input: "type:symbol symbol.kind:timelime foo",
+			want:  `invalid value "timelime" for field "symbol.kind". Valid values are symbol kinds like function, method, class, or variable`,
+		},
 		{
 			input:      "nice try type:repo",
 			want:       "this structural search query specifies `type:` and is not supported. Structural search syntax only applies to searching file contents",
@@ -0,0 +1,97 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+)
+
+// cachedInventory is the source of the language statistics of a tree. It is a
+// variable so that tests can read inventories without a Redis cache.
+var cachedInventory = backend.CachedInventory
+
+var (
+	// computeInventoryGroup deduplicates the background computations of the
+	// inventory of a revision.
+	computeInventoryGroup singleflight.Group
+	// computeInventorySem bounds the number of inventories computed in the
+	// background at once.
+	computeInventorySem = make(chan struct{}, 4)
+)
+
+// primaryLanguage returns the language with the most lines (or bytes, if lines
+// are not counted) in the tree of rev, or the empty string if no language was
+// detected. This is the first language of the inventory shown in the language
+// statistics of the repository.
+//
+// It only reads the inventory the frontend cached for these statistics, and
+// never computes one at search time. If the inventory of the tree is not
+// cached, ok is false and the inventory is computed in the background, so that
+// later searches can use it.
+func (r *Resolver) primaryLanguage(ctx context.Context, repo api.RepoName, rev string) (_ string, ok bool, err error) {
+	commitID, err := r.gitserver.ResolveRevision(ctx, repo, rev, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+	if err != nil {
+		return "", false, err
+	}
+
+	inv, ok, err := cachedInventory(ctx, r.logger, repo, r.gitserver, commitID)
+	if err != nil {
+		return "", false, err
+	}
+	if !ok {
+		r.computeInventory(ctx, repo, commitID)
+		return "", false, nil
+	}
+
+	if len(inv.Languages) == 0 {
+		return "", true, nil
+	}
+	return inv.Languages[0].Name, true, nil
+}
+
+// computeInventory computes and caches the inventory of the tree of commitID
+// in the background, unless too many inventories are being computed already.
+func (r *Resolver) computeInventory(ctx context.Context, repo api.RepoName, commitID api.CommitID) {
+	select {
+	case computeInventorySem <- struct{}{}:
+	default:
+		return // a later search will try again
+	}
+
+	// Keep the actor, so that the inventory respects sub-repo permissions, but
+	// not the deadline of the search.
+	ctx = actor.WithActor(context.Background(), actor.FromContext(ctx))
+	logger := r.logger.With(log.String("repo", string(repo)), log.String("commitID", string(commitID)))
+
+	go func() {
+		defer func() { <-computeInventorySem }()
+
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+		defer cancel()
+
+		_, err, _ := computeInventoryGroup.Do(string(repo)+"@"+string(commitID), func() (any, error) {
+			invCtx, err := backend.InventoryContext(logger, repo, r.gitserver, commitID, false)
+			if err != nil {
+				return nil, err
+			}
+
+			root, err := r.gitserver.Stat(ctx, repo, commitID, "")
+			if err != nil {
+				return nil, err
+			}
+
+			return invCtx.Entries(ctx, root)
+		})
+		if err != nil {
+			logger.Warn("failed to compute inventory for repo:has.language()", log.Error(err))
+		}
+	}()
+}
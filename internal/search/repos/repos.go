@@ -248,6 +248,15 @@ func (r *Resolver) doQueryDB(ctx context.Context, tr trace.Trace, op search.Repo
 		})
 	}
 
+	sizeFilters := make([]database.RepoSizeFilter, 0, len(op.HasSizes))
+	for _, filter := range op.HasSizes {
+		sizeFilters = append(sizeFilters, database.RepoSizeFilter{
+			Comparator: filter.Comparator,
+			Bytes:      int64(filter.Bytes),
+			Negated:    filter.Negated,
+		})
+	}
+
 	options := database.ReposListOptions{
 		IncludePatterns:       includePatterns,
 		ExcludePattern:        query.UnionRegExps(excludePatterns),
@@ -255,6 +264,7 @@ func (r *Resolver) doQueryDB(ctx context.Context, tr trace.Trace, op search.Repo
 		CaseSensitivePatterns: op.CaseSensitiveRepoFilters,
 		KVPFilters:            kvpFilters,
 		TopicFilters:          topicFilters,
+		SizeFilters:           sizeFilters,
 		Cursors:               op.Cursors,
 		// List N+1 repos so we can see if there are repos omitted due to our repo limit.
 		LimitOffset:  &database.LimitOffset{Limit: limit + 1},
@@ -399,6 +409,13 @@ func (r *Resolver) filterGitserver(ctx context.Context, tr trace.Trace, op searc
 	}
 	tr.AddEvent("completed rev filtering")
 
+	tr.AddEvent("starting language filtering")
+	filteredRepoRevs, err = r.filterHasLanguage(ctx, filteredRepoRevs, op)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "filter has language")
+	}
+	tr.AddEvent("completed language filtering")
+
 	return filteredRepoRevs, normalizedMissingRepoRevs, nil
 }
 
@@ -635,6 +652,80 @@ func (r *Resolver) filterHasCommitAfter(
 	return filteredRepoRevs, nil
 }
 
+// filterHasLanguage filters the revisions of repoRevs to those whose primary
+// language satisfies the repo:has.language() predicates in op. Revisions whose
+// language statistics are not cached yet are filtered out.
+func (r *Resolver) filterHasLanguage(
+	ctx context.Context,
+	repoRevs []*search.RepositoryRevisions,
+	op search.RepoOptions,
+) (
+	[]*search.RepositoryRevisions,
+	error,
+) {
+	// Early return if HasLanguages is not set
+	if len(op.HasLanguages) == 0 {
+		return repoRevs, nil
+	}
+
+	p := pool.New().WithContext(ctx).WithMaxGoroutines(16)
+
+	for _, repoRev := range repoRevs {
+		repoRev := repoRev
+
+		allRevs := repoRev.Revs
+
+		var mu sync.Mutex
+		repoRev.Revs = make([]string, 0, len(allRevs))
+
+		for _, rev := range allRevs {
+			rev := rev
+			p.Go(func(ctx context.Context) error {
+				language, ok, err := r.primaryLanguage(ctx, repoRev.Repo.Name, rev)
+				if err != nil {
+					if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) || gitdomain.IsRepoNotExist(err) {
+						// If the revision does not exist or the repo does not exist,
+						// it has no primary language. Ignore the error, but filter
+						// this repo out.
+						return nil
+					}
+					return err
+				}
+				if !ok {
+					// The language statistics of this revision have not been
+					// computed yet, so it does not satisfy any predicate.
+					return nil
+				}
+
+				for _, pred := range op.HasLanguages {
+					if (language == pred.Language) == pred.Negated {
+						return nil
+					}
+				}
+
+				mu.Lock()
+				repoRev.Revs = append(repoRev.Revs, rev)
+				mu.Unlock()
+				return nil
+			})
+		}
+	}
+
+	if err := p.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Filter out any repo revs with empty revs
+	filteredRepoRevs := repoRevs[:0]
+	for _, repoRev := range repoRevs {
+		if len(repoRev.Revs) > 0 {
+			filteredRepoRevs = append(filteredRepoRevs, repoRev)
+		}
+	}
+
+	return filteredRepoRevs, nil
+}
+
 // filterRepoHasFileContent filters a page of repos to only those that match the
 // given contains predicates in RepoOptions.HasFileContent.
 // Brief overview of the method:
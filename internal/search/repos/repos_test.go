@@ -4,8 +4,11 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,16 +19,20 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slices"
 
+	"github.com/sourcegraph/log"
 	"github.com/sourcegraph/log/logtest"
 
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/endpoint"
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
@@ -874,3 +881,129 @@ func TestRepoHasCommitAfter(t *testing.T) {
 		})
 	}
 }
+
+func TestRepoHasLanguage(t *testing.T) {
+	repoGo := types.MinimalRepo{ID: 1, Name: "example.com/go"}
+	repoTS := types.MinimalRepo{ID: 2, Name: "example.com/ts"}
+	repoEmpty := types.MinimalRepo{ID: 3, Name: "example.com/empty"}
+	repoMissing := types.MinimalRepo{ID: 4, Name: "example.com/missing"}
+	repoUncached := types.MinimalRepo{ID: 5, Name: "example.com/uncached"}
+
+	mkHead := func(repo types.MinimalRepo) *search.RepositoryRevisions {
+		return &search.RepositoryRevisions{
+			Repo: repo,
+			Revs: []string{""},
+		}
+	}
+
+	// Each file has the given number of lines of the given length.
+	type file struct {
+		name              string
+		lines, lineLength int
+	}
+	files := map[api.RepoName][]file{
+		// Languages are ranked by lines before bytes.
+		repoGo.Name:       {{"main.go", 100, 10}, {"a.ts", 10, 100}, {"b.ts", 10, 100}},
+		repoTS.Name:       {{"main.go", 10, 10}, {"index.ts", 100, 10}},
+		repoUncached.Name: {{"main.go", 100, 10}},
+	}
+	contents := func(f file) string {
+		return strings.Repeat(strings.Repeat("x", f.lineLength-1)+"\n", f.lines)
+	}
+
+	mockGitserver := gitserver.NewMockClient()
+	mockGitserver.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, repoName api.RepoName, _ string, _ gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		if repoName == repoMissing.Name {
+			return "", &gitdomain.RevisionNotFoundError{Repo: repoName}
+		}
+		return api.CommitID(strings.Repeat("a", 40)), nil
+	})
+	mockGitserver.StatFunc.SetDefaultReturn(&fileutil.FileInfo{Name_: "", Mode_: os.ModeDir}, nil)
+	mockGitserver.ReadDirFunc.SetDefaultHook(func(_ context.Context, repoName api.RepoName, _ api.CommitID, _ string, _ bool) ([]fs.FileInfo, error) {
+		var infos []fs.FileInfo
+		for _, f := range files[repoName] {
+			infos = append(infos, &fileutil.FileInfo{Name_: f.name, Mode_: 0o644, Size_: int64(len(contents(f)))})
+		}
+		return infos, nil
+	})
+	mockGitserver.NewFileReaderFunc.SetDefaultHook(func(_ context.Context, repoName api.RepoName, _ api.CommitID, name string) (io.ReadCloser, error) {
+		for _, f := range files[repoName] {
+			if f.name == name {
+				return io.NopCloser(strings.NewReader(contents(f))), nil
+			}
+		}
+		return nil, os.ErrNotExist
+	})
+
+	// Inventories are computed on demand, as if they were cached, except for
+	// repoUncached.
+	cachedInventory = func(ctx context.Context, logger log.Logger, repo api.RepoName, gsClient gitserver.Client, commitID api.CommitID) (inventory.Inventory, bool, error) {
+		if repo == repoUncached.Name {
+			return inventory.Inventory{}, false, nil
+		}
+		invCtx, err := backend.InventoryContext(logger, repo, gsClient, commitID, true)
+		if err != nil {
+			return inventory.Inventory{}, false, err
+		}
+		root, err := gsClient.Stat(ctx, repo, commitID, "")
+		if err != nil {
+			return inventory.Inventory{}, false, err
+		}
+		inv, err := invCtx.Entries(ctx, root)
+		return inv, true, err
+	}
+	t.Cleanup(func() { cachedInventory = backend.CachedInventory })
+
+	repos := dbmocks.NewMockRepoStore()
+	repos.ListMinimalReposFunc.SetDefaultReturn([]types.MinimalRepo{repoGo, repoTS, repoEmpty, repoMissing, repoUncached}, nil)
+
+	db := dbmocks.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	cases := []struct {
+		name      string
+		languages []query.RepoHasLanguagePredicate
+		expected  []*search.RepositoryRevisions
+		err       error
+	}{{
+		name:      "no filters",
+		languages: nil,
+		expected: []*search.RepositoryRevisions{
+			mkHead(repoGo),
+			mkHead(repoTS),
+			mkHead(repoEmpty),
+			mkHead(repoMissing),
+			mkHead(repoUncached),
+		},
+	}, {
+		name:      "has language",
+		languages: []query.RepoHasLanguagePredicate{{Language: "Go"}},
+		expected: []*search.RepositoryRevisions{
+			mkHead(repoGo),
+		},
+	}, {
+		name:      "not has language",
+		languages: []query.RepoHasLanguagePredicate{{Language: "Go", Negated: true}},
+		expected: []*search.RepositoryRevisions{
+			mkHead(repoTS),
+			mkHead(repoEmpty),
+		},
+	}, {
+		name:      "no repo has language",
+		languages: []query.RepoHasLanguagePredicate{{Language: "Rust"}},
+		expected:  []*search.RepositoryRevisions{},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := NewResolver(logtest.Scoped(t), db, nil, endpoint.Static("test"), nil)
+			res.gitserver = mockGitserver
+			resolved, _, err := res.resolve(context.Background(), search.RepoOptions{
+				RepoFilters:  toParsedRepoFilters(".*"),
+				HasLanguages: tc.languages,
+			})
+			require.Equal(t, tc.err, err)
+			require.Equal(t, tc.expected, resolved.RepoRevs)
+		})
+	}
+}
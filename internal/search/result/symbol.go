@@ -3,6 +3,7 @@ package result
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -25,9 +26,35 @@ type Symbol struct {
 	ParentKind string
 	Signature  string
 
+	// FullyQualifiedName is the complete name of the symbol, when known. For
+	// precise symbols this is the SCIP symbol name.
+	FullyQualifiedName string
+
+	// Provenance describes where the symbol data came from.
+	Provenance SymbolProvenance
+
 	FileLimited bool
 }
 
+// SymbolProvenance describes the source of a symbol result.
+type SymbolProvenance string
+
+const (
+	// SymbolProvenanceSearchBased marks symbols extracted by ctags (via the
+	// symbols service or Zoekt). This is the default for symbols with an
+	// empty provenance.
+	SymbolProvenanceSearchBased SymbolProvenance = "search-based"
+
+	// SymbolProvenancePrecise marks symbols read from a precise code
+	// intelligence index.
+	SymbolProvenancePrecise SymbolProvenance = "precise"
+)
+
+// IsPrecise returns true if the symbol was read from a precise index.
+func (s Symbol) IsPrecise() bool {
+	return s.Provenance == SymbolProvenancePrecise
+}
+
 // NewSymbolMatch returns a new SymbolMatch. Passing -1 as the character will make NewSymbolMatch infer
 // the column from the line and symbol name.
 func NewSymbolMatch(file *File, lineNumber, character int, name, kind, parent, parentKind, language, line string, fileLimited bool) *SymbolMatch {
@@ -235,8 +262,66 @@ func pick(symbols []*SymbolMatch, satisfy func(*SymbolMatch) bool) []*SymbolMatc
 	return result
 }
 
+// SelectKind returns the symbol selector kind (e.g. "function" for the
+// select:symbol.function and symbol.kind:function filters) of the symbol.
+func (s Symbol) SelectKind() string {
+	return toSelectKind[strings.ToLower(s.Kind)]
+}
+
 func SelectSymbolKind(symbols []*SymbolMatch, field string) []*SymbolMatch {
 	return pick(symbols, func(s *SymbolMatch) bool {
-		return field == toSelectKind[strings.ToLower(s.Symbol.Kind)]
+		return field == s.Symbol.SelectKind()
 	})
 }
+
+// SymbolKindFilter selects symbols by their selector kind, as specified by the
+// symbol.kind: filter. The zero value matches all symbols.
+type SymbolKindFilter struct {
+	// Include, if non-empty, lists the kinds of which a symbol must have one.
+	Include []string
+	// Exclude lists the kinds that a symbol must not have.
+	Exclude []string
+}
+
+// IsEmpty returns true if the filter matches all symbols.
+func (f SymbolKindFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// Matches returns true if the selector kind of the symbol is included and not
+// excluded by the filter.
+func (f SymbolKindFilter) Matches(s Symbol) bool {
+	kind := s.SelectKind()
+	for _, excluded := range f.Exclude {
+		if kind == excluded {
+			return false
+		}
+	}
+
+	if len(f.Include) == 0 {
+		return true
+	}
+	for _, included := range f.Include {
+		if kind == included {
+			return true
+		}
+	}
+	return false
+}
+
+// KindsForSelectKinds returns the lowercase symbol kinds (cf. Symbol.Kind) that
+// map to one of the given selector kinds, in sorted order. Backends that store
+// symbol kinds use it to filter symbols by selector kind in their queries.
+func KindsForSelectKinds(selectKinds []string) []string {
+	var kinds []string
+	for kind, selectKind := range toSelectKind {
+		for _, want := range selectKinds {
+			if selectKind == want {
+				kinds = append(kinds, kind)
+				break
+			}
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
@@ -53,3 +53,32 @@ func TestSymbolURL(t *testing.T) {
 		})
 	}
 }
+
+func TestSymbolKindFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  SymbolKindFilter
+		kind    string
+		matches bool
+	}{
+		{name: "empty", kind: "struct", matches: true},
+		{name: "included", filter: SymbolKindFilter{Include: []string{"function"}}, kind: "func", matches: true},
+		{name: "not included", filter: SymbolKindFilter{Include: []string{"function"}}, kind: "struct", matches: false},
+		{name: "included by any", filter: SymbolKindFilter{Include: []string{"function", "method"}}, kind: "method", matches: true},
+		{name: "excluded", filter: SymbolKindFilter{Exclude: []string{"variable", "constant"}}, kind: "const", matches: false},
+		{name: "not excluded", filter: SymbolKindFilter{Exclude: []string{"variable", "constant"}}, kind: "func", matches: true},
+		{name: "included and excluded", filter: SymbolKindFilter{Include: []string{"class"}, Exclude: []string{"class"}}, kind: "class", matches: false},
+		{name: "unknown kind", filter: SymbolKindFilter{Include: []string{"function"}}, kind: "unknown", matches: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.matches, tc.filter.Matches(Symbol{Name: "foo", Kind: tc.kind}))
+		})
+	}
+}
+
+func TestKindsForSelectKinds(t *testing.T) {
+	require.Equal(t, []string{"alias", "const", "constant", "define", "functionvar", "val", "var", "variable"}, KindsForSelectKinds([]string{"variable", "constant"}))
+	require.Empty(t, KindsForSelectKinds([]string{"unknown"}))
+}
@@ -2,7 +2,6 @@ package searcher
 
 import (
 	"context"
-	"sort"
 
 	"github.com/sourcegraph/conc/pool"
 	"go.opentelemetry.io/otel/attribute"
@@ -16,12 +15,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/symbols"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
-	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
 type SymbolSearchJob struct {
 	PatternInfo *search.TextPatternInfo
 	Repos       []*search.RepositoryRevisions // the set of repositories to search with searcher.
+	Kinds       result.SymbolKindFilter       // the kinds of symbols to search for (cf. symbol.kind:).
 	Limit       int
 }
 
@@ -46,7 +45,7 @@ func (s *SymbolSearchJob) Run(ctx context.Context, clients job.RuntimeClients, s
 		}
 
 		p.Go(func(ctx context.Context) error {
-			matches, err := searchInRepo(ctx, clients.Gitserver, repoRevs, s.PatternInfo, s.Limit)
+			matches, err := searchInRepo(ctx, clients.Gitserver, repoRevs, s.PatternInfo, s.Kinds, s.Limit)
 			status, limitHit, err := search.HandleRepoSearchResult(repoRevs.Repo.ID, repoRevs.Revs, len(matches) > s.Limit, false, err)
 			stream.Send(streaming.SearchEvent{
 				Results: matches,
@@ -79,6 +78,12 @@ func (s *SymbolSearchJob) Attributes(v job.Verbosity) (res []attribute.KeyValue)
 			attribute.Int("numRepos", len(s.Repos)),
 			attribute.Int("limit", s.Limit),
 		)
+		if !s.Kinds.IsEmpty() {
+			res = append(res,
+				attribute.StringSlice("includeKinds", s.Kinds.Include),
+				attribute.StringSlice("excludeKinds", s.Kinds.Exclude),
+			)
+		}
 	}
 	return res
 }
@@ -86,7 +91,7 @@ func (s *SymbolSearchJob) Attributes(v job.Verbosity) (res []attribute.KeyValue)
 func (s *SymbolSearchJob) Children() []job.Describer       { return nil }
 func (s *SymbolSearchJob) MapChildren(job.MapFunc) job.Job { return s }
 
-func searchInRepo(ctx context.Context, gitserverClient gitserver.Client, repoRevs *search.RepositoryRevisions, patternInfo *search.TextPatternInfo, limit int) (res []result.Match, err error) {
+func searchInRepo(ctx context.Context, gitserverClient gitserver.Client, repoRevs *search.RepositoryRevisions, patternInfo *search.TextPatternInfo, kinds result.SymbolKindFilter, limit int) (res []result.Match, err error) {
 	inputRev := repoRevs.Revs[0]
 	tr, ctx := trace.New(ctx, "symbols.searchInRepo",
 		repoRevs.Repo.Name.Attr(),
@@ -103,7 +108,7 @@ func searchInRepo(ctx context.Context, gitserverClient gitserver.Client, repoRev
 	}
 	tr.SetAttributes(commitID.Attr())
 
-	symbols, err := symbols.DefaultClient.Search(ctx, search.SymbolsParameters{
+	params := search.SymbolsParameters{
 		Repo:            repoRevs.Repo.Name,
 		CommitID:        commitID,
 		Query:           patternInfo.Pattern,
@@ -111,54 +116,66 @@ func searchInRepo(ctx context.Context, gitserverClient gitserver.Client, repoRev
 		IsRegExp:        patternInfo.IsRegExp,
 		IncludePatterns: patternInfo.IncludePatterns,
 		ExcludePattern:  patternInfo.ExcludePattern,
+		Kinds:           kinds,
 		// Ask for limit + 1 so we can detect whether there are more results than the limit.
 		First: limit + 1,
-	})
+	}
+
+	syms, err := searchSymbols(ctx, tr, repoRevs.Repo.ID, params)
 	if err != nil {
 		return nil, err
 	}
 
-	for i := range symbols {
-		symbols[i].Line += 1 // callers expect 1-indexed lines
+	for i := range syms {
+		syms[i].Line += 1 // callers expect 1-indexed lines
 	}
 
 	// All symbols are from the same repo, so we can just partition them by path
 	// to build file matches
-	return symbolsToMatches(symbols, repoRevs.Repo, commitID, inputRev), err
+	return symbols.ToMatches(syms, repoRevs.Repo, commitID, inputRev), err
 }
 
-func symbolsToMatches(symbols []result.Symbol, repo types.MinimalRepo, commitID api.CommitID, inputRev string) result.Matches {
-	symbolsByPath := make(map[string][]result.Symbol)
-	for _, symbol := range symbols {
-		cur := symbolsByPath[symbol.Path]
-		symbolsByPath[symbol.Path] = append(cur, symbol)
+// searchSymbols returns the symbols from the precise indexes uploaded for the
+// target commit, merged with the search-based symbols from the symbols service
+// for the paths that these indexes do not analyze. Errors from the precise
+// searcher are recorded but do not fail the search.
+func searchSymbols(ctx context.Context, tr trace.Trace, repoID api.RepoID, params search.SymbolsParameters) (result.Symbols, error) {
+	var preciseSymbols result.Symbols
+	var covered func(path string) bool
+	if preciseSearcher := symbols.DefaultPreciseSearcher; preciseSearcher != nil {
+		var err error
+		preciseSymbols, covered, err = preciseSearcher.Search(ctx, repoID, params)
+		if err != nil {
+			tr.AddEvent("precise symbol search failed", trace.Error(err))
+			preciseSymbols, covered = nil, nil
+		}
 	}
 
-	// Create file matches from partitioned symbols
-	matches := make(result.Matches, 0, len(symbolsByPath))
-	for path, symbols := range symbolsByPath {
-		file := result.File{
-			Path:     path,
-			Repo:     repo,
-			CommitID: commitID,
-			InputRev: &inputRev,
-		}
+	searchBasedSymbols, err := symbols.DefaultClient.Search(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if covered == nil {
+		return searchBasedSymbols, nil
+	}
 
-		symbolMatches := make([]*result.SymbolMatch, 0, len(symbols))
-		for _, symbol := range symbols {
-			symbolMatches = append(symbolMatches, &result.SymbolMatch{
-				File:   &file,
-				Symbol: symbol,
-			})
-		}
+	tr.SetAttributes(attribute.Bool("precise", true), attribute.Int("numPreciseSymbols", len(preciseSymbols)))
+	return mergeSymbols(preciseSymbols, searchBasedSymbols, covered, params.First), nil
+}
 
-		matches = append(matches, &result.FileMatch{
-			Symbols: symbolMatches,
-			File:    file,
-		})
+// mergeSymbols returns the precise symbols followed by the search-based symbols
+// of the paths that are not covered by a precise index, truncated to limit.
+func mergeSymbols(precise, searchBased result.Symbols, covered func(path string) bool, limit int) result.Symbols {
+	merged := make(result.Symbols, 0, len(precise)+len(searchBased))
+	merged = append(merged, precise...)
+	for _, symbol := range searchBased {
+		if !covered(symbol.Path) {
+			merged = append(merged, symbol)
+		}
 	}
 
-	// Make the results deterministic
-	sort.Sort(matches)
-	return matches
+	if limit > 0 && len(merged) > limit {
+		merged = merged[:limit]
+	}
+	return merged
 }
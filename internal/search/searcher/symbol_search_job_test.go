@@ -6,45 +6,25 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
-	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
-func Test_symbolsToMatches(t *testing.T) {
-	type fileType struct {
-		Path    string
-		Symbols []string
+func Test_mergeSymbols(t *testing.T) {
+	precise := result.Symbols{
+		{Name: "queryLSIF", Path: "src/lsif/api.ts", Kind: "function", Provenance: result.SymbolProvenancePrecise},
 	}
-
-	fixture := []fileType{
-		{Path: "path1", Symbols: []string{"sym1"}},
-		{Path: "path2", Symbols: []string{"sym1", "sym2"}},
+	searchBased := result.Symbols{
+		{Name: "queryLSIF", Path: "src/lsif/api.ts", Kind: "function"},
+		{Name: "build", Path: "Makefile", Kind: "target"},
+		{Name: "main", Path: "cmd/main.go", Kind: "function"},
 	}
+	covered := func(path string) bool { return path == "src/lsif/api.ts" }
 
-	input := []result.Symbol{}
-	for _, file := range fixture {
-		for _, symbol := range file.Symbols {
-			input = append(input, result.Symbol{Path: file.Path, Name: symbol})
-		}
+	want := result.Symbols{precise[0], searchBased[1], searchBased[2]}
+	if diff := cmp.Diff(want, mergeSymbols(precise, searchBased, covered, 10)); diff != "" {
+		t.Errorf("unexpected symbols (-want +got):\n%s", diff)
 	}
 
-	output := symbolsToMatches(input, types.MinimalRepo{Name: "somerepo"}, "abcdef", "abcdef")
-
-	got := []fileType{}
-	for _, match := range output {
-		fileMatch := match.(*result.FileMatch)
-		symbols := []string{}
-		for _, symbol := range fileMatch.Symbols {
-			symbols = append(symbols, symbol.Symbol.Name)
-		}
-		got = append(got, fileType{
-			Path:    fileMatch.Path,
-			Symbols: symbols,
-		})
-	}
-
-	want := fixture
-
-	if diff := cmp.Diff(got, want); diff != "" {
-		t.Errorf("symbolsToMatches() returned diff (-got +want):\n%s", diff)
+	if diff := cmp.Diff(want[:2], mergeSymbols(precise, searchBased, covered, 2)); diff != "" {
+		t.Errorf("unexpected symbols with limit (-want +got):\n%s", diff)
 	}
 }
@@ -132,11 +132,13 @@ type EventSymbolMatch struct {
 func (e *EventSymbolMatch) eventMatch() {}
 
 type Symbol struct {
-	URL           string `json:"url"`
-	Name          string `json:"name"`
-	ContainerName string `json:"containerName"`
-	Kind          string `json:"kind"`
-	Line          int32  `json:"line"`
+	URL                string `json:"url"`
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+	ContainerName      string `json:"containerName"`
+	Kind               string `json:"kind"`
+	Line               int32  `json:"line"`
+	Provenance         string `json:"provenance,omitempty"`
 }
 
 // EventCommitMatch is the generic results interface from GQL. There is a lot
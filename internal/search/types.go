@@ -126,6 +126,9 @@ type SymbolsParameters struct {
 	// need to match to get included in the result
 	ExcludePattern string
 
+	// Kinds selects the symbols to return by their kind (cf. symbol.kind:).
+	Kinds result.SymbolKindFilter
+
 	// First indicates that only the first n symbols should be returned.
 	First int
 
@@ -194,6 +197,11 @@ type ZoektParameters struct {
 	FileMatchLimit int32
 	Select         filter.SelectPath
 
+	// SymbolKinds selects the symbols of symbol requests by their kind (cf.
+	// symbol.kind:). Zoekt has no query for symbol kinds, so the symbols are
+	// filtered as the results are streamed back.
+	SymbolKinds result.SymbolKindFilter
+
 	// Features are feature flags that can affect behaviour of searcher.
 	Features Features
 
@@ -478,6 +486,8 @@ type RepoOptions struct {
 	HasFileContent []query.RepoHasFileContentArgs
 	HasKVPs        []query.RepoKVPFilter
 	HasTopics      []query.RepoHasTopicPredicate
+	HasLanguages   []query.RepoHasLanguagePredicate
+	HasSizes       []query.RepoHasSizePredicate
 
 	// ForkSet indicates whether `fork:` was set explicitly in the query,
 	// or whether the values were set from defaults.
@@ -573,6 +583,30 @@ func (op *RepoOptions) Attributes() []attribute.KeyValue {
 			add(trace.Scoped(fmt.Sprintf("hasTopics[%d]", i), nondefault...)...)
 		}
 	}
+	if len(op.HasLanguages) > 0 {
+		for i, arg := range op.HasLanguages {
+			nondefault := []attribute.KeyValue{}
+			if arg.Language != "" {
+				nondefault = append(nondefault, attribute.String("language", arg.Language))
+			}
+			if arg.Negated {
+				nondefault = append(nondefault, attribute.Bool("negated", arg.Negated))
+			}
+			add(trace.Scoped(fmt.Sprintf("hasLanguages[%d]", i), nondefault...)...)
+		}
+	}
+	if len(op.HasSizes) > 0 {
+		for i, arg := range op.HasSizes {
+			nondefault := []attribute.KeyValue{
+				attribute.String("comparator", arg.Comparator),
+				attribute.Int64("bytes", int64(arg.Bytes)),
+			}
+			if arg.Negated {
+				nondefault = append(nondefault, attribute.Bool("negated", arg.Negated))
+			}
+			add(trace.Scoped(fmt.Sprintf("hasSizes[%d]", i), nondefault...)...)
+		}
+	}
 	if op.ForkSet {
 		add(attribute.Bool("forkSet", op.ForkSet))
 	}
@@ -659,6 +693,24 @@ func (op *RepoOptions) String() string {
 			}
 		}
 	}
+	if len(op.HasLanguages) > 0 {
+		for i, arg := range op.HasLanguages {
+			if arg.Language != "" {
+				fmt.Fprintf(&b, "HasLanguages[%d].language: %s\n", i, arg.Language)
+			}
+			if arg.Negated {
+				fmt.Fprintf(&b, "HasLanguages[%d].negated: %t\n", i, arg.Negated)
+			}
+		}
+	}
+	if len(op.HasSizes) > 0 {
+		for i, arg := range op.HasSizes {
+			fmt.Fprintf(&b, "HasSizes[%d].size: %s %d\n", i, arg.Comparator, arg.Bytes)
+			if arg.Negated {
+				fmt.Fprintf(&b, "HasSizes[%d].negated: %t\n", i, arg.Negated)
+			}
+		}
+	}
 
 	if op.CaseSensitiveRepoFilters {
 		fmt.Fprintf(&b, "CaseSensitiveRepoFilters: %t\n", op.CaseSensitiveRepoFilters)
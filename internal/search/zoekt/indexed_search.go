@@ -298,7 +298,7 @@ func DoZoektSearchGlobal(ctx context.Context, client zoekt.Streamer, params *sea
 				Name: api.RepoName(file.Repository),
 			}
 			return repo, []string{""}
-		}, params.Typ, params.Select, c)
+		}, params.Typ, params.Select, params.SymbolKinds, c)
 	}))
 }
 
@@ -336,7 +336,7 @@ func zoektSearch(ctx context.Context, repos *IndexedRepoRevs, q zoektquery.Q, pa
 	foundResults := atomic.Bool{}
 	err := client.StreamSearch(ctx, finalQuery, searchOpts, backend.ZoektStreamFunc(func(event *zoekt.SearchResult) {
 		foundResults.CompareAndSwap(false, event.FileCount != 0 || event.MatchCount != 0)
-		sendMatches(event, pathRegexps, repos.getRepoInputRev, typ, zoektParams.Select, c)
+		sendMatches(event, pathRegexps, repos.getRepoInputRev, typ, zoektParams.Select, zoektParams.SymbolKinds, c)
 	}))
 	if err != nil {
 		return err
@@ -356,7 +356,7 @@ func zoektSearch(ctx context.Context, repos *IndexedRepoRevs, q zoektquery.Q, pa
 	return nil
 }
 
-func sendMatches(event *zoekt.SearchResult, pathRegexps []*regexp.Regexp, getRepoInputRev repoRevFunc, typ search.IndexedRequestType, selector filter.SelectPath, c streaming.Sender) {
+func sendMatches(event *zoekt.SearchResult, pathRegexps []*regexp.Regexp, getRepoInputRev repoRevFunc, typ search.IndexedRequestType, selector filter.SelectPath, symbolKinds result.SymbolKindFilter, c streaming.Sender) {
 	files := event.Files
 	stats := streaming.Stats{
 		// In the case of Zoekt the only time we get non-zero Crashes in
@@ -410,6 +410,12 @@ func sendMatches(event *zoekt.SearchResult, pathRegexps []*regexp.Regexp, getRep
 			var symbols []*result.SymbolMatch
 			if typ == search.SymbolRequest {
 				symbols = zoektFileMatchToSymbolResults(repo, inputRev, &file)
+				if !symbolKinds.IsEmpty() {
+					symbols = filterSymbolKinds(symbols, symbolKinds)
+					if len(symbols) == 0 {
+						continue
+					}
+				}
 			}
 			fm := result.FileMatch{
 				ChunkMatches: hms,
@@ -593,6 +599,17 @@ func zoektFileMatchToSymbolResults(repoName types.MinimalRepo, inputRev string,
 	return symbols
 }
 
+// filterSymbolKinds returns the symbols matching the given kind filter.
+func filterSymbolKinds(symbols []*result.SymbolMatch, kinds result.SymbolKindFilter) []*result.SymbolMatch {
+	filtered := symbols[:0]
+	for _, symbol := range symbols {
+		if kinds.Matches(symbol.Symbol) {
+			filtered = append(filtered, symbol)
+		}
+	}
+	return filtered
+}
+
 // contextWithoutDeadline returns a context which will cancel if the cOld is
 // canceled.
 func contextWithoutDeadline(cOld context.Context) (context.Context, context.CancelFunc) {
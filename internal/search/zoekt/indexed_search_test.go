@@ -638,6 +638,26 @@ func TestZoektFileMatchToSymbolResults(t *testing.T) {
 	}
 }
 
+func TestFilterSymbolKinds(t *testing.T) {
+	symbols := []*result.SymbolMatch{
+		{Symbol: result.Symbol{Name: "a", Kind: "function"}},
+		{Symbol: result.Symbol{Name: "b", Kind: "struct"}},
+		{Symbol: result.Symbol{Name: "c", Kind: "method"}},
+	}
+
+	names := func(kinds result.SymbolKindFilter) []string {
+		var names []string
+		for _, symbol := range filterSymbolKinds(append([]*result.SymbolMatch(nil), symbols...), kinds) {
+			names = append(names, symbol.Symbol.Name)
+		}
+		return names
+	}
+
+	require.Equal(t, []string{"a"}, names(result.SymbolKindFilter{Include: []string{"function"}}))
+	require.Equal(t, []string{"a", "c"}, names(result.SymbolKindFilter{Exclude: []string{"struct"}}))
+	require.Empty(t, names(result.SymbolKindFilter{Include: []string{"class"}}))
+}
+
 func repoRevsSliceToMap(rs []*search.RepositoryRevisions) map[api.RepoID]*search.RepositoryRevisions {
 	m := map[api.RepoID]*search.RepositoryRevisions{}
 	for _, r := range rs {
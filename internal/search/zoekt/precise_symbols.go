@@ -0,0 +1,113 @@
+package zoekt
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/symbols"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+)
+
+// newPreciseSymbolsStream returns a stream that replaces the symbols found by
+// Zoekt with the symbols defined by precise indexes. The first time a revision
+// of a repository is seen in the results, the precise indexes uploaded for it
+// are searched with params. If there are any, their symbols are sent, and the
+// Zoekt results of the paths analyzed by these indexes are dropped. Errors from
+// the precise searcher are recorded but do not fail the search.
+//
+// parent is returned as is if params is nil or precise symbol search is
+// disabled.
+func newPreciseSymbolsStream(ctx context.Context, tr trace.Trace, params *search.SymbolsParameters, parent streaming.Sender) streaming.Sender {
+	searcher := symbols.DefaultPreciseSearcher
+	if params == nil || searcher == nil {
+		return parent
+	}
+
+	return &preciseSymbolsStream{
+		ctx:      ctx,
+		tr:       tr,
+		searcher: searcher,
+		params:   *params,
+		parent:   parent,
+		covered:  map[repoCommit]func(path string) bool{},
+	}
+}
+
+type repoCommit struct {
+	repoID   api.RepoID
+	commitID api.CommitID
+}
+
+type preciseSymbolsStream struct {
+	ctx      context.Context
+	tr       trace.Trace
+	searcher symbols.PreciseSearcher
+	params   search.SymbolsParameters
+	parent   streaming.Sender
+
+	// mu protects covered and serializes the precise searches, so that every
+	// revision is searched once.
+	mu sync.Mutex
+	// covered maps the revisions seen so far to the paths analyzed by their
+	// precise indexes, or to nil if they have none.
+	covered map[repoCommit]func(path string) bool
+}
+
+func (s *preciseSymbolsStream) Send(event streaming.SearchEvent) {
+	results := make(result.Matches, 0, len(event.Results))
+	for _, res := range event.Results {
+		if fm, ok := res.(*result.FileMatch); ok {
+			if covered := s.coveredPaths(fm); covered != nil && covered(fm.Path) {
+				continue
+			}
+		}
+		results = append(results, res)
+	}
+
+	event.Results = results
+	s.parent.Send(event)
+}
+
+// coveredPaths returns the paths analyzed by the precise indexes of the
+// revision of the given file match, sending the precise symbols of the
+// revision when it is first seen.
+func (s *preciseSymbolsStream) coveredPaths(fm *result.FileMatch) func(path string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := repoCommit{repoID: fm.Repo.ID, commitID: fm.CommitID}
+	if covered, ok := s.covered[key]; ok {
+		return covered
+	}
+
+	params := s.params
+	params.Repo = fm.Repo.Name
+	params.CommitID = fm.CommitID
+
+	syms, covered, err := s.searcher.Search(s.ctx, fm.Repo.ID, params)
+	if err != nil {
+		s.tr.AddEvent("precise symbol search failed", fm.Repo.Name.Attr(), trace.Error(err))
+		syms, covered = nil, nil
+	}
+	s.covered[key] = covered
+
+	if len(syms) > 0 {
+		for i := range syms {
+			syms[i].Line += 1 // callers expect 1-indexed lines
+		}
+
+		var inputRev string
+		if fm.InputRev != nil {
+			inputRev = *fm.InputRev
+		}
+		s.parent.Send(streaming.SearchEvent{
+			Results: symbols.ToMatches(syms, fm.Repo, fm.CommitID, inputRev),
+		})
+	}
+
+	return covered
+}
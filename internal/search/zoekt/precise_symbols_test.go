@@ -0,0 +1,99 @@
+package zoekt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/symbols"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type fakePreciseSearcher struct {
+	searches []search.SymbolsParameters
+}
+
+func (f *fakePreciseSearcher) Search(_ context.Context, repoID api.RepoID, args search.SymbolsParameters) (result.Symbols, func(path string) bool, error) {
+	f.searches = append(f.searches, args)
+	switch repoID {
+	case 1:
+		symbols := result.Symbols{{Name: "Foo", Path: "a.go", Kind: "function", Provenance: result.SymbolProvenancePrecise}}
+		return symbols, func(path string) bool { return path == "a.go" }, nil
+	case 3:
+		return nil, nil, errors.New("boom")
+	}
+	return nil, nil, nil
+}
+
+func TestPreciseSymbolsStream(t *testing.T) {
+	searcher := &fakePreciseSearcher{}
+	symbols.DefaultPreciseSearcher = searcher
+	t.Cleanup(func() { symbols.DefaultPreciseSearcher = nil })
+
+	fileMatch := func(repoID api.RepoID, path string, symbolNames ...string) *result.FileMatch {
+		inputRev := "main"
+		fm := &result.FileMatch{File: result.File{
+			Repo:     types.MinimalRepo{ID: repoID, Name: api.RepoName("repo")},
+			CommitID: "deadbeef",
+			InputRev: &inputRev,
+			Path:     path,
+		}}
+		for _, name := range symbolNames {
+			fm.Symbols = append(fm.Symbols, &result.SymbolMatch{File: &fm.File, Symbol: result.Symbol{Name: name, Path: path}})
+		}
+		return fm
+	}
+
+	var sent []string
+	parent := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		for _, match := range event.Results {
+			fm := match.(*result.FileMatch)
+			for _, symbol := range fm.Symbols {
+				sent = append(sent, string(symbol.Symbol.Provenance)+":"+fm.Path+":"+symbol.Symbol.Name)
+			}
+		}
+	})
+
+	params := &search.SymbolsParameters{Query: "Foo", Kinds: result.SymbolKindFilter{Include: []string{"function"}}}
+	stream := newPreciseSymbolsStream(context.Background(), trace.FromContext(context.Background()), params, parent)
+
+	stream.Send(streaming.SearchEvent{Results: result.Matches{
+		fileMatch(1, "a.go", "Foo"),
+		fileMatch(1, "b.sh", "foo"),
+		fileMatch(2, "a.go", "Foo"),
+	}})
+	stream.Send(streaming.SearchEvent{Results: result.Matches{
+		fileMatch(1, "a.go", "FooBar"),
+		fileMatch(3, "c.go", "Foo"),
+	}})
+
+	require.Equal(t, []string{
+		"precise:a.go:Foo",
+		":b.sh:foo",
+		":a.go:Foo",
+		":c.go:Foo",
+	}, sent)
+
+	// Every revision is searched once, with the parameters of the stream.
+	require.Len(t, searcher.searches, 3)
+	require.Equal(t, api.RepoName("repo"), searcher.searches[0].Repo)
+	require.Equal(t, api.CommitID("deadbeef"), searcher.searches[0].CommitID)
+	require.Equal(t, "Foo", searcher.searches[0].Query)
+	require.Equal(t, []string{"function"}, searcher.searches[0].Kinds.Include)
+}
+
+func TestPreciseSymbolsStream_Disabled(t *testing.T) {
+	parent := streaming.NewAggregatingStream()
+	require.Equal(t, streaming.Sender(parent), newPreciseSymbolsStream(context.Background(), trace.FromContext(context.Background()), &search.SymbolsParameters{}, parent))
+
+	symbols.DefaultPreciseSearcher = &fakePreciseSearcher{}
+	t.Cleanup(func() { symbols.DefaultPreciseSearcher = nil })
+	require.Equal(t, streaming.Sender(parent), newPreciseSymbolsStream(context.Background(), trace.FromContext(context.Background()), nil, parent))
+}
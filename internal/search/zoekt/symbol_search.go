@@ -18,6 +18,11 @@ type SymbolSearchJob struct {
 	Query       zoektquery.Q
 	ZoektParams *search.ZoektParameters
 	Since       func(time.Time) time.Duration `json:"-"` // since if non-nil will be used instead of time.Since. For tests
+
+	// PreciseSymbols, if non-nil, are the parameters of the search for the symbols of
+	// precise indexes, which replace the symbols found by Zoekt in the paths they
+	// analyze. Repo and CommitID are set per indexed revision.
+	PreciseSymbols *search.SymbolsParameters
 }
 
 // Run calls the zoekt backend to search symbols
@@ -40,6 +45,7 @@ func (z *SymbolSearchJob) Run(ctx context.Context, clients job.RuntimeClients, s
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	stream = newPreciseSymbolsStream(ctx, tr, z.PreciseSymbols, stream)
 	err = zoektSearch(ctx, z.Repos, z.Query, nil, search.SymbolRequest, clients.Zoekt, z.ZoektParams, since, stream)
 	if err != nil {
 		tr.SetAttributes(trace.Error(err))
@@ -86,6 +92,10 @@ type GlobalSymbolSearchJob struct {
 	GlobalZoektQuery *GlobalZoektQuery
 	ZoektParams      *search.ZoektParameters
 	RepoOpts         search.RepoOptions
+
+	// PreciseSymbols, if non-nil, are the parameters of the search for the symbols of
+	// precise indexes (cf. SymbolSearchJob.PreciseSymbols).
+	PreciseSymbols *search.SymbolsParameters
 }
 
 func (s *GlobalSymbolSearchJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
@@ -97,6 +107,7 @@ func (s *GlobalSymbolSearchJob) Run(ctx context.Context, clients job.RuntimeClie
 	s.ZoektParams.Query = s.GlobalZoektQuery.Generate()
 
 	// always search for symbols in indexed repositories when searching the repo universe.
+	stream = newPreciseSymbolsStream(ctx, tr, s.PreciseSymbols, stream)
 	err = DoZoektSearchGlobal(ctx, clients.Zoekt, s.ZoektParams, nil, stream)
 	if err != nil {
 		tr.SetAttributes(trace.Error(err))
@@ -0,0 +1,48 @@
+package symbols
+
+import (
+	"sort"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// ToMatches converts the symbols found in a revision of a repository into file
+// matches, one per path. Callers are expected to have made the lines of the
+// symbols 1-indexed.
+func ToMatches(symbols []result.Symbol, repo types.MinimalRepo, commitID api.CommitID, inputRev string) result.Matches {
+	symbolsByPath := make(map[string][]result.Symbol)
+	for _, symbol := range symbols {
+		cur := symbolsByPath[symbol.Path]
+		symbolsByPath[symbol.Path] = append(cur, symbol)
+	}
+
+	// Create file matches from partitioned symbols
+	matches := make(result.Matches, 0, len(symbolsByPath))
+	for path, symbols := range symbolsByPath {
+		file := result.File{
+			Path:     path,
+			Repo:     repo,
+			CommitID: commitID,
+			InputRev: &inputRev,
+		}
+
+		symbolMatches := make([]*result.SymbolMatch, 0, len(symbols))
+		for _, symbol := range symbols {
+			symbolMatches = append(symbolMatches, &result.SymbolMatch{
+				File:   &file,
+				Symbol: symbol,
+			})
+		}
+
+		matches = append(matches, &result.FileMatch{
+			Symbols: symbolMatches,
+			File:    file,
+		})
+	}
+
+	// Make the results deterministic
+	sort.Sort(matches)
+	return matches
+}
@@ -0,0 +1,25 @@
+package symbols
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// PreciseSearcher searches the symbols defined in precise code intelligence
+// (SCIP) indexes.
+type PreciseSearcher interface {
+	// Search returns the symbols matching args that are defined by the precise
+	// indexes uploaded for exactly args.CommitID. Lines are 0-indexed, as with
+	// Client.Search. The returned function reports whether a path is analyzed by
+	// one of these indexes, and is nil when there is no such index. Callers
+	// should use DefaultClient for the paths that are not covered.
+	Search(ctx context.Context, repoID api.RepoID, args search.SymbolsParameters) (_ result.Symbols, covered func(path string) bool, err error)
+}
+
+// DefaultPreciseSearcher is the PreciseSearcher used by symbol search. It is
+// nil, which disables precise symbol search, unless set by the frontend's code
+// intelligence initialization.
+var DefaultPreciseSearcher PreciseSearcher
@@ -19,6 +19,8 @@ func (x *SearchRequest) FromInternal(p *search.SymbolsParameters) {
 		IsCaseSensitive: p.IsCaseSensitive,
 		IncludePatterns: p.IncludePatterns,
 		ExcludePattern:  p.ExcludePattern,
+		IncludeKinds:    p.Kinds.Include,
+		ExcludeKinds:    p.Kinds.Exclude,
 
 		First:   int32(p.First),
 		Timeout: durationpb.New(p.Timeout),
@@ -34,8 +36,12 @@ func (x *SearchRequest) ToInternal() search.SymbolsParameters {
 		IsCaseSensitive: x.GetIsCaseSensitive(),
 		IncludePatterns: x.GetIncludePatterns(),
 		ExcludePattern:  x.GetExcludePattern(),
-		First:           int(x.GetFirst()),
-		Timeout:         x.GetTimeout().AsDuration(),
+		Kinds: result.SymbolKindFilter{
+			Include: x.GetIncludeKinds(),
+			Exclude: x.GetExcludeKinds(),
+		},
+		First:   int(x.GetFirst()),
+		Timeout: x.GetTimeout().AsDuration(),
 	}
 }
 
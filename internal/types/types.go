@@ -109,6 +109,16 @@ type RepoCommit struct {
 	CreatedAt            time.Time
 }
 
+// RepoCommitHgChangeset is a record of a repo, a commit and the Mercurial
+// changeset the commit was converted from.
+type RepoCommitHgChangeset struct {
+	ID            int64
+	RepoID        api.RepoID
+	CommitSHA     dbutil.CommitBytea
+	HgChangesetID string
+	CreatedAt     time.Time
+}
+
 // SearchedRepo is a collection of metadata about repos that is used to decorate search results
 type SearchedRepo struct {
 	// ID is the unique numeric ID for this repository.
@@ -604,6 +614,29 @@ type GitserverRepo struct {
 	CorruptionLogs []RepoCorruptionLog
 }
 
+// GitserverRepoPushMirror is the status of pushing a repo to one of its push
+// mirrors, the secondary remotes that gitserver pushes repos to after every
+// update.
+type GitserverRepoPushMirror struct {
+	RepoID   api.RepoID
+	RepoName api.RepoName
+	// The name of the push mirror in the site configuration.
+	RemoteName string
+	// The time at which the last successful push started, or zero if the repo
+	// was never pushed successfully. The push mirror has all changes that were
+	// fetched before.
+	LastPushedAt time.Time
+	// The time of the last push attempt.
+	LastAttemptAt time.Time
+	// The error of the last push attempt or empty if it was successful.
+	LastError string
+	// The number of consecutive failed push attempts.
+	Failures int
+	// The time at which the failed push is retried, or zero if the last push
+	// was successful.
+	NextAttemptAt time.Time
+}
+
 // RepoCorruptionLog represents a corruption event that has been detected on a repo.
 type RepoCorruptionLog struct {
 	// When the corruption event was detected
@@ -2083,6 +2116,11 @@ type PerforceChangelist struct {
 	ChangelistID int64
 }
 
+type HgChangeset struct {
+	CommitSHA   api.CommitID
+	ChangesetID string
+}
+
 // CodeHost represents a signle code source, usually defined by url e.g. github.com, gitlab.com, bitbucket.sgdev.org.
 type CodeHost struct {
 	ID                          int32
@@ -48,3 +48,47 @@ func InitPrometheusMetric[T workerutil.Record](observationCtx *observation.Conte
 		return float64(age) / float64(time.Second)
 	}))
 }
+
+// InitTenantPrometheusMetric registers a gauge of the number of queued records per tenant, as given by
+// the `FairShareExpression` of the store. This shows which users or namespaces are consuming the workers.
+func InitTenantPrometheusMetric[T workerutil.Record](observationCtx *observation.Context, workerStore store.Store[T], team, resource string, constLabels prometheus.Labels) {
+	teamAndResource := resource
+	if team != "" {
+		teamAndResource = team + "_" + teamAndResource
+	}
+
+	observationCtx.Registerer.MustRegister(&tenantCollector[T]{
+		logger:      observationCtx.Logger.Scoped("InitTenantPrometheusMetric"),
+		workerStore: workerStore,
+		desc: prometheus.NewDesc(
+			fmt.Sprintf("src_%s_tenant_total", teamAndResource),
+			fmt.Sprintf("Total number of %s records in the queued state by tenant.", resource),
+			[]string{"tenant"},
+			constLabels,
+		),
+	})
+}
+
+// tenantCollector collects the queue size of each tenant on scrape, as the set of tenants isn't known
+// upfront.
+type tenantCollector[T workerutil.Record] struct {
+	logger      log.Logger
+	workerStore store.Store[T]
+	desc        *prometheus.Desc
+}
+
+func (c *tenantCollector[T]) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *tenantCollector[T]) Collect(ch chan<- prometheus.Metric) {
+	counts, err := c.workerStore.QueuedCountByTenant(context.Background(), false)
+	if err != nil {
+		c.logger.Error("Failed to determine queue size by tenant", log.Error(err))
+		return
+	}
+
+	for tenant, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), tenant)
+	}
+}
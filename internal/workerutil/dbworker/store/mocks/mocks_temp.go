@@ -29,6 +29,9 @@ type MockStore[T workerutil.Record] struct {
 	// DequeueFunc is an instance of a mock function object controlling the
 	// behavior of the method Dequeue.
 	DequeueFunc *StoreDequeueFunc[T]
+	// ExecutionLogsWritableFunc is an instance of a mock function object
+	// controlling the behavior of the method ExecutionLogsWritable.
+	ExecutionLogsWritableFunc *StoreExecutionLogsWritableFunc[T]
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
 	HandleFunc *StoreHandleFunc[T]
@@ -50,6 +53,9 @@ type MockStore[T workerutil.Record] struct {
 	// QueuedCountFunc is an instance of a mock function object controlling
 	// the behavior of the method QueuedCount.
 	QueuedCountFunc *StoreQueuedCountFunc[T]
+	// QueuedCountByTenantFunc is an instance of a mock function object
+	// controlling the behavior of the method QueuedCountByTenant.
+	QueuedCountByTenantFunc *StoreQueuedCountByTenantFunc[T]
 	// RequeueFunc is an instance of a mock function object controlling the
 	// behavior of the method Requeue.
 	RequeueFunc *StoreRequeueFunc[T]
@@ -78,6 +84,11 @@ func NewMockStore[T workerutil.Record]() *MockStore[T] {
 				return
 			},
 		},
+		ExecutionLogsWritableFunc: &StoreExecutionLogsWritableFunc[T]{
+			defaultHook: func(context.Context, int, store.ExecutionLogEntryOptions) (r0 bool, r1 error) {
+				return
+			},
+		},
 		HandleFunc: &StoreHandleFunc[T]{
 			defaultHook: func() (r0 basestore.TransactableHandle) {
 				return
@@ -113,6 +124,11 @@ func NewMockStore[T workerutil.Record]() *MockStore[T] {
 				return
 			},
 		},
+		QueuedCountByTenantFunc: &StoreQueuedCountByTenantFunc[T]{
+			defaultHook: func(context.Context, bool) (r0 map[string]int, r1 error) {
+				return
+			},
+		},
 		RequeueFunc: &StoreRequeueFunc[T]{
 			defaultHook: func(context.Context, int, time.Time) (r0 error) {
 				return
@@ -150,6 +166,11 @@ func NewStrictMockStore[T workerutil.Record]() *MockStore[T] {
 				panic("unexpected invocation of MockStore.Dequeue")
 			},
 		},
+		ExecutionLogsWritableFunc: &StoreExecutionLogsWritableFunc[T]{
+			defaultHook: func(context.Context, int, store.ExecutionLogEntryOptions) (bool, error) {
+				panic("unexpected invocation of MockStore.ExecutionLogsWritable")
+			},
+		},
 		HandleFunc: &StoreHandleFunc[T]{
 			defaultHook: func() basestore.TransactableHandle {
 				panic("unexpected invocation of MockStore.Handle")
@@ -185,6 +206,11 @@ func NewStrictMockStore[T workerutil.Record]() *MockStore[T] {
 				panic("unexpected invocation of MockStore.QueuedCount")
 			},
 		},
+		QueuedCountByTenantFunc: &StoreQueuedCountByTenantFunc[T]{
+			defaultHook: func(context.Context, bool) (map[string]int, error) {
+				panic("unexpected invocation of MockStore.QueuedCountByTenant")
+			},
+		},
 		RequeueFunc: &StoreRequeueFunc[T]{
 			defaultHook: func(context.Context, int, time.Time) error {
 				panic("unexpected invocation of MockStore.Requeue")
@@ -218,6 +244,9 @@ func NewMockStoreFrom[T workerutil.Record](i store.Store[T]) *MockStore[T] {
 		DequeueFunc: &StoreDequeueFunc[T]{
 			defaultHook: i.Dequeue,
 		},
+		ExecutionLogsWritableFunc: &StoreExecutionLogsWritableFunc[T]{
+			defaultHook: i.ExecutionLogsWritable,
+		},
 		HandleFunc: &StoreHandleFunc[T]{
 			defaultHook: i.Handle,
 		},
@@ -239,6 +268,9 @@ func NewMockStoreFrom[T workerutil.Record](i store.Store[T]) *MockStore[T] {
 		QueuedCountFunc: &StoreQueuedCountFunc[T]{
 			defaultHook: i.QueuedCount,
 		},
+		QueuedCountByTenantFunc: &StoreQueuedCountByTenantFunc[T]{
+			defaultHook: i.QueuedCountByTenant,
+		},
 		RequeueFunc: &StoreRequeueFunc[T]{
 			defaultHook: i.Requeue,
 		},
@@ -481,6 +513,117 @@ func (c StoreDequeueFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// StoreExecutionLogsWritableFunc describes the behavior when the
+// ExecutionLogsWritable method of the parent MockStore instance is invoked.
+type StoreExecutionLogsWritableFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, int, store.ExecutionLogEntryOptions) (bool, error)
+	hooks       []func(context.Context, int, store.ExecutionLogEntryOptions) (bool, error)
+	history     []StoreExecutionLogsWritableFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// ExecutionLogsWritable delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore[T]) ExecutionLogsWritable(v0 context.Context, v1 int, v2 store.ExecutionLogEntryOptions) (bool, error) {
+	r0, r1 := m.ExecutionLogsWritableFunc.nextHook()(v0, v1, v2)
+	m.ExecutionLogsWritableFunc.appendCall(StoreExecutionLogsWritableFuncCall[T]{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ExecutionLogsWritable method of the parent MockStore instance is invoked
+// and the hook queue is empty.
+func (f *StoreExecutionLogsWritableFunc[T]) SetDefaultHook(hook func(context.Context, int, store.ExecutionLogEntryOptions) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ExecutionLogsWritable method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreExecutionLogsWritableFunc[T]) PushHook(hook func(context.Context, int, store.ExecutionLogEntryOptions) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreExecutionLogsWritableFunc[T]) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, store.ExecutionLogEntryOptions) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreExecutionLogsWritableFunc[T]) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, store.ExecutionLogEntryOptions) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreExecutionLogsWritableFunc[T]) nextHook() func(context.Context, int, store.ExecutionLogEntryOptions) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreExecutionLogsWritableFunc[T]) appendCall(r0 StoreExecutionLogsWritableFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreExecutionLogsWritableFuncCall objects
+// describing the invocations of this function.
+func (f *StoreExecutionLogsWritableFunc[T]) History() []StoreExecutionLogsWritableFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]StoreExecutionLogsWritableFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreExecutionLogsWritableFuncCall is an object that describes an
+// invocation of method ExecutionLogsWritable on an instance of MockStore.
+type StoreExecutionLogsWritableFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 store.ExecutionLogEntryOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreExecutionLogsWritableFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreExecutionLogsWritableFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreHandleFunc describes the behavior when the Handle method of the
 // parent MockStore instance is invoked.
 type StoreHandleFunc[T workerutil.Record] struct {
@@ -1240,6 +1383,114 @@ func (c StoreQueuedCountFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreQueuedCountByTenantFunc describes the behavior when the
+// QueuedCountByTenant method of the parent MockStore instance is invoked.
+type StoreQueuedCountByTenantFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, bool) (map[string]int, error)
+	hooks       []func(context.Context, bool) (map[string]int, error)
+	history     []StoreQueuedCountByTenantFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// QueuedCountByTenant delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore[T]) QueuedCountByTenant(v0 context.Context, v1 bool) (map[string]int, error) {
+	r0, r1 := m.QueuedCountByTenantFunc.nextHook()(v0, v1)
+	m.QueuedCountByTenantFunc.appendCall(StoreQueuedCountByTenantFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the QueuedCountByTenant
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreQueuedCountByTenantFunc[T]) SetDefaultHook(hook func(context.Context, bool) (map[string]int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// QueuedCountByTenant method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreQueuedCountByTenantFunc[T]) PushHook(hook func(context.Context, bool) (map[string]int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreQueuedCountByTenantFunc[T]) SetDefaultReturn(r0 map[string]int, r1 error) {
+	f.SetDefaultHook(func(context.Context, bool) (map[string]int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreQueuedCountByTenantFunc[T]) PushReturn(r0 map[string]int, r1 error) {
+	f.PushHook(func(context.Context, bool) (map[string]int, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreQueuedCountByTenantFunc[T]) nextHook() func(context.Context, bool) (map[string]int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreQueuedCountByTenantFunc[T]) appendCall(r0 StoreQueuedCountByTenantFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreQueuedCountByTenantFuncCall objects
+// describing the invocations of this function.
+func (f *StoreQueuedCountByTenantFunc[T]) History() []StoreQueuedCountByTenantFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]StoreQueuedCountByTenantFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreQueuedCountByTenantFuncCall is an object that describes an
+// invocation of method QueuedCountByTenant on an instance of MockStore.
+type StoreQueuedCountByTenantFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[string]int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreQueuedCountByTenantFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreQueuedCountByTenantFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreRequeueFunc describes the behavior when the Requeue method of the
 // parent MockStore instance is invoked.
 type StoreRequeueFunc[T workerutil.Record] struct {
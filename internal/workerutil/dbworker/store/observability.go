@@ -11,12 +11,14 @@ import (
 type operations struct {
 	addExecutionLogEntry    *observation.Operation
 	dequeue                 *observation.Operation
+	executionLogsWritable   *observation.Operation
 	heartbeat               *observation.Operation
 	markComplete            *observation.Operation
 	markErrored             *observation.Operation
 	markFailed              *observation.Operation
 	maxDurationInQueue      *observation.Operation
 	queuedCount             *observation.Operation
+	queuedCountByTenant     *observation.Operation
 	requeue                 *observation.Operation
 	resetStalled            *observation.Operation
 	updateExecutionLogEntry *observation.Operation
@@ -60,12 +62,14 @@ func newOperations(observationCtx *observation.Context, storeName string) *opera
 	return &operations{
 		addExecutionLogEntry:    op("AddExecutionLogEntry"),
 		dequeue:                 op("Dequeue"),
+		executionLogsWritable:   op("ExecutionLogsWritable"),
 		heartbeat:               op("Heartbeat"),
 		markComplete:            op("MarkComplete"),
 		markErrored:             op("MarkErrored"),
 		markFailed:              op("MarkFailed"),
 		maxDurationInQueue:      op("MaxDurationInQueue"),
 		queuedCount:             op("QueuedCount"),
+		queuedCountByTenant:     op("QueuedCountByTenant"),
 		requeue:                 op("Requeue"),
 		resetStalled:            op("ResetStalled"),
 		updateExecutionLogEntry: op("UpdateExecutionLogEntry"),
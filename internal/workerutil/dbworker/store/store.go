@@ -85,6 +85,11 @@ type Store[T workerutil.Record] interface {
 	// is true it returns the number of queued _and_ processing records.
 	QueuedCount(ctx context.Context, includeProcessing bool) (int, error)
 
+	// QueuedCountByTenant returns the number of queued and errored records grouped by the tenant
+	// given by `FairShareExpression`. If includeProcessing is true processing records are counted
+	// as well. Records of stores without a `FairShareExpression` are counted for the empty tenant.
+	QueuedCountByTenant(ctx context.Context, includeProcessing bool) (map[string]int, error)
+
 	// MaxDurationInQueue returns the maximum age of queued records in this store. Returns 0 if there are no queued records.
 	MaxDurationInQueue(ctx context.Context) (time.Duration, error)
 
@@ -112,6 +117,10 @@ type Store[T workerutil.Record] interface {
 	// found (due to options not matching or the record being deleted), ErrExecutionLogEntryNotUpdated is returned.
 	UpdateExecutionLogEntry(ctx context.Context, recordID, entryID int, entry executor.ExecutionLogEntry, options ExecutionLogEntryOptions) error
 
+	// ExecutionLogsWritable returns whether the record with the given identifier matches the given options, that is
+	// whether AddExecutionLogEntry and UpdateExecutionLogEntry would currently accept log entries for it.
+	ExecutionLogsWritable(ctx context.Context, id int, options ExecutionLogEntryOptions) (bool, error)
+
 	// MarkComplete attempts to update the state of the record to complete. If this record has already been moved from
 	// the processing state to a terminal state, this method will have no effect. This method returns a boolean flag
 	// indicating if the record was updated.
@@ -205,6 +214,30 @@ type Options[T workerutil.Record] struct {
 	// supplied.
 	OrderByExpression *sqlf.Query
 
+	// PriorityExpression is an optional SQL expression evaluating to the priority of a record. Candidate
+	// records with a higher priority are dequeued before records with a lower priority, regardless of the
+	// order given by `OrderByExpression`. This expression may use the alias provided in `ViewName`, if one
+	// was supplied.
+	PriorityExpression *sqlf.Query
+
+	// FairShareExpression is an optional SQL expression identifying the tenant (e.g. the user or namespace)
+	// owning a record. Among candidate records of the same priority, records of the tenants with the fewest
+	// records currently being processed are dequeued first, so that a single tenant cannot starve all other
+	// tenants. This expression may use the alias provided in `ViewName`, if one was supplied.
+	FairShareExpression *sqlf.Query
+
+	// ConcurrencyKeyExpression is an optional SQL expression grouping records (e.g. by repository) whose
+	// concurrent processing is limited by `MaxConcurrencyPerKey`. This expression may use the alias
+	// provided in `ViewName`, if one was supplied.
+	ConcurrencyKeyExpression *sqlf.Query
+
+	// MaxConcurrencyPerKey is the maximum number of records with the same `ConcurrencyKeyExpression` value
+	// that can be processing at the same time. Records over the limit are not dequeued until another record
+	// with the same key leaves the processing state. Concurrent dequeues may exceed the limit briefly, as
+	// records being dequeued at the same time are not yet visible to each other. Setting this value to zero
+	// disables the limit.
+	MaxConcurrencyPerKey int
+
 	// ColumnExpressions are the target columns provided to the query when selecting a job record. These
 	// expressions may use the alias provided in `ViewName`, if one was supplied.
 	ColumnExpressions []*sqlf.Query
@@ -371,6 +404,45 @@ WHERE
 	{state} IN (%s)
 `
 
+// QueuedCountByTenant returns the number of queued records matching the given conditions grouped by tenant.
+func (s *store[T]) QueuedCountByTenant(ctx context.Context, includeProcessing bool) (_ map[string]int, err error) {
+	ctx, _, endObservation := s.operations.queuedCountByTenant.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	stateQueries := make([]*sqlf.Query, 0, 3)
+	stateQueries = append(stateQueries, sqlf.Sprintf("%s", "queued"), sqlf.Sprintf("%s", "errored"))
+	if includeProcessing {
+		stateQueries = append(stateQueries, sqlf.Sprintf("%s", "processing"))
+	}
+
+	fairShareExpression := s.options.FairShareExpression
+	if fairShareExpression == nil {
+		fairShareExpression = sqlf.Sprintf("NULL")
+	}
+
+	return scanCountsByTenant(s.Query(ctx, s.formatQuery(
+		queuedCountByTenantQuery,
+		fairShareExpression,
+		quote(s.options.ViewName),
+		sqlf.Join(stateQueries, ","),
+	)))
+}
+
+var scanCountsByTenant = basestore.NewMapScanner(func(s dbutil.Scanner) (tenant string, count int, _ error) {
+	err := s.Scan(&tenant, &count)
+	return tenant, count, err
+})
+
+const queuedCountByTenantQuery = `
+SELECT
+	COALESCE((%s)::text, '') AS tenant,
+	COUNT(*)
+FROM %s
+WHERE
+	{state} IN (%s)
+GROUP BY 1
+`
+
 // MaxDurationInQueue returns the longest duration for which a job associated with this store instance has
 // been in the queued state (including errored records that can be retried in the future). This method returns
 // a duration of zero if there are no jobs ready for processing.
@@ -492,16 +564,32 @@ func (s *store[T]) Dequeue(ctx context.Context, workerHostname string, condition
 		s.columnReplacer.Replace("{worker_hostname}"):   workerHostnameExpr,
 	}
 
+	fairShareKeyExpr := nullExpr
+	if s.options.FairShareExpression != nil {
+		fairShareKeyExpr = s.options.FairShareExpression
+	}
+	concurrencyKeyExpr := nullExpr
+	if s.options.ConcurrencyKeyExpression != nil {
+		concurrencyKeyExpr = s.options.ConcurrencyKeyExpression
+	}
+	orderByExpr := s.makeDequeueOrderByExpression()
+
 	records, err := s.options.Scan(s.Query(ctx, s.formatQuery(
 		dequeueQuery,
-		s.options.OrderByExpression,
+		fairShareKeyExpr,
+		quote(s.options.ViewName),
+		concurrencyKeyExpr,
+		quote(s.options.ViewName),
+		orderByExpr,
 		quote(s.options.ViewName),
+		s.makeDequeueJoins(),
 		now,
 		retryAfter,
 		now,
 		retryAfter,
 		makeConditionSuffix(conditions),
-		s.options.OrderByExpression,
+		s.makeDequeueConcurrencyCondition(),
+		orderByExpr,
 		quote(s.options.TableName),
 		quote(s.options.TableName),
 		quote(s.options.TableName),
@@ -524,11 +612,30 @@ func (s *store[T]) Dequeue(ctx context.Context, workerHostname string, condition
 }
 
 const dequeueQuery = `
-WITH potential_candidates AS (
+WITH dequeue_fair_share AS (
+	SELECT
+		%s AS fair_share_key,
+		COUNT(*) AS processing_count
+	FROM %s
+	WHERE
+		{state} = 'processing'
+	GROUP BY 1
+),
+dequeue_concurrency AS (
+	SELECT
+		%s AS concurrency_key,
+		COUNT(*) AS processing_count
+	FROM %s
+	WHERE
+		{state} = 'processing'
+	GROUP BY 1
+),
+potential_candidates AS (
 	SELECT
 		{id} AS candidate_id,
 		ROW_NUMBER() OVER (ORDER BY %s) AS order
 	FROM %s
+	%s
 	WHERE
 		(
 			(
@@ -541,6 +648,7 @@ WITH potential_candidates AS (
 			)
 		)
 		%s
+		%s
 	ORDER BY %s
 	LIMIT 50
 ),
@@ -571,6 +679,59 @@ WHERE
 	{id} IN (SELECT {id} FROM candidate)
 `
 
+// makeDequeueOrderByExpression constructs the SQL expression ordering candidate records in the dequeue
+// query. Records are ordered by priority first, then by the number of records of the same tenant that
+// are being processed, and finally by the configured order.
+func (s *store[T]) makeDequeueOrderByExpression() *sqlf.Query {
+	orderByExpressions := make([]*sqlf.Query, 0, 3)
+	if s.options.PriorityExpression != nil {
+		orderByExpressions = append(orderByExpressions, sqlf.Sprintf("%s DESC", s.options.PriorityExpression))
+	}
+	if s.options.FairShareExpression != nil {
+		orderByExpressions = append(orderByExpressions, sqlf.Sprintf("COALESCE(dequeue_fair_share.processing_count, 0)"))
+	}
+	orderByExpressions = append(orderByExpressions, s.options.OrderByExpression)
+
+	return sqlf.Join(orderByExpressions, ", ")
+}
+
+// makeDequeueJoins constructs the joins of candidate records with the number of processing records of
+// their tenant and of their concurrency key, which are counted once per key rather than once per candidate.
+// Joins are only added for the configured expressions, so the counts are not computed otherwise.
+func (s *store[T]) makeDequeueJoins() *sqlf.Query {
+	joins := make([]*sqlf.Query, 0, 2)
+	if s.options.FairShareExpression != nil {
+		joins = append(joins, sqlf.Sprintf(
+			"LEFT JOIN dequeue_fair_share ON dequeue_fair_share.fair_share_key = %s",
+			s.options.FairShareExpression,
+		))
+	}
+	if s.hasConcurrencyLimit() {
+		joins = append(joins, sqlf.Sprintf(
+			"LEFT JOIN dequeue_concurrency ON dequeue_concurrency.concurrency_key = %s",
+			s.options.ConcurrencyKeyExpression,
+		))
+	}
+
+	return sqlf.Join(joins, "\n")
+}
+
+// makeDequeueConcurrencyCondition returns a *sqlf.Query containing a condition that excludes candidate
+// records whose concurrency key has reached the configured limit of processing records, and an empty
+// string if no limit is configured.
+func (s *store[T]) makeDequeueConcurrencyCondition() *sqlf.Query {
+	if !s.hasConcurrencyLimit() {
+		return sqlf.Sprintf("")
+	}
+
+	return sqlf.Sprintf("AND COALESCE(dequeue_concurrency.processing_count, 0) < %s", s.options.MaxConcurrencyPerKey)
+}
+
+// hasConcurrencyLimit returns true if the number of processing records per concurrency key is limited.
+func (s *store[T]) hasConcurrencyLimit() bool {
+	return s.options.ConcurrencyKeyExpression != nil && s.options.MaxConcurrencyPerKey > 0
+}
+
 // makeDequeueSelectExpressions constructs the ordered set of SQL expressions that are returned
 // from the dequeue query. This method returns a copy of the configured column expressions slice
 // where expressions referencing one of the column updated by dequeue are replaced by the updated
@@ -828,6 +989,31 @@ RETURNING
 	array_length({execution_logs}, 1)
 `
 
+// ExecutionLogsWritable returns whether the record with the given identifier matches the given options, that is
+// whether AddExecutionLogEntry and UpdateExecutionLogEntry would currently accept log entries for it.
+func (s *store[T]) ExecutionLogsWritable(ctx context.Context, id int, options ExecutionLogEntryOptions) (_ bool, err error) {
+	ctx, _, endObservation := s.operations.executionLogsWritable.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("id", id),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	conds := []*sqlf.Query{
+		s.formatQuery("{id} = %s", id),
+	}
+	conds = append(conds, options.ToSQLConds(s.formatQuery)...)
+
+	writable, _, err := basestore.ScanFirstBool(s.Query(ctx, s.formatQuery(
+		executionLogsWritableQuery,
+		quote(s.options.TableName),
+		sqlf.Join(conds, "AND"),
+	)))
+	return writable, err
+}
+
+const executionLogsWritableQuery = `
+SELECT EXISTS (SELECT 1 FROM %s WHERE %s)
+`
+
 // MarkComplete attempts to update the state of the record to complete. If this record has already been moved from
 // the processing state to a terminal state, this method will have no effect. This method returns a boolean flag
 // indicating if the record was updated.
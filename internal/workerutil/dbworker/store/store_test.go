@@ -90,6 +90,42 @@ func TestStoreQueuedCountFailed(t *testing.T) {
 	}
 }
 
+func TestStoreQueuedCountByTenant(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, tenant)
+		VALUES
+			(1, 'queued', 'a'),
+			(2, 'queued', 'b'),
+			(3, 'errored', 'a'),
+			(4, 'queued', NULL),
+			(5, 'processing', 'b'),
+			(6, 'completed', 'a')
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	options := defaultTestStoreOptions(nil, testScanRecord)
+	options.FairShareExpression = sqlf.Sprintf("workerutil_test.tenant")
+
+	counts, err := testStore(db, options).QueuedCountByTenant(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error getting queued count by tenant: %s", err)
+	}
+	if diff := cmp.Diff(map[string]int{"a": 2, "b": 1, "": 1}, counts); diff != "" {
+		t.Errorf("unexpected counts (-want +got):\n%s", diff)
+	}
+
+	counts, err = testStore(db, options).QueuedCountByTenant(context.Background(), true)
+	if err != nil {
+		t.Fatalf("unexpected error getting queued count by tenant: %s", err)
+	}
+	if diff := cmp.Diff(map[string]int{"a": 2, "b": 2, "": 1}, counts); diff != "" {
+		t.Errorf("unexpected counts (-want +got):\n%s", diff)
+	}
+}
+
 func TestStoreMaxDurationInQueue(t *testing.T) {
 	db := setupStoreTest(t)
 
@@ -236,6 +272,87 @@ func TestStoreDequeueConditions(t *testing.T) {
 	assertDequeueRecordResult(t, 3, record, ok, err)
 }
 
+func TestStoreDequeuePriority(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, created_at, priority)
+		VALUES
+			(1, 'queued', NOW() - '1 minute'::interval, 1),
+			(2, 'queued', NOW() - '2 minute'::interval, 1),
+			(3, 'queued', NOW() - '3 minute'::interval, 0),
+			(4, 'queued', NOW() - '4 minute'::interval, 0)
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	options := defaultTestStoreOptions(nil, testScanRecord)
+	options.PriorityExpression = sqlf.Sprintf("workerutil_test.priority")
+
+	record, ok, err := testStore(db, options).Dequeue(context.Background(), "test", nil)
+	assertDequeueRecordResult(t, 2, record, ok, err)
+}
+
+func TestStoreDequeueFairShare(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, created_at, tenant)
+		VALUES
+			(1, 'processing', NOW() - '5 minute'::interval, 'a'),
+			(2, 'queued', NOW() - '4 minute'::interval, 'a'),
+			(3, 'queued', NOW() - '3 minute'::interval, 'a'),
+			(4, 'queued', NOW() - '2 minute'::interval, 'b'),
+			(5, 'queued', NOW() - '1 minute'::interval, 'b')
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	options := defaultTestStoreOptions(nil, testScanRecord)
+	options.FairShareExpression = sqlf.Sprintf("workerutil_test.tenant")
+	store := testStore(db, options)
+
+	// Tenant b has no records in processing, so its oldest record goes first.
+	record, ok, err := store.Dequeue(context.Background(), "test", nil)
+	assertDequeueRecordResult(t, 4, record, ok, err)
+
+	// Both tenants are processing a record now, so the oldest record goes next.
+	record, ok, err = store.Dequeue(context.Background(), "test", nil)
+	assertDequeueRecordResult(t, 2, record, ok, err)
+}
+
+func TestStoreDequeueConcurrencyLimit(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, created_at, tenant)
+		VALUES
+			(1, 'processing', NOW() - '5 minute'::interval, 'a'),
+			(2, 'queued', NOW() - '4 minute'::interval, 'a'),
+			(3, 'queued', NOW() - '3 minute'::interval, 'b'),
+			(4, 'queued', NOW() - '2 minute'::interval, 'b')
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	options := defaultTestStoreOptions(nil, testScanRecord)
+	options.ConcurrencyKeyExpression = sqlf.Sprintf("workerutil_test.tenant")
+	options.MaxConcurrencyPerKey = 1
+	store := testStore(db, options)
+
+	record, ok, err := store.Dequeue(context.Background(), "test", nil)
+	assertDequeueRecordResult(t, 3, record, ok, err)
+
+	// Both keys are at their limit.
+	_, ok, err = store.Dequeue(context.Background(), "test", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if ok {
+		t.Fatalf("did not expect a dequeueable record")
+	}
+}
+
 func TestStoreDequeueResetExecutionLogs(t *testing.T) {
 	db := setupStoreTest(t)
 
@@ -15,6 +15,10 @@ import (
 // yet).
 var ErrHeadBaseMismatch = errors.New("headRepository does not match baseRepository")
 
+// ErrDependsOnHeadRef is returned by ParseChangesetSpec if a changeset spec
+// depends on its own head ref.
+var ErrDependsOnHeadRef = errors.New("dependsOn must not be the headRef of the changeset itself")
+
 // ParseChangesetSpec unmarshals the RawSpec into Spec and validates it against
 // the ChangesetSpec schema and does additional semantic validation.
 func ParseChangesetSpec(rawSpec []byte) (*ChangesetSpec, error) {
@@ -30,6 +34,10 @@ func ParseChangesetSpec(rawSpec []byte) (*ChangesetSpec, error) {
 		return nil, ErrHeadBaseMismatch
 	}
 
+	if spec.DependsOn != "" && spec.DependsOn == spec.HeadRef {
+		return nil, ErrDependsOnHeadRef
+	}
+
 	return spec, nil
 }
 
@@ -74,6 +82,12 @@ type ChangesetSpec struct {
 	HeadRepository string `json:"headRepository,omitempty"`
 	HeadRef        string `json:"headRef,omitempty"`
 
+	// DependsOn is the head ref of another changeset in the same repository
+	// that this changeset is stacked on. If set, the changeset is based on the
+	// branch of that changeset instead of BaseRef, and the diff of its commits
+	// applies on top of that changeset's commits.
+	DependsOn string `json:"dependsOn,omitempty"`
+
 	Title string `json:"title,omitempty"`
 	Body  string `json:"body,omitempty"`
 	Fork  *bool  `json:"fork,omitempty"`
@@ -99,6 +113,7 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		BaseRef        string                 `json:"baseRef,omitempty"`
 		HeadRepository string                 `json:"headRepository,omitempty"`
 		HeadRef        string                 `json:"headRef,omitempty"`
+		DependsOn      string                 `json:"dependsOn,omitempty"`
 		Title          string                 `json:"title,omitempty"`
 		Body           string                 `json:"body,omitempty"`
 		Commits        []GitCommitDescription `json:"commits,omitempty"`
@@ -111,6 +126,7 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		BaseRef:        c.BaseRef,
 		HeadRepository: c.HeadRepository,
 		HeadRef:        c.HeadRef,
+		DependsOn:      c.DependsOn,
 		Title:          c.Title,
 		Body:           c.Body,
 		Commits:        c.Commits,
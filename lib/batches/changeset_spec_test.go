@@ -131,6 +131,68 @@ func TestParseChangesetSpec(t *testing.T) {
 				"fork": true
 			}`,
 		},
+		{
+			name: "with dependsOn",
+			rawSpec: `{
+				"baseRepository": "graphql-id",
+				"baseRef": "refs/heads/master",
+				"baseRev": "d34db33f",
+				"headRef": "refs/heads/my-branch",
+				"dependsOn": "refs/heads/my-other-branch",
+				"headRepository": "graphql-id",
+				"title": "my title",
+				"body": "my body",
+				"published": false,
+				"commits": [{
+				  "message": "commit message",
+				  "diff": "the diff",
+				  "authorName": "Mary McButtons",
+				  "authorEmail": "mary@example.com"
+				}]
+			}`,
+		},
+		{
+			name: "dependsOn its own headRef",
+			rawSpec: `{
+				"baseRepository": "graphql-id",
+				"baseRef": "refs/heads/master",
+				"baseRev": "d34db33f",
+				"headRef": "refs/heads/my-branch",
+				"dependsOn": "refs/heads/my-branch",
+				"headRepository": "graphql-id",
+				"title": "my title",
+				"body": "my body",
+				"published": false,
+				"commits": [{
+				  "message": "commit message",
+				  "diff": "the diff",
+				  "authorName": "Mary McButtons",
+				  "authorEmail": "mary@example.com"
+				}]
+			}`,
+			err: ErrDependsOnHeadRef.Error(),
+		},
+		{
+			name: "dependsOn is not a branch ref",
+			rawSpec: `{
+				"baseRepository": "graphql-id",
+				"baseRef": "refs/heads/master",
+				"baseRev": "d34db33f",
+				"headRef": "refs/heads/my-branch",
+				"dependsOn": "my-other-branch",
+				"headRepository": "graphql-id",
+				"title": "my title",
+				"body": "my body",
+				"published": false,
+				"commits": [{
+				  "message": "commit message",
+				  "diff": "the diff",
+				  "authorName": "Mary McButtons",
+				  "authorEmail": "mary@example.com"
+				}]
+			}`,
+			err: "2 errors occurred:\n\t* Must validate one and only one schema (oneOf)\n\t* dependsOn: Does not match pattern '^refs\\/heads\\/\\S+$'",
+		},
 	}
 
 	for _, tc := range tests {
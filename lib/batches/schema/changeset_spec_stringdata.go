@@ -70,6 +70,12 @@ const ChangesetSpecJSON = `{
           "pattern": "^refs\\/heads\\/\\S+$",
           "examples": ["refs/heads/fix-foo"]
         },
+        "dependsOn": {
+          "type": "string",
+          "description": "The full name of the head ref of another changeset in the same repository that this changeset is stacked on. The changeset is based on the branch of that changeset, its diff is applied on top of that changeset's changes, and it is only published after that changeset.",
+          "pattern": "^refs\\/heads\\/\\S+$",
+          "examples": ["refs/heads/bump-library"]
+        },
         "title": { "type": "string", "description": "The title of the changeset on the code host." },
         "body": { "type": "string", "description": "The body (description) of the changeset on the code host." },
         "commits": {
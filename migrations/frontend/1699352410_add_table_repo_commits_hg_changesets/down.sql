@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS repo_id_hg_changeset_id_unique;
+
+DROP TABLE IF EXISTS repo_commits_hg_changesets;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS repo_commits_hg_changesets (
+    id SERIAL PRIMARY KEY,
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE,
+    commit_sha bytea NOT NULL,
+    hg_changeset_id text NOT NULL,
+    created_at timestamp WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS repo_id_hg_changeset_id_unique ON repo_commits_hg_changesets USING btree (repo_id, hg_changeset_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS gitserver_repo_push_mirrors_next_attempt_at_idx;
+
+DROP TABLE IF EXISTS gitserver_repo_push_mirrors;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS gitserver_repo_push_mirrors (
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE,
+    remote_name text NOT NULL,
+    last_pushed_at timestamp WITH TIME ZONE,
+    last_attempt_at timestamp WITH TIME ZONE NOT NULL DEFAULT now(),
+    last_error text,
+    failures integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp WITH TIME ZONE,
+    PRIMARY KEY (repo_id, remote_name)
+);
+
+CREATE INDEX IF NOT EXISTS gitserver_repo_push_mirrors_next_attempt_at_idx ON gitserver_repo_push_mirrors USING btree (next_attempt_at) WHERE next_attempt_at IS NOT NULL;
@@ -0,0 +1,4 @@
+DELETE FROM own_signal_configurations
+WHERE name = 'coverage';
+
+DROP TABLE IF EXISTS own_coverage_reports;
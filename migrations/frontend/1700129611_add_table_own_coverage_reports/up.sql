@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS own_coverage_reports (
+    repo_id integer NOT NULL PRIMARY KEY REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE,
+    commit_id text NOT NULL,
+    total_file_count integer NOT NULL DEFAULT 0,
+    owned_file_count integer NOT NULL DEFAULT 0,
+    unowned_directories text[] NOT NULL DEFAULT '{}'::text[],
+    unmatched_rules jsonb NOT NULL DEFAULT '[]'::jsonb,
+    unresolved_owners jsonb NOT NULL DEFAULT '[]'::jsonb,
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE own_coverage_reports IS 'Ownership coverage and rule lint results of the latest coverage job run for every repository.';
+
+INSERT INTO own_signal_configurations (name, enabled, description)
+VALUES (
+        'coverage',
+        FALSE,
+        'Computes ownership coverage and lints ownership rules of every repository, presented in Repo > Ownership coverage'
+    ) ON CONFLICT DO NOTHING;
@@ -0,0 +1,4 @@
+ALTER TABLE cm_queries
+    DROP COLUMN IF EXISTS repo_ids,
+    DROP COLUMN IF EXISTS path_patterns,
+    DROP COLUMN IF EXISTS owner;
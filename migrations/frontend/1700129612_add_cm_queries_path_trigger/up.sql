@@ -0,0 +1,8 @@
+ALTER TABLE cm_queries
+    ADD COLUMN IF NOT EXISTS repo_ids integer[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS path_patterns text[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS owner text;
+
+COMMENT ON COLUMN cm_queries.repo_ids IS 'The IDs of the repositories watched by a path trigger. Empty for search query triggers.';
+COMMENT ON COLUMN cm_queries.path_patterns IS 'CODEOWNERS-style patterns of which a path trigger fires for commits modifying a matching file.';
+COMMENT ON COLUMN cm_queries.owner IS 'The handle or email of an owner of which a path trigger fires for commits modifying an owned file.';
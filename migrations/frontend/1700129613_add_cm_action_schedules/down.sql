@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS cm_notified_matches;
+
+ALTER TABLE cm_emails DROP COLUMN IF EXISTS schedule;
+ALTER TABLE cm_webhooks DROP COLUMN IF EXISTS schedule;
+ALTER TABLE cm_slack_webhooks DROP COLUMN IF EXISTS schedule;
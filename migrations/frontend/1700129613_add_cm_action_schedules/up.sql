@@ -0,0 +1,23 @@
+ALTER TABLE cm_emails ADD COLUMN IF NOT EXISTS schedule text NOT NULL DEFAULT 'IMMEDIATE';
+ALTER TABLE cm_webhooks ADD COLUMN IF NOT EXISTS schedule text NOT NULL DEFAULT 'IMMEDIATE';
+ALTER TABLE cm_slack_webhooks ADD COLUMN IF NOT EXISTS schedule text NOT NULL DEFAULT 'IMMEDIATE';
+
+COMMENT ON COLUMN cm_emails.schedule IS 'When the action is run: IMMEDIATE after every trigger run with results, or as an HOURLY or DAILY digest of the results of all trigger runs since the last run';
+COMMENT ON COLUMN cm_webhooks.schedule IS 'When the action is run: IMMEDIATE after every trigger run with results, or as an HOURLY or DAILY digest of the results of all trigger runs since the last run';
+COMMENT ON COLUMN cm_slack_webhooks.schedule IS 'When the action is run: IMMEDIATE after every trigger run with results, or as an HOURLY or DAILY digest of the results of all trigger runs since the last run';
+
+CREATE TABLE IF NOT EXISTS cm_notified_matches (
+    email bigint REFERENCES cm_emails(id) ON DELETE CASCADE,
+    webhook bigint REFERENCES cm_webhooks(id) ON DELETE CASCADE,
+    slack_webhook bigint REFERENCES cm_slack_webhooks(id) ON DELETE CASCADE,
+    repo_id integer NOT NULL,
+    commit_id text NOT NULL,
+    path text NOT NULL DEFAULT '',
+    notified_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE cm_notified_matches IS 'The commits and files code monitor actions already notified about, to not notify about them again';
+COMMENT ON COLUMN cm_notified_matches.path IS 'A file modified by the commit, or empty for matches of the commit message';
+
+CREATE UNIQUE INDEX IF NOT EXISTS cm_notified_matches_action_match ON cm_notified_matches (COALESCE(email, 0), COALESCE(webhook, 0), COALESCE(slack_webhook, 0), repo_id, commit_id, path);
+CREATE INDEX IF NOT EXISTS cm_notified_matches_notified_at ON cm_notified_matches (notified_at);
@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS cm_notified_matches_action_match;
+ALTER TABLE cm_notified_matches DROP COLUMN IF EXISTS chat_webhook;
+CREATE UNIQUE INDEX IF NOT EXISTS cm_notified_matches_action_match ON cm_notified_matches (COALESCE(email, 0), COALESCE(webhook, 0), COALESCE(slack_webhook, 0), repo_id, commit_id, path);
+
+ALTER TABLE cm_action_jobs DROP CONSTRAINT IF EXISTS cm_action_jobs_only_one_action_type;
+DELETE FROM cm_action_jobs WHERE chat_webhook IS NOT NULL;
+ALTER TABLE cm_action_jobs DROP COLUMN IF EXISTS chat_webhook;
+ALTER TABLE cm_action_jobs ADD CONSTRAINT cm_action_jobs_only_one_action_type CHECK ((
+    CASE WHEN email IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN slack_webhook IS NULL THEN 0 ELSE 1 END
+) = 1);
+
+COMMENT ON CONSTRAINT cm_action_jobs_only_one_action_type ON cm_action_jobs IS 'Constrains that each queued code monitor action has exactly one action type';
+
+DROP TABLE IF EXISTS cm_chat_webhooks;
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS cm_chat_webhooks (
+    id bigserial PRIMARY KEY,
+    monitor bigint NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    format text NOT NULL,
+    url text NOT NULL,
+    enabled boolean NOT NULL,
+    include_results boolean NOT NULL DEFAULT false,
+    schedule text NOT NULL DEFAULT 'IMMEDIATE',
+    created_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    changed_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    changed_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS cm_chat_webhooks_monitor ON cm_chat_webhooks (monitor);
+
+COMMENT ON TABLE cm_chat_webhooks IS 'Chat webhook actions configured on code monitors, such as Microsoft Teams or Mattermost incoming webhooks';
+COMMENT ON COLUMN cm_chat_webhooks.monitor IS 'The code monitor that the action is defined on';
+COMMENT ON COLUMN cm_chat_webhooks.format IS 'The format of the messages sent to the webhook: TEAMS for Microsoft Teams adaptive cards, or MATTERMOST for Mattermost messages';
+COMMENT ON COLUMN cm_chat_webhooks.url IS 'The incoming webhook URL we send the code monitor event to';
+COMMENT ON COLUMN cm_chat_webhooks.schedule IS 'When the action is run: IMMEDIATE after every trigger run with results, or as an HOURLY or DAILY digest of the results of all trigger runs since the last run';
+
+ALTER TABLE cm_action_jobs ADD COLUMN IF NOT EXISTS chat_webhook bigint REFERENCES cm_chat_webhooks(id) ON DELETE CASCADE;
+
+COMMENT ON COLUMN cm_action_jobs.chat_webhook IS 'The ID of the cm_chat_webhooks action to execute if this is a chat webhook job. Mutually exclusive with email, webhook and slack_webhook';
+
+ALTER TABLE cm_action_jobs DROP CONSTRAINT IF EXISTS cm_action_jobs_only_one_action_type;
+ALTER TABLE cm_action_jobs ADD CONSTRAINT cm_action_jobs_only_one_action_type CHECK ((
+    CASE WHEN email IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN slack_webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN chat_webhook IS NULL THEN 0 ELSE 1 END
+) = 1);
+
+COMMENT ON CONSTRAINT cm_action_jobs_only_one_action_type ON cm_action_jobs IS 'Constrains that each queued code monitor action has exactly one action type';
+
+ALTER TABLE cm_notified_matches ADD COLUMN IF NOT EXISTS chat_webhook bigint REFERENCES cm_chat_webhooks(id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS cm_notified_matches_action_match;
+CREATE UNIQUE INDEX IF NOT EXISTS cm_notified_matches_action_match ON cm_notified_matches (COALESCE(email, 0), COALESCE(webhook, 0), COALESCE(slack_webhook, 0), COALESCE(chat_webhook, 0), repo_id, commit_id, path);
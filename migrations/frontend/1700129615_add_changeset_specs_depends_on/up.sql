@@ -0,0 +1 @@
+ALTER TABLE changeset_specs ADD COLUMN IF NOT EXISTS depends_on text;
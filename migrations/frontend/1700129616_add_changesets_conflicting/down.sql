@@ -0,0 +1,22 @@
+CREATE OR REPLACE FUNCTION changesets_computed_state_ensure() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$ BEGIN
+
+    NEW.computed_state = CASE
+        WHEN NEW.reconciler_state = 'errored' THEN 'RETRYING'
+        WHEN NEW.reconciler_state = 'failed' THEN 'FAILED'
+        WHEN NEW.reconciler_state = 'scheduled' THEN 'SCHEDULED'
+        WHEN NEW.reconciler_state != 'completed' THEN 'PROCESSING'
+        WHEN NEW.publication_state = 'UNPUBLISHED' THEN 'UNPUBLISHED'
+        ELSE NEW.external_state
+    END AS computed_state;
+
+    RETURN NEW;
+END $$;
+
+UPDATE changesets SET computed_state = external_state WHERE computed_state = 'CONFLICTING';
+
+ALTER TABLE changesets DROP COLUMN IF EXISTS rebase_target_rev;
+ALTER TABLE changesets DROP COLUMN IF EXISTS rebase_checked_at;
+ALTER TABLE changesets DROP COLUMN IF EXISTS rebase_base_rev;
+ALTER TABLE changesets DROP COLUMN IF EXISTS conflicting;
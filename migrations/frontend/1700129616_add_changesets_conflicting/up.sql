@@ -0,0 +1,21 @@
+ALTER TABLE changesets ADD COLUMN IF NOT EXISTS conflicting boolean DEFAULT false NOT NULL;
+ALTER TABLE changesets ADD COLUMN IF NOT EXISTS rebase_base_rev text;
+ALTER TABLE changesets ADD COLUMN IF NOT EXISTS rebase_checked_at timestamp with time zone;
+ALTER TABLE changesets ADD COLUMN IF NOT EXISTS rebase_target_rev text;
+
+CREATE OR REPLACE FUNCTION changesets_computed_state_ensure() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$ BEGIN
+
+    NEW.computed_state = CASE
+        WHEN NEW.reconciler_state = 'errored' THEN 'RETRYING'
+        WHEN NEW.reconciler_state = 'failed' THEN 'FAILED'
+        WHEN NEW.reconciler_state = 'scheduled' THEN 'SCHEDULED'
+        WHEN NEW.reconciler_state != 'completed' THEN 'PROCESSING'
+        WHEN NEW.publication_state = 'UNPUBLISHED' THEN 'UNPUBLISHED'
+        WHEN NEW.conflicting AND NEW.external_state IN ('OPEN', 'DRAFT') THEN 'CONFLICTING'
+        ELSE NEW.external_state
+    END AS computed_state;
+
+    RETURN NEW;
+END $$;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS batch_changes_merge_queues (
+    batch_change_id bigint NOT NULL PRIMARY KEY REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE,
+    user_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    squash boolean NOT NULL DEFAULT false,
+    max_merges integer NOT NULL,
+    window_seconds integer NOT NULL,
+    paused_reason text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT batch_changes_merge_queues_max_merges_positive CHECK (max_merges > 0),
+    CONSTRAINT batch_changes_merge_queues_window_seconds_positive CHECK (window_seconds > 0)
+);
+
+COMMENT ON TABLE batch_changes_merge_queues IS 'Batch changes whose changesets are merged automatically once their checks passed and they are approved';
+COMMENT ON COLUMN batch_changes_merge_queues.user_id IS 'The user that enabled the merge queue, on whose behalf changesets are merged';
+COMMENT ON COLUMN batch_changes_merge_queues.max_merges IS 'The maximum number of changesets merged within window_seconds';
+COMMENT ON COLUMN batch_changes_merge_queues.paused_reason IS 'Why no changesets are merged at the moment, e.g. because the checks of a base branch are failing';
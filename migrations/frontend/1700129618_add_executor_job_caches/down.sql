@@ -0,0 +1 @@
+DROP TABLE IF EXISTS executor_job_caches;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS executor_job_caches (
+    key text NOT NULL PRIMARY KEY,
+    size_bytes bigint NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    last_accessed_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS executor_job_caches_last_accessed_at ON executor_job_caches USING btree (last_accessed_at);
+
+COMMENT ON TABLE executor_job_caches IS 'Tracks the caches of executor jobs kept in the upload store, so that the least recently used caches can be evicted.';
+COMMENT ON COLUMN executor_job_caches.key IS 'The key of the cache in the upload store, of the form <queue>/<repository name>/-/<cache key>.';
+COMMENT ON COLUMN executor_job_caches.size_bytes IS 'The size of the compressed cache archive.';
+COMMENT ON COLUMN executor_job_caches.last_accessed_at IS 'The last time the cache was restored or stored by a job.';
@@ -0,0 +1,38 @@
+ALTER TABLE lsif_indexes
+ADD COLUMN IF NOT EXISTS priority integer NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN lsif_indexes.priority IS 'The priority class of the index job. Jobs of a higher priority are dequeued first.';
+
+-- Indexes enqueued by users were already dequeued first.
+UPDATE lsif_indexes SET priority = 1 WHERE enqueuer_user_id > 0 AND state IN ('queued', 'errored');
+
+DROP VIEW IF EXISTS lsif_indexes_with_repository_name;
+
+CREATE VIEW lsif_indexes_with_repository_name AS
+    SELECT u.id,
+        u.commit,
+        u.queued_at,
+        u.state,
+        u.failure_message,
+        u.started_at,
+        u.finished_at,
+        u.repository_id,
+        u.process_after,
+        u.num_resets,
+        u.num_failures,
+        u.docker_steps,
+        u.root,
+        u.indexer,
+        u.indexer_args,
+        u.outfile,
+        u.log_contents,
+        u.execution_logs,
+        u.local_steps,
+        u.should_reindex,
+        u.requested_envvars,
+        r.name AS repository_name,
+        u.enqueuer_user_id,
+        u.priority
+    FROM (lsif_indexes u
+        JOIN repo r ON ((r.id = u.repository_id)))
+    WHERE (r.deleted_at IS NULL);
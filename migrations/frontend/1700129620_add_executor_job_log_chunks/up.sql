@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS executor_job_log_chunks (
+    id bigserial PRIMARY KEY,
+    queue text NOT NULL,
+    job_id integer NOT NULL,
+    entry_id integer NOT NULL,
+    entry_key text NOT NULL,
+    data text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS executor_job_log_chunks_queue_job_id_id ON executor_job_log_chunks USING btree (queue, job_id, id);
+CREATE INDEX IF NOT EXISTS executor_job_log_chunks_created_at ON executor_job_log_chunks USING btree (created_at);
+
+COMMENT ON TABLE executor_job_log_chunks IS 'Stores the output of executor jobs incrementally while they run, so that it can be tailed and searched.';
+COMMENT ON COLUMN executor_job_log_chunks.entry_id IS 'The ID of the execution log entry of the job that produced the output.';
+COMMENT ON COLUMN executor_job_log_chunks.entry_key IS 'The key of the execution log entry of the job that produced the output, e.g. step.docker.step.0.run.';
+COMMENT ON COLUMN executor_job_log_chunks.data IS 'The redacted output, consisting of complete lines unless it is the last chunk of the entry.';
@@ -0,0 +1,16 @@
+ALTER TABLE batch_spec_workspace_execution_jobs
+ADD COLUMN IF NOT EXISTS priority integer NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN batch_spec_workspace_execution_jobs.priority IS 'The priority class of the execution job. Jobs of a higher priority are dequeued first.';
+
+DROP VIEW IF EXISTS batch_spec_workspace_execution_jobs_with_rank;
+
+CREATE VIEW batch_spec_workspace_execution_jobs_with_rank AS (
+    SELECT
+        j.*,
+        q.place_in_global_queue,
+        q.place_in_user_queue
+    FROM
+        batch_spec_workspace_execution_jobs j
+    LEFT JOIN batch_spec_workspace_execution_queue q ON j.id = q.id
+);
@@ -109,6 +109,7 @@ CREATE FUNCTION changesets_computed_state_ensure() RETURNS trigger
         WHEN NEW.reconciler_state = 'scheduled' THEN 'SCHEDULED'
         WHEN NEW.reconciler_state != 'completed' THEN 'PROCESSING'
         WHEN NEW.publication_state = 'UNPUBLISHED' THEN 'UNPUBLISHED'
+        WHEN NEW.conflicting AND NEW.external_state IN ('OPEN', 'DRAFT') THEN 'CONFLICTING'
         ELSE NEW.external_state
     END AS computed_state;
 
@@ -1009,6 +1010,27 @@ CREATE SEQUENCE batch_changes_id_seq
 
 ALTER SEQUENCE batch_changes_id_seq OWNED BY batch_changes.id;
 
+CREATE TABLE batch_changes_merge_queues (
+    batch_change_id bigint NOT NULL,
+    user_id integer NOT NULL,
+    squash boolean DEFAULT false NOT NULL,
+    max_merges integer NOT NULL,
+    window_seconds integer NOT NULL,
+    paused_reason text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT batch_changes_merge_queues_max_merges_positive CHECK ((max_merges > 0)),
+    CONSTRAINT batch_changes_merge_queues_window_seconds_positive CHECK ((window_seconds > 0))
+);
+
+COMMENT ON TABLE batch_changes_merge_queues IS 'Batch changes whose changesets are merged automatically once their checks passed and they are approved';
+
+COMMENT ON COLUMN batch_changes_merge_queues.user_id IS 'The user that enabled the merge queue, on whose behalf changesets are merged';
+
+COMMENT ON COLUMN batch_changes_merge_queues.max_merges IS 'The maximum number of changesets merged within window_seconds';
+
+COMMENT ON COLUMN batch_changes_merge_queues.paused_reason IS 'Why no changesets are merged at the moment, e.g. because the checks of a base branch are failing';
+
 CREATE TABLE batch_changes_site_credentials (
     id bigint NOT NULL,
     external_service_type text NOT NULL,
@@ -1094,9 +1116,12 @@ CREATE TABLE batch_spec_workspace_execution_jobs (
     cancel boolean DEFAULT false NOT NULL,
     queued_at timestamp with time zone DEFAULT now(),
     user_id integer NOT NULL,
-    version integer DEFAULT 1 NOT NULL
+    version integer DEFAULT 1 NOT NULL,
+    priority integer DEFAULT 0 NOT NULL
 );
 
+COMMENT ON COLUMN batch_spec_workspace_execution_jobs.priority IS 'The priority class of the execution job. Jobs of a higher priority are dequeued first.';
+
 CREATE SEQUENCE batch_spec_workspace_execution_jobs_id_seq
     START WITH 1
     INCREMENT BY 1
@@ -1144,6 +1169,7 @@ CREATE VIEW batch_spec_workspace_execution_jobs_with_rank AS
     j.queued_at,
     j.user_id,
     j.version,
+    j.priority,
     q.place_in_global_queue,
     q.place_in_user_queue
    FROM (batch_spec_workspace_execution_jobs j
@@ -1251,6 +1277,7 @@ CREATE TABLE changeset_specs (
     commit_author_name text,
     commit_author_email text,
     type text NOT NULL,
+    depends_on text,
     CONSTRAINT changeset_specs_published_valid_values CHECK (((published = 'true'::text) OR (published = 'false'::text) OR (published = '"draft"'::text) OR (published IS NULL)))
 );
 
@@ -1299,6 +1326,10 @@ CREATE TABLE changesets (
     external_fork_name citext,
     previous_failure_message text,
     commit_verification jsonb DEFAULT '{}'::jsonb NOT NULL,
+    conflicting boolean DEFAULT false NOT NULL,
+    rebase_base_rev text,
+    rebase_checked_at timestamp with time zone,
+    rebase_target_rev text,
     CONSTRAINT changesets_batch_change_ids_check CHECK ((jsonb_typeof(batch_change_ids) = 'object'::text)),
     CONSTRAINT changesets_external_id_check CHECK ((external_id <> ''::text)),
     CONSTRAINT changesets_external_service_type_not_blank CHECK ((external_service_type <> ''::text)),
@@ -1459,7 +1490,8 @@ CREATE TABLE cm_action_jobs (
     slack_webhook bigint,
     queued_at timestamp with time zone DEFAULT now(),
     cancel boolean DEFAULT false NOT NULL,
-    CONSTRAINT cm_action_jobs_only_one_action_type CHECK ((((
+    chat_webhook bigint,
+    CONSTRAINT cm_action_jobs_only_one_action_type CHECK (((((
 CASE
     WHEN (email IS NULL) THEN 0
     ELSE 1
@@ -1471,6 +1503,10 @@ END) +
 CASE
     WHEN (slack_webhook IS NULL) THEN 0
     ELSE 1
+END) +
+CASE
+    WHEN (chat_webhook IS NULL) THEN 0
+    ELSE 1
 END) = 1))
 );
 
@@ -1480,6 +1516,8 @@ COMMENT ON COLUMN cm_action_jobs.webhook IS 'The ID of the cm_webhooks action to
 
 COMMENT ON COLUMN cm_action_jobs.slack_webhook IS 'The ID of the cm_slack_webhook action to execute if this is a slack webhook job. Mutually exclusive with email and webhook';
 
+COMMENT ON COLUMN cm_action_jobs.chat_webhook IS 'The ID of the cm_chat_webhooks action to execute if this is a chat webhook job. Mutually exclusive with email, webhook and slack_webhook';
+
 COMMENT ON CONSTRAINT cm_action_jobs_only_one_action_type ON cm_action_jobs IS 'Constrains that each queued code monitor action has exactly one action type';
 
 CREATE SEQUENCE cm_action_jobs_id_seq
@@ -1492,6 +1530,39 @@ CREATE SEQUENCE cm_action_jobs_id_seq
 
 ALTER SEQUENCE cm_action_jobs_id_seq OWNED BY cm_action_jobs.id;
 
+CREATE TABLE cm_chat_webhooks (
+    id bigint NOT NULL,
+    monitor bigint NOT NULL,
+    format text NOT NULL,
+    url text NOT NULL,
+    enabled boolean NOT NULL,
+    include_results boolean DEFAULT false NOT NULL,
+    schedule text DEFAULT 'IMMEDIATE'::text NOT NULL,
+    created_by integer NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    changed_by integer NOT NULL,
+    changed_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE cm_chat_webhooks IS 'Chat webhook actions configured on code monitors, such as Microsoft Teams or Mattermost incoming webhooks';
+
+COMMENT ON COLUMN cm_chat_webhooks.monitor IS 'The code monitor that the action is defined on';
+
+COMMENT ON COLUMN cm_chat_webhooks.format IS 'The format of the messages sent to the webhook: TEAMS for Microsoft Teams adaptive cards, or MATTERMOST for Mattermost messages';
+
+COMMENT ON COLUMN cm_chat_webhooks.url IS 'The incoming webhook URL we send the code monitor event to';
+
+COMMENT ON COLUMN cm_chat_webhooks.schedule IS 'When the action is run: IMMEDIATE after every trigger run with results, or as an HOURLY or DAILY digest of the results of all trigger runs since the last run';
+
+CREATE SEQUENCE cm_chat_webhooks_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE cm_chat_webhooks_id_seq OWNED BY cm_chat_webhooks.id;
+
 CREATE TABLE cm_emails (
     id bigint NOT NULL,
     monitor bigint NOT NULL,
@@ -1502,9 +1573,12 @@ CREATE TABLE cm_emails (
     created_at timestamp with time zone DEFAULT now() NOT NULL,
     changed_by integer NOT NULL,
     changed_at timestamp with time zone DEFAULT now() NOT NULL,
-    include_results boolean DEFAULT false NOT NULL
+    include_results boolean DEFAULT false NOT NULL,
+    schedule text DEFAULT 'IMMEDIATE'::text NOT NULL
 );
 
+COMMENT ON COLUMN cm_emails.schedule IS 'When the action is run: IMMEDIATE after every trigger run with results, or as an HOURLY or DAILY digest of the results of all trigger runs since the last run';
+
 CREATE SEQUENCE cm_emails_id_seq
     START WITH 1
     INCREMENT BY 1
@@ -1547,6 +1621,21 @@ CREATE SEQUENCE cm_monitors_id_seq
 
 ALTER SEQUENCE cm_monitors_id_seq OWNED BY cm_monitors.id;
 
+CREATE TABLE cm_notified_matches (
+    email bigint,
+    webhook bigint,
+    slack_webhook bigint,
+    repo_id integer NOT NULL,
+    commit_id text NOT NULL,
+    path text DEFAULT ''::text NOT NULL,
+    notified_at timestamp with time zone DEFAULT now() NOT NULL,
+    chat_webhook bigint
+);
+
+COMMENT ON TABLE cm_notified_matches IS 'The commits and files code monitor actions already notified about, to not notify about them again';
+
+COMMENT ON COLUMN cm_notified_matches.path IS 'A file modified by the commit, or empty for matches of the commit message';
+
 CREATE TABLE cm_queries (
     id bigint NOT NULL,
     monitor bigint NOT NULL,
@@ -1556,9 +1645,18 @@ CREATE TABLE cm_queries (
     changed_by integer NOT NULL,
     changed_at timestamp with time zone DEFAULT now() NOT NULL,
     next_run timestamp with time zone DEFAULT now(),
-    latest_result timestamp with time zone
+    latest_result timestamp with time zone,
+    repo_ids integer[] DEFAULT '{}'::integer[] NOT NULL,
+    path_patterns text[] DEFAULT '{}'::text[] NOT NULL,
+    owner text
 );
 
+COMMENT ON COLUMN cm_queries.repo_ids IS 'The IDs of the repositories watched by a path trigger. Empty for search query triggers.';
+
+COMMENT ON COLUMN cm_queries.path_patterns IS 'CODEOWNERS-style patterns of which a path trigger fires for commits modifying a matching file.';
+
+COMMENT ON COLUMN cm_queries.owner IS 'The handle or email of an owner of which a path trigger fires for commits modifying an owned file.';
+
 CREATE SEQUENCE cm_queries_id_seq
     START WITH 1
     INCREMENT BY 1
@@ -1593,7 +1691,8 @@ CREATE TABLE cm_slack_webhooks (
     created_at timestamp with time zone DEFAULT now() NOT NULL,
     changed_by integer NOT NULL,
     changed_at timestamp with time zone DEFAULT now() NOT NULL,
-    include_results boolean DEFAULT false NOT NULL
+    include_results boolean DEFAULT false NOT NULL,
+    schedule text DEFAULT 'IMMEDIATE'::text NOT NULL
 );
 
 COMMENT ON TABLE cm_slack_webhooks IS 'Slack webhook actions configured on code monitors';
@@ -1602,6 +1701,8 @@ COMMENT ON COLUMN cm_slack_webhooks.monitor IS 'The code monitor that the action
 
 COMMENT ON COLUMN cm_slack_webhooks.url IS 'The Slack webhook URL we send the code monitor event to';
 
+COMMENT ON COLUMN cm_slack_webhooks.schedule IS 'When the action is run: IMMEDIATE after every trigger run with results, or as an HOURLY or DAILY digest of the results of all trigger runs since the last run';
+
 CREATE SEQUENCE cm_slack_webhooks_id_seq
     START WITH 1
     INCREMENT BY 1
@@ -1651,7 +1752,8 @@ CREATE TABLE cm_webhooks (
     created_at timestamp with time zone DEFAULT now() NOT NULL,
     changed_by integer NOT NULL,
     changed_at timestamp with time zone DEFAULT now() NOT NULL,
-    include_results boolean DEFAULT false NOT NULL
+    include_results boolean DEFAULT false NOT NULL,
+    schedule text DEFAULT 'IMMEDIATE'::text NOT NULL
 );
 
 COMMENT ON TABLE cm_webhooks IS 'Webhook actions configured on code monitors';
@@ -1662,6 +1764,8 @@ COMMENT ON COLUMN cm_webhooks.url IS 'The webhook URL we send the code monitor e
 
 COMMENT ON COLUMN cm_webhooks.enabled IS 'Whether this Slack webhook action is enabled. When not enabled, the action will not be run when its code monitor generates events';
 
+COMMENT ON COLUMN cm_webhooks.schedule IS 'When the action is run: IMMEDIATE after every trigger run with results, or as an HOURLY or DAILY digest of the results of all trigger runs since the last run';
+
 CREATE SEQUENCE cm_webhooks_id_seq
     START WITH 1
     INCREMENT BY 1
@@ -2389,6 +2493,48 @@ CREATE SEQUENCE executor_heartbeats_id_seq
 
 ALTER SEQUENCE executor_heartbeats_id_seq OWNED BY executor_heartbeats.id;
 
+CREATE TABLE executor_job_caches (
+    key text NOT NULL,
+    size_bytes bigint NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    last_accessed_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE executor_job_caches IS 'Tracks the caches of executor jobs kept in the upload store, so that the least recently used caches can be evicted.';
+
+COMMENT ON COLUMN executor_job_caches.key IS 'The key of the cache in the upload store, of the form <queue>/<repository name>/-/<cache key>.';
+
+COMMENT ON COLUMN executor_job_caches.size_bytes IS 'The size of the compressed cache archive.';
+
+COMMENT ON COLUMN executor_job_caches.last_accessed_at IS 'The last time the cache was restored or stored by a job.';
+
+CREATE TABLE executor_job_log_chunks (
+    id bigint NOT NULL,
+    queue text NOT NULL,
+    job_id integer NOT NULL,
+    entry_id integer NOT NULL,
+    entry_key text NOT NULL,
+    data text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE executor_job_log_chunks IS 'Stores the output of executor jobs incrementally while they run, so that it can be tailed and searched.';
+
+COMMENT ON COLUMN executor_job_log_chunks.entry_id IS 'The ID of the execution log entry of the job that produced the output.';
+
+COMMENT ON COLUMN executor_job_log_chunks.entry_key IS 'The key of the execution log entry of the job that produced the output, e.g. step.docker.step.0.run.';
+
+COMMENT ON COLUMN executor_job_log_chunks.data IS 'The redacted output, consisting of complete lines unless it is the last chunk of the entry.';
+
+CREATE SEQUENCE executor_job_log_chunks_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE executor_job_log_chunks_id_seq OWNED BY executor_job_log_chunks.id;
+
 CREATE TABLE executor_job_tokens (
     id integer NOT NULL,
     value_sha256 bytea NOT NULL,
@@ -2818,6 +2964,16 @@ CREATE VIEW gitserver_relocator_jobs_with_repo_name AS
    FROM (gitserver_relocator_jobs glj
      JOIN repo r ON ((r.id = glj.repo_id)));
 
+CREATE TABLE gitserver_repo_push_mirrors (
+    repo_id integer NOT NULL,
+    remote_name text NOT NULL,
+    last_pushed_at timestamp with time zone,
+    last_attempt_at timestamp with time zone DEFAULT now() NOT NULL,
+    last_error text,
+    failures integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp with time zone
+);
+
 CREATE TABLE gitserver_repos (
     repo_id integer NOT NULL,
     clone_status text DEFAULT 'not_cloned'::text NOT NULL,
@@ -3250,6 +3406,7 @@ CREATE TABLE lsif_indexes (
     should_reindex boolean DEFAULT false NOT NULL,
     requested_envvars text[],
     enqueuer_user_id integer DEFAULT 0 NOT NULL,
+    priority integer DEFAULT 0 NOT NULL,
     CONSTRAINT lsif_uploads_commit_valid_chars CHECK ((commit ~ '^[a-z0-9]{40}$'::text))
 );
 
@@ -3273,6 +3430,8 @@ COMMENT ON COLUMN lsif_indexes.execution_logs IS 'An array of [log entries](http
 
 COMMENT ON COLUMN lsif_indexes.local_steps IS 'A list of commands to run inside the indexer image prior to running the indexer command.';
 
+COMMENT ON COLUMN lsif_indexes.priority IS 'The priority class of the index job. Jobs of a higher priority are dequeued first.';
+
 CREATE SEQUENCE lsif_indexes_id_seq
     START WITH 1
     INCREMENT BY 1
@@ -3305,7 +3464,8 @@ CREATE VIEW lsif_indexes_with_repository_name AS
     u.should_reindex,
     u.requested_envvars,
     r.name AS repository_name,
-    u.enqueuer_user_id
+    u.enqueuer_user_id,
+    u.priority
    FROM (lsif_indexes u
      JOIN repo r ON ((r.id = u.repository_id)))
   WHERE (r.deleted_at IS NULL);
@@ -3933,6 +4093,19 @@ CREATE TABLE own_background_jobs (
     job_type integer NOT NULL
 );
 
+CREATE TABLE own_coverage_reports (
+    repo_id integer NOT NULL,
+    commit_id text NOT NULL,
+    total_file_count integer DEFAULT 0 NOT NULL,
+    owned_file_count integer DEFAULT 0 NOT NULL,
+    unowned_directories text[] DEFAULT '{}'::text[] NOT NULL,
+    unmatched_rules jsonb DEFAULT '[]'::jsonb NOT NULL,
+    unresolved_owners jsonb DEFAULT '[]'::jsonb NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE own_coverage_reports IS 'Ownership coverage and rule lint results of the latest coverage job run for every repository.';
+
 CREATE TABLE own_signal_configurations (
     id integer NOT NULL,
     name text NOT NULL,
@@ -4347,6 +4520,24 @@ CREATE SEQUENCE repo_commits_changelists_id_seq
 
 ALTER SEQUENCE repo_commits_changelists_id_seq OWNED BY repo_commits_changelists.id;
 
+CREATE TABLE repo_commits_hg_changesets (
+    id integer NOT NULL,
+    repo_id integer NOT NULL,
+    commit_sha bytea NOT NULL,
+    hg_changeset_id text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+CREATE SEQUENCE repo_commits_hg_changesets_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE repo_commits_hg_changesets_id_seq OWNED BY repo_commits_hg_changesets.id;
+
 CREATE TABLE repo_embedding_job_stats (
     job_id integer NOT NULL,
     is_incremental boolean DEFAULT false NOT NULL,
@@ -5082,6 +5273,8 @@ ALTER TABLE ONLY changesets ALTER COLUMN id SET DEFAULT nextval('changesets_id_s
 
 ALTER TABLE ONLY cm_action_jobs ALTER COLUMN id SET DEFAULT nextval('cm_action_jobs_id_seq'::regclass);
 
+ALTER TABLE ONLY cm_chat_webhooks ALTER COLUMN id SET DEFAULT nextval('cm_chat_webhooks_id_seq'::regclass);
+
 ALTER TABLE ONLY cm_emails ALTER COLUMN id SET DEFAULT nextval('cm_emails_id_seq'::regclass);
 
 ALTER TABLE ONLY cm_monitors ALTER COLUMN id SET DEFAULT nextval('cm_monitors_id_seq'::regclass);
@@ -5152,6 +5345,8 @@ ALTER TABLE ONLY event_logs_scrape_state_own ALTER COLUMN id SET DEFAULT nextval
 
 ALTER TABLE ONLY executor_heartbeats ALTER COLUMN id SET DEFAULT nextval('executor_heartbeats_id_seq'::regclass);
 
+ALTER TABLE ONLY executor_job_log_chunks ALTER COLUMN id SET DEFAULT nextval('executor_job_log_chunks_id_seq'::regclass);
+
 ALTER TABLE ONLY executor_job_tokens ALTER COLUMN id SET DEFAULT nextval('executor_job_tokens_id_seq'::regclass);
 
 ALTER TABLE ONLY executor_secret_access_logs ALTER COLUMN id SET DEFAULT nextval('executor_secret_access_logs_id_seq'::regclass);
@@ -5254,6 +5449,8 @@ ALTER TABLE ONLY repo ALTER COLUMN id SET DEFAULT nextval('repo_id_seq'::regclas
 
 ALTER TABLE ONLY repo_commits_changelists ALTER COLUMN id SET DEFAULT nextval('repo_commits_changelists_id_seq'::regclass);
 
+ALTER TABLE ONLY repo_commits_hg_changesets ALTER COLUMN id SET DEFAULT nextval('repo_commits_hg_changesets_id_seq'::regclass);
+
 ALTER TABLE ONLY repo_embedding_jobs ALTER COLUMN id SET DEFAULT nextval('repo_embedding_jobs_id_seq'::regclass);
 
 ALTER TABLE ONLY repo_paths ALTER COLUMN id SET DEFAULT nextval('repo_paths_id_seq'::regclass);
@@ -5322,6 +5519,9 @@ ALTER TABLE ONLY assigned_teams
 ALTER TABLE ONLY batch_changes
     ADD CONSTRAINT batch_changes_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY batch_changes_merge_queues
+    ADD CONSTRAINT batch_changes_merge_queues_pkey PRIMARY KEY (batch_change_id);
+
 ALTER TABLE ONLY batch_changes_site_credentials
     ADD CONSTRAINT batch_changes_site_credentials_pkey PRIMARY KEY (id);
 
@@ -5376,6 +5576,9 @@ ALTER TABLE ONLY changesets
 ALTER TABLE ONLY cm_action_jobs
     ADD CONSTRAINT cm_action_jobs_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY cm_chat_webhooks
+    ADD CONSTRAINT cm_chat_webhooks_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY cm_emails
     ADD CONSTRAINT cm_emails_pkey PRIMARY KEY (id);
 
@@ -5502,6 +5705,12 @@ ALTER TABLE ONLY executor_heartbeats
 ALTER TABLE ONLY executor_heartbeats
     ADD CONSTRAINT executor_heartbeats_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY executor_job_caches
+    ADD CONSTRAINT executor_job_caches_pkey PRIMARY KEY (key);
+
+ALTER TABLE ONLY executor_job_log_chunks
+    ADD CONSTRAINT executor_job_log_chunks_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY executor_job_tokens
     ADD CONSTRAINT executor_job_tokens_job_id_queue_repo_id_key UNIQUE (job_id, queue, repo_id);
 
@@ -5553,6 +5762,9 @@ ALTER TABLE ONLY github_apps
 ALTER TABLE ONLY gitserver_relocator_jobs
     ADD CONSTRAINT gitserver_relocator_jobs_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY gitserver_repo_push_mirrors
+    ADD CONSTRAINT gitserver_repo_push_mirrors_pkey PRIMARY KEY (repo_id, remote_name);
+
 ALTER TABLE ONLY gitserver_repos
     ADD CONSTRAINT gitserver_repos_pkey PRIMARY KEY (repo_id);
 
@@ -5682,6 +5894,9 @@ ALTER TABLE ONLY own_aggregate_recent_view
 ALTER TABLE ONLY own_background_jobs
     ADD CONSTRAINT own_background_jobs_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY own_coverage_reports
+    ADD CONSTRAINT own_coverage_reports_pkey PRIMARY KEY (repo_id);
+
 ALTER TABLE ONLY own_signal_configurations
     ADD CONSTRAINT own_signal_configurations_pkey PRIMARY KEY (id);
 
@@ -5727,6 +5942,9 @@ ALTER TABLE ONLY registry_extensions
 ALTER TABLE ONLY repo_commits_changelists
     ADD CONSTRAINT repo_commits_changelists_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY repo_commits_hg_changesets
+    ADD CONSTRAINT repo_commits_hg_changesets_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY repo_embedding_job_stats
     ADD CONSTRAINT repo_embedding_job_stats_pkey PRIMARY KEY (job_id);
 
@@ -5948,6 +6166,12 @@ CREATE INDEX cm_action_jobs_state_idx ON cm_action_jobs USING btree (state);
 
 CREATE INDEX cm_action_jobs_trigger_event ON cm_action_jobs USING btree (trigger_event);
 
+CREATE INDEX cm_chat_webhooks_monitor ON cm_chat_webhooks USING btree (monitor);
+
+CREATE UNIQUE INDEX cm_notified_matches_action_match ON cm_notified_matches USING btree (COALESCE(email, (0)::bigint), COALESCE(webhook, (0)::bigint), COALESCE(slack_webhook, (0)::bigint), COALESCE(chat_webhook, (0)::bigint), repo_id, commit_id, path);
+
+CREATE INDEX cm_notified_matches_notified_at ON cm_notified_matches USING btree (notified_at);
+
 CREATE INDEX cm_slack_webhooks_monitor ON cm_slack_webhooks USING btree (monitor);
 
 CREATE INDEX cm_trigger_jobs_finished_at ON cm_trigger_jobs USING btree (finished_at);
@@ -6034,6 +6258,12 @@ CREATE INDEX event_logs_user_id_name ON event_logs USING btree (user_id, name);
 
 CREATE INDEX event_logs_user_id_timestamp ON event_logs USING btree (user_id, "timestamp");
 
+CREATE INDEX executor_job_caches_last_accessed_at ON executor_job_caches USING btree (last_accessed_at);
+
+CREATE INDEX executor_job_log_chunks_created_at ON executor_job_log_chunks USING btree (created_at);
+
+CREATE INDEX executor_job_log_chunks_queue_job_id_id ON executor_job_log_chunks USING btree (queue, job_id, id);
+
 CREATE UNIQUE INDEX executor_secrets_unique_key_global ON executor_secrets USING btree (key, scope) WHERE ((namespace_user_id IS NULL) AND (namespace_org_id IS NULL));
 
 CREATE UNIQUE INDEX executor_secrets_unique_key_namespace_org ON executor_secrets USING btree (key, namespace_org_id, scope) WHERE (namespace_org_id IS NOT NULL);
@@ -6078,6 +6308,8 @@ CREATE UNIQUE INDEX github_apps_app_id_slug_base_url_unique ON github_apps USING
 
 CREATE INDEX gitserver_relocator_jobs_state ON gitserver_relocator_jobs USING btree (state);
 
+CREATE INDEX gitserver_repo_push_mirrors_next_attempt_at_idx ON gitserver_repo_push_mirrors USING btree (next_attempt_at) WHERE (next_attempt_at IS NOT NULL);
+
 CREATE INDEX gitserver_repo_size_bytes ON gitserver_repos USING btree (repo_size_bytes);
 
 CREATE INDEX gitserver_repos_cloned_status_idx ON gitserver_repos USING btree (repo_id) WHERE (clone_status = 'cloned'::text);
@@ -6270,6 +6502,8 @@ CREATE INDEX repo_fork ON repo USING btree (fork);
 
 CREATE INDEX repo_hashed_name_idx ON repo USING btree (sha256((lower((name)::text))::bytea)) WHERE (deleted_at IS NULL);
 
+CREATE UNIQUE INDEX repo_id_hg_changeset_id_unique ON repo_commits_hg_changesets USING btree (repo_id, hg_changeset_id);
+
 CREATE UNIQUE INDEX repo_id_perforce_changelist_id_unique ON repo_commits_changelists USING btree (repo_id, perforce_changelist_id);
 
 CREATE INDEX repo_is_not_blocked_idx ON repo USING btree (((blocked IS NULL)));
@@ -6471,6 +6705,12 @@ ALTER TABLE ONLY batch_changes
 ALTER TABLE ONLY batch_changes
     ADD CONSTRAINT batch_changes_namespace_user_id_fkey FOREIGN KEY (namespace_user_id) REFERENCES users(id) ON DELETE CASCADE DEFERRABLE;
 
+ALTER TABLE ONLY batch_changes_merge_queues
+    ADD CONSTRAINT batch_changes_merge_queues_batch_change_id_fkey FOREIGN KEY (batch_change_id) REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE;
+
+ALTER TABLE ONLY batch_changes_merge_queues
+    ADD CONSTRAINT batch_changes_merge_queues_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE DEFERRABLE;
+
 ALTER TABLE ONLY batch_spec_execution_cache_entries
     ADD CONSTRAINT batch_spec_execution_cache_entries_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE DEFERRABLE;
 
@@ -6534,6 +6774,9 @@ ALTER TABLE ONLY changesets
 ALTER TABLE ONLY changesets
     ADD CONSTRAINT changesets_repo_id_fkey FOREIGN KEY (repo_id) REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE;
 
+ALTER TABLE ONLY cm_action_jobs
+    ADD CONSTRAINT cm_action_jobs_chat_webhook_fkey FOREIGN KEY (chat_webhook) REFERENCES cm_chat_webhooks(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY cm_action_jobs
     ADD CONSTRAINT cm_action_jobs_email_fk FOREIGN KEY (email) REFERENCES cm_emails(id) ON DELETE CASCADE;
 
@@ -6546,6 +6789,15 @@ ALTER TABLE ONLY cm_action_jobs
 ALTER TABLE ONLY cm_action_jobs
     ADD CONSTRAINT cm_action_jobs_webhook_fkey FOREIGN KEY (webhook) REFERENCES cm_webhooks(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY cm_chat_webhooks
+    ADD CONSTRAINT cm_chat_webhooks_changed_by_fkey FOREIGN KEY (changed_by) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY cm_chat_webhooks
+    ADD CONSTRAINT cm_chat_webhooks_created_by_fkey FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY cm_chat_webhooks
+    ADD CONSTRAINT cm_chat_webhooks_monitor_fkey FOREIGN KEY (monitor) REFERENCES cm_monitors(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY cm_emails
     ADD CONSTRAINT cm_emails_changed_by_fk FOREIGN KEY (changed_by) REFERENCES users(id) ON DELETE CASCADE;
 
@@ -6573,6 +6825,18 @@ ALTER TABLE ONLY cm_monitors
 ALTER TABLE ONLY cm_monitors
     ADD CONSTRAINT cm_monitors_user_id_fk FOREIGN KEY (namespace_user_id) REFERENCES users(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY cm_notified_matches
+    ADD CONSTRAINT cm_notified_matches_chat_webhook_fkey FOREIGN KEY (chat_webhook) REFERENCES cm_chat_webhooks(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY cm_notified_matches
+    ADD CONSTRAINT cm_notified_matches_email_fkey FOREIGN KEY (email) REFERENCES cm_emails(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY cm_notified_matches
+    ADD CONSTRAINT cm_notified_matches_slack_webhook_fkey FOREIGN KEY (slack_webhook) REFERENCES cm_slack_webhooks(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY cm_notified_matches
+    ADD CONSTRAINT cm_notified_matches_webhook_fkey FOREIGN KEY (webhook) REFERENCES cm_webhooks(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY cm_recipients
     ADD CONSTRAINT cm_recipients_emails FOREIGN KEY (email) REFERENCES cm_emails(id) ON DELETE CASCADE;
 
@@ -6747,6 +7011,9 @@ ALTER TABLE ONLY github_app_installs
 ALTER TABLE ONLY github_apps
     ADD CONSTRAINT github_apps_webhook_id_fkey FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE SET NULL;
 
+ALTER TABLE ONLY gitserver_repo_push_mirrors
+    ADD CONSTRAINT gitserver_repo_push_mirrors_repo_id_fkey FOREIGN KEY (repo_id) REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE;
+
 ALTER TABLE ONLY gitserver_repos
     ADD CONSTRAINT gitserver_repos_repo_id_fkey FOREIGN KEY (repo_id) REFERENCES repo(id) ON DELETE CASCADE;
 
@@ -6855,6 +7122,9 @@ ALTER TABLE ONLY own_aggregate_recent_view
 ALTER TABLE ONLY own_aggregate_recent_view
     ADD CONSTRAINT own_aggregate_recent_view_viewer_id_fkey FOREIGN KEY (viewer_id) REFERENCES users(id) ON DELETE CASCADE DEFERRABLE;
 
+ALTER TABLE ONLY own_coverage_reports
+    ADD CONSTRAINT own_coverage_reports_repo_id_fkey FOREIGN KEY (repo_id) REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE;
+
 ALTER TABLE ONLY own_signal_recent_contribution
     ADD CONSTRAINT own_signal_recent_contribution_changed_file_path_id_fkey FOREIGN KEY (changed_file_path_id) REFERENCES repo_paths(id);
 
@@ -6897,6 +7167,9 @@ ALTER TABLE ONLY registry_extensions
 ALTER TABLE ONLY repo_commits_changelists
     ADD CONSTRAINT repo_commits_changelists_repo_id_fkey FOREIGN KEY (repo_id) REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE;
 
+ALTER TABLE ONLY repo_commits_hg_changesets
+    ADD CONSTRAINT repo_commits_hg_changesets_repo_id_fkey FOREIGN KEY (repo_id) REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE;
+
 ALTER TABLE ONLY repo_embedding_job_stats
     ADD CONSTRAINT repo_embedding_job_stats_job_id_fkey FOREIGN KEY (job_id) REFERENCES repo_embedding_jobs(id) ON DELETE CASCADE DEFERRABLE;
 
@@ -867,18 +867,24 @@ type ExperimentalFeatures struct {
 	NpmPackages string `json:"npmPackages,omitempty"`
 	// Pagure description: Allow adding Pagure code host connections
 	Pagure string `json:"pagure,omitempty"`
+	// PartialClones description: An array of rules for repositories that gitserver stores as blobless partial clones. File contents are fetched from the code host when they are first read, except for the files under the sparse paths of the rule, which are always stored. Existing clones are converted when they are recloned.
+	PartialClones []*PartialCloneRule `json:"partialClones,omitempty"`
 	// PasswordPolicy description: DEPRECATED: this is now a standard feature see: auth.passwordPolicy
 	PasswordPolicy *PasswordPolicy `json:"passwordPolicy,omitempty"`
 	// Perforce description: Allow adding Perforce code host connections
 	Perforce string `json:"perforce,omitempty"`
 	// PerforceChangelistMapping description: Allow mapping of Perforce changelists to their commit SHAs in the DB
 	PerforceChangelistMapping string `json:"perforceChangelistMapping,omitempty"`
+	// PushMirrors description: An array of secondary remotes to which gitserver pushes the branches and tags of repositories after every successful update, for example for disaster recovery or to keep air-gapped replicas up to date. Failed pushes are retried with exponential backoff. Partial clones are not pushed.
+	PushMirrors []*PushMirror `json:"pushMirrors,omitempty"`
 	// PythonPackages description: Allow adding Python package code host connections
 	PythonPackages string `json:"pythonPackages,omitempty"`
 	// Ranking description: Experimental search result ranking options.
 	Ranking *Ranking `json:"ranking,omitempty"`
 	// RateLimitAnonymous description: Configures the hourly rate limits for anonymous calls to the GraphQL API. Setting limit to 0 disables the limiter. This is only relevant if unauthenticated calls to the API are permitted.
 	RateLimitAnonymous int `json:"rateLimitAnonymous,omitempty"`
+	// ResumableClones description: Clone Git repositories in steps of increasing depth, so that a clone that is interrupted by a gitserver restart or a network failure resumes from the last completed step instead of starting from scratch.
+	ResumableClones string `json:"resumableClones,omitempty"`
 	// RubyPackages description: Allow adding Ruby package host connections
 	RubyPackages string `json:"rubyPackages,omitempty"`
 	// RustPackages description: Allow adding Rust package code host connections
@@ -947,12 +953,15 @@ func (v *ExperimentalFeatures) UnmarshalJSON(data []byte) error {
 	delete(m, "jvmPackages")
 	delete(m, "npmPackages")
 	delete(m, "pagure")
+	delete(m, "partialClones")
 	delete(m, "passwordPolicy")
 	delete(m, "perforce")
 	delete(m, "perforceChangelistMapping")
+	delete(m, "pushMirrors")
 	delete(m, "pythonPackages")
 	delete(m, "ranking")
 	delete(m, "rateLimitAnonymous")
+	delete(m, "resumableClones")
 	delete(m, "rubyPackages")
 	delete(m, "rustPackages")
 	delete(m, "search.index.branches")
@@ -1809,6 +1818,8 @@ type OtherExternalServiceConnection struct {
 	// Root description: The root directory to walk for discovering local git repositories to mirror. To sync with local repositories and use this root property one must run Cody App and define the repos configuration property such as ["src-serve-local"].
 	Root string `json:"root,omitempty"`
 	Url  string `json:"url,omitempty"`
+	// Vcs description: The version control system of the repositories. Mercurial repositories are converted to Git when they are cloned and fetched, which requires git-remote-hg to be available on gitserver.
+	Vcs string `json:"vcs,omitempty"`
 }
 type OutputVariable struct {
 	// Format description: The expected format of the output. If set, the output is being parsed in that format before being stored in the var. If not set, 'text' is assumed to the format.
@@ -1848,6 +1859,13 @@ type ParentSourcegraph struct {
 	Url string `json:"url,omitempty"`
 }
 
+type PartialCloneRule struct {
+	// Name description: Regular expression which matches against the name of a repository (e.g. "^github\.com/owner/name$" or "^github\.com/" for all repositories of a code host).
+	Name string `json:"name"`
+	// SparsePaths description: Paths of directories or files whose contents at the default branch are always stored, instead of being fetched when they are first read.
+	SparsePaths []string `json:"sparsePaths,omitempty"`
+}
+
 // PasswordPolicy description: DEPRECATED: this is now a standard feature see: auth.passwordPolicy
 type PasswordPolicy struct {
 	// Enabled description: Enables password policy
@@ -1932,6 +1950,15 @@ type PhabricatorConnection struct {
 	Url string `json:"url,omitempty"`
 }
 
+type PushMirror struct {
+	// Name description: A unique name of the remote, which identifies it in the push status of repositories.
+	Name string `json:"name"`
+	// Repos description: Regular expression which matches against the name of the repositories that are pushed to the remote (e.g. "^github\.com/owner/"). All repositories are pushed if it is not set.
+	Repos string `json:"repos,omitempty"`
+	// Url description: The URL of the repository on the remote, or the path of a bare repository on the local filesystem of gitserver, which is created if it doesn't exist. The placeholder {repo} is replaced by the name of the repository. Credentials can be included in the URL.
+	Url string `json:"url"`
+}
+
 // PythonPackagesConnection description: Configuration for a connection to Python simple repository APIs compatible with PEP 503
 type PythonPackagesConnection struct {
 	// Dependencies description: An array of strings specifying Python packages to mirror in Sourcegraph.